@@ -160,6 +160,11 @@ func (r *renderer) renderComparison(cond *ComparisonCondition) (renderResult, er
 			return r.renderJSONBoolComparison(field, cond.Operator, cond.Right)
 		case FieldKindScalar:
 			return r.renderScalarComparison(field, cond.Operator, cond.Right)
+		case FieldKindJSONList:
+			if field.ValuePrefix == "" {
+				return renderResult{}, errors.Errorf("field %q does not support comparison", field.Name)
+			}
+			return r.renderJSONListComparison(field, cond.Operator, cond.Right)
 		default:
 			return renderResult{}, errors.Errorf("field %q does not support comparison", field.Name)
 		}
@@ -325,6 +330,9 @@ func (r *renderer) renderInCondition(cond *InCondition) (renderResult, error) {
 		return renderResult{}, errors.Errorf("unknown field %q", fieldRef.Name)
 	}
 
+	if field.Kind == FieldKindJSONList && field.ValuePrefix != "" {
+		return r.renderJSONListInList(field, cond.Values)
+	}
 	if field.Kind != FieldKindScalar {
 		return renderResult{}, errors.Errorf("field %q does not support IN()", fieldRef.Name)
 	}
@@ -332,6 +340,85 @@ func (r *renderer) renderInCondition(cond *InCondition) (renderResult, error) {
 	return r.renderScalarInCondition(field, cond.Values)
 }
 
+// renderJSONListComparison renders `field == "prefix/value"` as membership of
+// value in the JSON list, and `!=` as its negation.
+func (r *renderer) renderJSONListComparison(field Field, op ComparisonOperator, right ValueExpr) (renderResult, error) {
+	value, err := r.expectPrefixedValue(field, right)
+	if err != nil {
+		return renderResult{}, err
+	}
+	membership, err := r.jsonListMembership(field, value)
+	if err != nil {
+		return renderResult{}, err
+	}
+	switch op {
+	case CompareEq:
+		return renderResult{sql: membership}, nil
+	case CompareNeq:
+		arrayExpr := jsonArrayExpr(r.dialect, field)
+		return renderResult{sql: fmt.Sprintf("(%s IS NULL OR NOT (%s))", arrayExpr, membership)}, nil
+	default:
+		return renderResult{}, errors.Errorf("operator %s not supported for field %q", op, field.Name)
+	}
+}
+
+// renderJSONListInList renders `field in ["prefix/a", "prefix/b"]` as a
+// disjunction of list memberships.
+func (r *renderer) renderJSONListInList(field Field, values []ValueExpr) (renderResult, error) {
+	if len(values) == 0 {
+		return renderResult{sql: "1 = 0", unsatisfiable: true}, nil
+	}
+	conditions := make([]string, 0, len(values))
+	for _, v := range values {
+		value, err := r.expectPrefixedValue(field, v)
+		if err != nil {
+			return renderResult{}, err
+		}
+		membership, err := r.jsonListMembership(field, value)
+		if err != nil {
+			return renderResult{}, err
+		}
+		conditions = append(conditions, membership)
+	}
+	if len(conditions) == 1 {
+		return renderResult{sql: conditions[0]}, nil
+	}
+	return renderResult{
+		sql: fmt.Sprintf("(%s)", strings.Join(conditions, " OR ")),
+	}, nil
+}
+
+// expectPrefixedValue returns the string literal with the field's ValuePrefix removed.
+func (r *renderer) expectPrefixedValue(field Field, expr ValueExpr) (string, error) {
+	lit, err := expectLiteral(expr)
+	if err != nil {
+		return "", err
+	}
+	str, ok := lit.(string)
+	if !ok {
+		return "", errors.Errorf("field %q expects string values", field.Name)
+	}
+	value, ok := strings.CutPrefix(str, field.ValuePrefix)
+	if !ok || value == "" {
+		return "", errors.Errorf("field %q expects values of the form %s{id}, got %q", field.Name, field.ValuePrefix, str)
+	}
+	return value, nil
+}
+
+// jsonListMembership returns a predicate matching rows whose JSON list contains value.
+func (r *renderer) jsonListMembership(field Field, value string) (string, error) {
+	switch r.dialect {
+	case DialectSQLite:
+		return fmt.Sprintf("%s LIKE %s", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf(`%%"%s"%%`, value))), nil
+	case DialectMySQL:
+		return fmt.Sprintf("JSON_CONTAINS(%s, %s)", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf(`"%s"`, value))), nil
+	case DialectPostgres:
+		return fmt.Sprintf("%s @> jsonb_build_array(%s::json)", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf(`"%s"`, value))), nil
+	default:
+		return "", errors.Errorf("unsupported dialect %s", r.dialect)
+	}
+}
+
 func (r *renderer) renderTagInList(values []ValueExpr) (renderResult, error) {
 	field, ok := r.schema.ResolveAlias("tag")
 	if !ok {
@@ -399,19 +486,11 @@ func (r *renderer) renderElementInCondition(cond *ElementInCondition) (renderRes
 		return renderResult{}, errors.New("tags membership requires string literal")
 	}
 
-	switch r.dialect {
-	case DialectSQLite:
-		sql := fmt.Sprintf("%s LIKE %s", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf(`%%"%s"%%`, str)))
-		return renderResult{sql: sql}, nil
-	case DialectMySQL:
-		sql := fmt.Sprintf("JSON_CONTAINS(%s, %s)", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf(`"%s"`, str)))
-		return renderResult{sql: sql}, nil
-	case DialectPostgres:
-		sql := fmt.Sprintf("%s @> jsonb_build_array(%s::json)", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf(`"%s"`, str)))
-		return renderResult{sql: sql}, nil
-	default:
-		return renderResult{}, errors.Errorf("unsupported dialect %s", r.dialect)
+	sql, err := r.jsonListMembership(field, str)
+	if err != nil {
+		return renderResult{}, err
 	}
+	return renderResult{sql: sql}, nil
 }
 
 func (r *renderer) renderScalarInCondition(field Field, values []ValueExpr) (renderResult, error) {
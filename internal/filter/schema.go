@@ -47,12 +47,15 @@ type Column struct {
 
 // Field captures the schema metadata for an exposed CEL identifier.
 type Field struct {
-	Name                 string
-	Kind                 FieldKind
-	Type                 FieldType
-	Column               Column
-	JSONPath             []string
-	AliasFor             string
+	Name     string
+	Kind     FieldKind
+	Type     FieldType
+	Column   Column
+	JSONPath []string
+	AliasFor string
+	// ValuePrefix is a resource prefix that literals must carry and that is
+	// stripped before matching stored values (e.g. "groups/").
+	ValuePrefix          string
 	SupportsContains     bool
 	Expressions          map[DialectName]string
 	AllowedComparisonOps map[ComparisonOperator]bool
@@ -123,6 +126,17 @@ func NewSchema() Schema {
 				CompareNeq: true,
 			},
 		},
+		"id": {
+			Name:        "id",
+			Kind:        FieldKindScalar,
+			Type:        FieldTypeInt,
+			Column:      Column{Table: "memo", Name: "id"},
+			Expressions: map[DialectName]string{},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
 		"creator_id": {
 			Name:        "creator_id",
 			Kind:        FieldKindScalar,
@@ -160,6 +174,14 @@ func NewSchema() Schema {
 				DialectSQLite:   "%s",
 			},
 		},
+		"due_time": {
+			Name:   "due_time",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeTimestamp,
+			Column: Column{Table: "memo", Name: "due_ts"},
+			// due_ts is a nullable BIGINT (epoch) in all dialects.
+			Expressions: map[DialectName]string{},
+		},
 		"pinned": {
 			Name:        "pinned",
 			Kind:        FieldKindBoolColumn,
@@ -189,6 +211,18 @@ func NewSchema() Schema {
 			Column:   Column{Table: "memo", Name: "payload"},
 			JSONPath: []string{"tags"},
 		},
+		"audience": {
+			Name:        "audience",
+			Kind:        FieldKindJSONList,
+			Type:        FieldTypeString,
+			Column:      Column{Table: "memo", Name: "payload"},
+			JSONPath:    []string{"audience"},
+			ValuePrefix: "groups/",
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
 		"tag": {
 			Name:     "tag",
 			Kind:     FieldKindVirtualAlias,
@@ -244,13 +278,16 @@ func NewSchema() Schema {
 	envOptions := []cel.EnvOption{
 		cel.Variable("content", cel.StringType),
 		cel.Variable("creator", cel.StringType),
+		cel.Variable("id", cel.IntType),
 		cel.Variable("creator_id", cel.IntType),
 		cel.Variable("created_ts", cel.IntType),
 		cel.Variable("updated_ts", cel.IntType),
+		cel.Variable("due_time", cel.NullableType(cel.IntType)),
 		cel.Variable("pinned", cel.BoolType),
 		cel.Variable("tag", cel.StringType),
 		cel.Variable("tags", cel.ListType(cel.StringType)),
 		cel.Variable("visibility", cel.StringType),
+		cel.Variable("audience", cel.StringType),
 		cel.Variable("has_task_list", cel.BoolType),
 		cel.Variable("has_link", cel.BoolType),
 		cel.Variable("has_code", cel.BoolType),
@@ -0,0 +1,32 @@
+package ast
+
+import (
+	"time"
+
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// DueNode represents an @due(...) date in the markdown AST.
+type DueNode struct {
+	gast.BaseInline
+
+	// Raw is the text between the parentheses, as written.
+	Raw []byte
+	// Time is the parsed due time in UTC.
+	Time time.Time
+}
+
+// KindDue is the NodeKind for DueNode.
+var KindDue = gast.NewNodeKind("Due")
+
+// Kind returns KindDue.
+func (*DueNode) Kind() gast.NodeKind {
+	return KindDue
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *DueNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, map[string]string{
+		"Raw": string(n.Raw),
+	}, nil)
+}
@@ -0,0 +1,24 @@
+package extensions
+
+import (
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/util"
+
+	mparser "github.com/usememos/memos/internal/markdown/parser"
+)
+
+type dueExtension struct{}
+
+// DueExtension is a goldmark extension for @due(...) syntax.
+var DueExtension = &dueExtension{}
+
+// Extend extends the goldmark parser with due date support.
+func (*dueExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			// Priority 199 - run before the mention parser (200), which shares the '@' trigger.
+			util.Prioritized(mparser.NewDueParser(), 199),
+		),
+	)
+}
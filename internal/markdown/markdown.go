@@ -3,6 +3,7 @@ package markdown
 import (
 	"bytes"
 	"strings"
+	"time"
 
 	"github.com/yuin/goldmark"
 	gast "github.com/yuin/goldmark/ast"
@@ -22,6 +23,8 @@ type ExtractedData struct {
 	Tags     []string
 	Mentions []string
 	Property *storepb.MemoPayload_Property
+	// DueTime is the time of the first @due(...) marker, or nil if there is none.
+	DueTime *time.Time
 }
 
 // Service handles markdown metadata extraction.
@@ -65,6 +68,7 @@ type Option func(*config)
 type config struct {
 	enableTags     bool
 	enableMentions bool
+	enableDue      bool
 }
 
 // WithTagExtension enables #tag parsing.
@@ -81,6 +85,13 @@ func WithMentionExtension() Option {
 	}
 }
 
+// WithDueExtension enables @due(...) parsing.
+func WithDueExtension() Option {
+	return func(c *config) {
+		c.enableDue = true
+	}
+}
+
 // NewService creates a new markdown service with the given options.
 func NewService(opts ...Option) Service {
 	cfg := &config{}
@@ -99,6 +110,9 @@ func NewService(opts ...Option) Service {
 	if cfg.enableMentions {
 		exts = append(exts, extensions.MentionExtension)
 	}
+	if cfg.enableDue {
+		exts = append(exts, extensions.DueExtension)
+	}
 
 	md := goldmark.New(
 		goldmark.WithExtensions(exts...),
@@ -361,6 +375,10 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 		if mentionNode, ok := n.(*mast.MentionNode); ok {
 			data.Mentions = append(data.Mentions, strings.ToLower(string(mentionNode.Username)))
 		}
+		if dueNode, ok := n.(*mast.DueNode); ok && data.DueTime == nil {
+			dueTime := dueNode.Time
+			data.DueTime = &dueTime
+		}
 
 		// Check if the first block-level child of the document is an H1 heading.
 		if !firstBlockChecked && n.Parent() != nil && n.Parent().Kind() == gast.KindDocument {
@@ -2,6 +2,7 @@ package markdown
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -349,6 +350,65 @@ func TestExtractAllMentions(t *testing.T) {
 	assert.ElementsMatch(t, []string{"tag"}, data.Tags)
 }
 
+func TestExtractAllDueTime(t *testing.T) {
+	svc := NewService(WithMentionExtension(), WithDueExtension())
+
+	tests := []struct {
+		name     string
+		content  string
+		expected *time.Time
+		mentions []string
+	}{
+		{
+			name:     "date and time",
+			content:  "Ship it @due(2026-11-01 09:00)",
+			expected: ptrTime(time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)),
+			mentions: []string{},
+		},
+		{
+			name:     "date only",
+			content:  "@due(2026-11-01) and ask @alice",
+			expected: ptrTime(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)),
+			mentions: []string{"alice"},
+		},
+		{
+			name:     "first marker wins",
+			content:  "@due(2026-11-01T09:30) then @due(2026-12-01)",
+			expected: ptrTime(time.Date(2026, 11, 1, 9, 30, 0, 0, time.UTC)),
+			mentions: []string{},
+		},
+		{
+			name:     "invalid date falls back to mention",
+			content:  "@due(tomorrow)",
+			expected: nil,
+			mentions: []string{"due"},
+		},
+		{
+			name:     "inside code span",
+			content:  "`@due(2026-11-01)`",
+			expected: nil,
+			mentions: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data.DueTime)
+			assert.ElementsMatch(t, tt.mentions, data.Mentions)
+
+			rendered, err := svc.RenderMarkdown([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.content, rendered)
+		})
+	}
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
+
 func TestExtractTags(t *testing.T) {
 	tests := []struct {
 		name     string
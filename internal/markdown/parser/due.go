@@ -0,0 +1,81 @@
+package parser
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/internal/markdown/ast"
+)
+
+var (
+	duePrefix = []byte("@due(")
+
+	// dueLayouts are the accepted @due(...) formats, interpreted in UTC.
+	dueLayouts = []string{
+		"2006-01-02 15:04",
+		"2006-01-02T15:04",
+		"2006-01-02",
+	}
+)
+
+type dueParser struct{}
+
+// NewDueParser creates a new inline parser for @due(...) syntax.
+func NewDueParser() parser.InlineParser {
+	return &dueParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*dueParser) Trigger() []byte {
+	return []byte{'@'}
+}
+
+// parseDueTime parses the text of an @due(...) marker.
+func parseDueTime(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range dueLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Parse parses @due(2026-11-01 09:00) syntax. Markers with an unrecognized
+// date are left to the other parsers.
+func (*dueParser) Parse(_ gast.Node, block text.Reader, _ parser.Context) gast.Node {
+	line, _ := block.PeekLine()
+	if !bytes.HasPrefix(line, duePrefix) {
+		return nil
+	}
+
+	prev := block.PrecendingCharacter()
+	if prev != '\n' && !isMentionBoundary(prev) {
+		return nil
+	}
+
+	end := bytes.IndexByte(line[len(duePrefix):], ')')
+	if end < 0 {
+		return nil
+	}
+	raw := line[len(duePrefix) : len(duePrefix)+end]
+	dueTime, ok := parseDueTime(string(raw))
+	if !ok {
+		return nil
+	}
+
+	rawCopy := make([]byte, len(raw))
+	copy(rawCopy, raw)
+
+	block.Advance(len(duePrefix) + end + 1)
+
+	return &mast.DueNode{
+		Raw:  rawCopy,
+		Time: dueTime,
+	}
+}
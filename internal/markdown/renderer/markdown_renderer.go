@@ -160,6 +160,11 @@ func (r *MarkdownRenderer) renderNode(node gast.Node, source []byte, depth int)
 		r.buf.WriteByte('@')
 		r.buf.Write(n.Username)
 
+	case *mast.DueNode:
+		r.buf.WriteString("@due(")
+		r.buf.Write(n.Raw)
+		r.buf.WriteByte(')')
+
 	default:
 		// For unknown nodes, try to render children
 		r.renderChildren(n, source, depth)
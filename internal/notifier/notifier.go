@@ -0,0 +1,156 @@
+// Package notifier delivers notifications to self-hosted push services: ntfy, Gotify
+// and the Apprise API.
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Service is a push service a notification is delivered to.
+type Service string
+
+const (
+	// Ntfy publishes to an ntfy topic. The token is an optional access token.
+	Ntfy Service = "ntfy"
+	// Gotify creates a message on a Gotify server. The token is the application token.
+	Gotify Service = "gotify"
+	// Apprise notifies through the stateful notify endpoint of an Apprise API.
+	Apprise Service = "apprise"
+)
+
+// Target is where a notification is delivered.
+type Target struct {
+	Service Service
+	// URL is the ntfy topic URL, the Gotify server URL or the Apprise API notify URL.
+	URL   string
+	Token string
+}
+
+// Message is a notification.
+type Message struct {
+	Title string
+	Body  string
+	// URL is opened when the notification is clicked. It may be empty.
+	URL string
+}
+
+// Validate checks that a target is complete. It does not check where the URL resolves to.
+func Validate(target *Target) error {
+	u, err := url.Parse(target.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("invalid %s URL %q", target.Service, target.URL)
+	}
+	switch target.Service {
+	case Ntfy:
+		if strings.Trim(u.Path, "/") == "" {
+			return errors.New("ntfy URL must include the topic")
+		}
+	case Gotify:
+		if target.Token == "" {
+			return errors.New("gotify application token is required")
+		}
+	case Apprise:
+	default:
+		return errors.Errorf("unsupported notification service %q", target.Service)
+	}
+	return nil
+}
+
+// Send delivers a message to a target. The target must answer with a 2xx status code.
+func Send(ctx context.Context, client *http.Client, target *Target, message *Message) error {
+	request, err := NewRequest(ctx, target, message)
+	if err != nil {
+		return err
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to deliver %s notification", target.Service)
+	}
+	defer response.Body.Close()
+	snippet, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return errors.Errorf("%s responded with status %d: %s", target.Service, response.StatusCode, strings.TrimSpace(string(snippet)))
+	}
+	return nil
+}
+
+// NewRequest builds the request delivering a message to a target.
+func NewRequest(ctx context.Context, target *Target, message *Message) (*http.Request, error) {
+	if err := Validate(target); err != nil {
+		return nil, err
+	}
+	var (
+		endpoint string
+		body     any
+		headers  = http.Header{}
+	)
+	switch target.Service {
+	case Ntfy:
+		// Publishing as JSON to the server root keeps non-ASCII titles intact, which headers do not.
+		u, _ := url.Parse(target.URL)
+		path := strings.TrimRight(u.Path, "/")
+		index := strings.LastIndex(path, "/")
+		topic := path[index+1:]
+		u.Path = path[:index+1]
+		endpoint = u.String()
+		payload := map[string]any{
+			"topic":   topic,
+			"title":   message.Title,
+			"message": message.Body,
+		}
+		if message.URL != "" {
+			payload["click"] = message.URL
+		}
+		body = payload
+		if target.Token != "" {
+			headers.Set("Authorization", "Bearer "+target.Token)
+		}
+	case Gotify:
+		endpoint = strings.TrimRight(target.URL, "/") + "/message"
+		payload := map[string]any{
+			"title":    message.Title,
+			"message":  message.Body,
+			"priority": 5,
+		}
+		if message.URL != "" {
+			payload["extras"] = map[string]any{
+				"client::notification": map[string]any{"click": map[string]string{"url": message.URL}},
+			}
+		}
+		body = payload
+		headers.Set("X-Gotify-Key", target.Token)
+	case Apprise:
+		endpoint = target.URL
+		text := message.Body
+		if message.URL != "" {
+			text += "\n\n" + message.URL
+		}
+		body = map[string]string{
+			"title": message.Title,
+			"body":  text,
+			"type":  "info",
+		}
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal notification")
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s request", target.Service)
+	}
+	for key, values := range headers {
+		request.Header[key] = values
+	}
+	request.Header.Set("Content-Type", "application/json")
+	return request, nil
+}
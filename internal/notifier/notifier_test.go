@@ -0,0 +1,103 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type receivedRequest struct {
+	Path   string
+	Header http.Header
+	Body   map[string]any
+}
+
+func newTestServer(t *testing.T, statusCode int) (*httptest.Server, *receivedRequest) {
+	t.Helper()
+	received := &receivedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Path = r.URL.Path
+		received.Header = r.Header.Clone()
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received.Body)
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+func TestSendNtfy(t *testing.T) {
+	server, received := newTestServer(t, http.StatusOK)
+
+	err := Send(context.Background(), server.Client(), &Target{Service: Ntfy, URL: server.URL + "/memos-alerts", Token: "tk_secret"}, &Message{
+		Title: "Grüße commented on your memo",
+		Body:  "Grüße commented on your memo.",
+		URL:   "https://memos.example.com/memos/abc",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "/", received.Path)
+	require.Equal(t, "Bearer tk_secret", received.Header.Get("Authorization"))
+	require.Equal(t, map[string]any{
+		"topic":   "memos-alerts",
+		"title":   "Grüße commented on your memo",
+		"message": "Grüße commented on your memo.",
+		"click":   "https://memos.example.com/memos/abc",
+	}, received.Body)
+}
+
+func TestSendGotify(t *testing.T) {
+	server, received := newTestServer(t, http.StatusOK)
+
+	err := Send(context.Background(), server.Client(), &Target{Service: Gotify, URL: server.URL + "/gotify/", Token: "app-token"}, &Message{
+		Title: "Reminder",
+		Body:  "This is your reminder for a memo.",
+		URL:   "https://memos.example.com/memos/abc",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "/gotify/message", received.Path)
+	require.Equal(t, "app-token", received.Header.Get("X-Gotify-Key"))
+	require.Equal(t, "Reminder", received.Body["title"])
+	require.Equal(t, "This is your reminder for a memo.", received.Body["message"])
+	require.Equal(t, map[string]any{
+		"client::notification": map[string]any{"click": map[string]any{"url": "https://memos.example.com/memos/abc"}},
+	}, received.Body["extras"])
+}
+
+func TestSendApprise(t *testing.T) {
+	server, received := newTestServer(t, http.StatusOK)
+
+	err := Send(context.Background(), server.Client(), &Target{Service: Apprise, URL: server.URL + "/notify/memos"}, &Message{
+		Title: "Reminder",
+		Body:  "This is your reminder for a memo.",
+		URL:   "https://memos.example.com/memos/abc",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "/notify/memos", received.Path)
+	require.Equal(t, map[string]any{
+		"title": "Reminder",
+		"body":  "This is your reminder for a memo.\n\nhttps://memos.example.com/memos/abc",
+		"type":  "info",
+	}, received.Body)
+}
+
+func TestSendReportsErrorStatus(t *testing.T) {
+	server, _ := newTestServer(t, http.StatusUnauthorized)
+
+	err := Send(context.Background(), server.Client(), &Target{Service: Gotify, URL: server.URL, Token: "wrong"}, &Message{Title: "Test"})
+	require.ErrorContains(t, err, "status 401")
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(&Target{Service: Ntfy, URL: "https://ntfy.sh/topic"}))
+	require.Error(t, Validate(&Target{Service: Ntfy, URL: "https://ntfy.sh/"}))
+	require.Error(t, Validate(&Target{Service: Ntfy, URL: "ftp://ntfy.sh/topic"}))
+	require.Error(t, Validate(&Target{Service: Gotify, URL: "https://gotify.example.com"}))
+	require.NoError(t, Validate(&Target{Service: Gotify, URL: "https://gotify.example.com", Token: "token"}))
+	require.NoError(t, Validate(&Target{Service: Apprise, URL: "https://apprise.example.com/notify/key"}))
+	require.Error(t, Validate(&Target{Service: "pager", URL: "https://example.com"}))
+}
@@ -0,0 +1,34 @@
+package webhook
+
+// Event types of the activities delivered to webhooks.
+const (
+	EventMemoCreated          = "memos.memo.created"
+	EventMemoUpdated          = "memos.memo.updated"
+	EventMemoDeleted          = "memos.memo.deleted"
+	EventMemoCommentCreated   = "memos.memo.comment.created"
+	EventMemoReactionUpserted = "memos.memo.reaction.upserted"
+	EventMemoReminder         = "memos.memo.reminder"
+	EventUserCreated          = "memos.user.created"
+	EventUserDeleted          = "memos.user.deleted"
+)
+
+// EventTypes lists the event types user webhooks can subscribe to.
+var EventTypes = []string{
+	EventMemoCreated,
+	EventMemoUpdated,
+	EventMemoDeleted,
+	EventMemoCommentCreated,
+	EventMemoReactionUpserted,
+	EventMemoReminder,
+}
+
+// InstanceEventTypes lists the event types instance webhooks can subscribe to. Memo
+// events are only delivered for public and protected memos.
+var InstanceEventTypes = []string{
+	EventMemoCreated,
+	EventMemoUpdated,
+	EventMemoDeleted,
+	EventMemoCommentCreated,
+	EventUserCreated,
+	EventUserDeleted,
+}
@@ -0,0 +1,207 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Format is the payload format of webhook deliveries.
+type Format string
+
+const (
+	// FormatMemos is the memos JSON payload, WebhookRequestPayload.
+	FormatMemos Format = ""
+	// FormatSlack is a Slack incoming webhook message.
+	FormatSlack Format = "slack"
+	// FormatDiscord is a Discord webhook message with an embed.
+	FormatDiscord Format = "discord"
+	// FormatTeams is a Microsoft Teams message with an adaptive card.
+	FormatTeams Format = "teams"
+	// FormatTelegram is a Telegram Bot API sendMessage request. The chat is read from
+	// the chat_id query parameter of the webhook URL.
+	FormatTelegram Format = "telegram"
+)
+
+// ValidateFormat checks that a webhook URL can be used with a payload format.
+func ValidateFormat(format Format, rawURL string) error {
+	switch format {
+	case FormatMemos, FormatSlack, FormatDiscord, FormatTeams:
+		return nil
+	case FormatTelegram:
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return errors.Wrap(err, "invalid webhook URL")
+		}
+		if u.Query().Get("chat_id") == "" {
+			return errors.New("telegram webhook URL must have a chat_id query parameter")
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported webhook format %q", format)
+	}
+}
+
+// message is the human-readable rendering of a payload shared by the chat formats.
+type message struct {
+	Title   string
+	Author  string
+	Snippet string
+	Tags    []string
+	// Link is the memo URL, empty when the instance URL is not configured.
+	Link string
+}
+
+var activityTitles = map[string]string{
+	EventMemoCreated:          "New memo",
+	EventMemoUpdated:          "Memo updated",
+	EventMemoDeleted:          "Memo deleted",
+	EventMemoCommentCreated:   "New comment",
+	EventMemoReactionUpserted: "New reaction",
+	EventMemoReminder:         "Memo reminder",
+	EventUserCreated:          "New user",
+	EventUserDeleted:          "User deleted",
+}
+
+func newMessage(payload *WebhookRequestPayload) *message {
+	m := &message{
+		Title:  activityTitles[payload.ActivityType],
+		Author: strings.TrimPrefix(payload.Creator, "users/"),
+	}
+	if m.Title == "" {
+		m.Title = payload.ActivityType
+	}
+	if payload.Reaction != nil {
+		m.Title = fmt.Sprintf("%s %s", m.Title, payload.Reaction.ReactionType)
+	}
+	if user := payload.User; user != nil {
+		m.Snippet = user.Username
+		if user.DisplayName != "" {
+			m.Snippet = fmt.Sprintf("%s (%s)", user.DisplayName, user.Username)
+		}
+	}
+	if memo := payload.Memo; memo != nil {
+		m.Snippet = memo.Snippet
+		if m.Snippet == "" {
+			m.Snippet = memo.Content
+		}
+		m.Tags = memo.Tags
+		if payload.InstanceURL != "" {
+			m.Link = strings.TrimRight(payload.InstanceURL, "/") + "/" + memo.Name
+		}
+	}
+	return m
+}
+
+func (m *message) hashtags() string {
+	tags := make([]string, 0, len(m.Tags))
+	for _, tag := range m.Tags {
+		tags = append(tags, "#"+tag)
+	}
+	return strings.Join(tags, " ")
+}
+
+// marshalBody renders a payload in a format.
+func marshalBody(payload *WebhookRequestPayload) ([]byte, error) {
+	switch payload.Format {
+	case FormatMemos:
+		return json.Marshal(payload)
+	case FormatSlack:
+		return json.Marshal(buildSlackBody(newMessage(payload)))
+	case FormatDiscord:
+		return json.Marshal(buildDiscordBody(newMessage(payload)))
+	case FormatTeams:
+		return json.Marshal(buildTeamsBody(newMessage(payload)))
+	case FormatTelegram:
+		u, err := url.Parse(payload.URL)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid webhook URL")
+		}
+		return json.Marshal(buildTelegramBody(newMessage(payload), u.Query().Get("chat_id")))
+	default:
+		return nil, errors.Errorf("unsupported webhook format %q", payload.Format)
+	}
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func buildSlackBody(m *message) map[string]any {
+	lines := []string{fmt.Sprintf("*%s* by %s", slackEscaper.Replace(m.Title), slackEscaper.Replace(m.Author))}
+	if m.Snippet != "" {
+		lines = append(lines, slackEscaper.Replace(m.Snippet))
+	}
+	if tags := m.hashtags(); tags != "" {
+		lines = append(lines, slackEscaper.Replace(tags))
+	}
+	if m.Link != "" {
+		lines = append(lines, fmt.Sprintf("<%s|View memo>", m.Link))
+	}
+	return map[string]any{"text": strings.Join(lines, "\n")}
+}
+
+func buildDiscordBody(m *message) map[string]any {
+	embed := map[string]any{
+		"title":       m.Title,
+		"description": m.Snippet,
+		"author":      map[string]any{"name": m.Author},
+	}
+	if m.Link != "" {
+		embed["url"] = m.Link
+	}
+	if tags := m.hashtags(); tags != "" {
+		embed["footer"] = map[string]any{"text": tags}
+	}
+	return map[string]any{"embeds": []any{embed}}
+}
+
+func buildTeamsBody(m *message) map[string]any {
+	body := []any{
+		map[string]any{"type": "TextBlock", "text": m.Title, "weight": "Bolder", "size": "Medium", "wrap": true},
+		map[string]any{"type": "TextBlock", "text": "by " + m.Author, "isSubtle": true, "spacing": "None", "wrap": true},
+	}
+	if m.Snippet != "" {
+		body = append(body, map[string]any{"type": "TextBlock", "text": m.Snippet, "wrap": true})
+	}
+	if tags := m.hashtags(); tags != "" {
+		body = append(body, map[string]any{"type": "TextBlock", "text": tags, "isSubtle": true, "wrap": true})
+	}
+	card := map[string]any{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body":    body,
+	}
+	if m.Link != "" {
+		card["actions"] = []any{map[string]any{"type": "Action.OpenUrl", "title": "View memo", "url": m.Link}}
+	}
+	return map[string]any{
+		"type": "message",
+		"attachments": []any{map[string]any{
+			"contentType": "application/vnd.microsoft.card.adaptive",
+			"content":     card,
+		}},
+	}
+}
+
+func buildTelegramBody(m *message, chatID string) map[string]any {
+	lines := []string{fmt.Sprintf("<b>%s</b> by %s", html.EscapeString(m.Title), html.EscapeString(m.Author))}
+	if m.Snippet != "" {
+		lines = append(lines, html.EscapeString(m.Snippet))
+	}
+	if tags := m.hashtags(); tags != "" {
+		lines = append(lines, html.EscapeString(tags))
+	}
+	if m.Link != "" {
+		lines = append(lines, fmt.Sprintf(`<a href="%s">View memo</a>`, html.EscapeString(m.Link)))
+	}
+	return map[string]any{
+		"chat_id":                  chatID,
+		"text":                     strings.Join(lines, "\n"),
+		"parse_mode":               "HTML",
+		"disable_web_page_preview": true,
+	}
+}
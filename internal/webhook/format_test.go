@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func newFormatTestPayload(format Format, url string) *WebhookRequestPayload {
+	return &WebhookRequestPayload{
+		URL:          url,
+		ActivityType: EventMemoCreated,
+		Creator:      "users/alice",
+		Memo: &v1pb.Memo{
+			Name:    "memos/abc",
+			Content: "Deploying <v2> & friends #deploy",
+			Snippet: "Deploying <v2> & friends",
+			Tags:    []string{"deploy"},
+		},
+		Format:      format,
+		InstanceURL: "https://memos.example.com/",
+	}
+}
+
+func unmarshalBody(t *testing.T, payload *WebhookRequestPayload) map[string]any {
+	t.Helper()
+	delivery, err := NewDelivery(payload)
+	require.NoError(t, err)
+	body := map[string]any{}
+	require.NoError(t, json.Unmarshal(delivery.Body, &body))
+	return body
+}
+
+func TestSlackFormat(t *testing.T) {
+	body := unmarshalBody(t, newFormatTestPayload(FormatSlack, "https://hooks.slack.com/services/x"))
+	require.Equal(t,
+		"*New memo* by alice\nDeploying &lt;v2&gt; &amp; friends\n#deploy\n<https://memos.example.com/memos/abc|View memo>",
+		body["text"])
+}
+
+func TestDiscordFormat(t *testing.T) {
+	body := unmarshalBody(t, newFormatTestPayload(FormatDiscord, "https://discord.com/api/webhooks/x"))
+	embeds := body["embeds"].([]any)
+	require.Len(t, embeds, 1)
+	embed := embeds[0].(map[string]any)
+	require.Equal(t, "New memo", embed["title"])
+	require.Equal(t, "Deploying <v2> & friends", embed["description"])
+	require.Equal(t, "https://memos.example.com/memos/abc", embed["url"])
+	require.Equal(t, "alice", embed["author"].(map[string]any)["name"])
+	require.Equal(t, "#deploy", embed["footer"].(map[string]any)["text"])
+}
+
+func TestTeamsFormat(t *testing.T) {
+	body := unmarshalBody(t, newFormatTestPayload(FormatTeams, "https://example.webhook.office.com/x"))
+	require.Equal(t, "message", body["type"])
+	attachment := body["attachments"].([]any)[0].(map[string]any)
+	require.Equal(t, "application/vnd.microsoft.card.adaptive", attachment["contentType"])
+	card := attachment["content"].(map[string]any)
+	require.Equal(t, "AdaptiveCard", card["type"])
+	require.Len(t, card["body"], 4)
+	action := card["actions"].([]any)[0].(map[string]any)
+	require.Equal(t, "https://memos.example.com/memos/abc", action["url"])
+}
+
+func TestTelegramFormat(t *testing.T) {
+	url := "https://api.telegram.org/bot123:token/sendMessage?chat_id=-10042"
+	require.NoError(t, ValidateFormat(FormatTelegram, url))
+	require.Error(t, ValidateFormat(FormatTelegram, "https://api.telegram.org/bot123:token/sendMessage"))
+
+	body := unmarshalBody(t, newFormatTestPayload(FormatTelegram, url))
+	require.Equal(t, "-10042", body["chat_id"])
+	require.Equal(t, "HTML", body["parse_mode"])
+	require.Equal(t,
+		"<b>New memo</b> by alice\nDeploying &lt;v2&gt; &amp; friends\n#deploy\n<a href=\"https://memos.example.com/memos/abc\">View memo</a>",
+		body["text"])
+}
+
+func TestChatFormatWithoutInstanceURL(t *testing.T) {
+	payload := newFormatTestPayload(FormatDiscord, "https://discord.com/api/webhooks/x")
+	payload.InstanceURL = ""
+	embed := unmarshalBody(t, payload)["embeds"].([]any)[0].(map[string]any)
+	require.NotContains(t, embed, "url")
+}
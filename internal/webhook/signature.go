@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Deliveries to a webhook with a secret are signed, so that receivers can verify that
+// a request was sent by this instance and was not replayed:
+//
+//	X-Memos-Webhook-Id:        unique delivery ID, use it to drop duplicate deliveries
+//	X-Memos-Webhook-Timestamp: unix time in seconds at which the delivery was signed
+//	X-Memos-Webhook-Signature: sha256=<hex HMAC-SHA256 of "{timestamp}.{body}" keyed with the secret>
+//
+// To verify a delivery, compute the HMAC over the timestamp header, a period and the raw
+// request body, compare it to the signature header in constant time, and reject requests
+// whose timestamp is too far from the current time.
+const (
+	DeliveryIDHeader = "X-Memos-Webhook-Id"
+	TimestampHeader  = "X-Memos-Webhook-Timestamp"
+	SignatureHeader  = "X-Memos-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+	secretPrefix    = "whsec_"
+)
+
+// GenerateSecret returns a new random webhook secret.
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate webhook secret")
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
+
+// Sign returns the signature header value of a delivery body sent at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature and timestamp headers of a delivery body. Deliveries
+// signed more than tolerance away from now are rejected.
+func Verify(secret, timestampHeader, signatureHeader string, body []byte, tolerance time.Duration, now time.Time) error {
+	timestamp, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid webhook timestamp %q", timestampHeader)
+	}
+	if diff := now.Sub(time.Unix(timestamp, 0)); diff > tolerance || diff < -tolerance {
+		return errors.New("webhook timestamp is outside the tolerance")
+	}
+	if !strings.HasPrefix(signatureHeader, signaturePrefix) {
+		return errors.New("unsupported webhook signature scheme")
+	}
+	if !hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signatureHeader)) {
+		return errors.New("webhook signature mismatch")
+	}
+	return nil
+}
@@ -5,11 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"io"
-	"log/slog"
 	"net"
 	"net/http"
+	"strconv"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
@@ -28,25 +29,8 @@ var (
 			DialContext: safeDialContext,
 		},
 	}
-
-	asyncPostQueue = make(chan *WebhookRequestPayload, 128)
 )
 
-func init() {
-	for range 4 {
-		go func() {
-			for payload := range asyncPostQueue {
-				if err := Post(payload); err != nil {
-					slog.Warn("Failed to dispatch webhook asynchronously",
-						slog.String("url", payload.URL),
-						slog.String("activityType", payload.ActivityType),
-						slog.Any("err", err))
-				}
-			}
-		}()
-	}
-}
-
 // safeDialContext is a net.Dialer.DialContext replacement that resolves the target
 // hostname and rejects any address that falls within a reserved/private IP range.
 func safeDialContext(ctx context.Context, network, addr string) (net.Conn, error) {
@@ -69,6 +53,12 @@ func safeDialContext(ctx context.Context, network, addr string) (net.Conn, error
 	return (&net.Dialer{}).DialContext(ctx, network, net.JoinHostPort(host, port))
 }
 
+// SafeClient returns the SSRF-guarded HTTP client used for webhook dispatches, for
+// other outbound requests to user-provided URLs.
+func SafeClient() *http.Client {
+	return safeClient
+}
+
 type WebhookRequestPayload struct {
 	// The target URL for the webhook request.
 	URL string `json:"url"`
@@ -78,34 +68,88 @@ type WebhookRequestPayload struct {
 	Creator string `json:"creator"`
 	// The memo that triggered this webhook (if applicable).
 	Memo *v1pb.Memo `json:"memo"`
+	// The reaction that triggered this webhook (if applicable).
+	Reaction *v1pb.Reaction `json:"reaction,omitempty"`
+	// The user that triggered this webhook (if applicable).
+	User *v1pb.User `json:"user,omitempty"`
+	// The secret used to sign the delivery. It is never sent.
+	Secret string `json:"-"`
+	// The payload format of the delivery.
+	Format Format `json:"-"`
+	// The instance URL used to link to the memo in chat formats.
+	InstanceURL string `json:"-"`
+}
+
+// responseSnippetLimit is the number of response body bytes kept in a delivery result.
+const responseSnippetLimit = 1024
+
+// Delivery is a request to send to a webhook endpoint.
+type Delivery struct {
+	// ID identifies the delivery to the receiver, and is kept across retries.
+	ID     string
+	URL    string
+	Secret string
+	// Format is the payload format of the body, which decides how the response is checked.
+	Format Format
+	// Body is the JSON request body.
+	Body []byte
+}
+
+// Result is the response of a webhook endpoint to a delivery.
+type Result struct {
+	StatusCode int
+	// Snippet is the beginning of the response body.
+	Snippet string
 }
 
-// Post posts the message to webhook endpoint.
-func Post(requestPayload *WebhookRequestPayload) error {
-	body, err := json.Marshal(requestPayload)
+// Sender sends a delivery to its webhook endpoint.
+type Sender func(ctx context.Context, delivery *Delivery) (*Result, error)
+
+// NewDelivery renders a payload in its format into a delivery with a new delivery ID.
+func NewDelivery(requestPayload *WebhookRequestPayload) (*Delivery, error) {
+	body, err := marshalBody(requestPayload)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook request to %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to marshal webhook request to %s", requestPayload.URL)
 	}
+	return &Delivery{
+		ID:     uuid.NewString(),
+		URL:    requestPayload.URL,
+		Secret: requestPayload.Secret,
+		Format: requestPayload.Format,
+		Body:   body,
+	}, nil
+}
 
-	req, err := http.NewRequest("POST", requestPayload.URL, bytes.NewBuffer(body))
+// Deliver posts a delivery to its webhook endpoint. The endpoint must answer with a 2xx
+// status code; for the memos format, a JSON body with a non-zero code is reported as an
+// error too, while chat services answer in their own shapes. The result
+// is returned whenever the endpoint responded, even with an error.
+func Deliver(ctx context.Context, delivery *Delivery) (*Result, error) {
+	req, err := newRequest(ctx, delivery, time.Now())
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook request to %s", requestPayload.URL)
+		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
 	resp, err := safeClient.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to post webhook to %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to post webhook to %s", delivery.URL)
 	}
 	defer resp.Body.Close()
 
 	b, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
 	if err != nil {
-		return errors.Wrapf(err, "failed to read webhook response from %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to read webhook response from %s", delivery.URL)
+	}
+	result := &Result{
+		StatusCode: resp.StatusCode,
+		Snippet:    string(b[:min(len(b), responseSnippetLimit)]),
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return errors.Errorf("failed to post webhook %s, status code: %d", requestPayload.URL, resp.StatusCode)
+		return result, errors.Errorf("failed to post webhook %s, status code: %d", delivery.URL, resp.StatusCode)
+	}
+	if delivery.Format != FormatMemos {
+		return result, nil
 	}
 
 	response := &struct {
@@ -113,28 +157,29 @@ func Post(requestPayload *WebhookRequestPayload) error {
 		Message string `json:"message"`
 	}{}
 	if err := json.Unmarshal(b, response); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal webhook response from %s", requestPayload.URL)
+		return result, errors.Wrapf(err, "failed to unmarshal webhook response from %s", delivery.URL)
 	}
 
 	if response.Code != 0 {
-		return errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", response.Code, response.Message)
+		return result, errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", response.Code, response.Message)
 	}
 
-	return nil
+	return result, nil
 }
 
-// PostAsync posts the message to webhook endpoint asynchronously.
-// It enqueues the request for bounded asynchronous dispatch and does not wait for the response.
-func PostAsync(requestPayload *WebhookRequestPayload) {
-	if requestPayload == nil {
-		slog.Warn("Dropped webhook dispatch because payload is nil")
-		return
+// newRequest builds the request of a delivery, signed at now if the webhook has a secret.
+func newRequest(ctx context.Context, delivery *Delivery, now time.Time) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", delivery.URL, bytes.NewBuffer(delivery.Body))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to construct webhook request to %s", delivery.URL)
 	}
-	select {
-	case asyncPostQueue <- requestPayload:
-	default:
-		slog.Warn("Dropped webhook dispatch because the async queue is full",
-			slog.String("url", requestPayload.URL),
-			slog.String("activityType", requestPayload.ActivityType))
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryIDHeader, delivery.ID)
+	if delivery.Secret != "" {
+		timestamp := now.Unix()
+		req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(SignatureHeader, Sign(delivery.Secret, timestamp, delivery.Body))
 	}
+	return req, nil
 }
@@ -1,13 +1,131 @@
 package webhook
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
-func TestPostAsyncNilPayloadDoesNotPanic(t *testing.T) {
-	require.NotPanics(t, func() {
-		PostAsync(nil)
-	})
+func TestNewDelivery(t *testing.T) {
+	payload := &WebhookRequestPayload{
+		URL:          "https://example.com/hook",
+		ActivityType: "memos.memo.created",
+		Creator:      "users/alice",
+		Secret:       "whsec_test",
+	}
+
+	delivery, err := NewDelivery(payload)
+	require.NoError(t, err)
+	require.NotEmpty(t, delivery.ID)
+	require.Equal(t, "https://example.com/hook", delivery.URL)
+	require.Equal(t, "whsec_test", delivery.Secret)
+	require.Contains(t, string(delivery.Body), `"activityType":"memos.memo.created"`)
+	require.NotContains(t, string(delivery.Body), "whsec_test")
+
+	// Each delivery gets its own ID.
+	again, err := NewDelivery(payload)
+	require.NoError(t, err)
+	require.NotEqual(t, delivery.ID, again.ID)
+}
+
+func TestNewRequestSignsDelivery(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	delivery := &Delivery{
+		ID:     "delivery-1",
+		URL:    "https://example.com/hook",
+		Secret: "whsec_test",
+		Body:   []byte(`{"activityType":"memos.memo.created"}`),
+	}
+
+	req, err := newRequest(context.Background(), delivery, now)
+	require.NoError(t, err)
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	require.Equal(t, delivery.Body, body)
+
+	require.Equal(t, "delivery-1", req.Header.Get(DeliveryIDHeader))
+	require.Equal(t, "1800000000", req.Header.Get(TimestampHeader))
+	signature := req.Header.Get(SignatureHeader)
+	require.True(t, strings.HasPrefix(signature, "sha256="))
+
+	mac := hmac.New(sha256.New, []byte("whsec_test"))
+	mac.Write([]byte("1800000000." + string(body)))
+	require.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), signature)
+
+	require.NoError(t, Verify("whsec_test", req.Header.Get(TimestampHeader), signature, body, 5*time.Minute, now.Add(time.Minute)))
+	require.Error(t, Verify("other", req.Header.Get(TimestampHeader), signature, body, 5*time.Minute, now))
+	require.Error(t, Verify("whsec_test", req.Header.Get(TimestampHeader), signature, []byte("{}"), 5*time.Minute, now))
+	require.Error(t, Verify("whsec_test", req.Header.Get(TimestampHeader), signature, body, 5*time.Minute, now.Add(time.Hour)))
+}
+
+func TestNewRequestWithoutSecretIsUnsigned(t *testing.T) {
+	req, err := newRequest(context.Background(), &Delivery{ID: "delivery-1", URL: "https://example.com/hook"}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, "delivery-1", req.Header.Get(DeliveryIDHeader))
+	require.Empty(t, req.Header.Get(TimestampHeader))
+	require.Empty(t, req.Header.Get(SignatureHeader))
+}
+
+func TestDeliverReportsResponse(t *testing.T) {
+	var status int
+	var response string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	// The safe client refuses loopback addresses, so use the test server's client.
+	originalClient := safeClient
+	safeClient = server.Client()
+	defer func() { safeClient = originalClient }()
+
+	delivery := &Delivery{ID: "delivery-1", URL: server.URL, Body: []byte("{}")}
+
+	status, response = http.StatusOK, `{"code":0}`
+	result, err := Deliver(context.Background(), delivery)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, result.StatusCode)
+	require.Equal(t, `{"code":0}`, result.Snippet)
+
+	status, response = http.StatusInternalServerError, strings.Repeat("x", 2*responseSnippetLimit)
+	result, err = Deliver(context.Background(), delivery)
+	require.Error(t, err)
+	require.Equal(t, http.StatusInternalServerError, result.StatusCode)
+	require.Len(t, result.Snippet, responseSnippetLimit)
+
+	status, response = http.StatusOK, `{"code":1,"message":"rejected"}`
+	result, err = Deliver(context.Background(), delivery)
+	require.ErrorContains(t, err, "rejected")
+	require.Equal(t, http.StatusOK, result.StatusCode)
+}
+
+func TestDeliverChatFormatAcceptsAnySuccessfulResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	originalClient := safeClient
+	safeClient = server.Client()
+	defer func() { safeClient = originalClient }()
+
+	delivery := &Delivery{ID: "delivery-1", URL: server.URL, Format: FormatSlack, Body: []byte("{}")}
+	result, err := Deliver(context.Background(), delivery)
+	require.NoError(t, err)
+	require.Equal(t, "ok", result.Snippet)
+
+	// The memos format expects a JSON response.
+	delivery.Format = FormatMemos
+	_, err = Deliver(context.Background(), delivery)
+	require.Error(t, err)
 }
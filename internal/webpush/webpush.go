@@ -0,0 +1,253 @@
+// Package webpush sends Web Push messages: payloads are encrypted per RFC 8291
+// (aes128gcm content coding, RFC 8188) and requests are signed per RFC 8292 (VAPID).
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+const (
+	// recordSize is the aes128gcm record size. A push message is sent as a single record.
+	recordSize = 4096
+	// headerSize is the size of the aes128gcm header: salt, record size, key ID length and key ID.
+	headerSize = 16 + 4 + 1 + 65
+	// MaxPayloadSize is the largest payload that fits in a single record.
+	MaxPayloadSize = recordSize - headerSize - aes.BlockSize - 1
+
+	// defaultTTL is how long the push service keeps a message for an offline device.
+	defaultTTL = 24 * time.Hour
+	// vapidExpiration is the lifetime of a VAPID token; RFC 8292 caps it at 24 hours.
+	vapidExpiration = 12 * time.Hour
+)
+
+// ErrSubscriptionGone reports that the push service no longer accepts messages for a
+// subscription, and the subscription should be removed.
+var ErrSubscriptionGone = errors.New("webpush: subscription is no longer valid")
+
+// VAPIDKeys is the key pair an application server identifies itself with.
+// PublicKey is the uncompressed P-256 point and PrivateKey the scalar, both
+// base64url-encoded without padding.
+type VAPIDKeys struct {
+	PublicKey  string
+	PrivateKey string
+}
+
+// Subscription is a push subscription of a browser, as returned by PushManager.subscribe.
+type Subscription struct {
+	Endpoint string
+	// P256dh is the base64url-encoded ECDH public key of the browser.
+	P256dh string
+	// Auth is the base64url-encoded authentication secret of the browser.
+	Auth string
+}
+
+// GenerateVAPIDKeys generates a new VAPID key pair.
+func GenerateVAPIDKeys() (*VAPIDKeys, error) {
+	privateKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate VAPID key")
+	}
+	return &VAPIDKeys{
+		PublicKey:  base64.RawURLEncoding.EncodeToString(privateKey.PublicKey().Bytes()),
+		PrivateKey: base64.RawURLEncoding.EncodeToString(privateKey.Bytes()),
+	}, nil
+}
+
+// Send encrypts payload for the subscription and posts it to the push service.
+// It returns ErrSubscriptionGone when the push service reports the subscription expired.
+func Send(ctx context.Context, client *http.Client, subscription *Subscription, payload []byte, keys *VAPIDKeys, subject string) error {
+	request, err := NewRequest(ctx, subscription, payload, keys, subject, time.Now())
+	if err != nil {
+		return err
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "failed to send push message")
+	}
+	defer response.Body.Close()
+	// Drain a bounded amount of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 1<<16))
+
+	switch {
+	case response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusGone:
+		return ErrSubscriptionGone
+	case response.StatusCode < 200 || response.StatusCode > 299:
+		return errors.Errorf("push service responded with status %d", response.StatusCode)
+	default:
+		return nil
+	}
+}
+
+// NewRequest builds the encrypted and VAPID-signed push request for a subscription.
+func NewRequest(ctx context.Context, subscription *Subscription, payload []byte, keys *VAPIDKeys, subject string, now time.Time) (*http.Request, error) {
+	endpoint, err := url.Parse(subscription.Endpoint)
+	if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+		return nil, errors.Errorf("invalid push endpoint %q", subscription.Endpoint)
+	}
+	body, err := Encrypt(subscription, payload)
+	if err != nil {
+		return nil, err
+	}
+	authorization, err := vapidAuthorization(keys, fmt.Sprintf("%s://%s", endpoint.Scheme, endpoint.Host), subject, now)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create push request")
+	}
+	request.Header.Set("Content-Type", "application/octet-stream")
+	request.Header.Set("Content-Encoding", "aes128gcm")
+	request.Header.Set("TTL", strconv.Itoa(int(defaultTTL.Seconds())))
+	request.Header.Set("Authorization", authorization)
+	return request, nil
+}
+
+// Encrypt encrypts plaintext for the subscription per RFC 8291, returning the aes128gcm body.
+func Encrypt(subscription *Subscription, plaintext []byte) ([]byte, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "failed to generate salt")
+	}
+	serverKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate ephemeral key")
+	}
+	return encrypt(subscription, plaintext, salt, serverKey)
+}
+
+func encrypt(subscription *Subscription, plaintext, salt []byte, serverKey *ecdh.PrivateKey) ([]byte, error) {
+	if len(plaintext) > MaxPayloadSize {
+		return nil, errors.Errorf("push payload of %d bytes exceeds %d bytes", len(plaintext), MaxPayloadSize)
+	}
+	userAgentPublicBytes, err := decodeKey(subscription.P256dh)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid p256dh key")
+	}
+	authSecret, err := decodeKey(subscription.Auth)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid auth secret")
+	}
+	if len(authSecret) != 16 {
+		return nil, errors.Errorf("auth secret must be 16 bytes, got %d", len(authSecret))
+	}
+	userAgentPublicKey, err := ecdh.P256().NewPublicKey(userAgentPublicBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid p256dh key")
+	}
+	ecdhSecret, err := serverKey.ECDH(userAgentPublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute shared secret")
+	}
+	serverPublicBytes := serverKey.PublicKey().Bytes()
+
+	// key_info = "WebPush: info" || 0x00 || ua_public || as_public
+	keyInfo := "WebPush: info\x00" + string(userAgentPublicBytes) + string(serverPublicBytes)
+	ikm, err := hkdf.Key(sha256.New, ecdhSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	contentKey, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(contentKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, headerSize)
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, recordSize)
+	header = append(header, byte(len(serverPublicBytes)))
+	header = append(header, serverPublicBytes...)
+	// The single record is the last one, so it ends with the 0x02 padding delimiter.
+	record := append(append(make([]byte, 0, len(plaintext)+1), plaintext...), 0x02)
+	return gcm.Seal(header, nonce, record, nil), nil
+}
+
+// ValidateSubject checks that a VAPID subject is a mailto: or https: URI, as RFC 8292 requires
+// so push services can contact the application server operator.
+func ValidateSubject(subject string) error {
+	u, err := url.Parse(subject)
+	if err != nil {
+		return errors.Errorf("invalid VAPID subject %q", subject)
+	}
+	switch {
+	case u.Scheme == "mailto" && u.Opaque != "":
+		return nil
+	case u.Scheme == "https" && u.Host != "":
+		return nil
+	default:
+		return errors.Errorf("VAPID subject %q must be a mailto: or https: URI", subject)
+	}
+}
+
+// vapidAuthorization returns the Authorization header value for a push service origin.
+func vapidAuthorization(keys *VAPIDKeys, audience, subject string, now time.Time) (string, error) {
+	if keys == nil {
+		return "", errors.New("VAPID keys are required")
+	}
+	if err := ValidateSubject(subject); err != nil {
+		return "", err
+	}
+	privateBytes, err := decodeKey(keys.PrivateKey)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid VAPID private key")
+	}
+	privateKey, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), privateBytes)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid VAPID private key")
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"aud": audience,
+		"exp": now.Add(vapidExpiration).Unix(),
+		"sub": subject,
+	}).SignedString(privateKey)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign VAPID token")
+	}
+	return fmt.Sprintf("vapid t=%s, k=%s", token, keys.PublicKey), nil
+}
+
+// decodeKey decodes a base64url key, with or without padding as browsers differ.
+func decodeKey(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(trimPadding(value))
+}
+
+func trimPadding(value string) string {
+	for len(value) > 0 && value[len(value)-1] == '=' {
+		value = value[:len(value)-1]
+	}
+	return value
+}
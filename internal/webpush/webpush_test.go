@@ -0,0 +1,200 @@
+package webpush
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// testUserAgent is the browser side of a push subscription.
+type testUserAgent struct {
+	privateKey *ecdh.PrivateKey
+	authSecret []byte
+}
+
+func newTestUserAgent(t *testing.T) *testUserAgent {
+	t.Helper()
+	privateKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	authSecret := make([]byte, 16)
+	_, err = rand.Read(authSecret)
+	require.NoError(t, err)
+	return &testUserAgent{privateKey: privateKey, authSecret: authSecret}
+}
+
+func (ua *testUserAgent) subscription(endpoint string) *Subscription {
+	return &Subscription{
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(ua.privateKey.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(ua.authSecret),
+	}
+}
+
+// decrypt decrypts an aes128gcm push message as the browser would.
+func (ua *testUserAgent) decrypt(t *testing.T, body []byte) []byte {
+	t.Helper()
+	require.Greater(t, len(body), headerSize)
+	salt := body[:16]
+	require.Equal(t, uint32(recordSize), binary.BigEndian.Uint32(body[16:20]))
+	keyIDLength := int(body[20])
+	serverPublicBytes := body[21 : 21+keyIDLength]
+	ciphertext := body[21+keyIDLength:]
+
+	serverPublicKey, err := ecdh.P256().NewPublicKey(serverPublicBytes)
+	require.NoError(t, err)
+	ecdhSecret, err := ua.privateKey.ECDH(serverPublicKey)
+	require.NoError(t, err)
+	keyInfo := "WebPush: info\x00" + string(ua.privateKey.PublicKey().Bytes()) + string(serverPublicBytes)
+	ikm, err := hkdf.Key(sha256.New, ecdhSecret, ua.authSecret, keyInfo, 32)
+	require.NoError(t, err)
+	contentKey, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: aes128gcm\x00", 16)
+	require.NoError(t, err)
+	nonce, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: nonce\x00", 12)
+	require.NoError(t, err)
+
+	block, err := aes.NewCipher(contentKey)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	record, err := gcm.Open(nil, nonce, ciphertext, nil)
+	require.NoError(t, err)
+	require.Equal(t, byte(0x02), record[len(record)-1])
+	return record[:len(record)-1]
+}
+
+func TestSendEncryptsAndSignsPushMessage(t *testing.T) {
+	keys, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	ua := newTestUserAgent(t)
+
+	var received []byte
+	var authorization string
+	var headers http.Header
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = io.ReadAll(r.Body)
+		authorization = r.Header.Get("Authorization")
+		headers = r.Header.Clone()
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	payload := []byte(`{"title":"New comment"}`)
+	err = Send(context.Background(), server.Client(), ua.subscription(server.URL+"/push/abc"), payload, keys, "https://memos.example.com")
+	require.NoError(t, err)
+
+	require.Equal(t, "aes128gcm", headers.Get("Content-Encoding"))
+	require.NotEmpty(t, headers.Get("TTL"))
+	require.Equal(t, payload, ua.decrypt(t, received))
+
+	// The VAPID token is signed by the instance key for the push service origin.
+	require.True(t, strings.HasPrefix(authorization, "vapid t="))
+	parts := strings.SplitN(strings.TrimPrefix(authorization, "vapid t="), ", k=", 2)
+	require.Len(t, parts, 2)
+	require.Equal(t, keys.PublicKey, parts[1])
+	publicBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	publicKey, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), publicBytes)
+	require.NoError(t, err)
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(parts[0], claims, func(*jwt.Token) (any, error) { return publicKey, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}))
+	require.NoError(t, err)
+	require.Equal(t, server.URL, claims["aud"])
+	require.Equal(t, "https://memos.example.com", claims["sub"])
+	expiration, err := claims.GetExpirationTime()
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(vapidExpiration), expiration.Time, time.Minute)
+}
+
+func TestSendReportsGoneSubscription(t *testing.T) {
+	keys, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	ua := newTestUserAgent(t)
+
+	for _, code := range []int{http.StatusNotFound, http.StatusGone} {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(code)
+		}))
+		err = Send(context.Background(), server.Client(), ua.subscription(server.URL), []byte("hi"), keys, "https://memos.example.com")
+		require.ErrorIs(t, err, ErrSubscriptionGone)
+		server.Close()
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	err = Send(context.Background(), server.Client(), ua.subscription(server.URL), []byte("hi"), keys, "https://memos.example.com")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrSubscriptionGone)
+}
+
+func TestNewRequestRejectsInvalidSubscriptions(t *testing.T) {
+	keys, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	ua := newTestUserAgent(t)
+
+	_, err = NewRequest(context.Background(), ua.subscription("http://push.example.com/abc"), []byte("hi"), keys, "", time.Now())
+	require.Error(t, err)
+
+	subscription := ua.subscription("https://push.example.com/abc")
+	subscription.Auth = "short"
+	_, err = NewRequest(context.Background(), subscription, []byte("hi"), keys, "", time.Now())
+	require.Error(t, err)
+
+	_, err = NewRequest(context.Background(), ua.subscription("https://push.example.com/abc"), make([]byte, MaxPayloadSize+1), keys, "", time.Now())
+	require.Error(t, err)
+}
+
+func TestNewRequestRejectsInvalidSubjects(t *testing.T) {
+	keys, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	subscription := newTestUserAgent(t).subscription("https://push.example.com/abc")
+
+	for _, subject := range []string{"mailto:admin@example.com", "https://memos.example.com"} {
+		_, err = NewRequest(context.Background(), subscription, []byte("hi"), keys, subject, time.Now())
+		require.NoError(t, err, subject)
+	}
+	for _, subject := range []string{"", "http://localhost:8080", "mailto:", "memos.example.com"} {
+		_, err = NewRequest(context.Background(), subscription, []byte("hi"), keys, subject, time.Now())
+		require.Error(t, err, subject)
+	}
+}
+
+// TestEncryptMatchesRFC8291Example checks the encryption against the example in RFC 8291, Appendix A.
+func TestEncryptMatchesRFC8291Example(t *testing.T) {
+	decode := func(value string) []byte {
+		decoded, err := base64.RawURLEncoding.DecodeString(value)
+		require.NoError(t, err)
+		return decoded
+	}
+	serverKey, err := ecdh.P256().NewPrivateKey(decode("yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"))
+	require.NoError(t, err)
+	subscription := &Subscription{
+		Endpoint: "https://push.example.net/push/JzLQ3raZJfFBR0aqvOMsLrt54w4rJUsV",
+		P256dh:   "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+		Auth:     "BTBZMqHH6r4Tts7J_aSIgg",
+	}
+
+	body, err := encrypt(subscription, []byte("When I grow up, I want to be a watermelon"), decode("DGv6ra1nlYgDCS1FRnbzlw"), serverKey)
+	require.NoError(t, err)
+	require.Equal(t, "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN",
+		base64.RawURLEncoding.EncodeToString(body))
+}
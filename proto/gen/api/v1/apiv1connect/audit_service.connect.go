@@ -0,0 +1,117 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/audit_service.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/usememos/memos/proto/gen/api/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuditServiceName is the fully-qualified name of the AuditService service.
+	AuditServiceName = "memos.api.v1.AuditService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuditServiceListAuditLogsProcedure is the fully-qualified name of the AuditService's
+	// ListAuditLogs RPC.
+	AuditServiceListAuditLogsProcedure = "/memos.api.v1.AuditService/ListAuditLogs"
+)
+
+// AuditServiceClient is a client for the memos.api.v1.AuditService service.
+type AuditServiceClient interface {
+	// ListAuditLogs lists audit log entries, newest first.
+	// Matching entries can also be downloaded as JSON Lines from
+	// GET /api/v1/auditLogs/export, which accepts the same filters as query parameters.
+	// Only admins can list audit logs.
+	ListAuditLogs(context.Context, *connect.Request[v1.ListAuditLogsRequest]) (*connect.Response[v1.ListAuditLogsResponse], error)
+}
+
+// NewAuditServiceClient constructs a client for the memos.api.v1.AuditService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuditServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuditServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	auditServiceMethods := v1.File_api_v1_audit_service_proto.Services().ByName("AuditService").Methods()
+	return &auditServiceClient{
+		listAuditLogs: connect.NewClient[v1.ListAuditLogsRequest, v1.ListAuditLogsResponse](
+			httpClient,
+			baseURL+AuditServiceListAuditLogsProcedure,
+			connect.WithSchema(auditServiceMethods.ByName("ListAuditLogs")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// auditServiceClient implements AuditServiceClient.
+type auditServiceClient struct {
+	listAuditLogs *connect.Client[v1.ListAuditLogsRequest, v1.ListAuditLogsResponse]
+}
+
+// ListAuditLogs calls memos.api.v1.AuditService.ListAuditLogs.
+func (c *auditServiceClient) ListAuditLogs(ctx context.Context, req *connect.Request[v1.ListAuditLogsRequest]) (*connect.Response[v1.ListAuditLogsResponse], error) {
+	return c.listAuditLogs.CallUnary(ctx, req)
+}
+
+// AuditServiceHandler is an implementation of the memos.api.v1.AuditService service.
+type AuditServiceHandler interface {
+	// ListAuditLogs lists audit log entries, newest first.
+	// Matching entries can also be downloaded as JSON Lines from
+	// GET /api/v1/auditLogs/export, which accepts the same filters as query parameters.
+	// Only admins can list audit logs.
+	ListAuditLogs(context.Context, *connect.Request[v1.ListAuditLogsRequest]) (*connect.Response[v1.ListAuditLogsResponse], error)
+}
+
+// NewAuditServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuditServiceHandler(svc AuditServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	auditServiceMethods := v1.File_api_v1_audit_service_proto.Services().ByName("AuditService").Methods()
+	auditServiceListAuditLogsHandler := connect.NewUnaryHandler(
+		AuditServiceListAuditLogsProcedure,
+		svc.ListAuditLogs,
+		connect.WithSchema(auditServiceMethods.ByName("ListAuditLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/memos.api.v1.AuditService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuditServiceListAuditLogsProcedure:
+			auditServiceListAuditLogsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuditServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuditServiceHandler struct{}
+
+func (UnimplementedAuditServiceHandler) ListAuditLogs(context.Context, *connect.Request[v1.ListAuditLogsRequest]) (*connect.Response[v1.ListAuditLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AuditService.ListAuditLogs is not implemented"))
+}
@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/invitation_service.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/usememos/memos/proto/gen/api/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// InvitationServiceName is the fully-qualified name of the InvitationService service.
+	InvitationServiceName = "memos.api.v1.InvitationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// InvitationServiceListInvitationsProcedure is the fully-qualified name of the InvitationService's
+	// ListInvitations RPC.
+	InvitationServiceListInvitationsProcedure = "/memos.api.v1.InvitationService/ListInvitations"
+	// InvitationServiceCreateInvitationProcedure is the fully-qualified name of the InvitationService's
+	// CreateInvitation RPC.
+	InvitationServiceCreateInvitationProcedure = "/memos.api.v1.InvitationService/CreateInvitation"
+	// InvitationServiceRevokeInvitationProcedure is the fully-qualified name of the InvitationService's
+	// RevokeInvitation RPC.
+	InvitationServiceRevokeInvitationProcedure = "/memos.api.v1.InvitationService/RevokeInvitation"
+)
+
+// InvitationServiceClient is a client for the memos.api.v1.InvitationService service.
+type InvitationServiceClient interface {
+	// ListInvitations returns all invitations of the instance.
+	// Requires the MANAGE_USERS permission.
+	ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error)
+	// CreateInvitation creates an invitation that allows registering an account
+	// while user registration is disabled.
+	// Requires the MANAGE_USERS permission; only admins can invite admins.
+	CreateInvitation(context.Context, *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.Invitation], error)
+	// RevokeInvitation deletes an invitation so it can no longer be used.
+	// Requires the MANAGE_USERS permission.
+	RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewInvitationServiceClient constructs a client for the memos.api.v1.InvitationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewInvitationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) InvitationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	invitationServiceMethods := v1.File_api_v1_invitation_service_proto.Services().ByName("InvitationService").Methods()
+	return &invitationServiceClient{
+		listInvitations: connect.NewClient[v1.ListInvitationsRequest, v1.ListInvitationsResponse](
+			httpClient,
+			baseURL+InvitationServiceListInvitationsProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("ListInvitations")),
+			connect.WithClientOptions(opts...),
+		),
+		createInvitation: connect.NewClient[v1.CreateInvitationRequest, v1.Invitation](
+			httpClient,
+			baseURL+InvitationServiceCreateInvitationProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("CreateInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeInvitation: connect.NewClient[v1.RevokeInvitationRequest, emptypb.Empty](
+			httpClient,
+			baseURL+InvitationServiceRevokeInvitationProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("RevokeInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// invitationServiceClient implements InvitationServiceClient.
+type invitationServiceClient struct {
+	listInvitations  *connect.Client[v1.ListInvitationsRequest, v1.ListInvitationsResponse]
+	createInvitation *connect.Client[v1.CreateInvitationRequest, v1.Invitation]
+	revokeInvitation *connect.Client[v1.RevokeInvitationRequest, emptypb.Empty]
+}
+
+// ListInvitations calls memos.api.v1.InvitationService.ListInvitations.
+func (c *invitationServiceClient) ListInvitations(ctx context.Context, req *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error) {
+	return c.listInvitations.CallUnary(ctx, req)
+}
+
+// CreateInvitation calls memos.api.v1.InvitationService.CreateInvitation.
+func (c *invitationServiceClient) CreateInvitation(ctx context.Context, req *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.Invitation], error) {
+	return c.createInvitation.CallUnary(ctx, req)
+}
+
+// RevokeInvitation calls memos.api.v1.InvitationService.RevokeInvitation.
+func (c *invitationServiceClient) RevokeInvitation(ctx context.Context, req *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.revokeInvitation.CallUnary(ctx, req)
+}
+
+// InvitationServiceHandler is an implementation of the memos.api.v1.InvitationService service.
+type InvitationServiceHandler interface {
+	// ListInvitations returns all invitations of the instance.
+	// Requires the MANAGE_USERS permission.
+	ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error)
+	// CreateInvitation creates an invitation that allows registering an account
+	// while user registration is disabled.
+	// Requires the MANAGE_USERS permission; only admins can invite admins.
+	CreateInvitation(context.Context, *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.Invitation], error)
+	// RevokeInvitation deletes an invitation so it can no longer be used.
+	// Requires the MANAGE_USERS permission.
+	RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewInvitationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewInvitationServiceHandler(svc InvitationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	invitationServiceMethods := v1.File_api_v1_invitation_service_proto.Services().ByName("InvitationService").Methods()
+	invitationServiceListInvitationsHandler := connect.NewUnaryHandler(
+		InvitationServiceListInvitationsProcedure,
+		svc.ListInvitations,
+		connect.WithSchema(invitationServiceMethods.ByName("ListInvitations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceCreateInvitationHandler := connect.NewUnaryHandler(
+		InvitationServiceCreateInvitationProcedure,
+		svc.CreateInvitation,
+		connect.WithSchema(invitationServiceMethods.ByName("CreateInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceRevokeInvitationHandler := connect.NewUnaryHandler(
+		InvitationServiceRevokeInvitationProcedure,
+		svc.RevokeInvitation,
+		connect.WithSchema(invitationServiceMethods.ByName("RevokeInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/memos.api.v1.InvitationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case InvitationServiceListInvitationsProcedure:
+			invitationServiceListInvitationsHandler.ServeHTTP(w, r)
+		case InvitationServiceCreateInvitationProcedure:
+			invitationServiceCreateInvitationHandler.ServeHTTP(w, r)
+		case InvitationServiceRevokeInvitationProcedure:
+			invitationServiceRevokeInvitationHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedInvitationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedInvitationServiceHandler struct{}
+
+func (UnimplementedInvitationServiceHandler) ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InvitationService.ListInvitations is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) CreateInvitation(context.Context, *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.Invitation], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InvitationService.CreateInvitation is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InvitationService.RevokeInvitation is not implemented"))
+}
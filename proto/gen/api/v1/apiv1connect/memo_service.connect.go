@@ -71,6 +71,15 @@ const (
 	// MemoServiceDeleteMemoReactionProcedure is the fully-qualified name of the MemoService's
 	// DeleteMemoReaction RPC.
 	MemoServiceDeleteMemoReactionProcedure = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	// MemoServiceListMemoCollaboratorsProcedure is the fully-qualified name of the MemoService's
+	// ListMemoCollaborators RPC.
+	MemoServiceListMemoCollaboratorsProcedure = "/memos.api.v1.MemoService/ListMemoCollaborators"
+	// MemoServiceAddMemoCollaboratorProcedure is the fully-qualified name of the MemoService's
+	// AddMemoCollaborator RPC.
+	MemoServiceAddMemoCollaboratorProcedure = "/memos.api.v1.MemoService/AddMemoCollaborator"
+	// MemoServiceRemoveMemoCollaboratorProcedure is the fully-qualified name of the MemoService's
+	// RemoveMemoCollaborator RPC.
+	MemoServiceRemoveMemoCollaboratorProcedure = "/memos.api.v1.MemoService/RemoveMemoCollaborator"
 	// MemoServiceCreateMemoShareProcedure is the fully-qualified name of the MemoService's
 	// CreateMemoShare RPC.
 	MemoServiceCreateMemoShareProcedure = "/memos.api.v1.MemoService/CreateMemoShare"
@@ -83,6 +92,18 @@ const (
 	// MemoServiceGetMemoByShareProcedure is the fully-qualified name of the MemoService's
 	// GetMemoByShare RPC.
 	MemoServiceGetMemoByShareProcedure = "/memos.api.v1.MemoService/GetMemoByShare"
+	// MemoServiceCreateCollectionShareProcedure is the fully-qualified name of the MemoService's
+	// CreateCollectionShare RPC.
+	MemoServiceCreateCollectionShareProcedure = "/memos.api.v1.MemoService/CreateCollectionShare"
+	// MemoServiceListCollectionSharesProcedure is the fully-qualified name of the MemoService's
+	// ListCollectionShares RPC.
+	MemoServiceListCollectionSharesProcedure = "/memos.api.v1.MemoService/ListCollectionShares"
+	// MemoServiceDeleteCollectionShareProcedure is the fully-qualified name of the MemoService's
+	// DeleteCollectionShare RPC.
+	MemoServiceDeleteCollectionShareProcedure = "/memos.api.v1.MemoService/DeleteCollectionShare"
+	// MemoServiceListMemosByShareProcedure is the fully-qualified name of the MemoService's
+	// ListMemosByShare RPC.
+	MemoServiceListMemosByShareProcedure = "/memos.api.v1.MemoService/ListMemosByShare"
 	// MemoServiceGetLinkMetadataProcedure is the fully-qualified name of the MemoService's
 	// GetLinkMetadata RPC.
 	MemoServiceGetLinkMetadataProcedure = "/memos.api.v1.MemoService/GetLinkMetadata"
@@ -121,6 +142,14 @@ type MemoServiceClient interface {
 	UpsertMemoReaction(context.Context, *connect.Request[v1.UpsertMemoReactionRequest]) (*connect.Response[v1.Reaction], error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *connect.Request[v1.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListMemoCollaborators lists the collaborators of a memo.
+	ListMemoCollaborators(context.Context, *connect.Request[v1.ListMemoCollaboratorsRequest]) (*connect.Response[v1.ListMemoCollaboratorsResponse], error)
+	// AddMemoCollaborator grants a user viewer or editor rights on a memo.
+	// Adding an existing collaborator changes their role. Requires authentication as the memo creator.
+	AddMemoCollaborator(context.Context, *connect.Request[v1.AddMemoCollaboratorRequest]) (*connect.Response[v1.MemoCollaborator], error)
+	// RemoveMemoCollaborator revokes a collaborator's access to a memo.
+	// Requires authentication as the memo creator, or as the collaborator being removed.
+	RemoveMemoCollaborator(context.Context, *connect.Request[v1.RemoveMemoCollaboratorRequest]) (*connect.Response[emptypb.Empty], error)
 	// CreateMemoShare creates a share link for a memo. Requires authentication as the memo creator.
 	CreateMemoShare(context.Context, *connect.Request[v1.CreateMemoShareRequest]) (*connect.Response[v1.MemoShare], error)
 	// ListMemoShares lists all share links for a memo. Requires authentication as the memo creator.
@@ -128,8 +157,20 @@ type MemoServiceClient interface {
 	// DeleteMemoShare revokes a share link. Requires authentication as the memo creator.
 	DeleteMemoShare(context.Context, *connect.Request[v1.DeleteMemoShareRequest]) (*connect.Response[emptypb.Empty], error)
 	// GetMemoByShare resolves a share token to its memo. No authentication required.
-	// Returns NOT_FOUND if the token is invalid or expired.
+	// Returns NOT_FOUND if the token is invalid, expired or has used up its views,
+	// and PERMISSION_DENIED if the share password is missing or wrong.
 	GetMemoByShare(context.Context, *connect.Request[v1.GetMemoByShareRequest]) (*connect.Response[v1.Memo], error)
+	// CreateCollectionShare creates a share link for the memos matching one of the
+	// user's shortcuts or carrying a tag. Requires authentication as the user.
+	CreateCollectionShare(context.Context, *connect.Request[v1.CreateCollectionShareRequest]) (*connect.Response[v1.CollectionShare], error)
+	// ListCollectionShares lists a user's collection share links. Requires authentication as the user.
+	ListCollectionShares(context.Context, *connect.Request[v1.ListCollectionSharesRequest]) (*connect.Response[v1.ListCollectionSharesResponse], error)
+	// DeleteCollectionShare revokes a collection share link. Requires authentication as the user.
+	DeleteCollectionShare(context.Context, *connect.Request[v1.DeleteCollectionShareRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListMemosByShare resolves a collection share token to the memos of the collection.
+	// No authentication required. Returns NOT_FOUND if the token is invalid or expired,
+	// or if the shared shortcut has been deleted.
+	ListMemosByShare(context.Context, *connect.Request[v1.ListMemosByShareRequest]) (*connect.Response[v1.ListMemosByShareResponse], error)
 	// GetLinkMetadata gets metadata for a link.
 	GetLinkMetadata(context.Context, *connect.Request[v1.GetLinkMetadataRequest]) (*connect.Response[v1.LinkMetadata], error)
 	// BatchGetLinkMetadata gets metadata for links.
@@ -231,6 +272,24 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("DeleteMemoReaction")),
 			connect.WithClientOptions(opts...),
 		),
+		listMemoCollaborators: connect.NewClient[v1.ListMemoCollaboratorsRequest, v1.ListMemoCollaboratorsResponse](
+			httpClient,
+			baseURL+MemoServiceListMemoCollaboratorsProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListMemoCollaborators")),
+			connect.WithClientOptions(opts...),
+		),
+		addMemoCollaborator: connect.NewClient[v1.AddMemoCollaboratorRequest, v1.MemoCollaborator](
+			httpClient,
+			baseURL+MemoServiceAddMemoCollaboratorProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("AddMemoCollaborator")),
+			connect.WithClientOptions(opts...),
+		),
+		removeMemoCollaborator: connect.NewClient[v1.RemoveMemoCollaboratorRequest, emptypb.Empty](
+			httpClient,
+			baseURL+MemoServiceRemoveMemoCollaboratorProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("RemoveMemoCollaborator")),
+			connect.WithClientOptions(opts...),
+		),
 		createMemoShare: connect.NewClient[v1.CreateMemoShareRequest, v1.MemoShare](
 			httpClient,
 			baseURL+MemoServiceCreateMemoShareProcedure,
@@ -255,6 +314,30 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("GetMemoByShare")),
 			connect.WithClientOptions(opts...),
 		),
+		createCollectionShare: connect.NewClient[v1.CreateCollectionShareRequest, v1.CollectionShare](
+			httpClient,
+			baseURL+MemoServiceCreateCollectionShareProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("CreateCollectionShare")),
+			connect.WithClientOptions(opts...),
+		),
+		listCollectionShares: connect.NewClient[v1.ListCollectionSharesRequest, v1.ListCollectionSharesResponse](
+			httpClient,
+			baseURL+MemoServiceListCollectionSharesProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListCollectionShares")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCollectionShare: connect.NewClient[v1.DeleteCollectionShareRequest, emptypb.Empty](
+			httpClient,
+			baseURL+MemoServiceDeleteCollectionShareProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("DeleteCollectionShare")),
+			connect.WithClientOptions(opts...),
+		),
+		listMemosByShare: connect.NewClient[v1.ListMemosByShareRequest, v1.ListMemosByShareResponse](
+			httpClient,
+			baseURL+MemoServiceListMemosByShareProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListMemosByShare")),
+			connect.WithClientOptions(opts...),
+		),
 		getLinkMetadata: connect.NewClient[v1.GetLinkMetadataRequest, v1.LinkMetadata](
 			httpClient,
 			baseURL+MemoServiceGetLinkMetadataProcedure,
@@ -272,26 +355,33 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 
 // memoServiceClient implements MemoServiceClient.
 type memoServiceClient struct {
-	createMemo           *connect.Client[v1.CreateMemoRequest, v1.Memo]
-	listMemos            *connect.Client[v1.ListMemosRequest, v1.ListMemosResponse]
-	getMemo              *connect.Client[v1.GetMemoRequest, v1.Memo]
-	updateMemo           *connect.Client[v1.UpdateMemoRequest, v1.Memo]
-	deleteMemo           *connect.Client[v1.DeleteMemoRequest, emptypb.Empty]
-	setMemoAttachments   *connect.Client[v1.SetMemoAttachmentsRequest, emptypb.Empty]
-	listMemoAttachments  *connect.Client[v1.ListMemoAttachmentsRequest, v1.ListMemoAttachmentsResponse]
-	setMemoRelations     *connect.Client[v1.SetMemoRelationsRequest, emptypb.Empty]
-	listMemoRelations    *connect.Client[v1.ListMemoRelationsRequest, v1.ListMemoRelationsResponse]
-	createMemoComment    *connect.Client[v1.CreateMemoCommentRequest, v1.Memo]
-	listMemoComments     *connect.Client[v1.ListMemoCommentsRequest, v1.ListMemoCommentsResponse]
-	listMemoReactions    *connect.Client[v1.ListMemoReactionsRequest, v1.ListMemoReactionsResponse]
-	upsertMemoReaction   *connect.Client[v1.UpsertMemoReactionRequest, v1.Reaction]
-	deleteMemoReaction   *connect.Client[v1.DeleteMemoReactionRequest, emptypb.Empty]
-	createMemoShare      *connect.Client[v1.CreateMemoShareRequest, v1.MemoShare]
-	listMemoShares       *connect.Client[v1.ListMemoSharesRequest, v1.ListMemoSharesResponse]
-	deleteMemoShare      *connect.Client[v1.DeleteMemoShareRequest, emptypb.Empty]
-	getMemoByShare       *connect.Client[v1.GetMemoByShareRequest, v1.Memo]
-	getLinkMetadata      *connect.Client[v1.GetLinkMetadataRequest, v1.LinkMetadata]
-	batchGetLinkMetadata *connect.Client[v1.BatchGetLinkMetadataRequest, v1.BatchGetLinkMetadataResponse]
+	createMemo             *connect.Client[v1.CreateMemoRequest, v1.Memo]
+	listMemos              *connect.Client[v1.ListMemosRequest, v1.ListMemosResponse]
+	getMemo                *connect.Client[v1.GetMemoRequest, v1.Memo]
+	updateMemo             *connect.Client[v1.UpdateMemoRequest, v1.Memo]
+	deleteMemo             *connect.Client[v1.DeleteMemoRequest, emptypb.Empty]
+	setMemoAttachments     *connect.Client[v1.SetMemoAttachmentsRequest, emptypb.Empty]
+	listMemoAttachments    *connect.Client[v1.ListMemoAttachmentsRequest, v1.ListMemoAttachmentsResponse]
+	setMemoRelations       *connect.Client[v1.SetMemoRelationsRequest, emptypb.Empty]
+	listMemoRelations      *connect.Client[v1.ListMemoRelationsRequest, v1.ListMemoRelationsResponse]
+	createMemoComment      *connect.Client[v1.CreateMemoCommentRequest, v1.Memo]
+	listMemoComments       *connect.Client[v1.ListMemoCommentsRequest, v1.ListMemoCommentsResponse]
+	listMemoReactions      *connect.Client[v1.ListMemoReactionsRequest, v1.ListMemoReactionsResponse]
+	upsertMemoReaction     *connect.Client[v1.UpsertMemoReactionRequest, v1.Reaction]
+	deleteMemoReaction     *connect.Client[v1.DeleteMemoReactionRequest, emptypb.Empty]
+	listMemoCollaborators  *connect.Client[v1.ListMemoCollaboratorsRequest, v1.ListMemoCollaboratorsResponse]
+	addMemoCollaborator    *connect.Client[v1.AddMemoCollaboratorRequest, v1.MemoCollaborator]
+	removeMemoCollaborator *connect.Client[v1.RemoveMemoCollaboratorRequest, emptypb.Empty]
+	createMemoShare        *connect.Client[v1.CreateMemoShareRequest, v1.MemoShare]
+	listMemoShares         *connect.Client[v1.ListMemoSharesRequest, v1.ListMemoSharesResponse]
+	deleteMemoShare        *connect.Client[v1.DeleteMemoShareRequest, emptypb.Empty]
+	getMemoByShare         *connect.Client[v1.GetMemoByShareRequest, v1.Memo]
+	createCollectionShare  *connect.Client[v1.CreateCollectionShareRequest, v1.CollectionShare]
+	listCollectionShares   *connect.Client[v1.ListCollectionSharesRequest, v1.ListCollectionSharesResponse]
+	deleteCollectionShare  *connect.Client[v1.DeleteCollectionShareRequest, emptypb.Empty]
+	listMemosByShare       *connect.Client[v1.ListMemosByShareRequest, v1.ListMemosByShareResponse]
+	getLinkMetadata        *connect.Client[v1.GetLinkMetadataRequest, v1.LinkMetadata]
+	batchGetLinkMetadata   *connect.Client[v1.BatchGetLinkMetadataRequest, v1.BatchGetLinkMetadataResponse]
 }
 
 // CreateMemo calls memos.api.v1.MemoService.CreateMemo.
@@ -364,6 +454,21 @@ func (c *memoServiceClient) DeleteMemoReaction(ctx context.Context, req *connect
 	return c.deleteMemoReaction.CallUnary(ctx, req)
 }
 
+// ListMemoCollaborators calls memos.api.v1.MemoService.ListMemoCollaborators.
+func (c *memoServiceClient) ListMemoCollaborators(ctx context.Context, req *connect.Request[v1.ListMemoCollaboratorsRequest]) (*connect.Response[v1.ListMemoCollaboratorsResponse], error) {
+	return c.listMemoCollaborators.CallUnary(ctx, req)
+}
+
+// AddMemoCollaborator calls memos.api.v1.MemoService.AddMemoCollaborator.
+func (c *memoServiceClient) AddMemoCollaborator(ctx context.Context, req *connect.Request[v1.AddMemoCollaboratorRequest]) (*connect.Response[v1.MemoCollaborator], error) {
+	return c.addMemoCollaborator.CallUnary(ctx, req)
+}
+
+// RemoveMemoCollaborator calls memos.api.v1.MemoService.RemoveMemoCollaborator.
+func (c *memoServiceClient) RemoveMemoCollaborator(ctx context.Context, req *connect.Request[v1.RemoveMemoCollaboratorRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeMemoCollaborator.CallUnary(ctx, req)
+}
+
 // CreateMemoShare calls memos.api.v1.MemoService.CreateMemoShare.
 func (c *memoServiceClient) CreateMemoShare(ctx context.Context, req *connect.Request[v1.CreateMemoShareRequest]) (*connect.Response[v1.MemoShare], error) {
 	return c.createMemoShare.CallUnary(ctx, req)
@@ -384,6 +489,26 @@ func (c *memoServiceClient) GetMemoByShare(ctx context.Context, req *connect.Req
 	return c.getMemoByShare.CallUnary(ctx, req)
 }
 
+// CreateCollectionShare calls memos.api.v1.MemoService.CreateCollectionShare.
+func (c *memoServiceClient) CreateCollectionShare(ctx context.Context, req *connect.Request[v1.CreateCollectionShareRequest]) (*connect.Response[v1.CollectionShare], error) {
+	return c.createCollectionShare.CallUnary(ctx, req)
+}
+
+// ListCollectionShares calls memos.api.v1.MemoService.ListCollectionShares.
+func (c *memoServiceClient) ListCollectionShares(ctx context.Context, req *connect.Request[v1.ListCollectionSharesRequest]) (*connect.Response[v1.ListCollectionSharesResponse], error) {
+	return c.listCollectionShares.CallUnary(ctx, req)
+}
+
+// DeleteCollectionShare calls memos.api.v1.MemoService.DeleteCollectionShare.
+func (c *memoServiceClient) DeleteCollectionShare(ctx context.Context, req *connect.Request[v1.DeleteCollectionShareRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteCollectionShare.CallUnary(ctx, req)
+}
+
+// ListMemosByShare calls memos.api.v1.MemoService.ListMemosByShare.
+func (c *memoServiceClient) ListMemosByShare(ctx context.Context, req *connect.Request[v1.ListMemosByShareRequest]) (*connect.Response[v1.ListMemosByShareResponse], error) {
+	return c.listMemosByShare.CallUnary(ctx, req)
+}
+
 // GetLinkMetadata calls memos.api.v1.MemoService.GetLinkMetadata.
 func (c *memoServiceClient) GetLinkMetadata(ctx context.Context, req *connect.Request[v1.GetLinkMetadataRequest]) (*connect.Response[v1.LinkMetadata], error) {
 	return c.getLinkMetadata.CallUnary(ctx, req)
@@ -424,6 +549,14 @@ type MemoServiceHandler interface {
 	UpsertMemoReaction(context.Context, *connect.Request[v1.UpsertMemoReactionRequest]) (*connect.Response[v1.Reaction], error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *connect.Request[v1.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListMemoCollaborators lists the collaborators of a memo.
+	ListMemoCollaborators(context.Context, *connect.Request[v1.ListMemoCollaboratorsRequest]) (*connect.Response[v1.ListMemoCollaboratorsResponse], error)
+	// AddMemoCollaborator grants a user viewer or editor rights on a memo.
+	// Adding an existing collaborator changes their role. Requires authentication as the memo creator.
+	AddMemoCollaborator(context.Context, *connect.Request[v1.AddMemoCollaboratorRequest]) (*connect.Response[v1.MemoCollaborator], error)
+	// RemoveMemoCollaborator revokes a collaborator's access to a memo.
+	// Requires authentication as the memo creator, or as the collaborator being removed.
+	RemoveMemoCollaborator(context.Context, *connect.Request[v1.RemoveMemoCollaboratorRequest]) (*connect.Response[emptypb.Empty], error)
 	// CreateMemoShare creates a share link for a memo. Requires authentication as the memo creator.
 	CreateMemoShare(context.Context, *connect.Request[v1.CreateMemoShareRequest]) (*connect.Response[v1.MemoShare], error)
 	// ListMemoShares lists all share links for a memo. Requires authentication as the memo creator.
@@ -431,8 +564,20 @@ type MemoServiceHandler interface {
 	// DeleteMemoShare revokes a share link. Requires authentication as the memo creator.
 	DeleteMemoShare(context.Context, *connect.Request[v1.DeleteMemoShareRequest]) (*connect.Response[emptypb.Empty], error)
 	// GetMemoByShare resolves a share token to its memo. No authentication required.
-	// Returns NOT_FOUND if the token is invalid or expired.
+	// Returns NOT_FOUND if the token is invalid, expired or has used up its views,
+	// and PERMISSION_DENIED if the share password is missing or wrong.
 	GetMemoByShare(context.Context, *connect.Request[v1.GetMemoByShareRequest]) (*connect.Response[v1.Memo], error)
+	// CreateCollectionShare creates a share link for the memos matching one of the
+	// user's shortcuts or carrying a tag. Requires authentication as the user.
+	CreateCollectionShare(context.Context, *connect.Request[v1.CreateCollectionShareRequest]) (*connect.Response[v1.CollectionShare], error)
+	// ListCollectionShares lists a user's collection share links. Requires authentication as the user.
+	ListCollectionShares(context.Context, *connect.Request[v1.ListCollectionSharesRequest]) (*connect.Response[v1.ListCollectionSharesResponse], error)
+	// DeleteCollectionShare revokes a collection share link. Requires authentication as the user.
+	DeleteCollectionShare(context.Context, *connect.Request[v1.DeleteCollectionShareRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListMemosByShare resolves a collection share token to the memos of the collection.
+	// No authentication required. Returns NOT_FOUND if the token is invalid or expired,
+	// or if the shared shortcut has been deleted.
+	ListMemosByShare(context.Context, *connect.Request[v1.ListMemosByShareRequest]) (*connect.Response[v1.ListMemosByShareResponse], error)
 	// GetLinkMetadata gets metadata for a link.
 	GetLinkMetadata(context.Context, *connect.Request[v1.GetLinkMetadataRequest]) (*connect.Response[v1.LinkMetadata], error)
 	// BatchGetLinkMetadata gets metadata for links.
@@ -530,6 +675,24 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("DeleteMemoReaction")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceListMemoCollaboratorsHandler := connect.NewUnaryHandler(
+		MemoServiceListMemoCollaboratorsProcedure,
+		svc.ListMemoCollaborators,
+		connect.WithSchema(memoServiceMethods.ByName("ListMemoCollaborators")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceAddMemoCollaboratorHandler := connect.NewUnaryHandler(
+		MemoServiceAddMemoCollaboratorProcedure,
+		svc.AddMemoCollaborator,
+		connect.WithSchema(memoServiceMethods.ByName("AddMemoCollaborator")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceRemoveMemoCollaboratorHandler := connect.NewUnaryHandler(
+		MemoServiceRemoveMemoCollaboratorProcedure,
+		svc.RemoveMemoCollaborator,
+		connect.WithSchema(memoServiceMethods.ByName("RemoveMemoCollaborator")),
+		connect.WithHandlerOptions(opts...),
+	)
 	memoServiceCreateMemoShareHandler := connect.NewUnaryHandler(
 		MemoServiceCreateMemoShareProcedure,
 		svc.CreateMemoShare,
@@ -554,6 +717,30 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("GetMemoByShare")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceCreateCollectionShareHandler := connect.NewUnaryHandler(
+		MemoServiceCreateCollectionShareProcedure,
+		svc.CreateCollectionShare,
+		connect.WithSchema(memoServiceMethods.ByName("CreateCollectionShare")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceListCollectionSharesHandler := connect.NewUnaryHandler(
+		MemoServiceListCollectionSharesProcedure,
+		svc.ListCollectionShares,
+		connect.WithSchema(memoServiceMethods.ByName("ListCollectionShares")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceDeleteCollectionShareHandler := connect.NewUnaryHandler(
+		MemoServiceDeleteCollectionShareProcedure,
+		svc.DeleteCollectionShare,
+		connect.WithSchema(memoServiceMethods.ByName("DeleteCollectionShare")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceListMemosByShareHandler := connect.NewUnaryHandler(
+		MemoServiceListMemosByShareProcedure,
+		svc.ListMemosByShare,
+		connect.WithSchema(memoServiceMethods.ByName("ListMemosByShare")),
+		connect.WithHandlerOptions(opts...),
+	)
 	memoServiceGetLinkMetadataHandler := connect.NewUnaryHandler(
 		MemoServiceGetLinkMetadataProcedure,
 		svc.GetLinkMetadata,
@@ -596,6 +783,12 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 			memoServiceUpsertMemoReactionHandler.ServeHTTP(w, r)
 		case MemoServiceDeleteMemoReactionProcedure:
 			memoServiceDeleteMemoReactionHandler.ServeHTTP(w, r)
+		case MemoServiceListMemoCollaboratorsProcedure:
+			memoServiceListMemoCollaboratorsHandler.ServeHTTP(w, r)
+		case MemoServiceAddMemoCollaboratorProcedure:
+			memoServiceAddMemoCollaboratorHandler.ServeHTTP(w, r)
+		case MemoServiceRemoveMemoCollaboratorProcedure:
+			memoServiceRemoveMemoCollaboratorHandler.ServeHTTP(w, r)
 		case MemoServiceCreateMemoShareProcedure:
 			memoServiceCreateMemoShareHandler.ServeHTTP(w, r)
 		case MemoServiceListMemoSharesProcedure:
@@ -604,6 +797,14 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 			memoServiceDeleteMemoShareHandler.ServeHTTP(w, r)
 		case MemoServiceGetMemoByShareProcedure:
 			memoServiceGetMemoByShareHandler.ServeHTTP(w, r)
+		case MemoServiceCreateCollectionShareProcedure:
+			memoServiceCreateCollectionShareHandler.ServeHTTP(w, r)
+		case MemoServiceListCollectionSharesProcedure:
+			memoServiceListCollectionSharesHandler.ServeHTTP(w, r)
+		case MemoServiceDeleteCollectionShareProcedure:
+			memoServiceDeleteCollectionShareHandler.ServeHTTP(w, r)
+		case MemoServiceListMemosByShareProcedure:
+			memoServiceListMemosByShareHandler.ServeHTTP(w, r)
 		case MemoServiceGetLinkMetadataProcedure:
 			memoServiceGetLinkMetadataHandler.ServeHTTP(w, r)
 		case MemoServiceBatchGetLinkMetadataProcedure:
@@ -673,6 +874,18 @@ func (UnimplementedMemoServiceHandler) DeleteMemoReaction(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.DeleteMemoReaction is not implemented"))
 }
 
+func (UnimplementedMemoServiceHandler) ListMemoCollaborators(context.Context, *connect.Request[v1.ListMemoCollaboratorsRequest]) (*connect.Response[v1.ListMemoCollaboratorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemoCollaborators is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) AddMemoCollaborator(context.Context, *connect.Request[v1.AddMemoCollaboratorRequest]) (*connect.Response[v1.MemoCollaborator], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.AddMemoCollaborator is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) RemoveMemoCollaborator(context.Context, *connect.Request[v1.RemoveMemoCollaboratorRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.RemoveMemoCollaborator is not implemented"))
+}
+
 func (UnimplementedMemoServiceHandler) CreateMemoShare(context.Context, *connect.Request[v1.CreateMemoShareRequest]) (*connect.Response[v1.MemoShare], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.CreateMemoShare is not implemented"))
 }
@@ -689,6 +902,22 @@ func (UnimplementedMemoServiceHandler) GetMemoByShare(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetMemoByShare is not implemented"))
 }
 
+func (UnimplementedMemoServiceHandler) CreateCollectionShare(context.Context, *connect.Request[v1.CreateCollectionShareRequest]) (*connect.Response[v1.CollectionShare], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.CreateCollectionShare is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ListCollectionShares(context.Context, *connect.Request[v1.ListCollectionSharesRequest]) (*connect.Response[v1.ListCollectionSharesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListCollectionShares is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) DeleteCollectionShare(context.Context, *connect.Request[v1.DeleteCollectionShareRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.DeleteCollectionShare is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ListMemosByShare(context.Context, *connect.Request[v1.ListMemosByShareRequest]) (*connect.Response[v1.ListMemosByShareResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemosByShare is not implemented"))
+}
+
 func (UnimplementedMemoServiceHandler) GetLinkMetadata(context.Context, *connect.Request[v1.GetLinkMetadataRequest]) (*connect.Response[v1.LinkMetadata], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetLinkMetadata is not implemented"))
 }
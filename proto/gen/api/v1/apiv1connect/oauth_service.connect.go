@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/oauth_service.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/usememos/memos/proto/gen/api/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// OAuthServiceName is the fully-qualified name of the OAuthService service.
+	OAuthServiceName = "memos.api.v1.OAuthService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OAuthServiceListOAuthClientsProcedure is the fully-qualified name of the OAuthService's
+	// ListOAuthClients RPC.
+	OAuthServiceListOAuthClientsProcedure = "/memos.api.v1.OAuthService/ListOAuthClients"
+	// OAuthServiceCreateOAuthClientProcedure is the fully-qualified name of the OAuthService's
+	// CreateOAuthClient RPC.
+	OAuthServiceCreateOAuthClientProcedure = "/memos.api.v1.OAuthService/CreateOAuthClient"
+	// OAuthServiceDeleteOAuthClientProcedure is the fully-qualified name of the OAuthService's
+	// DeleteOAuthClient RPC.
+	OAuthServiceDeleteOAuthClientProcedure = "/memos.api.v1.OAuthService/DeleteOAuthClient"
+	// OAuthServiceGetOAuthConsentProcedure is the fully-qualified name of the OAuthService's
+	// GetOAuthConsent RPC.
+	OAuthServiceGetOAuthConsentProcedure = "/memos.api.v1.OAuthService/GetOAuthConsent"
+	// OAuthServiceAuthorizeOAuthClientProcedure is the fully-qualified name of the OAuthService's
+	// AuthorizeOAuthClient RPC.
+	OAuthServiceAuthorizeOAuthClientProcedure = "/memos.api.v1.OAuthService/AuthorizeOAuthClient"
+)
+
+// OAuthServiceClient is a client for the memos.api.v1.OAuthService service.
+type OAuthServiceClient interface {
+	// ListOAuthClients returns the OAuth clients created by the current user.
+	// Admins see every client, including dynamically registered ones.
+	ListOAuthClients(context.Context, *connect.Request[v1.ListOAuthClientsRequest]) (*connect.Response[v1.ListOAuthClientsResponse], error)
+	// CreateOAuthClient registers a new OAuth client owned by the current user.
+	CreateOAuthClient(context.Context, *connect.Request[v1.CreateOAuthClientRequest]) (*connect.Response[v1.CreateOAuthClientResponse], error)
+	// DeleteOAuthClient deletes an OAuth client and revokes every token issued to it.
+	DeleteOAuthClient(context.Context, *connect.Request[v1.DeleteOAuthClientRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetOAuthConsent validates an authorization request and returns the data
+	// shown on the consent screen.
+	GetOAuthConsent(context.Context, *connect.Request[v1.GetOAuthConsentRequest]) (*connect.Response[v1.OAuthConsent], error)
+	// AuthorizeOAuthClient records the decision of the current user on an
+	// authorization request and returns the URL to redirect the browser to.
+	// Only browser sessions can authorize clients.
+	AuthorizeOAuthClient(context.Context, *connect.Request[v1.AuthorizeOAuthClientRequest]) (*connect.Response[v1.AuthorizeOAuthClientResponse], error)
+}
+
+// NewOAuthServiceClient constructs a client for the memos.api.v1.OAuthService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OAuthServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	oAuthServiceMethods := v1.File_api_v1_oauth_service_proto.Services().ByName("OAuthService").Methods()
+	return &oAuthServiceClient{
+		listOAuthClients: connect.NewClient[v1.ListOAuthClientsRequest, v1.ListOAuthClientsResponse](
+			httpClient,
+			baseURL+OAuthServiceListOAuthClientsProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("ListOAuthClients")),
+			connect.WithClientOptions(opts...),
+		),
+		createOAuthClient: connect.NewClient[v1.CreateOAuthClientRequest, v1.CreateOAuthClientResponse](
+			httpClient,
+			baseURL+OAuthServiceCreateOAuthClientProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("CreateOAuthClient")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteOAuthClient: connect.NewClient[v1.DeleteOAuthClientRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OAuthServiceDeleteOAuthClientProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("DeleteOAuthClient")),
+			connect.WithClientOptions(opts...),
+		),
+		getOAuthConsent: connect.NewClient[v1.GetOAuthConsentRequest, v1.OAuthConsent](
+			httpClient,
+			baseURL+OAuthServiceGetOAuthConsentProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("GetOAuthConsent")),
+			connect.WithClientOptions(opts...),
+		),
+		authorizeOAuthClient: connect.NewClient[v1.AuthorizeOAuthClientRequest, v1.AuthorizeOAuthClientResponse](
+			httpClient,
+			baseURL+OAuthServiceAuthorizeOAuthClientProcedure,
+			connect.WithSchema(oAuthServiceMethods.ByName("AuthorizeOAuthClient")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// oAuthServiceClient implements OAuthServiceClient.
+type oAuthServiceClient struct {
+	listOAuthClients     *connect.Client[v1.ListOAuthClientsRequest, v1.ListOAuthClientsResponse]
+	createOAuthClient    *connect.Client[v1.CreateOAuthClientRequest, v1.CreateOAuthClientResponse]
+	deleteOAuthClient    *connect.Client[v1.DeleteOAuthClientRequest, emptypb.Empty]
+	getOAuthConsent      *connect.Client[v1.GetOAuthConsentRequest, v1.OAuthConsent]
+	authorizeOAuthClient *connect.Client[v1.AuthorizeOAuthClientRequest, v1.AuthorizeOAuthClientResponse]
+}
+
+// ListOAuthClients calls memos.api.v1.OAuthService.ListOAuthClients.
+func (c *oAuthServiceClient) ListOAuthClients(ctx context.Context, req *connect.Request[v1.ListOAuthClientsRequest]) (*connect.Response[v1.ListOAuthClientsResponse], error) {
+	return c.listOAuthClients.CallUnary(ctx, req)
+}
+
+// CreateOAuthClient calls memos.api.v1.OAuthService.CreateOAuthClient.
+func (c *oAuthServiceClient) CreateOAuthClient(ctx context.Context, req *connect.Request[v1.CreateOAuthClientRequest]) (*connect.Response[v1.CreateOAuthClientResponse], error) {
+	return c.createOAuthClient.CallUnary(ctx, req)
+}
+
+// DeleteOAuthClient calls memos.api.v1.OAuthService.DeleteOAuthClient.
+func (c *oAuthServiceClient) DeleteOAuthClient(ctx context.Context, req *connect.Request[v1.DeleteOAuthClientRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteOAuthClient.CallUnary(ctx, req)
+}
+
+// GetOAuthConsent calls memos.api.v1.OAuthService.GetOAuthConsent.
+func (c *oAuthServiceClient) GetOAuthConsent(ctx context.Context, req *connect.Request[v1.GetOAuthConsentRequest]) (*connect.Response[v1.OAuthConsent], error) {
+	return c.getOAuthConsent.CallUnary(ctx, req)
+}
+
+// AuthorizeOAuthClient calls memos.api.v1.OAuthService.AuthorizeOAuthClient.
+func (c *oAuthServiceClient) AuthorizeOAuthClient(ctx context.Context, req *connect.Request[v1.AuthorizeOAuthClientRequest]) (*connect.Response[v1.AuthorizeOAuthClientResponse], error) {
+	return c.authorizeOAuthClient.CallUnary(ctx, req)
+}
+
+// OAuthServiceHandler is an implementation of the memos.api.v1.OAuthService service.
+type OAuthServiceHandler interface {
+	// ListOAuthClients returns the OAuth clients created by the current user.
+	// Admins see every client, including dynamically registered ones.
+	ListOAuthClients(context.Context, *connect.Request[v1.ListOAuthClientsRequest]) (*connect.Response[v1.ListOAuthClientsResponse], error)
+	// CreateOAuthClient registers a new OAuth client owned by the current user.
+	CreateOAuthClient(context.Context, *connect.Request[v1.CreateOAuthClientRequest]) (*connect.Response[v1.CreateOAuthClientResponse], error)
+	// DeleteOAuthClient deletes an OAuth client and revokes every token issued to it.
+	DeleteOAuthClient(context.Context, *connect.Request[v1.DeleteOAuthClientRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetOAuthConsent validates an authorization request and returns the data
+	// shown on the consent screen.
+	GetOAuthConsent(context.Context, *connect.Request[v1.GetOAuthConsentRequest]) (*connect.Response[v1.OAuthConsent], error)
+	// AuthorizeOAuthClient records the decision of the current user on an
+	// authorization request and returns the URL to redirect the browser to.
+	// Only browser sessions can authorize clients.
+	AuthorizeOAuthClient(context.Context, *connect.Request[v1.AuthorizeOAuthClientRequest]) (*connect.Response[v1.AuthorizeOAuthClientResponse], error)
+}
+
+// NewOAuthServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOAuthServiceHandler(svc OAuthServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	oAuthServiceMethods := v1.File_api_v1_oauth_service_proto.Services().ByName("OAuthService").Methods()
+	oAuthServiceListOAuthClientsHandler := connect.NewUnaryHandler(
+		OAuthServiceListOAuthClientsProcedure,
+		svc.ListOAuthClients,
+		connect.WithSchema(oAuthServiceMethods.ByName("ListOAuthClients")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceCreateOAuthClientHandler := connect.NewUnaryHandler(
+		OAuthServiceCreateOAuthClientProcedure,
+		svc.CreateOAuthClient,
+		connect.WithSchema(oAuthServiceMethods.ByName("CreateOAuthClient")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceDeleteOAuthClientHandler := connect.NewUnaryHandler(
+		OAuthServiceDeleteOAuthClientProcedure,
+		svc.DeleteOAuthClient,
+		connect.WithSchema(oAuthServiceMethods.ByName("DeleteOAuthClient")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceGetOAuthConsentHandler := connect.NewUnaryHandler(
+		OAuthServiceGetOAuthConsentProcedure,
+		svc.GetOAuthConsent,
+		connect.WithSchema(oAuthServiceMethods.ByName("GetOAuthConsent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthServiceAuthorizeOAuthClientHandler := connect.NewUnaryHandler(
+		OAuthServiceAuthorizeOAuthClientProcedure,
+		svc.AuthorizeOAuthClient,
+		connect.WithSchema(oAuthServiceMethods.ByName("AuthorizeOAuthClient")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/memos.api.v1.OAuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OAuthServiceListOAuthClientsProcedure:
+			oAuthServiceListOAuthClientsHandler.ServeHTTP(w, r)
+		case OAuthServiceCreateOAuthClientProcedure:
+			oAuthServiceCreateOAuthClientHandler.ServeHTTP(w, r)
+		case OAuthServiceDeleteOAuthClientProcedure:
+			oAuthServiceDeleteOAuthClientHandler.ServeHTTP(w, r)
+		case OAuthServiceGetOAuthConsentProcedure:
+			oAuthServiceGetOAuthConsentHandler.ServeHTTP(w, r)
+		case OAuthServiceAuthorizeOAuthClientProcedure:
+			oAuthServiceAuthorizeOAuthClientHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOAuthServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOAuthServiceHandler struct{}
+
+func (UnimplementedOAuthServiceHandler) ListOAuthClients(context.Context, *connect.Request[v1.ListOAuthClientsRequest]) (*connect.Response[v1.ListOAuthClientsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.OAuthService.ListOAuthClients is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) CreateOAuthClient(context.Context, *connect.Request[v1.CreateOAuthClientRequest]) (*connect.Response[v1.CreateOAuthClientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.OAuthService.CreateOAuthClient is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) DeleteOAuthClient(context.Context, *connect.Request[v1.DeleteOAuthClientRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.OAuthService.DeleteOAuthClient is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) GetOAuthConsent(context.Context, *connect.Request[v1.GetOAuthConsentRequest]) (*connect.Response[v1.OAuthConsent], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.OAuthService.GetOAuthConsent is not implemented"))
+}
+
+func (UnimplementedOAuthServiceHandler) AuthorizeOAuthClient(context.Context, *connect.Request[v1.AuthorizeOAuthClientRequest]) (*connect.Response[v1.AuthorizeOAuthClientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.OAuthService.AuthorizeOAuthClient is not implemented"))
+}
@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/role_service.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/usememos/memos/proto/gen/api/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RoleServiceName is the fully-qualified name of the RoleService service.
+	RoleServiceName = "memos.api.v1.RoleService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RoleServiceListRolesProcedure is the fully-qualified name of the RoleService's ListRoles RPC.
+	RoleServiceListRolesProcedure = "/memos.api.v1.RoleService/ListRoles"
+	// RoleServiceGetRoleProcedure is the fully-qualified name of the RoleService's GetRole RPC.
+	RoleServiceGetRoleProcedure = "/memos.api.v1.RoleService/GetRole"
+	// RoleServiceCreateRoleProcedure is the fully-qualified name of the RoleService's CreateRole RPC.
+	RoleServiceCreateRoleProcedure = "/memos.api.v1.RoleService/CreateRole"
+	// RoleServiceUpdateRoleProcedure is the fully-qualified name of the RoleService's UpdateRole RPC.
+	RoleServiceUpdateRoleProcedure = "/memos.api.v1.RoleService/UpdateRole"
+	// RoleServiceDeleteRoleProcedure is the fully-qualified name of the RoleService's DeleteRole RPC.
+	RoleServiceDeleteRoleProcedure = "/memos.api.v1.RoleService/DeleteRole"
+)
+
+// RoleServiceClient is a client for the memos.api.v1.RoleService service.
+type RoleServiceClient interface {
+	// ListRoles lists the custom roles of the instance.
+	// Only admins can list roles.
+	ListRoles(context.Context, *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error)
+	// GetRole gets a custom role.
+	// Only admins can get roles.
+	GetRole(context.Context, *connect.Request[v1.GetRoleRequest]) (*connect.Response[v1.Role], error)
+	// CreateRole creates a custom role.
+	// Only admins can create roles.
+	CreateRole(context.Context, *connect.Request[v1.CreateRoleRequest]) (*connect.Response[v1.Role], error)
+	// UpdateRole updates the description or permissions of a custom role.
+	// Only admins can update roles.
+	UpdateRole(context.Context, *connect.Request[v1.UpdateRoleRequest]) (*connect.Response[v1.Role], error)
+	// DeleteRole deletes a custom role. Roles still assigned to users cannot be deleted.
+	// Only admins can delete roles.
+	DeleteRole(context.Context, *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewRoleServiceClient constructs a client for the memos.api.v1.RoleService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRoleServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RoleServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	roleServiceMethods := v1.File_api_v1_role_service_proto.Services().ByName("RoleService").Methods()
+	return &roleServiceClient{
+		listRoles: connect.NewClient[v1.ListRolesRequest, v1.ListRolesResponse](
+			httpClient,
+			baseURL+RoleServiceListRolesProcedure,
+			connect.WithSchema(roleServiceMethods.ByName("ListRoles")),
+			connect.WithClientOptions(opts...),
+		),
+		getRole: connect.NewClient[v1.GetRoleRequest, v1.Role](
+			httpClient,
+			baseURL+RoleServiceGetRoleProcedure,
+			connect.WithSchema(roleServiceMethods.ByName("GetRole")),
+			connect.WithClientOptions(opts...),
+		),
+		createRole: connect.NewClient[v1.CreateRoleRequest, v1.Role](
+			httpClient,
+			baseURL+RoleServiceCreateRoleProcedure,
+			connect.WithSchema(roleServiceMethods.ByName("CreateRole")),
+			connect.WithClientOptions(opts...),
+		),
+		updateRole: connect.NewClient[v1.UpdateRoleRequest, v1.Role](
+			httpClient,
+			baseURL+RoleServiceUpdateRoleProcedure,
+			connect.WithSchema(roleServiceMethods.ByName("UpdateRole")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRole: connect.NewClient[v1.DeleteRoleRequest, emptypb.Empty](
+			httpClient,
+			baseURL+RoleServiceDeleteRoleProcedure,
+			connect.WithSchema(roleServiceMethods.ByName("DeleteRole")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// roleServiceClient implements RoleServiceClient.
+type roleServiceClient struct {
+	listRoles  *connect.Client[v1.ListRolesRequest, v1.ListRolesResponse]
+	getRole    *connect.Client[v1.GetRoleRequest, v1.Role]
+	createRole *connect.Client[v1.CreateRoleRequest, v1.Role]
+	updateRole *connect.Client[v1.UpdateRoleRequest, v1.Role]
+	deleteRole *connect.Client[v1.DeleteRoleRequest, emptypb.Empty]
+}
+
+// ListRoles calls memos.api.v1.RoleService.ListRoles.
+func (c *roleServiceClient) ListRoles(ctx context.Context, req *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error) {
+	return c.listRoles.CallUnary(ctx, req)
+}
+
+// GetRole calls memos.api.v1.RoleService.GetRole.
+func (c *roleServiceClient) GetRole(ctx context.Context, req *connect.Request[v1.GetRoleRequest]) (*connect.Response[v1.Role], error) {
+	return c.getRole.CallUnary(ctx, req)
+}
+
+// CreateRole calls memos.api.v1.RoleService.CreateRole.
+func (c *roleServiceClient) CreateRole(ctx context.Context, req *connect.Request[v1.CreateRoleRequest]) (*connect.Response[v1.Role], error) {
+	return c.createRole.CallUnary(ctx, req)
+}
+
+// UpdateRole calls memos.api.v1.RoleService.UpdateRole.
+func (c *roleServiceClient) UpdateRole(ctx context.Context, req *connect.Request[v1.UpdateRoleRequest]) (*connect.Response[v1.Role], error) {
+	return c.updateRole.CallUnary(ctx, req)
+}
+
+// DeleteRole calls memos.api.v1.RoleService.DeleteRole.
+func (c *roleServiceClient) DeleteRole(ctx context.Context, req *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteRole.CallUnary(ctx, req)
+}
+
+// RoleServiceHandler is an implementation of the memos.api.v1.RoleService service.
+type RoleServiceHandler interface {
+	// ListRoles lists the custom roles of the instance.
+	// Only admins can list roles.
+	ListRoles(context.Context, *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error)
+	// GetRole gets a custom role.
+	// Only admins can get roles.
+	GetRole(context.Context, *connect.Request[v1.GetRoleRequest]) (*connect.Response[v1.Role], error)
+	// CreateRole creates a custom role.
+	// Only admins can create roles.
+	CreateRole(context.Context, *connect.Request[v1.CreateRoleRequest]) (*connect.Response[v1.Role], error)
+	// UpdateRole updates the description or permissions of a custom role.
+	// Only admins can update roles.
+	UpdateRole(context.Context, *connect.Request[v1.UpdateRoleRequest]) (*connect.Response[v1.Role], error)
+	// DeleteRole deletes a custom role. Roles still assigned to users cannot be deleted.
+	// Only admins can delete roles.
+	DeleteRole(context.Context, *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewRoleServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRoleServiceHandler(svc RoleServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	roleServiceMethods := v1.File_api_v1_role_service_proto.Services().ByName("RoleService").Methods()
+	roleServiceListRolesHandler := connect.NewUnaryHandler(
+		RoleServiceListRolesProcedure,
+		svc.ListRoles,
+		connect.WithSchema(roleServiceMethods.ByName("ListRoles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roleServiceGetRoleHandler := connect.NewUnaryHandler(
+		RoleServiceGetRoleProcedure,
+		svc.GetRole,
+		connect.WithSchema(roleServiceMethods.ByName("GetRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roleServiceCreateRoleHandler := connect.NewUnaryHandler(
+		RoleServiceCreateRoleProcedure,
+		svc.CreateRole,
+		connect.WithSchema(roleServiceMethods.ByName("CreateRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roleServiceUpdateRoleHandler := connect.NewUnaryHandler(
+		RoleServiceUpdateRoleProcedure,
+		svc.UpdateRole,
+		connect.WithSchema(roleServiceMethods.ByName("UpdateRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roleServiceDeleteRoleHandler := connect.NewUnaryHandler(
+		RoleServiceDeleteRoleProcedure,
+		svc.DeleteRole,
+		connect.WithSchema(roleServiceMethods.ByName("DeleteRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/memos.api.v1.RoleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RoleServiceListRolesProcedure:
+			roleServiceListRolesHandler.ServeHTTP(w, r)
+		case RoleServiceGetRoleProcedure:
+			roleServiceGetRoleHandler.ServeHTTP(w, r)
+		case RoleServiceCreateRoleProcedure:
+			roleServiceCreateRoleHandler.ServeHTTP(w, r)
+		case RoleServiceUpdateRoleProcedure:
+			roleServiceUpdateRoleHandler.ServeHTTP(w, r)
+		case RoleServiceDeleteRoleProcedure:
+			roleServiceDeleteRoleHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRoleServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRoleServiceHandler struct{}
+
+func (UnimplementedRoleServiceHandler) ListRoles(context.Context, *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.RoleService.ListRoles is not implemented"))
+}
+
+func (UnimplementedRoleServiceHandler) GetRole(context.Context, *connect.Request[v1.GetRoleRequest]) (*connect.Response[v1.Role], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.RoleService.GetRole is not implemented"))
+}
+
+func (UnimplementedRoleServiceHandler) CreateRole(context.Context, *connect.Request[v1.CreateRoleRequest]) (*connect.Response[v1.Role], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.RoleService.CreateRole is not implemented"))
+}
+
+func (UnimplementedRoleServiceHandler) UpdateRole(context.Context, *connect.Request[v1.UpdateRoleRequest]) (*connect.Response[v1.Role], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.RoleService.UpdateRole is not implemented"))
+}
+
+func (UnimplementedRoleServiceHandler) DeleteRole(context.Context, *connect.Request[v1.DeleteRoleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.RoleService.DeleteRole is not implemented"))
+}
@@ -47,6 +47,8 @@ const (
 	UserServiceUpdateUserProcedure = "/memos.api.v1.UserService/UpdateUser"
 	// UserServiceDeleteUserProcedure is the fully-qualified name of the UserService's DeleteUser RPC.
 	UserServiceDeleteUserProcedure = "/memos.api.v1.UserService/DeleteUser"
+	// UserServiceUnlockUserProcedure is the fully-qualified name of the UserService's UnlockUser RPC.
+	UserServiceUnlockUserProcedure = "/memos.api.v1.UserService/UnlockUser"
 	// UserServiceListAllUserStatsProcedure is the fully-qualified name of the UserService's
 	// ListAllUserStats RPC.
 	UserServiceListAllUserStatsProcedure = "/memos.api.v1.UserService/ListAllUserStats"
@@ -83,6 +85,15 @@ const (
 	// UserServiceDeletePersonalAccessTokenProcedure is the fully-qualified name of the UserService's
 	// DeletePersonalAccessToken RPC.
 	UserServiceDeletePersonalAccessTokenProcedure = "/memos.api.v1.UserService/DeletePersonalAccessToken"
+	// UserServiceListSessionsProcedure is the fully-qualified name of the UserService's ListSessions
+	// RPC.
+	UserServiceListSessionsProcedure = "/memos.api.v1.UserService/ListSessions"
+	// UserServiceRevokeSessionProcedure is the fully-qualified name of the UserService's RevokeSession
+	// RPC.
+	UserServiceRevokeSessionProcedure = "/memos.api.v1.UserService/RevokeSession"
+	// UserServiceRevokeAllSessionsProcedure is the fully-qualified name of the UserService's
+	// RevokeAllSessions RPC.
+	UserServiceRevokeAllSessionsProcedure = "/memos.api.v1.UserService/RevokeAllSessions"
 	// UserServiceListUserWebhooksProcedure is the fully-qualified name of the UserService's
 	// ListUserWebhooks RPC.
 	UserServiceListUserWebhooksProcedure = "/memos.api.v1.UserService/ListUserWebhooks"
@@ -95,6 +106,12 @@ const (
 	// UserServiceDeleteUserWebhookProcedure is the fully-qualified name of the UserService's
 	// DeleteUserWebhook RPC.
 	UserServiceDeleteUserWebhookProcedure = "/memos.api.v1.UserService/DeleteUserWebhook"
+	// UserServiceListWebhookDeliveriesProcedure is the fully-qualified name of the UserService's
+	// ListWebhookDeliveries RPC.
+	UserServiceListWebhookDeliveriesProcedure = "/memos.api.v1.UserService/ListWebhookDeliveries"
+	// UserServiceRedeliverWebhookProcedure is the fully-qualified name of the UserService's
+	// RedeliverWebhook RPC.
+	UserServiceRedeliverWebhookProcedure = "/memos.api.v1.UserService/RedeliverWebhook"
 	// UserServiceListUserNotificationsProcedure is the fully-qualified name of the UserService's
 	// ListUserNotifications RPC.
 	UserServiceListUserNotificationsProcedure = "/memos.api.v1.UserService/ListUserNotifications"
@@ -104,6 +121,42 @@ const (
 	// UserServiceDeleteUserNotificationProcedure is the fully-qualified name of the UserService's
 	// DeleteUserNotification RPC.
 	UserServiceDeleteUserNotificationProcedure = "/memos.api.v1.UserService/DeleteUserNotification"
+	// UserServiceSendTestNotificationProcedure is the fully-qualified name of the UserService's
+	// SendTestNotification RPC.
+	UserServiceSendTestNotificationProcedure = "/memos.api.v1.UserService/SendTestNotification"
+	// UserServiceListUserPushSubscriptionsProcedure is the fully-qualified name of the UserService's
+	// ListUserPushSubscriptions RPC.
+	UserServiceListUserPushSubscriptionsProcedure = "/memos.api.v1.UserService/ListUserPushSubscriptions"
+	// UserServiceCreateUserPushSubscriptionProcedure is the fully-qualified name of the UserService's
+	// CreateUserPushSubscription RPC.
+	UserServiceCreateUserPushSubscriptionProcedure = "/memos.api.v1.UserService/CreateUserPushSubscription"
+	// UserServiceDeleteUserPushSubscriptionProcedure is the fully-qualified name of the UserService's
+	// DeleteUserPushSubscription RPC.
+	UserServiceDeleteUserPushSubscriptionProcedure = "/memos.api.v1.UserService/DeleteUserPushSubscription"
+	// UserServiceListUserGroupsProcedure is the fully-qualified name of the UserService's
+	// ListUserGroups RPC.
+	UserServiceListUserGroupsProcedure = "/memos.api.v1.UserService/ListUserGroups"
+	// UserServiceGetUserGroupProcedure is the fully-qualified name of the UserService's GetUserGroup
+	// RPC.
+	UserServiceGetUserGroupProcedure = "/memos.api.v1.UserService/GetUserGroup"
+	// UserServiceCreateUserGroupProcedure is the fully-qualified name of the UserService's
+	// CreateUserGroup RPC.
+	UserServiceCreateUserGroupProcedure = "/memos.api.v1.UserService/CreateUserGroup"
+	// UserServiceUpdateUserGroupProcedure is the fully-qualified name of the UserService's
+	// UpdateUserGroup RPC.
+	UserServiceUpdateUserGroupProcedure = "/memos.api.v1.UserService/UpdateUserGroup"
+	// UserServiceDeleteUserGroupProcedure is the fully-qualified name of the UserService's
+	// DeleteUserGroup RPC.
+	UserServiceDeleteUserGroupProcedure = "/memos.api.v1.UserService/DeleteUserGroup"
+	// UserServiceListUserGroupMembersProcedure is the fully-qualified name of the UserService's
+	// ListUserGroupMembers RPC.
+	UserServiceListUserGroupMembersProcedure = "/memos.api.v1.UserService/ListUserGroupMembers"
+	// UserServiceAddUserGroupMemberProcedure is the fully-qualified name of the UserService's
+	// AddUserGroupMember RPC.
+	UserServiceAddUserGroupMemberProcedure = "/memos.api.v1.UserService/AddUserGroupMember"
+	// UserServiceRemoveUserGroupMemberProcedure is the fully-qualified name of the UserService's
+	// RemoveUserGroupMember RPC.
+	UserServiceRemoveUserGroupMemberProcedure = "/memos.api.v1.UserService/RemoveUserGroupMember"
 )
 
 // UserServiceClient is a client for the memos.api.v1.UserService service.
@@ -121,6 +174,9 @@ type UserServiceClient interface {
 	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.User], error)
 	// DeleteUser deletes a user.
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[emptypb.Empty], error)
+	// UnlockUser clears the failed sign-in attempts of a user, lifting any lockout.
+	// Only admins can unlock users.
+	UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListAllUserStats returns statistics for all users.
 	ListAllUserStats(context.Context, *connect.Request[v1.ListAllUserStatsRequest]) (*connect.Response[v1.ListAllUserStatsResponse], error)
 	// GetUserStats returns statistics for a specific user.
@@ -147,6 +203,13 @@ type UserServiceClient interface {
 	CreatePersonalAccessToken(context.Context, *connect.Request[v1.CreatePersonalAccessTokenRequest]) (*connect.Response[v1.CreatePersonalAccessTokenResponse], error)
 	// DeletePersonalAccessToken deletes a Personal Access Token.
 	DeletePersonalAccessToken(context.Context, *connect.Request[v1.DeletePersonalAccessTokenRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListSessions returns the active sign-in sessions of a user.
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// RevokeSession signs out a single session.
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[emptypb.Empty], error)
+	// RevokeAllSessions signs a user out everywhere.
+	// Admins may also use it to revoke the sessions and personal access tokens of other users.
+	RevokeAllSessions(context.Context, *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListUserWebhooks returns a list of webhooks for a user.
 	ListUserWebhooks(context.Context, *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error)
 	// CreateUserWebhook creates a new webhook for a user.
@@ -155,12 +218,50 @@ type UserServiceClient interface {
 	UpdateUserWebhook(context.Context, *connect.Request[v1.UpdateUserWebhookRequest]) (*connect.Response[v1.UserWebhook], error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *connect.Request[v1.DeleteUserWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListWebhookDeliveries lists the deliveries of a webhook, newest first.
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	// RedeliverWebhook queues a delivery to be sent again with the same delivery ID.
+	RedeliverWebhook(context.Context, *connect.Request[v1.RedeliverWebhookRequest]) (*connect.Response[v1.WebhookDelivery], error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error)
 	// UpdateUserNotification updates a notification.
 	UpdateUserNotification(context.Context, *connect.Request[v1.UpdateUserNotificationRequest]) (*connect.Response[v1.UserNotification], error)
 	// DeleteUserNotification deletes a notification.
 	DeleteUserNotification(context.Context, *connect.Request[v1.DeleteUserNotificationRequest]) (*connect.Response[emptypb.Empty], error)
+	// SendTestNotification sends a test notification to the user's notification channels,
+	// or to the given channel before it is saved.
+	SendTestNotification(context.Context, *connect.Request[v1.SendTestNotificationRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserPushSubscriptions lists the devices a user registered for Web Push notifications.
+	ListUserPushSubscriptions(context.Context, *connect.Request[v1.ListUserPushSubscriptionsRequest]) (*connect.Response[v1.ListUserPushSubscriptionsResponse], error)
+	// CreateUserPushSubscription registers a device for Web Push notifications.
+	// Registering an endpoint again replaces its keys.
+	CreateUserPushSubscription(context.Context, *connect.Request[v1.CreateUserPushSubscriptionRequest]) (*connect.Response[v1.UserPushSubscription], error)
+	// DeleteUserPushSubscription unregisters a device from Web Push notifications.
+	DeleteUserPushSubscription(context.Context, *connect.Request[v1.DeleteUserPushSubscriptionRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserGroups lists user groups.
+	// User managers see every group; other users see the groups they belong to.
+	ListUserGroups(context.Context, *connect.Request[v1.ListUserGroupsRequest]) (*connect.Response[v1.ListUserGroupsResponse], error)
+	// GetUserGroup gets a user group.
+	// User managers can get any group; other users can get the groups they belong to.
+	GetUserGroup(context.Context, *connect.Request[v1.GetUserGroupRequest]) (*connect.Response[v1.UserGroup], error)
+	// CreateUserGroup creates a user group.
+	// Requires the MANAGE_USERS permission.
+	CreateUserGroup(context.Context, *connect.Request[v1.CreateUserGroupRequest]) (*connect.Response[v1.UserGroup], error)
+	// UpdateUserGroup updates the description of a user group.
+	// Requires the MANAGE_USERS permission.
+	UpdateUserGroup(context.Context, *connect.Request[v1.UpdateUserGroupRequest]) (*connect.Response[v1.UserGroup], error)
+	// DeleteUserGroup deletes a user group and its memberships.
+	// Requires the MANAGE_USERS permission.
+	DeleteUserGroup(context.Context, *connect.Request[v1.DeleteUserGroupRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserGroupMembers lists the members of a user group.
+	// User managers can list any group; other users can list the groups they belong to.
+	ListUserGroupMembers(context.Context, *connect.Request[v1.ListUserGroupMembersRequest]) (*connect.Response[v1.ListUserGroupMembersResponse], error)
+	// AddUserGroupMember adds a user to a group.
+	// Requires the MANAGE_USERS permission.
+	AddUserGroupMember(context.Context, *connect.Request[v1.AddUserGroupMemberRequest]) (*connect.Response[v1.UserGroupMember], error)
+	// RemoveUserGroupMember removes a user from a group.
+	// Requires the MANAGE_USERS permission.
+	RemoveUserGroupMember(context.Context, *connect.Request[v1.RemoveUserGroupMemberRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewUserServiceClient constructs a client for the memos.api.v1.UserService service. By default, it
@@ -210,6 +311,12 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeleteUser")),
 			connect.WithClientOptions(opts...),
 		),
+		unlockUser: connect.NewClient[v1.UnlockUserRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceUnlockUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UnlockUser")),
+			connect.WithClientOptions(opts...),
+		),
 		listAllUserStats: connect.NewClient[v1.ListAllUserStatsRequest, v1.ListAllUserStatsResponse](
 			httpClient,
 			baseURL+UserServiceListAllUserStatsProcedure,
@@ -282,6 +389,24 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeletePersonalAccessToken")),
 			connect.WithClientOptions(opts...),
 		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+UserServiceListSessionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceRevokeSessionProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAllSessions: connect.NewClient[v1.RevokeAllSessionsRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceRevokeAllSessionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeAllSessions")),
+			connect.WithClientOptions(opts...),
+		),
 		listUserWebhooks: connect.NewClient[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse](
 			httpClient,
 			baseURL+UserServiceListUserWebhooksProcedure,
@@ -306,6 +431,18 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeleteUserWebhook")),
 			connect.WithClientOptions(opts...),
 		),
+		listWebhookDeliveries: connect.NewClient[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+UserServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		redeliverWebhook: connect.NewClient[v1.RedeliverWebhookRequest, v1.WebhookDelivery](
+			httpClient,
+			baseURL+UserServiceRedeliverWebhookProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RedeliverWebhook")),
+			connect.WithClientOptions(opts...),
+		),
 		listUserNotifications: connect.NewClient[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse](
 			httpClient,
 			baseURL+UserServiceListUserNotificationsProcedure,
@@ -324,36 +461,126 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeleteUserNotification")),
 			connect.WithClientOptions(opts...),
 		),
+		sendTestNotification: connect.NewClient[v1.SendTestNotificationRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceSendTestNotificationProcedure,
+			connect.WithSchema(userServiceMethods.ByName("SendTestNotification")),
+			connect.WithClientOptions(opts...),
+		),
+		listUserPushSubscriptions: connect.NewClient[v1.ListUserPushSubscriptionsRequest, v1.ListUserPushSubscriptionsResponse](
+			httpClient,
+			baseURL+UserServiceListUserPushSubscriptionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUserPushSubscriptions")),
+			connect.WithClientOptions(opts...),
+		),
+		createUserPushSubscription: connect.NewClient[v1.CreateUserPushSubscriptionRequest, v1.UserPushSubscription](
+			httpClient,
+			baseURL+UserServiceCreateUserPushSubscriptionProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateUserPushSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUserPushSubscription: connect.NewClient[v1.DeleteUserPushSubscriptionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteUserPushSubscriptionProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteUserPushSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		listUserGroups: connect.NewClient[v1.ListUserGroupsRequest, v1.ListUserGroupsResponse](
+			httpClient,
+			baseURL+UserServiceListUserGroupsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUserGroups")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserGroup: connect.NewClient[v1.GetUserGroupRequest, v1.UserGroup](
+			httpClient,
+			baseURL+UserServiceGetUserGroupProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUserGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		createUserGroup: connect.NewClient[v1.CreateUserGroupRequest, v1.UserGroup](
+			httpClient,
+			baseURL+UserServiceCreateUserGroupProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateUserGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUserGroup: connect.NewClient[v1.UpdateUserGroupRequest, v1.UserGroup](
+			httpClient,
+			baseURL+UserServiceUpdateUserGroupProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UpdateUserGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUserGroup: connect.NewClient[v1.DeleteUserGroupRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteUserGroupProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteUserGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		listUserGroupMembers: connect.NewClient[v1.ListUserGroupMembersRequest, v1.ListUserGroupMembersResponse](
+			httpClient,
+			baseURL+UserServiceListUserGroupMembersProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUserGroupMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		addUserGroupMember: connect.NewClient[v1.AddUserGroupMemberRequest, v1.UserGroupMember](
+			httpClient,
+			baseURL+UserServiceAddUserGroupMemberProcedure,
+			connect.WithSchema(userServiceMethods.ByName("AddUserGroupMember")),
+			connect.WithClientOptions(opts...),
+		),
+		removeUserGroupMember: connect.NewClient[v1.RemoveUserGroupMemberRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceRemoveUserGroupMemberProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RemoveUserGroupMember")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	listUsers                 *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	batchGetUsers             *connect.Client[v1.BatchGetUsersRequest, v1.BatchGetUsersResponse]
-	getUser                   *connect.Client[v1.GetUserRequest, v1.User]
-	createUser                *connect.Client[v1.CreateUserRequest, v1.User]
-	updateUser                *connect.Client[v1.UpdateUserRequest, v1.User]
-	deleteUser                *connect.Client[v1.DeleteUserRequest, emptypb.Empty]
-	listAllUserStats          *connect.Client[v1.ListAllUserStatsRequest, v1.ListAllUserStatsResponse]
-	getUserStats              *connect.Client[v1.GetUserStatsRequest, v1.UserStats]
-	getUserSetting            *connect.Client[v1.GetUserSettingRequest, v1.UserSetting]
-	updateUserSetting         *connect.Client[v1.UpdateUserSettingRequest, v1.UserSetting]
-	listUserSettings          *connect.Client[v1.ListUserSettingsRequest, v1.ListUserSettingsResponse]
-	listLinkedIdentities      *connect.Client[v1.ListLinkedIdentitiesRequest, v1.ListLinkedIdentitiesResponse]
-	createLinkedIdentity      *connect.Client[v1.CreateLinkedIdentityRequest, v1.LinkedIdentity]
-	getLinkedIdentity         *connect.Client[v1.GetLinkedIdentityRequest, v1.LinkedIdentity]
-	deleteLinkedIdentity      *connect.Client[v1.DeleteLinkedIdentityRequest, emptypb.Empty]
-	listPersonalAccessTokens  *connect.Client[v1.ListPersonalAccessTokensRequest, v1.ListPersonalAccessTokensResponse]
-	createPersonalAccessToken *connect.Client[v1.CreatePersonalAccessTokenRequest, v1.CreatePersonalAccessTokenResponse]
-	deletePersonalAccessToken *connect.Client[v1.DeletePersonalAccessTokenRequest, emptypb.Empty]
-	listUserWebhooks          *connect.Client[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse]
-	createUserWebhook         *connect.Client[v1.CreateUserWebhookRequest, v1.UserWebhook]
-	updateUserWebhook         *connect.Client[v1.UpdateUserWebhookRequest, v1.UserWebhook]
-	deleteUserWebhook         *connect.Client[v1.DeleteUserWebhookRequest, emptypb.Empty]
-	listUserNotifications     *connect.Client[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse]
-	updateUserNotification    *connect.Client[v1.UpdateUserNotificationRequest, v1.UserNotification]
-	deleteUserNotification    *connect.Client[v1.DeleteUserNotificationRequest, emptypb.Empty]
+	listUsers                  *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	batchGetUsers              *connect.Client[v1.BatchGetUsersRequest, v1.BatchGetUsersResponse]
+	getUser                    *connect.Client[v1.GetUserRequest, v1.User]
+	createUser                 *connect.Client[v1.CreateUserRequest, v1.User]
+	updateUser                 *connect.Client[v1.UpdateUserRequest, v1.User]
+	deleteUser                 *connect.Client[v1.DeleteUserRequest, emptypb.Empty]
+	unlockUser                 *connect.Client[v1.UnlockUserRequest, emptypb.Empty]
+	listAllUserStats           *connect.Client[v1.ListAllUserStatsRequest, v1.ListAllUserStatsResponse]
+	getUserStats               *connect.Client[v1.GetUserStatsRequest, v1.UserStats]
+	getUserSetting             *connect.Client[v1.GetUserSettingRequest, v1.UserSetting]
+	updateUserSetting          *connect.Client[v1.UpdateUserSettingRequest, v1.UserSetting]
+	listUserSettings           *connect.Client[v1.ListUserSettingsRequest, v1.ListUserSettingsResponse]
+	listLinkedIdentities       *connect.Client[v1.ListLinkedIdentitiesRequest, v1.ListLinkedIdentitiesResponse]
+	createLinkedIdentity       *connect.Client[v1.CreateLinkedIdentityRequest, v1.LinkedIdentity]
+	getLinkedIdentity          *connect.Client[v1.GetLinkedIdentityRequest, v1.LinkedIdentity]
+	deleteLinkedIdentity       *connect.Client[v1.DeleteLinkedIdentityRequest, emptypb.Empty]
+	listPersonalAccessTokens   *connect.Client[v1.ListPersonalAccessTokensRequest, v1.ListPersonalAccessTokensResponse]
+	createPersonalAccessToken  *connect.Client[v1.CreatePersonalAccessTokenRequest, v1.CreatePersonalAccessTokenResponse]
+	deletePersonalAccessToken  *connect.Client[v1.DeletePersonalAccessTokenRequest, emptypb.Empty]
+	listSessions               *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	revokeSession              *connect.Client[v1.RevokeSessionRequest, emptypb.Empty]
+	revokeAllSessions          *connect.Client[v1.RevokeAllSessionsRequest, emptypb.Empty]
+	listUserWebhooks           *connect.Client[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse]
+	createUserWebhook          *connect.Client[v1.CreateUserWebhookRequest, v1.UserWebhook]
+	updateUserWebhook          *connect.Client[v1.UpdateUserWebhookRequest, v1.UserWebhook]
+	deleteUserWebhook          *connect.Client[v1.DeleteUserWebhookRequest, emptypb.Empty]
+	listWebhookDeliveries      *connect.Client[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]
+	redeliverWebhook           *connect.Client[v1.RedeliverWebhookRequest, v1.WebhookDelivery]
+	listUserNotifications      *connect.Client[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse]
+	updateUserNotification     *connect.Client[v1.UpdateUserNotificationRequest, v1.UserNotification]
+	deleteUserNotification     *connect.Client[v1.DeleteUserNotificationRequest, emptypb.Empty]
+	sendTestNotification       *connect.Client[v1.SendTestNotificationRequest, emptypb.Empty]
+	listUserPushSubscriptions  *connect.Client[v1.ListUserPushSubscriptionsRequest, v1.ListUserPushSubscriptionsResponse]
+	createUserPushSubscription *connect.Client[v1.CreateUserPushSubscriptionRequest, v1.UserPushSubscription]
+	deleteUserPushSubscription *connect.Client[v1.DeleteUserPushSubscriptionRequest, emptypb.Empty]
+	listUserGroups             *connect.Client[v1.ListUserGroupsRequest, v1.ListUserGroupsResponse]
+	getUserGroup               *connect.Client[v1.GetUserGroupRequest, v1.UserGroup]
+	createUserGroup            *connect.Client[v1.CreateUserGroupRequest, v1.UserGroup]
+	updateUserGroup            *connect.Client[v1.UpdateUserGroupRequest, v1.UserGroup]
+	deleteUserGroup            *connect.Client[v1.DeleteUserGroupRequest, emptypb.Empty]
+	listUserGroupMembers       *connect.Client[v1.ListUserGroupMembersRequest, v1.ListUserGroupMembersResponse]
+	addUserGroupMember         *connect.Client[v1.AddUserGroupMemberRequest, v1.UserGroupMember]
+	removeUserGroupMember      *connect.Client[v1.RemoveUserGroupMemberRequest, emptypb.Empty]
 }
 
 // ListUsers calls memos.api.v1.UserService.ListUsers.
@@ -386,6 +613,11 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, req *connect.Request
 	return c.deleteUser.CallUnary(ctx, req)
 }
 
+// UnlockUser calls memos.api.v1.UserService.UnlockUser.
+func (c *userServiceClient) UnlockUser(ctx context.Context, req *connect.Request[v1.UnlockUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.unlockUser.CallUnary(ctx, req)
+}
+
 // ListAllUserStats calls memos.api.v1.UserService.ListAllUserStats.
 func (c *userServiceClient) ListAllUserStats(ctx context.Context, req *connect.Request[v1.ListAllUserStatsRequest]) (*connect.Response[v1.ListAllUserStatsResponse], error) {
 	return c.listAllUserStats.CallUnary(ctx, req)
@@ -446,6 +678,21 @@ func (c *userServiceClient) DeletePersonalAccessToken(ctx context.Context, req *
 	return c.deletePersonalAccessToken.CallUnary(ctx, req)
 }
 
+// ListSessions calls memos.api.v1.UserService.ListSessions.
+func (c *userServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls memos.api.v1.UserService.RevokeSession.
+func (c *userServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// RevokeAllSessions calls memos.api.v1.UserService.RevokeAllSessions.
+func (c *userServiceClient) RevokeAllSessions(ctx context.Context, req *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.revokeAllSessions.CallUnary(ctx, req)
+}
+
 // ListUserWebhooks calls memos.api.v1.UserService.ListUserWebhooks.
 func (c *userServiceClient) ListUserWebhooks(ctx context.Context, req *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error) {
 	return c.listUserWebhooks.CallUnary(ctx, req)
@@ -466,6 +713,16 @@ func (c *userServiceClient) DeleteUserWebhook(ctx context.Context, req *connect.
 	return c.deleteUserWebhook.CallUnary(ctx, req)
 }
 
+// ListWebhookDeliveries calls memos.api.v1.UserService.ListWebhookDeliveries.
+func (c *userServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// RedeliverWebhook calls memos.api.v1.UserService.RedeliverWebhook.
+func (c *userServiceClient) RedeliverWebhook(ctx context.Context, req *connect.Request[v1.RedeliverWebhookRequest]) (*connect.Response[v1.WebhookDelivery], error) {
+	return c.redeliverWebhook.CallUnary(ctx, req)
+}
+
 // ListUserNotifications calls memos.api.v1.UserService.ListUserNotifications.
 func (c *userServiceClient) ListUserNotifications(ctx context.Context, req *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error) {
 	return c.listUserNotifications.CallUnary(ctx, req)
@@ -481,6 +738,66 @@ func (c *userServiceClient) DeleteUserNotification(ctx context.Context, req *con
 	return c.deleteUserNotification.CallUnary(ctx, req)
 }
 
+// SendTestNotification calls memos.api.v1.UserService.SendTestNotification.
+func (c *userServiceClient) SendTestNotification(ctx context.Context, req *connect.Request[v1.SendTestNotificationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.sendTestNotification.CallUnary(ctx, req)
+}
+
+// ListUserPushSubscriptions calls memos.api.v1.UserService.ListUserPushSubscriptions.
+func (c *userServiceClient) ListUserPushSubscriptions(ctx context.Context, req *connect.Request[v1.ListUserPushSubscriptionsRequest]) (*connect.Response[v1.ListUserPushSubscriptionsResponse], error) {
+	return c.listUserPushSubscriptions.CallUnary(ctx, req)
+}
+
+// CreateUserPushSubscription calls memos.api.v1.UserService.CreateUserPushSubscription.
+func (c *userServiceClient) CreateUserPushSubscription(ctx context.Context, req *connect.Request[v1.CreateUserPushSubscriptionRequest]) (*connect.Response[v1.UserPushSubscription], error) {
+	return c.createUserPushSubscription.CallUnary(ctx, req)
+}
+
+// DeleteUserPushSubscription calls memos.api.v1.UserService.DeleteUserPushSubscription.
+func (c *userServiceClient) DeleteUserPushSubscription(ctx context.Context, req *connect.Request[v1.DeleteUserPushSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteUserPushSubscription.CallUnary(ctx, req)
+}
+
+// ListUserGroups calls memos.api.v1.UserService.ListUserGroups.
+func (c *userServiceClient) ListUserGroups(ctx context.Context, req *connect.Request[v1.ListUserGroupsRequest]) (*connect.Response[v1.ListUserGroupsResponse], error) {
+	return c.listUserGroups.CallUnary(ctx, req)
+}
+
+// GetUserGroup calls memos.api.v1.UserService.GetUserGroup.
+func (c *userServiceClient) GetUserGroup(ctx context.Context, req *connect.Request[v1.GetUserGroupRequest]) (*connect.Response[v1.UserGroup], error) {
+	return c.getUserGroup.CallUnary(ctx, req)
+}
+
+// CreateUserGroup calls memos.api.v1.UserService.CreateUserGroup.
+func (c *userServiceClient) CreateUserGroup(ctx context.Context, req *connect.Request[v1.CreateUserGroupRequest]) (*connect.Response[v1.UserGroup], error) {
+	return c.createUserGroup.CallUnary(ctx, req)
+}
+
+// UpdateUserGroup calls memos.api.v1.UserService.UpdateUserGroup.
+func (c *userServiceClient) UpdateUserGroup(ctx context.Context, req *connect.Request[v1.UpdateUserGroupRequest]) (*connect.Response[v1.UserGroup], error) {
+	return c.updateUserGroup.CallUnary(ctx, req)
+}
+
+// DeleteUserGroup calls memos.api.v1.UserService.DeleteUserGroup.
+func (c *userServiceClient) DeleteUserGroup(ctx context.Context, req *connect.Request[v1.DeleteUserGroupRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteUserGroup.CallUnary(ctx, req)
+}
+
+// ListUserGroupMembers calls memos.api.v1.UserService.ListUserGroupMembers.
+func (c *userServiceClient) ListUserGroupMembers(ctx context.Context, req *connect.Request[v1.ListUserGroupMembersRequest]) (*connect.Response[v1.ListUserGroupMembersResponse], error) {
+	return c.listUserGroupMembers.CallUnary(ctx, req)
+}
+
+// AddUserGroupMember calls memos.api.v1.UserService.AddUserGroupMember.
+func (c *userServiceClient) AddUserGroupMember(ctx context.Context, req *connect.Request[v1.AddUserGroupMemberRequest]) (*connect.Response[v1.UserGroupMember], error) {
+	return c.addUserGroupMember.CallUnary(ctx, req)
+}
+
+// RemoveUserGroupMember calls memos.api.v1.UserService.RemoveUserGroupMember.
+func (c *userServiceClient) RemoveUserGroupMember(ctx context.Context, req *connect.Request[v1.RemoveUserGroupMemberRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeUserGroupMember.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the memos.api.v1.UserService service.
 type UserServiceHandler interface {
 	// ListUsers returns a list of users.
@@ -496,6 +813,9 @@ type UserServiceHandler interface {
 	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.User], error)
 	// DeleteUser deletes a user.
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[emptypb.Empty], error)
+	// UnlockUser clears the failed sign-in attempts of a user, lifting any lockout.
+	// Only admins can unlock users.
+	UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListAllUserStats returns statistics for all users.
 	ListAllUserStats(context.Context, *connect.Request[v1.ListAllUserStatsRequest]) (*connect.Response[v1.ListAllUserStatsResponse], error)
 	// GetUserStats returns statistics for a specific user.
@@ -522,6 +842,13 @@ type UserServiceHandler interface {
 	CreatePersonalAccessToken(context.Context, *connect.Request[v1.CreatePersonalAccessTokenRequest]) (*connect.Response[v1.CreatePersonalAccessTokenResponse], error)
 	// DeletePersonalAccessToken deletes a Personal Access Token.
 	DeletePersonalAccessToken(context.Context, *connect.Request[v1.DeletePersonalAccessTokenRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListSessions returns the active sign-in sessions of a user.
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// RevokeSession signs out a single session.
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[emptypb.Empty], error)
+	// RevokeAllSessions signs a user out everywhere.
+	// Admins may also use it to revoke the sessions and personal access tokens of other users.
+	RevokeAllSessions(context.Context, *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListUserWebhooks returns a list of webhooks for a user.
 	ListUserWebhooks(context.Context, *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error)
 	// CreateUserWebhook creates a new webhook for a user.
@@ -530,12 +857,50 @@ type UserServiceHandler interface {
 	UpdateUserWebhook(context.Context, *connect.Request[v1.UpdateUserWebhookRequest]) (*connect.Response[v1.UserWebhook], error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *connect.Request[v1.DeleteUserWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListWebhookDeliveries lists the deliveries of a webhook, newest first.
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	// RedeliverWebhook queues a delivery to be sent again with the same delivery ID.
+	RedeliverWebhook(context.Context, *connect.Request[v1.RedeliverWebhookRequest]) (*connect.Response[v1.WebhookDelivery], error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error)
 	// UpdateUserNotification updates a notification.
 	UpdateUserNotification(context.Context, *connect.Request[v1.UpdateUserNotificationRequest]) (*connect.Response[v1.UserNotification], error)
 	// DeleteUserNotification deletes a notification.
 	DeleteUserNotification(context.Context, *connect.Request[v1.DeleteUserNotificationRequest]) (*connect.Response[emptypb.Empty], error)
+	// SendTestNotification sends a test notification to the user's notification channels,
+	// or to the given channel before it is saved.
+	SendTestNotification(context.Context, *connect.Request[v1.SendTestNotificationRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserPushSubscriptions lists the devices a user registered for Web Push notifications.
+	ListUserPushSubscriptions(context.Context, *connect.Request[v1.ListUserPushSubscriptionsRequest]) (*connect.Response[v1.ListUserPushSubscriptionsResponse], error)
+	// CreateUserPushSubscription registers a device for Web Push notifications.
+	// Registering an endpoint again replaces its keys.
+	CreateUserPushSubscription(context.Context, *connect.Request[v1.CreateUserPushSubscriptionRequest]) (*connect.Response[v1.UserPushSubscription], error)
+	// DeleteUserPushSubscription unregisters a device from Web Push notifications.
+	DeleteUserPushSubscription(context.Context, *connect.Request[v1.DeleteUserPushSubscriptionRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserGroups lists user groups.
+	// User managers see every group; other users see the groups they belong to.
+	ListUserGroups(context.Context, *connect.Request[v1.ListUserGroupsRequest]) (*connect.Response[v1.ListUserGroupsResponse], error)
+	// GetUserGroup gets a user group.
+	// User managers can get any group; other users can get the groups they belong to.
+	GetUserGroup(context.Context, *connect.Request[v1.GetUserGroupRequest]) (*connect.Response[v1.UserGroup], error)
+	// CreateUserGroup creates a user group.
+	// Requires the MANAGE_USERS permission.
+	CreateUserGroup(context.Context, *connect.Request[v1.CreateUserGroupRequest]) (*connect.Response[v1.UserGroup], error)
+	// UpdateUserGroup updates the description of a user group.
+	// Requires the MANAGE_USERS permission.
+	UpdateUserGroup(context.Context, *connect.Request[v1.UpdateUserGroupRequest]) (*connect.Response[v1.UserGroup], error)
+	// DeleteUserGroup deletes a user group and its memberships.
+	// Requires the MANAGE_USERS permission.
+	DeleteUserGroup(context.Context, *connect.Request[v1.DeleteUserGroupRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserGroupMembers lists the members of a user group.
+	// User managers can list any group; other users can list the groups they belong to.
+	ListUserGroupMembers(context.Context, *connect.Request[v1.ListUserGroupMembersRequest]) (*connect.Response[v1.ListUserGroupMembersResponse], error)
+	// AddUserGroupMember adds a user to a group.
+	// Requires the MANAGE_USERS permission.
+	AddUserGroupMember(context.Context, *connect.Request[v1.AddUserGroupMemberRequest]) (*connect.Response[v1.UserGroupMember], error)
+	// RemoveUserGroupMember removes a user from a group.
+	// Requires the MANAGE_USERS permission.
+	RemoveUserGroupMember(context.Context, *connect.Request[v1.RemoveUserGroupMemberRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -581,6 +946,12 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeleteUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceUnlockUserHandler := connect.NewUnaryHandler(
+		UserServiceUnlockUserProcedure,
+		svc.UnlockUser,
+		connect.WithSchema(userServiceMethods.ByName("UnlockUser")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceListAllUserStatsHandler := connect.NewUnaryHandler(
 		UserServiceListAllUserStatsProcedure,
 		svc.ListAllUserStats,
@@ -653,6 +1024,24 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeletePersonalAccessToken")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceListSessionsHandler := connect.NewUnaryHandler(
+		UserServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(userServiceMethods.ByName("ListSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		UserServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(userServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeAllSessionsHandler := connect.NewUnaryHandler(
+		UserServiceRevokeAllSessionsProcedure,
+		svc.RevokeAllSessions,
+		connect.WithSchema(userServiceMethods.ByName("RevokeAllSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceListUserWebhooksHandler := connect.NewUnaryHandler(
 		UserServiceListUserWebhooksProcedure,
 		svc.ListUserWebhooks,
@@ -677,6 +1066,18 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeleteUserWebhook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		UserServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(userServiceMethods.ByName("ListWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRedeliverWebhookHandler := connect.NewUnaryHandler(
+		UserServiceRedeliverWebhookProcedure,
+		svc.RedeliverWebhook,
+		connect.WithSchema(userServiceMethods.ByName("RedeliverWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceListUserNotificationsHandler := connect.NewUnaryHandler(
 		UserServiceListUserNotificationsProcedure,
 		svc.ListUserNotifications,
@@ -695,6 +1096,78 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeleteUserNotification")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceSendTestNotificationHandler := connect.NewUnaryHandler(
+		UserServiceSendTestNotificationProcedure,
+		svc.SendTestNotification,
+		connect.WithSchema(userServiceMethods.ByName("SendTestNotification")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListUserPushSubscriptionsHandler := connect.NewUnaryHandler(
+		UserServiceListUserPushSubscriptionsProcedure,
+		svc.ListUserPushSubscriptions,
+		connect.WithSchema(userServiceMethods.ByName("ListUserPushSubscriptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateUserPushSubscriptionHandler := connect.NewUnaryHandler(
+		UserServiceCreateUserPushSubscriptionProcedure,
+		svc.CreateUserPushSubscription,
+		connect.WithSchema(userServiceMethods.ByName("CreateUserPushSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteUserPushSubscriptionHandler := connect.NewUnaryHandler(
+		UserServiceDeleteUserPushSubscriptionProcedure,
+		svc.DeleteUserPushSubscription,
+		connect.WithSchema(userServiceMethods.ByName("DeleteUserPushSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListUserGroupsHandler := connect.NewUnaryHandler(
+		UserServiceListUserGroupsProcedure,
+		svc.ListUserGroups,
+		connect.WithSchema(userServiceMethods.ByName("ListUserGroups")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetUserGroupHandler := connect.NewUnaryHandler(
+		UserServiceGetUserGroupProcedure,
+		svc.GetUserGroup,
+		connect.WithSchema(userServiceMethods.ByName("GetUserGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateUserGroupHandler := connect.NewUnaryHandler(
+		UserServiceCreateUserGroupProcedure,
+		svc.CreateUserGroup,
+		connect.WithSchema(userServiceMethods.ByName("CreateUserGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUpdateUserGroupHandler := connect.NewUnaryHandler(
+		UserServiceUpdateUserGroupProcedure,
+		svc.UpdateUserGroup,
+		connect.WithSchema(userServiceMethods.ByName("UpdateUserGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteUserGroupHandler := connect.NewUnaryHandler(
+		UserServiceDeleteUserGroupProcedure,
+		svc.DeleteUserGroup,
+		connect.WithSchema(userServiceMethods.ByName("DeleteUserGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListUserGroupMembersHandler := connect.NewUnaryHandler(
+		UserServiceListUserGroupMembersProcedure,
+		svc.ListUserGroupMembers,
+		connect.WithSchema(userServiceMethods.ByName("ListUserGroupMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceAddUserGroupMemberHandler := connect.NewUnaryHandler(
+		UserServiceAddUserGroupMemberProcedure,
+		svc.AddUserGroupMember,
+		connect.WithSchema(userServiceMethods.ByName("AddUserGroupMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRemoveUserGroupMemberHandler := connect.NewUnaryHandler(
+		UserServiceRemoveUserGroupMemberProcedure,
+		svc.RemoveUserGroupMember,
+		connect.WithSchema(userServiceMethods.ByName("RemoveUserGroupMember")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceListUsersProcedure:
@@ -709,6 +1182,8 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceUpdateUserHandler.ServeHTTP(w, r)
 		case UserServiceDeleteUserProcedure:
 			userServiceDeleteUserHandler.ServeHTTP(w, r)
+		case UserServiceUnlockUserProcedure:
+			userServiceUnlockUserHandler.ServeHTTP(w, r)
 		case UserServiceListAllUserStatsProcedure:
 			userServiceListAllUserStatsHandler.ServeHTTP(w, r)
 		case UserServiceGetUserStatsProcedure:
@@ -733,6 +1208,12 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceCreatePersonalAccessTokenHandler.ServeHTTP(w, r)
 		case UserServiceDeletePersonalAccessTokenProcedure:
 			userServiceDeletePersonalAccessTokenHandler.ServeHTTP(w, r)
+		case UserServiceListSessionsProcedure:
+			userServiceListSessionsHandler.ServeHTTP(w, r)
+		case UserServiceRevokeSessionProcedure:
+			userServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case UserServiceRevokeAllSessionsProcedure:
+			userServiceRevokeAllSessionsHandler.ServeHTTP(w, r)
 		case UserServiceListUserWebhooksProcedure:
 			userServiceListUserWebhooksHandler.ServeHTTP(w, r)
 		case UserServiceCreateUserWebhookProcedure:
@@ -741,12 +1222,40 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceUpdateUserWebhookHandler.ServeHTTP(w, r)
 		case UserServiceDeleteUserWebhookProcedure:
 			userServiceDeleteUserWebhookHandler.ServeHTTP(w, r)
+		case UserServiceListWebhookDeliveriesProcedure:
+			userServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case UserServiceRedeliverWebhookProcedure:
+			userServiceRedeliverWebhookHandler.ServeHTTP(w, r)
 		case UserServiceListUserNotificationsProcedure:
 			userServiceListUserNotificationsHandler.ServeHTTP(w, r)
 		case UserServiceUpdateUserNotificationProcedure:
 			userServiceUpdateUserNotificationHandler.ServeHTTP(w, r)
 		case UserServiceDeleteUserNotificationProcedure:
 			userServiceDeleteUserNotificationHandler.ServeHTTP(w, r)
+		case UserServiceSendTestNotificationProcedure:
+			userServiceSendTestNotificationHandler.ServeHTTP(w, r)
+		case UserServiceListUserPushSubscriptionsProcedure:
+			userServiceListUserPushSubscriptionsHandler.ServeHTTP(w, r)
+		case UserServiceCreateUserPushSubscriptionProcedure:
+			userServiceCreateUserPushSubscriptionHandler.ServeHTTP(w, r)
+		case UserServiceDeleteUserPushSubscriptionProcedure:
+			userServiceDeleteUserPushSubscriptionHandler.ServeHTTP(w, r)
+		case UserServiceListUserGroupsProcedure:
+			userServiceListUserGroupsHandler.ServeHTTP(w, r)
+		case UserServiceGetUserGroupProcedure:
+			userServiceGetUserGroupHandler.ServeHTTP(w, r)
+		case UserServiceCreateUserGroupProcedure:
+			userServiceCreateUserGroupHandler.ServeHTTP(w, r)
+		case UserServiceUpdateUserGroupProcedure:
+			userServiceUpdateUserGroupHandler.ServeHTTP(w, r)
+		case UserServiceDeleteUserGroupProcedure:
+			userServiceDeleteUserGroupHandler.ServeHTTP(w, r)
+		case UserServiceListUserGroupMembersProcedure:
+			userServiceListUserGroupMembersHandler.ServeHTTP(w, r)
+		case UserServiceAddUserGroupMemberProcedure:
+			userServiceAddUserGroupMemberHandler.ServeHTTP(w, r)
+		case UserServiceRemoveUserGroupMemberProcedure:
+			userServiceRemoveUserGroupMemberHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -780,6 +1289,10 @@ func (UnimplementedUserServiceHandler) DeleteUser(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUser is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.UnlockUser is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ListAllUserStats(context.Context, *connect.Request[v1.ListAllUserStatsRequest]) (*connect.Response[v1.ListAllUserStatsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListAllUserStats is not implemented"))
 }
@@ -828,6 +1341,18 @@ func (UnimplementedUserServiceHandler) DeletePersonalAccessToken(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeletePersonalAccessToken is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListSessions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeAllSessions(context.Context, *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RevokeAllSessions is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ListUserWebhooks(context.Context, *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserWebhooks is not implemented"))
 }
@@ -844,6 +1369,14 @@ func (UnimplementedUserServiceHandler) DeleteUserWebhook(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserWebhook is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RedeliverWebhook(context.Context, *connect.Request[v1.RedeliverWebhookRequest]) (*connect.Response[v1.WebhookDelivery], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RedeliverWebhook is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserNotifications is not implemented"))
 }
@@ -855,3 +1388,51 @@ func (UnimplementedUserServiceHandler) UpdateUserNotification(context.Context, *
 func (UnimplementedUserServiceHandler) DeleteUserNotification(context.Context, *connect.Request[v1.DeleteUserNotificationRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserNotification is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) SendTestNotification(context.Context, *connect.Request[v1.SendTestNotificationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.SendTestNotification is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListUserPushSubscriptions(context.Context, *connect.Request[v1.ListUserPushSubscriptionsRequest]) (*connect.Response[v1.ListUserPushSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserPushSubscriptions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateUserPushSubscription(context.Context, *connect.Request[v1.CreateUserPushSubscriptionRequest]) (*connect.Response[v1.UserPushSubscription], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.CreateUserPushSubscription is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteUserPushSubscription(context.Context, *connect.Request[v1.DeleteUserPushSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserPushSubscription is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListUserGroups(context.Context, *connect.Request[v1.ListUserGroupsRequest]) (*connect.Response[v1.ListUserGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserGroups is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetUserGroup(context.Context, *connect.Request[v1.GetUserGroupRequest]) (*connect.Response[v1.UserGroup], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GetUserGroup is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateUserGroup(context.Context, *connect.Request[v1.CreateUserGroupRequest]) (*connect.Response[v1.UserGroup], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.CreateUserGroup is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateUserGroup(context.Context, *connect.Request[v1.UpdateUserGroupRequest]) (*connect.Response[v1.UserGroup], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.UpdateUserGroup is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteUserGroup(context.Context, *connect.Request[v1.DeleteUserGroupRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserGroup is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListUserGroupMembers(context.Context, *connect.Request[v1.ListUserGroupMembersRequest]) (*connect.Response[v1.ListUserGroupMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserGroupMembers is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) AddUserGroupMember(context.Context, *connect.Request[v1.AddUserGroupMemberRequest]) (*connect.Response[v1.UserGroupMember], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.AddUserGroupMember is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RemoveUserGroupMember(context.Context, *connect.Request[v1.RemoveUserGroupMemberRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RemoveUserGroupMember is not implemented"))
+}
@@ -0,0 +1,467 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/v1/audit_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuditLog_Action int32
+
+const (
+	AuditLog_ACTION_UNSPECIFIED           AuditLog_Action = 0
+	AuditLog_SIGN_IN                      AuditLog_Action = 1
+	AuditLog_SIGN_OUT                     AuditLog_Action = 2
+	AuditLog_UPDATE_INSTANCE_SETTING      AuditLog_Action = 3
+	AuditLog_DELETE_USER                  AuditLog_Action = 4
+	AuditLog_CREATE_PERSONAL_ACCESS_TOKEN AuditLog_Action = 5
+	AuditLog_CREATE_IDENTITY_PROVIDER     AuditLog_Action = 6
+	AuditLog_UPDATE_IDENTITY_PROVIDER     AuditLog_Action = 7
+	AuditLog_DELETE_IDENTITY_PROVIDER     AuditLog_Action = 8
+	AuditLog_CREATE_MEMO_SHARE            AuditLog_Action = 9
+	AuditLog_CREATE_COLLECTION_SHARE      AuditLog_Action = 10
+)
+
+// Enum value maps for AuditLog_Action.
+var (
+	AuditLog_Action_name = map[int32]string{
+		0:  "ACTION_UNSPECIFIED",
+		1:  "SIGN_IN",
+		2:  "SIGN_OUT",
+		3:  "UPDATE_INSTANCE_SETTING",
+		4:  "DELETE_USER",
+		5:  "CREATE_PERSONAL_ACCESS_TOKEN",
+		6:  "CREATE_IDENTITY_PROVIDER",
+		7:  "UPDATE_IDENTITY_PROVIDER",
+		8:  "DELETE_IDENTITY_PROVIDER",
+		9:  "CREATE_MEMO_SHARE",
+		10: "CREATE_COLLECTION_SHARE",
+	}
+	AuditLog_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED":           0,
+		"SIGN_IN":                      1,
+		"SIGN_OUT":                     2,
+		"UPDATE_INSTANCE_SETTING":      3,
+		"DELETE_USER":                  4,
+		"CREATE_PERSONAL_ACCESS_TOKEN": 5,
+		"CREATE_IDENTITY_PROVIDER":     6,
+		"UPDATE_IDENTITY_PROVIDER":     7,
+		"DELETE_IDENTITY_PROVIDER":     8,
+		"CREATE_MEMO_SHARE":            9,
+		"CREATE_COLLECTION_SHARE":      10,
+	}
+)
+
+func (x AuditLog_Action) Enum() *AuditLog_Action {
+	p := new(AuditLog_Action)
+	*p = x
+	return p
+}
+
+func (x AuditLog_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLog_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_audit_service_proto_enumTypes[0].Descriptor()
+}
+
+func (AuditLog_Action) Type() protoreflect.EnumType {
+	return &file_api_v1_audit_service_proto_enumTypes[0]
+}
+
+func (x AuditLog_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLog_Action.Descriptor instead.
+func (AuditLog_Action) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_audit_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type AuditLog struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the audit log entry.
+	// Format: auditLogs/{audit_log}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The user who performed the action.
+	// Format: users/{user}. Empty when the actor is unknown, e.g. a failed sign-in.
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The action that was performed.
+	Action AuditLog_Action `protobuf:"varint,3,opt,name=action,proto3,enum=memos.api.v1.AuditLog_Action" json:"action,omitempty"`
+	// The resource name the action applied to, e.g. users/1 or instance/settings/GENERAL.
+	Resource string `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	// Additional human-readable context about the action.
+	Detail string `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	// The client IP address the request came from.
+	IpAddress string `protobuf:"bytes,6,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// The client user agent.
+	UserAgent string `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// The time the action was performed.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_api_v1_audit_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_audit_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_api_v1_audit_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditLog) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuditLog) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditLog) GetAction() AuditLog_Action {
+	if x != nil {
+		return x.Action
+	}
+	return AuditLog_ACTION_UNSPECIFIED
+}
+
+func (x *AuditLog) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AuditLog) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AuditLog) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *AuditLog) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditLog) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListAuditLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of entries to return.
+	// The service may return fewer than this value.
+	// If unspecified, at most 10 entries will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListAuditLogs` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Only return entries performed by this user.
+	// Format: users/{user}
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Optional. Only return entries of this action.
+	Action AuditLog_Action `protobuf:"varint,4,opt,name=action,proto3,enum=memos.api.v1.AuditLog_Action" json:"action,omitempty"`
+	// Optional. Only return entries created at or after this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Optional. Only return entries created before this time.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsRequest) Reset() {
+	*x = ListAuditLogsRequest{}
+	mi := &file_api_v1_audit_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsRequest) ProtoMessage() {}
+
+func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_audit_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_audit_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAuditLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetAction() AuditLog_Action {
+	if x != nil {
+		return x.Action
+	}
+	return AuditLog_ACTION_UNSPECIFIED
+}
+
+func (x *ListAuditLogsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAuditLogsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListAuditLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of audit log entries.
+	AuditLogs []*AuditLog `protobuf:"bytes,1,rep,name=audit_logs,json=auditLogs,proto3" json:"audit_logs,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsResponse) Reset() {
+	*x = ListAuditLogsResponse{}
+	mi := &file_api_v1_audit_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsResponse) ProtoMessage() {}
+
+func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_audit_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_audit_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAuditLogsResponse) GetAuditLogs() []*AuditLog {
+	if x != nil {
+		return x.AuditLogs
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_api_v1_audit_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_audit_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapi/v1/audit_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\x05\n" +
+	"\bAuditLog\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
+	"\x05actor\x18\x02 \x01(\tB\x03\xe0A\x03R\x05actor\x12:\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x1d.memos.api.v1.AuditLog.ActionB\x03\xe0A\x03R\x06action\x12\x1f\n" +
+	"\bresource\x18\x04 \x01(\tB\x03\xe0A\x03R\bresource\x12\x1b\n" +
+	"\x06detail\x18\x05 \x01(\tB\x03\xe0A\x03R\x06detail\x12\"\n" +
+	"\n" +
+	"ip_address\x18\x06 \x01(\tB\x03\xe0A\x03R\tipAddress\x12\"\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tB\x03\xe0A\x03R\tuserAgent\x12@\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\"\x99\x02\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aSIGN_IN\x10\x01\x12\f\n" +
+	"\bSIGN_OUT\x10\x02\x12\x1b\n" +
+	"\x17UPDATE_INSTANCE_SETTING\x10\x03\x12\x0f\n" +
+	"\vDELETE_USER\x10\x04\x12 \n" +
+	"\x1cCREATE_PERSONAL_ACCESS_TOKEN\x10\x05\x12\x1c\n" +
+	"\x18CREATE_IDENTITY_PROVIDER\x10\x06\x12\x1c\n" +
+	"\x18UPDATE_IDENTITY_PROVIDER\x10\a\x12\x1c\n" +
+	"\x18DELETE_IDENTITY_PROVIDER\x10\b\x12\x15\n" +
+	"\x11CREATE_MEMO_SHARE\x10\t\x12\x1b\n" +
+	"\x17CREATE_COLLECTION_SHARE\x10\n" +
+	":L\xeaAI\n" +
+	"\x15memos.api.v1/AuditLog\x12\x15auditLogs/{audit_log}\x1a\x04name*\tauditLogs2\bauditLog\"\xaf\x02\n" +
+	"\x14ListAuditLogsRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x19\n" +
+	"\x05actor\x18\x03 \x01(\tB\x03\xe0A\x01R\x05actor\x12:\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x1d.memos.api.v1.AuditLog.ActionB\x03\xe0A\x01R\x06action\x12>\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\tstartTime\x12:\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\aendTime\"v\n" +
+	"\x15ListAuditLogsResponse\x125\n" +
+	"\n" +
+	"audit_logs\x18\x01 \x03(\v2\x16.memos.api.v1.AuditLogR\tauditLogs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\x83\x01\n" +
+	"\fAuditService\x12s\n" +
+	"\rListAuditLogs\x12\".memos.api.v1.ListAuditLogsRequest\x1a#.memos.api.v1.ListAuditLogsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/auditLogsB\xa9\x01\n" +
+	"\x10com.memos.api.v1B\x11AuditServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_audit_service_proto_rawDescOnce sync.Once
+	file_api_v1_audit_service_proto_rawDescData []byte
+)
+
+func file_api_v1_audit_service_proto_rawDescGZIP() []byte {
+	file_api_v1_audit_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_audit_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_audit_service_proto_rawDesc), len(file_api_v1_audit_service_proto_rawDesc)))
+	})
+	return file_api_v1_audit_service_proto_rawDescData
+}
+
+var file_api_v1_audit_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_v1_audit_service_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_v1_audit_service_proto_goTypes = []any{
+	(AuditLog_Action)(0),          // 0: memos.api.v1.AuditLog.Action
+	(*AuditLog)(nil),              // 1: memos.api.v1.AuditLog
+	(*ListAuditLogsRequest)(nil),  // 2: memos.api.v1.ListAuditLogsRequest
+	(*ListAuditLogsResponse)(nil), // 3: memos.api.v1.ListAuditLogsResponse
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_api_v1_audit_service_proto_depIdxs = []int32{
+	0, // 0: memos.api.v1.AuditLog.action:type_name -> memos.api.v1.AuditLog.Action
+	4, // 1: memos.api.v1.AuditLog.create_time:type_name -> google.protobuf.Timestamp
+	0, // 2: memos.api.v1.ListAuditLogsRequest.action:type_name -> memos.api.v1.AuditLog.Action
+	4, // 3: memos.api.v1.ListAuditLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	4, // 4: memos.api.v1.ListAuditLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	1, // 5: memos.api.v1.ListAuditLogsResponse.audit_logs:type_name -> memos.api.v1.AuditLog
+	2, // 6: memos.api.v1.AuditService.ListAuditLogs:input_type -> memos.api.v1.ListAuditLogsRequest
+	3, // 7: memos.api.v1.AuditService.ListAuditLogs:output_type -> memos.api.v1.ListAuditLogsResponse
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_audit_service_proto_init() }
+func file_api_v1_audit_service_proto_init() {
+	if File_api_v1_audit_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_audit_service_proto_rawDesc), len(file_api_v1_audit_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_audit_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_audit_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_audit_service_proto_enumTypes,
+		MessageInfos:      file_api_v1_audit_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_audit_service_proto = out.File
+	file_api_v1_audit_service_proto_goTypes = nil
+	file_api_v1_audit_service_proto_depIdxs = nil
+}
@@ -0,0 +1,437 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/v1/invitation_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Invitation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the invitation.
+	// Format: invitations/{invitation}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The code to pass as invitation_code when creating a user.
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// The resource name of the user who created the invitation.
+	// Format: users/{user}
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The role assigned to users who register with the invitation.
+	// Defaults to USER.
+	Role User_Role `protobuf:"varint,4,opt,name=role,proto3,enum=memos.api.v1.User_Role" json:"role,omitempty"`
+	// If set, only a user registering with this email can use the invitation.
+	Email string `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	// The number of accounts that can be registered with the invitation.
+	// Defaults to 1.
+	MaxUses int32 `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// The number of accounts registered with the invitation so far.
+	UseCount int32 `protobuf:"varint,7,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
+	// The creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The expiration timestamp. The invitation never expires if not set.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expire_time,json=expireTime,proto3,oneof" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_api_v1_invitation_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_invitation_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_api_v1_invitation_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invitation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Invitation) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Invitation) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Invitation) GetRole() User_Role {
+	if x != nil {
+		return x.Role
+	}
+	return User_ROLE_UNSPECIFIED
+}
+
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Invitation) GetUseCount() int32 {
+	if x != nil {
+		return x.UseCount
+	}
+	return 0
+}
+
+func (x *Invitation) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Invitation) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type ListInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_api_v1_invitation_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_invitation_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_invitation_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ListInvitationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of invitations.
+	Invitations   []*Invitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_api_v1_invitation_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_invitation_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_invitation_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type CreateInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The invitation to create.
+	Invitation *Invitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	// Optional. If set, the invitation link is sent to the invitation email.
+	// Requires email delivery to be configured and the invitation email to be set.
+	SendEmail     bool `protobuf:"varint,2,opt,name=send_email,json=sendEmail,proto3" json:"send_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationRequest) Reset() {
+	*x = CreateInvitationRequest{}
+	mi := &file_api_v1_invitation_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationRequest) ProtoMessage() {}
+
+func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_invitation_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_invitation_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateInvitationRequest) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+func (x *CreateInvitationRequest) GetSendEmail() bool {
+	if x != nil {
+		return x.SendEmail
+	}
+	return false
+}
+
+type RevokeInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the invitation to revoke.
+	// Format: invitations/{invitation}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_api_v1_invitation_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_invitation_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_invitation_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RevokeInvitationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_api_v1_invitation_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_invitation_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1fapi/v1/invitation_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x03\n" +
+	"\n" +
+	"Invitation\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x17\n" +
+	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x03R\x04code\x12\x1d\n" +
+	"\acreator\x18\x03 \x01(\tB\x03\xe0A\x03R\acreator\x120\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x17.memos.api.v1.User.RoleB\x03\xe0A\x01R\x04role\x12\x19\n" +
+	"\x05email\x18\x05 \x01(\tB\x03\xe0A\x01R\x05email\x12\x1e\n" +
+	"\bmax_uses\x18\x06 \x01(\x05B\x03\xe0A\x01R\amaxUses\x12 \n" +
+	"\tuse_count\x18\a \x01(\x05B\x03\xe0A\x03R\buseCount\x12@\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12E\n" +
+	"\vexpire_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01H\x00R\n" +
+	"expireTime\x88\x01\x01:O\xeaAL\n" +
+	"\x17memos.api.v1/Invitation\x12\x18invitations/{invitation}*\vinvitations2\n" +
+	"invitationB\x0e\n" +
+	"\f_expire_time\"\x18\n" +
+	"\x16ListInvitationsRequest\"U\n" +
+	"\x17ListInvitationsResponse\x12:\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x18.memos.api.v1.InvitationR\vinvitations\"|\n" +
+	"\x17CreateInvitationRequest\x12=\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x18.memos.api.v1.InvitationB\x03\xe0A\x02R\n" +
+	"invitation\x12\"\n" +
+	"\n" +
+	"send_email\x18\x02 \x01(\bB\x03\xe0A\x01R\tsendEmail\"N\n" +
+	"\x17RevokeInvitationRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/InvitationR\x04name2\x9c\x03\n" +
+	"\x11InvitationService\x12{\n" +
+	"\x0fListInvitations\x12$.memos.api.v1.ListInvitationsRequest\x1a%.memos.api.v1.ListInvitationsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/invitations\x12\x89\x01\n" +
+	"\x10CreateInvitation\x12%.memos.api.v1.CreateInvitationRequest\x1a\x18.memos.api.v1.Invitation\"4\xdaA\n" +
+	"invitation\x82\xd3\xe4\x93\x02!:\n" +
+	"invitation\"\x13/api/v1/invitations\x12~\n" +
+	"\x10RevokeInvitation\x12%.memos.api.v1.RevokeInvitationRequest\x1a\x16.google.protobuf.Empty\"+\xdaA\x04name\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/{name=invitations/*}B\xae\x01\n" +
+	"\x10com.memos.api.v1B\x16InvitationServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_invitation_service_proto_rawDescOnce sync.Once
+	file_api_v1_invitation_service_proto_rawDescData []byte
+)
+
+func file_api_v1_invitation_service_proto_rawDescGZIP() []byte {
+	file_api_v1_invitation_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_invitation_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_invitation_service_proto_rawDesc), len(file_api_v1_invitation_service_proto_rawDesc)))
+	})
+	return file_api_v1_invitation_service_proto_rawDescData
+}
+
+var file_api_v1_invitation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_v1_invitation_service_proto_goTypes = []any{
+	(*Invitation)(nil),              // 0: memos.api.v1.Invitation
+	(*ListInvitationsRequest)(nil),  // 1: memos.api.v1.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil), // 2: memos.api.v1.ListInvitationsResponse
+	(*CreateInvitationRequest)(nil), // 3: memos.api.v1.CreateInvitationRequest
+	(*RevokeInvitationRequest)(nil), // 4: memos.api.v1.RevokeInvitationRequest
+	(User_Role)(0),                  // 5: memos.api.v1.User.Role
+	(*timestamppb.Timestamp)(nil),   // 6: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),           // 7: google.protobuf.Empty
+}
+var file_api_v1_invitation_service_proto_depIdxs = []int32{
+	5, // 0: memos.api.v1.Invitation.role:type_name -> memos.api.v1.User.Role
+	6, // 1: memos.api.v1.Invitation.create_time:type_name -> google.protobuf.Timestamp
+	6, // 2: memos.api.v1.Invitation.expire_time:type_name -> google.protobuf.Timestamp
+	0, // 3: memos.api.v1.ListInvitationsResponse.invitations:type_name -> memos.api.v1.Invitation
+	0, // 4: memos.api.v1.CreateInvitationRequest.invitation:type_name -> memos.api.v1.Invitation
+	1, // 5: memos.api.v1.InvitationService.ListInvitations:input_type -> memos.api.v1.ListInvitationsRequest
+	3, // 6: memos.api.v1.InvitationService.CreateInvitation:input_type -> memos.api.v1.CreateInvitationRequest
+	4, // 7: memos.api.v1.InvitationService.RevokeInvitation:input_type -> memos.api.v1.RevokeInvitationRequest
+	2, // 8: memos.api.v1.InvitationService.ListInvitations:output_type -> memos.api.v1.ListInvitationsResponse
+	0, // 9: memos.api.v1.InvitationService.CreateInvitation:output_type -> memos.api.v1.Invitation
+	7, // 10: memos.api.v1.InvitationService.RevokeInvitation:output_type -> google.protobuf.Empty
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_invitation_service_proto_init() }
+func file_api_v1_invitation_service_proto_init() {
+	if File_api_v1_invitation_service_proto != nil {
+		return
+	}
+	file_api_v1_user_service_proto_init()
+	file_api_v1_invitation_service_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_invitation_service_proto_rawDesc), len(file_api_v1_invitation_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_invitation_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_invitation_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_invitation_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_invitation_service_proto = out.File
+	file_api_v1_invitation_service_proto_goTypes = nil
+	file_api_v1_invitation_service_proto_depIdxs = nil
+}
@@ -32,6 +32,8 @@ const (
 	Visibility_PRIVATE                Visibility = 1
 	Visibility_PROTECTED              Visibility = 2
 	Visibility_PUBLIC                 Visibility = 3
+	// Visible to the creator and the members of the memo's audience groups.
+	Visibility_GROUP Visibility = 4
 )
 
 // Enum value maps for Visibility.
@@ -41,12 +43,14 @@ var (
 		1: "PRIVATE",
 		2: "PROTECTED",
 		3: "PUBLIC",
+		4: "GROUP",
 	}
 	Visibility_value = map[string]int32{
 		"VISIBILITY_UNSPECIFIED": 0,
 		"PRIVATE":                1,
 		"PROTECTED":              2,
 		"PUBLIC":                 3,
+		"GROUP":                  4,
 	}
 )
 
@@ -127,6 +131,58 @@ func (MemoRelation_Type) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12, 0}
 }
 
+// Role is the access level granted to a collaborator.
+type MemoCollaborator_Role int32
+
+const (
+	MemoCollaborator_ROLE_UNSPECIFIED MemoCollaborator_Role = 0
+	// The collaborator can read the memo regardless of its visibility.
+	MemoCollaborator_VIEWER MemoCollaborator_Role = 1
+	// The collaborator can also edit the memo's content and attachments.
+	MemoCollaborator_EDITOR MemoCollaborator_Role = 2
+)
+
+// Enum value maps for MemoCollaborator_Role.
+var (
+	MemoCollaborator_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "VIEWER",
+		2: "EDITOR",
+	}
+	MemoCollaborator_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"VIEWER":           1,
+		"EDITOR":           2,
+	}
+)
+
+func (x MemoCollaborator_Role) Enum() *MemoCollaborator_Role {
+	p := new(MemoCollaborator_Role)
+	*p = x
+	return p
+}
+
+func (x MemoCollaborator_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoCollaborator_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[2].Descriptor()
+}
+
+func (MemoCollaborator_Role) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[2]
+}
+
+func (x MemoCollaborator_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoCollaborator_Role.Descriptor instead.
+func (MemoCollaborator_Role) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{25, 0}
+}
+
 type Reaction struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The resource name of the reaction.
@@ -250,7 +306,25 @@ type Memo struct {
 	// Output only. The snippet of the memo content. Plain text only.
 	Snippet string `protobuf:"bytes,17,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	// Optional. The location of the memo.
-	Location      *Location `protobuf:"bytes,18,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Location *Location `protobuf:"bytes,18,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	// The user groups that can read a GROUP memo. Required for GROUP visibility
+	// and ignored otherwise.
+	// Format: groups/{group}
+	Audience []string `protobuf:"bytes,19,rep,name=audience,proto3" json:"audience,omitempty"`
+	// Optional. The entity tag of the memo, which changes on every update.
+	// Send it back on update or delete to fail with FAILED_PRECONDITION if the
+	// memo was changed in the meantime.
+	Etag string `protobuf:"bytes,20,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Optional. The time the memo is due. When not set explicitly, it is taken
+	// from the first @due(...) marker in the content, e.g. @due(2026-11-01 09:00).
+	DueTime *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=due_time,json=dueTime,proto3,oneof" json:"due_time,omitempty"`
+	// Optional. The time to remind the creator about the memo. Defaults to the
+	// due time when not set.
+	RemindTime *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=remind_time,json=remindTime,proto3,oneof" json:"remind_time,omitempty"`
+	// Optional. The time to publish the memo at. Until then the memo is only
+	// visible to its creator, and `visibility` is the visibility it gets once
+	// published. Clearing it cancels the schedule and keeps the memo private.
+	PublishTime   *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=publish_time,json=publishTime,proto3,oneof" json:"publish_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -397,6 +471,41 @@ func (x *Memo) GetLocation() *Location {
 	return nil
 }
 
+func (x *Memo) GetAudience() []string {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *Memo) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Memo) GetDueTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueTime
+	}
+	return nil
+}
+
+func (x *Memo) GetRemindTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindTime
+	}
+	return nil
+}
+
+func (x *Memo) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
 type Location struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// A placeholder text for the location.
@@ -723,6 +832,8 @@ type UpdateMemoRequest struct {
 	// The `name` field is required.
 	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
 	// Required. The list of fields to update.
+	// If `memo.etag` is set, the update only applies when the memo has not been
+	// changed since; otherwise FAILED_PRECONDITION is returned.
 	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -778,7 +889,10 @@ type DeleteMemoRequest struct {
 	// Format: memos/{memo}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Optional. If set to true, the memo will be deleted even if it has associated data.
-	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// Optional. The etag of the memo. If set, the memo is only deleted when it
+	// has not been changed since; otherwise FAILED_PRECONDITION is returned.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -827,6 +941,13 @@ func (x *DeleteMemoRequest) GetForce() bool {
 	return false
 }
 
+func (x *DeleteMemoRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type SetMemoAttachmentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the memo.
@@ -1673,9 +1794,20 @@ type MemoShare struct {
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// Optional. When set, the share link stops working after this time.
 	// If unset, the link never expires.
-	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3,oneof" json:"expire_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3,oneof" json:"expire_time,omitempty"`
+	// Input only. When set, viewers must enter this password to open the share link.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Output only. Whether the share link is protected by a password.
+	PasswordProtected bool `protobuf:"varint,5,opt,name=password_protected,json=passwordProtected,proto3" json:"password_protected,omitempty"`
+	// Optional. When set, the share link stops working after the memo has been
+	// viewed this many times through it.
+	MaxViews *int32 `protobuf:"varint,6,opt,name=max_views,json=maxViews,proto3,oneof" json:"max_views,omitempty"`
+	// Output only. How many times the memo has been viewed through the share link.
+	ViewCount int32 `protobuf:"varint,7,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	// Output only. When the memo was last viewed through the share link.
+	LastAccessTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_access_time,json=lastAccessTime,proto3,oneof" json:"last_access_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *MemoShare) Reset() {
@@ -1729,31 +1861,77 @@ func (x *MemoShare) GetExpireTime() *timestamppb.Timestamp {
 	return nil
 }
 
-type CreateMemoShareRequest struct {
+func (x *MemoShare) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *MemoShare) GetPasswordProtected() bool {
+	if x != nil {
+		return x.PasswordProtected
+	}
+	return false
+}
+
+func (x *MemoShare) GetMaxViews() int32 {
+	if x != nil && x.MaxViews != nil {
+		return *x.MaxViews
+	}
+	return 0
+}
+
+func (x *MemoShare) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *MemoShare) GetLastAccessTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessTime
+	}
+	return nil
+}
+
+// CollectionShare is an access grant that permits read-only access to the memos
+// matching a shortcut or a tag via an opaque bearer token.
+type CollectionShare struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo to share.
-	// Format: memos/{memo}
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// Required. The share to create.
-	MemoShare     *MemoShare `protobuf:"bytes,2,opt,name=memo_share,json=memoShare,proto3" json:"memo_share,omitempty"`
+	// The resource name of the collection share.
+	// Format: users/{user}/collectionShares/{collection_share}
+	// The {collection_share} segment is the opaque token used in the share URL.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The resource name of the shared shortcut. Exactly one of shortcut and tag must be set.
+	// Format: users/{user}/shortcuts/{shortcut}
+	Shortcut string `protobuf:"bytes,2,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	// The shared tag. Exactly one of shortcut and tag must be set.
+	Tag string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Output only. When this share link was created.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Optional. When set, the share link stops working after this time.
+	// If unset, the link never expires.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3,oneof" json:"expire_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMemoShareRequest) Reset() {
-	*x = CreateMemoShareRequest{}
+func (x *CollectionShare) Reset() {
+	*x = CollectionShare{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMemoShareRequest) String() string {
+func (x *CollectionShare) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMemoShareRequest) ProtoMessage() {}
+func (*CollectionShare) ProtoMessage() {}
 
-func (x *CreateMemoShareRequest) ProtoReflect() protoreflect.Message {
+func (x *CollectionShare) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1765,48 +1943,76 @@ func (x *CreateMemoShareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMemoShareRequest.ProtoReflect.Descriptor instead.
-func (*CreateMemoShareRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectionShare.ProtoReflect.Descriptor instead.
+func (*CollectionShare) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateMemoShareRequest) GetParent() string {
+func (x *CollectionShare) GetName() string {
 	if x != nil {
-		return x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateMemoShareRequest) GetMemoShare() *MemoShare {
+func (x *CollectionShare) GetShortcut() string {
 	if x != nil {
-		return x.MemoShare
+		return x.Shortcut
+	}
+	return ""
+}
+
+func (x *CollectionShare) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *CollectionShare) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
 	}
 	return nil
 }
 
-type ListMemoSharesRequest struct {
+func (x *CollectionShare) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type MemoCollaborator struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The resource name of the collaborator.
+	// Format: memos/{memo}/collaborators/{collaborator}, where {collaborator} is the username.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The user granted access.
+	// Format: users/{user}
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// The access level granted to the user.
+	Role MemoCollaborator_Role `protobuf:"varint,3,opt,name=role,proto3,enum=memos.api.v1.MemoCollaborator_Role" json:"role,omitempty"`
+	// Output only. When the user became a collaborator.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoSharesRequest) Reset() {
-	*x = ListMemoSharesRequest{}
+func (x *MemoCollaborator) Reset() {
+	*x = MemoCollaborator{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoSharesRequest) String() string {
+func (x *MemoCollaborator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoSharesRequest) ProtoMessage() {}
+func (*MemoCollaborator) ProtoMessage() {}
 
-func (x *ListMemoSharesRequest) ProtoReflect() protoreflect.Message {
+func (x *MemoCollaborator) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1818,40 +2024,62 @@ func (x *ListMemoSharesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoSharesRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoSharesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MemoCollaborator.ProtoReflect.Descriptor instead.
+func (*MemoCollaborator) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListMemoSharesRequest) GetParent() string {
+func (x *MemoCollaborator) GetName() string {
 	if x != nil {
-		return x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-type ListMemoSharesResponse struct {
+func (x *MemoCollaborator) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *MemoCollaborator) GetRole() MemoCollaborator_Role {
+	if x != nil {
+		return x.Role
+	}
+	return MemoCollaborator_ROLE_UNSPECIFIED
+}
+
+func (x *MemoCollaborator) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListMemoCollaboratorsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of share links.
-	MemoShares    []*MemoShare `protobuf:"bytes,1,rep,name=memo_shares,json=memoShares,proto3" json:"memo_shares,omitempty"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoSharesResponse) Reset() {
-	*x = ListMemoSharesResponse{}
+func (x *ListMemoCollaboratorsRequest) Reset() {
+	*x = ListMemoCollaboratorsRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoSharesResponse) String() string {
+func (x *ListMemoCollaboratorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoSharesResponse) ProtoMessage() {}
+func (*ListMemoCollaboratorsRequest) ProtoMessage() {}
 
-func (x *ListMemoSharesResponse) ProtoReflect() protoreflect.Message {
+func (x *ListMemoCollaboratorsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1863,41 +2091,40 @@ func (x *ListMemoSharesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoSharesResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoSharesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMemoCollaboratorsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoCollaboratorsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ListMemoSharesResponse) GetMemoShares() []*MemoShare {
+func (x *ListMemoCollaboratorsRequest) GetParent() string {
 	if x != nil {
-		return x.MemoShares
+		return x.Parent
 	}
-	return nil
+	return ""
 }
 
-type DeleteMemoShareRequest struct {
+type ListMemoCollaboratorsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the share to delete.
-	// Format: memos/{memo}/shares/{share}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The list of collaborators.
+	Collaborators []*MemoCollaborator `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoShareRequest) Reset() {
-	*x = DeleteMemoShareRequest{}
+func (x *ListMemoCollaboratorsResponse) Reset() {
+	*x = ListMemoCollaboratorsResponse{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoShareRequest) String() string {
+func (x *ListMemoCollaboratorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoShareRequest) ProtoMessage() {}
+func (*ListMemoCollaboratorsResponse) ProtoMessage() {}
 
-func (x *DeleteMemoShareRequest) ProtoReflect() protoreflect.Message {
+func (x *ListMemoCollaboratorsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1909,40 +2136,46 @@ func (x *DeleteMemoShareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoShareRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoShareRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMemoCollaboratorsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoCollaboratorsResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DeleteMemoShareRequest) GetName() string {
+func (x *ListMemoCollaboratorsResponse) GetCollaborators() []*MemoCollaborator {
 	if x != nil {
-		return x.Name
+		return x.Collaborators
 	}
-	return ""
+	return nil
 }
 
-type GetMemoByShareRequest struct {
+type AddMemoCollaboratorRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The share token extracted from the share URL (/s/{share_id}).
-	ShareId       string `protobuf:"bytes,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The user to grant access to.
+	// Format: users/{user}
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Required. The access level to grant.
+	Role          MemoCollaborator_Role `protobuf:"varint,3,opt,name=role,proto3,enum=memos.api.v1.MemoCollaborator_Role" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemoByShareRequest) Reset() {
-	*x = GetMemoByShareRequest{}
+func (x *AddMemoCollaboratorRequest) Reset() {
+	*x = AddMemoCollaboratorRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemoByShareRequest) String() string {
+func (x *AddMemoCollaboratorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemoByShareRequest) ProtoMessage() {}
+func (*AddMemoCollaboratorRequest) ProtoMessage() {}
 
-func (x *GetMemoByShareRequest) ProtoReflect() protoreflect.Message {
+func (x *AddMemoCollaboratorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1954,40 +2187,55 @@ func (x *GetMemoByShareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemoByShareRequest.ProtoReflect.Descriptor instead.
-func (*GetMemoByShareRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddMemoCollaboratorRequest.ProtoReflect.Descriptor instead.
+func (*AddMemoCollaboratorRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetMemoByShareRequest) GetShareId() string {
+func (x *AddMemoCollaboratorRequest) GetParent() string {
 	if x != nil {
-		return x.ShareId
+		return x.Parent
 	}
 	return ""
 }
 
-type GetLinkMetadataRequest struct {
+func (x *AddMemoCollaboratorRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AddMemoCollaboratorRequest) GetRole() MemoCollaborator_Role {
+	if x != nil {
+		return x.Role
+	}
+	return MemoCollaborator_ROLE_UNSPECIFIED
+}
+
+type RemoveMemoCollaboratorRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The link URL.
-	Url           string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Required. The resource name of the collaborator to remove.
+	// Format: memos/{memo}/collaborators/{collaborator}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLinkMetadataRequest) Reset() {
-	*x = GetLinkMetadataRequest{}
+func (x *RemoveMemoCollaboratorRequest) Reset() {
+	*x = RemoveMemoCollaboratorRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLinkMetadataRequest) String() string {
+func (x *RemoveMemoCollaboratorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLinkMetadataRequest) ProtoMessage() {}
+func (*RemoveMemoCollaboratorRequest) ProtoMessage() {}
 
-func (x *GetLinkMetadataRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveMemoCollaboratorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1999,40 +2247,43 @@ func (x *GetLinkMetadataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLinkMetadataRequest.ProtoReflect.Descriptor instead.
-func (*GetLinkMetadataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveMemoCollaboratorRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMemoCollaboratorRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetLinkMetadataRequest) GetUrl() string {
+func (x *RemoveMemoCollaboratorRequest) GetName() string {
 	if x != nil {
-		return x.Url
+		return x.Name
 	}
 	return ""
 }
 
-type BatchGetLinkMetadataRequest struct {
+type CreateMemoShareRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The link URLs.
-	Urls          []string `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	// Required. The resource name of the memo to share.
+	// Format: memos/{memo}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The share to create.
+	MemoShare     *MemoShare `protobuf:"bytes,2,opt,name=memo_share,json=memoShare,proto3" json:"memo_share,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchGetLinkMetadataRequest) Reset() {
-	*x = BatchGetLinkMetadataRequest{}
+func (x *CreateMemoShareRequest) Reset() {
+	*x = CreateMemoShareRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchGetLinkMetadataRequest) String() string {
+func (x *CreateMemoShareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchGetLinkMetadataRequest) ProtoMessage() {}
+func (*CreateMemoShareRequest) ProtoMessage() {}
 
-func (x *BatchGetLinkMetadataRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateMemoShareRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2044,21 +2295,619 @@ func (x *BatchGetLinkMetadataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchGetLinkMetadataRequest.ProtoReflect.Descriptor instead.
-func (*BatchGetLinkMetadataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateMemoShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoShareRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *BatchGetLinkMetadataRequest) GetUrls() []string {
+func (x *CreateMemoShareRequest) GetParent() string {
 	if x != nil {
-		return x.Urls
+		return x.Parent
 	}
-	return nil
+	return ""
 }
 
-type BatchGetLinkMetadataResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The link metadata list, in the same order as the input URLs.
+func (x *CreateMemoShareRequest) GetMemoShare() *MemoShare {
+	if x != nil {
+		return x.MemoShare
+	}
+	return nil
+}
+
+type ListMemoSharesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoSharesRequest) Reset() {
+	*x = ListMemoSharesRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoSharesRequest) ProtoMessage() {}
+
+func (x *ListMemoSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoSharesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListMemoSharesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListMemoSharesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of share links.
+	MemoShares    []*MemoShare `protobuf:"bytes,1,rep,name=memo_shares,json=memoShares,proto3" json:"memo_shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoSharesResponse) Reset() {
+	*x = ListMemoSharesResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoSharesResponse) ProtoMessage() {}
+
+func (x *ListMemoSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoSharesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListMemoSharesResponse) GetMemoShares() []*MemoShare {
+	if x != nil {
+		return x.MemoShares
+	}
+	return nil
+}
+
+type DeleteMemoShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the share to delete.
+	// Format: memos/{memo}/shares/{share}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoShareRequest) Reset() {
+	*x = DeleteMemoShareRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoShareRequest) ProtoMessage() {}
+
+func (x *DeleteMemoShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoShareRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteMemoShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetMemoByShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The share token extracted from the share URL (/s/{share_id}).
+	ShareId string `protobuf:"bytes,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	// Optional. The share password, required when the share link is password protected.
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoByShareRequest) Reset() {
+	*x = GetMemoByShareRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoByShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoByShareRequest) ProtoMessage() {}
+
+func (x *GetMemoByShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoByShareRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoByShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetMemoByShareRequest) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+func (x *GetMemoByShareRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type CreateCollectionShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the user creating the share.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The collection share to create.
+	CollectionShare *CollectionShare `protobuf:"bytes,2,opt,name=collection_share,json=collectionShare,proto3" json:"collection_share,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateCollectionShareRequest) Reset() {
+	*x = CreateCollectionShareRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionShareRequest) ProtoMessage() {}
+
+func (x *CreateCollectionShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateCollectionShareRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateCollectionShareRequest) GetCollectionShare() *CollectionShare {
+	if x != nil {
+		return x.CollectionShare
+	}
+	return nil
+}
+
+type ListCollectionSharesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the user.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionSharesRequest) Reset() {
+	*x = ListCollectionSharesRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionSharesRequest) ProtoMessage() {}
+
+func (x *ListCollectionSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionSharesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListCollectionSharesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListCollectionSharesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of collection share links.
+	CollectionShares []*CollectionShare `protobuf:"bytes,1,rep,name=collection_shares,json=collectionShares,proto3" json:"collection_shares,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListCollectionSharesResponse) Reset() {
+	*x = ListCollectionSharesResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionSharesResponse) ProtoMessage() {}
+
+func (x *ListCollectionSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionSharesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListCollectionSharesResponse) GetCollectionShares() []*CollectionShare {
+	if x != nil {
+		return x.CollectionShares
+	}
+	return nil
+}
+
+type DeleteCollectionShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the collection share to delete.
+	// Format: users/{user}/collectionShares/{collection_share}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionShareRequest) Reset() {
+	*x = DeleteCollectionShareRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionShareRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionShareRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteCollectionShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListMemosByShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The collection share token extracted from the share URL.
+	ShareId string `protobuf:"bytes,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	// Optional. The maximum number of memos to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListMemosByShare` call.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemosByShareRequest) Reset() {
+	*x = ListMemosByShareRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemosByShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemosByShareRequest) ProtoMessage() {}
+
+func (x *ListMemosByShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemosByShareRequest.ProtoReflect.Descriptor instead.
+func (*ListMemosByShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListMemosByShareRequest) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+func (x *ListMemosByShareRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemosByShareRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemosByShareResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memos of the collection.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemosByShareResponse) Reset() {
+	*x = ListMemosByShareResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemosByShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemosByShareResponse) ProtoMessage() {}
+
+func (x *ListMemosByShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemosByShareResponse.ProtoReflect.Descriptor instead.
+func (*ListMemosByShareResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListMemosByShareResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListMemosByShareResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetLinkMetadataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The link URL.
+	Url           string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLinkMetadataRequest) Reset() {
+	*x = GetLinkMetadataRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLinkMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLinkMetadataRequest) ProtoMessage() {}
+
+func (x *GetLinkMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLinkMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetLinkMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetLinkMetadataRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type BatchGetLinkMetadataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The link URLs.
+	Urls          []string `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetLinkMetadataRequest) Reset() {
+	*x = BatchGetLinkMetadataRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetLinkMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetLinkMetadataRequest) ProtoMessage() {}
+
+func (x *BatchGetLinkMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetLinkMetadataRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetLinkMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BatchGetLinkMetadataRequest) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+type BatchGetLinkMetadataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The link metadata list, in the same order as the input URLs.
 	LinkMetadata  []*LinkMetadata `protobuf:"bytes,1,rep,name=link_metadata,json=linkMetadata,proto3" json:"link_metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -2066,7 +2915,7 @@ type BatchGetLinkMetadataResponse struct {
 
 func (x *BatchGetLinkMetadataResponse) Reset() {
 	*x = BatchGetLinkMetadataResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2078,7 +2927,7 @@ func (x *BatchGetLinkMetadataResponse) String() string {
 func (*BatchGetLinkMetadataResponse) ProtoMessage() {}
 
 func (x *BatchGetLinkMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2091,7 +2940,7 @@ func (x *BatchGetLinkMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetLinkMetadataResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetLinkMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{31}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *BatchGetLinkMetadataResponse) GetLinkMetadata() []*LinkMetadata {
@@ -2117,7 +2966,7 @@ type LinkMetadata struct {
 
 func (x *LinkMetadata) Reset() {
 	*x = LinkMetadata{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2129,7 +2978,7 @@ func (x *LinkMetadata) String() string {
 func (*LinkMetadata) ProtoMessage() {}
 
 func (x *LinkMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2142,7 +2991,7 @@ func (x *LinkMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkMetadata.ProtoReflect.Descriptor instead.
 func (*LinkMetadata) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{32}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *LinkMetadata) GetUrl() string {
@@ -2188,7 +3037,7 @@ type Memo_Property struct {
 
 func (x *Memo_Property) Reset() {
 	*x = Memo_Property{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2200,7 +3049,7 @@ func (x *Memo_Property) String() string {
 func (*Memo_Property) ProtoMessage() {}
 
 func (x *Memo_Property) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2265,7 +3114,7 @@ type MemoRelation_Memo struct {
 
 func (x *MemoRelation_Memo) Reset() {
 	*x = MemoRelation_Memo{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2277,7 +3126,7 @@ func (x *MemoRelation_Memo) String() string {
 func (*MemoRelation_Memo) ProtoMessage() {}
 
 func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2322,7 +3171,7 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\rreaction_type\x18\x04 \x01(\tB\x03\xe0A\x02R\freactionType\x12@\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime:X\xeaAU\n" +
-	"\x15memos.api.v1/Reaction\x12!memos/{memo}/reactions/{reaction}\x1a\x04name*\treactions2\breaction\"\xbe\b\n" +
+	"\x15memos.api.v1/Reaction\x12!memos/{memo}/reactions/{reaction}\x1a\x04name*\treactions2\breaction\"\x92\v\n" +
 	"\x04Memo\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12.\n" +
 	"\x05state\x18\x02 \x01(\x0e2\x13.memos.api.v1.StateB\x03\xe0A\x02R\x05state\x123\n" +
@@ -2346,7 +3195,14 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x06parent\x18\x10 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoH\x00R\x06parent\x88\x01\x01\x12\x1d\n" +
 	"\asnippet\x18\x11 \x01(\tB\x03\xe0A\x03R\asnippet\x12<\n" +
-	"\blocation\x18\x12 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x01H\x01R\blocation\x88\x01\x01\x1a\xac\x01\n" +
+	"\blocation\x18\x12 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x01H\x01R\blocation\x88\x01\x01\x12:\n" +
+	"\baudience\x18\x13 \x03(\tB\x1e\xe0A\x01\xfaA\x18\n" +
+	"\x16memos.api.v1/UserGroupR\baudience\x12\x17\n" +
+	"\x04etag\x18\x14 \x01(\tB\x03\xe0A\x01R\x04etag\x12?\n" +
+	"\bdue_time\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01H\x02R\adueTime\x88\x01\x01\x12E\n" +
+	"\vremind_time\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01H\x03R\n" +
+	"remindTime\x88\x01\x01\x12G\n" +
+	"\fpublish_time\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01H\x04R\vpublishTime\x88\x01\x01\x1a\xac\x01\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
@@ -2355,7 +3211,10 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x05title\x18\x05 \x01(\tR\x05title:7\xeaA4\n" +
 	"\x11memos.api.v1/Memo\x12\fmemos/{memo}\x1a\x04name*\x05memos2\x04memoB\t\n" +
 	"\a_parentB\v\n" +
-	"\t_locationJ\x04\b\x06\x10\aR\fdisplay_time\"u\n" +
+	"\t_locationB\v\n" +
+	"\t_due_timeB\x0e\n" +
+	"\f_remind_timeB\x0f\n" +
+	"\r_publish_timeJ\x04\b\x06\x10\aR\fdisplay_time\"u\n" +
 	"\bLocation\x12%\n" +
 	"\vplaceholder\x18\x01 \x01(\tB\x03\xe0A\x01R\vplaceholder\x12\x1f\n" +
 	"\blatitude\x18\x02 \x01(\x01B\x03\xe0A\x01R\blatitude\x12!\n" +
@@ -2380,11 +3239,12 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x11UpdateMemoRequest\x12+\n" +
 	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoB\x03\xe0A\x02R\x04memo\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
-	"updateMask\"]\n" +
+	"updateMask\"v\n" +
 	"\x11DeleteMemoRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12\x19\n" +
-	"\x05force\x18\x02 \x01(\bB\x03\xe0A\x01R\x05force\"\x8b\x01\n" +
+	"\x05force\x18\x02 \x01(\bB\x03\xe0A\x01R\x05force\x12\x17\n" +
+	"\x04etag\x18\x03 \x01(\tB\x03\xe0A\x01R\x04etag\"\x8b\x01\n" +
 	"\x19SetMemoAttachmentsRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12?\n" +
@@ -2458,15 +3318,62 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\breaction\x18\x02 \x01(\v2\x16.memos.api.v1.ReactionB\x03\xe0A\x02R\breaction\"N\n" +
 	"\x19DeleteMemoReactionRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
-	"\x15memos.api.v1/ReactionR\x04name\"\x86\x02\n" +
+	"\x15memos.api.v1/ReactionR\x04name\"\x99\x04\n" +
 	"\tMemoShare\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12@\n" +
 	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12E\n" +
 	"\vexpire_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01H\x00R\n" +
-	"expireTime\x88\x01\x01:G\xeaAD\n" +
+	"expireTime\x88\x01\x01\x12\x1f\n" +
+	"\bpassword\x18\x04 \x01(\tB\x03\xe0A\x04R\bpassword\x122\n" +
+	"\x12password_protected\x18\x05 \x01(\bB\x03\xe0A\x03R\x11passwordProtected\x12%\n" +
+	"\tmax_views\x18\x06 \x01(\x05B\x03\xe0A\x01H\x01R\bmaxViews\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"view_count\x18\a \x01(\x05B\x03\xe0A\x03R\tviewCount\x12N\n" +
+	"\x10last_access_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03H\x02R\x0elastAccessTime\x88\x01\x01:G\xeaAD\n" +
 	"\x16memos.api.v1/MemoShare\x12\x1bmemos/{memo}/shares/{share}*\x06shares2\x05shareB\x0e\n" +
-	"\f_expire_time\"\x88\x01\n" +
+	"\f_expire_timeB\f\n" +
+	"\n" +
+	"_max_viewsB\x13\n" +
+	"\x11_last_access_time\"\x8d\x03\n" +
+	"\x0fCollectionShare\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x129\n" +
+	"\bshortcut\x18\x02 \x01(\tB\x1d\xe0A\x01\xfaA\x17\n" +
+	"\x15memos.api.v1/ShortcutR\bshortcut\x12\x15\n" +
+	"\x03tag\x18\x03 \x01(\tB\x03\xe0A\x01R\x03tag\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12E\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01H\x00R\n" +
+	"expireTime\x88\x01\x01:v\xeaAs\n" +
+	"\x1cmemos.api.v1/CollectionShare\x120users/{user}/collectionShares/{collection_share}*\x10collectionShares2\x0fcollectionShareB\x0e\n" +
+	"\f_expire_time\"\xe1\x02\n" +
+	"\x10MemoCollaborator\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x17\n" +
+	"\x04user\x18\x02 \x01(\tB\x03\xe0A\x03R\x04user\x127\n" +
+	"\x04role\x18\x03 \x01(\x0e2#.memos.api.v1.MemoCollaborator.RoleR\x04role\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\"4\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06VIEWER\x10\x01\x12\n" +
+	"\n" +
+	"\x06EDITOR\x10\x02:j\xeaAg\n" +
+	"\x1dmemos.api.v1/MemoCollaborator\x12)memos/{memo}/collaborators/{collaborator}*\rcollaborators2\fcollaborator\"Q\n" +
+	"\x1cListMemoCollaboratorsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x06parent\"e\n" +
+	"\x1dListMemoCollaboratorsResponse\x12D\n" +
+	"\rcollaborators\x18\x01 \x03(\v2\x1e.memos.api.v1.MemoCollaboratorR\rcollaborators\"\xbc\x01\n" +
+	"\x1aAddMemoCollaboratorRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x06parent\x12-\n" +
+	"\x04user\x18\x02 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04user\x12<\n" +
+	"\x04role\x18\x03 \x01(\x0e2#.memos.api.v1.MemoCollaborator.RoleB\x03\xe0A\x02R\x04role\"Z\n" +
+	"\x1dRemoveMemoCollaboratorRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
+	"\x1dmemos.api.v1/MemoCollaboratorR\x04name\"\x88\x01\n" +
 	"\x16CreateMemoShareRequest\x121\n" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x06parent\x12;\n" +
@@ -2480,9 +3387,30 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"memoShares\"L\n" +
 	"\x16DeleteMemoShareRequest\x122\n" +
 	"\x04name\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\n" +
-	"\x16memos.api.v1/MemoShareR\x04name\"7\n" +
+	"\x16memos.api.v1/MemoShareR\x04name\"X\n" +
 	"\x15GetMemoByShareRequest\x12\x1e\n" +
-	"\bshare_id\x18\x01 \x01(\tB\x03\xe0A\x02R\ashareId\"/\n" +
+	"\bshare_id\x18\x01 \x01(\tB\x03\xe0A\x02R\ashareId\x12\x1f\n" +
+	"\bpassword\x18\x02 \x01(\tB\x03\xe0A\x01R\bpassword\"\xa0\x01\n" +
+	"\x1cCreateCollectionShareRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12M\n" +
+	"\x10collection_share\x18\x02 \x01(\v2\x1d.memos.api.v1.CollectionShareB\x03\xe0A\x02R\x0fcollectionShare\"P\n" +
+	"\x1bListCollectionSharesRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"j\n" +
+	"\x1cListCollectionSharesResponse\x12J\n" +
+	"\x11collection_shares\x18\x01 \x03(\v2\x1d.memos.api.v1.CollectionShareR\x10collectionShares\"X\n" +
+	"\x1cDeleteCollectionShareRequest\x128\n" +
+	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
+	"\x1cmemos.api.v1/CollectionShareR\x04name\"\x7f\n" +
+	"\x17ListMemosByShareRequest\x12\x1e\n" +
+	"\bshare_id\x18\x01 \x01(\tB\x03\xe0A\x02R\ashareId\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"l\n" +
+	"\x18ListMemosByShareResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"/\n" +
 	"\x16GetLinkMetadataRequest\x12\x15\n" +
 	"\x03url\x18\x01 \x01(\tB\x03\xe0A\x02R\x03url\"6\n" +
 	"\x1bBatchGetLinkMetadataRequest\x12\x17\n" +
@@ -2493,14 +3421,15 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05image\x18\x04 \x01(\tR\x05image*P\n" +
+	"\x05image\x18\x04 \x01(\tR\x05image*[\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPRIVATE\x10\x01\x12\r\n" +
 	"\tPROTECTED\x10\x02\x12\n" +
 	"\n" +
-	"\x06PUBLIC\x10\x032\x8b\x15\n" +
+	"\x06PUBLIC\x10\x03\x12\t\n" +
+	"\x05GROUP\x10\x042\x8b\x1e\n" +
 	"\vMemoService\x12e\n" +
 	"\n" +
 	"CreateMemo\x12\x1f.memos.api.v1.CreateMemoRequest\x1a\x12.memos.api.v1.Memo\"\"\xdaA\x04memo\x82\xd3\xe4\x93\x02\x15:\x04memo\"\r/api/v1/memos\x12f\n" +
@@ -2518,12 +3447,19 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x10ListMemoComments\x12%.memos.api.v1.ListMemoCommentsRequest\x1a&.memos.api.v1.ListMemoCommentsResponse\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=memos/*}/comments\x12\x95\x01\n" +
 	"\x11ListMemoReactions\x12&.memos.api.v1.ListMemoReactionsRequest\x1a'.memos.api.v1.ListMemoReactionsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/reactions\x12\x89\x01\n" +
 	"\x12UpsertMemoReaction\x12'.memos.api.v1.UpsertMemoReactionRequest\x1a\x16.memos.api.v1.Reaction\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/{name=memos/*}/reactions\x12\x88\x01\n" +
-	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/api/v1/{name=memos/*/reactions/*}\x12\x99\x01\n" +
+	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/api/v1/{name=memos/*/reactions/*}\x12\xa9\x01\n" +
+	"\x15ListMemoCollaborators\x12*.memos.api.v1.ListMemoCollaboratorsRequest\x1a+.memos.api.v1.ListMemoCollaboratorsResponse\"7\xdaA\x06parent\x82\xd3\xe4\x93\x02(\x12&/api/v1/{parent=memos/*}/collaborators\x12\xa5\x01\n" +
+	"\x13AddMemoCollaborator\x12(.memos.api.v1.AddMemoCollaboratorRequest\x1a\x1e.memos.api.v1.MemoCollaborator\"D\xdaA\x10parent,user,role\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/{parent=memos/*}/collaborators\x12\x94\x01\n" +
+	"\x16RemoveMemoCollaborator\x12+.memos.api.v1.RemoveMemoCollaboratorRequest\x1a\x16.google.protobuf.Empty\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(*&/api/v1/{name=memos/*/collaborators/*}\x12\x99\x01\n" +
 	"\x0fCreateMemoShare\x12$.memos.api.v1.CreateMemoShareRequest\x1a\x17.memos.api.v1.MemoShare\"G\xdaA\x11parent,memo_share\x82\xd3\xe4\x93\x02-:\n" +
 	"memo_share\"\x1f/api/v1/{parent=memos/*}/shares\x12\x8d\x01\n" +
 	"\x0eListMemoShares\x12#.memos.api.v1.ListMemoSharesRequest\x1a$.memos.api.v1.ListMemoSharesResponse\"0\xdaA\x06parent\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{parent=memos/*}/shares\x12\x7f\n" +
 	"\x0fDeleteMemoShare\x12$.memos.api.v1.DeleteMemoShareRequest\x1a\x16.google.protobuf.Empty\".\xdaA\x04name\x82\xd3\xe4\x93\x02!*\x1f/api/v1/{name=memos/*/shares/*}\x12l\n" +
-	"\x0eGetMemoByShare\x12#.memos.api.v1.GetMemoByShareRequest\x1a\x12.memos.api.v1.Memo\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/shares/{share_id}\x12y\n" +
+	"\x0eGetMemoByShare\x12#.memos.api.v1.GetMemoByShareRequest\x1a\x12.memos.api.v1.Memo\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/shares/{share_id}\x12\xc1\x01\n" +
+	"\x15CreateCollectionShare\x12*.memos.api.v1.CreateCollectionShareRequest\x1a\x1d.memos.api.v1.CollectionShare\"]\xdaA\x17parent,collection_share\x82\xd3\xe4\x93\x02=:\x10collection_share\")/api/v1/{parent=users/*}/collectionShares\x12\xa9\x01\n" +
+	"\x14ListCollectionShares\x12).memos.api.v1.ListCollectionSharesRequest\x1a*.memos.api.v1.ListCollectionSharesResponse\":\xdaA\x06parent\x82\xd3\xe4\x93\x02+\x12)/api/v1/{parent=users/*}/collectionShares\x12\x95\x01\n" +
+	"\x15DeleteCollectionShare\x12*.memos.api.v1.DeleteCollectionShareRequest\x1a\x16.google.protobuf.Empty\"8\xdaA\x04name\x82\xd3\xe4\x93\x02+*)/api/v1/{name=users/*/collectionShares/*}\x12\x8a\x01\n" +
+	"\x10ListMemosByShare\x12%.memos.api.v1.ListMemosByShareRequest\x1a&.memos.api.v1.ListMemosByShareResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/shares/{share_id}/memos\x12y\n" +
 	"\x0fGetLinkMetadata\x12$.memos.api.v1.GetLinkMetadataRequest\x1a\x1a.memos.api.v1.LinkMetadata\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/memos/-/linkMetadata\x12\x9f\x01\n" +
 	"\x14BatchGetLinkMetadata\x12).memos.api.v1.BatchGetLinkMetadataRequest\x1a*.memos.api.v1.BatchGetLinkMetadataResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/memos/-/linkMetadata:batchGetB\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10MemoServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
@@ -2540,129 +3476,169 @@ func file_api_v1_memo_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_memo_service_proto_rawDescData
 }
 
-var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_api_v1_memo_service_proto_goTypes = []any{
-	(Visibility)(0),                      // 0: memos.api.v1.Visibility
-	(MemoRelation_Type)(0),               // 1: memos.api.v1.MemoRelation.Type
-	(*Reaction)(nil),                     // 2: memos.api.v1.Reaction
-	(*Memo)(nil),                         // 3: memos.api.v1.Memo
-	(*Location)(nil),                     // 4: memos.api.v1.Location
-	(*CreateMemoRequest)(nil),            // 5: memos.api.v1.CreateMemoRequest
-	(*ListMemosRequest)(nil),             // 6: memos.api.v1.ListMemosRequest
-	(*ListMemosResponse)(nil),            // 7: memos.api.v1.ListMemosResponse
-	(*GetMemoRequest)(nil),               // 8: memos.api.v1.GetMemoRequest
-	(*UpdateMemoRequest)(nil),            // 9: memos.api.v1.UpdateMemoRequest
-	(*DeleteMemoRequest)(nil),            // 10: memos.api.v1.DeleteMemoRequest
-	(*SetMemoAttachmentsRequest)(nil),    // 11: memos.api.v1.SetMemoAttachmentsRequest
-	(*ListMemoAttachmentsRequest)(nil),   // 12: memos.api.v1.ListMemoAttachmentsRequest
-	(*ListMemoAttachmentsResponse)(nil),  // 13: memos.api.v1.ListMemoAttachmentsResponse
-	(*MemoRelation)(nil),                 // 14: memos.api.v1.MemoRelation
-	(*SetMemoRelationsRequest)(nil),      // 15: memos.api.v1.SetMemoRelationsRequest
-	(*ListMemoRelationsRequest)(nil),     // 16: memos.api.v1.ListMemoRelationsRequest
-	(*ListMemoRelationsResponse)(nil),    // 17: memos.api.v1.ListMemoRelationsResponse
-	(*CreateMemoCommentRequest)(nil),     // 18: memos.api.v1.CreateMemoCommentRequest
-	(*ListMemoCommentsRequest)(nil),      // 19: memos.api.v1.ListMemoCommentsRequest
-	(*ListMemoCommentsResponse)(nil),     // 20: memos.api.v1.ListMemoCommentsResponse
-	(*ListMemoReactionsRequest)(nil),     // 21: memos.api.v1.ListMemoReactionsRequest
-	(*ListMemoReactionsResponse)(nil),    // 22: memos.api.v1.ListMemoReactionsResponse
-	(*UpsertMemoReactionRequest)(nil),    // 23: memos.api.v1.UpsertMemoReactionRequest
-	(*DeleteMemoReactionRequest)(nil),    // 24: memos.api.v1.DeleteMemoReactionRequest
-	(*MemoShare)(nil),                    // 25: memos.api.v1.MemoShare
-	(*CreateMemoShareRequest)(nil),       // 26: memos.api.v1.CreateMemoShareRequest
-	(*ListMemoSharesRequest)(nil),        // 27: memos.api.v1.ListMemoSharesRequest
-	(*ListMemoSharesResponse)(nil),       // 28: memos.api.v1.ListMemoSharesResponse
-	(*DeleteMemoShareRequest)(nil),       // 29: memos.api.v1.DeleteMemoShareRequest
-	(*GetMemoByShareRequest)(nil),        // 30: memos.api.v1.GetMemoByShareRequest
-	(*GetLinkMetadataRequest)(nil),       // 31: memos.api.v1.GetLinkMetadataRequest
-	(*BatchGetLinkMetadataRequest)(nil),  // 32: memos.api.v1.BatchGetLinkMetadataRequest
-	(*BatchGetLinkMetadataResponse)(nil), // 33: memos.api.v1.BatchGetLinkMetadataResponse
-	(*LinkMetadata)(nil),                 // 34: memos.api.v1.LinkMetadata
-	(*Memo_Property)(nil),                // 35: memos.api.v1.Memo.Property
-	(*MemoRelation_Memo)(nil),            // 36: memos.api.v1.MemoRelation.Memo
-	(*timestamppb.Timestamp)(nil),        // 37: google.protobuf.Timestamp
-	(State)(0),                           // 38: memos.api.v1.State
-	(*Attachment)(nil),                   // 39: memos.api.v1.Attachment
-	(*fieldmaskpb.FieldMask)(nil),        // 40: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                // 41: google.protobuf.Empty
+	(Visibility)(0),                       // 0: memos.api.v1.Visibility
+	(MemoRelation_Type)(0),                // 1: memos.api.v1.MemoRelation.Type
+	(MemoCollaborator_Role)(0),            // 2: memos.api.v1.MemoCollaborator.Role
+	(*Reaction)(nil),                      // 3: memos.api.v1.Reaction
+	(*Memo)(nil),                          // 4: memos.api.v1.Memo
+	(*Location)(nil),                      // 5: memos.api.v1.Location
+	(*CreateMemoRequest)(nil),             // 6: memos.api.v1.CreateMemoRequest
+	(*ListMemosRequest)(nil),              // 7: memos.api.v1.ListMemosRequest
+	(*ListMemosResponse)(nil),             // 8: memos.api.v1.ListMemosResponse
+	(*GetMemoRequest)(nil),                // 9: memos.api.v1.GetMemoRequest
+	(*UpdateMemoRequest)(nil),             // 10: memos.api.v1.UpdateMemoRequest
+	(*DeleteMemoRequest)(nil),             // 11: memos.api.v1.DeleteMemoRequest
+	(*SetMemoAttachmentsRequest)(nil),     // 12: memos.api.v1.SetMemoAttachmentsRequest
+	(*ListMemoAttachmentsRequest)(nil),    // 13: memos.api.v1.ListMemoAttachmentsRequest
+	(*ListMemoAttachmentsResponse)(nil),   // 14: memos.api.v1.ListMemoAttachmentsResponse
+	(*MemoRelation)(nil),                  // 15: memos.api.v1.MemoRelation
+	(*SetMemoRelationsRequest)(nil),       // 16: memos.api.v1.SetMemoRelationsRequest
+	(*ListMemoRelationsRequest)(nil),      // 17: memos.api.v1.ListMemoRelationsRequest
+	(*ListMemoRelationsResponse)(nil),     // 18: memos.api.v1.ListMemoRelationsResponse
+	(*CreateMemoCommentRequest)(nil),      // 19: memos.api.v1.CreateMemoCommentRequest
+	(*ListMemoCommentsRequest)(nil),       // 20: memos.api.v1.ListMemoCommentsRequest
+	(*ListMemoCommentsResponse)(nil),      // 21: memos.api.v1.ListMemoCommentsResponse
+	(*ListMemoReactionsRequest)(nil),      // 22: memos.api.v1.ListMemoReactionsRequest
+	(*ListMemoReactionsResponse)(nil),     // 23: memos.api.v1.ListMemoReactionsResponse
+	(*UpsertMemoReactionRequest)(nil),     // 24: memos.api.v1.UpsertMemoReactionRequest
+	(*DeleteMemoReactionRequest)(nil),     // 25: memos.api.v1.DeleteMemoReactionRequest
+	(*MemoShare)(nil),                     // 26: memos.api.v1.MemoShare
+	(*CollectionShare)(nil),               // 27: memos.api.v1.CollectionShare
+	(*MemoCollaborator)(nil),              // 28: memos.api.v1.MemoCollaborator
+	(*ListMemoCollaboratorsRequest)(nil),  // 29: memos.api.v1.ListMemoCollaboratorsRequest
+	(*ListMemoCollaboratorsResponse)(nil), // 30: memos.api.v1.ListMemoCollaboratorsResponse
+	(*AddMemoCollaboratorRequest)(nil),    // 31: memos.api.v1.AddMemoCollaboratorRequest
+	(*RemoveMemoCollaboratorRequest)(nil), // 32: memos.api.v1.RemoveMemoCollaboratorRequest
+	(*CreateMemoShareRequest)(nil),        // 33: memos.api.v1.CreateMemoShareRequest
+	(*ListMemoSharesRequest)(nil),         // 34: memos.api.v1.ListMemoSharesRequest
+	(*ListMemoSharesResponse)(nil),        // 35: memos.api.v1.ListMemoSharesResponse
+	(*DeleteMemoShareRequest)(nil),        // 36: memos.api.v1.DeleteMemoShareRequest
+	(*GetMemoByShareRequest)(nil),         // 37: memos.api.v1.GetMemoByShareRequest
+	(*CreateCollectionShareRequest)(nil),  // 38: memos.api.v1.CreateCollectionShareRequest
+	(*ListCollectionSharesRequest)(nil),   // 39: memos.api.v1.ListCollectionSharesRequest
+	(*ListCollectionSharesResponse)(nil),  // 40: memos.api.v1.ListCollectionSharesResponse
+	(*DeleteCollectionShareRequest)(nil),  // 41: memos.api.v1.DeleteCollectionShareRequest
+	(*ListMemosByShareRequest)(nil),       // 42: memos.api.v1.ListMemosByShareRequest
+	(*ListMemosByShareResponse)(nil),      // 43: memos.api.v1.ListMemosByShareResponse
+	(*GetLinkMetadataRequest)(nil),        // 44: memos.api.v1.GetLinkMetadataRequest
+	(*BatchGetLinkMetadataRequest)(nil),   // 45: memos.api.v1.BatchGetLinkMetadataRequest
+	(*BatchGetLinkMetadataResponse)(nil),  // 46: memos.api.v1.BatchGetLinkMetadataResponse
+	(*LinkMetadata)(nil),                  // 47: memos.api.v1.LinkMetadata
+	(*Memo_Property)(nil),                 // 48: memos.api.v1.Memo.Property
+	(*MemoRelation_Memo)(nil),             // 49: memos.api.v1.MemoRelation.Memo
+	(*timestamppb.Timestamp)(nil),         // 50: google.protobuf.Timestamp
+	(State)(0),                            // 51: memos.api.v1.State
+	(*Attachment)(nil),                    // 52: memos.api.v1.Attachment
+	(*fieldmaskpb.FieldMask)(nil),         // 53: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                 // 54: google.protobuf.Empty
 }
 var file_api_v1_memo_service_proto_depIdxs = []int32{
-	37, // 0: memos.api.v1.Reaction.create_time:type_name -> google.protobuf.Timestamp
-	38, // 1: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
-	37, // 2: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
-	37, // 3: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
+	50, // 0: memos.api.v1.Reaction.create_time:type_name -> google.protobuf.Timestamp
+	51, // 1: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
+	50, // 2: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
+	50, // 3: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
 	0,  // 4: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
-	39, // 5: memos.api.v1.Memo.attachments:type_name -> memos.api.v1.Attachment
-	14, // 6: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
-	2,  // 7: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
-	35, // 8: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
-	4,  // 9: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
-	3,  // 10: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	38, // 11: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
-	3,  // 12: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
-	3,  // 13: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	40, // 14: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
-	39, // 15: memos.api.v1.SetMemoAttachmentsRequest.attachments:type_name -> memos.api.v1.Attachment
-	39, // 16: memos.api.v1.ListMemoAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
-	36, // 17: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
-	36, // 18: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
-	1,  // 19: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
-	14, // 20: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
-	14, // 21: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
-	3,  // 22: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
-	3,  // 23: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
-	2,  // 24: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
-	2,  // 25: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
-	37, // 26: memos.api.v1.MemoShare.create_time:type_name -> google.protobuf.Timestamp
-	37, // 27: memos.api.v1.MemoShare.expire_time:type_name -> google.protobuf.Timestamp
-	25, // 28: memos.api.v1.CreateMemoShareRequest.memo_share:type_name -> memos.api.v1.MemoShare
-	25, // 29: memos.api.v1.ListMemoSharesResponse.memo_shares:type_name -> memos.api.v1.MemoShare
-	34, // 30: memos.api.v1.BatchGetLinkMetadataResponse.link_metadata:type_name -> memos.api.v1.LinkMetadata
-	5,  // 31: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
-	6,  // 32: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
-	8,  // 33: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
-	9,  // 34: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
-	10, // 35: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
-	11, // 36: memos.api.v1.MemoService.SetMemoAttachments:input_type -> memos.api.v1.SetMemoAttachmentsRequest
-	12, // 37: memos.api.v1.MemoService.ListMemoAttachments:input_type -> memos.api.v1.ListMemoAttachmentsRequest
-	15, // 38: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
-	16, // 39: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
-	18, // 40: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
-	19, // 41: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
-	21, // 42: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
-	23, // 43: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
-	24, // 44: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
-	26, // 45: memos.api.v1.MemoService.CreateMemoShare:input_type -> memos.api.v1.CreateMemoShareRequest
-	27, // 46: memos.api.v1.MemoService.ListMemoShares:input_type -> memos.api.v1.ListMemoSharesRequest
-	29, // 47: memos.api.v1.MemoService.DeleteMemoShare:input_type -> memos.api.v1.DeleteMemoShareRequest
-	30, // 48: memos.api.v1.MemoService.GetMemoByShare:input_type -> memos.api.v1.GetMemoByShareRequest
-	31, // 49: memos.api.v1.MemoService.GetLinkMetadata:input_type -> memos.api.v1.GetLinkMetadataRequest
-	32, // 50: memos.api.v1.MemoService.BatchGetLinkMetadata:input_type -> memos.api.v1.BatchGetLinkMetadataRequest
-	3,  // 51: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
-	7,  // 52: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
-	3,  // 53: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
-	3,  // 54: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
-	41, // 55: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
-	41, // 56: memos.api.v1.MemoService.SetMemoAttachments:output_type -> google.protobuf.Empty
-	13, // 57: memos.api.v1.MemoService.ListMemoAttachments:output_type -> memos.api.v1.ListMemoAttachmentsResponse
-	41, // 58: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
-	17, // 59: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
-	3,  // 60: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
-	20, // 61: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
-	22, // 62: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
-	2,  // 63: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
-	41, // 64: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
-	25, // 65: memos.api.v1.MemoService.CreateMemoShare:output_type -> memos.api.v1.MemoShare
-	28, // 66: memos.api.v1.MemoService.ListMemoShares:output_type -> memos.api.v1.ListMemoSharesResponse
-	41, // 67: memos.api.v1.MemoService.DeleteMemoShare:output_type -> google.protobuf.Empty
-	3,  // 68: memos.api.v1.MemoService.GetMemoByShare:output_type -> memos.api.v1.Memo
-	34, // 69: memos.api.v1.MemoService.GetLinkMetadata:output_type -> memos.api.v1.LinkMetadata
-	33, // 70: memos.api.v1.MemoService.BatchGetLinkMetadata:output_type -> memos.api.v1.BatchGetLinkMetadataResponse
-	51, // [51:71] is the sub-list for method output_type
-	31, // [31:51] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	52, // 5: memos.api.v1.Memo.attachments:type_name -> memos.api.v1.Attachment
+	15, // 6: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
+	3,  // 7: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
+	48, // 8: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
+	5,  // 9: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
+	50, // 10: memos.api.v1.Memo.due_time:type_name -> google.protobuf.Timestamp
+	50, // 11: memos.api.v1.Memo.remind_time:type_name -> google.protobuf.Timestamp
+	50, // 12: memos.api.v1.Memo.publish_time:type_name -> google.protobuf.Timestamp
+	4,  // 13: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	51, // 14: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
+	4,  // 15: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
+	4,  // 16: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	53, // 17: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	52, // 18: memos.api.v1.SetMemoAttachmentsRequest.attachments:type_name -> memos.api.v1.Attachment
+	52, // 19: memos.api.v1.ListMemoAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
+	49, // 20: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
+	49, // 21: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
+	1,  // 22: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
+	15, // 23: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
+	15, // 24: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
+	4,  // 25: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
+	4,  // 26: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
+	3,  // 27: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
+	3,  // 28: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
+	50, // 29: memos.api.v1.MemoShare.create_time:type_name -> google.protobuf.Timestamp
+	50, // 30: memos.api.v1.MemoShare.expire_time:type_name -> google.protobuf.Timestamp
+	50, // 31: memos.api.v1.MemoShare.last_access_time:type_name -> google.protobuf.Timestamp
+	50, // 32: memos.api.v1.CollectionShare.create_time:type_name -> google.protobuf.Timestamp
+	50, // 33: memos.api.v1.CollectionShare.expire_time:type_name -> google.protobuf.Timestamp
+	2,  // 34: memos.api.v1.MemoCollaborator.role:type_name -> memos.api.v1.MemoCollaborator.Role
+	50, // 35: memos.api.v1.MemoCollaborator.create_time:type_name -> google.protobuf.Timestamp
+	28, // 36: memos.api.v1.ListMemoCollaboratorsResponse.collaborators:type_name -> memos.api.v1.MemoCollaborator
+	2,  // 37: memos.api.v1.AddMemoCollaboratorRequest.role:type_name -> memos.api.v1.MemoCollaborator.Role
+	26, // 38: memos.api.v1.CreateMemoShareRequest.memo_share:type_name -> memos.api.v1.MemoShare
+	26, // 39: memos.api.v1.ListMemoSharesResponse.memo_shares:type_name -> memos.api.v1.MemoShare
+	27, // 40: memos.api.v1.CreateCollectionShareRequest.collection_share:type_name -> memos.api.v1.CollectionShare
+	27, // 41: memos.api.v1.ListCollectionSharesResponse.collection_shares:type_name -> memos.api.v1.CollectionShare
+	4,  // 42: memos.api.v1.ListMemosByShareResponse.memos:type_name -> memos.api.v1.Memo
+	47, // 43: memos.api.v1.BatchGetLinkMetadataResponse.link_metadata:type_name -> memos.api.v1.LinkMetadata
+	6,  // 44: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
+	7,  // 45: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
+	9,  // 46: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
+	10, // 47: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
+	11, // 48: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
+	12, // 49: memos.api.v1.MemoService.SetMemoAttachments:input_type -> memos.api.v1.SetMemoAttachmentsRequest
+	13, // 50: memos.api.v1.MemoService.ListMemoAttachments:input_type -> memos.api.v1.ListMemoAttachmentsRequest
+	16, // 51: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
+	17, // 52: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
+	19, // 53: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
+	20, // 54: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
+	22, // 55: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
+	24, // 56: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
+	25, // 57: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
+	29, // 58: memos.api.v1.MemoService.ListMemoCollaborators:input_type -> memos.api.v1.ListMemoCollaboratorsRequest
+	31, // 59: memos.api.v1.MemoService.AddMemoCollaborator:input_type -> memos.api.v1.AddMemoCollaboratorRequest
+	32, // 60: memos.api.v1.MemoService.RemoveMemoCollaborator:input_type -> memos.api.v1.RemoveMemoCollaboratorRequest
+	33, // 61: memos.api.v1.MemoService.CreateMemoShare:input_type -> memos.api.v1.CreateMemoShareRequest
+	34, // 62: memos.api.v1.MemoService.ListMemoShares:input_type -> memos.api.v1.ListMemoSharesRequest
+	36, // 63: memos.api.v1.MemoService.DeleteMemoShare:input_type -> memos.api.v1.DeleteMemoShareRequest
+	37, // 64: memos.api.v1.MemoService.GetMemoByShare:input_type -> memos.api.v1.GetMemoByShareRequest
+	38, // 65: memos.api.v1.MemoService.CreateCollectionShare:input_type -> memos.api.v1.CreateCollectionShareRequest
+	39, // 66: memos.api.v1.MemoService.ListCollectionShares:input_type -> memos.api.v1.ListCollectionSharesRequest
+	41, // 67: memos.api.v1.MemoService.DeleteCollectionShare:input_type -> memos.api.v1.DeleteCollectionShareRequest
+	42, // 68: memos.api.v1.MemoService.ListMemosByShare:input_type -> memos.api.v1.ListMemosByShareRequest
+	44, // 69: memos.api.v1.MemoService.GetLinkMetadata:input_type -> memos.api.v1.GetLinkMetadataRequest
+	45, // 70: memos.api.v1.MemoService.BatchGetLinkMetadata:input_type -> memos.api.v1.BatchGetLinkMetadataRequest
+	4,  // 71: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
+	8,  // 72: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
+	4,  // 73: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
+	4,  // 74: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
+	54, // 75: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
+	54, // 76: memos.api.v1.MemoService.SetMemoAttachments:output_type -> google.protobuf.Empty
+	14, // 77: memos.api.v1.MemoService.ListMemoAttachments:output_type -> memos.api.v1.ListMemoAttachmentsResponse
+	54, // 78: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
+	18, // 79: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
+	4,  // 80: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
+	21, // 81: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
+	23, // 82: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
+	3,  // 83: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
+	54, // 84: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
+	30, // 85: memos.api.v1.MemoService.ListMemoCollaborators:output_type -> memos.api.v1.ListMemoCollaboratorsResponse
+	28, // 86: memos.api.v1.MemoService.AddMemoCollaborator:output_type -> memos.api.v1.MemoCollaborator
+	54, // 87: memos.api.v1.MemoService.RemoveMemoCollaborator:output_type -> google.protobuf.Empty
+	26, // 88: memos.api.v1.MemoService.CreateMemoShare:output_type -> memos.api.v1.MemoShare
+	35, // 89: memos.api.v1.MemoService.ListMemoShares:output_type -> memos.api.v1.ListMemoSharesResponse
+	54, // 90: memos.api.v1.MemoService.DeleteMemoShare:output_type -> google.protobuf.Empty
+	4,  // 91: memos.api.v1.MemoService.GetMemoByShare:output_type -> memos.api.v1.Memo
+	27, // 92: memos.api.v1.MemoService.CreateCollectionShare:output_type -> memos.api.v1.CollectionShare
+	40, // 93: memos.api.v1.MemoService.ListCollectionShares:output_type -> memos.api.v1.ListCollectionSharesResponse
+	54, // 94: memos.api.v1.MemoService.DeleteCollectionShare:output_type -> google.protobuf.Empty
+	43, // 95: memos.api.v1.MemoService.ListMemosByShare:output_type -> memos.api.v1.ListMemosByShareResponse
+	47, // 96: memos.api.v1.MemoService.GetLinkMetadata:output_type -> memos.api.v1.LinkMetadata
+	46, // 97: memos.api.v1.MemoService.BatchGetLinkMetadata:output_type -> memos.api.v1.BatchGetLinkMetadataResponse
+	71, // [71:98] is the sub-list for method output_type
+	44, // [44:71] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_memo_service_proto_init() }
@@ -2674,13 +3650,14 @@ func file_api_v1_memo_service_proto_init() {
 	file_api_v1_common_proto_init()
 	file_api_v1_memo_service_proto_msgTypes[1].OneofWrappers = []any{}
 	file_api_v1_memo_service_proto_msgTypes[23].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_memo_service_proto_rawDesc), len(file_api_v1_memo_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   35,
+			NumEnums:      3,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
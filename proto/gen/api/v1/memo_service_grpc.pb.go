@@ -20,26 +20,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemoService_CreateMemo_FullMethodName           = "/memos.api.v1.MemoService/CreateMemo"
-	MemoService_ListMemos_FullMethodName            = "/memos.api.v1.MemoService/ListMemos"
-	MemoService_GetMemo_FullMethodName              = "/memos.api.v1.MemoService/GetMemo"
-	MemoService_UpdateMemo_FullMethodName           = "/memos.api.v1.MemoService/UpdateMemo"
-	MemoService_DeleteMemo_FullMethodName           = "/memos.api.v1.MemoService/DeleteMemo"
-	MemoService_SetMemoAttachments_FullMethodName   = "/memos.api.v1.MemoService/SetMemoAttachments"
-	MemoService_ListMemoAttachments_FullMethodName  = "/memos.api.v1.MemoService/ListMemoAttachments"
-	MemoService_SetMemoRelations_FullMethodName     = "/memos.api.v1.MemoService/SetMemoRelations"
-	MemoService_ListMemoRelations_FullMethodName    = "/memos.api.v1.MemoService/ListMemoRelations"
-	MemoService_CreateMemoComment_FullMethodName    = "/memos.api.v1.MemoService/CreateMemoComment"
-	MemoService_ListMemoComments_FullMethodName     = "/memos.api.v1.MemoService/ListMemoComments"
-	MemoService_ListMemoReactions_FullMethodName    = "/memos.api.v1.MemoService/ListMemoReactions"
-	MemoService_UpsertMemoReaction_FullMethodName   = "/memos.api.v1.MemoService/UpsertMemoReaction"
-	MemoService_DeleteMemoReaction_FullMethodName   = "/memos.api.v1.MemoService/DeleteMemoReaction"
-	MemoService_CreateMemoShare_FullMethodName      = "/memos.api.v1.MemoService/CreateMemoShare"
-	MemoService_ListMemoShares_FullMethodName       = "/memos.api.v1.MemoService/ListMemoShares"
-	MemoService_DeleteMemoShare_FullMethodName      = "/memos.api.v1.MemoService/DeleteMemoShare"
-	MemoService_GetMemoByShare_FullMethodName       = "/memos.api.v1.MemoService/GetMemoByShare"
-	MemoService_GetLinkMetadata_FullMethodName      = "/memos.api.v1.MemoService/GetLinkMetadata"
-	MemoService_BatchGetLinkMetadata_FullMethodName = "/memos.api.v1.MemoService/BatchGetLinkMetadata"
+	MemoService_CreateMemo_FullMethodName             = "/memos.api.v1.MemoService/CreateMemo"
+	MemoService_ListMemos_FullMethodName              = "/memos.api.v1.MemoService/ListMemos"
+	MemoService_GetMemo_FullMethodName                = "/memos.api.v1.MemoService/GetMemo"
+	MemoService_UpdateMemo_FullMethodName             = "/memos.api.v1.MemoService/UpdateMemo"
+	MemoService_DeleteMemo_FullMethodName             = "/memos.api.v1.MemoService/DeleteMemo"
+	MemoService_SetMemoAttachments_FullMethodName     = "/memos.api.v1.MemoService/SetMemoAttachments"
+	MemoService_ListMemoAttachments_FullMethodName    = "/memos.api.v1.MemoService/ListMemoAttachments"
+	MemoService_SetMemoRelations_FullMethodName       = "/memos.api.v1.MemoService/SetMemoRelations"
+	MemoService_ListMemoRelations_FullMethodName      = "/memos.api.v1.MemoService/ListMemoRelations"
+	MemoService_CreateMemoComment_FullMethodName      = "/memos.api.v1.MemoService/CreateMemoComment"
+	MemoService_ListMemoComments_FullMethodName       = "/memos.api.v1.MemoService/ListMemoComments"
+	MemoService_ListMemoReactions_FullMethodName      = "/memos.api.v1.MemoService/ListMemoReactions"
+	MemoService_UpsertMemoReaction_FullMethodName     = "/memos.api.v1.MemoService/UpsertMemoReaction"
+	MemoService_DeleteMemoReaction_FullMethodName     = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_ListMemoCollaborators_FullMethodName  = "/memos.api.v1.MemoService/ListMemoCollaborators"
+	MemoService_AddMemoCollaborator_FullMethodName    = "/memos.api.v1.MemoService/AddMemoCollaborator"
+	MemoService_RemoveMemoCollaborator_FullMethodName = "/memos.api.v1.MemoService/RemoveMemoCollaborator"
+	MemoService_CreateMemoShare_FullMethodName        = "/memos.api.v1.MemoService/CreateMemoShare"
+	MemoService_ListMemoShares_FullMethodName         = "/memos.api.v1.MemoService/ListMemoShares"
+	MemoService_DeleteMemoShare_FullMethodName        = "/memos.api.v1.MemoService/DeleteMemoShare"
+	MemoService_GetMemoByShare_FullMethodName         = "/memos.api.v1.MemoService/GetMemoByShare"
+	MemoService_CreateCollectionShare_FullMethodName  = "/memos.api.v1.MemoService/CreateCollectionShare"
+	MemoService_ListCollectionShares_FullMethodName   = "/memos.api.v1.MemoService/ListCollectionShares"
+	MemoService_DeleteCollectionShare_FullMethodName  = "/memos.api.v1.MemoService/DeleteCollectionShare"
+	MemoService_ListMemosByShare_FullMethodName       = "/memos.api.v1.MemoService/ListMemosByShare"
+	MemoService_GetLinkMetadata_FullMethodName        = "/memos.api.v1.MemoService/GetLinkMetadata"
+	MemoService_BatchGetLinkMetadata_FullMethodName   = "/memos.api.v1.MemoService/BatchGetLinkMetadata"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -74,6 +81,14 @@ type MemoServiceClient interface {
 	UpsertMemoReaction(ctx context.Context, in *UpsertMemoReactionRequest, opts ...grpc.CallOption) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(ctx context.Context, in *DeleteMemoReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemoCollaborators lists the collaborators of a memo.
+	ListMemoCollaborators(ctx context.Context, in *ListMemoCollaboratorsRequest, opts ...grpc.CallOption) (*ListMemoCollaboratorsResponse, error)
+	// AddMemoCollaborator grants a user viewer or editor rights on a memo.
+	// Adding an existing collaborator changes their role. Requires authentication as the memo creator.
+	AddMemoCollaborator(ctx context.Context, in *AddMemoCollaboratorRequest, opts ...grpc.CallOption) (*MemoCollaborator, error)
+	// RemoveMemoCollaborator revokes a collaborator's access to a memo.
+	// Requires authentication as the memo creator, or as the collaborator being removed.
+	RemoveMemoCollaborator(ctx context.Context, in *RemoveMemoCollaboratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// CreateMemoShare creates a share link for a memo. Requires authentication as the memo creator.
 	CreateMemoShare(ctx context.Context, in *CreateMemoShareRequest, opts ...grpc.CallOption) (*MemoShare, error)
 	// ListMemoShares lists all share links for a memo. Requires authentication as the memo creator.
@@ -81,8 +96,20 @@ type MemoServiceClient interface {
 	// DeleteMemoShare revokes a share link. Requires authentication as the memo creator.
 	DeleteMemoShare(ctx context.Context, in *DeleteMemoShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// GetMemoByShare resolves a share token to its memo. No authentication required.
-	// Returns NOT_FOUND if the token is invalid or expired.
+	// Returns NOT_FOUND if the token is invalid, expired or has used up its views,
+	// and PERMISSION_DENIED if the share password is missing or wrong.
 	GetMemoByShare(ctx context.Context, in *GetMemoByShareRequest, opts ...grpc.CallOption) (*Memo, error)
+	// CreateCollectionShare creates a share link for the memos matching one of the
+	// user's shortcuts or carrying a tag. Requires authentication as the user.
+	CreateCollectionShare(ctx context.Context, in *CreateCollectionShareRequest, opts ...grpc.CallOption) (*CollectionShare, error)
+	// ListCollectionShares lists a user's collection share links. Requires authentication as the user.
+	ListCollectionShares(ctx context.Context, in *ListCollectionSharesRequest, opts ...grpc.CallOption) (*ListCollectionSharesResponse, error)
+	// DeleteCollectionShare revokes a collection share link. Requires authentication as the user.
+	DeleteCollectionShare(ctx context.Context, in *DeleteCollectionShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemosByShare resolves a collection share token to the memos of the collection.
+	// No authentication required. Returns NOT_FOUND if the token is invalid or expired,
+	// or if the shared shortcut has been deleted.
+	ListMemosByShare(ctx context.Context, in *ListMemosByShareRequest, opts ...grpc.CallOption) (*ListMemosByShareResponse, error)
 	// GetLinkMetadata gets metadata for a link.
 	GetLinkMetadata(ctx context.Context, in *GetLinkMetadataRequest, opts ...grpc.CallOption) (*LinkMetadata, error)
 	// BatchGetLinkMetadata gets metadata for links.
@@ -237,6 +264,36 @@ func (c *memoServiceClient) DeleteMemoReaction(ctx context.Context, in *DeleteMe
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoCollaborators(ctx context.Context, in *ListMemoCollaboratorsRequest, opts ...grpc.CallOption) (*ListMemoCollaboratorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoCollaborators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) AddMemoCollaborator(ctx context.Context, in *AddMemoCollaboratorRequest, opts ...grpc.CallOption) (*MemoCollaborator, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoCollaborator)
+	err := c.cc.Invoke(ctx, MemoService_AddMemoCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RemoveMemoCollaborator(ctx context.Context, in *RemoveMemoCollaboratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_RemoveMemoCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) CreateMemoShare(ctx context.Context, in *CreateMemoShareRequest, opts ...grpc.CallOption) (*MemoShare, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MemoShare)
@@ -277,6 +334,46 @@ func (c *memoServiceClient) GetMemoByShare(ctx context.Context, in *GetMemoBySha
 	return out, nil
 }
 
+func (c *memoServiceClient) CreateCollectionShare(ctx context.Context, in *CreateCollectionShareRequest, opts ...grpc.CallOption) (*CollectionShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionShare)
+	err := c.cc.Invoke(ctx, MemoService_CreateCollectionShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListCollectionShares(ctx context.Context, in *ListCollectionSharesRequest, opts ...grpc.CallOption) (*ListCollectionSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionSharesResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListCollectionShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DeleteCollectionShare(ctx context.Context, in *DeleteCollectionShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_DeleteCollectionShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemosByShare(ctx context.Context, in *ListMemosByShareRequest, opts ...grpc.CallOption) (*ListMemosByShareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemosByShareResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemosByShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) GetLinkMetadata(ctx context.Context, in *GetLinkMetadataRequest, opts ...grpc.CallOption) (*LinkMetadata, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LinkMetadata)
@@ -329,6 +426,14 @@ type MemoServiceServer interface {
 	UpsertMemoReaction(context.Context, *UpsertMemoReactionRequest) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error)
+	// ListMemoCollaborators lists the collaborators of a memo.
+	ListMemoCollaborators(context.Context, *ListMemoCollaboratorsRequest) (*ListMemoCollaboratorsResponse, error)
+	// AddMemoCollaborator grants a user viewer or editor rights on a memo.
+	// Adding an existing collaborator changes their role. Requires authentication as the memo creator.
+	AddMemoCollaborator(context.Context, *AddMemoCollaboratorRequest) (*MemoCollaborator, error)
+	// RemoveMemoCollaborator revokes a collaborator's access to a memo.
+	// Requires authentication as the memo creator, or as the collaborator being removed.
+	RemoveMemoCollaborator(context.Context, *RemoveMemoCollaboratorRequest) (*emptypb.Empty, error)
 	// CreateMemoShare creates a share link for a memo. Requires authentication as the memo creator.
 	CreateMemoShare(context.Context, *CreateMemoShareRequest) (*MemoShare, error)
 	// ListMemoShares lists all share links for a memo. Requires authentication as the memo creator.
@@ -336,8 +441,20 @@ type MemoServiceServer interface {
 	// DeleteMemoShare revokes a share link. Requires authentication as the memo creator.
 	DeleteMemoShare(context.Context, *DeleteMemoShareRequest) (*emptypb.Empty, error)
 	// GetMemoByShare resolves a share token to its memo. No authentication required.
-	// Returns NOT_FOUND if the token is invalid or expired.
+	// Returns NOT_FOUND if the token is invalid, expired or has used up its views,
+	// and PERMISSION_DENIED if the share password is missing or wrong.
 	GetMemoByShare(context.Context, *GetMemoByShareRequest) (*Memo, error)
+	// CreateCollectionShare creates a share link for the memos matching one of the
+	// user's shortcuts or carrying a tag. Requires authentication as the user.
+	CreateCollectionShare(context.Context, *CreateCollectionShareRequest) (*CollectionShare, error)
+	// ListCollectionShares lists a user's collection share links. Requires authentication as the user.
+	ListCollectionShares(context.Context, *ListCollectionSharesRequest) (*ListCollectionSharesResponse, error)
+	// DeleteCollectionShare revokes a collection share link. Requires authentication as the user.
+	DeleteCollectionShare(context.Context, *DeleteCollectionShareRequest) (*emptypb.Empty, error)
+	// ListMemosByShare resolves a collection share token to the memos of the collection.
+	// No authentication required. Returns NOT_FOUND if the token is invalid or expired,
+	// or if the shared shortcut has been deleted.
+	ListMemosByShare(context.Context, *ListMemosByShareRequest) (*ListMemosByShareResponse, error)
 	// GetLinkMetadata gets metadata for a link.
 	GetLinkMetadata(context.Context, *GetLinkMetadataRequest) (*LinkMetadata, error)
 	// BatchGetLinkMetadata gets metadata for links.
@@ -394,6 +511,15 @@ func (UnimplementedMemoServiceServer) UpsertMemoReaction(context.Context, *Upser
 func (UnimplementedMemoServiceServer) DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteMemoReaction not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoCollaborators(context.Context, *ListMemoCollaboratorsRequest) (*ListMemoCollaboratorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoCollaborators not implemented")
+}
+func (UnimplementedMemoServiceServer) AddMemoCollaborator(context.Context, *AddMemoCollaboratorRequest) (*MemoCollaborator, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddMemoCollaborator not implemented")
+}
+func (UnimplementedMemoServiceServer) RemoveMemoCollaborator(context.Context, *RemoveMemoCollaboratorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveMemoCollaborator not implemented")
+}
 func (UnimplementedMemoServiceServer) CreateMemoShare(context.Context, *CreateMemoShareRequest) (*MemoShare, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateMemoShare not implemented")
 }
@@ -406,6 +532,18 @@ func (UnimplementedMemoServiceServer) DeleteMemoShare(context.Context, *DeleteMe
 func (UnimplementedMemoServiceServer) GetMemoByShare(context.Context, *GetMemoByShareRequest) (*Memo, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMemoByShare not implemented")
 }
+func (UnimplementedMemoServiceServer) CreateCollectionShare(context.Context, *CreateCollectionShareRequest) (*CollectionShare, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCollectionShare not implemented")
+}
+func (UnimplementedMemoServiceServer) ListCollectionShares(context.Context, *ListCollectionSharesRequest) (*ListCollectionSharesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollectionShares not implemented")
+}
+func (UnimplementedMemoServiceServer) DeleteCollectionShare(context.Context, *DeleteCollectionShareRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCollectionShare not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemosByShare(context.Context, *ListMemosByShareRequest) (*ListMemosByShareResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemosByShare not implemented")
+}
 func (UnimplementedMemoServiceServer) GetLinkMetadata(context.Context, *GetLinkMetadataRequest) (*LinkMetadata, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetLinkMetadata not implemented")
 }
@@ -685,6 +823,60 @@ func _MemoService_DeleteMemoReaction_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListMemoCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoCollaborators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoCollaborators(ctx, req.(*ListMemoCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_AddMemoCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMemoCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).AddMemoCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_AddMemoCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).AddMemoCollaborator(ctx, req.(*AddMemoCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RemoveMemoCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemoCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RemoveMemoCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RemoveMemoCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RemoveMemoCollaborator(ctx, req.(*RemoveMemoCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_CreateMemoShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateMemoShareRequest)
 	if err := dec(in); err != nil {
@@ -757,6 +949,78 @@ func _MemoService_GetMemoByShare_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_CreateCollectionShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateCollectionShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateCollectionShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateCollectionShare(ctx, req.(*CreateCollectionShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListCollectionShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListCollectionShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListCollectionShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListCollectionShares(ctx, req.(*ListCollectionSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DeleteCollectionShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DeleteCollectionShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DeleteCollectionShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DeleteCollectionShare(ctx, req.(*DeleteCollectionShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemosByShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemosByShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemosByShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemosByShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemosByShare(ctx, req.(*ListMemosByShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_GetLinkMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLinkMetadataRequest)
 	if err := dec(in); err != nil {
@@ -856,6 +1120,18 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMemoReaction",
 			Handler:    _MemoService_DeleteMemoReaction_Handler,
 		},
+		{
+			MethodName: "ListMemoCollaborators",
+			Handler:    _MemoService_ListMemoCollaborators_Handler,
+		},
+		{
+			MethodName: "AddMemoCollaborator",
+			Handler:    _MemoService_AddMemoCollaborator_Handler,
+		},
+		{
+			MethodName: "RemoveMemoCollaborator",
+			Handler:    _MemoService_RemoveMemoCollaborator_Handler,
+		},
 		{
 			MethodName: "CreateMemoShare",
 			Handler:    _MemoService_CreateMemoShare_Handler,
@@ -872,6 +1148,22 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMemoByShare",
 			Handler:    _MemoService_GetMemoByShare_Handler,
 		},
+		{
+			MethodName: "CreateCollectionShare",
+			Handler:    _MemoService_CreateCollectionShare_Handler,
+		},
+		{
+			MethodName: "ListCollectionShares",
+			Handler:    _MemoService_ListCollectionShares_Handler,
+		},
+		{
+			MethodName: "DeleteCollectionShare",
+			Handler:    _MemoService_DeleteCollectionShare_Handler,
+		},
+		{
+			MethodName: "ListMemosByShare",
+			Handler:    _MemoService_ListMemosByShare_Handler,
+		},
 		{
 			MethodName: "GetLinkMetadata",
 			Handler:    _MemoService_GetLinkMetadata_Handler,
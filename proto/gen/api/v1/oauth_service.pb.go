@@ -0,0 +1,802 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/v1/oauth_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OAuthClient struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the OAuth client.
+	// Format: oauth-clients/{client_id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The client identifier used in OAuth requests.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The display name shown on the consent screen.
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The allowed redirect URIs. Authorization requests must use one of them exactly.
+	RedirectUris []string `protobuf:"bytes,4,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	// The scopes granted when an authorization request does not specify any.
+	Scopes []string `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Whether the client authenticates with a client secret.
+	// Public clients, such as native and browser apps, rely on PKCE only.
+	Confidential bool `protobuf:"varint,6,opt,name=confidential,proto3" json:"confidential,omitempty"`
+	// The resource name of the user who created the client.
+	// Empty for dynamically registered clients.
+	// Format: users/{user}
+	Creator string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The creation timestamp.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthClient) Reset() {
+	*x = OAuthClient{}
+	mi := &file_api_v1_oauth_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthClient) ProtoMessage() {}
+
+func (x *OAuthClient) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_oauth_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthClient.ProtoReflect.Descriptor instead.
+func (*OAuthClient) Descriptor() ([]byte, []int) {
+	return file_api_v1_oauth_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OAuthClient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetRedirectUris() []string {
+	if x != nil {
+		return x.RedirectUris
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetConfidential() bool {
+	if x != nil {
+		return x.Confidential
+	}
+	return false
+}
+
+func (x *OAuthClient) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListOAuthClientsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOAuthClientsRequest) Reset() {
+	*x = ListOAuthClientsRequest{}
+	mi := &file_api_v1_oauth_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOAuthClientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOAuthClientsRequest) ProtoMessage() {}
+
+func (x *ListOAuthClientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_oauth_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOAuthClientsRequest.ProtoReflect.Descriptor instead.
+func (*ListOAuthClientsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_oauth_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ListOAuthClientsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of OAuth clients.
+	OauthClients  []*OAuthClient `protobuf:"bytes,1,rep,name=oauth_clients,json=oauthClients,proto3" json:"oauth_clients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOAuthClientsResponse) Reset() {
+	*x = ListOAuthClientsResponse{}
+	mi := &file_api_v1_oauth_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOAuthClientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOAuthClientsResponse) ProtoMessage() {}
+
+func (x *ListOAuthClientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_oauth_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOAuthClientsResponse.ProtoReflect.Descriptor instead.
+func (*ListOAuthClientsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_oauth_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListOAuthClientsResponse) GetOauthClients() []*OAuthClient {
+	if x != nil {
+		return x.OauthClients
+	}
+	return nil
+}
+
+type CreateOAuthClientRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The OAuth client to create.
+	OauthClient   *OAuthClient `protobuf:"bytes,1,opt,name=oauth_client,json=oauthClient,proto3" json:"oauth_client,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOAuthClientRequest) Reset() {
+	*x = CreateOAuthClientRequest{}
+	mi := &file_api_v1_oauth_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOAuthClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOAuthClientRequest) ProtoMessage() {}
+
+func (x *CreateOAuthClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_oauth_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOAuthClientRequest.ProtoReflect.Descriptor instead.
+func (*CreateOAuthClientRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_oauth_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateOAuthClientRequest) GetOauthClient() *OAuthClient {
+	if x != nil {
+		return x.OauthClient
+	}
+	return nil
+}
+
+type CreateOAuthClientResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created OAuth client.
+	OauthClient *OAuthClient `protobuf:"bytes,1,opt,name=oauth_client,json=oauthClient,proto3" json:"oauth_client,omitempty"`
+	// The client secret - only returned on creation of a confidential client.
+	// This is the only time the secret will be visible.
+	ClientSecret  string `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOAuthClientResponse) Reset() {
+	*x = CreateOAuthClientResponse{}
+	mi := &file_api_v1_oauth_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOAuthClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOAuthClientResponse) ProtoMessage() {}
+
+func (x *CreateOAuthClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_oauth_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOAuthClientResponse.ProtoReflect.Descriptor instead.
+func (*CreateOAuthClientResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_oauth_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateOAuthClientResponse) GetOauthClient() *OAuthClient {
+	if x != nil {
+		return x.OauthClient
+	}
+	return nil
+}
+
+func (x *CreateOAuthClientResponse) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type DeleteOAuthClientRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the OAuth client to delete.
+	// Format: oauth-clients/{client_id}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOAuthClientRequest) Reset() {
+	*x = DeleteOAuthClientRequest{}
+	mi := &file_api_v1_oauth_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOAuthClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOAuthClientRequest) ProtoMessage() {}
+
+func (x *DeleteOAuthClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_oauth_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOAuthClientRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOAuthClientRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_oauth_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteOAuthClientRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// OAuthAuthorizationRequest holds the query parameters of an authorization request.
+type OAuthAuthorizationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Must be "code".
+	ResponseType string `protobuf:"bytes,1,opt,name=response_type,json=responseType,proto3" json:"response_type,omitempty"`
+	ClientId     string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// Must exactly match one of the registered redirect URIs.
+	RedirectUri string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	// Space-separated scopes. Defaults to the scopes registered for the client.
+	Scope string `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	// Opaque value returned to the client unchanged.
+	State string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	// The PKCE code challenge.
+	CodeChallenge string `protobuf:"bytes,6,opt,name=code_challenge,json=codeChallenge,proto3" json:"code_challenge,omitempty"`
+	// Must be "S256".
+	CodeChallengeMethod string `protobuf:"bytes,7,opt,name=code_challenge_method,json=codeChallengeMethod,proto3" json:"code_challenge_method,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *OAuthAuthorizationRequest) Reset() {
+	*x = OAuthAuthorizationRequest{}
+	mi := &file_api_v1_oauth_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthAuthorizationRequest) ProtoMessage() {}
+
+func (x *OAuthAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_oauth_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*OAuthAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_oauth_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OAuthAuthorizationRequest) GetResponseType() string {
+	if x != nil {
+		return x.ResponseType
+	}
+	return ""
+}
+
+func (x *OAuthAuthorizationRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OAuthAuthorizationRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *OAuthAuthorizationRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *OAuthAuthorizationRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OAuthAuthorizationRequest) GetCodeChallenge() string {
+	if x != nil {
+		return x.CodeChallenge
+	}
+	return ""
+}
+
+func (x *OAuthAuthorizationRequest) GetCodeChallengeMethod() string {
+	if x != nil {
+		return x.CodeChallengeMethod
+	}
+	return ""
+}
+
+type GetOAuthConsentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The authorization request to validate.
+	Request       *OAuthAuthorizationRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOAuthConsentRequest) Reset() {
+	*x = GetOAuthConsentRequest{}
+	mi := &file_api_v1_oauth_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOAuthConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOAuthConsentRequest) ProtoMessage() {}
+
+func (x *GetOAuthConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_oauth_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOAuthConsentRequest.ProtoReflect.Descriptor instead.
+func (*GetOAuthConsentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_oauth_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetOAuthConsentRequest) GetRequest() *OAuthAuthorizationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type OAuthConsent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The client requesting access.
+	Client *OAuthClient `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	// The scopes that will be granted if the user approves.
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// The redirect URI the browser returns to.
+	RedirectUri   string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthConsent) Reset() {
+	*x = OAuthConsent{}
+	mi := &file_api_v1_oauth_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthConsent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthConsent) ProtoMessage() {}
+
+func (x *OAuthConsent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_oauth_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthConsent.ProtoReflect.Descriptor instead.
+func (*OAuthConsent) Descriptor() ([]byte, []int) {
+	return file_api_v1_oauth_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OAuthConsent) GetClient() *OAuthClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *OAuthConsent) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OAuthConsent) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+type AuthorizeOAuthClientRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The authorization request being decided.
+	Request *OAuthAuthorizationRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// Whether the user approved the request.
+	Approve       bool `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeOAuthClientRequest) Reset() {
+	*x = AuthorizeOAuthClientRequest{}
+	mi := &file_api_v1_oauth_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeOAuthClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeOAuthClientRequest) ProtoMessage() {}
+
+func (x *AuthorizeOAuthClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_oauth_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeOAuthClientRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeOAuthClientRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_oauth_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AuthorizeOAuthClientRequest) GetRequest() *OAuthAuthorizationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *AuthorizeOAuthClientRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type AuthorizeOAuthClientResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL to redirect the browser to. It carries either an authorization code
+	// or an access_denied error, together with the original state.
+	RedirectUri   string `protobuf:"bytes,1,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeOAuthClientResponse) Reset() {
+	*x = AuthorizeOAuthClientResponse{}
+	mi := &file_api_v1_oauth_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeOAuthClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeOAuthClientResponse) ProtoMessage() {}
+
+func (x *AuthorizeOAuthClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_oauth_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeOAuthClientResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeOAuthClientResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_oauth_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AuthorizeOAuthClientResponse) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+var File_api_v1_oauth_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_oauth_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapi/v1/oauth_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x99\x03\n" +
+	"\vOAuthClient\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12 \n" +
+	"\tclient_id\x18\x02 \x01(\tB\x03\xe0A\x03R\bclientId\x12&\n" +
+	"\fdisplay_name\x18\x03 \x01(\tB\x03\xe0A\x02R\vdisplayName\x12(\n" +
+	"\rredirect_uris\x18\x04 \x03(\tB\x03\xe0A\x02R\fredirectUris\x12\x1b\n" +
+	"\x06scopes\x18\x05 \x03(\tB\x03\xe0A\x01R\x06scopes\x12'\n" +
+	"\fconfidential\x18\x06 \x01(\bB\x03\xe0A\x01R\fconfidential\x12\x1d\n" +
+	"\acreator\x18\a \x01(\tB\x03\xe0A\x03R\acreator\x12@\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime:V\xeaAS\n" +
+	"\x18memos.api.v1/OAuthClient\x12\x1coauth-clients/{oauth_client}*\foauthClients2\voauthClient\"\x19\n" +
+	"\x17ListOAuthClientsRequest\"Z\n" +
+	"\x18ListOAuthClientsResponse\x12>\n" +
+	"\roauth_clients\x18\x01 \x03(\v2\x19.memos.api.v1.OAuthClientR\foauthClients\"]\n" +
+	"\x18CreateOAuthClientRequest\x12A\n" +
+	"\foauth_client\x18\x01 \x01(\v2\x19.memos.api.v1.OAuthClientB\x03\xe0A\x02R\voauthClient\"~\n" +
+	"\x19CreateOAuthClientResponse\x12<\n" +
+	"\foauth_client\x18\x01 \x01(\v2\x19.memos.api.v1.OAuthClientR\voauthClient\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\"P\n" +
+	"\x18DeleteOAuthClientRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/OAuthClientR\x04name\"\xaa\x02\n" +
+	"\x19OAuthAuthorizationRequest\x12(\n" +
+	"\rresponse_type\x18\x01 \x01(\tB\x03\xe0A\x02R\fresponseType\x12 \n" +
+	"\tclient_id\x18\x02 \x01(\tB\x03\xe0A\x02R\bclientId\x12&\n" +
+	"\fredirect_uri\x18\x03 \x01(\tB\x03\xe0A\x02R\vredirectUri\x12\x19\n" +
+	"\x05scope\x18\x04 \x01(\tB\x03\xe0A\x01R\x05scope\x12\x19\n" +
+	"\x05state\x18\x05 \x01(\tB\x03\xe0A\x01R\x05state\x12*\n" +
+	"\x0ecode_challenge\x18\x06 \x01(\tB\x03\xe0A\x02R\rcodeChallenge\x127\n" +
+	"\x15code_challenge_method\x18\a \x01(\tB\x03\xe0A\x02R\x13codeChallengeMethod\"`\n" +
+	"\x16GetOAuthConsentRequest\x12F\n" +
+	"\arequest\x18\x01 \x01(\v2'.memos.api.v1.OAuthAuthorizationRequestB\x03\xe0A\x02R\arequest\"|\n" +
+	"\fOAuthConsent\x121\n" +
+	"\x06client\x18\x01 \x01(\v2\x19.memos.api.v1.OAuthClientR\x06client\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12!\n" +
+	"\fredirect_uri\x18\x03 \x01(\tR\vredirectUri\"\x7f\n" +
+	"\x1bAuthorizeOAuthClientRequest\x12F\n" +
+	"\arequest\x18\x01 \x01(\v2'.memos.api.v1.OAuthAuthorizationRequestB\x03\xe0A\x02R\arequest\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\"A\n" +
+	"\x1cAuthorizeOAuthClientResponse\x12!\n" +
+	"\fredirect_uri\x18\x01 \x01(\tR\vredirectUri2\xc1\x05\n" +
+	"\fOAuthService\x12\x80\x01\n" +
+	"\x10ListOAuthClients\x12%.memos.api.v1.ListOAuthClientsRequest\x1a&.memos.api.v1.ListOAuthClientsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/oauth-clients\x12\xa0\x01\n" +
+	"\x11CreateOAuthClient\x12&.memos.api.v1.CreateOAuthClientRequest\x1a'.memos.api.v1.CreateOAuthClientResponse\":\xdaA\foauth_client\x82\xd3\xe4\x93\x02%:\foauth_client\"\x15/api/v1/oauth-clients\x12\x82\x01\n" +
+	"\x11DeleteOAuthClient\x12&.memos.api.v1.DeleteOAuthClientRequest\x1a\x16.google.protobuf.Empty\"-\xdaA\x04name\x82\xd3\xe4\x93\x02 *\x1e/api/v1/{name=oauth-clients/*}\x12r\n" +
+	"\x0fGetOAuthConsent\x12$.memos.api.v1.GetOAuthConsentRequest\x1a\x1a.memos.api.v1.OAuthConsent\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/oauth/consent\x12\x91\x01\n" +
+	"\x14AuthorizeOAuthClient\x12).memos.api.v1.AuthorizeOAuthClientRequest\x1a*.memos.api.v1.AuthorizeOAuthClientResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/oauth/authorizeB\xa9\x01\n" +
+	"\x10com.memos.api.v1B\x11OauthServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_oauth_service_proto_rawDescOnce sync.Once
+	file_api_v1_oauth_service_proto_rawDescData []byte
+)
+
+func file_api_v1_oauth_service_proto_rawDescGZIP() []byte {
+	file_api_v1_oauth_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_oauth_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_oauth_service_proto_rawDesc), len(file_api_v1_oauth_service_proto_rawDesc)))
+	})
+	return file_api_v1_oauth_service_proto_rawDescData
+}
+
+var file_api_v1_oauth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_v1_oauth_service_proto_goTypes = []any{
+	(*OAuthClient)(nil),                  // 0: memos.api.v1.OAuthClient
+	(*ListOAuthClientsRequest)(nil),      // 1: memos.api.v1.ListOAuthClientsRequest
+	(*ListOAuthClientsResponse)(nil),     // 2: memos.api.v1.ListOAuthClientsResponse
+	(*CreateOAuthClientRequest)(nil),     // 3: memos.api.v1.CreateOAuthClientRequest
+	(*CreateOAuthClientResponse)(nil),    // 4: memos.api.v1.CreateOAuthClientResponse
+	(*DeleteOAuthClientRequest)(nil),     // 5: memos.api.v1.DeleteOAuthClientRequest
+	(*OAuthAuthorizationRequest)(nil),    // 6: memos.api.v1.OAuthAuthorizationRequest
+	(*GetOAuthConsentRequest)(nil),       // 7: memos.api.v1.GetOAuthConsentRequest
+	(*OAuthConsent)(nil),                 // 8: memos.api.v1.OAuthConsent
+	(*AuthorizeOAuthClientRequest)(nil),  // 9: memos.api.v1.AuthorizeOAuthClientRequest
+	(*AuthorizeOAuthClientResponse)(nil), // 10: memos.api.v1.AuthorizeOAuthClientResponse
+	(*timestamppb.Timestamp)(nil),        // 11: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                // 12: google.protobuf.Empty
+}
+var file_api_v1_oauth_service_proto_depIdxs = []int32{
+	11, // 0: memos.api.v1.OAuthClient.create_time:type_name -> google.protobuf.Timestamp
+	0,  // 1: memos.api.v1.ListOAuthClientsResponse.oauth_clients:type_name -> memos.api.v1.OAuthClient
+	0,  // 2: memos.api.v1.CreateOAuthClientRequest.oauth_client:type_name -> memos.api.v1.OAuthClient
+	0,  // 3: memos.api.v1.CreateOAuthClientResponse.oauth_client:type_name -> memos.api.v1.OAuthClient
+	6,  // 4: memos.api.v1.GetOAuthConsentRequest.request:type_name -> memos.api.v1.OAuthAuthorizationRequest
+	0,  // 5: memos.api.v1.OAuthConsent.client:type_name -> memos.api.v1.OAuthClient
+	6,  // 6: memos.api.v1.AuthorizeOAuthClientRequest.request:type_name -> memos.api.v1.OAuthAuthorizationRequest
+	1,  // 7: memos.api.v1.OAuthService.ListOAuthClients:input_type -> memos.api.v1.ListOAuthClientsRequest
+	3,  // 8: memos.api.v1.OAuthService.CreateOAuthClient:input_type -> memos.api.v1.CreateOAuthClientRequest
+	5,  // 9: memos.api.v1.OAuthService.DeleteOAuthClient:input_type -> memos.api.v1.DeleteOAuthClientRequest
+	7,  // 10: memos.api.v1.OAuthService.GetOAuthConsent:input_type -> memos.api.v1.GetOAuthConsentRequest
+	9,  // 11: memos.api.v1.OAuthService.AuthorizeOAuthClient:input_type -> memos.api.v1.AuthorizeOAuthClientRequest
+	2,  // 12: memos.api.v1.OAuthService.ListOAuthClients:output_type -> memos.api.v1.ListOAuthClientsResponse
+	4,  // 13: memos.api.v1.OAuthService.CreateOAuthClient:output_type -> memos.api.v1.CreateOAuthClientResponse
+	12, // 14: memos.api.v1.OAuthService.DeleteOAuthClient:output_type -> google.protobuf.Empty
+	8,  // 15: memos.api.v1.OAuthService.GetOAuthConsent:output_type -> memos.api.v1.OAuthConsent
+	10, // 16: memos.api.v1.OAuthService.AuthorizeOAuthClient:output_type -> memos.api.v1.AuthorizeOAuthClientResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_oauth_service_proto_init() }
+func file_api_v1_oauth_service_proto_init() {
+	if File_api_v1_oauth_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_oauth_service_proto_rawDesc), len(file_api_v1_oauth_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_oauth_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_oauth_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_oauth_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_oauth_service_proto = out.File
+	file_api_v1_oauth_service_proto_goTypes = nil
+	file_api_v1_oauth_service_proto_depIdxs = nil
+}
@@ -0,0 +1,300 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: api/v1/oauth_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OAuthService_ListOAuthClients_FullMethodName     = "/memos.api.v1.OAuthService/ListOAuthClients"
+	OAuthService_CreateOAuthClient_FullMethodName    = "/memos.api.v1.OAuthService/CreateOAuthClient"
+	OAuthService_DeleteOAuthClient_FullMethodName    = "/memos.api.v1.OAuthService/DeleteOAuthClient"
+	OAuthService_GetOAuthConsent_FullMethodName      = "/memos.api.v1.OAuthService/GetOAuthConsent"
+	OAuthService_AuthorizeOAuthClient_FullMethodName = "/memos.api.v1.OAuthService/AuthorizeOAuthClient"
+)
+
+// OAuthServiceClient is the client API for OAuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// OAuthService manages third-party applications that access Memos through the
+// OAuth2 authorization server, and backs the consent screen of the authorization flow.
+// The protocol endpoints themselves (token, userinfo, registration) are served under /oauth.
+type OAuthServiceClient interface {
+	// ListOAuthClients returns the OAuth clients created by the current user.
+	// Admins see every client, including dynamically registered ones.
+	ListOAuthClients(ctx context.Context, in *ListOAuthClientsRequest, opts ...grpc.CallOption) (*ListOAuthClientsResponse, error)
+	// CreateOAuthClient registers a new OAuth client owned by the current user.
+	CreateOAuthClient(ctx context.Context, in *CreateOAuthClientRequest, opts ...grpc.CallOption) (*CreateOAuthClientResponse, error)
+	// DeleteOAuthClient deletes an OAuth client and revokes every token issued to it.
+	DeleteOAuthClient(ctx context.Context, in *DeleteOAuthClientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetOAuthConsent validates an authorization request and returns the data
+	// shown on the consent screen.
+	GetOAuthConsent(ctx context.Context, in *GetOAuthConsentRequest, opts ...grpc.CallOption) (*OAuthConsent, error)
+	// AuthorizeOAuthClient records the decision of the current user on an
+	// authorization request and returns the URL to redirect the browser to.
+	// Only browser sessions can authorize clients.
+	AuthorizeOAuthClient(ctx context.Context, in *AuthorizeOAuthClientRequest, opts ...grpc.CallOption) (*AuthorizeOAuthClientResponse, error)
+}
+
+type oAuthServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOAuthServiceClient(cc grpc.ClientConnInterface) OAuthServiceClient {
+	return &oAuthServiceClient{cc}
+}
+
+func (c *oAuthServiceClient) ListOAuthClients(ctx context.Context, in *ListOAuthClientsRequest, opts ...grpc.CallOption) (*ListOAuthClientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOAuthClientsResponse)
+	err := c.cc.Invoke(ctx, OAuthService_ListOAuthClients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthServiceClient) CreateOAuthClient(ctx context.Context, in *CreateOAuthClientRequest, opts ...grpc.CallOption) (*CreateOAuthClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOAuthClientResponse)
+	err := c.cc.Invoke(ctx, OAuthService_CreateOAuthClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthServiceClient) DeleteOAuthClient(ctx context.Context, in *DeleteOAuthClientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OAuthService_DeleteOAuthClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthServiceClient) GetOAuthConsent(ctx context.Context, in *GetOAuthConsentRequest, opts ...grpc.CallOption) (*OAuthConsent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthConsent)
+	err := c.cc.Invoke(ctx, OAuthService_GetOAuthConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthServiceClient) AuthorizeOAuthClient(ctx context.Context, in *AuthorizeOAuthClientRequest, opts ...grpc.CallOption) (*AuthorizeOAuthClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizeOAuthClientResponse)
+	err := c.cc.Invoke(ctx, OAuthService_AuthorizeOAuthClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OAuthServiceServer is the server API for OAuthService service.
+// All implementations must embed UnimplementedOAuthServiceServer
+// for forward compatibility.
+//
+// OAuthService manages third-party applications that access Memos through the
+// OAuth2 authorization server, and backs the consent screen of the authorization flow.
+// The protocol endpoints themselves (token, userinfo, registration) are served under /oauth.
+type OAuthServiceServer interface {
+	// ListOAuthClients returns the OAuth clients created by the current user.
+	// Admins see every client, including dynamically registered ones.
+	ListOAuthClients(context.Context, *ListOAuthClientsRequest) (*ListOAuthClientsResponse, error)
+	// CreateOAuthClient registers a new OAuth client owned by the current user.
+	CreateOAuthClient(context.Context, *CreateOAuthClientRequest) (*CreateOAuthClientResponse, error)
+	// DeleteOAuthClient deletes an OAuth client and revokes every token issued to it.
+	DeleteOAuthClient(context.Context, *DeleteOAuthClientRequest) (*emptypb.Empty, error)
+	// GetOAuthConsent validates an authorization request and returns the data
+	// shown on the consent screen.
+	GetOAuthConsent(context.Context, *GetOAuthConsentRequest) (*OAuthConsent, error)
+	// AuthorizeOAuthClient records the decision of the current user on an
+	// authorization request and returns the URL to redirect the browser to.
+	// Only browser sessions can authorize clients.
+	AuthorizeOAuthClient(context.Context, *AuthorizeOAuthClientRequest) (*AuthorizeOAuthClientResponse, error)
+	mustEmbedUnimplementedOAuthServiceServer()
+}
+
+// UnimplementedOAuthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOAuthServiceServer struct{}
+
+func (UnimplementedOAuthServiceServer) ListOAuthClients(context.Context, *ListOAuthClientsRequest) (*ListOAuthClientsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOAuthClients not implemented")
+}
+func (UnimplementedOAuthServiceServer) CreateOAuthClient(context.Context, *CreateOAuthClientRequest) (*CreateOAuthClientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateOAuthClient not implemented")
+}
+func (UnimplementedOAuthServiceServer) DeleteOAuthClient(context.Context, *DeleteOAuthClientRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteOAuthClient not implemented")
+}
+func (UnimplementedOAuthServiceServer) GetOAuthConsent(context.Context, *GetOAuthConsentRequest) (*OAuthConsent, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOAuthConsent not implemented")
+}
+func (UnimplementedOAuthServiceServer) AuthorizeOAuthClient(context.Context, *AuthorizeOAuthClientRequest) (*AuthorizeOAuthClientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AuthorizeOAuthClient not implemented")
+}
+func (UnimplementedOAuthServiceServer) mustEmbedUnimplementedOAuthServiceServer() {}
+func (UnimplementedOAuthServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeOAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OAuthServiceServer will
+// result in compilation errors.
+type UnsafeOAuthServiceServer interface {
+	mustEmbedUnimplementedOAuthServiceServer()
+}
+
+func RegisterOAuthServiceServer(s grpc.ServiceRegistrar, srv OAuthServiceServer) {
+	// If the following call panics, it indicates UnimplementedOAuthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OAuthService_ServiceDesc, srv)
+}
+
+func _OAuthService_ListOAuthClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOAuthClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthServiceServer).ListOAuthClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthService_ListOAuthClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthServiceServer).ListOAuthClients(ctx, req.(*ListOAuthClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthService_CreateOAuthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOAuthClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthServiceServer).CreateOAuthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthService_CreateOAuthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthServiceServer).CreateOAuthClient(ctx, req.(*CreateOAuthClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthService_DeleteOAuthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOAuthClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthServiceServer).DeleteOAuthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthService_DeleteOAuthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthServiceServer).DeleteOAuthClient(ctx, req.(*DeleteOAuthClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthService_GetOAuthConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOAuthConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthServiceServer).GetOAuthConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthService_GetOAuthConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthServiceServer).GetOAuthConsent(ctx, req.(*GetOAuthConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthService_AuthorizeOAuthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeOAuthClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthServiceServer).AuthorizeOAuthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthService_AuthorizeOAuthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthServiceServer).AuthorizeOAuthClient(ctx, req.(*AuthorizeOAuthClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OAuthService_ServiceDesc is the grpc.ServiceDesc for OAuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OAuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.OAuthService",
+	HandlerType: (*OAuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListOAuthClients",
+			Handler:    _OAuthService_ListOAuthClients_Handler,
+		},
+		{
+			MethodName: "CreateOAuthClient",
+			Handler:    _OAuthService_CreateOAuthClient_Handler,
+		},
+		{
+			MethodName: "DeleteOAuthClient",
+			Handler:    _OAuthService_DeleteOAuthClient_Handler,
+		},
+		{
+			MethodName: "GetOAuthConsent",
+			Handler:    _OAuthService_GetOAuthConsent_Handler,
+		},
+		{
+			MethodName: "AuthorizeOAuthClient",
+			Handler:    _OAuthService_AuthorizeOAuthClient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/oauth_service.proto",
+}
@@ -0,0 +1,582 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/v1/role_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Permission is a named capability that a custom role grants on top of the USER role.
+// Admins implicitly hold every permission.
+type Permission int32
+
+const (
+	Permission_PERMISSION_UNSPECIFIED Permission = 0
+	// Create, update, archive and unlock non-admin users, and manage invitations.
+	Permission_MANAGE_USERS Permission = 1
+	// Read and update instance settings.
+	Permission_MANAGE_SETTINGS Permission = 2
+	// Archive and restore other users' public and protected memos, and manage tag metadata.
+	Permission_MODERATE_MEMOS Permission = 3
+	// Read instance statistics.
+	Permission_VIEW_STATS Permission = 4
+	// Create, update and delete identity providers.
+	Permission_MANAGE_IDENTITY_PROVIDERS Permission = 5
+)
+
+// Enum value maps for Permission.
+var (
+	Permission_name = map[int32]string{
+		0: "PERMISSION_UNSPECIFIED",
+		1: "MANAGE_USERS",
+		2: "MANAGE_SETTINGS",
+		3: "MODERATE_MEMOS",
+		4: "VIEW_STATS",
+		5: "MANAGE_IDENTITY_PROVIDERS",
+	}
+	Permission_value = map[string]int32{
+		"PERMISSION_UNSPECIFIED":    0,
+		"MANAGE_USERS":              1,
+		"MANAGE_SETTINGS":           2,
+		"MODERATE_MEMOS":            3,
+		"VIEW_STATS":                4,
+		"MANAGE_IDENTITY_PROVIDERS": 5,
+	}
+)
+
+func (x Permission) Enum() *Permission {
+	p := new(Permission)
+	*p = x
+	return p
+}
+
+func (x Permission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_role_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Permission) Type() protoreflect.EnumType {
+	return &file_api_v1_role_service_proto_enumTypes[0]
+}
+
+func (x Permission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission.Descriptor instead.
+func (Permission) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_role_service_proto_rawDescGZIP(), []int{0}
+}
+
+type Role struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the role.
+	// Format: roles/{role}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. A description of what the role is for.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The permissions granted by the role.
+	Permissions []Permission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=memos.api.v1.Permission" json:"permissions,omitempty"`
+	// Output only. The creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. The last update timestamp.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_api_v1_role_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_role_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_api_v1_role_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() []Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *Role) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Role) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type ListRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_api_v1_role_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_role_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_role_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ListRolesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of custom roles.
+	Roles         []*Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_api_v1_role_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_role_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_role_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type GetRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the role.
+	// Format: roles/{role}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleRequest) Reset() {
+	*x = GetRoleRequest{}
+	mi := &file_api_v1_role_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleRequest) ProtoMessage() {}
+
+func (x *GetRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_role_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_role_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The role to create.
+	Role *Role `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// Required. The ID to use for the role, which will become the final component of the resource name.
+	// Must start with a lowercase letter and contain only lowercase letters, digits and hyphens.
+	RoleId        string `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleRequest) Reset() {
+	*x = CreateRoleRequest{}
+	mi := &file_api_v1_role_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleRequest) ProtoMessage() {}
+
+func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_role_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_role_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateRoleRequest) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *CreateRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type UpdateRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The role to update.
+	Role *Role `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// Required. The update mask. Supported fields: description, permissions.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_api_v1_role_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_role_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_role_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateRoleRequest) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the role to delete.
+	// Format: roles/{role}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_api_v1_role_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_role_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_role_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_api_v1_role_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_role_service_proto_rawDesc = "" +
+	"\n" +
+	"\x19api/v1/role_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc4\x02\n" +
+	"\x04Role\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tB\x03\xe0A\x01R\vdescription\x12?\n" +
+	"\vpermissions\x18\x03 \x03(\x0e2\x18.memos.api.v1.PermissionB\x03\xe0A\x01R\vpermissions\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime:7\xeaA4\n" +
+	"\x11memos.api.v1/Role\x12\froles/{role}\x1a\x04name*\x05roles2\x04role\"\x12\n" +
+	"\x10ListRolesRequest\"=\n" +
+	"\x11ListRolesResponse\x12(\n" +
+	"\x05roles\x18\x01 \x03(\v2\x12.memos.api.v1.RoleR\x05roles\"?\n" +
+	"\x0eGetRoleRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/RoleR\x04name\"^\n" +
+	"\x11CreateRoleRequest\x12+\n" +
+	"\x04role\x18\x01 \x01(\v2\x12.memos.api.v1.RoleB\x03\xe0A\x02R\x04role\x12\x1c\n" +
+	"\arole_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06roleId\"\x82\x01\n" +
+	"\x11UpdateRoleRequest\x12+\n" +
+	"\x04role\x18\x01 \x01(\v2\x12.memos.api.v1.RoleB\x03\xe0A\x02R\x04role\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"B\n" +
+	"\x11DeleteRoleRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/RoleR\x04name*\x92\x01\n" +
+	"\n" +
+	"Permission\x12\x1a\n" +
+	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fMANAGE_USERS\x10\x01\x12\x13\n" +
+	"\x0fMANAGE_SETTINGS\x10\x02\x12\x12\n" +
+	"\x0eMODERATE_MEMOS\x10\x03\x12\x0e\n" +
+	"\n" +
+	"VIEW_STATS\x10\x04\x12\x1d\n" +
+	"\x19MANAGE_IDENTITY_PROVIDERS\x10\x052\xb4\x04\n" +
+	"\vRoleService\x12c\n" +
+	"\tListRoles\x12\x1e.memos.api.v1.ListRolesRequest\x1a\x1f.memos.api.v1.ListRolesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/roles\x12b\n" +
+	"\aGetRole\x12\x1c.memos.api.v1.GetRoleRequest\x1a\x12.memos.api.v1.Role\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=roles/*}\x12m\n" +
+	"\n" +
+	"CreateRole\x12\x1f.memos.api.v1.CreateRoleRequest\x1a\x12.memos.api.v1.Role\"*\xdaA\frole,role_id\x82\xd3\xe4\x93\x02\x15:\x04role\"\r/api/v1/roles\x12\x7f\n" +
+	"\n" +
+	"UpdateRole\x12\x1f.memos.api.v1.UpdateRoleRequest\x1a\x12.memos.api.v1.Role\"<\xdaA\x10role,update_mask\x82\xd3\xe4\x93\x02#:\x04role2\x1b/api/v1/{role.name=roles/*}\x12l\n" +
+	"\n" +
+	"DeleteRole\x12\x1f.memos.api.v1.DeleteRoleRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=roles/*}B\xa8\x01\n" +
+	"\x10com.memos.api.v1B\x10RoleServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_role_service_proto_rawDescOnce sync.Once
+	file_api_v1_role_service_proto_rawDescData []byte
+)
+
+func file_api_v1_role_service_proto_rawDescGZIP() []byte {
+	file_api_v1_role_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_role_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_role_service_proto_rawDesc), len(file_api_v1_role_service_proto_rawDesc)))
+	})
+	return file_api_v1_role_service_proto_rawDescData
+}
+
+var file_api_v1_role_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_v1_role_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_v1_role_service_proto_goTypes = []any{
+	(Permission)(0),               // 0: memos.api.v1.Permission
+	(*Role)(nil),                  // 1: memos.api.v1.Role
+	(*ListRolesRequest)(nil),      // 2: memos.api.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),     // 3: memos.api.v1.ListRolesResponse
+	(*GetRoleRequest)(nil),        // 4: memos.api.v1.GetRoleRequest
+	(*CreateRoleRequest)(nil),     // 5: memos.api.v1.CreateRoleRequest
+	(*UpdateRoleRequest)(nil),     // 6: memos.api.v1.UpdateRoleRequest
+	(*DeleteRoleRequest)(nil),     // 7: memos.api.v1.DeleteRoleRequest
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 9: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 10: google.protobuf.Empty
+}
+var file_api_v1_role_service_proto_depIdxs = []int32{
+	0,  // 0: memos.api.v1.Role.permissions:type_name -> memos.api.v1.Permission
+	8,  // 1: memos.api.v1.Role.create_time:type_name -> google.protobuf.Timestamp
+	8,  // 2: memos.api.v1.Role.update_time:type_name -> google.protobuf.Timestamp
+	1,  // 3: memos.api.v1.ListRolesResponse.roles:type_name -> memos.api.v1.Role
+	1,  // 4: memos.api.v1.CreateRoleRequest.role:type_name -> memos.api.v1.Role
+	1,  // 5: memos.api.v1.UpdateRoleRequest.role:type_name -> memos.api.v1.Role
+	9,  // 6: memos.api.v1.UpdateRoleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 7: memos.api.v1.RoleService.ListRoles:input_type -> memos.api.v1.ListRolesRequest
+	4,  // 8: memos.api.v1.RoleService.GetRole:input_type -> memos.api.v1.GetRoleRequest
+	5,  // 9: memos.api.v1.RoleService.CreateRole:input_type -> memos.api.v1.CreateRoleRequest
+	6,  // 10: memos.api.v1.RoleService.UpdateRole:input_type -> memos.api.v1.UpdateRoleRequest
+	7,  // 11: memos.api.v1.RoleService.DeleteRole:input_type -> memos.api.v1.DeleteRoleRequest
+	3,  // 12: memos.api.v1.RoleService.ListRoles:output_type -> memos.api.v1.ListRolesResponse
+	1,  // 13: memos.api.v1.RoleService.GetRole:output_type -> memos.api.v1.Role
+	1,  // 14: memos.api.v1.RoleService.CreateRole:output_type -> memos.api.v1.Role
+	1,  // 15: memos.api.v1.RoleService.UpdateRole:output_type -> memos.api.v1.Role
+	10, // 16: memos.api.v1.RoleService.DeleteRole:output_type -> google.protobuf.Empty
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_role_service_proto_init() }
+func file_api_v1_role_service_proto_init() {
+	if File_api_v1_role_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_role_service_proto_rawDesc), len(file_api_v1_role_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_role_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_role_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_role_service_proto_enumTypes,
+		MessageInfos:      file_api_v1_role_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_role_service_proto = out.File
+	file_api_v1_role_service_proto_goTypes = nil
+	file_api_v1_role_service_proto_depIdxs = nil
+}
@@ -86,6 +86,8 @@ const (
 	UserSetting_GENERAL UserSetting_Key = 1
 	// WEBHOOKS is the key for user webhooks.
 	UserSetting_WEBHOOKS UserSetting_Key = 4
+	// NOTIFICATION is the key for notification delivery preferences.
+	UserSetting_NOTIFICATION UserSetting_Key = 5
 )
 
 // Enum value maps for UserSetting_Key.
@@ -94,11 +96,13 @@ var (
 		0: "KEY_UNSPECIFIED",
 		1: "GENERAL",
 		4: "WEBHOOKS",
+		5: "NOTIFICATION",
 	}
 	UserSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED": 0,
 		"GENERAL":         1,
 		"WEBHOOKS":        4,
+		"NOTIFICATION":    5,
 	}
 )
 
@@ -126,7 +130,243 @@ func (x UserSetting_Key) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserSetting_Key.Descriptor instead.
 func (UserSetting_Key) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 0}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14, 0}
+}
+
+// How notification emails are delivered.
+type UserSetting_NotificationSetting_EmailDelivery int32
+
+const (
+	// Unspecified is treated as IMMEDIATE.
+	UserSetting_NotificationSetting_EMAIL_DELIVERY_UNSPECIFIED UserSetting_NotificationSetting_EmailDelivery = 0
+	// Email each notification as soon as it is created.
+	UserSetting_NotificationSetting_IMMEDIATE UserSetting_NotificationSetting_EmailDelivery = 1
+	// Batch unread notifications into an hourly digest email.
+	UserSetting_NotificationSetting_HOURLY_DIGEST UserSetting_NotificationSetting_EmailDelivery = 2
+	// Batch unread notifications into a daily digest email.
+	UserSetting_NotificationSetting_DAILY_DIGEST UserSetting_NotificationSetting_EmailDelivery = 3
+	// Never email the notification.
+	UserSetting_NotificationSetting_OFF UserSetting_NotificationSetting_EmailDelivery = 4
+)
+
+// Enum value maps for UserSetting_NotificationSetting_EmailDelivery.
+var (
+	UserSetting_NotificationSetting_EmailDelivery_name = map[int32]string{
+		0: "EMAIL_DELIVERY_UNSPECIFIED",
+		1: "IMMEDIATE",
+		2: "HOURLY_DIGEST",
+		3: "DAILY_DIGEST",
+		4: "OFF",
+	}
+	UserSetting_NotificationSetting_EmailDelivery_value = map[string]int32{
+		"EMAIL_DELIVERY_UNSPECIFIED": 0,
+		"IMMEDIATE":                  1,
+		"HOURLY_DIGEST":              2,
+		"DAILY_DIGEST":               3,
+		"OFF":                        4,
+	}
+)
+
+func (x UserSetting_NotificationSetting_EmailDelivery) Enum() *UserSetting_NotificationSetting_EmailDelivery {
+	p := new(UserSetting_NotificationSetting_EmailDelivery)
+	*p = x
+	return p
+}
+
+func (x UserSetting_NotificationSetting_EmailDelivery) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserSetting_NotificationSetting_EmailDelivery) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[2].Descriptor()
+}
+
+func (UserSetting_NotificationSetting_EmailDelivery) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[2]
+}
+
+func (x UserSetting_NotificationSetting_EmailDelivery) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserSetting_NotificationSetting_EmailDelivery.Descriptor instead.
+func (UserSetting_NotificationSetting_EmailDelivery) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14, 2, 0}
+}
+
+type UserSetting_NotificationSetting_Channel_Type int32
+
+const (
+	UserSetting_NotificationSetting_Channel_TYPE_UNSPECIFIED UserSetting_NotificationSetting_Channel_Type = 0
+	// ntfy (https://ntfy.sh).
+	UserSetting_NotificationSetting_Channel_NTFY UserSetting_NotificationSetting_Channel_Type = 1
+	// Gotify (https://gotify.net).
+	UserSetting_NotificationSetting_Channel_GOTIFY UserSetting_NotificationSetting_Channel_Type = 2
+	// Apprise API (https://github.com/caronc/apprise-api).
+	UserSetting_NotificationSetting_Channel_APPRISE UserSetting_NotificationSetting_Channel_Type = 3
+)
+
+// Enum value maps for UserSetting_NotificationSetting_Channel_Type.
+var (
+	UserSetting_NotificationSetting_Channel_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "NTFY",
+		2: "GOTIFY",
+		3: "APPRISE",
+	}
+	UserSetting_NotificationSetting_Channel_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"NTFY":             1,
+		"GOTIFY":           2,
+		"APPRISE":          3,
+	}
+)
+
+func (x UserSetting_NotificationSetting_Channel_Type) Enum() *UserSetting_NotificationSetting_Channel_Type {
+	p := new(UserSetting_NotificationSetting_Channel_Type)
+	*p = x
+	return p
+}
+
+func (x UserSetting_NotificationSetting_Channel_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserSetting_NotificationSetting_Channel_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[3].Descriptor()
+}
+
+func (UserSetting_NotificationSetting_Channel_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[3]
+}
+
+func (x UserSetting_NotificationSetting_Channel_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserSetting_NotificationSetting_Channel_Type.Descriptor instead.
+func (UserSetting_NotificationSetting_Channel_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14, 2, 1, 0}
+}
+
+// The payload format of a webhook delivery.
+type UserWebhook_Format int32
+
+const (
+	UserWebhook_FORMAT_UNSPECIFIED UserWebhook_Format = 0
+	// The memos JSON payload, the default.
+	UserWebhook_MEMOS UserWebhook_Format = 1
+	// A Slack incoming webhook message.
+	UserWebhook_SLACK UserWebhook_Format = 2
+	// A Discord webhook message with an embed.
+	UserWebhook_DISCORD UserWebhook_Format = 3
+	// A Microsoft Teams message with an adaptive card.
+	UserWebhook_TEAMS UserWebhook_Format = 4
+	// A Telegram Bot API sendMessage request.
+	// The URL is https://api.telegram.org/bot{token}/sendMessage?chat_id={chat}.
+	UserWebhook_TELEGRAM UserWebhook_Format = 5
+)
+
+// Enum value maps for UserWebhook_Format.
+var (
+	UserWebhook_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "MEMOS",
+		2: "SLACK",
+		3: "DISCORD",
+		4: "TEAMS",
+		5: "TELEGRAM",
+	}
+	UserWebhook_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"MEMOS":              1,
+		"SLACK":              2,
+		"DISCORD":            3,
+		"TEAMS":              4,
+		"TELEGRAM":           5,
+	}
+)
+
+func (x UserWebhook_Format) Enum() *UserWebhook_Format {
+	p := new(UserWebhook_Format)
+	*p = x
+	return p
+}
+
+func (x UserWebhook_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserWebhook_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[4].Descriptor()
+}
+
+func (UserWebhook_Format) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[4]
+}
+
+func (x UserWebhook_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserWebhook_Format.Descriptor instead.
+func (UserWebhook_Format) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{36, 0}
+}
+
+type WebhookDelivery_State int32
+
+const (
+	WebhookDelivery_STATE_UNSPECIFIED WebhookDelivery_State = 0
+	// The delivery is waiting for its next attempt.
+	WebhookDelivery_PENDING WebhookDelivery_State = 1
+	// The delivery was accepted by the endpoint.
+	WebhookDelivery_SUCCEEDED WebhookDelivery_State = 2
+	// The delivery ran out of attempts, or its webhook was disabled.
+	WebhookDelivery_FAILED WebhookDelivery_State = 3
+)
+
+// Enum value maps for WebhookDelivery_State.
+var (
+	WebhookDelivery_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	WebhookDelivery_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"SUCCEEDED":         2,
+		"FAILED":            3,
+	}
+)
+
+func (x WebhookDelivery_State) Enum() *WebhookDelivery_State {
+	p := new(WebhookDelivery_State)
+	*p = x
+	return p
+}
+
+func (x WebhookDelivery_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDelivery_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[5].Descriptor()
+}
+
+func (WebhookDelivery_State) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[5]
+}
+
+func (x WebhookDelivery_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDelivery_State.Descriptor instead.
+func (WebhookDelivery_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{42, 0}
 }
 
 type UserNotification_Status int32
@@ -162,11 +402,11 @@ func (x UserNotification_Status) String() string {
 }
 
 func (UserNotification_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_user_service_proto_enumTypes[2].Descriptor()
+	return file_api_v1_user_service_proto_enumTypes[6].Descriptor()
 }
 
 func (UserNotification_Status) Type() protoreflect.EnumType {
-	return &file_api_v1_user_service_proto_enumTypes[2]
+	return &file_api_v1_user_service_proto_enumTypes[6]
 }
 
 func (x UserNotification_Status) Number() protoreflect.EnumNumber {
@@ -175,15 +415,20 @@ func (x UserNotification_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserNotification_Status.Descriptor instead.
 func (UserNotification_Status) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{36, 0}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46, 0}
 }
 
 type UserNotification_Type int32
 
 const (
-	UserNotification_TYPE_UNSPECIFIED UserNotification_Type = 0
-	UserNotification_MEMO_COMMENT     UserNotification_Type = 1
-	UserNotification_MEMO_MENTION     UserNotification_Type = 2
+	UserNotification_TYPE_UNSPECIFIED  UserNotification_Type = 0
+	UserNotification_MEMO_COMMENT      UserNotification_Type = 1
+	UserNotification_MEMO_MENTION      UserNotification_Type = 2
+	UserNotification_MEMO_COLLABORATOR UserNotification_Type = 3
+	UserNotification_MEMO_REMINDER     UserNotification_Type = 4
+	UserNotification_MEMO_REACTION     UserNotification_Type = 5
+	UserNotification_MEMO_THREAD_REPLY UserNotification_Type = 6
+	UserNotification_MEMO_SHARE_OPENED UserNotification_Type = 7
 )
 
 // Enum value maps for UserNotification_Type.
@@ -192,11 +437,21 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
 		2: "MEMO_MENTION",
+		3: "MEMO_COLLABORATOR",
+		4: "MEMO_REMINDER",
+		5: "MEMO_REACTION",
+		6: "MEMO_THREAD_REPLY",
+		7: "MEMO_SHARE_OPENED",
 	}
 	UserNotification_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"MEMO_COMMENT":     1,
-		"MEMO_MENTION":     2,
+		"TYPE_UNSPECIFIED":  0,
+		"MEMO_COMMENT":      1,
+		"MEMO_MENTION":      2,
+		"MEMO_COLLABORATOR": 3,
+		"MEMO_REMINDER":     4,
+		"MEMO_REACTION":     5,
+		"MEMO_THREAD_REPLY": 6,
+		"MEMO_SHARE_OPENED": 7,
 	}
 )
 
@@ -211,11 +466,11 @@ func (x UserNotification_Type) String() string {
 }
 
 func (UserNotification_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_user_service_proto_enumTypes[3].Descriptor()
+	return file_api_v1_user_service_proto_enumTypes[7].Descriptor()
 }
 
 func (UserNotification_Type) Type() protoreflect.EnumType {
-	return &file_api_v1_user_service_proto_enumTypes[3]
+	return &file_api_v1_user_service_proto_enumTypes[7]
 }
 
 func (x UserNotification_Type) Number() protoreflect.EnumNumber {
@@ -224,7 +479,7 @@ func (x UserNotification_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserNotification_Type.Descriptor instead.
 func (UserNotification_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{36, 1}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46, 1}
 }
 
 type User struct {
@@ -251,7 +506,13 @@ type User struct {
 	// Output only. The creation timestamp.
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// Output only. The last update timestamp.
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. Whether the user confirmed ownership of the email.
+	EmailVerified bool `protobuf:"varint,12,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	// Optional. The custom role of the user, which grants its permissions on top of
+	// the USER role. Only admins can change it; setting role clears it.
+	// Format: roles/{role}
+	CustomRole    string `protobuf:"bytes,13,opt,name=custom_role,json=customRole,proto3" json:"custom_role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -363,6 +624,20 @@ func (x *User) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *User) GetCustomRole() string {
+	if x != nil {
+		return x.CustomRole
+	}
+	return ""
+}
+
 type ListUsersRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional. The maximum number of users to return.
@@ -662,9 +937,12 @@ type CreateUserRequest struct {
 	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
 	// Optional. An idempotency token that can be used to ensure that multiple
 	// requests to create a user have the same result.
-	RequestId     string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	RequestId string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Optional. An invitation code that allows registering while user
+	// registration is disabled. The invitation determines the role of the user.
+	InvitationCode string `protobuf:"bytes,5,opt,name=invitation_code,json=invitationCode,proto3" json:"invitation_code,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateUserRequest) Reset() {
@@ -725,6 +1003,13 @@ func (x *CreateUserRequest) GetRequestId() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetInvitationCode() string {
+	if x != nil {
+		return x.InvitationCode
+	}
+	return ""
+}
+
 type UpdateUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The user to update.
@@ -843,6 +1128,52 @@ func (x *DeleteUserRequest) GetForce() bool {
 	return false
 }
 
+type UnlockUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the user to unlock.
+	// Format: users/{user}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserRequest) Reset() {
+	*x = UnlockUserRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserRequest) ProtoMessage() {}
+
+func (x *UnlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnlockUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // User statistics messages
 type UserStats struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -869,7 +1200,7 @@ type UserStats struct {
 
 func (x *UserStats) Reset() {
 	*x = UserStats{}
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -881,7 +1212,7 @@ func (x *UserStats) String() string {
 func (*UserStats) ProtoMessage() {}
 
 func (x *UserStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,7 +1225,7 @@ func (x *UserStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
 func (*UserStats) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UserStats) GetName() string {
@@ -957,7 +1288,7 @@ type GetUserStatsRequest struct {
 
 func (x *GetUserStatsRequest) Reset() {
 	*x = GetUserStatsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -969,7 +1300,7 @@ func (x *GetUserStatsRequest) String() string {
 func (*GetUserStatsRequest) ProtoMessage() {}
 
 func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,7 +1313,7 @@ func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetUserStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetUserStatsRequest) GetName() string {
@@ -1005,7 +1336,7 @@ type ListAllUserStatsRequest struct {
 
 func (x *ListAllUserStatsRequest) Reset() {
 	*x = ListAllUserStatsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1017,7 +1348,7 @@ func (x *ListAllUserStatsRequest) String() string {
 func (*ListAllUserStatsRequest) ProtoMessage() {}
 
 func (x *ListAllUserStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,7 +1361,7 @@ func (x *ListAllUserStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllUserStatsRequest.ProtoReflect.Descriptor instead.
 func (*ListAllUserStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListAllUserStatsRequest) GetState() State {
@@ -1057,7 +1388,7 @@ type ListAllUserStatsResponse struct {
 
 func (x *ListAllUserStatsResponse) Reset() {
 	*x = ListAllUserStatsResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[12]
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1069,7 +1400,7 @@ func (x *ListAllUserStatsResponse) String() string {
 func (*ListAllUserStatsResponse) ProtoMessage() {}
 
 func (x *ListAllUserStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[12]
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,7 +1413,7 @@ func (x *ListAllUserStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllUserStatsResponse.ProtoReflect.Descriptor instead.
 func (*ListAllUserStatsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListAllUserStatsResponse) GetStats() []*UserStats {
@@ -1103,6 +1434,7 @@ type UserSetting struct {
 	//
 	//	*UserSetting_GeneralSetting_
 	//	*UserSetting_WebhooksSetting_
+	//	*UserSetting_NotificationSetting_
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1110,7 +1442,7 @@ type UserSetting struct {
 
 func (x *UserSetting) Reset() {
 	*x = UserSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[13]
+	mi := &file_api_v1_user_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1122,7 +1454,7 @@ func (x *UserSetting) String() string {
 func (*UserSetting) ProtoMessage() {}
 
 func (x *UserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[13]
+	mi := &file_api_v1_user_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1467,7 @@ func (x *UserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSetting.ProtoReflect.Descriptor instead.
 func (*UserSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UserSetting) GetName() string {
@@ -1170,6 +1502,15 @@ func (x *UserSetting) GetWebhooksSetting() *UserSetting_WebhooksSetting {
 	return nil
 }
 
+func (x *UserSetting) GetNotificationSetting() *UserSetting_NotificationSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_NotificationSetting_); ok {
+			return x.NotificationSetting
+		}
+	}
+	return nil
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -1182,10 +1523,16 @@ type UserSetting_WebhooksSetting_ struct {
 	WebhooksSetting *UserSetting_WebhooksSetting `protobuf:"bytes,5,opt,name=webhooks_setting,json=webhooksSetting,proto3,oneof"`
 }
 
+type UserSetting_NotificationSetting_ struct {
+	NotificationSetting *UserSetting_NotificationSetting `protobuf:"bytes,6,opt,name=notification_setting,json=notificationSetting,proto3,oneof"`
+}
+
 func (*UserSetting_GeneralSetting_) isUserSetting_Value() {}
 
 func (*UserSetting_WebhooksSetting_) isUserSetting_Value() {}
 
+func (*UserSetting_NotificationSetting_) isUserSetting_Value() {}
+
 type GetUserSettingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the user setting.
@@ -1197,7 +1544,7 @@ type GetUserSettingRequest struct {
 
 func (x *GetUserSettingRequest) Reset() {
 	*x = GetUserSettingRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[14]
+	mi := &file_api_v1_user_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1209,7 +1556,7 @@ func (x *GetUserSettingRequest) String() string {
 func (*GetUserSettingRequest) ProtoMessage() {}
 
 func (x *GetUserSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[14]
+	mi := &file_api_v1_user_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +1569,7 @@ func (x *GetUserSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserSettingRequest.ProtoReflect.Descriptor instead.
 func (*GetUserSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetUserSettingRequest) GetName() string {
@@ -1244,7 +1591,7 @@ type UpdateUserSettingRequest struct {
 
 func (x *UpdateUserSettingRequest) Reset() {
 	*x = UpdateUserSettingRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[15]
+	mi := &file_api_v1_user_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1256,7 +1603,7 @@ func (x *UpdateUserSettingRequest) String() string {
 func (*UpdateUserSettingRequest) ProtoMessage() {}
 
 func (x *UpdateUserSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[15]
+	mi := &file_api_v1_user_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1269,7 +1616,7 @@ func (x *UpdateUserSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserSettingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{15}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateUserSettingRequest) GetSetting() *UserSetting {
@@ -1306,7 +1653,7 @@ type ListUserSettingsRequest struct {
 
 func (x *ListUserSettingsRequest) Reset() {
 	*x = ListUserSettingsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[16]
+	mi := &file_api_v1_user_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1318,7 +1665,7 @@ func (x *ListUserSettingsRequest) String() string {
 func (*ListUserSettingsRequest) ProtoMessage() {}
 
 func (x *ListUserSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[16]
+	mi := &file_api_v1_user_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,7 +1678,7 @@ func (x *ListUserSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserSettingsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{16}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListUserSettingsRequest) GetParent() string {
@@ -1371,7 +1718,7 @@ type ListUserSettingsResponse struct {
 
 func (x *ListUserSettingsResponse) Reset() {
 	*x = ListUserSettingsResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[17]
+	mi := &file_api_v1_user_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1383,7 +1730,7 @@ func (x *ListUserSettingsResponse) String() string {
 func (*ListUserSettingsResponse) ProtoMessage() {}
 
 func (x *ListUserSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[17]
+	mi := &file_api_v1_user_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1396,7 +1743,7 @@ func (x *ListUserSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserSettingsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{17}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListUserSettingsResponse) GetSettings() []*UserSetting {
@@ -1437,7 +1784,7 @@ type LinkedIdentity struct {
 
 func (x *LinkedIdentity) Reset() {
 	*x = LinkedIdentity{}
-	mi := &file_api_v1_user_service_proto_msgTypes[18]
+	mi := &file_api_v1_user_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1449,7 +1796,7 @@ func (x *LinkedIdentity) String() string {
 func (*LinkedIdentity) ProtoMessage() {}
 
 func (x *LinkedIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[18]
+	mi := &file_api_v1_user_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1462,7 +1809,7 @@ func (x *LinkedIdentity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkedIdentity.ProtoReflect.Descriptor instead.
 func (*LinkedIdentity) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *LinkedIdentity) GetName() string {
@@ -1497,7 +1844,7 @@ type ListLinkedIdentitiesRequest struct {
 
 func (x *ListLinkedIdentitiesRequest) Reset() {
 	*x = ListLinkedIdentitiesRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[19]
+	mi := &file_api_v1_user_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1509,7 +1856,7 @@ func (x *ListLinkedIdentitiesRequest) String() string {
 func (*ListLinkedIdentitiesRequest) ProtoMessage() {}
 
 func (x *ListLinkedIdentitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[19]
+	mi := &file_api_v1_user_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,7 +1869,7 @@ func (x *ListLinkedIdentitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLinkedIdentitiesRequest.ProtoReflect.Descriptor instead.
 func (*ListLinkedIdentitiesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{19}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListLinkedIdentitiesRequest) GetParent() string {
@@ -1542,7 +1889,7 @@ type ListLinkedIdentitiesResponse struct {
 
 func (x *ListLinkedIdentitiesResponse) Reset() {
 	*x = ListLinkedIdentitiesResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[20]
+	mi := &file_api_v1_user_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1554,7 +1901,7 @@ func (x *ListLinkedIdentitiesResponse) String() string {
 func (*ListLinkedIdentitiesResponse) ProtoMessage() {}
 
 func (x *ListLinkedIdentitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[20]
+	mi := &file_api_v1_user_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,7 +1914,7 @@ func (x *ListLinkedIdentitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLinkedIdentitiesResponse.ProtoReflect.Descriptor instead.
 func (*ListLinkedIdentitiesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{20}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListLinkedIdentitiesResponse) GetLinkedIdentities() []*LinkedIdentity {
@@ -1597,7 +1944,7 @@ type CreateLinkedIdentityRequest struct {
 
 func (x *CreateLinkedIdentityRequest) Reset() {
 	*x = CreateLinkedIdentityRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[21]
+	mi := &file_api_v1_user_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1609,7 +1956,7 @@ func (x *CreateLinkedIdentityRequest) String() string {
 func (*CreateLinkedIdentityRequest) ProtoMessage() {}
 
 func (x *CreateLinkedIdentityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[21]
+	mi := &file_api_v1_user_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1622,7 +1969,7 @@ func (x *CreateLinkedIdentityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLinkedIdentityRequest.ProtoReflect.Descriptor instead.
 func (*CreateLinkedIdentityRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{21}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreateLinkedIdentityRequest) GetParent() string {
@@ -1671,7 +2018,7 @@ type GetLinkedIdentityRequest struct {
 
 func (x *GetLinkedIdentityRequest) Reset() {
 	*x = GetLinkedIdentityRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[22]
+	mi := &file_api_v1_user_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1683,7 +2030,7 @@ func (x *GetLinkedIdentityRequest) String() string {
 func (*GetLinkedIdentityRequest) ProtoMessage() {}
 
 func (x *GetLinkedIdentityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[22]
+	mi := &file_api_v1_user_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1696,7 +2043,7 @@ func (x *GetLinkedIdentityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLinkedIdentityRequest.ProtoReflect.Descriptor instead.
 func (*GetLinkedIdentityRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{22}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetLinkedIdentityRequest) GetName() string {
@@ -1717,7 +2064,7 @@ type DeleteLinkedIdentityRequest struct {
 
 func (x *DeleteLinkedIdentityRequest) Reset() {
 	*x = DeleteLinkedIdentityRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[23]
+	mi := &file_api_v1_user_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1729,7 +2076,7 @@ func (x *DeleteLinkedIdentityRequest) String() string {
 func (*DeleteLinkedIdentityRequest) ProtoMessage() {}
 
 func (x *DeleteLinkedIdentityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[23]
+	mi := &file_api_v1_user_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1742,7 +2089,7 @@ func (x *DeleteLinkedIdentityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLinkedIdentityRequest.ProtoReflect.Descriptor instead.
 func (*DeleteLinkedIdentityRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{23}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DeleteLinkedIdentityRequest) GetName() string {
@@ -1766,14 +2113,17 @@ type PersonalAccessToken struct {
 	// Optional. The expiration timestamp.
 	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	// Output only. The last used timestamp.
-	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Output only. The scopes granted to the token.
+	// An empty list means the token has full access to the account.
+	Scopes        []string `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PersonalAccessToken) Reset() {
 	*x = PersonalAccessToken{}
-	mi := &file_api_v1_user_service_proto_msgTypes[24]
+	mi := &file_api_v1_user_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1785,7 +2135,7 @@ func (x *PersonalAccessToken) String() string {
 func (*PersonalAccessToken) ProtoMessage() {}
 
 func (x *PersonalAccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[24]
+	mi := &file_api_v1_user_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1798,7 +2148,7 @@ func (x *PersonalAccessToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PersonalAccessToken.ProtoReflect.Descriptor instead.
 func (*PersonalAccessToken) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{24}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *PersonalAccessToken) GetName() string {
@@ -1836,6 +2186,13 @@ func (x *PersonalAccessToken) GetLastUsedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *PersonalAccessToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 type ListPersonalAccessTokensRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The parent resource whose personal access tokens will be listed.
@@ -1851,7 +2208,7 @@ type ListPersonalAccessTokensRequest struct {
 
 func (x *ListPersonalAccessTokensRequest) Reset() {
 	*x = ListPersonalAccessTokensRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[25]
+	mi := &file_api_v1_user_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1863,7 +2220,7 @@ func (x *ListPersonalAccessTokensRequest) String() string {
 func (*ListPersonalAccessTokensRequest) ProtoMessage() {}
 
 func (x *ListPersonalAccessTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[25]
+	mi := &file_api_v1_user_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1876,7 +2233,7 @@ func (x *ListPersonalAccessTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPersonalAccessTokensRequest.ProtoReflect.Descriptor instead.
 func (*ListPersonalAccessTokensRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{25}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListPersonalAccessTokensRequest) GetParent() string {
@@ -1914,7 +2271,7 @@ type ListPersonalAccessTokensResponse struct {
 
 func (x *ListPersonalAccessTokensResponse) Reset() {
 	*x = ListPersonalAccessTokensResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[26]
+	mi := &file_api_v1_user_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1926,7 +2283,7 @@ func (x *ListPersonalAccessTokensResponse) String() string {
 func (*ListPersonalAccessTokensResponse) ProtoMessage() {}
 
 func (x *ListPersonalAccessTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[26]
+	mi := &file_api_v1_user_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1939,7 +2296,7 @@ func (x *ListPersonalAccessTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPersonalAccessTokensResponse.ProtoReflect.Descriptor instead.
 func (*ListPersonalAccessTokensResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{26}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListPersonalAccessTokensResponse) GetPersonalAccessTokens() []*PersonalAccessToken {
@@ -1972,13 +2329,18 @@ type CreatePersonalAccessTokenRequest struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// Optional. Expiration duration in days (0 = never expires).
 	ExpiresInDays int32 `protobuf:"varint,3,opt,name=expires_in_days,json=expiresInDays,proto3" json:"expires_in_days,omitempty"`
+	// Optional. The scopes to grant to the token.
+	// Supported values: memos:read, memos:write, attachments:read,
+	// attachments:write, settings:read, settings:write, admin.
+	// An empty list grants full access to the account.
+	Scopes        []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePersonalAccessTokenRequest) Reset() {
 	*x = CreatePersonalAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[27]
+	mi := &file_api_v1_user_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1990,7 +2352,7 @@ func (x *CreatePersonalAccessTokenRequest) String() string {
 func (*CreatePersonalAccessTokenRequest) ProtoMessage() {}
 
 func (x *CreatePersonalAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[27]
+	mi := &file_api_v1_user_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,7 +2365,7 @@ func (x *CreatePersonalAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePersonalAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreatePersonalAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{27}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CreatePersonalAccessTokenRequest) GetParent() string {
@@ -2027,6 +2389,13 @@ func (x *CreatePersonalAccessTokenRequest) GetExpiresInDays() int32 {
 	return 0
 }
 
+func (x *CreatePersonalAccessTokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 type CreatePersonalAccessTokenResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The personal access token metadata.
@@ -2040,7 +2409,7 @@ type CreatePersonalAccessTokenResponse struct {
 
 func (x *CreatePersonalAccessTokenResponse) Reset() {
 	*x = CreatePersonalAccessTokenResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[28]
+	mi := &file_api_v1_user_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2052,7 +2421,7 @@ func (x *CreatePersonalAccessTokenResponse) String() string {
 func (*CreatePersonalAccessTokenResponse) ProtoMessage() {}
 
 func (x *CreatePersonalAccessTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[28]
+	mi := &file_api_v1_user_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2065,7 +2434,7 @@ func (x *CreatePersonalAccessTokenResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreatePersonalAccessTokenResponse.ProtoReflect.Descriptor instead.
 func (*CreatePersonalAccessTokenResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CreatePersonalAccessTokenResponse) GetPersonalAccessToken() *PersonalAccessToken {
@@ -2093,7 +2462,7 @@ type DeletePersonalAccessTokenRequest struct {
 
 func (x *DeletePersonalAccessTokenRequest) Reset() {
 	*x = DeletePersonalAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[29]
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2105,7 +2474,7 @@ func (x *DeletePersonalAccessTokenRequest) String() string {
 func (*DeletePersonalAccessTokenRequest) ProtoMessage() {}
 
 func (x *DeletePersonalAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[29]
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2118,7 +2487,7 @@ func (x *DeletePersonalAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePersonalAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*DeletePersonalAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{29}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DeletePersonalAccessTokenRequest) GetName() string {
@@ -2128,39 +2497,45 @@ func (x *DeletePersonalAccessTokenRequest) GetName() string {
 	return ""
 }
 
-// UserWebhook represents a webhook owned by a user.
-type UserWebhook struct {
+// Session represents an active sign-in session of a user.
+// A session is backed by a refresh token, so its identifier changes each time
+// the token is rotated while its creation time and client info are preserved.
+type Session struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the webhook.
-	// Format: users/{user}/webhooks/{webhook}
+	// The resource name of the session.
+	// Format: users/{user}/sessions/{session}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The URL to send the webhook to.
-	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	// Optional. Human-readable name for the webhook.
-	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// The creation time of the webhook.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// The last update time of the webhook.
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. When the session was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Output only. When the session expires unless it is refreshed.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Output only. When the session was last refreshed.
+	LastSeenAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// Output only. The IP address of the client at the last refresh.
+	LastSeenIp string `protobuf:"bytes,5,opt,name=last_seen_ip,json=lastSeenIp,proto3" json:"last_seen_ip,omitempty"`
+	// Output only. Information about the client that signed in.
+	ClientInfo *Session_ClientInfo `protobuf:"bytes,6,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	// Output only. Whether this is the session making the request.
+	Current       bool `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserWebhook) Reset() {
-	*x = UserWebhook{}
-	mi := &file_api_v1_user_service_proto_msgTypes[30]
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_api_v1_user_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserWebhook) String() string {
+func (x *Session) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserWebhook) ProtoMessage() {}
+func (*Session) ProtoMessage() {}
 
-func (x *UserWebhook) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[30]
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2171,70 +2546,84 @@ func (x *UserWebhook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserWebhook.ProtoReflect.Descriptor instead.
-func (*UserWebhook) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UserWebhook) GetName() string {
+func (x *Session) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UserWebhook) GetUrl() string {
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Url
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UserWebhook) GetDisplayName() string {
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DisplayName
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UserWebhook) GetCreateTime() *timestamppb.Timestamp {
+func (x *Session) GetLastSeenAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreateTime
+		return x.LastSeenAt
 	}
 	return nil
 }
 
-func (x *UserWebhook) GetUpdateTime() *timestamppb.Timestamp {
+func (x *Session) GetLastSeenIp() string {
 	if x != nil {
-		return x.UpdateTime
+		return x.LastSeenIp
+	}
+	return ""
+}
+
+func (x *Session) GetClientInfo() *Session_ClientInfo {
+	if x != nil {
+		return x.ClientInfo
 	}
 	return nil
 }
 
-type ListUserWebhooksRequest struct {
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListSessionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent user resource.
+	// Required. The parent resource whose sessions will be listed.
 	// Format: users/{user}
 	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserWebhooksRequest) Reset() {
-	*x = ListUserWebhooksRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[31]
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserWebhooksRequest) String() string {
+func (x *ListSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserWebhooksRequest) ProtoMessage() {}
+func (*ListSessionsRequest) ProtoMessage() {}
 
-func (x *ListUserWebhooksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[31]
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2245,41 +2634,41 @@ func (x *ListUserWebhooksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserWebhooksRequest.ProtoReflect.Descriptor instead.
-func (*ListUserWebhooksRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListUserWebhooksRequest) GetParent() string {
+func (x *ListSessionsRequest) GetParent() string {
 	if x != nil {
 		return x.Parent
 	}
 	return ""
 }
 
-type ListUserWebhooksResponse struct {
+type ListSessionsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of webhooks.
-	Webhooks      []*UserWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	// The list of active sessions, most recently seen first.
+	Sessions      []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserWebhooksResponse) Reset() {
-	*x = ListUserWebhooksResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[32]
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserWebhooksResponse) String() string {
+func (x *ListSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserWebhooksResponse) ProtoMessage() {}
+func (*ListSessionsResponse) ProtoMessage() {}
 
-func (x *ListUserWebhooksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[32]
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2290,44 +2679,42 @@ func (x *ListUserWebhooksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserWebhooksResponse.ProtoReflect.Descriptor instead.
-func (*ListUserWebhooksResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListUserWebhooksResponse) GetWebhooks() []*UserWebhook {
+func (x *ListSessionsResponse) GetSessions() []*Session {
 	if x != nil {
-		return x.Webhooks
+		return x.Sessions
 	}
 	return nil
 }
 
-type CreateUserWebhookRequest struct {
+type RevokeSessionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent user resource.
-	// Format: users/{user}
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The webhook to create.
-	Webhook       *UserWebhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// Required. The resource name of the session to revoke.
+	// Format: users/{user}/sessions/{session}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserWebhookRequest) Reset() {
-	*x = CreateUserWebhookRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[33]
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserWebhookRequest) String() string {
+func (x *RevokeSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserWebhookRequest) ProtoMessage() {}
+func (*RevokeSessionRequest) ProtoMessage() {}
 
-func (x *CreateUserWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[33]
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2338,50 +2725,44 @@ func (x *CreateUserWebhookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserWebhookRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CreateUserWebhookRequest) GetParent() string {
+func (x *RevokeSessionRequest) GetName() string {
 	if x != nil {
-		return x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateUserWebhookRequest) GetWebhook() *UserWebhook {
-	if x != nil {
-		return x.Webhook
-	}
-	return nil
-}
-
-type UpdateUserWebhookRequest struct {
+type RevokeAllSessionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The webhook to update.
-	Webhook *UserWebhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
-	// The list of fields to update.
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Required. The user whose sessions will be revoked.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. Whether to also delete all personal access tokens of the user.
+	IncludePersonalAccessTokens bool `protobuf:"varint,2,opt,name=include_personal_access_tokens,json=includePersonalAccessTokens,proto3" json:"include_personal_access_tokens,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *UpdateUserWebhookRequest) Reset() {
-	*x = UpdateUserWebhookRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[34]
+func (x *RevokeAllSessionsRequest) Reset() {
+	*x = RevokeAllSessionsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserWebhookRequest) String() string {
+func (x *RevokeAllSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserWebhookRequest) ProtoMessage() {}
+func (*RevokeAllSessionsRequest) ProtoMessage() {}
 
-func (x *UpdateUserWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[34]
+func (x *RevokeAllSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2392,49 +2773,78 @@ func (x *UpdateUserWebhookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserWebhookRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use RevokeAllSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *UpdateUserWebhookRequest) GetWebhook() *UserWebhook {
+func (x *RevokeAllSessionsRequest) GetParent() string {
 	if x != nil {
-		return x.Webhook
+		return x.Parent
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateUserWebhookRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *RevokeAllSessionsRequest) GetIncludePersonalAccessTokens() bool {
 	if x != nil {
-		return x.UpdateMask
+		return x.IncludePersonalAccessTokens
 	}
-	return nil
+	return false
 }
 
-type DeleteUserWebhookRequest struct {
+// UserWebhook represents a webhook owned by a user.
+type UserWebhook struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the webhook to delete.
+	// The name of the webhook.
 	// Format: users/{user}/webhooks/{webhook}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The URL to send the webhook to.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Optional. Human-readable name for the webhook.
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The creation time of the webhook.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The last update time of the webhook.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// The secret used to sign deliveries.
+	// Each delivery carries the headers X-Memos-Webhook-Id, X-Memos-Webhook-Timestamp and
+	// X-Memos-Webhook-Signature, where the signature is "sha256=" followed by the hex-encoded
+	// HMAC-SHA256 of "{timestamp}.{body}" keyed with this secret.
+	// The secret is generated on creation and regenerated by updating the "secret" path.
+	Secret string `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Whether deliveries are paused.
+	// Webhooks are disabled automatically after repeated failed deliveries,
+	// and re-enabled by updating the "disabled" path.
+	Disabled bool `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Optional. The event types delivered to the webhook. Empty means all event types.
+	// Supported: memos.memo.created, memos.memo.updated, memos.memo.deleted,
+	// memos.memo.comment.created, memos.memo.reaction.upserted and memos.memo.reminder.
+	EventTypes []string `protobuf:"bytes,8,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// Optional. A CEL filter the memo of an event must match to be delivered,
+	// using the same syntax as ListMemos filters, e.g. `tag in ["deploy"]`.
+	Filter string `protobuf:"bytes,9,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. The payload format of deliveries. Chat formats render the memo snippet,
+	// tags, author and a link to the memo built from the instance URL.
+	Format        UserWebhook_Format `protobuf:"varint,10,opt,name=format,proto3,enum=memos.api.v1.UserWebhook_Format" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserWebhookRequest) Reset() {
-	*x = DeleteUserWebhookRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[35]
+func (x *UserWebhook) Reset() {
+	*x = UserWebhook{}
+	mi := &file_api_v1_user_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserWebhookRequest) String() string {
+func (x *UserWebhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserWebhookRequest) ProtoMessage() {}
+func (*UserWebhook) ProtoMessage() {}
 
-func (x *DeleteUserWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[35]
+func (x *UserWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2445,58 +2855,2199 @@ func (x *DeleteUserWebhookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserWebhookRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use UserWebhook.ProtoReflect.Descriptor instead.
+func (*UserWebhook) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DeleteUserWebhookRequest) GetName() string {
+func (x *UserWebhook) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type UserNotification struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the notification.
-	// Format: users/{user}/notifications/{notification}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The sender of the notification.
-	// Format: users/{user}
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// The sender user details.
-	SenderUser *User `protobuf:"bytes,8,opt,name=sender_user,json=senderUser,proto3" json:"sender_user,omitempty"`
-	// The status of the notification.
-	Status UserNotification_Status `protobuf:"varint,3,opt,name=status,proto3,enum=memos.api.v1.UserNotification_Status" json:"status,omitempty"`
-	// The creation timestamp.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// The type of the notification.
-	Type UserNotification_Type `protobuf:"varint,5,opt,name=type,proto3,enum=memos.api.v1.UserNotification_Type" json:"type,omitempty"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*UserNotification_MemoComment
-	//	*UserNotification_MemoMention
-	Payload       isUserNotification_Payload `protobuf_oneof:"payload"`
+func (x *UserWebhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *UserWebhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetFormat() UserWebhook_Format {
+	if x != nil {
+		return x.Format
+	}
+	return UserWebhook_FORMAT_UNSPECIFIED
+}
+
+type ListUserWebhooksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent user resource.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhooksRequest) Reset() {
+	*x = ListUserWebhooksRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhooksRequest) ProtoMessage() {}
+
+func (x *ListUserWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListUserWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListUserWebhooksRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserWebhooksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of webhooks.
+	Webhooks      []*UserWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhooksResponse) Reset() {
+	*x = ListUserWebhooksResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhooksResponse) ProtoMessage() {}
+
+func (x *ListUserWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListUserWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListUserWebhooksResponse) GetWebhooks() []*UserWebhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type CreateUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent user resource.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The webhook to create.
+	Webhook       *UserWebhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserWebhookRequest) Reset() {
+	*x = CreateUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserWebhookRequest) ProtoMessage() {}
+
+func (x *CreateUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateUserWebhookRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateUserWebhookRequest) GetWebhook() *UserWebhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type UpdateUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The webhook to update.
+	Webhook *UserWebhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserWebhookRequest) Reset() {
+	*x = UpdateUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateUserWebhookRequest) GetWebhook() *UserWebhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *UpdateUserWebhookRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the webhook to delete.
+	// Format: users/{user}/webhooks/{webhook}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserWebhookRequest) Reset() {
+	*x = DeleteUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteUserWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// WebhookDelivery is a webhook event sent, or to be sent, to a webhook.
+type WebhookDelivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the delivery.
+	// Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+	// The delivery ID is sent in the X-Memos-Webhook-Id header.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The type of activity delivered, e.g. memos.memo.created.
+	ActivityType string `protobuf:"bytes,2,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	// The URL the delivery is sent to.
+	Url   string                `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	State WebhookDelivery_State `protobuf:"varint,4,opt,name=state,proto3,enum=memos.api.v1.WebhookDelivery_State" json:"state,omitempty"`
+	// The number of attempts made so far.
+	AttemptCount int32 `protobuf:"varint,5,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`
+	// The HTTP status code of the last attempt, or 0 if there was no response.
+	StatusCode int32 `protobuf:"varint,6,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// The beginning of the response body of the last attempt, or the error if there was no response.
+	ResponseSnippet string `protobuf:"bytes,7,opt,name=response_snippet,json=responseSnippet,proto3" json:"response_snippet,omitempty"`
+	// The time the delivery was created.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time of the last attempt.
+	LastAttemptTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_attempt_time,json=lastAttemptTime,proto3,oneof" json:"last_attempt_time,omitempty"`
+	// The time of the next attempt of a pending delivery.
+	NextAttemptTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_attempt_time,json=nextAttemptTime,proto3,oneof" json:"next_attempt_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_api_v1_user_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WebhookDelivery) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetState() WebhookDelivery_State {
+	if x != nil {
+		return x.State
+	}
+	return WebhookDelivery_STATE_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttemptCount() int32 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseSnippet() string {
+	if x != nil {
+		return x.ResponseSnippet
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetLastAttemptTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptTime
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetNextAttemptTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptTime
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent webhook.
+	// Format: users/{user}/webhooks/{webhook}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The maximum number of deliveries to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token from a previous call.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The deliveries, newest first.
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	// A token for the next page, empty if there are no more deliveries.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RedeliverWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the delivery to send again.
+	// Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RedeliverWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UserNotification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the notification.
+	// Format: users/{user}/notifications/{notification}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The sender of the notification.
+	// Format: users/{user}
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The sender user details.
+	SenderUser *User `protobuf:"bytes,8,opt,name=sender_user,json=senderUser,proto3" json:"sender_user,omitempty"`
+	// The status of the notification.
+	Status UserNotification_Status `protobuf:"varint,3,opt,name=status,proto3,enum=memos.api.v1.UserNotification_Status" json:"status,omitempty"`
+	// The creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The type of the notification.
+	Type UserNotification_Type `protobuf:"varint,5,opt,name=type,proto3,enum=memos.api.v1.UserNotification_Type" json:"type,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UserNotification_MemoComment
+	//	*UserNotification_MemoMention
+	//	*UserNotification_MemoCollaborator
+	//	*UserNotification_MemoReminder
+	//	*UserNotification_MemoReaction
+	//	*UserNotification_MemoThreadReply
+	//	*UserNotification_MemoShareOpened
+	Payload       isUserNotification_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserNotification) Reset() {
+	*x = UserNotification{}
+	mi := &file_api_v1_user_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserNotification) ProtoMessage() {}
+
+func (x *UserNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserNotification.ProtoReflect.Descriptor instead.
+func (*UserNotification) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UserNotification) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserNotification) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *UserNotification) GetSenderUser() *User {
+	if x != nil {
+		return x.SenderUser
+	}
+	return nil
+}
+
+func (x *UserNotification) GetStatus() UserNotification_Status {
+	if x != nil {
+		return x.Status
+	}
+	return UserNotification_STATUS_UNSPECIFIED
+}
+
+func (x *UserNotification) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserNotification) GetType() UserNotification_Type {
+	if x != nil {
+		return x.Type
+	}
+	return UserNotification_TYPE_UNSPECIFIED
+}
+
+func (x *UserNotification) GetPayload() isUserNotification_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UserNotification) GetMemoComment() *UserNotification_MemoCommentPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*UserNotification_MemoComment); ok {
+			return x.MemoComment
+		}
+	}
+	return nil
+}
+
+func (x *UserNotification) GetMemoMention() *UserNotification_MemoMentionPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*UserNotification_MemoMention); ok {
+			return x.MemoMention
+		}
+	}
+	return nil
+}
+
+func (x *UserNotification) GetMemoCollaborator() *UserNotification_MemoCollaboratorPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*UserNotification_MemoCollaborator); ok {
+			return x.MemoCollaborator
+		}
+	}
+	return nil
+}
+
+func (x *UserNotification) GetMemoReminder() *UserNotification_MemoReminderPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*UserNotification_MemoReminder); ok {
+			return x.MemoReminder
+		}
+	}
+	return nil
+}
+
+func (x *UserNotification) GetMemoReaction() *UserNotification_MemoReactionPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*UserNotification_MemoReaction); ok {
+			return x.MemoReaction
+		}
+	}
+	return nil
+}
+
+func (x *UserNotification) GetMemoThreadReply() *UserNotification_MemoThreadReplyPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*UserNotification_MemoThreadReply); ok {
+			return x.MemoThreadReply
+		}
+	}
+	return nil
+}
+
+func (x *UserNotification) GetMemoShareOpened() *UserNotification_MemoShareOpenedPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*UserNotification_MemoShareOpened); ok {
+			return x.MemoShareOpened
+		}
+	}
+	return nil
+}
+
+type isUserNotification_Payload interface {
+	isUserNotification_Payload()
+}
+
+type UserNotification_MemoComment struct {
+	MemoComment *UserNotification_MemoCommentPayload `protobuf:"bytes,6,opt,name=memo_comment,json=memoComment,proto3,oneof"`
+}
+
+type UserNotification_MemoMention struct {
+	MemoMention *UserNotification_MemoMentionPayload `protobuf:"bytes,7,opt,name=memo_mention,json=memoMention,proto3,oneof"`
+}
+
+type UserNotification_MemoCollaborator struct {
+	MemoCollaborator *UserNotification_MemoCollaboratorPayload `protobuf:"bytes,9,opt,name=memo_collaborator,json=memoCollaborator,proto3,oneof"`
+}
+
+type UserNotification_MemoReminder struct {
+	MemoReminder *UserNotification_MemoReminderPayload `protobuf:"bytes,10,opt,name=memo_reminder,json=memoReminder,proto3,oneof"`
+}
+
+type UserNotification_MemoReaction struct {
+	MemoReaction *UserNotification_MemoReactionPayload `protobuf:"bytes,11,opt,name=memo_reaction,json=memoReaction,proto3,oneof"`
+}
+
+type UserNotification_MemoThreadReply struct {
+	MemoThreadReply *UserNotification_MemoThreadReplyPayload `protobuf:"bytes,12,opt,name=memo_thread_reply,json=memoThreadReply,proto3,oneof"`
+}
+
+type UserNotification_MemoShareOpened struct {
+	MemoShareOpened *UserNotification_MemoShareOpenedPayload `protobuf:"bytes,13,opt,name=memo_share_opened,json=memoShareOpened,proto3,oneof"`
+}
+
+func (*UserNotification_MemoComment) isUserNotification_Payload() {}
+
+func (*UserNotification_MemoMention) isUserNotification_Payload() {}
+
+func (*UserNotification_MemoCollaborator) isUserNotification_Payload() {}
+
+func (*UserNotification_MemoReminder) isUserNotification_Payload() {}
+
+func (*UserNotification_MemoReaction) isUserNotification_Payload() {}
+
+func (*UserNotification_MemoThreadReply) isUserNotification_Payload() {}
+
+func (*UserNotification_MemoShareOpened) isUserNotification_Payload() {}
+
+type ListUserNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent user resource.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter        string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserNotificationsRequest) Reset() {
+	*x = ListUserNotificationsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserNotificationsRequest) ProtoMessage() {}
+
+func (x *ListUserNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListUserNotificationsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListUserNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserNotificationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUserNotificationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ListUserNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*UserNotification    `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserNotificationsResponse) Reset() {
+	*x = ListUserNotificationsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserNotificationsResponse) ProtoMessage() {}
+
+func (x *ListUserNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListUserNotificationsResponse) GetNotifications() []*UserNotification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListUserNotificationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateUserNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *UserNotification      `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserNotificationRequest) Reset() {
+	*x = UpdateUserNotificationRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserNotificationRequest) ProtoMessage() {}
+
+func (x *UpdateUserNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserNotificationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateUserNotificationRequest) GetNotification() *UserNotification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *UpdateUserNotificationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteUserNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format: users/{user}/notifications/{notification}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserNotificationRequest) Reset() {
+	*x = DeleteUserNotificationRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserNotificationRequest) ProtoMessage() {}
+
+func (x *DeleteUserNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserNotificationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteUserNotificationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SendTestNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user to send the test notification to.
+	// Format: users/{user}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The channel to test. If omitted, every saved channel is tested.
+	Channel       *UserSetting_NotificationSetting_Channel `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestNotificationRequest) Reset() {
+	*x = SendTestNotificationRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestNotificationRequest) ProtoMessage() {}
+
+func (x *SendTestNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SendTestNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SendTestNotificationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SendTestNotificationRequest) GetChannel() *UserSetting_NotificationSetting_Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+// UserPushSubscription is a device registered for Web Push notifications.
+// The fields mirror the browser's PushSubscription.
+type UserPushSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the push subscription.
+	// Format: users/{user}/pushSubscriptions/{push_subscription}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The push service URL of the device. It must use https.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The "p256dh" key of the subscription, base64url-encoded.
+	P256DhKey string `protobuf:"bytes,3,opt,name=p256dh_key,json=p256dhKey,proto3" json:"p256dh_key,omitempty"`
+	// The "auth" secret of the subscription, base64url-encoded.
+	AuthKey string `protobuf:"bytes,4,opt,name=auth_key,json=authKey,proto3" json:"auth_key,omitempty"`
+	// Optional. Human-readable description of the device.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// The creation time of the push subscription.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPushSubscription) Reset() {
+	*x = UserPushSubscription{}
+	mi := &file_api_v1_user_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPushSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPushSubscription) ProtoMessage() {}
+
+func (x *UserPushSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPushSubscription.ProtoReflect.Descriptor instead.
+func (*UserPushSubscription) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UserPushSubscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserPushSubscription) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *UserPushSubscription) GetP256DhKey() string {
+	if x != nil {
+		return x.P256DhKey
+	}
+	return ""
+}
+
+func (x *UserPushSubscription) GetAuthKey() string {
+	if x != nil {
+		return x.AuthKey
+	}
+	return ""
+}
+
+func (x *UserPushSubscription) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UserPushSubscription) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListUserPushSubscriptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent user resource.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserPushSubscriptionsRequest) Reset() {
+	*x = ListUserPushSubscriptionsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPushSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPushSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListUserPushSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPushSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserPushSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListUserPushSubscriptionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserPushSubscriptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of push subscriptions.
+	PushSubscriptions []*UserPushSubscription `protobuf:"bytes,1,rep,name=push_subscriptions,json=pushSubscriptions,proto3" json:"push_subscriptions,omitempty"`
+	// The VAPID public key of the instance, base64url-encoded.
+	// Browsers subscribe with it as the applicationServerKey.
+	VapidPublicKey string `protobuf:"bytes,2,opt,name=vapid_public_key,json=vapidPublicKey,proto3" json:"vapid_public_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListUserPushSubscriptionsResponse) Reset() {
+	*x = ListUserPushSubscriptionsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPushSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPushSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListUserPushSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPushSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserPushSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListUserPushSubscriptionsResponse) GetPushSubscriptions() []*UserPushSubscription {
+	if x != nil {
+		return x.PushSubscriptions
+	}
+	return nil
+}
+
+func (x *ListUserPushSubscriptionsResponse) GetVapidPublicKey() string {
+	if x != nil {
+		return x.VapidPublicKey
+	}
+	return ""
+}
+
+type CreateUserPushSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent user resource.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The push subscription to register.
+	PushSubscription *UserPushSubscription `protobuf:"bytes,2,opt,name=push_subscription,json=pushSubscription,proto3" json:"push_subscription,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateUserPushSubscriptionRequest) Reset() {
+	*x = CreateUserPushSubscriptionRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserPushSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserPushSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateUserPushSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserPushSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserPushSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateUserPushSubscriptionRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateUserPushSubscriptionRequest) GetPushSubscription() *UserPushSubscription {
+	if x != nil {
+		return x.PushSubscription
+	}
+	return nil
+}
+
+type DeleteUserPushSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the push subscription to delete.
+	// Format: users/{user}/pushSubscriptions/{push_subscription}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserPushSubscriptionRequest) Reset() {
+	*x = DeleteUserPushSubscriptionRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserPushSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserPushSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteUserPushSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserPushSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserPushSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteUserPushSubscriptionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// UserGroup is a named set of users that GROUP memos can be shared with.
+type UserGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the group.
+	// Format: groups/{group}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. A description of the group.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Output only. The number of members in the group.
+	MemberCount int32 `protobuf:"varint,3,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	// Output only. The creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. The last update timestamp.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGroup) Reset() {
+	*x = UserGroup{}
+	mi := &file_api_v1_user_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGroup) ProtoMessage() {}
+
+func (x *UserGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGroup.ProtoReflect.Descriptor instead.
+func (*UserGroup) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UserGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserGroup) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UserGroup) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *UserGroup) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserGroup) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// UserGroupMember is a user's membership in a group.
+type UserGroupMember struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the membership.
+	// Format: groups/{group}/members/{member}, member is the username.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The member user.
+	// Format: users/{user}
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Output only. When the user joined the group.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGroupMember) Reset() {
+	*x = UserGroupMember{}
+	mi := &file_api_v1_user_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGroupMember) ProtoMessage() {}
+
+func (x *UserGroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGroupMember.ProtoReflect.Descriptor instead.
+func (*UserGroupMember) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UserGroupMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserGroupMember) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *UserGroupMember) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListUserGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserGroupsRequest) Reset() {
+	*x = ListUserGroupsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserGroupsRequest) ProtoMessage() {}
+
+func (x *ListUserGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{59}
+}
+
+type ListUserGroupsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of groups.
+	Groups        []*UserGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserGroupsResponse) Reset() {
+	*x = ListUserGroupsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserGroupsResponse) ProtoMessage() {}
+
+func (x *ListUserGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListUserGroupsResponse) GetGroups() []*UserGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetUserGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the group.
+	// Format: groups/{group}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserGroupRequest) Reset() {
+	*x = GetUserGroupRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserGroupRequest) ProtoMessage() {}
+
+func (x *GetUserGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetUserGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetUserGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateUserGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The group to create.
+	Group *UserGroup `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// Required. The ID to use for the group, which will become the final component of the resource name.
+	// Must start with a lowercase letter and contain only lowercase letters, digits and hyphens.
+	GroupId       string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserGroupRequest) Reset() {
+	*x = CreateUserGroupRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserGroupRequest) ProtoMessage() {}
+
+func (x *CreateUserGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateUserGroupRequest) GetGroup() *UserGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *CreateUserGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type UpdateUserGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The group to update.
+	Group *UserGroup `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// Required. The update mask. Supported fields: description.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserGroupRequest) Reset() {
+	*x = UpdateUserGroupRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserGroupRequest) ProtoMessage() {}
+
+func (x *UpdateUserGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateUserGroupRequest) GetGroup() *UserGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *UpdateUserGroupRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteUserGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the group to delete.
+	// Format: groups/{group}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserGroupRequest) Reset() {
+	*x = DeleteUserGroupRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserGroupRequest) ProtoMessage() {}
+
+func (x *DeleteUserGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteUserGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListUserGroupMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent group.
+	// Format: groups/{group}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserGroupMembersRequest) Reset() {
+	*x = ListUserGroupMembersRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserGroupMembersRequest) ProtoMessage() {}
+
+func (x *ListUserGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListUserGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListUserGroupMembersRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserGroupMembersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of members.
+	Members       []*UserGroupMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserGroupMembersResponse) Reset() {
+	*x = ListUserGroupMembersResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserGroupMembersResponse) ProtoMessage() {}
+
+func (x *ListUserGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListUserGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListUserGroupMembersResponse) GetMembers() []*UserGroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AddUserGroupMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent group.
+	// Format: groups/{group}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The user to add.
+	// Format: users/{user}
+	User          string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUserGroupMemberRequest) Reset() {
+	*x = AddUserGroupMemberRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUserGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUserGroupMemberRequest) ProtoMessage() {}
+
+func (x *AddUserGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUserGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddUserGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AddUserGroupMemberRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *AddUserGroupMemberRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type RemoveUserGroupMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the membership to remove.
+	// Format: groups/{group}/members/{member}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUserGroupMemberRequest) Reset() {
+	*x = RemoveUserGroupMemberRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUserGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserGroupMemberRequest) ProtoMessage() {}
+
+func (x *RemoveUserGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUserGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveUserGroupMemberRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Memo type statistics.
+type UserStats_MemoTypeStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkCount     int32                  `protobuf:"varint,1,opt,name=link_count,json=linkCount,proto3" json:"link_count,omitempty"`
+	CodeCount     int32                  `protobuf:"varint,2,opt,name=code_count,json=codeCount,proto3" json:"code_count,omitempty"`
+	TodoCount     int32                  `protobuf:"varint,3,opt,name=todo_count,json=todoCount,proto3" json:"todo_count,omitempty"`
+	UndoCount     int32                  `protobuf:"varint,4,opt,name=undo_count,json=undoCount,proto3" json:"undo_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserStats_MemoTypeStats) Reset() {
+	*x = UserStats_MemoTypeStats{}
+	mi := &file_api_v1_user_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStats_MemoTypeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStats_MemoTypeStats) ProtoMessage() {}
+
+func (x *UserStats_MemoTypeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStats_MemoTypeStats.ProtoReflect.Descriptor instead.
+func (*UserStats_MemoTypeStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *UserStats_MemoTypeStats) GetLinkCount() int32 {
+	if x != nil {
+		return x.LinkCount
+	}
+	return 0
+}
+
+func (x *UserStats_MemoTypeStats) GetCodeCount() int32 {
+	if x != nil {
+		return x.CodeCount
+	}
+	return 0
+}
+
+func (x *UserStats_MemoTypeStats) GetTodoCount() int32 {
+	if x != nil {
+		return x.TodoCount
+	}
+	return 0
+}
+
+func (x *UserStats_MemoTypeStats) GetUndoCount() int32 {
+	if x != nil {
+		return x.UndoCount
+	}
+	return 0
+}
+
+// General user settings configuration.
+type UserSetting_GeneralSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The preferred locale of the user.
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	// The default visibility of the memo.
+	MemoVisibility string `protobuf:"bytes,3,opt,name=memo_visibility,json=memoVisibility,proto3" json:"memo_visibility,omitempty"`
+	// The preferred theme of the user.
+	// This references a CSS file in the web/public/themes/ directory.
+	// If not set, the default theme will be used.
+	Theme string `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
+	// The notification types the user opted out of. Muted notifications are
+	// neither added to the inbox nor emailed.
+	MutedNotificationTypes []UserNotification_Type `protobuf:"varint,5,rep,packed,name=muted_notification_types,json=mutedNotificationTypes,proto3,enum=memos.api.v1.UserNotification_Type" json:"muted_notification_types,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UserSetting_GeneralSetting) Reset() {
+	*x = UserSetting_GeneralSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting_GeneralSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting_GeneralSetting) ProtoMessage() {}
+
+func (x *UserSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetting_GeneralSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_GeneralSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *UserSetting_GeneralSetting) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UserSetting_GeneralSetting) GetMemoVisibility() string {
+	if x != nil {
+		return x.MemoVisibility
+	}
+	return ""
+}
+
+func (x *UserSetting_GeneralSetting) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *UserSetting_GeneralSetting) GetMutedNotificationTypes() []UserNotification_Type {
+	if x != nil {
+		return x.MutedNotificationTypes
+	}
+	return nil
+}
+
+// User webhooks configuration.
+type UserSetting_WebhooksSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of user webhooks.
+	Webhooks      []*UserWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserNotification) Reset() {
-	*x = UserNotification{}
-	mi := &file_api_v1_user_service_proto_msgTypes[36]
+func (x *UserSetting_WebhooksSetting) Reset() {
+	*x = UserSetting_WebhooksSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserNotification) String() string {
+func (x *UserSetting_WebhooksSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserNotification) ProtoMessage() {}
+func (*UserSetting_WebhooksSetting) ProtoMessage() {}
 
-func (x *UserNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[36]
+func (x *UserSetting_WebhooksSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2507,121 +5058,233 @@ func (x *UserNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserNotification.ProtoReflect.Descriptor instead.
-func (*UserNotification) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use UserSetting_WebhooksSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_WebhooksSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14, 1}
 }
 
-func (x *UserNotification) GetName() string {
+func (x *UserSetting_WebhooksSetting) GetWebhooks() []*UserWebhook {
 	if x != nil {
-		return x.Name
+		return x.Webhooks
 	}
-	return ""
+	return nil
 }
 
-func (x *UserNotification) GetSender() string {
+// Notification delivery preferences.
+type UserSetting_NotificationSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The email delivery preferences. Types without a preference are emailed immediately.
+	Preferences []*UserSetting_NotificationSetting_Preference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	// The push services notifications are delivered to, in addition to email.
+	Channels []*UserSetting_NotificationSetting_Channel `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	// Whether to receive a weekly recap email with memos from the same date in past years,
+	// this week's activity and open tasks.
+	WeeklyRecap   bool `protobuf:"varint,3,opt,name=weekly_recap,json=weeklyRecap,proto3" json:"weekly_recap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetting_NotificationSetting) Reset() {
+	*x = UserSetting_NotificationSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting_NotificationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting_NotificationSetting) ProtoMessage() {}
+
+func (x *UserSetting_NotificationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[73]
 	if x != nil {
-		return x.Sender
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserNotification) GetSenderUser() *User {
+// Deprecated: Use UserSetting_NotificationSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_NotificationSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14, 2}
+}
+
+func (x *UserSetting_NotificationSetting) GetPreferences() []*UserSetting_NotificationSetting_Preference {
 	if x != nil {
-		return x.SenderUser
+		return x.Preferences
 	}
 	return nil
 }
 
-func (x *UserNotification) GetStatus() UserNotification_Status {
+func (x *UserSetting_NotificationSetting) GetChannels() []*UserSetting_NotificationSetting_Channel {
 	if x != nil {
-		return x.Status
+		return x.Channels
 	}
-	return UserNotification_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *UserNotification) GetCreateTime() *timestamppb.Timestamp {
+func (x *UserSetting_NotificationSetting) GetWeeklyRecap() bool {
 	if x != nil {
-		return x.CreateTime
+		return x.WeeklyRecap
 	}
-	return nil
+	return false
 }
 
-func (x *UserNotification) GetType() UserNotification_Type {
+// The email delivery preference for a notification type.
+type UserSetting_NotificationSetting_Preference struct {
+	state         protoimpl.MessageState                        `protogen:"open.v1"`
+	Type          UserNotification_Type                         `protobuf:"varint,1,opt,name=type,proto3,enum=memos.api.v1.UserNotification_Type" json:"type,omitempty"`
+	EmailDelivery UserSetting_NotificationSetting_EmailDelivery `protobuf:"varint,2,opt,name=email_delivery,json=emailDelivery,proto3,enum=memos.api.v1.UserSetting_NotificationSetting_EmailDelivery" json:"email_delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetting_NotificationSetting_Preference) Reset() {
+	*x = UserSetting_NotificationSetting_Preference{}
+	mi := &file_api_v1_user_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting_NotificationSetting_Preference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting_NotificationSetting_Preference) ProtoMessage() {}
+
+func (x *UserSetting_NotificationSetting_Preference) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[74]
 	if x != nil {
-		return x.Type
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return UserNotification_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *UserNotification) GetPayload() isUserNotification_Payload {
+// Deprecated: Use UserSetting_NotificationSetting_Preference.ProtoReflect.Descriptor instead.
+func (*UserSetting_NotificationSetting_Preference) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14, 2, 0}
+}
+
+func (x *UserSetting_NotificationSetting_Preference) GetType() UserNotification_Type {
 	if x != nil {
-		return x.Payload
+		return x.Type
 	}
-	return nil
+	return UserNotification_TYPE_UNSPECIFIED
 }
 
-func (x *UserNotification) GetMemoComment() *UserNotification_MemoCommentPayload {
+func (x *UserSetting_NotificationSetting_Preference) GetEmailDelivery() UserSetting_NotificationSetting_EmailDelivery {
 	if x != nil {
-		if x, ok := x.Payload.(*UserNotification_MemoComment); ok {
-			return x.MemoComment
-		}
+		return x.EmailDelivery
 	}
-	return nil
+	return UserSetting_NotificationSetting_EMAIL_DELIVERY_UNSPECIFIED
 }
 
-func (x *UserNotification) GetMemoMention() *UserNotification_MemoMentionPayload {
+// A self-hosted push service that inbox notifications and reminders are also delivered to.
+type UserSetting_NotificationSetting_Channel struct {
+	state protoimpl.MessageState                       `protogen:"open.v1"`
+	Type  UserSetting_NotificationSetting_Channel_Type `protobuf:"varint,1,opt,name=type,proto3,enum=memos.api.v1.UserSetting_NotificationSetting_Channel_Type" json:"type,omitempty"`
+	// For ntfy, the topic URL, e.g. https://ntfy.sh/my-topic.
+	// For Gotify, the server URL.
+	// For Apprise, the notify URL of the API, e.g. https://apprise.example.com/notify/my-key.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The ntfy access token (optional) or the Gotify application token (required).
+	// It is never returned; leave it empty to keep the token of the channel with the same type and URL.
+	Token         string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetting_NotificationSetting_Channel) Reset() {
+	*x = UserSetting_NotificationSetting_Channel{}
+	mi := &file_api_v1_user_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting_NotificationSetting_Channel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting_NotificationSetting_Channel) ProtoMessage() {}
+
+func (x *UserSetting_NotificationSetting_Channel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[75]
 	if x != nil {
-		if x, ok := x.Payload.(*UserNotification_MemoMention); ok {
-			return x.MemoMention
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type isUserNotification_Payload interface {
-	isUserNotification_Payload()
+// Deprecated: Use UserSetting_NotificationSetting_Channel.ProtoReflect.Descriptor instead.
+func (*UserSetting_NotificationSetting_Channel) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14, 2, 1}
 }
 
-type UserNotification_MemoComment struct {
-	MemoComment *UserNotification_MemoCommentPayload `protobuf:"bytes,6,opt,name=memo_comment,json=memoComment,proto3,oneof"`
+func (x *UserSetting_NotificationSetting_Channel) GetType() UserSetting_NotificationSetting_Channel_Type {
+	if x != nil {
+		return x.Type
+	}
+	return UserSetting_NotificationSetting_Channel_TYPE_UNSPECIFIED
 }
 
-type UserNotification_MemoMention struct {
-	MemoMention *UserNotification_MemoMentionPayload `protobuf:"bytes,7,opt,name=memo_mention,json=memoMention,proto3,oneof"`
+func (x *UserSetting_NotificationSetting_Channel) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
 }
 
-func (*UserNotification_MemoComment) isUserNotification_Payload() {}
-
-func (*UserNotification_MemoMention) isUserNotification_Payload() {}
+func (x *UserSetting_NotificationSetting_Channel) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
 
-type ListUserNotificationsRequest struct {
+type Session_ClientInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent user resource.
-	// Format: users/{user}
-	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Filter        string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The user agent string of the client.
+	UserAgent string `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// The IP address of the client at sign-in.
+	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// The device type (e.g., "mobile", "desktop", "tablet").
+	DeviceType string `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	// The operating system (e.g., "iOS 17.0", "Windows 11").
+	Os string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
+	// The browser name and version (e.g., "Chrome 119.0").
+	Browser       string `protobuf:"bytes,5,opt,name=browser,proto3" json:"browser,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserNotificationsRequest) Reset() {
-	*x = ListUserNotificationsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[37]
+func (x *Session_ClientInfo) Reset() {
+	*x = Session_ClientInfo{}
+	mi := &file_api_v1_user_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserNotificationsRequest) String() string {
+func (x *Session_ClientInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserNotificationsRequest) ProtoMessage() {}
+func (*Session_ClientInfo) ProtoMessage() {}
 
-func (x *ListUserNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[37]
+func (x *Session_ClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2632,62 +5295,77 @@ func (x *ListUserNotificationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserNotificationsRequest.ProtoReflect.Descriptor instead.
-func (*ListUserNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use Session_ClientInfo.ProtoReflect.Descriptor instead.
+func (*Session_ClientInfo) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31, 0}
 }
 
-func (x *ListUserNotificationsRequest) GetParent() string {
+func (x *Session_ClientInfo) GetUserAgent() string {
 	if x != nil {
-		return x.Parent
+		return x.UserAgent
 	}
 	return ""
 }
 
-func (x *ListUserNotificationsRequest) GetPageSize() int32 {
+func (x *Session_ClientInfo) GetIpAddress() string {
 	if x != nil {
-		return x.PageSize
+		return x.IpAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *ListUserNotificationsRequest) GetPageToken() string {
+func (x *Session_ClientInfo) GetDeviceType() string {
 	if x != nil {
-		return x.PageToken
+		return x.DeviceType
 	}
 	return ""
 }
 
-func (x *ListUserNotificationsRequest) GetFilter() string {
+func (x *Session_ClientInfo) GetOs() string {
 	if x != nil {
-		return x.Filter
+		return x.Os
 	}
 	return ""
 }
 
-type ListUserNotificationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notifications []*UserNotification    `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Session_ClientInfo) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
 }
 
-func (x *ListUserNotificationsResponse) Reset() {
-	*x = ListUserNotificationsResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[38]
+type UserNotification_MemoCommentPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memo name of comment.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The name of related memo.
+	// Format: memos/{memo}
+	RelatedMemo string `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
+	// Preview text of the comment memo.
+	MemoSnippet string `protobuf:"bytes,3,opt,name=memo_snippet,json=memoSnippet,proto3" json:"memo_snippet,omitempty"`
+	// Preview text of the related memo.
+	RelatedMemoSnippet string `protobuf:"bytes,4,opt,name=related_memo_snippet,json=relatedMemoSnippet,proto3" json:"related_memo_snippet,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UserNotification_MemoCommentPayload) Reset() {
+	*x = UserNotification_MemoCommentPayload{}
+	mi := &file_api_v1_user_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserNotificationsResponse) String() string {
+func (x *UserNotification_MemoCommentPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserNotificationsResponse) ProtoMessage() {}
+func (*UserNotification_MemoCommentPayload) ProtoMessage() {}
 
-func (x *ListUserNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[38]
+func (x *UserNotification_MemoCommentPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2698,48 +5376,70 @@ func (x *ListUserNotificationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserNotificationsResponse.ProtoReflect.Descriptor instead.
-func (*ListUserNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use UserNotification_MemoCommentPayload.ProtoReflect.Descriptor instead.
+func (*UserNotification_MemoCommentPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46, 0}
 }
 
-func (x *ListUserNotificationsResponse) GetNotifications() []*UserNotification {
+func (x *UserNotification_MemoCommentPayload) GetMemo() string {
 	if x != nil {
-		return x.Notifications
+		return x.Memo
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUserNotificationsResponse) GetNextPageToken() string {
+func (x *UserNotification_MemoCommentPayload) GetRelatedMemo() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.RelatedMemo
 	}
 	return ""
 }
 
-type UpdateUserNotificationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notification  *UserNotification      `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UserNotification_MemoCommentPayload) GetMemoSnippet() string {
+	if x != nil {
+		return x.MemoSnippet
+	}
+	return ""
+}
+
+func (x *UserNotification_MemoCommentPayload) GetRelatedMemoSnippet() string {
+	if x != nil {
+		return x.RelatedMemoSnippet
+	}
+	return ""
+}
+
+type UserNotification_MemoMentionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memo that contains the mention.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The related parent memo when the mention was created in a comment.
+	// Format: memos/{memo}
+	RelatedMemo string `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
+	// Preview text of the memo that contains the mention.
+	MemoSnippet string `protobuf:"bytes,3,opt,name=memo_snippet,json=memoSnippet,proto3" json:"memo_snippet,omitempty"`
+	// Preview text of the related parent memo.
+	RelatedMemoSnippet string `protobuf:"bytes,4,opt,name=related_memo_snippet,json=relatedMemoSnippet,proto3" json:"related_memo_snippet,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *UpdateUserNotificationRequest) Reset() {
-	*x = UpdateUserNotificationRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[39]
+func (x *UserNotification_MemoMentionPayload) Reset() {
+	*x = UserNotification_MemoMentionPayload{}
+	mi := &file_api_v1_user_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserNotificationRequest) String() string {
+func (x *UserNotification_MemoMentionPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserNotificationRequest) ProtoMessage() {}
+func (*UserNotification_MemoMentionPayload) ProtoMessage() {}
 
-func (x *UpdateUserNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[39]
+func (x *UserNotification_MemoMentionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2750,96 +5450,67 @@ func (x *UpdateUserNotificationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserNotificationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UserNotification_MemoMentionPayload.ProtoReflect.Descriptor instead.
+func (*UserNotification_MemoMentionPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46, 1}
 }
 
-func (x *UpdateUserNotificationRequest) GetNotification() *UserNotification {
+func (x *UserNotification_MemoMentionPayload) GetMemo() string {
 	if x != nil {
-		return x.Notification
+		return x.Memo
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateUserNotificationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *UserNotification_MemoMentionPayload) GetRelatedMemo() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.RelatedMemo
 	}
-	return nil
-}
-
-type DeleteUserNotificationRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Format: users/{user}/notifications/{notification}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteUserNotificationRequest) Reset() {
-	*x = DeleteUserNotificationRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[40]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteUserNotificationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*DeleteUserNotificationRequest) ProtoMessage() {}
-
-func (x *DeleteUserNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[40]
+func (x *UserNotification_MemoMentionPayload) GetMemoSnippet() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MemoSnippet
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteUserNotificationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{40}
+	return ""
 }
 
-func (x *DeleteUserNotificationRequest) GetName() string {
+func (x *UserNotification_MemoMentionPayload) GetRelatedMemoSnippet() string {
 	if x != nil {
-		return x.Name
+		return x.RelatedMemoSnippet
 	}
 	return ""
 }
 
-// Memo type statistics.
-type UserStats_MemoTypeStats struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LinkCount     int32                  `protobuf:"varint,1,opt,name=link_count,json=linkCount,proto3" json:"link_count,omitempty"`
-	CodeCount     int32                  `protobuf:"varint,2,opt,name=code_count,json=codeCount,proto3" json:"code_count,omitempty"`
-	TodoCount     int32                  `protobuf:"varint,3,opt,name=todo_count,json=todoCount,proto3" json:"todo_count,omitempty"`
-	UndoCount     int32                  `protobuf:"varint,4,opt,name=undo_count,json=undoCount,proto3" json:"undo_count,omitempty"`
+type UserNotification_MemoCollaboratorPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memo the receiver can now collaborate on.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Preview text of the memo.
+	MemoSnippet string `protobuf:"bytes,2,opt,name=memo_snippet,json=memoSnippet,proto3" json:"memo_snippet,omitempty"`
+	// The granted collaborator role, VIEWER or EDITOR.
+	Role          string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserStats_MemoTypeStats) Reset() {
-	*x = UserStats_MemoTypeStats{}
-	mi := &file_api_v1_user_service_proto_msgTypes[41]
+func (x *UserNotification_MemoCollaboratorPayload) Reset() {
+	*x = UserNotification_MemoCollaboratorPayload{}
+	mi := &file_api_v1_user_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserStats_MemoTypeStats) String() string {
+func (x *UserNotification_MemoCollaboratorPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserStats_MemoTypeStats) ProtoMessage() {}
+func (*UserNotification_MemoCollaboratorPayload) ProtoMessage() {}
 
-func (x *UserStats_MemoTypeStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[41]
+func (x *UserNotification_MemoCollaboratorPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2850,69 +5521,60 @@ func (x *UserStats_MemoTypeStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserStats_MemoTypeStats.ProtoReflect.Descriptor instead.
-func (*UserStats_MemoTypeStats) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9, 0}
-}
-
-func (x *UserStats_MemoTypeStats) GetLinkCount() int32 {
-	if x != nil {
-		return x.LinkCount
-	}
-	return 0
+// Deprecated: Use UserNotification_MemoCollaboratorPayload.ProtoReflect.Descriptor instead.
+func (*UserNotification_MemoCollaboratorPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46, 2}
 }
 
-func (x *UserStats_MemoTypeStats) GetCodeCount() int32 {
+func (x *UserNotification_MemoCollaboratorPayload) GetMemo() string {
 	if x != nil {
-		return x.CodeCount
+		return x.Memo
 	}
-	return 0
+	return ""
 }
 
-func (x *UserStats_MemoTypeStats) GetTodoCount() int32 {
+func (x *UserNotification_MemoCollaboratorPayload) GetMemoSnippet() string {
 	if x != nil {
-		return x.TodoCount
+		return x.MemoSnippet
 	}
-	return 0
+	return ""
 }
 
-func (x *UserStats_MemoTypeStats) GetUndoCount() int32 {
+func (x *UserNotification_MemoCollaboratorPayload) GetRole() string {
 	if x != nil {
-		return x.UndoCount
+		return x.Role
 	}
-	return 0
+	return ""
 }
 
-// General user settings configuration.
-type UserSetting_GeneralSetting struct {
+type UserNotification_MemoReminderPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The preferred locale of the user.
-	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
-	// The default visibility of the memo.
-	MemoVisibility string `protobuf:"bytes,3,opt,name=memo_visibility,json=memoVisibility,proto3" json:"memo_visibility,omitempty"`
-	// The preferred theme of the user.
-	// This references a CSS file in the web/public/themes/ directory.
-	// If not set, the default theme will be used.
-	Theme         string `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
+	// The memo the reminder is for.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Preview text of the memo.
+	MemoSnippet string `protobuf:"bytes,2,opt,name=memo_snippet,json=memoSnippet,proto3" json:"memo_snippet,omitempty"`
+	// The time the memo is due, if set.
+	DueTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_time,json=dueTime,proto3" json:"due_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserSetting_GeneralSetting) Reset() {
-	*x = UserSetting_GeneralSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[43]
+func (x *UserNotification_MemoReminderPayload) Reset() {
+	*x = UserNotification_MemoReminderPayload{}
+	mi := &file_api_v1_user_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSetting_GeneralSetting) String() string {
+func (x *UserNotification_MemoReminderPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSetting_GeneralSetting) ProtoMessage() {}
+func (*UserNotification_MemoReminderPayload) ProtoMessage() {}
 
-func (x *UserSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[43]
+func (x *UserNotification_MemoReminderPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2923,56 +5585,60 @@ func (x *UserSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSetting_GeneralSetting.ProtoReflect.Descriptor instead.
-func (*UserSetting_GeneralSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use UserNotification_MemoReminderPayload.ProtoReflect.Descriptor instead.
+func (*UserNotification_MemoReminderPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46, 3}
 }
 
-func (x *UserSetting_GeneralSetting) GetLocale() string {
+func (x *UserNotification_MemoReminderPayload) GetMemo() string {
 	if x != nil {
-		return x.Locale
+		return x.Memo
 	}
 	return ""
 }
 
-func (x *UserSetting_GeneralSetting) GetMemoVisibility() string {
+func (x *UserNotification_MemoReminderPayload) GetMemoSnippet() string {
 	if x != nil {
-		return x.MemoVisibility
+		return x.MemoSnippet
 	}
 	return ""
 }
 
-func (x *UserSetting_GeneralSetting) GetTheme() string {
+func (x *UserNotification_MemoReminderPayload) GetDueTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Theme
+		return x.DueTime
 	}
-	return ""
+	return nil
 }
 
-// User webhooks configuration.
-type UserSetting_WebhooksSetting struct {
+type UserNotification_MemoReactionPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of user webhooks.
-	Webhooks      []*UserWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	// The memo that was reacted to.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Preview text of the memo.
+	MemoSnippet string `protobuf:"bytes,2,opt,name=memo_snippet,json=memoSnippet,proto3" json:"memo_snippet,omitempty"`
+	// The reaction, e.g. an emoji.
+	ReactionType  string `protobuf:"bytes,3,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserSetting_WebhooksSetting) Reset() {
-	*x = UserSetting_WebhooksSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[44]
+func (x *UserNotification_MemoReactionPayload) Reset() {
+	*x = UserNotification_MemoReactionPayload{}
+	mi := &file_api_v1_user_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSetting_WebhooksSetting) String() string {
+func (x *UserNotification_MemoReactionPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSetting_WebhooksSetting) ProtoMessage() {}
+func (*UserNotification_MemoReactionPayload) ProtoMessage() {}
 
-func (x *UserSetting_WebhooksSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[44]
+func (x *UserNotification_MemoReactionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2983,27 +5649,41 @@ func (x *UserSetting_WebhooksSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSetting_WebhooksSetting.ProtoReflect.Descriptor instead.
-func (*UserSetting_WebhooksSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 1}
+// Deprecated: Use UserNotification_MemoReactionPayload.ProtoReflect.Descriptor instead.
+func (*UserNotification_MemoReactionPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46, 4}
 }
 
-func (x *UserSetting_WebhooksSetting) GetWebhooks() []*UserWebhook {
+func (x *UserNotification_MemoReactionPayload) GetMemo() string {
 	if x != nil {
-		return x.Webhooks
+		return x.Memo
 	}
-	return nil
+	return ""
 }
 
-type UserNotification_MemoCommentPayload struct {
+func (x *UserNotification_MemoReactionPayload) GetMemoSnippet() string {
+	if x != nil {
+		return x.MemoSnippet
+	}
+	return ""
+}
+
+func (x *UserNotification_MemoReactionPayload) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
+type UserNotification_MemoThreadReplyPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The memo name of comment.
+	// The new comment.
 	// Format: memos/{memo}
 	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	// The name of related memo.
+	// The memo whose comment thread the receiver took part in.
 	// Format: memos/{memo}
 	RelatedMemo string `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
-	// Preview text of the comment memo.
+	// Preview text of the comment.
 	MemoSnippet string `protobuf:"bytes,3,opt,name=memo_snippet,json=memoSnippet,proto3" json:"memo_snippet,omitempty"`
 	// Preview text of the related memo.
 	RelatedMemoSnippet string `protobuf:"bytes,4,opt,name=related_memo_snippet,json=relatedMemoSnippet,proto3" json:"related_memo_snippet,omitempty"`
@@ -3011,21 +5691,21 @@ type UserNotification_MemoCommentPayload struct {
 	sizeCache          protoimpl.SizeCache
 }
 
-func (x *UserNotification_MemoCommentPayload) Reset() {
-	*x = UserNotification_MemoCommentPayload{}
-	mi := &file_api_v1_user_service_proto_msgTypes[45]
+func (x *UserNotification_MemoThreadReplyPayload) Reset() {
+	*x = UserNotification_MemoThreadReplyPayload{}
+	mi := &file_api_v1_user_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserNotification_MemoCommentPayload) String() string {
+func (x *UserNotification_MemoThreadReplyPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserNotification_MemoCommentPayload) ProtoMessage() {}
+func (*UserNotification_MemoThreadReplyPayload) ProtoMessage() {}
 
-func (x *UserNotification_MemoCommentPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[45]
+func (x *UserNotification_MemoThreadReplyPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3036,70 +5716,68 @@ func (x *UserNotification_MemoCommentPayload) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserNotification_MemoCommentPayload.ProtoReflect.Descriptor instead.
-func (*UserNotification_MemoCommentPayload) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{36, 0}
+// Deprecated: Use UserNotification_MemoThreadReplyPayload.ProtoReflect.Descriptor instead.
+func (*UserNotification_MemoThreadReplyPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46, 5}
 }
 
-func (x *UserNotification_MemoCommentPayload) GetMemo() string {
+func (x *UserNotification_MemoThreadReplyPayload) GetMemo() string {
 	if x != nil {
 		return x.Memo
 	}
 	return ""
 }
 
-func (x *UserNotification_MemoCommentPayload) GetRelatedMemo() string {
+func (x *UserNotification_MemoThreadReplyPayload) GetRelatedMemo() string {
 	if x != nil {
 		return x.RelatedMemo
 	}
 	return ""
 }
 
-func (x *UserNotification_MemoCommentPayload) GetMemoSnippet() string {
+func (x *UserNotification_MemoThreadReplyPayload) GetMemoSnippet() string {
 	if x != nil {
 		return x.MemoSnippet
 	}
 	return ""
 }
 
-func (x *UserNotification_MemoCommentPayload) GetRelatedMemoSnippet() string {
+func (x *UserNotification_MemoThreadReplyPayload) GetRelatedMemoSnippet() string {
 	if x != nil {
 		return x.RelatedMemoSnippet
 	}
 	return ""
 }
 
-type UserNotification_MemoMentionPayload struct {
+type UserNotification_MemoShareOpenedPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The memo that contains the mention.
+	// The shared memo.
 	// Format: memos/{memo}
 	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	// The related parent memo when the mention was created in a comment.
-	// Format: memos/{memo}
-	RelatedMemo string `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
-	// Preview text of the memo that contains the mention.
-	MemoSnippet string `protobuf:"bytes,3,opt,name=memo_snippet,json=memoSnippet,proto3" json:"memo_snippet,omitempty"`
-	// Preview text of the related parent memo.
-	RelatedMemoSnippet string `protobuf:"bytes,4,opt,name=related_memo_snippet,json=relatedMemoSnippet,proto3" json:"related_memo_snippet,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Preview text of the memo.
+	MemoSnippet string `protobuf:"bytes,2,opt,name=memo_snippet,json=memoSnippet,proto3" json:"memo_snippet,omitempty"`
+	// The share link that was opened.
+	// Format: memos/{memo}/shares/{share}
+	Share         string `protobuf:"bytes,3,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserNotification_MemoMentionPayload) Reset() {
-	*x = UserNotification_MemoMentionPayload{}
-	mi := &file_api_v1_user_service_proto_msgTypes[46]
+func (x *UserNotification_MemoShareOpenedPayload) Reset() {
+	*x = UserNotification_MemoShareOpenedPayload{}
+	mi := &file_api_v1_user_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserNotification_MemoMentionPayload) String() string {
+func (x *UserNotification_MemoShareOpenedPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserNotification_MemoMentionPayload) ProtoMessage() {}
+func (*UserNotification_MemoShareOpenedPayload) ProtoMessage() {}
 
-func (x *UserNotification_MemoMentionPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[46]
+func (x *UserNotification_MemoShareOpenedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3110,35 +5788,28 @@ func (x *UserNotification_MemoMentionPayload) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserNotification_MemoMentionPayload.ProtoReflect.Descriptor instead.
-func (*UserNotification_MemoMentionPayload) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{36, 1}
+// Deprecated: Use UserNotification_MemoShareOpenedPayload.ProtoReflect.Descriptor instead.
+func (*UserNotification_MemoShareOpenedPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46, 6}
 }
 
-func (x *UserNotification_MemoMentionPayload) GetMemo() string {
+func (x *UserNotification_MemoShareOpenedPayload) GetMemo() string {
 	if x != nil {
 		return x.Memo
 	}
 	return ""
 }
 
-func (x *UserNotification_MemoMentionPayload) GetRelatedMemo() string {
-	if x != nil {
-		return x.RelatedMemo
-	}
-	return ""
-}
-
-func (x *UserNotification_MemoMentionPayload) GetMemoSnippet() string {
+func (x *UserNotification_MemoShareOpenedPayload) GetMemoSnippet() string {
 	if x != nil {
 		return x.MemoSnippet
 	}
 	return ""
 }
 
-func (x *UserNotification_MemoMentionPayload) GetRelatedMemoSnippet() string {
+func (x *UserNotification_MemoShareOpenedPayload) GetShare() string {
 	if x != nil {
-		return x.RelatedMemoSnippet
+		return x.Share
 	}
 	return ""
 }
@@ -3147,7 +5818,7 @@ var File_api_v1_user_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_user_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/v1/user_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc1\x04\n" +
+	"\x19api/v1/user_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa9\x05\n" +
 	"\x04User\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x120\n" +
 	"\x04role\x18\x02 \x01(\x0e2\x17.memos.api.v1.User.RoleB\x03\xe0A\x02R\x04role\x12\x1f\n" +
@@ -3163,7 +5834,11 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"updateTime\"1\n" +
+	"updateTime\x12*\n" +
+	"\x0eemail_verified\x18\f \x01(\bB\x03\xe0A\x03R\remailVerified\x12:\n" +
+	"\vcustom_role\x18\r \x01(\tB\x19\xe0A\x01\xfaA\x13\n" +
+	"\x11memos.api.v1/RoleR\n" +
+	"customRole\"1\n" +
 	"\x04Role\x12\x14\n" +
 	"\x10ROLE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05ADMIN\x10\x02\x12\b\n" +
@@ -3187,13 +5862,14 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x0eGetUserRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/UserR\x04name\x12<\n" +
-	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\breadMask\"\xaf\x01\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\breadMask\"\xdd\x01\n" +
 	"\x11CreateUserRequest\x12.\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserB\x06\xe0A\x02\xe0A\x04R\x04user\x12\x1c\n" +
 	"\auser_id\x18\x02 \x01(\tB\x03\xe0A\x01R\x06userId\x12(\n" +
 	"\rvalidate_only\x18\x03 \x01(\bB\x03\xe0A\x01R\fvalidateOnly\x12\"\n" +
 	"\n" +
-	"request_id\x18\x04 \x01(\tB\x03\xe0A\x01R\trequestId\"\xac\x01\n" +
+	"request_id\x18\x04 \x01(\tB\x03\xe0A\x01R\trequestId\x12,\n" +
+	"\x0finvitation_code\x18\x05 \x01(\tB\x03\xe0A\x01R\x0einvitationCode\"\xac\x01\n" +
 	"\x11UpdateUserRequest\x12+\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserB\x03\xe0A\x02R\x04user\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
@@ -3202,7 +5878,10 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x11DeleteUserRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/UserR\x04name\x12\x19\n" +
-	"\x05force\x18\x02 \x01(\bB\x03\xe0A\x01R\x05force\"\xd7\x05\n" +
+	"\x05force\x18\x02 \x01(\bB\x03\xe0A\x01R\x05force\"B\n" +
+	"\x11UnlockUserRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\"\xd7\x05\n" +
 	"\tUserStats\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12M\n" +
 	"\x0fmemo_type_stats\x18\x03 \x01(\v2%.memos.api.v1.UserStats.MemoTypeStatsR\rmemoTypeStats\x12B\n" +
@@ -3231,21 +5910,48 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x05state\x18\x01 \x01(\x0e2\x13.memos.api.v1.StateB\x03\xe0A\x01R\x05state\x12\x1b\n" +
 	"\x06filter\x18\x02 \x01(\tB\x03\xe0A\x01R\x06filter\"I\n" +
 	"\x18ListAllUserStatsResponse\x12-\n" +
-	"\x05stats\x18\x01 \x03(\v2\x17.memos.api.v1.UserStatsR\x05stats\"\xb4\x04\n" +
+	"\x05stats\x18\x01 \x03(\v2\x17.memos.api.v1.UserStatsR\x05stats\"\x83\f\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12S\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2(.memos.api.v1.UserSetting.GeneralSettingH\x00R\x0egeneralSetting\x12V\n" +
-	"\x10webhooks_setting\x18\x05 \x01(\v2).memos.api.v1.UserSetting.WebhooksSettingH\x00R\x0fwebhooksSetting\x1av\n" +
+	"\x10webhooks_setting\x18\x05 \x01(\v2).memos.api.v1.UserSetting.WebhooksSettingH\x00R\x0fwebhooksSetting\x12b\n" +
+	"\x14notification_setting\x18\x06 \x01(\v2-.memos.api.v1.UserSetting.NotificationSettingH\x00R\x13notificationSetting\x1a\xda\x01\n" +
 	"\x0eGeneralSetting\x12\x1b\n" +
 	"\x06locale\x18\x01 \x01(\tB\x03\xe0A\x01R\x06locale\x12,\n" +
 	"\x0fmemo_visibility\x18\x03 \x01(\tB\x03\xe0A\x01R\x0ememoVisibility\x12\x19\n" +
-	"\x05theme\x18\x04 \x01(\tB\x03\xe0A\x01R\x05theme\x1aH\n" +
+	"\x05theme\x18\x04 \x01(\tB\x03\xe0A\x01R\x05theme\x12b\n" +
+	"\x18muted_notification_types\x18\x05 \x03(\x0e2#.memos.api.v1.UserNotification.TypeB\x03\xe0A\x01R\x16mutedNotificationTypes\x1aH\n" +
 	"\x0fWebhooksSetting\x125\n" +
-	"\bwebhooks\x18\x01 \x03(\v2\x19.memos.api.v1.UserWebhookR\bwebhooks\"5\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x19.memos.api.v1.UserWebhookR\bwebhooks\x1a\xf1\x05\n" +
+	"\x13NotificationSetting\x12_\n" +
+	"\vpreferences\x18\x01 \x03(\v28.memos.api.v1.UserSetting.NotificationSetting.PreferenceB\x03\xe0A\x01R\vpreferences\x12V\n" +
+	"\bchannels\x18\x02 \x03(\v25.memos.api.v1.UserSetting.NotificationSetting.ChannelB\x03\xe0A\x01R\bchannels\x12&\n" +
+	"\fweekly_recap\x18\x03 \x01(\bB\x03\xe0A\x01R\vweeklyRecap\x1a\xb3\x01\n" +
+	"\n" +
+	"Preference\x12<\n" +
+	"\x04type\x18\x01 \x01(\x0e2#.memos.api.v1.UserNotification.TypeB\x03\xe0A\x02R\x04type\x12g\n" +
+	"\x0eemail_delivery\x18\x02 \x01(\x0e2;.memos.api.v1.UserSetting.NotificationSetting.EmailDeliveryB\x03\xe0A\x02R\remailDelivery\x1a\xd4\x01\n" +
+	"\aChannel\x12S\n" +
+	"\x04type\x18\x01 \x01(\x0e2:.memos.api.v1.UserSetting.NotificationSetting.Channel.TypeB\x03\xe0A\x02R\x04type\x12\x15\n" +
+	"\x03url\x18\x02 \x01(\tB\x03\xe0A\x02R\x03url\x12\x1c\n" +
+	"\x05token\x18\x03 \x01(\tB\x06\xe0A\x01\xe0A\x04R\x05token\"?\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04NTFY\x10\x01\x12\n" +
+	"\n" +
+	"\x06GOTIFY\x10\x02\x12\v\n" +
+	"\aAPPRISE\x10\x03\"l\n" +
+	"\rEmailDelivery\x12\x1e\n" +
+	"\x1aEMAIL_DELIVERY_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tIMMEDIATE\x10\x01\x12\x11\n" +
+	"\rHOURLY_DIGEST\x10\x02\x12\x10\n" +
+	"\fDAILY_DIGEST\x10\x03\x12\a\n" +
+	"\x03OFF\x10\x04\"G\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\f\n" +
-	"\bWEBHOOKS\x10\x04:]\xeaAZ\n" +
+	"\bWEBHOOKS\x10\x04\x12\x10\n" +
+	"\fNOTIFICATION\x10\x05:]\xeaAZ\n" +
 	"\x18memos.api.v1/UserSetting\x12#users/{username}/settings/{setting}*\fuserSettings2\vuserSettingB\a\n" +
 	"\x05value\"M\n" +
 	"\x15GetUserSettingRequest\x124\n" +
@@ -3291,7 +5997,7 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x1bmemos.api.v1/LinkedIdentityR\x04name\"V\n" +
 	"\x1bDeleteLinkedIdentityRequest\x127\n" +
 	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
-	"\x1bmemos.api.v1/LinkedIdentityR\x04name\"\xa7\x03\n" +
+	"\x1bmemos.api.v1/LinkedIdentityR\x04name\"\xc4\x03\n" +
 	"\x13PersonalAccessToken\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tB\x03\xe0A\x01R\vdescription\x12>\n" +
@@ -3300,7 +6006,8 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\n" +
 	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\texpiresAt\x12A\n" +
 	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"lastUsedAt:\x8c\x01\xeaA\x88\x01\n" +
+	"lastUsedAt\x12\x1b\n" +
+	"\x06scopes\x18\x06 \x03(\tB\x03\xe0A\x03R\x06scopes:\x8c\x01\xeaA\x88\x01\n" +
 	" memos.api.v1/PersonalAccessToken\x129users/{user}/personalAccessTokens/{personal_access_token}*\x14personalAccessTokens2\x13personalAccessToken\"\x9a\x01\n" +
 	"\x1fListPersonalAccessTokensRequest\x121\n" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
@@ -3312,18 +6019,55 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x16personal_access_tokens\x18\x01 \x03(\v2!.memos.api.v1.PersonalAccessTokenR\x14personalAccessTokens\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xa9\x01\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xc6\x01\n" +
 	" CreatePersonalAccessTokenRequest\x121\n" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/UserR\x06parent\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tB\x03\xe0A\x01R\vdescription\x12+\n" +
-	"\x0fexpires_in_days\x18\x03 \x01(\x05B\x03\xe0A\x01R\rexpiresInDays\"\x90\x01\n" +
+	"\x0fexpires_in_days\x18\x03 \x01(\x05B\x03\xe0A\x01R\rexpiresInDays\x12\x1b\n" +
+	"\x06scopes\x18\x04 \x03(\tB\x03\xe0A\x01R\x06scopes\"\x90\x01\n" +
 	"!CreatePersonalAccessTokenResponse\x12U\n" +
 	"\x15personal_access_token\x18\x01 \x01(\v2!.memos.api.v1.PersonalAccessTokenR\x13personalAccessToken\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\"`\n" +
 	" DeletePersonalAccessTokenRequest\x12<\n" +
 	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
-	" memos.api.v1/PersonalAccessTokenR\x04name\"\xda\x01\n" +
+	" memos.api.v1/PersonalAccessTokenR\x04name\"\xda\x04\n" +
+	"\aSession\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12>\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\x12>\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\texpiresAt\x12A\n" +
+	"\flast_seen_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"lastSeenAt\x12%\n" +
+	"\flast_seen_ip\x18\x05 \x01(\tB\x03\xe0A\x03R\n" +
+	"lastSeenIp\x12F\n" +
+	"\vclient_info\x18\x06 \x01(\v2 .memos.api.v1.Session.ClientInfoB\x03\xe0A\x03R\n" +
+	"clientInfo\x12\x1d\n" +
+	"\acurrent\x18\a \x01(\bB\x03\xe0A\x03R\acurrent\x1a\x95\x01\n" +
+	"\n" +
+	"ClientInfo\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x01 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x1f\n" +
+	"\vdevice_type\x18\x03 \x01(\tR\n" +
+	"deviceType\x12\x0e\n" +
+	"\x02os\x18\x04 \x01(\tR\x02os\x12\x18\n" +
+	"\abrowser\x18\x05 \x01(\tR\abrowser:M\xeaAJ\n" +
+	"\x14memos.api.v1/Session\x12\x1fusers/{user}/sessions/{session}*\bsessions2\asession\"H\n" +
+	"\x13ListSessionsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"I\n" +
+	"\x14ListSessionsResponse\x121\n" +
+	"\bsessions\x18\x01 \x03(\v2\x15.memos.api.v1.SessionR\bsessions\"H\n" +
+	"\x14RevokeSessionRequest\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
+	"\x14memos.api.v1/SessionR\x04name\"\x97\x01\n" +
+	"\x18RevokeAllSessionsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12H\n" +
+	"\x1einclude_personal_access_tokens\x18\x02 \x01(\bB\x03\xe0A\x01R\x1bincludePersonalAccessTokens\"\xf3\x03\n" +
 	"\vUserWebhook\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12!\n" +
@@ -3331,7 +6075,21 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"updateTime\"6\n" +
+	"updateTime\x12\x1b\n" +
+	"\x06secret\x18\x06 \x01(\tB\x03\xe0A\x03R\x06secret\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\x12$\n" +
+	"\vevent_types\x18\b \x03(\tB\x03\xe0A\x01R\n" +
+	"eventTypes\x12\x1b\n" +
+	"\x06filter\x18\t \x01(\tB\x03\xe0A\x01R\x06filter\x12=\n" +
+	"\x06format\x18\n" +
+	" \x01(\x0e2 .memos.api.v1.UserWebhook.FormatB\x03\xe0A\x01R\x06format\"\\\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05MEMOS\x10\x01\x12\t\n" +
+	"\x05SLACK\x10\x02\x12\v\n" +
+	"\aDISCORD\x10\x03\x12\t\n" +
+	"\x05TEAMS\x10\x04\x12\f\n" +
+	"\bTELEGRAM\x10\x05\"6\n" +
 	"\x17ListUserWebhooksRequest\x12\x1b\n" +
 	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x02R\x06parent\"Q\n" +
 	"\x18ListUserWebhooksResponse\x125\n" +
@@ -3344,7 +6102,41 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\"3\n" +
 	"\x18DeleteUserWebhookRequest\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xda\b\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\x85\x05\n" +
+	"\x0fWebhookDelivery\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12(\n" +
+	"\ractivity_type\x18\x02 \x01(\tB\x03\xe0A\x03R\factivityType\x12\x15\n" +
+	"\x03url\x18\x03 \x01(\tB\x03\xe0A\x03R\x03url\x12>\n" +
+	"\x05state\x18\x04 \x01(\x0e2#.memos.api.v1.WebhookDelivery.StateB\x03\xe0A\x03R\x05state\x12(\n" +
+	"\rattempt_count\x18\x05 \x01(\x05B\x03\xe0A\x03R\fattemptCount\x12$\n" +
+	"\vstatus_code\x18\x06 \x01(\x05B\x03\xe0A\x03R\n" +
+	"statusCode\x12.\n" +
+	"\x10response_snippet\x18\a \x01(\tB\x03\xe0A\x03R\x0fresponseSnippet\x12@\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12P\n" +
+	"\x11last_attempt_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03H\x00R\x0flastAttemptTime\x88\x01\x01\x12P\n" +
+	"\x11next_attempt_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03H\x01R\x0fnextAttemptTime\x88\x01\x01\"F\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\r\n" +
+	"\tSUCCEEDED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03B\x14\n" +
+	"\x12_last_attempt_timeB\x14\n" +
+	"\x12_next_attempt_time\"\x81\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1b\n" +
+	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x02R\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x86\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x12=\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1d.memos.api.v1.WebhookDeliveryR\n" +
+	"deliveries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"2\n" +
+	"\x17RedeliverWebhookRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xb3\x12\n" +
 	"\x10UserNotification\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x121\n" +
 	"\x06sender\x18\x02 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
@@ -3356,7 +6148,13 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"createTime\x12<\n" +
 	"\x04type\x18\x05 \x01(\x0e2#.memos.api.v1.UserNotification.TypeB\x03\xe0A\x03R\x04type\x12[\n" +
 	"\fmemo_comment\x18\x06 \x01(\v21.memos.api.v1.UserNotification.MemoCommentPayloadB\x03\xe0A\x03H\x00R\vmemoComment\x12[\n" +
-	"\fmemo_mention\x18\a \x01(\v21.memos.api.v1.UserNotification.MemoMentionPayloadB\x03\xe0A\x03H\x00R\vmemoMention\x1a\xa0\x01\n" +
+	"\fmemo_mention\x18\a \x01(\v21.memos.api.v1.UserNotification.MemoMentionPayloadB\x03\xe0A\x03H\x00R\vmemoMention\x12j\n" +
+	"\x11memo_collaborator\x18\t \x01(\v26.memos.api.v1.UserNotification.MemoCollaboratorPayloadB\x03\xe0A\x03H\x00R\x10memoCollaborator\x12^\n" +
+	"\rmemo_reminder\x18\n" +
+	" \x01(\v22.memos.api.v1.UserNotification.MemoReminderPayloadB\x03\xe0A\x03H\x00R\fmemoReminder\x12^\n" +
+	"\rmemo_reaction\x18\v \x01(\v22.memos.api.v1.UserNotification.MemoReactionPayloadB\x03\xe0A\x03H\x00R\fmemoReaction\x12h\n" +
+	"\x11memo_thread_reply\x18\f \x01(\v25.memos.api.v1.UserNotification.MemoThreadReplyPayloadB\x03\xe0A\x03H\x00R\x0fmemoThreadReply\x12h\n" +
+	"\x11memo_share_opened\x18\r \x01(\v25.memos.api.v1.UserNotification.MemoShareOpenedPayloadB\x03\xe0A\x03H\x00R\x0fmemoShareOpened\x1a\xa0\x01\n" +
 	"\x12MemoCommentPayload\x12\x12\n" +
 	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
 	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\x12!\n" +
@@ -3366,16 +6164,42 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
 	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\x12!\n" +
 	"\fmemo_snippet\x18\x03 \x01(\tR\vmemoSnippet\x120\n" +
-	"\x14related_memo_snippet\x18\x04 \x01(\tR\x12relatedMemoSnippet\":\n" +
+	"\x14related_memo_snippet\x18\x04 \x01(\tR\x12relatedMemoSnippet\x1ad\n" +
+	"\x17MemoCollaboratorPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
+	"\fmemo_snippet\x18\x02 \x01(\tR\vmemoSnippet\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x1a\x83\x01\n" +
+	"\x13MemoReminderPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
+	"\fmemo_snippet\x18\x02 \x01(\tR\vmemoSnippet\x125\n" +
+	"\bdue_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\adueTime\x1aq\n" +
+	"\x13MemoReactionPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
+	"\fmemo_snippet\x18\x02 \x01(\tR\vmemoSnippet\x12#\n" +
+	"\rreaction_type\x18\x03 \x01(\tR\freactionType\x1a\xa4\x01\n" +
+	"\x16MemoThreadReplyPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
+	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\x12!\n" +
+	"\fmemo_snippet\x18\x03 \x01(\tR\vmemoSnippet\x120\n" +
+	"\x14related_memo_snippet\x18\x04 \x01(\tR\x12relatedMemoSnippet\x1ae\n" +
+	"\x16MemoShareOpenedPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
+	"\fmemo_snippet\x18\x02 \x01(\tR\vmemoSnippet\x12\x14\n" +
+	"\x05share\x18\x03 \x01(\tR\x05share\":\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06UNREAD\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02\"@\n" +
+	"\bARCHIVED\x10\x02\"\xab\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMEMO_COMMENT\x10\x01\x12\x10\n" +
-	"\fMEMO_MENTION\x10\x02:p\xeaAm\n" +
+	"\fMEMO_MENTION\x10\x02\x12\x15\n" +
+	"\x11MEMO_COLLABORATOR\x10\x03\x12\x11\n" +
+	"\rMEMO_REMINDER\x10\x04\x12\x11\n" +
+	"\rMEMO_REACTION\x10\x05\x12\x15\n" +
+	"\x11MEMO_THREAD_REPLY\x10\x06\x12\x15\n" +
+	"\x11MEMO_SHARE_OPENED\x10\a:p\xeaAm\n" +
 	"\x1dmemos.api.v1/UserNotification\x12)users/{user}/notifications/{notification}\x1a\x04name*\rnotifications2\fnotificationB\t\n" +
 	"\apayload\"\xb4\x01\n" +
 	"\x1cListUserNotificationsRequest\x121\n" +
@@ -3394,7 +6218,79 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"updateMask\"Z\n" +
 	"\x1dDeleteUserNotificationRequest\x129\n" +
 	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
-	"\x1dmemos.api.v1/UserNotificationR\x04name2\x82\x1d\n" +
+	"\x1dmemos.api.v1/UserNotificationR\x04name\"\xa2\x01\n" +
+	"\x1bSendTestNotificationRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12T\n" +
+	"\achannel\x18\x02 \x01(\v25.memos.api.v1.UserSetting.NotificationSetting.ChannelB\x03\xe0A\x01R\achannel\"\x84\x03\n" +
+	"\x14UserPushSubscription\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1f\n" +
+	"\bendpoint\x18\x02 \x01(\tB\x03\xe0A\x02R\bendpoint\x12%\n" +
+	"\n" +
+	"p256dh_key\x18\x03 \x01(\tB\x06\xe0A\x02\xe0A\x04R\tp256dhKey\x12!\n" +
+	"\bauth_key\x18\x04 \x01(\tB\x06\xe0A\x02\xe0A\x04R\aauthKey\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tB\x03\xe0A\x01R\vdescription\x12@\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime:\x7f\xeaA|\n" +
+	"!memos.api.v1/UserPushSubscription\x122users/{user}/pushSubscriptions/{push_subscription}*\x11pushSubscriptions2\x10pushSubscription\"U\n" +
+	" ListUserPushSubscriptionsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"\xa0\x01\n" +
+	"!ListUserPushSubscriptionsResponse\x12Q\n" +
+	"\x12push_subscriptions\x18\x01 \x03(\v2\".memos.api.v1.UserPushSubscriptionR\x11pushSubscriptions\x12(\n" +
+	"\x10vapid_public_key\x18\x02 \x01(\tR\x0evapidPublicKey\"\xac\x01\n" +
+	"!CreateUserPushSubscriptionRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12T\n" +
+	"\x11push_subscription\x18\x02 \x01(\v2\".memos.api.v1.UserPushSubscriptionB\x03\xe0A\x02R\x10pushSubscription\"b\n" +
+	"!DeleteUserPushSubscriptionRequest\x12=\n" +
+	"\x04name\x18\x01 \x01(\tB)\xe0A\x02\xfaA#\n" +
+	"!memos.api.v1/UserPushSubscriptionR\x04name\"\xb9\x02\n" +
+	"\tUserGroup\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tB\x03\xe0A\x01R\vdescription\x12&\n" +
+	"\fmember_count\x18\x03 \x01(\x05B\x03\xe0A\x03R\vmemberCount\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime:@\xeaA=\n" +
+	"\x16memos.api.v1/UserGroup\x12\x0egroups/{group}\x1a\x04name*\x06groups2\x05group\"\xf9\x01\n" +
+	"\x0fUserGroupMember\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12-\n" +
+	"\x04user\x18\x02 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04user\x12@\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime:Y\xeaAV\n" +
+	"\x1cmemos.api.v1/UserGroupMember\x12\x1fgroups/{group}/members/{member}\x1a\x04name*\amembers2\x06member\"\x17\n" +
+	"\x15ListUserGroupsRequest\"I\n" +
+	"\x16ListUserGroupsResponse\x12/\n" +
+	"\x06groups\x18\x01 \x03(\v2\x17.memos.api.v1.UserGroupR\x06groups\"I\n" +
+	"\x13GetUserGroupRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\n" +
+	"\x16memos.api.v1/UserGroupR\x04name\"l\n" +
+	"\x16CreateUserGroupRequest\x122\n" +
+	"\x05group\x18\x01 \x01(\v2\x17.memos.api.v1.UserGroupB\x03\xe0A\x02R\x05group\x12\x1e\n" +
+	"\bgroup_id\x18\x02 \x01(\tB\x03\xe0A\x02R\agroupId\"\x8e\x01\n" +
+	"\x16UpdateUserGroupRequest\x122\n" +
+	"\x05group\x18\x01 \x01(\v2\x17.memos.api.v1.UserGroupB\x03\xe0A\x02R\x05group\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"L\n" +
+	"\x16DeleteUserGroupRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\n" +
+	"\x16memos.api.v1/UserGroupR\x04name\"U\n" +
+	"\x1bListUserGroupMembersRequest\x126\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\n" +
+	"\x16memos.api.v1/UserGroupR\x06parent\"W\n" +
+	"\x1cListUserGroupMembersResponse\x127\n" +
+	"\amembers\x18\x01 \x03(\v2\x1d.memos.api.v1.UserGroupMemberR\amembers\"\x82\x01\n" +
+	"\x19AddUserGroupMemberRequest\x126\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\n" +
+	"\x16memos.api.v1/UserGroupR\x06parent\x12-\n" +
+	"\x04user\x18\x02 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04user\"X\n" +
+	"\x1cRemoveUserGroupMemberRequest\x128\n" +
+	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
+	"\x1cmemos.api.v1/UserGroupMemberR\x04name2\x902\n" +
 	"\vUserService\x12c\n" +
 	"\tListUsers\x12\x1e.memos.api.v1.ListUsersRequest\x1a\x1f.memos.api.v1.ListUsersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12{\n" +
 	"\rBatchGetUsers\x12\".memos.api.v1.BatchGetUsersRequest\x1a#.memos.api.v1.BatchGetUsersResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/users:batchGet\x12b\n" +
@@ -3404,7 +6300,9 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\n" +
 	"UpdateUser\x12\x1f.memos.api.v1.UpdateUserRequest\x1a\x12.memos.api.v1.User\"<\xdaA\x10user,update_mask\x82\xd3\xe4\x93\x02#:\x04user2\x1b/api/v1/{user.name=users/*}\x12l\n" +
 	"\n" +
-	"DeleteUser\x12\x1f.memos.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=users/*}\x12~\n" +
+	"DeleteUser\x12\x1f.memos.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=users/*}\x12v\n" +
+	"\n" +
+	"UnlockUser\x12\x1f.memos.api.v1.UnlockUserRequest\x1a\x16.google.protobuf.Empty\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/{name=users/*}:unlock\x12~\n" +
 	"\x10ListAllUserStats\x12%.memos.api.v1.ListAllUserStatsRequest\x1a&.memos.api.v1.ListAllUserStatsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/users:stats\x12z\n" +
 	"\fGetUserStats\x12!.memos.api.v1.GetUserStatsRequest\x1a\x17.memos.api.v1.UserStats\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=users/*}:getStats\x12\x82\x01\n" +
 	"\x0eGetUserSetting\x12#.memos.api.v1.GetUserSettingRequest\x1a\x19.memos.api.v1.UserSetting\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#\x12!/api/v1/{name=users/*/settings/*}\x12\xa8\x01\n" +
@@ -3416,14 +6314,31 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x14DeleteLinkedIdentity\x12).memos.api.v1.DeleteLinkedIdentityRequest\x1a\x16.google.protobuf.Empty\"8\xdaA\x04name\x82\xd3\xe4\x93\x02+*)/api/v1/{name=users/*/linkedIdentities/*}\x12\xb9\x01\n" +
 	"\x18ListPersonalAccessTokens\x12-.memos.api.v1.ListPersonalAccessTokensRequest\x1a..memos.api.v1.ListPersonalAccessTokensResponse\">\xdaA\x06parent\x82\xd3\xe4\x93\x02/\x12-/api/v1/{parent=users/*}/personalAccessTokens\x12\xb6\x01\n" +
 	"\x19CreatePersonalAccessToken\x12..memos.api.v1.CreatePersonalAccessTokenRequest\x1a/.memos.api.v1.CreatePersonalAccessTokenResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/api/v1/{parent=users/*}/personalAccessTokens\x12\xa1\x01\n" +
-	"\x19DeletePersonalAccessToken\x12..memos.api.v1.DeletePersonalAccessTokenRequest\x1a\x16.google.protobuf.Empty\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/*-/api/v1/{name=users/*/personalAccessTokens/*}\x12\x95\x01\n" +
+	"\x19DeletePersonalAccessToken\x12..memos.api.v1.DeletePersonalAccessTokenRequest\x1a\x16.google.protobuf.Empty\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/*-/api/v1/{name=users/*/personalAccessTokens/*}\x12\x89\x01\n" +
+	"\fListSessions\x12!.memos.api.v1.ListSessionsRequest\x1a\".memos.api.v1.ListSessionsResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/sessions\x12}\n" +
+	"\rRevokeSession\x12\".memos.api.v1.RevokeSessionRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/sessions/*}\x12\x94\x01\n" +
+	"\x11RevokeAllSessions\x12&.memos.api.v1.RevokeAllSessionsRequest\x1a\x16.google.protobuf.Empty\"?\xdaA\x06parent\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/{parent=users/*}/sessions:revokeAll\x12\x95\x01\n" +
 	"\x10ListUserWebhooks\x12%.memos.api.v1.ListUserWebhooksRequest\x1a&.memos.api.v1.ListUserWebhooksResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/webhooks\x12\x9b\x01\n" +
 	"\x11CreateUserWebhook\x12&.memos.api.v1.CreateUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"C\xdaA\x0eparent,webhook\x82\xd3\xe4\x93\x02,:\awebhook\"!/api/v1/{parent=users/*}/webhooks\x12\xa8\x01\n" +
 	"\x11UpdateUserWebhook\x12&.memos.api.v1.UpdateUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"P\xdaA\x13webhook,update_mask\x82\xd3\xe4\x93\x024:\awebhook2)/api/v1/{webhook.name=users/*/webhooks/*}\x12\x85\x01\n" +
-	"\x11DeleteUserWebhook\x12&.memos.api.v1.DeleteUserWebhookRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/webhooks/*}\x12\xa9\x01\n" +
+	"\x11DeleteUserWebhook\x12&.memos.api.v1.DeleteUserWebhookRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/webhooks/*}\x12\xb1\x01\n" +
+	"\x15ListWebhookDeliveries\x12*.memos.api.v1.ListWebhookDeliveriesRequest\x1a+.memos.api.v1.ListWebhookDeliveriesResponse\"?\xdaA\x06parent\x82\xd3\xe4\x93\x020\x12./api/v1/{parent=users/*/webhooks/*}/deliveries\x12\xa4\x01\n" +
+	"\x10RedeliverWebhook\x12%.memos.api.v1.RedeliverWebhookRequest\x1a\x1d.memos.api.v1.WebhookDelivery\"J\xdaA\x04name\x82\xd3\xe4\x93\x02=:\x01*\"8/api/v1/{name=users/*/webhooks/*/deliveries/*}:redeliver\x12\xa9\x01\n" +
 	"\x15ListUserNotifications\x12*.memos.api.v1.ListUserNotificationsRequest\x1a+.memos.api.v1.ListUserNotificationsResponse\"7\xdaA\x06parent\x82\xd3\xe4\x93\x02(\x12&/api/v1/{parent=users/*}/notifications\x12\xcb\x01\n" +
 	"\x16UpdateUserNotification\x12+.memos.api.v1.UpdateUserNotificationRequest\x1a\x1e.memos.api.v1.UserNotification\"d\xdaA\x18notification,update_mask\x82\xd3\xe4\x93\x02C:\fnotification23/api/v1/{notification.name=users/*/notifications/*}\x12\x94\x01\n" +
-	"\x16DeleteUserNotification\x12+.memos.api.v1.DeleteUserNotificationRequest\x1a\x16.google.protobuf.Empty\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(*&/api/v1/{name=users/*/notifications/*}B\xa8\x01\n" +
+	"\x16DeleteUserNotification\x12+.memos.api.v1.DeleteUserNotificationRequest\x1a\x16.google.protobuf.Empty\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(*&/api/v1/{name=users/*/notifications/*}\x12\x98\x01\n" +
+	"\x14SendTestNotification\x12).memos.api.v1.SendTestNotificationRequest\x1a\x16.google.protobuf.Empty\"=\xdaA\x04name\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/{name=users/*}:sendTestNotification\x12\xb9\x01\n" +
+	"\x19ListUserPushSubscriptions\x12..memos.api.v1.ListUserPushSubscriptionsRequest\x1a/.memos.api.v1.ListUserPushSubscriptionsResponse\";\xdaA\x06parent\x82\xd3\xe4\x93\x02,\x12*/api/v1/{parent=users/*}/pushSubscriptions\x12\xd3\x01\n" +
+	"\x1aCreateUserPushSubscription\x12/.memos.api.v1.CreateUserPushSubscriptionRequest\x1a\".memos.api.v1.UserPushSubscription\"`\xdaA\x18parent,push_subscription\x82\xd3\xe4\x93\x02?:\x11push_subscription\"*/api/v1/{parent=users/*}/pushSubscriptions\x12\xa0\x01\n" +
+	"\x1aDeleteUserPushSubscription\x12/.memos.api.v1.DeleteUserPushSubscriptionRequest\x1a\x16.google.protobuf.Empty\"9\xdaA\x04name\x82\xd3\xe4\x93\x02,**/api/v1/{name=users/*/pushSubscriptions/*}\x12s\n" +
+	"\x0eListUserGroups\x12#.memos.api.v1.ListUserGroupsRequest\x1a$.memos.api.v1.ListUserGroupsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/groups\x12r\n" +
+	"\fGetUserGroup\x12!.memos.api.v1.GetUserGroupRequest\x1a\x17.memos.api.v1.UserGroup\"&\xdaA\x04name\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/{name=groups/*}\x12\x80\x01\n" +
+	"\x0fCreateUserGroup\x12$.memos.api.v1.CreateUserGroupRequest\x1a\x17.memos.api.v1.UserGroup\".\xdaA\x0egroup,group_id\x82\xd3\xe4\x93\x02\x17:\x05group\"\x0e/api/v1/groups\x12\x92\x01\n" +
+	"\x0fUpdateUserGroup\x12$.memos.api.v1.UpdateUserGroupRequest\x1a\x17.memos.api.v1.UserGroup\"@\xdaA\x11group,update_mask\x82\xd3\xe4\x93\x02&:\x05group2\x1d/api/v1/{group.name=groups/*}\x12w\n" +
+	"\x0fDeleteUserGroup\x12$.memos.api.v1.DeleteUserGroupRequest\x1a\x16.google.protobuf.Empty\"&\xdaA\x04name\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/{name=groups/*}\x12\xa1\x01\n" +
+	"\x14ListUserGroupMembers\x12).memos.api.v1.ListUserGroupMembersRequest\x1a*.memos.api.v1.ListUserGroupMembersResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=groups/*}/members\x12\x98\x01\n" +
+	"\x12AddUserGroupMember\x12'.memos.api.v1.AddUserGroupMemberRequest\x1a\x1d.memos.api.v1.UserGroupMember\":\xdaA\vparent,user\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/{parent=groups/*}/members\x12\x8d\x01\n" +
+	"\x15RemoveUserGroupMember\x12*.memos.api.v1.RemoveUserGroupMemberRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=groups/*/members/*}B\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10UserServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -3438,164 +6353,277 @@ func file_api_v1_user_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_user_service_proto_rawDescData
 }
 
-var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
 var file_api_v1_user_service_proto_goTypes = []any{
-	(User_Role)(0),                              // 0: memos.api.v1.User.Role
-	(UserSetting_Key)(0),                        // 1: memos.api.v1.UserSetting.Key
-	(UserNotification_Status)(0),                // 2: memos.api.v1.UserNotification.Status
-	(UserNotification_Type)(0),                  // 3: memos.api.v1.UserNotification.Type
-	(*User)(nil),                                // 4: memos.api.v1.User
-	(*ListUsersRequest)(nil),                    // 5: memos.api.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),                   // 6: memos.api.v1.ListUsersResponse
-	(*BatchGetUsersRequest)(nil),                // 7: memos.api.v1.BatchGetUsersRequest
-	(*BatchGetUsersResponse)(nil),               // 8: memos.api.v1.BatchGetUsersResponse
-	(*GetUserRequest)(nil),                      // 9: memos.api.v1.GetUserRequest
-	(*CreateUserRequest)(nil),                   // 10: memos.api.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),                   // 11: memos.api.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),                   // 12: memos.api.v1.DeleteUserRequest
-	(*UserStats)(nil),                           // 13: memos.api.v1.UserStats
-	(*GetUserStatsRequest)(nil),                 // 14: memos.api.v1.GetUserStatsRequest
-	(*ListAllUserStatsRequest)(nil),             // 15: memos.api.v1.ListAllUserStatsRequest
-	(*ListAllUserStatsResponse)(nil),            // 16: memos.api.v1.ListAllUserStatsResponse
-	(*UserSetting)(nil),                         // 17: memos.api.v1.UserSetting
-	(*GetUserSettingRequest)(nil),               // 18: memos.api.v1.GetUserSettingRequest
-	(*UpdateUserSettingRequest)(nil),            // 19: memos.api.v1.UpdateUserSettingRequest
-	(*ListUserSettingsRequest)(nil),             // 20: memos.api.v1.ListUserSettingsRequest
-	(*ListUserSettingsResponse)(nil),            // 21: memos.api.v1.ListUserSettingsResponse
-	(*LinkedIdentity)(nil),                      // 22: memos.api.v1.LinkedIdentity
-	(*ListLinkedIdentitiesRequest)(nil),         // 23: memos.api.v1.ListLinkedIdentitiesRequest
-	(*ListLinkedIdentitiesResponse)(nil),        // 24: memos.api.v1.ListLinkedIdentitiesResponse
-	(*CreateLinkedIdentityRequest)(nil),         // 25: memos.api.v1.CreateLinkedIdentityRequest
-	(*GetLinkedIdentityRequest)(nil),            // 26: memos.api.v1.GetLinkedIdentityRequest
-	(*DeleteLinkedIdentityRequest)(nil),         // 27: memos.api.v1.DeleteLinkedIdentityRequest
-	(*PersonalAccessToken)(nil),                 // 28: memos.api.v1.PersonalAccessToken
-	(*ListPersonalAccessTokensRequest)(nil),     // 29: memos.api.v1.ListPersonalAccessTokensRequest
-	(*ListPersonalAccessTokensResponse)(nil),    // 30: memos.api.v1.ListPersonalAccessTokensResponse
-	(*CreatePersonalAccessTokenRequest)(nil),    // 31: memos.api.v1.CreatePersonalAccessTokenRequest
-	(*CreatePersonalAccessTokenResponse)(nil),   // 32: memos.api.v1.CreatePersonalAccessTokenResponse
-	(*DeletePersonalAccessTokenRequest)(nil),    // 33: memos.api.v1.DeletePersonalAccessTokenRequest
-	(*UserWebhook)(nil),                         // 34: memos.api.v1.UserWebhook
-	(*ListUserWebhooksRequest)(nil),             // 35: memos.api.v1.ListUserWebhooksRequest
-	(*ListUserWebhooksResponse)(nil),            // 36: memos.api.v1.ListUserWebhooksResponse
-	(*CreateUserWebhookRequest)(nil),            // 37: memos.api.v1.CreateUserWebhookRequest
-	(*UpdateUserWebhookRequest)(nil),            // 38: memos.api.v1.UpdateUserWebhookRequest
-	(*DeleteUserWebhookRequest)(nil),            // 39: memos.api.v1.DeleteUserWebhookRequest
-	(*UserNotification)(nil),                    // 40: memos.api.v1.UserNotification
-	(*ListUserNotificationsRequest)(nil),        // 41: memos.api.v1.ListUserNotificationsRequest
-	(*ListUserNotificationsResponse)(nil),       // 42: memos.api.v1.ListUserNotificationsResponse
-	(*UpdateUserNotificationRequest)(nil),       // 43: memos.api.v1.UpdateUserNotificationRequest
-	(*DeleteUserNotificationRequest)(nil),       // 44: memos.api.v1.DeleteUserNotificationRequest
-	(*UserStats_MemoTypeStats)(nil),             // 45: memos.api.v1.UserStats.MemoTypeStats
-	nil,                                         // 46: memos.api.v1.UserStats.TagCountEntry
-	(*UserSetting_GeneralSetting)(nil),          // 47: memos.api.v1.UserSetting.GeneralSetting
-	(*UserSetting_WebhooksSetting)(nil),         // 48: memos.api.v1.UserSetting.WebhooksSetting
-	(*UserNotification_MemoCommentPayload)(nil), // 49: memos.api.v1.UserNotification.MemoCommentPayload
-	(*UserNotification_MemoMentionPayload)(nil), // 50: memos.api.v1.UserNotification.MemoMentionPayload
-	(State)(0),                    // 51: memos.api.v1.State
-	(*timestamppb.Timestamp)(nil), // 52: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil), // 53: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),         // 54: google.protobuf.Empty
+	(User_Role)(0),       // 0: memos.api.v1.User.Role
+	(UserSetting_Key)(0), // 1: memos.api.v1.UserSetting.Key
+	(UserSetting_NotificationSetting_EmailDelivery)(0), // 2: memos.api.v1.UserSetting.NotificationSetting.EmailDelivery
+	(UserSetting_NotificationSetting_Channel_Type)(0),  // 3: memos.api.v1.UserSetting.NotificationSetting.Channel.Type
+	(UserWebhook_Format)(0),                            // 4: memos.api.v1.UserWebhook.Format
+	(WebhookDelivery_State)(0),                         // 5: memos.api.v1.WebhookDelivery.State
+	(UserNotification_Status)(0),                       // 6: memos.api.v1.UserNotification.Status
+	(UserNotification_Type)(0),                         // 7: memos.api.v1.UserNotification.Type
+	(*User)(nil),                                       // 8: memos.api.v1.User
+	(*ListUsersRequest)(nil),                           // 9: memos.api.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                          // 10: memos.api.v1.ListUsersResponse
+	(*BatchGetUsersRequest)(nil),                       // 11: memos.api.v1.BatchGetUsersRequest
+	(*BatchGetUsersResponse)(nil),                      // 12: memos.api.v1.BatchGetUsersResponse
+	(*GetUserRequest)(nil),                             // 13: memos.api.v1.GetUserRequest
+	(*CreateUserRequest)(nil),                          // 14: memos.api.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),                          // 15: memos.api.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                          // 16: memos.api.v1.DeleteUserRequest
+	(*UnlockUserRequest)(nil),                          // 17: memos.api.v1.UnlockUserRequest
+	(*UserStats)(nil),                                  // 18: memos.api.v1.UserStats
+	(*GetUserStatsRequest)(nil),                        // 19: memos.api.v1.GetUserStatsRequest
+	(*ListAllUserStatsRequest)(nil),                    // 20: memos.api.v1.ListAllUserStatsRequest
+	(*ListAllUserStatsResponse)(nil),                   // 21: memos.api.v1.ListAllUserStatsResponse
+	(*UserSetting)(nil),                                // 22: memos.api.v1.UserSetting
+	(*GetUserSettingRequest)(nil),                      // 23: memos.api.v1.GetUserSettingRequest
+	(*UpdateUserSettingRequest)(nil),                   // 24: memos.api.v1.UpdateUserSettingRequest
+	(*ListUserSettingsRequest)(nil),                    // 25: memos.api.v1.ListUserSettingsRequest
+	(*ListUserSettingsResponse)(nil),                   // 26: memos.api.v1.ListUserSettingsResponse
+	(*LinkedIdentity)(nil),                             // 27: memos.api.v1.LinkedIdentity
+	(*ListLinkedIdentitiesRequest)(nil),                // 28: memos.api.v1.ListLinkedIdentitiesRequest
+	(*ListLinkedIdentitiesResponse)(nil),               // 29: memos.api.v1.ListLinkedIdentitiesResponse
+	(*CreateLinkedIdentityRequest)(nil),                // 30: memos.api.v1.CreateLinkedIdentityRequest
+	(*GetLinkedIdentityRequest)(nil),                   // 31: memos.api.v1.GetLinkedIdentityRequest
+	(*DeleteLinkedIdentityRequest)(nil),                // 32: memos.api.v1.DeleteLinkedIdentityRequest
+	(*PersonalAccessToken)(nil),                        // 33: memos.api.v1.PersonalAccessToken
+	(*ListPersonalAccessTokensRequest)(nil),            // 34: memos.api.v1.ListPersonalAccessTokensRequest
+	(*ListPersonalAccessTokensResponse)(nil),           // 35: memos.api.v1.ListPersonalAccessTokensResponse
+	(*CreatePersonalAccessTokenRequest)(nil),           // 36: memos.api.v1.CreatePersonalAccessTokenRequest
+	(*CreatePersonalAccessTokenResponse)(nil),          // 37: memos.api.v1.CreatePersonalAccessTokenResponse
+	(*DeletePersonalAccessTokenRequest)(nil),           // 38: memos.api.v1.DeletePersonalAccessTokenRequest
+	(*Session)(nil),                                    // 39: memos.api.v1.Session
+	(*ListSessionsRequest)(nil),                        // 40: memos.api.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),                       // 41: memos.api.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),                       // 42: memos.api.v1.RevokeSessionRequest
+	(*RevokeAllSessionsRequest)(nil),                   // 43: memos.api.v1.RevokeAllSessionsRequest
+	(*UserWebhook)(nil),                                // 44: memos.api.v1.UserWebhook
+	(*ListUserWebhooksRequest)(nil),                    // 45: memos.api.v1.ListUserWebhooksRequest
+	(*ListUserWebhooksResponse)(nil),                   // 46: memos.api.v1.ListUserWebhooksResponse
+	(*CreateUserWebhookRequest)(nil),                   // 47: memos.api.v1.CreateUserWebhookRequest
+	(*UpdateUserWebhookRequest)(nil),                   // 48: memos.api.v1.UpdateUserWebhookRequest
+	(*DeleteUserWebhookRequest)(nil),                   // 49: memos.api.v1.DeleteUserWebhookRequest
+	(*WebhookDelivery)(nil),                            // 50: memos.api.v1.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),               // 51: memos.api.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),              // 52: memos.api.v1.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),                    // 53: memos.api.v1.RedeliverWebhookRequest
+	(*UserNotification)(nil),                           // 54: memos.api.v1.UserNotification
+	(*ListUserNotificationsRequest)(nil),               // 55: memos.api.v1.ListUserNotificationsRequest
+	(*ListUserNotificationsResponse)(nil),              // 56: memos.api.v1.ListUserNotificationsResponse
+	(*UpdateUserNotificationRequest)(nil),              // 57: memos.api.v1.UpdateUserNotificationRequest
+	(*DeleteUserNotificationRequest)(nil),              // 58: memos.api.v1.DeleteUserNotificationRequest
+	(*SendTestNotificationRequest)(nil),                // 59: memos.api.v1.SendTestNotificationRequest
+	(*UserPushSubscription)(nil),                       // 60: memos.api.v1.UserPushSubscription
+	(*ListUserPushSubscriptionsRequest)(nil),           // 61: memos.api.v1.ListUserPushSubscriptionsRequest
+	(*ListUserPushSubscriptionsResponse)(nil),          // 62: memos.api.v1.ListUserPushSubscriptionsResponse
+	(*CreateUserPushSubscriptionRequest)(nil),          // 63: memos.api.v1.CreateUserPushSubscriptionRequest
+	(*DeleteUserPushSubscriptionRequest)(nil),          // 64: memos.api.v1.DeleteUserPushSubscriptionRequest
+	(*UserGroup)(nil),                                  // 65: memos.api.v1.UserGroup
+	(*UserGroupMember)(nil),                            // 66: memos.api.v1.UserGroupMember
+	(*ListUserGroupsRequest)(nil),                      // 67: memos.api.v1.ListUserGroupsRequest
+	(*ListUserGroupsResponse)(nil),                     // 68: memos.api.v1.ListUserGroupsResponse
+	(*GetUserGroupRequest)(nil),                        // 69: memos.api.v1.GetUserGroupRequest
+	(*CreateUserGroupRequest)(nil),                     // 70: memos.api.v1.CreateUserGroupRequest
+	(*UpdateUserGroupRequest)(nil),                     // 71: memos.api.v1.UpdateUserGroupRequest
+	(*DeleteUserGroupRequest)(nil),                     // 72: memos.api.v1.DeleteUserGroupRequest
+	(*ListUserGroupMembersRequest)(nil),                // 73: memos.api.v1.ListUserGroupMembersRequest
+	(*ListUserGroupMembersResponse)(nil),               // 74: memos.api.v1.ListUserGroupMembersResponse
+	(*AddUserGroupMemberRequest)(nil),                  // 75: memos.api.v1.AddUserGroupMemberRequest
+	(*RemoveUserGroupMemberRequest)(nil),               // 76: memos.api.v1.RemoveUserGroupMemberRequest
+	(*UserStats_MemoTypeStats)(nil),                    // 77: memos.api.v1.UserStats.MemoTypeStats
+	nil,                                                // 78: memos.api.v1.UserStats.TagCountEntry
+	(*UserSetting_GeneralSetting)(nil),                 // 79: memos.api.v1.UserSetting.GeneralSetting
+	(*UserSetting_WebhooksSetting)(nil),                // 80: memos.api.v1.UserSetting.WebhooksSetting
+	(*UserSetting_NotificationSetting)(nil),            // 81: memos.api.v1.UserSetting.NotificationSetting
+	(*UserSetting_NotificationSetting_Preference)(nil), // 82: memos.api.v1.UserSetting.NotificationSetting.Preference
+	(*UserSetting_NotificationSetting_Channel)(nil),    // 83: memos.api.v1.UserSetting.NotificationSetting.Channel
+	(*Session_ClientInfo)(nil),                         // 84: memos.api.v1.Session.ClientInfo
+	(*UserNotification_MemoCommentPayload)(nil),        // 85: memos.api.v1.UserNotification.MemoCommentPayload
+	(*UserNotification_MemoMentionPayload)(nil),        // 86: memos.api.v1.UserNotification.MemoMentionPayload
+	(*UserNotification_MemoCollaboratorPayload)(nil),   // 87: memos.api.v1.UserNotification.MemoCollaboratorPayload
+	(*UserNotification_MemoReminderPayload)(nil),       // 88: memos.api.v1.UserNotification.MemoReminderPayload
+	(*UserNotification_MemoReactionPayload)(nil),       // 89: memos.api.v1.UserNotification.MemoReactionPayload
+	(*UserNotification_MemoThreadReplyPayload)(nil),    // 90: memos.api.v1.UserNotification.MemoThreadReplyPayload
+	(*UserNotification_MemoShareOpenedPayload)(nil),    // 91: memos.api.v1.UserNotification.MemoShareOpenedPayload
+	(State)(0),                    // 92: memos.api.v1.State
+	(*timestamppb.Timestamp)(nil), // 93: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 94: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 95: google.protobuf.Empty
 }
 var file_api_v1_user_service_proto_depIdxs = []int32{
-	0,  // 0: memos.api.v1.User.role:type_name -> memos.api.v1.User.Role
-	51, // 1: memos.api.v1.User.state:type_name -> memos.api.v1.State
-	52, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
-	52, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
-	4,  // 4: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
-	4,  // 5: memos.api.v1.BatchGetUsersResponse.users:type_name -> memos.api.v1.User
-	53, // 6: memos.api.v1.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
-	4,  // 7: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
-	4,  // 8: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
-	53, // 9: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	45, // 10: memos.api.v1.UserStats.memo_type_stats:type_name -> memos.api.v1.UserStats.MemoTypeStats
-	46, // 11: memos.api.v1.UserStats.tag_count:type_name -> memos.api.v1.UserStats.TagCountEntry
-	52, // 12: memos.api.v1.UserStats.memo_created_timestamps:type_name -> google.protobuf.Timestamp
-	52, // 13: memos.api.v1.UserStats.memo_updated_timestamps:type_name -> google.protobuf.Timestamp
-	51, // 14: memos.api.v1.ListAllUserStatsRequest.state:type_name -> memos.api.v1.State
-	13, // 15: memos.api.v1.ListAllUserStatsResponse.stats:type_name -> memos.api.v1.UserStats
-	47, // 16: memos.api.v1.UserSetting.general_setting:type_name -> memos.api.v1.UserSetting.GeneralSetting
-	48, // 17: memos.api.v1.UserSetting.webhooks_setting:type_name -> memos.api.v1.UserSetting.WebhooksSetting
-	17, // 18: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
-	53, // 19: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	17, // 20: memos.api.v1.ListUserSettingsResponse.settings:type_name -> memos.api.v1.UserSetting
-	22, // 21: memos.api.v1.ListLinkedIdentitiesResponse.linked_identities:type_name -> memos.api.v1.LinkedIdentity
-	52, // 22: memos.api.v1.PersonalAccessToken.created_at:type_name -> google.protobuf.Timestamp
-	52, // 23: memos.api.v1.PersonalAccessToken.expires_at:type_name -> google.protobuf.Timestamp
-	52, // 24: memos.api.v1.PersonalAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
-	28, // 25: memos.api.v1.ListPersonalAccessTokensResponse.personal_access_tokens:type_name -> memos.api.v1.PersonalAccessToken
-	28, // 26: memos.api.v1.CreatePersonalAccessTokenResponse.personal_access_token:type_name -> memos.api.v1.PersonalAccessToken
-	52, // 27: memos.api.v1.UserWebhook.create_time:type_name -> google.protobuf.Timestamp
-	52, // 28: memos.api.v1.UserWebhook.update_time:type_name -> google.protobuf.Timestamp
-	34, // 29: memos.api.v1.ListUserWebhooksResponse.webhooks:type_name -> memos.api.v1.UserWebhook
-	34, // 30: memos.api.v1.CreateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
-	34, // 31: memos.api.v1.UpdateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
-	53, // 32: memos.api.v1.UpdateUserWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
-	4,  // 33: memos.api.v1.UserNotification.sender_user:type_name -> memos.api.v1.User
-	2,  // 34: memos.api.v1.UserNotification.status:type_name -> memos.api.v1.UserNotification.Status
-	52, // 35: memos.api.v1.UserNotification.create_time:type_name -> google.protobuf.Timestamp
-	3,  // 36: memos.api.v1.UserNotification.type:type_name -> memos.api.v1.UserNotification.Type
-	49, // 37: memos.api.v1.UserNotification.memo_comment:type_name -> memos.api.v1.UserNotification.MemoCommentPayload
-	50, // 38: memos.api.v1.UserNotification.memo_mention:type_name -> memos.api.v1.UserNotification.MemoMentionPayload
-	40, // 39: memos.api.v1.ListUserNotificationsResponse.notifications:type_name -> memos.api.v1.UserNotification
-	40, // 40: memos.api.v1.UpdateUserNotificationRequest.notification:type_name -> memos.api.v1.UserNotification
-	53, // 41: memos.api.v1.UpdateUserNotificationRequest.update_mask:type_name -> google.protobuf.FieldMask
-	34, // 42: memos.api.v1.UserSetting.WebhooksSetting.webhooks:type_name -> memos.api.v1.UserWebhook
-	5,  // 43: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
-	7,  // 44: memos.api.v1.UserService.BatchGetUsers:input_type -> memos.api.v1.BatchGetUsersRequest
-	9,  // 45: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
-	10, // 46: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
-	11, // 47: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
-	12, // 48: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
-	15, // 49: memos.api.v1.UserService.ListAllUserStats:input_type -> memos.api.v1.ListAllUserStatsRequest
-	14, // 50: memos.api.v1.UserService.GetUserStats:input_type -> memos.api.v1.GetUserStatsRequest
-	18, // 51: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
-	19, // 52: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
-	20, // 53: memos.api.v1.UserService.ListUserSettings:input_type -> memos.api.v1.ListUserSettingsRequest
-	23, // 54: memos.api.v1.UserService.ListLinkedIdentities:input_type -> memos.api.v1.ListLinkedIdentitiesRequest
-	25, // 55: memos.api.v1.UserService.CreateLinkedIdentity:input_type -> memos.api.v1.CreateLinkedIdentityRequest
-	26, // 56: memos.api.v1.UserService.GetLinkedIdentity:input_type -> memos.api.v1.GetLinkedIdentityRequest
-	27, // 57: memos.api.v1.UserService.DeleteLinkedIdentity:input_type -> memos.api.v1.DeleteLinkedIdentityRequest
-	29, // 58: memos.api.v1.UserService.ListPersonalAccessTokens:input_type -> memos.api.v1.ListPersonalAccessTokensRequest
-	31, // 59: memos.api.v1.UserService.CreatePersonalAccessToken:input_type -> memos.api.v1.CreatePersonalAccessTokenRequest
-	33, // 60: memos.api.v1.UserService.DeletePersonalAccessToken:input_type -> memos.api.v1.DeletePersonalAccessTokenRequest
-	35, // 61: memos.api.v1.UserService.ListUserWebhooks:input_type -> memos.api.v1.ListUserWebhooksRequest
-	37, // 62: memos.api.v1.UserService.CreateUserWebhook:input_type -> memos.api.v1.CreateUserWebhookRequest
-	38, // 63: memos.api.v1.UserService.UpdateUserWebhook:input_type -> memos.api.v1.UpdateUserWebhookRequest
-	39, // 64: memos.api.v1.UserService.DeleteUserWebhook:input_type -> memos.api.v1.DeleteUserWebhookRequest
-	41, // 65: memos.api.v1.UserService.ListUserNotifications:input_type -> memos.api.v1.ListUserNotificationsRequest
-	43, // 66: memos.api.v1.UserService.UpdateUserNotification:input_type -> memos.api.v1.UpdateUserNotificationRequest
-	44, // 67: memos.api.v1.UserService.DeleteUserNotification:input_type -> memos.api.v1.DeleteUserNotificationRequest
-	6,  // 68: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
-	8,  // 69: memos.api.v1.UserService.BatchGetUsers:output_type -> memos.api.v1.BatchGetUsersResponse
-	4,  // 70: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
-	4,  // 71: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
-	4,  // 72: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
-	54, // 73: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	16, // 74: memos.api.v1.UserService.ListAllUserStats:output_type -> memos.api.v1.ListAllUserStatsResponse
-	13, // 75: memos.api.v1.UserService.GetUserStats:output_type -> memos.api.v1.UserStats
-	17, // 76: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
-	17, // 77: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
-	21, // 78: memos.api.v1.UserService.ListUserSettings:output_type -> memos.api.v1.ListUserSettingsResponse
-	24, // 79: memos.api.v1.UserService.ListLinkedIdentities:output_type -> memos.api.v1.ListLinkedIdentitiesResponse
-	22, // 80: memos.api.v1.UserService.CreateLinkedIdentity:output_type -> memos.api.v1.LinkedIdentity
-	22, // 81: memos.api.v1.UserService.GetLinkedIdentity:output_type -> memos.api.v1.LinkedIdentity
-	54, // 82: memos.api.v1.UserService.DeleteLinkedIdentity:output_type -> google.protobuf.Empty
-	30, // 83: memos.api.v1.UserService.ListPersonalAccessTokens:output_type -> memos.api.v1.ListPersonalAccessTokensResponse
-	32, // 84: memos.api.v1.UserService.CreatePersonalAccessToken:output_type -> memos.api.v1.CreatePersonalAccessTokenResponse
-	54, // 85: memos.api.v1.UserService.DeletePersonalAccessToken:output_type -> google.protobuf.Empty
-	36, // 86: memos.api.v1.UserService.ListUserWebhooks:output_type -> memos.api.v1.ListUserWebhooksResponse
-	34, // 87: memos.api.v1.UserService.CreateUserWebhook:output_type -> memos.api.v1.UserWebhook
-	34, // 88: memos.api.v1.UserService.UpdateUserWebhook:output_type -> memos.api.v1.UserWebhook
-	54, // 89: memos.api.v1.UserService.DeleteUserWebhook:output_type -> google.protobuf.Empty
-	42, // 90: memos.api.v1.UserService.ListUserNotifications:output_type -> memos.api.v1.ListUserNotificationsResponse
-	40, // 91: memos.api.v1.UserService.UpdateUserNotification:output_type -> memos.api.v1.UserNotification
-	54, // 92: memos.api.v1.UserService.DeleteUserNotification:output_type -> google.protobuf.Empty
-	68, // [68:93] is the sub-list for method output_type
-	43, // [43:68] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	0,   // 0: memos.api.v1.User.role:type_name -> memos.api.v1.User.Role
+	92,  // 1: memos.api.v1.User.state:type_name -> memos.api.v1.State
+	93,  // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
+	93,  // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
+	8,   // 4: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
+	8,   // 5: memos.api.v1.BatchGetUsersResponse.users:type_name -> memos.api.v1.User
+	94,  // 6: memos.api.v1.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
+	8,   // 7: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
+	8,   // 8: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
+	94,  // 9: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	77,  // 10: memos.api.v1.UserStats.memo_type_stats:type_name -> memos.api.v1.UserStats.MemoTypeStats
+	78,  // 11: memos.api.v1.UserStats.tag_count:type_name -> memos.api.v1.UserStats.TagCountEntry
+	93,  // 12: memos.api.v1.UserStats.memo_created_timestamps:type_name -> google.protobuf.Timestamp
+	93,  // 13: memos.api.v1.UserStats.memo_updated_timestamps:type_name -> google.protobuf.Timestamp
+	92,  // 14: memos.api.v1.ListAllUserStatsRequest.state:type_name -> memos.api.v1.State
+	18,  // 15: memos.api.v1.ListAllUserStatsResponse.stats:type_name -> memos.api.v1.UserStats
+	79,  // 16: memos.api.v1.UserSetting.general_setting:type_name -> memos.api.v1.UserSetting.GeneralSetting
+	80,  // 17: memos.api.v1.UserSetting.webhooks_setting:type_name -> memos.api.v1.UserSetting.WebhooksSetting
+	81,  // 18: memos.api.v1.UserSetting.notification_setting:type_name -> memos.api.v1.UserSetting.NotificationSetting
+	22,  // 19: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
+	94,  // 20: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	22,  // 21: memos.api.v1.ListUserSettingsResponse.settings:type_name -> memos.api.v1.UserSetting
+	27,  // 22: memos.api.v1.ListLinkedIdentitiesResponse.linked_identities:type_name -> memos.api.v1.LinkedIdentity
+	93,  // 23: memos.api.v1.PersonalAccessToken.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 24: memos.api.v1.PersonalAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	93,  // 25: memos.api.v1.PersonalAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
+	33,  // 26: memos.api.v1.ListPersonalAccessTokensResponse.personal_access_tokens:type_name -> memos.api.v1.PersonalAccessToken
+	33,  // 27: memos.api.v1.CreatePersonalAccessTokenResponse.personal_access_token:type_name -> memos.api.v1.PersonalAccessToken
+	93,  // 28: memos.api.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 29: memos.api.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	93,  // 30: memos.api.v1.Session.last_seen_at:type_name -> google.protobuf.Timestamp
+	84,  // 31: memos.api.v1.Session.client_info:type_name -> memos.api.v1.Session.ClientInfo
+	39,  // 32: memos.api.v1.ListSessionsResponse.sessions:type_name -> memos.api.v1.Session
+	93,  // 33: memos.api.v1.UserWebhook.create_time:type_name -> google.protobuf.Timestamp
+	93,  // 34: memos.api.v1.UserWebhook.update_time:type_name -> google.protobuf.Timestamp
+	4,   // 35: memos.api.v1.UserWebhook.format:type_name -> memos.api.v1.UserWebhook.Format
+	44,  // 36: memos.api.v1.ListUserWebhooksResponse.webhooks:type_name -> memos.api.v1.UserWebhook
+	44,  // 37: memos.api.v1.CreateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
+	44,  // 38: memos.api.v1.UpdateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
+	94,  // 39: memos.api.v1.UpdateUserWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,   // 40: memos.api.v1.WebhookDelivery.state:type_name -> memos.api.v1.WebhookDelivery.State
+	93,  // 41: memos.api.v1.WebhookDelivery.create_time:type_name -> google.protobuf.Timestamp
+	93,  // 42: memos.api.v1.WebhookDelivery.last_attempt_time:type_name -> google.protobuf.Timestamp
+	93,  // 43: memos.api.v1.WebhookDelivery.next_attempt_time:type_name -> google.protobuf.Timestamp
+	50,  // 44: memos.api.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> memos.api.v1.WebhookDelivery
+	8,   // 45: memos.api.v1.UserNotification.sender_user:type_name -> memos.api.v1.User
+	6,   // 46: memos.api.v1.UserNotification.status:type_name -> memos.api.v1.UserNotification.Status
+	93,  // 47: memos.api.v1.UserNotification.create_time:type_name -> google.protobuf.Timestamp
+	7,   // 48: memos.api.v1.UserNotification.type:type_name -> memos.api.v1.UserNotification.Type
+	85,  // 49: memos.api.v1.UserNotification.memo_comment:type_name -> memos.api.v1.UserNotification.MemoCommentPayload
+	86,  // 50: memos.api.v1.UserNotification.memo_mention:type_name -> memos.api.v1.UserNotification.MemoMentionPayload
+	87,  // 51: memos.api.v1.UserNotification.memo_collaborator:type_name -> memos.api.v1.UserNotification.MemoCollaboratorPayload
+	88,  // 52: memos.api.v1.UserNotification.memo_reminder:type_name -> memos.api.v1.UserNotification.MemoReminderPayload
+	89,  // 53: memos.api.v1.UserNotification.memo_reaction:type_name -> memos.api.v1.UserNotification.MemoReactionPayload
+	90,  // 54: memos.api.v1.UserNotification.memo_thread_reply:type_name -> memos.api.v1.UserNotification.MemoThreadReplyPayload
+	91,  // 55: memos.api.v1.UserNotification.memo_share_opened:type_name -> memos.api.v1.UserNotification.MemoShareOpenedPayload
+	54,  // 56: memos.api.v1.ListUserNotificationsResponse.notifications:type_name -> memos.api.v1.UserNotification
+	54,  // 57: memos.api.v1.UpdateUserNotificationRequest.notification:type_name -> memos.api.v1.UserNotification
+	94,  // 58: memos.api.v1.UpdateUserNotificationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	83,  // 59: memos.api.v1.SendTestNotificationRequest.channel:type_name -> memos.api.v1.UserSetting.NotificationSetting.Channel
+	93,  // 60: memos.api.v1.UserPushSubscription.create_time:type_name -> google.protobuf.Timestamp
+	60,  // 61: memos.api.v1.ListUserPushSubscriptionsResponse.push_subscriptions:type_name -> memos.api.v1.UserPushSubscription
+	60,  // 62: memos.api.v1.CreateUserPushSubscriptionRequest.push_subscription:type_name -> memos.api.v1.UserPushSubscription
+	93,  // 63: memos.api.v1.UserGroup.create_time:type_name -> google.protobuf.Timestamp
+	93,  // 64: memos.api.v1.UserGroup.update_time:type_name -> google.protobuf.Timestamp
+	93,  // 65: memos.api.v1.UserGroupMember.create_time:type_name -> google.protobuf.Timestamp
+	65,  // 66: memos.api.v1.ListUserGroupsResponse.groups:type_name -> memos.api.v1.UserGroup
+	65,  // 67: memos.api.v1.CreateUserGroupRequest.group:type_name -> memos.api.v1.UserGroup
+	65,  // 68: memos.api.v1.UpdateUserGroupRequest.group:type_name -> memos.api.v1.UserGroup
+	94,  // 69: memos.api.v1.UpdateUserGroupRequest.update_mask:type_name -> google.protobuf.FieldMask
+	66,  // 70: memos.api.v1.ListUserGroupMembersResponse.members:type_name -> memos.api.v1.UserGroupMember
+	7,   // 71: memos.api.v1.UserSetting.GeneralSetting.muted_notification_types:type_name -> memos.api.v1.UserNotification.Type
+	44,  // 72: memos.api.v1.UserSetting.WebhooksSetting.webhooks:type_name -> memos.api.v1.UserWebhook
+	82,  // 73: memos.api.v1.UserSetting.NotificationSetting.preferences:type_name -> memos.api.v1.UserSetting.NotificationSetting.Preference
+	83,  // 74: memos.api.v1.UserSetting.NotificationSetting.channels:type_name -> memos.api.v1.UserSetting.NotificationSetting.Channel
+	7,   // 75: memos.api.v1.UserSetting.NotificationSetting.Preference.type:type_name -> memos.api.v1.UserNotification.Type
+	2,   // 76: memos.api.v1.UserSetting.NotificationSetting.Preference.email_delivery:type_name -> memos.api.v1.UserSetting.NotificationSetting.EmailDelivery
+	3,   // 77: memos.api.v1.UserSetting.NotificationSetting.Channel.type:type_name -> memos.api.v1.UserSetting.NotificationSetting.Channel.Type
+	93,  // 78: memos.api.v1.UserNotification.MemoReminderPayload.due_time:type_name -> google.protobuf.Timestamp
+	9,   // 79: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
+	11,  // 80: memos.api.v1.UserService.BatchGetUsers:input_type -> memos.api.v1.BatchGetUsersRequest
+	13,  // 81: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
+	14,  // 82: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
+	15,  // 83: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
+	16,  // 84: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
+	17,  // 85: memos.api.v1.UserService.UnlockUser:input_type -> memos.api.v1.UnlockUserRequest
+	20,  // 86: memos.api.v1.UserService.ListAllUserStats:input_type -> memos.api.v1.ListAllUserStatsRequest
+	19,  // 87: memos.api.v1.UserService.GetUserStats:input_type -> memos.api.v1.GetUserStatsRequest
+	23,  // 88: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
+	24,  // 89: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
+	25,  // 90: memos.api.v1.UserService.ListUserSettings:input_type -> memos.api.v1.ListUserSettingsRequest
+	28,  // 91: memos.api.v1.UserService.ListLinkedIdentities:input_type -> memos.api.v1.ListLinkedIdentitiesRequest
+	30,  // 92: memos.api.v1.UserService.CreateLinkedIdentity:input_type -> memos.api.v1.CreateLinkedIdentityRequest
+	31,  // 93: memos.api.v1.UserService.GetLinkedIdentity:input_type -> memos.api.v1.GetLinkedIdentityRequest
+	32,  // 94: memos.api.v1.UserService.DeleteLinkedIdentity:input_type -> memos.api.v1.DeleteLinkedIdentityRequest
+	34,  // 95: memos.api.v1.UserService.ListPersonalAccessTokens:input_type -> memos.api.v1.ListPersonalAccessTokensRequest
+	36,  // 96: memos.api.v1.UserService.CreatePersonalAccessToken:input_type -> memos.api.v1.CreatePersonalAccessTokenRequest
+	38,  // 97: memos.api.v1.UserService.DeletePersonalAccessToken:input_type -> memos.api.v1.DeletePersonalAccessTokenRequest
+	40,  // 98: memos.api.v1.UserService.ListSessions:input_type -> memos.api.v1.ListSessionsRequest
+	42,  // 99: memos.api.v1.UserService.RevokeSession:input_type -> memos.api.v1.RevokeSessionRequest
+	43,  // 100: memos.api.v1.UserService.RevokeAllSessions:input_type -> memos.api.v1.RevokeAllSessionsRequest
+	45,  // 101: memos.api.v1.UserService.ListUserWebhooks:input_type -> memos.api.v1.ListUserWebhooksRequest
+	47,  // 102: memos.api.v1.UserService.CreateUserWebhook:input_type -> memos.api.v1.CreateUserWebhookRequest
+	48,  // 103: memos.api.v1.UserService.UpdateUserWebhook:input_type -> memos.api.v1.UpdateUserWebhookRequest
+	49,  // 104: memos.api.v1.UserService.DeleteUserWebhook:input_type -> memos.api.v1.DeleteUserWebhookRequest
+	51,  // 105: memos.api.v1.UserService.ListWebhookDeliveries:input_type -> memos.api.v1.ListWebhookDeliveriesRequest
+	53,  // 106: memos.api.v1.UserService.RedeliverWebhook:input_type -> memos.api.v1.RedeliverWebhookRequest
+	55,  // 107: memos.api.v1.UserService.ListUserNotifications:input_type -> memos.api.v1.ListUserNotificationsRequest
+	57,  // 108: memos.api.v1.UserService.UpdateUserNotification:input_type -> memos.api.v1.UpdateUserNotificationRequest
+	58,  // 109: memos.api.v1.UserService.DeleteUserNotification:input_type -> memos.api.v1.DeleteUserNotificationRequest
+	59,  // 110: memos.api.v1.UserService.SendTestNotification:input_type -> memos.api.v1.SendTestNotificationRequest
+	61,  // 111: memos.api.v1.UserService.ListUserPushSubscriptions:input_type -> memos.api.v1.ListUserPushSubscriptionsRequest
+	63,  // 112: memos.api.v1.UserService.CreateUserPushSubscription:input_type -> memos.api.v1.CreateUserPushSubscriptionRequest
+	64,  // 113: memos.api.v1.UserService.DeleteUserPushSubscription:input_type -> memos.api.v1.DeleteUserPushSubscriptionRequest
+	67,  // 114: memos.api.v1.UserService.ListUserGroups:input_type -> memos.api.v1.ListUserGroupsRequest
+	69,  // 115: memos.api.v1.UserService.GetUserGroup:input_type -> memos.api.v1.GetUserGroupRequest
+	70,  // 116: memos.api.v1.UserService.CreateUserGroup:input_type -> memos.api.v1.CreateUserGroupRequest
+	71,  // 117: memos.api.v1.UserService.UpdateUserGroup:input_type -> memos.api.v1.UpdateUserGroupRequest
+	72,  // 118: memos.api.v1.UserService.DeleteUserGroup:input_type -> memos.api.v1.DeleteUserGroupRequest
+	73,  // 119: memos.api.v1.UserService.ListUserGroupMembers:input_type -> memos.api.v1.ListUserGroupMembersRequest
+	75,  // 120: memos.api.v1.UserService.AddUserGroupMember:input_type -> memos.api.v1.AddUserGroupMemberRequest
+	76,  // 121: memos.api.v1.UserService.RemoveUserGroupMember:input_type -> memos.api.v1.RemoveUserGroupMemberRequest
+	10,  // 122: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
+	12,  // 123: memos.api.v1.UserService.BatchGetUsers:output_type -> memos.api.v1.BatchGetUsersResponse
+	8,   // 124: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
+	8,   // 125: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
+	8,   // 126: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
+	95,  // 127: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	95,  // 128: memos.api.v1.UserService.UnlockUser:output_type -> google.protobuf.Empty
+	21,  // 129: memos.api.v1.UserService.ListAllUserStats:output_type -> memos.api.v1.ListAllUserStatsResponse
+	18,  // 130: memos.api.v1.UserService.GetUserStats:output_type -> memos.api.v1.UserStats
+	22,  // 131: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
+	22,  // 132: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
+	26,  // 133: memos.api.v1.UserService.ListUserSettings:output_type -> memos.api.v1.ListUserSettingsResponse
+	29,  // 134: memos.api.v1.UserService.ListLinkedIdentities:output_type -> memos.api.v1.ListLinkedIdentitiesResponse
+	27,  // 135: memos.api.v1.UserService.CreateLinkedIdentity:output_type -> memos.api.v1.LinkedIdentity
+	27,  // 136: memos.api.v1.UserService.GetLinkedIdentity:output_type -> memos.api.v1.LinkedIdentity
+	95,  // 137: memos.api.v1.UserService.DeleteLinkedIdentity:output_type -> google.protobuf.Empty
+	35,  // 138: memos.api.v1.UserService.ListPersonalAccessTokens:output_type -> memos.api.v1.ListPersonalAccessTokensResponse
+	37,  // 139: memos.api.v1.UserService.CreatePersonalAccessToken:output_type -> memos.api.v1.CreatePersonalAccessTokenResponse
+	95,  // 140: memos.api.v1.UserService.DeletePersonalAccessToken:output_type -> google.protobuf.Empty
+	41,  // 141: memos.api.v1.UserService.ListSessions:output_type -> memos.api.v1.ListSessionsResponse
+	95,  // 142: memos.api.v1.UserService.RevokeSession:output_type -> google.protobuf.Empty
+	95,  // 143: memos.api.v1.UserService.RevokeAllSessions:output_type -> google.protobuf.Empty
+	46,  // 144: memos.api.v1.UserService.ListUserWebhooks:output_type -> memos.api.v1.ListUserWebhooksResponse
+	44,  // 145: memos.api.v1.UserService.CreateUserWebhook:output_type -> memos.api.v1.UserWebhook
+	44,  // 146: memos.api.v1.UserService.UpdateUserWebhook:output_type -> memos.api.v1.UserWebhook
+	95,  // 147: memos.api.v1.UserService.DeleteUserWebhook:output_type -> google.protobuf.Empty
+	52,  // 148: memos.api.v1.UserService.ListWebhookDeliveries:output_type -> memos.api.v1.ListWebhookDeliveriesResponse
+	50,  // 149: memos.api.v1.UserService.RedeliverWebhook:output_type -> memos.api.v1.WebhookDelivery
+	56,  // 150: memos.api.v1.UserService.ListUserNotifications:output_type -> memos.api.v1.ListUserNotificationsResponse
+	54,  // 151: memos.api.v1.UserService.UpdateUserNotification:output_type -> memos.api.v1.UserNotification
+	95,  // 152: memos.api.v1.UserService.DeleteUserNotification:output_type -> google.protobuf.Empty
+	95,  // 153: memos.api.v1.UserService.SendTestNotification:output_type -> google.protobuf.Empty
+	62,  // 154: memos.api.v1.UserService.ListUserPushSubscriptions:output_type -> memos.api.v1.ListUserPushSubscriptionsResponse
+	60,  // 155: memos.api.v1.UserService.CreateUserPushSubscription:output_type -> memos.api.v1.UserPushSubscription
+	95,  // 156: memos.api.v1.UserService.DeleteUserPushSubscription:output_type -> google.protobuf.Empty
+	68,  // 157: memos.api.v1.UserService.ListUserGroups:output_type -> memos.api.v1.ListUserGroupsResponse
+	65,  // 158: memos.api.v1.UserService.GetUserGroup:output_type -> memos.api.v1.UserGroup
+	65,  // 159: memos.api.v1.UserService.CreateUserGroup:output_type -> memos.api.v1.UserGroup
+	65,  // 160: memos.api.v1.UserService.UpdateUserGroup:output_type -> memos.api.v1.UserGroup
+	95,  // 161: memos.api.v1.UserService.DeleteUserGroup:output_type -> google.protobuf.Empty
+	74,  // 162: memos.api.v1.UserService.ListUserGroupMembers:output_type -> memos.api.v1.ListUserGroupMembersResponse
+	66,  // 163: memos.api.v1.UserService.AddUserGroupMember:output_type -> memos.api.v1.UserGroupMember
+	95,  // 164: memos.api.v1.UserService.RemoveUserGroupMember:output_type -> google.protobuf.Empty
+	122, // [122:165] is the sub-list for method output_type
+	79,  // [79:122] is the sub-list for method input_type
+	79,  // [79:79] is the sub-list for extension type_name
+	79,  // [79:79] is the sub-list for extension extendee
+	0,   // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_user_service_proto_init() }
@@ -3604,21 +6632,28 @@ func file_api_v1_user_service_proto_init() {
 		return
 	}
 	file_api_v1_common_proto_init()
-	file_api_v1_user_service_proto_msgTypes[13].OneofWrappers = []any{
+	file_api_v1_user_service_proto_msgTypes[14].OneofWrappers = []any{
 		(*UserSetting_GeneralSetting_)(nil),
 		(*UserSetting_WebhooksSetting_)(nil),
+		(*UserSetting_NotificationSetting_)(nil),
 	}
-	file_api_v1_user_service_proto_msgTypes[36].OneofWrappers = []any{
+	file_api_v1_user_service_proto_msgTypes[42].OneofWrappers = []any{}
+	file_api_v1_user_service_proto_msgTypes[46].OneofWrappers = []any{
 		(*UserNotification_MemoComment)(nil),
 		(*UserNotification_MemoMention)(nil),
+		(*UserNotification_MemoCollaborator)(nil),
+		(*UserNotification_MemoReminder)(nil),
+		(*UserNotification_MemoReaction)(nil),
+		(*UserNotification_MemoThreadReply)(nil),
+		(*UserNotification_MemoShareOpened)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_user_service_proto_rawDesc), len(file_api_v1_user_service_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   47,
+			NumEnums:      8,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
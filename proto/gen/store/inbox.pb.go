@@ -29,6 +29,16 @@ const (
 	InboxMessage_MEMO_COMMENT InboxMessage_Type = 1
 	// Memo mention notification.
 	InboxMessage_MEMO_MENTION InboxMessage_Type = 2
+	// Notification that the receiver was added as a memo collaborator.
+	InboxMessage_MEMO_COLLABORATOR InboxMessage_Type = 3
+	// Reminder that a memo is due.
+	InboxMessage_MEMO_REMINDER InboxMessage_Type = 4
+	// Reaction on a memo of the receiver.
+	InboxMessage_MEMO_REACTION InboxMessage_Type = 5
+	// Comment on a memo the receiver commented on.
+	InboxMessage_MEMO_THREAD_REPLY InboxMessage_Type = 6
+	// A share link created by the receiver was opened.
+	InboxMessage_MEMO_SHARE_OPENED InboxMessage_Type = 7
 )
 
 // Enum value maps for InboxMessage_Type.
@@ -37,11 +47,21 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
 		2: "MEMO_MENTION",
+		3: "MEMO_COLLABORATOR",
+		4: "MEMO_REMINDER",
+		5: "MEMO_REACTION",
+		6: "MEMO_THREAD_REPLY",
+		7: "MEMO_SHARE_OPENED",
 	}
 	InboxMessage_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"MEMO_COMMENT":     1,
-		"MEMO_MENTION":     2,
+		"TYPE_UNSPECIFIED":  0,
+		"MEMO_COMMENT":      1,
+		"MEMO_MENTION":      2,
+		"MEMO_COLLABORATOR": 3,
+		"MEMO_REMINDER":     4,
+		"MEMO_REACTION":     5,
+		"MEMO_THREAD_REPLY": 6,
+		"MEMO_SHARE_OPENED": 7,
 	}
 )
 
@@ -80,6 +100,11 @@ type InboxMessage struct {
 	//
 	//	*InboxMessage_MemoComment
 	//	*InboxMessage_MemoMention
+	//	*InboxMessage_MemoCollaborator
+	//	*InboxMessage_MemoReminder
+	//	*InboxMessage_MemoReaction
+	//	*InboxMessage_MemoThreadReply
+	//	*InboxMessage_MemoShareOpened
 	Payload       isInboxMessage_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -147,6 +172,51 @@ func (x *InboxMessage) GetMemoMention() *InboxMessage_MemoMentionPayload {
 	return nil
 }
 
+func (x *InboxMessage) GetMemoCollaborator() *InboxMessage_MemoCollaboratorPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*InboxMessage_MemoCollaborator); ok {
+			return x.MemoCollaborator
+		}
+	}
+	return nil
+}
+
+func (x *InboxMessage) GetMemoReminder() *InboxMessage_MemoReminderPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*InboxMessage_MemoReminder); ok {
+			return x.MemoReminder
+		}
+	}
+	return nil
+}
+
+func (x *InboxMessage) GetMemoReaction() *InboxMessage_MemoReactionPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*InboxMessage_MemoReaction); ok {
+			return x.MemoReaction
+		}
+	}
+	return nil
+}
+
+func (x *InboxMessage) GetMemoThreadReply() *InboxMessage_MemoThreadReplyPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*InboxMessage_MemoThreadReply); ok {
+			return x.MemoThreadReply
+		}
+	}
+	return nil
+}
+
+func (x *InboxMessage) GetMemoShareOpened() *InboxMessage_MemoShareOpenedPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*InboxMessage_MemoShareOpened); ok {
+			return x.MemoShareOpened
+		}
+	}
+	return nil
+}
+
 type isInboxMessage_Payload interface {
 	isInboxMessage_Payload()
 }
@@ -159,10 +229,40 @@ type InboxMessage_MemoMention struct {
 	MemoMention *InboxMessage_MemoMentionPayload `protobuf:"bytes,3,opt,name=memo_mention,json=memoMention,proto3,oneof"`
 }
 
+type InboxMessage_MemoCollaborator struct {
+	MemoCollaborator *InboxMessage_MemoCollaboratorPayload `protobuf:"bytes,4,opt,name=memo_collaborator,json=memoCollaborator,proto3,oneof"`
+}
+
+type InboxMessage_MemoReminder struct {
+	MemoReminder *InboxMessage_MemoReminderPayload `protobuf:"bytes,5,opt,name=memo_reminder,json=memoReminder,proto3,oneof"`
+}
+
+type InboxMessage_MemoReaction struct {
+	MemoReaction *InboxMessage_MemoReactionPayload `protobuf:"bytes,6,opt,name=memo_reaction,json=memoReaction,proto3,oneof"`
+}
+
+type InboxMessage_MemoThreadReply struct {
+	MemoThreadReply *InboxMessage_MemoThreadReplyPayload `protobuf:"bytes,7,opt,name=memo_thread_reply,json=memoThreadReply,proto3,oneof"`
+}
+
+type InboxMessage_MemoShareOpened struct {
+	MemoShareOpened *InboxMessage_MemoShareOpenedPayload `protobuf:"bytes,8,opt,name=memo_share_opened,json=memoShareOpened,proto3,oneof"`
+}
+
 func (*InboxMessage_MemoComment) isInboxMessage_Payload() {}
 
 func (*InboxMessage_MemoMention) isInboxMessage_Payload() {}
 
+func (*InboxMessage_MemoCollaborator) isInboxMessage_Payload() {}
+
+func (*InboxMessage_MemoReminder) isInboxMessage_Payload() {}
+
+func (*InboxMessage_MemoReaction) isInboxMessage_Payload() {}
+
+func (*InboxMessage_MemoThreadReply) isInboxMessage_Payload() {}
+
+func (*InboxMessage_MemoShareOpened) isInboxMessage_Payload() {}
+
 type InboxMessage_MemoCommentPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MemoId        int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
@@ -267,25 +367,306 @@ func (x *InboxMessage_MemoMentionPayload) GetRelatedMemoId() int32 {
 	return 0
 }
 
+type InboxMessage_MemoCollaboratorPayload struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	MemoId int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	// The granted collaborator role, VIEWER or EDITOR.
+	Role          string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxMessage_MemoCollaboratorPayload) Reset() {
+	*x = InboxMessage_MemoCollaboratorPayload{}
+	mi := &file_store_inbox_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxMessage_MemoCollaboratorPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxMessage_MemoCollaboratorPayload) ProtoMessage() {}
+
+func (x *InboxMessage_MemoCollaboratorPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_inbox_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxMessage_MemoCollaboratorPayload.ProtoReflect.Descriptor instead.
+func (*InboxMessage_MemoCollaboratorPayload) Descriptor() ([]byte, []int) {
+	return file_store_inbox_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *InboxMessage_MemoCollaboratorPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *InboxMessage_MemoCollaboratorPayload) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type InboxMessage_MemoReminderPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoId        int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxMessage_MemoReminderPayload) Reset() {
+	*x = InboxMessage_MemoReminderPayload{}
+	mi := &file_store_inbox_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxMessage_MemoReminderPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxMessage_MemoReminderPayload) ProtoMessage() {}
+
+func (x *InboxMessage_MemoReminderPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_inbox_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxMessage_MemoReminderPayload.ProtoReflect.Descriptor instead.
+func (*InboxMessage_MemoReminderPayload) Descriptor() ([]byte, []int) {
+	return file_store_inbox_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *InboxMessage_MemoReminderPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+type InboxMessage_MemoReactionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memo reacted to, owned by the receiver.
+	MemoId        int32  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	ReactionType  string `protobuf:"bytes,2,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxMessage_MemoReactionPayload) Reset() {
+	*x = InboxMessage_MemoReactionPayload{}
+	mi := &file_store_inbox_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxMessage_MemoReactionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxMessage_MemoReactionPayload) ProtoMessage() {}
+
+func (x *InboxMessage_MemoReactionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_inbox_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxMessage_MemoReactionPayload.ProtoReflect.Descriptor instead.
+func (*InboxMessage_MemoReactionPayload) Descriptor() ([]byte, []int) {
+	return file_store_inbox_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *InboxMessage_MemoReactionPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *InboxMessage_MemoReactionPayload) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
+type InboxMessage_MemoThreadReplyPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new comment.
+	MemoId int32 `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	// The memo whose comment thread the receiver took part in.
+	RelatedMemoId int32 `protobuf:"varint,2,opt,name=related_memo_id,json=relatedMemoId,proto3" json:"related_memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxMessage_MemoThreadReplyPayload) Reset() {
+	*x = InboxMessage_MemoThreadReplyPayload{}
+	mi := &file_store_inbox_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxMessage_MemoThreadReplyPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxMessage_MemoThreadReplyPayload) ProtoMessage() {}
+
+func (x *InboxMessage_MemoThreadReplyPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_inbox_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxMessage_MemoThreadReplyPayload.ProtoReflect.Descriptor instead.
+func (*InboxMessage_MemoThreadReplyPayload) Descriptor() ([]byte, []int) {
+	return file_store_inbox_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *InboxMessage_MemoThreadReplyPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *InboxMessage_MemoThreadReplyPayload) GetRelatedMemoId() int32 {
+	if x != nil {
+		return x.RelatedMemoId
+	}
+	return 0
+}
+
+type InboxMessage_MemoShareOpenedPayload struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	MemoId int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	// The ID of the share link created by the receiver.
+	ShareId       int32 `protobuf:"varint,3,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxMessage_MemoShareOpenedPayload) Reset() {
+	*x = InboxMessage_MemoShareOpenedPayload{}
+	mi := &file_store_inbox_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxMessage_MemoShareOpenedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxMessage_MemoShareOpenedPayload) ProtoMessage() {}
+
+func (x *InboxMessage_MemoShareOpenedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_inbox_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxMessage_MemoShareOpenedPayload.ProtoReflect.Descriptor instead.
+func (*InboxMessage_MemoShareOpenedPayload) Descriptor() ([]byte, []int) {
+	return file_store_inbox_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *InboxMessage_MemoShareOpenedPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *InboxMessage_MemoShareOpenedPayload) GetShareId() int32 {
+	if x != nil {
+		return x.ShareId
+	}
+	return 0
+}
+
 var File_store_inbox_proto protoreflect.FileDescriptor
 
 const file_store_inbox_proto_rawDesc = "" +
 	"\n" +
-	"\x11store/inbox.proto\x12\vmemos.store\"\xe3\x03\n" +
+	"\x11store/inbox.proto\x12\vmemos.store\"\xa4\v\n" +
 	"\fInboxMessage\x122\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12Q\n" +
 	"\fmemo_comment\x18\x02 \x01(\v2,.memos.store.InboxMessage.MemoCommentPayloadH\x00R\vmemoComment\x12Q\n" +
-	"\fmemo_mention\x18\x03 \x01(\v2,.memos.store.InboxMessage.MemoMentionPayloadH\x00R\vmemoMention\x1aU\n" +
+	"\fmemo_mention\x18\x03 \x01(\v2,.memos.store.InboxMessage.MemoMentionPayloadH\x00R\vmemoMention\x12`\n" +
+	"\x11memo_collaborator\x18\x04 \x01(\v21.memos.store.InboxMessage.MemoCollaboratorPayloadH\x00R\x10memoCollaborator\x12T\n" +
+	"\rmemo_reminder\x18\x05 \x01(\v2-.memos.store.InboxMessage.MemoReminderPayloadH\x00R\fmemoReminder\x12T\n" +
+	"\rmemo_reaction\x18\x06 \x01(\v2-.memos.store.InboxMessage.MemoReactionPayloadH\x00R\fmemoReaction\x12^\n" +
+	"\x11memo_thread_reply\x18\a \x01(\v20.memos.store.InboxMessage.MemoThreadReplyPayloadH\x00R\x0fmemoThreadReply\x12^\n" +
+	"\x11memo_share_opened\x18\b \x01(\v20.memos.store.InboxMessage.MemoShareOpenedPayloadH\x00R\x0fmemoShareOpened\x1aU\n" +
 	"\x12MemoCommentPayload\x12\x17\n" +
 	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12&\n" +
 	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\x1aU\n" +
 	"\x12MemoMentionPayload\x12\x17\n" +
 	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12&\n" +
-	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"@\n" +
+	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\x1aF\n" +
+	"\x17MemoCollaboratorPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x1a.\n" +
+	"\x13MemoReminderPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x1aS\n" +
+	"\x13MemoReactionPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12#\n" +
+	"\rreaction_type\x18\x02 \x01(\tR\freactionType\x1aY\n" +
+	"\x16MemoThreadReplyPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12&\n" +
+	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\x1a]\n" +
+	"\x16MemoShareOpenedPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12\x19\n" +
+	"\bshare_id\x18\x03 \x01(\x05R\ashareIdJ\x04\b\x02\x10\x03R\tshare_uid\"\xab\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMEMO_COMMENT\x10\x01\x12\x10\n" +
-	"\fMEMO_MENTION\x10\x02B\t\n" +
+	"\fMEMO_MENTION\x10\x02\x12\x15\n" +
+	"\x11MEMO_COLLABORATOR\x10\x03\x12\x11\n" +
+	"\rMEMO_REMINDER\x10\x04\x12\x11\n" +
+	"\rMEMO_REACTION\x10\x05\x12\x15\n" +
+	"\x11MEMO_THREAD_REPLY\x10\x06\x12\x15\n" +
+	"\x11MEMO_SHARE_OPENED\x10\aB\t\n" +
 	"\apayloadB\x95\x01\n" +
 	"\x0fcom.memos.storeB\n" +
 	"InboxProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
@@ -303,22 +684,32 @@ func file_store_inbox_proto_rawDescGZIP() []byte {
 }
 
 var file_store_inbox_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_inbox_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_store_inbox_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_store_inbox_proto_goTypes = []any{
-	(InboxMessage_Type)(0),                  // 0: memos.store.InboxMessage.Type
-	(*InboxMessage)(nil),                    // 1: memos.store.InboxMessage
-	(*InboxMessage_MemoCommentPayload)(nil), // 2: memos.store.InboxMessage.MemoCommentPayload
-	(*InboxMessage_MemoMentionPayload)(nil), // 3: memos.store.InboxMessage.MemoMentionPayload
+	(InboxMessage_Type)(0),                       // 0: memos.store.InboxMessage.Type
+	(*InboxMessage)(nil),                         // 1: memos.store.InboxMessage
+	(*InboxMessage_MemoCommentPayload)(nil),      // 2: memos.store.InboxMessage.MemoCommentPayload
+	(*InboxMessage_MemoMentionPayload)(nil),      // 3: memos.store.InboxMessage.MemoMentionPayload
+	(*InboxMessage_MemoCollaboratorPayload)(nil), // 4: memos.store.InboxMessage.MemoCollaboratorPayload
+	(*InboxMessage_MemoReminderPayload)(nil),     // 5: memos.store.InboxMessage.MemoReminderPayload
+	(*InboxMessage_MemoReactionPayload)(nil),     // 6: memos.store.InboxMessage.MemoReactionPayload
+	(*InboxMessage_MemoThreadReplyPayload)(nil),  // 7: memos.store.InboxMessage.MemoThreadReplyPayload
+	(*InboxMessage_MemoShareOpenedPayload)(nil),  // 8: memos.store.InboxMessage.MemoShareOpenedPayload
 }
 var file_store_inbox_proto_depIdxs = []int32{
 	0, // 0: memos.store.InboxMessage.type:type_name -> memos.store.InboxMessage.Type
 	2, // 1: memos.store.InboxMessage.memo_comment:type_name -> memos.store.InboxMessage.MemoCommentPayload
 	3, // 2: memos.store.InboxMessage.memo_mention:type_name -> memos.store.InboxMessage.MemoMentionPayload
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: memos.store.InboxMessage.memo_collaborator:type_name -> memos.store.InboxMessage.MemoCollaboratorPayload
+	5, // 4: memos.store.InboxMessage.memo_reminder:type_name -> memos.store.InboxMessage.MemoReminderPayload
+	6, // 5: memos.store.InboxMessage.memo_reaction:type_name -> memos.store.InboxMessage.MemoReactionPayload
+	7, // 6: memos.store.InboxMessage.memo_thread_reply:type_name -> memos.store.InboxMessage.MemoThreadReplyPayload
+	8, // 7: memos.store.InboxMessage.memo_share_opened:type_name -> memos.store.InboxMessage.MemoShareOpenedPayload
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_store_inbox_proto_init() }
@@ -329,6 +720,11 @@ func file_store_inbox_proto_init() {
 	file_store_inbox_proto_msgTypes[0].OneofWrappers = []any{
 		(*InboxMessage_MemoComment)(nil),
 		(*InboxMessage_MemoMention)(nil),
+		(*InboxMessage_MemoCollaborator)(nil),
+		(*InboxMessage_MemoReminder)(nil),
+		(*InboxMessage_MemoReaction)(nil),
+		(*InboxMessage_MemoThreadReply)(nil),
+		(*InboxMessage_MemoShareOpened)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -336,7 +732,7 @@ func file_store_inbox_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_inbox_proto_rawDesc), len(file_store_inbox_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -40,6 +40,10 @@ const (
 	InstanceSettingKey_NOTIFICATION InstanceSettingKey = 6
 	// AI is the key for AI provider settings.
 	InstanceSettingKey_AI InstanceSettingKey = 7
+	// WEBHOOKS is the key for instance-wide webhooks.
+	InstanceSettingKey_WEBHOOKS InstanceSettingKey = 8
+	// WEB_PUSH is the key for the Web Push VAPID keys. It is managed by the server.
+	InstanceSettingKey_WEB_PUSH InstanceSettingKey = 9
 )
 
 // Enum value maps for InstanceSettingKey.
@@ -53,6 +57,8 @@ var (
 		5: "TAGS",
 		6: "NOTIFICATION",
 		7: "AI",
+		8: "WEBHOOKS",
+		9: "WEB_PUSH",
 	}
 	InstanceSettingKey_value = map[string]int32{
 		"INSTANCE_SETTING_KEY_UNSPECIFIED": 0,
@@ -63,6 +69,8 @@ var (
 		"TAGS":                             5,
 		"NOTIFICATION":                     6,
 		"AI":                               7,
+		"WEBHOOKS":                         8,
+		"WEB_PUSH":                         9,
 	}
 )
 
@@ -194,7 +202,7 @@ func (x InstanceStorageSetting_StorageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InstanceStorageSetting_StorageType.Descriptor instead.
 func (InstanceStorageSetting_StorageType) EnumDescriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{4, 0}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type InstanceSetting struct {
@@ -209,6 +217,8 @@ type InstanceSetting struct {
 	//	*InstanceSetting_TagsSetting
 	//	*InstanceSetting_NotificationSetting
 	//	*InstanceSetting_AiSetting
+	//	*InstanceSetting_WebhooksSetting
+	//	*InstanceSetting_WebPushSetting
 	Value         isInstanceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -321,6 +331,24 @@ func (x *InstanceSetting) GetAiSetting() *InstanceAISetting {
 	return nil
 }
 
+func (x *InstanceSetting) GetWebhooksSetting() *InstanceWebhooksSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_WebhooksSetting); ok {
+			return x.WebhooksSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetWebPushSetting() *InstanceWebPushSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_WebPushSetting); ok {
+			return x.WebPushSetting
+		}
+	}
+	return nil
+}
+
 type isInstanceSetting_Value interface {
 	isInstanceSetting_Value()
 }
@@ -353,6 +381,14 @@ type InstanceSetting_AiSetting struct {
 	AiSetting *InstanceAISetting `protobuf:"bytes,8,opt,name=ai_setting,json=aiSetting,proto3,oneof"`
 }
 
+type InstanceSetting_WebhooksSetting struct {
+	WebhooksSetting *InstanceWebhooksSetting `protobuf:"bytes,9,opt,name=webhooks_setting,json=webhooksSetting,proto3,oneof"`
+}
+
+type InstanceSetting_WebPushSetting struct {
+	WebPushSetting *InstanceWebPushSetting `protobuf:"bytes,10,opt,name=web_push_setting,json=webPushSetting,proto3,oneof"`
+}
+
 func (*InstanceSetting_BasicSetting) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_GeneralSetting) isInstanceSetting_Value() {}
@@ -367,6 +403,10 @@ func (*InstanceSetting_NotificationSetting) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_AiSetting) isInstanceSetting_Value() {}
 
+func (*InstanceSetting_WebhooksSetting) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_WebPushSetting) isInstanceSetting_Value() {}
+
 type InstanceBasicSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The secret key for instance. Mainly used for session management.
@@ -441,8 +481,13 @@ type InstanceGeneralSetting struct {
 	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
 	// disallow_change_nickname disallows changing nickname.
 	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// sign_in_protection configures brute-force protection for password sign-in.
+	SignInProtection *InstanceSignInProtectionSetting `protobuf:"bytes,10,opt,name=sign_in_protection,json=signInProtection,proto3" json:"sign_in_protection,omitempty"`
+	// require_email_verification requires users to verify their email before
+	// they can sign in with a password or receive notification emails.
+	RequireEmailVerification bool `protobuf:"varint,11,opt,name=require_email_verification,json=requireEmailVerification,proto3" json:"require_email_verification,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *InstanceGeneralSetting) Reset() {
@@ -531,6 +576,20 @@ func (x *InstanceGeneralSetting) GetDisallowChangeNickname() bool {
 	return false
 }
 
+func (x *InstanceGeneralSetting) GetSignInProtection() *InstanceSignInProtectionSetting {
+	if x != nil {
+		return x.SignInProtection
+	}
+	return nil
+}
+
+func (x *InstanceGeneralSetting) GetRequireEmailVerification() bool {
+	if x != nil {
+		return x.RequireEmailVerification
+	}
+	return false
+}
+
 type InstanceCustomProfile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -591,6 +650,91 @@ func (x *InstanceCustomProfile) GetLogoUrl() string {
 	return ""
 }
 
+type InstanceSignInProtectionSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// disabled turns off sign-in throttling and account lockout.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// max_failures_per_username is the number of consecutive failures for a username
+	// before it is temporarily locked. Default is 5.
+	MaxFailuresPerUsername int32 `protobuf:"varint,2,opt,name=max_failures_per_username,json=maxFailuresPerUsername,proto3" json:"max_failures_per_username,omitempty"`
+	// max_failures_per_ip is the number of consecutive failures from a client IP
+	// before it is temporarily blocked. Default is 20.
+	MaxFailuresPerIp int32 `protobuf:"varint,3,opt,name=max_failures_per_ip,json=maxFailuresPerIp,proto3" json:"max_failures_per_ip,omitempty"`
+	// lockout_seconds is the first lockout duration; it doubles with each further failure.
+	// Default is 60.
+	LockoutSeconds int32 `protobuf:"varint,4,opt,name=lockout_seconds,json=lockoutSeconds,proto3" json:"lockout_seconds,omitempty"`
+	// max_lockout_seconds caps the lockout duration and is the window after which
+	// failures are forgotten. Default is 3600.
+	MaxLockoutSeconds int32 `protobuf:"varint,5,opt,name=max_lockout_seconds,json=maxLockoutSeconds,proto3" json:"max_lockout_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InstanceSignInProtectionSetting) Reset() {
+	*x = InstanceSignInProtectionSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSignInProtectionSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSignInProtectionSetting) ProtoMessage() {}
+
+func (x *InstanceSignInProtectionSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSignInProtectionSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSignInProtectionSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InstanceSignInProtectionSetting) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *InstanceSignInProtectionSetting) GetMaxFailuresPerUsername() int32 {
+	if x != nil {
+		return x.MaxFailuresPerUsername
+	}
+	return 0
+}
+
+func (x *InstanceSignInProtectionSetting) GetMaxFailuresPerIp() int32 {
+	if x != nil {
+		return x.MaxFailuresPerIp
+	}
+	return 0
+}
+
+func (x *InstanceSignInProtectionSetting) GetLockoutSeconds() int32 {
+	if x != nil {
+		return x.LockoutSeconds
+	}
+	return 0
+}
+
+func (x *InstanceSignInProtectionSetting) GetMaxLockoutSeconds() int32 {
+	if x != nil {
+		return x.MaxLockoutSeconds
+	}
+	return 0
+}
+
 type InstanceStorageSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// storage_type is the storage type.
@@ -608,7 +752,7 @@ type InstanceStorageSetting struct {
 
 func (x *InstanceStorageSetting) Reset() {
 	*x = InstanceStorageSetting{}
-	mi := &file_store_instance_setting_proto_msgTypes[4]
+	mi := &file_store_instance_setting_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -620,7 +764,7 @@ func (x *InstanceStorageSetting) String() string {
 func (*InstanceStorageSetting) ProtoMessage() {}
 
 func (x *InstanceStorageSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[4]
+	mi := &file_store_instance_setting_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +777,7 @@ func (x *InstanceStorageSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceStorageSetting.ProtoReflect.Descriptor instead.
 func (*InstanceStorageSetting) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{4}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *InstanceStorageSetting) GetStorageType() InstanceStorageSetting_StorageType {
@@ -679,7 +823,7 @@ type StorageS3Config struct {
 
 func (x *StorageS3Config) Reset() {
 	*x = StorageS3Config{}
-	mi := &file_store_instance_setting_proto_msgTypes[5]
+	mi := &file_store_instance_setting_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -691,7 +835,7 @@ func (x *StorageS3Config) String() string {
 func (*StorageS3Config) ProtoMessage() {}
 
 func (x *StorageS3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[5]
+	mi := &file_store_instance_setting_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,7 +848,7 @@ func (x *StorageS3Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StorageS3Config.ProtoReflect.Descriptor instead.
 func (*StorageS3Config) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{5}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *StorageS3Config) GetAccessKeyId() string {
@@ -756,14 +900,16 @@ type InstanceMemoRelatedSetting struct {
 	// enable_double_click_edit enables editing on double click.
 	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
 	// reactions is the list of reactions.
-	Reactions     []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// bump_time_on_publish sets the create and update time of scheduled memos to their publish time.
+	BumpTimeOnPublish bool `protobuf:"varint,8,opt,name=bump_time_on_publish,json=bumpTimeOnPublish,proto3" json:"bump_time_on_publish,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *InstanceMemoRelatedSetting) Reset() {
 	*x = InstanceMemoRelatedSetting{}
-	mi := &file_store_instance_setting_proto_msgTypes[6]
+	mi := &file_store_instance_setting_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -775,7 +921,7 @@ func (x *InstanceMemoRelatedSetting) String() string {
 func (*InstanceMemoRelatedSetting) ProtoMessage() {}
 
 func (x *InstanceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[6]
+	mi := &file_store_instance_setting_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +934,7 @@ func (x *InstanceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceMemoRelatedSetting.ProtoReflect.Descriptor instead.
 func (*InstanceMemoRelatedSetting) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{6}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *InstanceMemoRelatedSetting) GetContentLengthLimit() int32 {
@@ -812,6 +958,13 @@ func (x *InstanceMemoRelatedSetting) GetReactions() []string {
 	return nil
 }
 
+func (x *InstanceMemoRelatedSetting) GetBumpTimeOnPublish() bool {
+	if x != nil {
+		return x.BumpTimeOnPublish
+	}
+	return false
+}
+
 type InstanceTagMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional background color for the tag label.
@@ -825,7 +978,7 @@ type InstanceTagMetadata struct {
 
 func (x *InstanceTagMetadata) Reset() {
 	*x = InstanceTagMetadata{}
-	mi := &file_store_instance_setting_proto_msgTypes[7]
+	mi := &file_store_instance_setting_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -837,7 +990,7 @@ func (x *InstanceTagMetadata) String() string {
 func (*InstanceTagMetadata) ProtoMessage() {}
 
 func (x *InstanceTagMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[7]
+	mi := &file_store_instance_setting_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,7 +1003,7 @@ func (x *InstanceTagMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceTagMetadata.ProtoReflect.Descriptor instead.
 func (*InstanceTagMetadata) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{7}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *InstanceTagMetadata) GetBackgroundColor() *color.Color {
@@ -880,7 +1033,7 @@ type InstanceTagsSetting struct {
 
 func (x *InstanceTagsSetting) Reset() {
 	*x = InstanceTagsSetting{}
-	mi := &file_store_instance_setting_proto_msgTypes[8]
+	mi := &file_store_instance_setting_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -892,7 +1045,7 @@ func (x *InstanceTagsSetting) String() string {
 func (*InstanceTagsSetting) ProtoMessage() {}
 
 func (x *InstanceTagsSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[8]
+	mi := &file_store_instance_setting_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -905,7 +1058,7 @@ func (x *InstanceTagsSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceTagsSetting.ProtoReflect.Descriptor instead.
 func (*InstanceTagsSetting) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{8}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *InstanceTagsSetting) GetTags() map[string]*InstanceTagMetadata {
@@ -924,7 +1077,7 @@ type InstanceNotificationSetting struct {
 
 func (x *InstanceNotificationSetting) Reset() {
 	*x = InstanceNotificationSetting{}
-	mi := &file_store_instance_setting_proto_msgTypes[9]
+	mi := &file_store_instance_setting_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -936,7 +1089,7 @@ func (x *InstanceNotificationSetting) String() string {
 func (*InstanceNotificationSetting) ProtoMessage() {}
 
 func (x *InstanceNotificationSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[9]
+	mi := &file_store_instance_setting_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,7 +1102,7 @@ func (x *InstanceNotificationSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceNotificationSetting.ProtoReflect.Descriptor instead.
 func (*InstanceNotificationSetting) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{9}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *InstanceNotificationSetting) GetEmail() *InstanceNotificationSetting_EmailSetting {
@@ -959,6 +1112,107 @@ func (x *InstanceNotificationSetting) GetEmail() *InstanceNotificationSetting_Em
 	return nil
 }
 
+type InstanceWebPushSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The VAPID (RFC 8292) key pair the instance signs push requests with.
+	// The public key is the uncompressed P-256 point and the private key the scalar,
+	// both base64url-encoded without padding.
+	VapidPublicKey  string `protobuf:"bytes,1,opt,name=vapid_public_key,json=vapidPublicKey,proto3" json:"vapid_public_key,omitempty"`
+	VapidPrivateKey string `protobuf:"bytes,2,opt,name=vapid_private_key,json=vapidPrivateKey,proto3" json:"vapid_private_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InstanceWebPushSetting) Reset() {
+	*x = InstanceWebPushSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceWebPushSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceWebPushSetting) ProtoMessage() {}
+
+func (x *InstanceWebPushSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceWebPushSetting.ProtoReflect.Descriptor instead.
+func (*InstanceWebPushSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InstanceWebPushSetting) GetVapidPublicKey() string {
+	if x != nil {
+		return x.VapidPublicKey
+	}
+	return ""
+}
+
+func (x *InstanceWebPushSetting) GetVapidPrivateKey() string {
+	if x != nil {
+		return x.VapidPrivateKey
+	}
+	return ""
+}
+
+type InstanceWebhooksSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// webhooks are the admin-managed webhooks that receive instance-wide events.
+	// They share the shape of user webhooks, with event types from the instance catalog.
+	Webhooks      []*WebhooksUserSetting_Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceWebhooksSetting) Reset() {
+	*x = InstanceWebhooksSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceWebhooksSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceWebhooksSetting) ProtoMessage() {}
+
+func (x *InstanceWebhooksSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceWebhooksSetting.ProtoReflect.Descriptor instead.
+func (*InstanceWebhooksSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InstanceWebhooksSetting) GetWebhooks() []*WebhooksUserSetting_Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
 type InstanceAISetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// providers is the list of AI provider configurations available instance-wide.
@@ -972,7 +1226,7 @@ type InstanceAISetting struct {
 
 func (x *InstanceAISetting) Reset() {
 	*x = InstanceAISetting{}
-	mi := &file_store_instance_setting_proto_msgTypes[10]
+	mi := &file_store_instance_setting_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -984,7 +1238,7 @@ func (x *InstanceAISetting) String() string {
 func (*InstanceAISetting) ProtoMessage() {}
 
 func (x *InstanceAISetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[10]
+	mi := &file_store_instance_setting_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -997,7 +1251,7 @@ func (x *InstanceAISetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceAISetting.ProtoReflect.Descriptor instead.
 func (*InstanceAISetting) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{10}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *InstanceAISetting) GetProviders() []*AIProviderConfig {
@@ -1028,7 +1282,7 @@ type AIProviderConfig struct {
 
 func (x *AIProviderConfig) Reset() {
 	*x = AIProviderConfig{}
-	mi := &file_store_instance_setting_proto_msgTypes[11]
+	mi := &file_store_instance_setting_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1040,7 +1294,7 @@ func (x *AIProviderConfig) String() string {
 func (*AIProviderConfig) ProtoMessage() {}
 
 func (x *AIProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[11]
+	mi := &file_store_instance_setting_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1053,7 +1307,7 @@ func (x *AIProviderConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AIProviderConfig.ProtoReflect.Descriptor instead.
 func (*AIProviderConfig) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{11}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AIProviderConfig) GetId() string {
@@ -1122,7 +1376,7 @@ type TranscriptionConfig struct {
 
 func (x *TranscriptionConfig) Reset() {
 	*x = TranscriptionConfig{}
-	mi := &file_store_instance_setting_proto_msgTypes[12]
+	mi := &file_store_instance_setting_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1134,7 +1388,7 @@ func (x *TranscriptionConfig) String() string {
 func (*TranscriptionConfig) ProtoMessage() {}
 
 func (x *TranscriptionConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[12]
+	mi := &file_store_instance_setting_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +1401,7 @@ func (x *TranscriptionConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TranscriptionConfig.ProtoReflect.Descriptor instead.
 func (*TranscriptionConfig) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{12}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TranscriptionConfig) GetProviderId() string {
@@ -1196,7 +1450,7 @@ type InstanceNotificationSetting_EmailSetting struct {
 
 func (x *InstanceNotificationSetting_EmailSetting) Reset() {
 	*x = InstanceNotificationSetting_EmailSetting{}
-	mi := &file_store_instance_setting_proto_msgTypes[14]
+	mi := &file_store_instance_setting_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1208,7 +1462,7 @@ func (x *InstanceNotificationSetting_EmailSetting) String() string {
 func (*InstanceNotificationSetting_EmailSetting) ProtoMessage() {}
 
 func (x *InstanceNotificationSetting_EmailSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[14]
+	mi := &file_store_instance_setting_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1221,7 +1475,7 @@ func (x *InstanceNotificationSetting_EmailSetting) ProtoReflect() protoreflect.M
 
 // Deprecated: Use InstanceNotificationSetting_EmailSetting.ProtoReflect.Descriptor instead.
 func (*InstanceNotificationSetting_EmailSetting) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{9, 0}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *InstanceNotificationSetting_EmailSetting) GetEnabled() bool {
@@ -1298,7 +1552,7 @@ var File_store_instance_setting_proto protoreflect.FileDescriptor
 
 const file_store_instance_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x1cstore/instance_setting.proto\x12\vmemos.store\x1a\x17google/type/color.proto\"\xfb\x04\n" +
+	"\x1cstore/instance_setting.proto\x12\vmemos.store\x1a\x17google/type/color.proto\x1a\x18store/user_setting.proto\"\x9f\x06\n" +
 	"\x0fInstanceSetting\x121\n" +
 	"\x03key\x18\x01 \x01(\x0e2\x1f.memos.store.InstanceSettingKeyR\x03key\x12H\n" +
 	"\rbasic_setting\x18\x02 \x01(\v2!.memos.store.InstanceBasicSettingH\x00R\fbasicSetting\x12N\n" +
@@ -1308,12 +1562,15 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\ftags_setting\x18\x06 \x01(\v2 .memos.store.InstanceTagsSettingH\x00R\vtagsSetting\x12]\n" +
 	"\x14notification_setting\x18\a \x01(\v2(.memos.store.InstanceNotificationSettingH\x00R\x13notificationSetting\x12?\n" +
 	"\n" +
-	"ai_setting\x18\b \x01(\v2\x1e.memos.store.InstanceAISettingH\x00R\taiSettingB\a\n" +
+	"ai_setting\x18\b \x01(\v2\x1e.memos.store.InstanceAISettingH\x00R\taiSetting\x12Q\n" +
+	"\x10webhooks_setting\x18\t \x01(\v2$.memos.store.InstanceWebhooksSettingH\x00R\x0fwebhooksSetting\x12O\n" +
+	"\x10web_push_setting\x18\n" +
+	" \x01(\v2#.memos.store.InstanceWebPushSettingH\x00R\x0ewebPushSettingB\a\n" +
 	"\x05value\"\\\n" +
 	"\x14InstanceBasicSetting\x12\x1d\n" +
 	"\n" +
 	"secret_key\x18\x01 \x01(\tR\tsecretKey\x12%\n" +
-	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\"\xd6\x03\n" +
+	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\"\xf0\x04\n" +
 	"\x16InstanceGeneralSetting\x12<\n" +
 	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
 	"\x16disallow_password_auth\x18\x03 \x01(\bR\x14disallowPasswordAuth\x12+\n" +
@@ -1322,11 +1579,20 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\x0ecustom_profile\x18\x06 \x01(\v2\".memos.store.InstanceCustomProfileR\rcustomProfile\x121\n" +
 	"\x15week_start_day_offset\x18\a \x01(\x05R\x12weekStartDayOffset\x128\n" +
 	"\x18disallow_change_username\x18\b \x01(\bR\x16disallowChangeUsername\x128\n" +
-	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\"j\n" +
+	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\x12Z\n" +
+	"\x12sign_in_protection\x18\n" +
+	" \x01(\v2,.memos.store.InstanceSignInProtectionSettingR\x10signInProtection\x12<\n" +
+	"\x1arequire_email_verification\x18\v \x01(\bR\x18requireEmailVerification\"j\n" +
 	"\x15InstanceCustomProfile\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
-	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\"\xd3\x02\n" +
+	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\"\x80\x02\n" +
+	"\x1fInstanceSignInProtectionSetting\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x129\n" +
+	"\x19max_failures_per_username\x18\x02 \x01(\x05R\x16maxFailuresPerUsername\x12-\n" +
+	"\x13max_failures_per_ip\x18\x03 \x01(\x05R\x10maxFailuresPerIp\x12'\n" +
+	"\x0flockout_seconds\x18\x04 \x01(\x05R\x0elockoutSeconds\x12.\n" +
+	"\x13max_lockout_seconds\x18\x05 \x01(\x05R\x11maxLockoutSeconds\"\xd3\x02\n" +
 	"\x16InstanceStorageSetting\x12R\n" +
 	"\fstorage_type\x18\x01 \x01(\x0e2/.memos.store.InstanceStorageSetting.StorageTypeR\vstorageType\x12+\n" +
 	"\x11filepath_template\x18\x02 \x01(\tR\x10filepathTemplate\x12/\n" +
@@ -1343,11 +1609,12 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x16\n" +
 	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
 	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12$\n" +
-	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\xc5\x01\n" +
+	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\xf6\x01\n" +
 	"\x1aInstanceMemoRelatedSetting\x120\n" +
 	"\x14content_length_limit\x18\x03 \x01(\x05R\x12contentLengthLimit\x127\n" +
 	"\x18enable_double_click_edit\x18\x04 \x01(\bR\x15enableDoubleClickEdit\x12\x1c\n" +
-	"\treactions\x18\a \x03(\tR\treactionsJ\x04\b\x02\x10\x03R\x18display_with_update_time\"w\n" +
+	"\treactions\x18\a \x03(\tR\treactions\x12/\n" +
+	"\x14bump_time_on_publish\x18\b \x01(\bR\x11bumpTimeOnPublishJ\x04\b\x02\x10\x03R\x18display_with_update_time\"w\n" +
 	"\x13InstanceTagMetadata\x12=\n" +
 	"\x10background_color\x18\x01 \x01(\v2\x12.google.type.ColorR\x0fbackgroundColor\x12!\n" +
 	"\fblur_content\x18\x02 \x01(\bR\vblurContent\"\xb0\x01\n" +
@@ -1370,7 +1637,12 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\breply_to\x18\b \x01(\tR\areplyTo\x12\x17\n" +
 	"\ause_tls\x18\t \x01(\bR\x06useTls\x12\x17\n" +
 	"\ause_ssl\x18\n" +
-	" \x01(\bR\x06useSsl\"\x98\x01\n" +
+	" \x01(\bR\x06useSsl\"n\n" +
+	"\x16InstanceWebPushSetting\x12(\n" +
+	"\x10vapid_public_key\x18\x01 \x01(\tR\x0evapidPublicKey\x12*\n" +
+	"\x11vapid_private_key\x18\x02 \x01(\tR\x0fvapidPrivateKey\"_\n" +
+	"\x17InstanceWebhooksSetting\x12D\n" +
+	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\"\x98\x01\n" +
 	"\x11InstanceAISetting\x12;\n" +
 	"\tproviders\x18\x01 \x03(\v2\x1d.memos.store.AIProviderConfigR\tproviders\x12F\n" +
 	"\rtranscription\x18\x02 \x01(\v2 .memos.store.TranscriptionConfigR\rtranscription\"\x9e\x01\n" +
@@ -1385,7 +1657,7 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"providerId\x12\x14\n" +
 	"\x05model\x18\x02 \x01(\tR\x05model\x12\x1a\n" +
 	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x16\n" +
-	"\x06prompt\x18\x04 \x01(\tR\x06prompt*\x95\x01\n" +
+	"\x06prompt\x18\x04 \x01(\tR\x06prompt*\xb1\x01\n" +
 	"\x12InstanceSettingKey\x12$\n" +
 	" INSTANCE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05BASIC\x10\x01\x12\v\n" +
@@ -1394,7 +1666,9 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\fMEMO_RELATED\x10\x04\x12\b\n" +
 	"\x04TAGS\x10\x05\x12\x10\n" +
 	"\fNOTIFICATION\x10\x06\x12\x06\n" +
-	"\x02AI\x10\a*J\n" +
+	"\x02AI\x10\a\x12\f\n" +
+	"\bWEBHOOKS\x10\b\x12\f\n" +
+	"\bWEB_PUSH\x10\t*J\n" +
 	"\x0eAIProviderType\x12 \n" +
 	"\x1cAI_PROVIDER_TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
@@ -1416,7 +1690,7 @@ func file_store_instance_setting_proto_rawDescGZIP() []byte {
 }
 
 var file_store_instance_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_store_instance_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_store_instance_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_store_instance_setting_proto_goTypes = []any{
 	(InstanceSettingKey)(0),                          // 0: memos.store.InstanceSettingKey
 	(AIProviderType)(0),                              // 1: memos.store.AIProviderType
@@ -1425,43 +1699,51 @@ var file_store_instance_setting_proto_goTypes = []any{
 	(*InstanceBasicSetting)(nil),                     // 4: memos.store.InstanceBasicSetting
 	(*InstanceGeneralSetting)(nil),                   // 5: memos.store.InstanceGeneralSetting
 	(*InstanceCustomProfile)(nil),                    // 6: memos.store.InstanceCustomProfile
-	(*InstanceStorageSetting)(nil),                   // 7: memos.store.InstanceStorageSetting
-	(*StorageS3Config)(nil),                          // 8: memos.store.StorageS3Config
-	(*InstanceMemoRelatedSetting)(nil),               // 9: memos.store.InstanceMemoRelatedSetting
-	(*InstanceTagMetadata)(nil),                      // 10: memos.store.InstanceTagMetadata
-	(*InstanceTagsSetting)(nil),                      // 11: memos.store.InstanceTagsSetting
-	(*InstanceNotificationSetting)(nil),              // 12: memos.store.InstanceNotificationSetting
-	(*InstanceAISetting)(nil),                        // 13: memos.store.InstanceAISetting
-	(*AIProviderConfig)(nil),                         // 14: memos.store.AIProviderConfig
-	(*TranscriptionConfig)(nil),                      // 15: memos.store.TranscriptionConfig
-	nil,                                              // 16: memos.store.InstanceTagsSetting.TagsEntry
-	(*InstanceNotificationSetting_EmailSetting)(nil), // 17: memos.store.InstanceNotificationSetting.EmailSetting
-	(*color.Color)(nil),                              // 18: google.type.Color
+	(*InstanceSignInProtectionSetting)(nil),          // 7: memos.store.InstanceSignInProtectionSetting
+	(*InstanceStorageSetting)(nil),                   // 8: memos.store.InstanceStorageSetting
+	(*StorageS3Config)(nil),                          // 9: memos.store.StorageS3Config
+	(*InstanceMemoRelatedSetting)(nil),               // 10: memos.store.InstanceMemoRelatedSetting
+	(*InstanceTagMetadata)(nil),                      // 11: memos.store.InstanceTagMetadata
+	(*InstanceTagsSetting)(nil),                      // 12: memos.store.InstanceTagsSetting
+	(*InstanceNotificationSetting)(nil),              // 13: memos.store.InstanceNotificationSetting
+	(*InstanceWebPushSetting)(nil),                   // 14: memos.store.InstanceWebPushSetting
+	(*InstanceWebhooksSetting)(nil),                  // 15: memos.store.InstanceWebhooksSetting
+	(*InstanceAISetting)(nil),                        // 16: memos.store.InstanceAISetting
+	(*AIProviderConfig)(nil),                         // 17: memos.store.AIProviderConfig
+	(*TranscriptionConfig)(nil),                      // 18: memos.store.TranscriptionConfig
+	nil,                                              // 19: memos.store.InstanceTagsSetting.TagsEntry
+	(*InstanceNotificationSetting_EmailSetting)(nil), // 20: memos.store.InstanceNotificationSetting.EmailSetting
+	(*color.Color)(nil),                              // 21: google.type.Color
+	(*WebhooksUserSetting_Webhook)(nil),              // 22: memos.store.WebhooksUserSetting.Webhook
 }
 var file_store_instance_setting_proto_depIdxs = []int32{
 	0,  // 0: memos.store.InstanceSetting.key:type_name -> memos.store.InstanceSettingKey
 	4,  // 1: memos.store.InstanceSetting.basic_setting:type_name -> memos.store.InstanceBasicSetting
 	5,  // 2: memos.store.InstanceSetting.general_setting:type_name -> memos.store.InstanceGeneralSetting
-	7,  // 3: memos.store.InstanceSetting.storage_setting:type_name -> memos.store.InstanceStorageSetting
-	9,  // 4: memos.store.InstanceSetting.memo_related_setting:type_name -> memos.store.InstanceMemoRelatedSetting
-	11, // 5: memos.store.InstanceSetting.tags_setting:type_name -> memos.store.InstanceTagsSetting
-	12, // 6: memos.store.InstanceSetting.notification_setting:type_name -> memos.store.InstanceNotificationSetting
-	13, // 7: memos.store.InstanceSetting.ai_setting:type_name -> memos.store.InstanceAISetting
-	6,  // 8: memos.store.InstanceGeneralSetting.custom_profile:type_name -> memos.store.InstanceCustomProfile
-	2,  // 9: memos.store.InstanceStorageSetting.storage_type:type_name -> memos.store.InstanceStorageSetting.StorageType
-	8,  // 10: memos.store.InstanceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
-	18, // 11: memos.store.InstanceTagMetadata.background_color:type_name -> google.type.Color
-	16, // 12: memos.store.InstanceTagsSetting.tags:type_name -> memos.store.InstanceTagsSetting.TagsEntry
-	17, // 13: memos.store.InstanceNotificationSetting.email:type_name -> memos.store.InstanceNotificationSetting.EmailSetting
-	14, // 14: memos.store.InstanceAISetting.providers:type_name -> memos.store.AIProviderConfig
-	15, // 15: memos.store.InstanceAISetting.transcription:type_name -> memos.store.TranscriptionConfig
-	1,  // 16: memos.store.AIProviderConfig.type:type_name -> memos.store.AIProviderType
-	10, // 17: memos.store.InstanceTagsSetting.TagsEntry.value:type_name -> memos.store.InstanceTagMetadata
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	8,  // 3: memos.store.InstanceSetting.storage_setting:type_name -> memos.store.InstanceStorageSetting
+	10, // 4: memos.store.InstanceSetting.memo_related_setting:type_name -> memos.store.InstanceMemoRelatedSetting
+	12, // 5: memos.store.InstanceSetting.tags_setting:type_name -> memos.store.InstanceTagsSetting
+	13, // 6: memos.store.InstanceSetting.notification_setting:type_name -> memos.store.InstanceNotificationSetting
+	16, // 7: memos.store.InstanceSetting.ai_setting:type_name -> memos.store.InstanceAISetting
+	15, // 8: memos.store.InstanceSetting.webhooks_setting:type_name -> memos.store.InstanceWebhooksSetting
+	14, // 9: memos.store.InstanceSetting.web_push_setting:type_name -> memos.store.InstanceWebPushSetting
+	6,  // 10: memos.store.InstanceGeneralSetting.custom_profile:type_name -> memos.store.InstanceCustomProfile
+	7,  // 11: memos.store.InstanceGeneralSetting.sign_in_protection:type_name -> memos.store.InstanceSignInProtectionSetting
+	2,  // 12: memos.store.InstanceStorageSetting.storage_type:type_name -> memos.store.InstanceStorageSetting.StorageType
+	9,  // 13: memos.store.InstanceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
+	21, // 14: memos.store.InstanceTagMetadata.background_color:type_name -> google.type.Color
+	19, // 15: memos.store.InstanceTagsSetting.tags:type_name -> memos.store.InstanceTagsSetting.TagsEntry
+	20, // 16: memos.store.InstanceNotificationSetting.email:type_name -> memos.store.InstanceNotificationSetting.EmailSetting
+	22, // 17: memos.store.InstanceWebhooksSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
+	17, // 18: memos.store.InstanceAISetting.providers:type_name -> memos.store.AIProviderConfig
+	18, // 19: memos.store.InstanceAISetting.transcription:type_name -> memos.store.TranscriptionConfig
+	1,  // 20: memos.store.AIProviderConfig.type:type_name -> memos.store.AIProviderType
+	11, // 21: memos.store.InstanceTagsSetting.TagsEntry.value:type_name -> memos.store.InstanceTagMetadata
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_store_instance_setting_proto_init() }
@@ -1469,6 +1751,7 @@ func file_store_instance_setting_proto_init() {
 	if File_store_instance_setting_proto != nil {
 		return
 	}
+	file_store_user_setting_proto_init()
 	file_store_instance_setting_proto_msgTypes[0].OneofWrappers = []any{
 		(*InstanceSetting_BasicSetting)(nil),
 		(*InstanceSetting_GeneralSetting)(nil),
@@ -1477,6 +1760,8 @@ func file_store_instance_setting_proto_init() {
 		(*InstanceSetting_TagsSetting)(nil),
 		(*InstanceSetting_NotificationSetting)(nil),
 		(*InstanceSetting_AiSetting)(nil),
+		(*InstanceSetting_WebhooksSetting)(nil),
+		(*InstanceSetting_WebPushSetting)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1484,7 +1769,7 @@ func file_store_instance_setting_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_instance_setting_proto_rawDesc), len(file_store_instance_setting_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   15,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
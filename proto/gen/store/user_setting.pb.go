@@ -36,6 +36,10 @@ const (
 	UserSetting_REFRESH_TOKENS UserSetting_Key = 6
 	// Personal access tokens for the user.
 	UserSetting_PERSONAL_ACCESS_TOKENS UserSetting_Key = 7
+	// Notification delivery preferences of the user.
+	UserSetting_NOTIFICATION UserSetting_Key = 8
+	// Web Push subscriptions of the user's devices.
+	UserSetting_WEB_PUSH_SUBSCRIPTIONS UserSetting_Key = 9
 )
 
 // Enum value maps for UserSetting_Key.
@@ -47,6 +51,8 @@ var (
 		5: "WEBHOOKS",
 		6: "REFRESH_TOKENS",
 		7: "PERSONAL_ACCESS_TOKENS",
+		8: "NOTIFICATION",
+		9: "WEB_PUSH_SUBSCRIPTIONS",
 	}
 	UserSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED":        0,
@@ -55,6 +61,8 @@ var (
 		"WEBHOOKS":               5,
 		"REFRESH_TOKENS":         6,
 		"PERSONAL_ACCESS_TOKENS": 7,
+		"NOTIFICATION":           8,
+		"WEB_PUSH_SUBSCRIPTIONS": 9,
 	}
 )
 
@@ -85,6 +93,118 @@ func (UserSetting_Key) EnumDescriptor() ([]byte, []int) {
 	return file_store_user_setting_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type NotificationUserSetting_EmailDelivery int32
+
+const (
+	// Unspecified is treated as IMMEDIATE.
+	NotificationUserSetting_EMAIL_DELIVERY_UNSPECIFIED NotificationUserSetting_EmailDelivery = 0
+	// Email each notification as soon as it is created.
+	NotificationUserSetting_IMMEDIATE NotificationUserSetting_EmailDelivery = 1
+	// Batch unread notifications into an hourly digest email.
+	NotificationUserSetting_HOURLY_DIGEST NotificationUserSetting_EmailDelivery = 2
+	// Batch unread notifications into a daily digest email.
+	NotificationUserSetting_DAILY_DIGEST NotificationUserSetting_EmailDelivery = 3
+	// Never email the notification.
+	NotificationUserSetting_OFF NotificationUserSetting_EmailDelivery = 4
+)
+
+// Enum value maps for NotificationUserSetting_EmailDelivery.
+var (
+	NotificationUserSetting_EmailDelivery_name = map[int32]string{
+		0: "EMAIL_DELIVERY_UNSPECIFIED",
+		1: "IMMEDIATE",
+		2: "HOURLY_DIGEST",
+		3: "DAILY_DIGEST",
+		4: "OFF",
+	}
+	NotificationUserSetting_EmailDelivery_value = map[string]int32{
+		"EMAIL_DELIVERY_UNSPECIFIED": 0,
+		"IMMEDIATE":                  1,
+		"HOURLY_DIGEST":              2,
+		"DAILY_DIGEST":               3,
+		"OFF":                        4,
+	}
+)
+
+func (x NotificationUserSetting_EmailDelivery) Enum() *NotificationUserSetting_EmailDelivery {
+	p := new(NotificationUserSetting_EmailDelivery)
+	*p = x
+	return p
+}
+
+func (x NotificationUserSetting_EmailDelivery) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationUserSetting_EmailDelivery) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (NotificationUserSetting_EmailDelivery) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[1]
+}
+
+func (x NotificationUserSetting_EmailDelivery) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationUserSetting_EmailDelivery.Descriptor instead.
+func (NotificationUserSetting_EmailDelivery) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type NotificationUserSetting_Channel_Type int32
+
+const (
+	NotificationUserSetting_Channel_TYPE_UNSPECIFIED NotificationUserSetting_Channel_Type = 0
+	NotificationUserSetting_Channel_NTFY             NotificationUserSetting_Channel_Type = 1
+	NotificationUserSetting_Channel_GOTIFY           NotificationUserSetting_Channel_Type = 2
+	NotificationUserSetting_Channel_APPRISE          NotificationUserSetting_Channel_Type = 3
+)
+
+// Enum value maps for NotificationUserSetting_Channel_Type.
+var (
+	NotificationUserSetting_Channel_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "NTFY",
+		2: "GOTIFY",
+		3: "APPRISE",
+	}
+	NotificationUserSetting_Channel_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"NTFY":             1,
+		"GOTIFY":           2,
+		"APPRISE":          3,
+	}
+)
+
+func (x NotificationUserSetting_Channel_Type) Enum() *NotificationUserSetting_Channel_Type {
+	p := new(NotificationUserSetting_Channel_Type)
+	*p = x
+	return p
+}
+
+func (x NotificationUserSetting_Channel_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationUserSetting_Channel_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (NotificationUserSetting_Channel_Type) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[2]
+}
+
+func (x NotificationUserSetting_Channel_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationUserSetting_Channel_Type.Descriptor instead.
+func (NotificationUserSetting_Channel_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 1, 0}
+}
+
 type UserSetting struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -96,6 +216,8 @@ type UserSetting struct {
 	//	*UserSetting_Webhooks
 	//	*UserSetting_RefreshTokens
 	//	*UserSetting_PersonalAccessTokens
+	//	*UserSetting_Notification
+	//	*UserSetting_WebPushSubscriptions
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -197,6 +319,24 @@ func (x *UserSetting) GetPersonalAccessTokens() *PersonalAccessTokensUserSetting
 	return nil
 }
 
+func (x *UserSetting) GetNotification() *NotificationUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Notification); ok {
+			return x.Notification
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetWebPushSubscriptions() *WebPushSubscriptionsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_WebPushSubscriptions); ok {
+			return x.WebPushSubscriptions
+		}
+	}
+	return nil
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -221,6 +361,14 @@ type UserSetting_PersonalAccessTokens struct {
 	PersonalAccessTokens *PersonalAccessTokensUserSetting `protobuf:"bytes,9,opt,name=personal_access_tokens,json=personalAccessTokens,proto3,oneof"`
 }
 
+type UserSetting_Notification struct {
+	Notification *NotificationUserSetting `protobuf:"bytes,10,opt,name=notification,proto3,oneof"`
+}
+
+type UserSetting_WebPushSubscriptions struct {
+	WebPushSubscriptions *WebPushSubscriptionsUserSetting `protobuf:"bytes,11,opt,name=web_push_subscriptions,json=webPushSubscriptions,proto3,oneof"`
+}
+
 func (*UserSetting_General) isUserSetting_Value() {}
 
 func (*UserSetting_Shortcuts) isUserSetting_Value() {}
@@ -231,6 +379,10 @@ func (*UserSetting_RefreshTokens) isUserSetting_Value() {}
 
 func (*UserSetting_PersonalAccessTokens) isUserSetting_Value() {}
 
+func (*UserSetting_Notification) isUserSetting_Value() {}
+
+func (*UserSetting_WebPushSubscriptions) isUserSetting_Value() {}
+
 type GeneralUserSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The user's locale.
@@ -239,9 +391,11 @@ type GeneralUserSetting struct {
 	MemoVisibility string `protobuf:"bytes,2,opt,name=memo_visibility,json=memoVisibility,proto3" json:"memo_visibility,omitempty"`
 	// The user's theme preference.
 	// This references a CSS file in the web/public/themes/ directory.
-	Theme         string `protobuf:"bytes,3,opt,name=theme,proto3" json:"theme,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Theme string `protobuf:"bytes,3,opt,name=theme,proto3" json:"theme,omitempty"`
+	// The inbox notification types the user opted out of.
+	MutedNotificationTypes []InboxMessage_Type `protobuf:"varint,4,rep,packed,name=muted_notification_types,json=mutedNotificationTypes,proto3,enum=memos.store.InboxMessage_Type" json:"muted_notification_types,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *GeneralUserSetting) Reset() {
@@ -295,6 +449,137 @@ func (x *GeneralUserSetting) GetTheme() string {
 	return ""
 }
 
+func (x *GeneralUserSetting) GetMutedNotificationTypes() []InboxMessage_Type {
+	if x != nil {
+		return x.MutedNotificationTypes
+	}
+	return nil
+}
+
+type NotificationUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The email delivery preference per inbox message type.
+	Preferences []*NotificationUserSetting_Preference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	// The time the next hourly digest starts from, in seconds since epoch.
+	HourlyDigestTs int64 `protobuf:"varint,2,opt,name=hourly_digest_ts,json=hourlyDigestTs,proto3" json:"hourly_digest_ts,omitempty"`
+	// The time the next daily digest starts from, in seconds since epoch.
+	DailyDigestTs int64                              `protobuf:"varint,3,opt,name=daily_digest_ts,json=dailyDigestTs,proto3" json:"daily_digest_ts,omitempty"`
+	Channels      []*NotificationUserSetting_Channel `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	// Whether the user receives the weekly recap email.
+	WeeklyRecap   bool `protobuf:"varint,5,opt,name=weekly_recap,json=weeklyRecap,proto3" json:"weekly_recap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationUserSetting) Reset() {
+	*x = NotificationUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationUserSetting) ProtoMessage() {}
+
+func (x *NotificationUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationUserSetting.ProtoReflect.Descriptor instead.
+func (*NotificationUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NotificationUserSetting) GetPreferences() []*NotificationUserSetting_Preference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *NotificationUserSetting) GetHourlyDigestTs() int64 {
+	if x != nil {
+		return x.HourlyDigestTs
+	}
+	return 0
+}
+
+func (x *NotificationUserSetting) GetDailyDigestTs() int64 {
+	if x != nil {
+		return x.DailyDigestTs
+	}
+	return 0
+}
+
+func (x *NotificationUserSetting) GetChannels() []*NotificationUserSetting_Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *NotificationUserSetting) GetWeeklyRecap() bool {
+	if x != nil {
+		return x.WeeklyRecap
+	}
+	return false
+}
+
+type WebPushSubscriptionsUserSetting struct {
+	state         protoimpl.MessageState                          `protogen:"open.v1"`
+	Subscriptions []*WebPushSubscriptionsUserSetting_Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebPushSubscriptionsUserSetting) Reset() {
+	*x = WebPushSubscriptionsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebPushSubscriptionsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebPushSubscriptionsUserSetting) ProtoMessage() {}
+
+func (x *WebPushSubscriptionsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebPushSubscriptionsUserSetting.ProtoReflect.Descriptor instead.
+func (*WebPushSubscriptionsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WebPushSubscriptionsUserSetting) GetSubscriptions() []*WebPushSubscriptionsUserSetting_Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
 type RefreshTokensUserSetting struct {
 	state         protoimpl.MessageState                   `protogen:"open.v1"`
 	RefreshTokens []*RefreshTokensUserSetting_RefreshToken `protobuf:"bytes,1,rep,name=refresh_tokens,json=refreshTokens,proto3" json:"refresh_tokens,omitempty"`
@@ -304,7 +589,7 @@ type RefreshTokensUserSetting struct {
 
 func (x *RefreshTokensUserSetting) Reset() {
 	*x = RefreshTokensUserSetting{}
-	mi := &file_store_user_setting_proto_msgTypes[2]
+	mi := &file_store_user_setting_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +601,7 @@ func (x *RefreshTokensUserSetting) String() string {
 func (*RefreshTokensUserSetting) ProtoMessage() {}
 
 func (x *RefreshTokensUserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[2]
+	mi := &file_store_user_setting_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +614,7 @@ func (x *RefreshTokensUserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokensUserSetting.ProtoReflect.Descriptor instead.
 func (*RefreshTokensUserSetting) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RefreshTokensUserSetting) GetRefreshTokens() []*RefreshTokensUserSetting_RefreshToken {
@@ -348,7 +633,7 @@ type PersonalAccessTokensUserSetting struct {
 
 func (x *PersonalAccessTokensUserSetting) Reset() {
 	*x = PersonalAccessTokensUserSetting{}
-	mi := &file_store_user_setting_proto_msgTypes[3]
+	mi := &file_store_user_setting_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -360,7 +645,7 @@ func (x *PersonalAccessTokensUserSetting) String() string {
 func (*PersonalAccessTokensUserSetting) ProtoMessage() {}
 
 func (x *PersonalAccessTokensUserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[3]
+	mi := &file_store_user_setting_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -373,7 +658,7 @@ func (x *PersonalAccessTokensUserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PersonalAccessTokensUserSetting.ProtoReflect.Descriptor instead.
 func (*PersonalAccessTokensUserSetting) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{3}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PersonalAccessTokensUserSetting) GetTokens() []*PersonalAccessTokensUserSetting_PersonalAccessToken {
@@ -392,7 +677,7 @@ type ShortcutsUserSetting struct {
 
 func (x *ShortcutsUserSetting) Reset() {
 	*x = ShortcutsUserSetting{}
-	mi := &file_store_user_setting_proto_msgTypes[4]
+	mi := &file_store_user_setting_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +689,7 @@ func (x *ShortcutsUserSetting) String() string {
 func (*ShortcutsUserSetting) ProtoMessage() {}
 
 func (x *ShortcutsUserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[4]
+	mi := &file_store_user_setting_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +702,7 @@ func (x *ShortcutsUserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShortcutsUserSetting.ProtoReflect.Descriptor instead.
 func (*ShortcutsUserSetting) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{4}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ShortcutsUserSetting) GetShortcuts() []*ShortcutsUserSetting_Shortcut {
@@ -436,7 +721,7 @@ type WebhooksUserSetting struct {
 
 func (x *WebhooksUserSetting) Reset() {
 	*x = WebhooksUserSetting{}
-	mi := &file_store_user_setting_proto_msgTypes[5]
+	mi := &file_store_user_setting_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +733,7 @@ func (x *WebhooksUserSetting) String() string {
 func (*WebhooksUserSetting) ProtoMessage() {}
 
 func (x *WebhooksUserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[5]
+	mi := &file_store_user_setting_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +746,7 @@ func (x *WebhooksUserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebhooksUserSetting.ProtoReflect.Descriptor instead.
 func (*WebhooksUserSetting) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{5}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *WebhooksUserSetting) GetWebhooks() []*WebhooksUserSetting_Webhook {
@@ -471,25 +756,234 @@ func (x *WebhooksUserSetting) GetWebhooks() []*WebhooksUserSetting_Webhook {
 	return nil
 }
 
+type NotificationUserSetting_Preference struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Type          InboxMessage_Type                     `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.InboxMessage_Type" json:"type,omitempty"`
+	EmailDelivery NotificationUserSetting_EmailDelivery `protobuf:"varint,2,opt,name=email_delivery,json=emailDelivery,proto3,enum=memos.store.NotificationUserSetting_EmailDelivery" json:"email_delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationUserSetting_Preference) Reset() {
+	*x = NotificationUserSetting_Preference{}
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationUserSetting_Preference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationUserSetting_Preference) ProtoMessage() {}
+
+func (x *NotificationUserSetting_Preference) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationUserSetting_Preference.ProtoReflect.Descriptor instead.
+func (*NotificationUserSetting_Preference) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *NotificationUserSetting_Preference) GetType() InboxMessage_Type {
+	if x != nil {
+		return x.Type
+	}
+	return InboxMessage_TYPE_UNSPECIFIED
+}
+
+func (x *NotificationUserSetting_Preference) GetEmailDelivery() NotificationUserSetting_EmailDelivery {
+	if x != nil {
+		return x.EmailDelivery
+	}
+	return NotificationUserSetting_EMAIL_DELIVERY_UNSPECIFIED
+}
+
+// A self-hosted push service that inbox notifications are also delivered to.
+type NotificationUserSetting_Channel struct {
+	state protoimpl.MessageState               `protogen:"open.v1"`
+	Type  NotificationUserSetting_Channel_Type `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.NotificationUserSetting_Channel_Type" json:"type,omitempty"`
+	// The ntfy topic URL, the Gotify server URL or the Apprise API notify URL.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The ntfy access token or the Gotify application token.
+	Token         string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationUserSetting_Channel) Reset() {
+	*x = NotificationUserSetting_Channel{}
+	mi := &file_store_user_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationUserSetting_Channel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationUserSetting_Channel) ProtoMessage() {}
+
+func (x *NotificationUserSetting_Channel) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationUserSetting_Channel.ProtoReflect.Descriptor instead.
+func (*NotificationUserSetting_Channel) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *NotificationUserSetting_Channel) GetType() NotificationUserSetting_Channel_Type {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationUserSetting_Channel_TYPE_UNSPECIFIED
+}
+
+func (x *NotificationUserSetting_Channel) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NotificationUserSetting_Channel) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type WebPushSubscriptionsUserSetting_Subscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the subscription.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The push service URL of the device.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The P-256 ECDH public key of the device, base64url-encoded.
+	P256Dh string `protobuf:"bytes,3,opt,name=p256dh,proto3" json:"p256dh,omitempty"`
+	// The authentication secret of the device, base64url-encoded.
+	Auth string `protobuf:"bytes,4,opt,name=auth,proto3" json:"auth,omitempty"`
+	// Optional description of the device.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// When the subscription was registered.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebPushSubscriptionsUserSetting_Subscription) Reset() {
+	*x = WebPushSubscriptionsUserSetting_Subscription{}
+	mi := &file_store_user_setting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebPushSubscriptionsUserSetting_Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebPushSubscriptionsUserSetting_Subscription) ProtoMessage() {}
+
+func (x *WebPushSubscriptionsUserSetting_Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebPushSubscriptionsUserSetting_Subscription.ProtoReflect.Descriptor instead.
+func (*WebPushSubscriptionsUserSetting_Subscription) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *WebPushSubscriptionsUserSetting_Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebPushSubscriptionsUserSetting_Subscription) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WebPushSubscriptionsUserSetting_Subscription) GetP256Dh() string {
+	if x != nil {
+		return x.P256Dh
+	}
+	return ""
+}
+
+func (x *WebPushSubscriptionsUserSetting_Subscription) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
+func (x *WebPushSubscriptionsUserSetting_Subscription) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WebPushSubscriptionsUserSetting_Subscription) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
 type RefreshTokensUserSetting_RefreshToken struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique identifier (matches 'tid' claim in JWT)
 	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 	// When the token expires
 	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	// When the token was created
+	// When the session was created (preserved across token rotation)
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Client information for session management UI
 	ClientInfo *RefreshTokensUserSetting_ClientInfo `protobuf:"bytes,4,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
 	// Optional description
-	Description   string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// When the session was last refreshed
+	LastSeenAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// IP address of the client at the last refresh
+	LastSeenIp    string `protobuf:"bytes,7,opt,name=last_seen_ip,json=lastSeenIp,proto3" json:"last_seen_ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RefreshTokensUserSetting_RefreshToken) Reset() {
 	*x = RefreshTokensUserSetting_RefreshToken{}
-	mi := &file_store_user_setting_proto_msgTypes[6]
+	mi := &file_store_user_setting_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -501,7 +995,7 @@ func (x *RefreshTokensUserSetting_RefreshToken) String() string {
 func (*RefreshTokensUserSetting_RefreshToken) ProtoMessage() {}
 
 func (x *RefreshTokensUserSetting_RefreshToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[6]
+	mi := &file_store_user_setting_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +1008,7 @@ func (x *RefreshTokensUserSetting_RefreshToken) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use RefreshTokensUserSetting_RefreshToken.ProtoReflect.Descriptor instead.
 func (*RefreshTokensUserSetting_RefreshToken) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 0}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *RefreshTokensUserSetting_RefreshToken) GetTokenId() string {
@@ -552,6 +1046,20 @@ func (x *RefreshTokensUserSetting_RefreshToken) GetDescription() string {
 	return ""
 }
 
+func (x *RefreshTokensUserSetting_RefreshToken) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *RefreshTokensUserSetting_RefreshToken) GetLastSeenIp() string {
+	if x != nil {
+		return x.LastSeenIp
+	}
+	return ""
+}
+
 type RefreshTokensUserSetting_ClientInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// User agent string of the client.
@@ -570,7 +1078,7 @@ type RefreshTokensUserSetting_ClientInfo struct {
 
 func (x *RefreshTokensUserSetting_ClientInfo) Reset() {
 	*x = RefreshTokensUserSetting_ClientInfo{}
-	mi := &file_store_user_setting_proto_msgTypes[7]
+	mi := &file_store_user_setting_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -582,7 +1090,7 @@ func (x *RefreshTokensUserSetting_ClientInfo) String() string {
 func (*RefreshTokensUserSetting_ClientInfo) ProtoMessage() {}
 
 func (x *RefreshTokensUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[7]
+	mi := &file_store_user_setting_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,7 +1103,7 @@ func (x *RefreshTokensUserSetting_ClientInfo) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use RefreshTokensUserSetting_ClientInfo.ProtoReflect.Descriptor instead.
 func (*RefreshTokensUserSetting_ClientInfo) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 1}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{4, 1}
 }
 
 func (x *RefreshTokensUserSetting_ClientInfo) GetUserAgent() string {
@@ -646,14 +1154,16 @@ type PersonalAccessTokensUserSetting_PersonalAccessToken struct {
 	// When the token was created
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// When the token was last used
-	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Scopes granted to the token (empty = full access for legacy tokens)
+	Scopes        []string `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) Reset() {
 	*x = PersonalAccessTokensUserSetting_PersonalAccessToken{}
-	mi := &file_store_user_setting_proto_msgTypes[8]
+	mi := &file_store_user_setting_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -665,7 +1175,7 @@ func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) String() string {
 func (*PersonalAccessTokensUserSetting_PersonalAccessToken) ProtoMessage() {}
 
 func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[8]
+	mi := &file_store_user_setting_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +1188,7 @@ func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) ProtoReflect() pro
 
 // Deprecated: Use PersonalAccessTokensUserSetting_PersonalAccessToken.ProtoReflect.Descriptor instead.
 func (*PersonalAccessTokensUserSetting_PersonalAccessToken) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 0}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) GetTokenId() string {
@@ -723,6 +1233,13 @@ func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) GetLastUsedAt() *t
 	return nil
 }
 
+func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 type ShortcutsUserSetting_Shortcut struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -734,7 +1251,7 @@ type ShortcutsUserSetting_Shortcut struct {
 
 func (x *ShortcutsUserSetting_Shortcut) Reset() {
 	*x = ShortcutsUserSetting_Shortcut{}
-	mi := &file_store_user_setting_proto_msgTypes[9]
+	mi := &file_store_user_setting_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -746,7 +1263,7 @@ func (x *ShortcutsUserSetting_Shortcut) String() string {
 func (*ShortcutsUserSetting_Shortcut) ProtoMessage() {}
 
 func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[9]
+	mi := &file_store_user_setting_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,7 +1276,7 @@ func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShortcutsUserSetting_Shortcut.ProtoReflect.Descriptor instead.
 func (*ShortcutsUserSetting_Shortcut) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{4, 0}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *ShortcutsUserSetting_Shortcut) GetId() string {
@@ -790,14 +1307,29 @@ type WebhooksUserSetting_Webhook struct {
 	// Descriptive title for the webhook
 	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	// The webhook URL endpoint
-	Url           string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// The secret used to sign deliveries with HMAC-SHA256.
+	// Webhooks created before signing was introduced have no secret until it is rotated.
+	Secret string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Whether deliveries are paused, set after too many consecutive failed deliveries.
+	Disabled bool `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// The number of consecutive deliveries that ran out of attempts.
+	ConsecutiveFailures int32 `protobuf:"varint,6,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// The event types delivered to the webhook, e.g. memos.memo.created.
+	// Empty means all event types.
+	EventTypes []string `protobuf:"bytes,7,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// Optional CEL filter the memo of an event must match to be delivered.
+	Filter string `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The payload format of deliveries: empty for the memos JSON payload,
+	// or one of "slack", "discord", "teams" and "telegram".
+	Format        string `protobuf:"bytes,9,opt,name=format,proto3" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WebhooksUserSetting_Webhook) Reset() {
 	*x = WebhooksUserSetting_Webhook{}
-	mi := &file_store_user_setting_proto_msgTypes[10]
+	mi := &file_store_user_setting_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -809,7 +1341,7 @@ func (x *WebhooksUserSetting_Webhook) String() string {
 func (*WebhooksUserSetting_Webhook) ProtoMessage() {}
 
 func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[10]
+	mi := &file_store_user_setting_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +1354,7 @@ func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebhooksUserSetting_Webhook.ProtoReflect.Descriptor instead.
 func (*WebhooksUserSetting_Webhook) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{5, 0}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *WebhooksUserSetting_Webhook) GetId() string {
@@ -846,11 +1378,53 @@ func (x *WebhooksUserSetting_Webhook) GetUrl() string {
 	return ""
 }
 
+func (x *WebhooksUserSetting_Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhooksUserSetting_Webhook) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *WebhooksUserSetting_Webhook) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *WebhooksUserSetting_Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhooksUserSetting_Webhook) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *WebhooksUserSetting_Webhook) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
 var File_store_user_setting_proto protoreflect.FileDescriptor
 
 const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcb\x04\n" +
+	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x11store/inbox.proto\"\xac\x06\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12.\n" +
 	"\x03key\x18\x02 \x01(\x0e2\x1c.memos.store.UserSetting.KeyR\x03key\x12;\n" +
@@ -858,21 +1432,63 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\tshortcuts\x18\x06 \x01(\v2!.memos.store.ShortcutsUserSettingH\x00R\tshortcuts\x12>\n" +
 	"\bwebhooks\x18\a \x01(\v2 .memos.store.WebhooksUserSettingH\x00R\bwebhooks\x12N\n" +
 	"\x0erefresh_tokens\x18\b \x01(\v2%.memos.store.RefreshTokensUserSettingH\x00R\rrefreshTokens\x12d\n" +
-	"\x16personal_access_tokens\x18\t \x01(\v2,.memos.store.PersonalAccessTokensUserSettingH\x00R\x14personalAccessTokens\"t\n" +
+	"\x16personal_access_tokens\x18\t \x01(\v2,.memos.store.PersonalAccessTokensUserSettingH\x00R\x14personalAccessTokens\x12J\n" +
+	"\fnotification\x18\n" +
+	" \x01(\v2$.memos.store.NotificationUserSettingH\x00R\fnotification\x12d\n" +
+	"\x16web_push_subscriptions\x18\v \x01(\v2,.memos.store.WebPushSubscriptionsUserSettingH\x00R\x14webPushSubscriptions\"\xa2\x01\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\r\n" +
 	"\tSHORTCUTS\x10\x04\x12\f\n" +
 	"\bWEBHOOKS\x10\x05\x12\x12\n" +
 	"\x0eREFRESH_TOKENS\x10\x06\x12\x1a\n" +
-	"\x16PERSONAL_ACCESS_TOKENS\x10\aB\a\n" +
-	"\x05value\"k\n" +
+	"\x16PERSONAL_ACCESS_TOKENS\x10\a\x12\x10\n" +
+	"\fNOTIFICATION\x10\b\x12\x1a\n" +
+	"\x16WEB_PUSH_SUBSCRIPTIONS\x10\tB\a\n" +
+	"\x05value\"\xc5\x01\n" +
 	"\x12GeneralUserSetting\x12\x16\n" +
 	"\x06locale\x18\x01 \x01(\tR\x06locale\x12'\n" +
 	"\x0fmemo_visibility\x18\x02 \x01(\tR\x0ememoVisibility\x12\x14\n" +
-	"\x05theme\x18\x03 \x01(\tR\x05theme\"\xa4\x04\n" +
+	"\x05theme\x18\x03 \x01(\tR\x05theme\x12X\n" +
+	"\x18muted_notification_types\x18\x04 \x03(\x0e2\x1e.memos.store.InboxMessage.TypeR\x16mutedNotificationTypes\"\xf3\x05\n" +
+	"\x17NotificationUserSetting\x12Q\n" +
+	"\vpreferences\x18\x01 \x03(\v2/.memos.store.NotificationUserSetting.PreferenceR\vpreferences\x12(\n" +
+	"\x10hourly_digest_ts\x18\x02 \x01(\x03R\x0ehourlyDigestTs\x12&\n" +
+	"\x0fdaily_digest_ts\x18\x03 \x01(\x03R\rdailyDigestTs\x12H\n" +
+	"\bchannels\x18\x04 \x03(\v2,.memos.store.NotificationUserSetting.ChannelR\bchannels\x12!\n" +
+	"\fweekly_recap\x18\x05 \x01(\bR\vweeklyRecap\x1a\x9b\x01\n" +
+	"\n" +
+	"Preference\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12Y\n" +
+	"\x0eemail_delivery\x18\x02 \x01(\x0e22.memos.store.NotificationUserSetting.EmailDeliveryR\remailDelivery\x1a\xb9\x01\n" +
+	"\aChannel\x12E\n" +
+	"\x04type\x18\x01 \x01(\x0e21.memos.store.NotificationUserSetting.Channel.TypeR\x04type\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"?\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04NTFY\x10\x01\x12\n" +
+	"\n" +
+	"\x06GOTIFY\x10\x02\x12\v\n" +
+	"\aAPPRISE\x10\x03\"l\n" +
+	"\rEmailDelivery\x12\x1e\n" +
+	"\x1aEMAIL_DELIVERY_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tIMMEDIATE\x10\x01\x12\x11\n" +
+	"\rHOURLY_DIGEST\x10\x02\x12\x10\n" +
+	"\fDAILY_DIGEST\x10\x03\x12\a\n" +
+	"\x03OFF\x10\x04\"\xca\x02\n" +
+	"\x1fWebPushSubscriptionsUserSetting\x12_\n" +
+	"\rsubscriptions\x18\x01 \x03(\v29.memos.store.WebPushSubscriptionsUserSetting.SubscriptionR\rsubscriptions\x1a\xc5\x01\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06p256dh\x18\x03 \x01(\tR\x06p256dh\x12\x12\n" +
+	"\x04auth\x18\x04 \x01(\tR\x04auth\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\x84\x05\n" +
 	"\x18RefreshTokensUserSetting\x12Y\n" +
-	"\x0erefresh_tokens\x18\x01 \x03(\v22.memos.store.RefreshTokensUserSetting.RefreshTokenR\rrefreshTokens\x1a\x94\x02\n" +
+	"\x0erefresh_tokens\x18\x01 \x03(\v22.memos.store.RefreshTokensUserSetting.RefreshTokenR\rrefreshTokens\x1a\xf4\x02\n" +
 	"\fRefreshToken\x12\x19\n" +
 	"\btoken_id\x18\x01 \x01(\tR\atokenId\x129\n" +
 	"\n" +
@@ -881,7 +1497,11 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12Q\n" +
 	"\vclient_info\x18\x04 \x01(\v20.memos.store.RefreshTokensUserSetting.ClientInfoR\n" +
 	"clientInfo\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\x1a\x95\x01\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12<\n" +
+	"\flast_seen_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x12 \n" +
+	"\flast_seen_ip\x18\a \x01(\tR\n" +
+	"lastSeenIp\x1a\x95\x01\n" +
 	"\n" +
 	"ClientInfo\x12\x1d\n" +
 	"\n" +
@@ -891,9 +1511,9 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\vdevice_type\x18\x03 \x01(\tR\n" +
 	"deviceType\x12\x0e\n" +
 	"\x02os\x18\x04 \x01(\tR\x02os\x12\x18\n" +
-	"\abrowser\x18\x05 \x01(\tR\abrowser\"\xa3\x03\n" +
+	"\abrowser\x18\x05 \x01(\tR\abrowser\"\xbb\x03\n" +
 	"\x1fPersonalAccessTokensUserSetting\x12X\n" +
-	"\x06tokens\x18\x01 \x03(\v2@.memos.store.PersonalAccessTokensUserSetting.PersonalAccessTokenR\x06tokens\x1a\xa5\x02\n" +
+	"\x06tokens\x18\x01 \x03(\v2@.memos.store.PersonalAccessTokensUserSetting.PersonalAccessTokenR\x06tokens\x1a\xbd\x02\n" +
 	"\x13PersonalAccessToken\x12\x19\n" +
 	"\btoken_id\x18\x01 \x01(\tR\atokenId\x12\x1d\n" +
 	"\n" +
@@ -904,19 +1524,27 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
 	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"lastUsedAt\"\xaa\x01\n" +
+	"lastUsedAt\x12\x16\n" +
+	"\x06scopes\x18\a \x03(\tR\x06scopes\"\xaa\x01\n" +
 	"\x14ShortcutsUserSetting\x12H\n" +
 	"\tshortcuts\x18\x01 \x03(\v2*.memos.store.ShortcutsUserSetting.ShortcutR\tshortcuts\x1aH\n" +
 	"\bShortcut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
-	"\x06filter\x18\x03 \x01(\tR\x06filter\"\x9e\x01\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\"\xd7\x02\n" +
 	"\x13WebhooksUserSetting\x12D\n" +
-	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\x1aA\n" +
+	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\x1a\xf9\x01\n" +
 	"\aWebhook\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03urlB\x9b\x01\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1a\n" +
+	"\bdisabled\x18\x05 \x01(\bR\bdisabled\x121\n" +
+	"\x14consecutive_failures\x18\x06 \x01(\x05R\x13consecutiveFailures\x12\x1f\n" +
+	"\vevent_types\x18\a \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06filter\x18\b \x01(\tR\x06filter\x12\x16\n" +
+	"\x06format\x18\t \x01(\tR\x06formatB\x9b\x01\n" +
 	"\x0fcom.memos.storeB\x10UserSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -931,45 +1559,64 @@ func file_store_user_setting_proto_rawDescGZIP() []byte {
 	return file_store_user_setting_proto_rawDescData
 }
 
-var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_store_user_setting_proto_goTypes = []any{
 	(UserSetting_Key)(0),                                        // 0: memos.store.UserSetting.Key
-	(*UserSetting)(nil),                                         // 1: memos.store.UserSetting
-	(*GeneralUserSetting)(nil),                                  // 2: memos.store.GeneralUserSetting
-	(*RefreshTokensUserSetting)(nil),                            // 3: memos.store.RefreshTokensUserSetting
-	(*PersonalAccessTokensUserSetting)(nil),                     // 4: memos.store.PersonalAccessTokensUserSetting
-	(*ShortcutsUserSetting)(nil),                                // 5: memos.store.ShortcutsUserSetting
-	(*WebhooksUserSetting)(nil),                                 // 6: memos.store.WebhooksUserSetting
-	(*RefreshTokensUserSetting_RefreshToken)(nil),               // 7: memos.store.RefreshTokensUserSetting.RefreshToken
-	(*RefreshTokensUserSetting_ClientInfo)(nil),                 // 8: memos.store.RefreshTokensUserSetting.ClientInfo
-	(*PersonalAccessTokensUserSetting_PersonalAccessToken)(nil), // 9: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken
-	(*ShortcutsUserSetting_Shortcut)(nil),                       // 10: memos.store.ShortcutsUserSetting.Shortcut
-	(*WebhooksUserSetting_Webhook)(nil),                         // 11: memos.store.WebhooksUserSetting.Webhook
-	(*timestamppb.Timestamp)(nil),                               // 12: google.protobuf.Timestamp
+	(NotificationUserSetting_EmailDelivery)(0),                  // 1: memos.store.NotificationUserSetting.EmailDelivery
+	(NotificationUserSetting_Channel_Type)(0),                   // 2: memos.store.NotificationUserSetting.Channel.Type
+	(*UserSetting)(nil),                                         // 3: memos.store.UserSetting
+	(*GeneralUserSetting)(nil),                                  // 4: memos.store.GeneralUserSetting
+	(*NotificationUserSetting)(nil),                             // 5: memos.store.NotificationUserSetting
+	(*WebPushSubscriptionsUserSetting)(nil),                     // 6: memos.store.WebPushSubscriptionsUserSetting
+	(*RefreshTokensUserSetting)(nil),                            // 7: memos.store.RefreshTokensUserSetting
+	(*PersonalAccessTokensUserSetting)(nil),                     // 8: memos.store.PersonalAccessTokensUserSetting
+	(*ShortcutsUserSetting)(nil),                                // 9: memos.store.ShortcutsUserSetting
+	(*WebhooksUserSetting)(nil),                                 // 10: memos.store.WebhooksUserSetting
+	(*NotificationUserSetting_Preference)(nil),                  // 11: memos.store.NotificationUserSetting.Preference
+	(*NotificationUserSetting_Channel)(nil),                     // 12: memos.store.NotificationUserSetting.Channel
+	(*WebPushSubscriptionsUserSetting_Subscription)(nil),        // 13: memos.store.WebPushSubscriptionsUserSetting.Subscription
+	(*RefreshTokensUserSetting_RefreshToken)(nil),               // 14: memos.store.RefreshTokensUserSetting.RefreshToken
+	(*RefreshTokensUserSetting_ClientInfo)(nil),                 // 15: memos.store.RefreshTokensUserSetting.ClientInfo
+	(*PersonalAccessTokensUserSetting_PersonalAccessToken)(nil), // 16: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken
+	(*ShortcutsUserSetting_Shortcut)(nil),                       // 17: memos.store.ShortcutsUserSetting.Shortcut
+	(*WebhooksUserSetting_Webhook)(nil),                         // 18: memos.store.WebhooksUserSetting.Webhook
+	(InboxMessage_Type)(0),                                      // 19: memos.store.InboxMessage.Type
+	(*timestamppb.Timestamp)(nil),                               // 20: google.protobuf.Timestamp
 }
 var file_store_user_setting_proto_depIdxs = []int32{
 	0,  // 0: memos.store.UserSetting.key:type_name -> memos.store.UserSetting.Key
-	2,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
-	5,  // 2: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
-	6,  // 3: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
-	3,  // 4: memos.store.UserSetting.refresh_tokens:type_name -> memos.store.RefreshTokensUserSetting
-	4,  // 5: memos.store.UserSetting.personal_access_tokens:type_name -> memos.store.PersonalAccessTokensUserSetting
-	7,  // 6: memos.store.RefreshTokensUserSetting.refresh_tokens:type_name -> memos.store.RefreshTokensUserSetting.RefreshToken
-	9,  // 7: memos.store.PersonalAccessTokensUserSetting.tokens:type_name -> memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken
-	10, // 8: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
-	11, // 9: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
-	12, // 10: memos.store.RefreshTokensUserSetting.RefreshToken.expires_at:type_name -> google.protobuf.Timestamp
-	12, // 11: memos.store.RefreshTokensUserSetting.RefreshToken.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 12: memos.store.RefreshTokensUserSetting.RefreshToken.client_info:type_name -> memos.store.RefreshTokensUserSetting.ClientInfo
-	12, // 13: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.expires_at:type_name -> google.protobuf.Timestamp
-	12, // 14: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.created_at:type_name -> google.protobuf.Timestamp
-	12, // 15: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	4,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
+	9,  // 2: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
+	10, // 3: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
+	7,  // 4: memos.store.UserSetting.refresh_tokens:type_name -> memos.store.RefreshTokensUserSetting
+	8,  // 5: memos.store.UserSetting.personal_access_tokens:type_name -> memos.store.PersonalAccessTokensUserSetting
+	5,  // 6: memos.store.UserSetting.notification:type_name -> memos.store.NotificationUserSetting
+	6,  // 7: memos.store.UserSetting.web_push_subscriptions:type_name -> memos.store.WebPushSubscriptionsUserSetting
+	19, // 8: memos.store.GeneralUserSetting.muted_notification_types:type_name -> memos.store.InboxMessage.Type
+	11, // 9: memos.store.NotificationUserSetting.preferences:type_name -> memos.store.NotificationUserSetting.Preference
+	12, // 10: memos.store.NotificationUserSetting.channels:type_name -> memos.store.NotificationUserSetting.Channel
+	13, // 11: memos.store.WebPushSubscriptionsUserSetting.subscriptions:type_name -> memos.store.WebPushSubscriptionsUserSetting.Subscription
+	14, // 12: memos.store.RefreshTokensUserSetting.refresh_tokens:type_name -> memos.store.RefreshTokensUserSetting.RefreshToken
+	16, // 13: memos.store.PersonalAccessTokensUserSetting.tokens:type_name -> memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken
+	17, // 14: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
+	18, // 15: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
+	19, // 16: memos.store.NotificationUserSetting.Preference.type:type_name -> memos.store.InboxMessage.Type
+	1,  // 17: memos.store.NotificationUserSetting.Preference.email_delivery:type_name -> memos.store.NotificationUserSetting.EmailDelivery
+	2,  // 18: memos.store.NotificationUserSetting.Channel.type:type_name -> memos.store.NotificationUserSetting.Channel.Type
+	20, // 19: memos.store.WebPushSubscriptionsUserSetting.Subscription.create_time:type_name -> google.protobuf.Timestamp
+	20, // 20: memos.store.RefreshTokensUserSetting.RefreshToken.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 21: memos.store.RefreshTokensUserSetting.RefreshToken.created_at:type_name -> google.protobuf.Timestamp
+	15, // 22: memos.store.RefreshTokensUserSetting.RefreshToken.client_info:type_name -> memos.store.RefreshTokensUserSetting.ClientInfo
+	20, // 23: memos.store.RefreshTokensUserSetting.RefreshToken.last_seen_at:type_name -> google.protobuf.Timestamp
+	20, // 24: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 25: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.created_at:type_name -> google.protobuf.Timestamp
+	20, // 26: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_store_user_setting_proto_init() }
@@ -977,20 +1624,23 @@ func file_store_user_setting_proto_init() {
 	if File_store_user_setting_proto != nil {
 		return
 	}
+	file_store_inbox_proto_init()
 	file_store_user_setting_proto_msgTypes[0].OneofWrappers = []any{
 		(*UserSetting_General)(nil),
 		(*UserSetting_Shortcuts)(nil),
 		(*UserSetting_Webhooks)(nil),
 		(*UserSetting_RefreshTokens)(nil),
 		(*UserSetting_PersonalAccessTokens)(nil),
+		(*UserSetting_Notification)(nil),
+		(*UserSetting_WebPushSubscriptions)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_user_setting_proto_rawDesc), len(file_store_user_setting_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+const (
+	// PasswordResetTokenAudienceName is the audience claim for password reset tokens.
+	PasswordResetTokenAudienceName = "user.password-reset"
+
+	// PasswordResetTokenDuration is the lifetime of password reset tokens (1 hour).
+	PasswordResetTokenDuration = time.Hour
+
+	// EmailVerificationTokenAudienceName is the audience claim for email verification tokens.
+	EmailVerificationTokenAudienceName = "user.email-verification"
+
+	// EmailVerificationTokenDuration is the lifetime of email verification tokens (24 hours).
+	EmailVerificationTokenDuration = 24 * time.Hour
+
+	// MemoShareAccessTokenAudienceName is the audience claim for memo share access tokens.
+	MemoShareAccessTokenAudienceName = "memo.share-access"
+
+	// MemoShareAccessTokenDuration is the lifetime of memo share access tokens (1 hour).
+	MemoShareAccessTokenDuration = time.Hour
+)
+
+// UserActionTokenClaims contains claims for single-purpose tokens sent by email.
+// The fingerprint binds a token to the state it acts on, e.g. the current password hash,
+// so the token stops working once it has been used or that state changes.
+type UserActionTokenClaims struct {
+	Type        string `json:"type"` // "password-reset", "email-verification" or "memo-share-access"
+	Fingerprint string `json:"fp"`
+	jwt.RegisteredClaims
+}
+
+// GeneratePasswordResetToken generates a token for resetting the password of a user.
+// The fingerprint should be derived from the current password hash.
+func GeneratePasswordResetToken(userID int32, fingerprint string, secret []byte) (string, time.Time, error) {
+	return generateUserActionToken(userID, "password-reset", PasswordResetTokenAudienceName, fingerprint, PasswordResetTokenDuration, secret)
+}
+
+// ParsePasswordResetToken parses and validates a password reset token.
+func ParsePasswordResetToken(tokenString string, secret []byte) (*UserActionTokenClaims, error) {
+	return parseUserActionToken(tokenString, "password-reset", PasswordResetTokenAudienceName, secret)
+}
+
+// GenerateEmailVerificationToken generates a token for verifying the email of a user.
+// The fingerprint should be derived from the email being verified.
+func GenerateEmailVerificationToken(userID int32, fingerprint string, secret []byte) (string, time.Time, error) {
+	return generateUserActionToken(userID, "email-verification", EmailVerificationTokenAudienceName, fingerprint, EmailVerificationTokenDuration, secret)
+}
+
+// ParseEmailVerificationToken parses and validates an email verification token.
+func ParseEmailVerificationToken(tokenString string, secret []byte) (*UserActionTokenClaims, error) {
+	return parseUserActionToken(tokenString, "email-verification", EmailVerificationTokenAudienceName, secret)
+}
+
+// GenerateMemoShareAccessToken generates a token that lets the browser which unlocked a
+// password-protected memo share load the share's attachments. Its subject is the share ID,
+// and the fingerprint should be derived from the share password hash.
+func GenerateMemoShareAccessToken(shareID int32, fingerprint string, secret []byte) (string, time.Time, error) {
+	return generateUserActionToken(shareID, "memo-share-access", MemoShareAccessTokenAudienceName, fingerprint, MemoShareAccessTokenDuration, secret)
+}
+
+// ParseMemoShareAccessToken parses and validates a memo share access token.
+func ParseMemoShareAccessToken(tokenString string, secret []byte) (*UserActionTokenClaims, error) {
+	return parseUserActionToken(tokenString, "memo-share-access", MemoShareAccessTokenAudienceName, secret)
+}
+
+// MemoShareAccessCookieName returns the name of the cookie holding the access token of a memo share.
+func MemoShareAccessCookieName(shareID int32) string {
+	return fmt.Sprintf("memos_share_%d", shareID)
+}
+
+// Fingerprint returns a short, non-reversible digest of a value for binding tokens to it.
+func Fingerprint(value string) string {
+	hash := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(hash[:16])
+}
+
+func generateUserActionToken(subjectID int32, tokenType, audience, fingerprint string, duration time.Duration, secret []byte) (string, time.Time, error) {
+	expiresAt := time.Now().Add(duration)
+
+	claims := &UserActionTokenClaims{
+		Type:        tokenType,
+		Fingerprint: fingerprint,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{audience},
+			Subject:   fmt.Sprint(subjectID),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = KeyID
+
+	tokenString, err := token.SignedString(secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return tokenString, expiresAt, nil
+}
+
+func parseUserActionToken(tokenString, tokenType, audience string, secret []byte) (*UserActionTokenClaims, error) {
+	claims := &UserActionTokenClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, verifyJWTKeyFunc(secret),
+		jwt.WithIssuer(Issuer),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Type != tokenType {
+		return nil, errors.Errorf("invalid token type: expected %s token", tokenType)
+	}
+	return claims, nil
+}
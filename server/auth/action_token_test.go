@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordResetToken(t *testing.T) {
+	secret := []byte("test-secret")
+	fingerprint := Fingerprint("password-hash")
+
+	t.Run("parses valid token", func(t *testing.T) {
+		token, _, err := GeneratePasswordResetToken(1, fingerprint, secret)
+		require.NoError(t, err)
+
+		claims, err := ParsePasswordResetToken(token, secret)
+		require.NoError(t, err)
+		assert.Equal(t, "1", claims.Subject)
+		assert.Equal(t, fingerprint, claims.Fingerprint)
+	})
+
+	t.Run("fails with wrong secret", func(t *testing.T) {
+		token, _, err := GeneratePasswordResetToken(1, fingerprint, secret)
+		require.NoError(t, err)
+
+		_, err = ParsePasswordResetToken(token, []byte("wrong-secret"))
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects other token kinds", func(t *testing.T) {
+		verificationToken, _, err := GenerateEmailVerificationToken(1, fingerprint, secret)
+		require.NoError(t, err)
+		_, err = ParsePasswordResetToken(verificationToken, secret)
+		assert.Error(t, err)
+
+		accessToken, _, err := GenerateAccessTokenV2(1, "testuser", "USER", "ACTIVE", secret)
+		require.NoError(t, err)
+		_, err = ParsePasswordResetToken(accessToken, secret)
+		assert.Error(t, err)
+	})
+}
+
+func TestEmailVerificationToken(t *testing.T) {
+	secret := []byte("test-secret")
+
+	token, _, err := GenerateEmailVerificationToken(2, Fingerprint("alice@example.com"), secret)
+	require.NoError(t, err)
+
+	claims, err := ParseEmailVerificationToken(token, secret)
+	require.NoError(t, err)
+	assert.Equal(t, "2", claims.Subject)
+	assert.Equal(t, Fingerprint("alice@example.com"), claims.Fingerprint)
+	assert.NotEqual(t, Fingerprint("bob@example.com"), claims.Fingerprint)
+
+	_, err = ParsePasswordResetToken(token, secret)
+	assert.Error(t, err)
+}
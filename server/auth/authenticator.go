@@ -21,6 +21,7 @@ import (
 // Authentication methods:
 // - JWT access tokens: Short-lived tokens (15 minutes) for API access
 // - Personal Access Tokens (PAT): Long-lived tokens for programmatic access
+// - OAuth2 access tokens: JWT access tokens carrying the scopes granted to a client
 //
 // This struct is safe for concurrent use.
 type Authenticator struct {
@@ -54,6 +55,8 @@ func (a *Authenticator) AuthenticateByAccessTokenV2(accessToken string) (*UserCl
 		Username: claims.Username,
 		Role:     claims.Role,
 		Status:   claims.Status,
+		ClientID: claims.ClientID,
+		Scopes:   strings.Fields(claims.Scope),
 	}, nil
 }
 
@@ -128,6 +131,7 @@ type AuthResult struct {
 	User        *store.User // Set for PAT authentication
 	Claims      *UserClaims // Set for Access Token V2 (stateless)
 	AccessToken string      // Non-empty if authenticated via JWT
+	Scopes      []string    // Set for scoped PATs and OAuth tokens; empty means unrestricted
 }
 
 // AuthenticateToUser resolves the current request to a *store.User, checking the
@@ -188,6 +192,7 @@ func (a *Authenticator) Authenticate(ctx context.Context, authHeader string) *Au
 			return &AuthResult{
 				Claims:      claims,
 				AccessToken: token,
+				Scopes:      claims.Scopes,
 			}
 		}
 	}
@@ -202,7 +207,7 @@ func (a *Authenticator) Authenticate(ctx context.Context, authHeader string) *Au
 					slog.Warn("failed to update PAT last used time", "error", err, "userID", user.ID)
 				}
 			}()
-			return &AuthResult{User: user, AccessToken: token}
+			return &AuthResult{User: user, AccessToken: token, Scopes: pat.Scopes}
 		}
 	}
 
@@ -25,6 +25,10 @@ const (
 
 	// RefreshTokenIDContextKey stores the refresh token ID.
 	RefreshTokenIDContextKey
+
+	// ScopesContextKey stores the scopes of a restricted token.
+	// Only set when authenticated via a scoped PAT or an OAuth access token.
+	ScopesContextKey
 )
 
 // GetUserID retrieves the authenticated user's ID from the context.
@@ -66,6 +70,9 @@ type UserClaims struct {
 	Username string
 	Role     string
 	Status   string
+	// ClientID and Scopes are set when the token was issued to an OAuth client.
+	ClientID string
+	Scopes   []string
 }
 
 // GetUserClaims retrieves the user claims from context.
@@ -95,5 +102,8 @@ func ApplyToContext(ctx context.Context, result *AuthResult) context.Context {
 	} else if result.User != nil {
 		ctx = SetUserInContext(ctx, result.User, result.AccessToken)
 	}
+	if len(result.Scopes) > 0 {
+		ctx = context.WithValue(ctx, ScopesContextKey, result.Scopes)
+	}
 	return ctx
 }
@@ -21,11 +21,16 @@ func ExtractBearerToken(authHeader string) string {
 
 // ExtractRefreshTokenFromCookie extracts the refresh token from cookie header.
 func ExtractRefreshTokenFromCookie(cookieHeader string) string {
+	return ExtractCookie(cookieHeader, RefreshTokenCookieName)
+}
+
+// ExtractCookie extracts the value of the named cookie from a cookie header.
+func ExtractCookie(cookieHeader, name string) string {
 	if cookieHeader == "" {
 		return ""
 	}
 	req := &http.Request{Header: http.Header{"Cookie": []string{cookieHeader}}}
-	cookie, err := req.Cookie(RefreshTokenCookieName)
+	cookie, err := req.Cookie(name)
 	if err != nil {
 		return ""
 	}
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/util"
+)
+
+const (
+	// OAuthAuthorizationCodeDuration is the lifetime of OAuth authorization codes (10 minutes).
+	OAuthAuthorizationCodeDuration = 10 * time.Minute
+
+	// OAuthRefreshTokenDuration is the lifetime of OAuth refresh tokens (30 days).
+	// Refresh tokens are rotated on every use.
+	OAuthRefreshTokenDuration = 30 * 24 * time.Hour
+
+	// OAuthClientSecretPrefix is the prefix for OAuth client secrets.
+	OAuthClientSecretPrefix = "memos_cs_"
+
+	// OAuthRefreshTokenPrefix is the prefix for OAuth refresh tokens.
+	OAuthRefreshTokenPrefix = "memos_ort_"
+
+	// PKCEMethodS256 is the only supported PKCE code challenge method.
+	PKCEMethodS256 = "S256"
+)
+
+// OpenID Connect scopes. They control which claims the userinfo endpoint returns
+// and do not grant access to any API.
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+)
+
+// OAuthScopes lists every scope an OAuth client can request.
+var OAuthScopes = append([]string{ScopeOpenID, ScopeProfile, ScopeEmail}, AllScopes...)
+
+// DefaultOAuthScopes are granted when neither the request nor the client specify scopes.
+var DefaultOAuthScopes = []string{ScopeMemosRead}
+
+// IsOAuthIdentityScope reports whether the scope only releases OpenID Connect claims.
+func IsOAuthIdentityScope(scope string) bool {
+	return scope == ScopeOpenID || scope == ScopeProfile || scope == ScopeEmail
+}
+
+// OAuthClientAllowsScope reports whether a client may request the scope. Identity scopes are
+// always allowed. A client registered without scopes may request every API scope except admin.
+func OAuthClientAllowsScope(registered []string, scope string) bool {
+	if IsOAuthIdentityScope(scope) {
+		return true
+	}
+	if len(registered) == 0 {
+		return scope != ScopeAdmin
+	}
+	return slices.Contains(registered, scope)
+}
+
+// NormalizeOAuthScopes validates the requested OAuth scopes and returns them
+// deduplicated and in canonical order.
+func NormalizeOAuthScopes(scopes []string) ([]string, error) {
+	requested := map[string]bool{}
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if scope == "" {
+			continue
+		}
+		if !slices.Contains(OAuthScopes, scope) {
+			return nil, errors.Errorf("unknown scope %q", scope)
+		}
+		requested[scope] = true
+	}
+	normalized := []string{}
+	for _, scope := range OAuthScopes {
+		if requested[scope] {
+			normalized = append(normalized, scope)
+		}
+	}
+	return normalized, nil
+}
+
+// GenerateOAuthClientSecret generates a random client secret for a confidential OAuth client.
+func GenerateOAuthClientSecret() (string, error) {
+	randomStr, err := util.RandomString(40)
+	if err != nil {
+		return "", err
+	}
+	return OAuthClientSecretPrefix + randomStr, nil
+}
+
+// GenerateOAuthAuthorizationCode generates a random single-use authorization code.
+func GenerateOAuthAuthorizationCode() (string, error) {
+	return util.RandomString(40)
+}
+
+// GenerateOAuthRefreshToken generates a random OAuth refresh token.
+func GenerateOAuthRefreshToken() (string, error) {
+	randomStr, err := util.RandomString(40)
+	if err != nil {
+		return "", err
+	}
+	return OAuthRefreshTokenPrefix + randomStr, nil
+}
+
+// HashOAuthSecret returns the SHA-256 hash of an OAuth client secret, authorization code or refresh token.
+// Only hashes are persisted.
+func HashOAuthSecret(secret string) string {
+	return HashPersonalAccessToken(secret)
+}
+
+// VerifyOAuthClientSecret reports whether the secret matches the stored hash in constant time.
+func VerifyOAuthClientSecret(secret, hash string) bool {
+	if secret == "" || hash == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(HashOAuthSecret(secret)), []byte(hash)) == 1
+}
+
+// VerifyPKCE checks a PKCE code verifier against the S256 code challenge (RFC 7636).
+func VerifyPKCE(verifier, challenge string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 || challenge == "" {
+		return false
+	}
+	hash := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(hash[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
+
+// ValidateOAuthRedirectURI checks that a redirect URI can be registered for an OAuth client.
+// HTTPS URIs are always allowed, plain HTTP only for loopback hosts (RFC 8252),
+// and private-use schemes such as com.example.app:/callback for native apps.
+func ValidateOAuthRedirectURI(rawURI string) error {
+	u, err := url.Parse(rawURI)
+	if err != nil {
+		return errors.Wrap(err, "invalid redirect URI")
+	}
+	if u.Scheme == "" {
+		return errors.Errorf("redirect URI %q must be absolute", rawURI)
+	}
+	if u.Fragment != "" {
+		return errors.Errorf("redirect URI %q must not contain a fragment", rawURI)
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "https":
+		if u.Host == "" {
+			return errors.Errorf("redirect URI %q must have a host", rawURI)
+		}
+	case "http":
+		if !isLoopbackHost(u.Hostname()) {
+			return errors.Errorf("redirect URI %q must use https unless it targets a loopback address", rawURI)
+		}
+	case "javascript", "data", "file", "vbscript":
+		return errors.Errorf("redirect URI scheme %q is not allowed", u.Scheme)
+	}
+	return nil
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeOAuthScopes(t *testing.T) {
+	scopes, err := NormalizeOAuthScopes([]string{"memos:write", "openid", "email", "openid"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ScopeOpenID, ScopeEmail, ScopeMemosWrite}, scopes)
+
+	_, err = NormalizeOAuthScopes([]string{"offline_access"})
+	assert.Error(t, err)
+}
+
+func TestVerifyPKCE(t *testing.T) {
+	verifier := strings.Repeat("a", 43)
+	hash := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(hash[:])
+
+	assert.True(t, VerifyPKCE(verifier, challenge))
+	assert.False(t, VerifyPKCE(strings.Repeat("b", 43), challenge))
+	assert.False(t, VerifyPKCE("short", challenge))
+	assert.False(t, VerifyPKCE(verifier, ""))
+}
+
+func TestOAuthAccessToken(t *testing.T) {
+	secret := []byte("test-secret")
+
+	token, _, err := GenerateOAuthAccessToken(1, "testuser", "USER", "NORMAL", "client-1", []string{ScopeOpenID, ScopeMemosRead}, secret)
+	require.NoError(t, err)
+
+	claims, err := ParseAccessTokenV2(token, secret)
+	require.NoError(t, err)
+	assert.Equal(t, "client-1", claims.ClientID)
+	assert.Equal(t, "openid memos:read", claims.Scope)
+
+	authenticator := NewAuthenticator(nil, string(secret))
+	userClaims, err := authenticator.AuthenticateByAccessTokenV2(token)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), userClaims.UserID)
+	assert.Equal(t, "client-1", userClaims.ClientID)
+	assert.Equal(t, []string{ScopeOpenID, ScopeMemosRead}, userClaims.Scopes)
+}
+
+func TestOAuthClientSecret(t *testing.T) {
+	secret, err := GenerateOAuthClientSecret()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, OAuthClientSecretPrefix))
+
+	hash := HashOAuthSecret(secret)
+	assert.True(t, VerifyOAuthClientSecret(secret, hash))
+	assert.False(t, VerifyOAuthClientSecret(secret+"x", hash))
+	assert.False(t, VerifyOAuthClientSecret(secret, ""))
+}
+
+func TestValidateOAuthRedirectURI(t *testing.T) {
+	for _, uri := range []string{
+		"https://app.example.com/callback",
+		"http://localhost:3000/callback",
+		"http://127.0.0.1/callback",
+		"http://[::1]:8080/callback",
+		"com.example.app:/oauth",
+	} {
+		assert.NoError(t, ValidateOAuthRedirectURI(uri), uri)
+	}
+	for _, uri := range []string{
+		"",
+		"/relative",
+		"http://app.example.com/callback",
+		"https://app.example.com/callback#fragment",
+		"javascript:alert(1)",
+	} {
+		assert.Error(t, ValidateOAuthRedirectURI(uri), uri)
+	}
+}
+
+func TestOAuthClientAllowsScope(t *testing.T) {
+	assert.True(t, OAuthClientAllowsScope([]string{ScopeMemosWrite}, ScopeMemosWrite))
+	assert.True(t, OAuthClientAllowsScope([]string{ScopeMemosWrite}, ScopeOpenID))
+	assert.False(t, OAuthClientAllowsScope([]string{ScopeMemosWrite}, ScopeAdmin))
+	assert.False(t, OAuthClientAllowsScope([]string{ScopeMemosWrite}, ScopeSettingsRead))
+	assert.True(t, OAuthClientAllowsScope(nil, ScopeMemosWrite))
+	assert.False(t, OAuthClientAllowsScope(nil, ScopeAdmin))
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Personal Access Token scopes.
+//
+// A token with no scopes is a legacy full-access token. A write scope implies
+// the matching read scope, and ScopeAdmin implies every other scope.
+const (
+	ScopeMemosRead        = "memos:read"
+	ScopeMemosWrite       = "memos:write"
+	ScopeAttachmentsRead  = "attachments:read"
+	ScopeAttachmentsWrite = "attachments:write"
+	ScopeSettingsRead     = "settings:read"
+	ScopeSettingsWrite    = "settings:write"
+	ScopeAdmin            = "admin"
+)
+
+// AllScopes lists every scope that can be granted to a Personal Access Token.
+var AllScopes = []string{
+	ScopeMemosRead,
+	ScopeMemosWrite,
+	ScopeAttachmentsRead,
+	ScopeAttachmentsWrite,
+	ScopeSettingsRead,
+	ScopeSettingsWrite,
+	ScopeAdmin,
+}
+
+// NormalizeScopes validates the requested scopes and returns them trimmed,
+// deduplicated and in canonical order.
+func NormalizeScopes(scopes []string) ([]string, error) {
+	requested := map[string]bool{}
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if scope == "" {
+			continue
+		}
+		if !slices.Contains(AllScopes, scope) {
+			return nil, errors.Errorf("unknown scope %q", scope)
+		}
+		requested[scope] = true
+	}
+	normalized := []string{}
+	for _, scope := range AllScopes {
+		if requested[scope] {
+			normalized = append(normalized, scope)
+		}
+	}
+	return normalized, nil
+}
+
+// HasScope reports whether the granted scopes satisfy the required scope.
+// An empty required scope is satisfied by any token, and an empty granted
+// list means the token is unrestricted.
+func HasScope(granted []string, required string) bool {
+	if required == "" || len(granted) == 0 {
+		return true
+	}
+	for _, scope := range granted {
+		if scope == ScopeAdmin || scope == required {
+			return true
+		}
+		if resource, ok := strings.CutSuffix(scope, ":write"); ok && required == resource+":read" {
+			return true
+		}
+	}
+	return false
+}
+
+// ScopesSubset reports whether every scope in requested is covered by granted.
+// It is used to prevent a scoped token from minting a more powerful one.
+func ScopesSubset(requested, granted []string) bool {
+	if len(granted) == 0 {
+		return true
+	}
+	if len(requested) == 0 {
+		return false
+	}
+	for _, scope := range requested {
+		if !HasScope(granted, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetScopes retrieves the scopes of the credential used for the request.
+// Returns nil when the request is not restricted (session or legacy PAT).
+func GetScopes(ctx context.Context) []string {
+	if v, ok := ctx.Value(ScopesContextKey).([]string); ok {
+		return v
+	}
+	return nil
+}
+
+// HasScopeInContext reports whether the request credential grants the scope.
+func HasScopeInContext(ctx context.Context, required string) bool {
+	return HasScope(GetScopes(ctx), required)
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeScopes(t *testing.T) {
+	scopes, err := NormalizeScopes([]string{" Memos:Write ", "memos:read", "memos:read", ""})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ScopeMemosRead, ScopeMemosWrite}, scopes)
+
+	_, err = NormalizeScopes([]string{"memos:delete"})
+	assert.Error(t, err)
+}
+
+func TestHasScope(t *testing.T) {
+	assert.True(t, HasScope(nil, ScopeAdmin))
+	assert.True(t, HasScope([]string{ScopeMemosRead}, ScopeMemosRead))
+	assert.False(t, HasScope([]string{ScopeMemosRead}, ScopeMemosWrite))
+	assert.True(t, HasScope([]string{ScopeMemosWrite}, ScopeMemosRead))
+	assert.False(t, HasScope([]string{ScopeMemosWrite}, ScopeAttachmentsRead))
+	assert.True(t, HasScope([]string{ScopeAdmin}, ScopeSettingsWrite))
+}
+
+func TestScopesSubset(t *testing.T) {
+	assert.True(t, ScopesSubset([]string{ScopeMemosRead}, nil))
+	assert.True(t, ScopesSubset(nil, nil))
+	assert.False(t, ScopesSubset(nil, []string{ScopeMemosRead}))
+	assert.True(t, ScopesSubset([]string{ScopeMemosRead}, []string{ScopeMemosWrite}))
+	assert.False(t, ScopesSubset([]string{ScopeAdmin}, []string{ScopeMemosWrite}))
+}
@@ -8,12 +8,15 @@
 // - JWT access tokens: Short-lived tokens (15 minutes) for API access
 // - JWT refresh tokens: Long-lived tokens (30 days) for obtaining new access tokens
 // - Personal Access Tokens (PAT): Long-lived tokens for programmatic access
+// - OAuth2 access tokens: Access tokens issued to third-party apps, restricted to the granted scopes
+// - Password reset and email verification tokens: Short-lived, single-purpose tokens sent by email
 package auth
 
 import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -73,6 +76,9 @@ type AccessTokenClaims struct {
 	Role     string `json:"role"`     // User role
 	Status   string `json:"status"`   // User status
 	Username string `json:"username"` // Username for display
+	// Scope and ClientID are only set for tokens issued to OAuth clients.
+	Scope    string `json:"scope,omitempty"` // Space-separated granted scopes
+	ClientID string `json:"client_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -132,6 +138,16 @@ func generateToken(username string, userID int32, audience string, expirationTim
 
 // GenerateAccessTokenV2 generates a short-lived access token with user claims.
 func GenerateAccessTokenV2(userID int32, username, role, status string, secret []byte) (string, time.Time, error) {
+	return generateAccessTokenV2(userID, username, role, status, "", nil, secret)
+}
+
+// GenerateOAuthAccessToken generates a short-lived access token issued to an OAuth client.
+// The token is restricted to the granted scopes.
+func GenerateOAuthAccessToken(userID int32, username, role, status, clientID string, scopes []string, secret []byte) (string, time.Time, error) {
+	return generateAccessTokenV2(userID, username, role, status, clientID, scopes, secret)
+}
+
+func generateAccessTokenV2(userID int32, username, role, status, clientID string, scopes []string, secret []byte) (string, time.Time, error) {
 	expiresAt := time.Now().Add(AccessTokenDuration)
 
 	claims := &AccessTokenClaims{
@@ -139,6 +155,8 @@ func GenerateAccessTokenV2(userID int32, username, role, status string, secret [
 		Role:     role,
 		Status:   status,
 		Username: username,
+		Scope:    strings.Join(scopes, " "),
+		ClientID: clientID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    Issuer,
 			Audience:  jwt.ClaimStrings{AccessTokenAudienceName},
@@ -0,0 +1,82 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/email"
+	"github.com/usememos/memos/store"
+)
+
+// SendPasswordResetEmail sends a password reset link to the email of the user.
+func (d *EmailDispatcher) SendPasswordResetEmail(ctx context.Context, user *store.User, token string) error {
+	return d.sendAccountEmail(ctx, func(baseURL string) *email.Message {
+		link := fmt.Sprintf("%s/auth/reset-password?token=%s", baseURL, url.QueryEscape(token))
+		body := []string{
+			fmt.Sprintf("Hi %s,", displayNameForEmail(user)),
+			"",
+			"We received a request to reset the password of your Memos account.",
+			"",
+			"Choose a new password:",
+			link,
+			"",
+			"The link expires in 1 hour. If you did not request a password reset, you can ignore this email.",
+		}
+		return &email.Message{
+			To:      []string{user.Email},
+			Subject: "[Memos] Reset your password",
+			Body:    strings.Join(body, "\n"),
+		}
+	})
+}
+
+// SendEmailVerificationEmail sends an email verification link to the email of the user.
+func (d *EmailDispatcher) SendEmailVerificationEmail(ctx context.Context, user *store.User, token string) error {
+	return d.sendAccountEmail(ctx, func(baseURL string) *email.Message {
+		link := fmt.Sprintf("%s/auth/verify-email?token=%s", baseURL, url.QueryEscape(token))
+		body := []string{
+			fmt.Sprintf("Hi %s,", displayNameForEmail(user)),
+			"",
+			"Please confirm the email address of your Memos account:",
+			link,
+			"",
+			"The link expires in 24 hours.",
+		}
+		return &email.Message{
+			To:      []string{user.Email},
+			Subject: "[Memos] Verify your email",
+			Body:    strings.Join(body, "\n"),
+		}
+	})
+}
+
+// sendAccountEmail sends a transactional email built from the instance URL.
+// Unlike inbox notifications, failures are returned so the caller can report them.
+func (d *EmailDispatcher) sendAccountEmail(ctx context.Context, build func(baseURL string) *email.Message) error {
+	setting, err := d.store.GetInstanceNotificationSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get notification setting")
+	}
+	emailSetting := setting.GetEmail()
+	if emailSetting == nil || !emailSetting.Enabled {
+		return errors.New("email notifications are not enabled")
+	}
+	baseURL := d.baseURL()
+	if baseURL == "" {
+		return errors.New("instance URL is required to send account emails")
+	}
+
+	config := EmailConfigFromInstanceSetting(emailSetting)
+	if err := config.Validate(); err != nil {
+		return errors.Wrap(err, "invalid notification email setting")
+	}
+
+	message := build(baseURL)
+	message.ReplyTo = emailSetting.ReplyTo
+	d.sender(config, message)
+	return nil
+}
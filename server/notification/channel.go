@@ -0,0 +1,124 @@
+package notification
+
+import (
+	"context"
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/notifier"
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/internal/webhook"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+var channelServices = map[storepb.NotificationUserSetting_Channel_Type]notifier.Service{
+	storepb.NotificationUserSetting_Channel_NTFY:    notifier.Ntfy,
+	storepb.NotificationUserSetting_Channel_GOTIFY:  notifier.Gotify,
+	storepb.NotificationUserSetting_Channel_APPRISE: notifier.Apprise,
+}
+
+// ChannelDispatcher delivers inbox notifications to the push services users configured
+// as notification channels.
+type ChannelDispatcher struct {
+	store  *store.Store
+	client *http.Client
+	// content renders notifications the same way as notification emails.
+	content *EmailDispatcher
+}
+
+// NewChannelDispatcher creates a notification channel dispatcher. A nil client means the
+// SSRF-guarded webhook client.
+func NewChannelDispatcher(profile *profile.Profile, store *store.Store, client *http.Client) *ChannelDispatcher {
+	if client == nil {
+		client = webhook.SafeClient()
+	}
+	return &ChannelDispatcher{
+		store:   store,
+		client:  client,
+		content: NewEmailDispatcher(profile, store, nil),
+	}
+}
+
+// ChannelTarget converts a notification channel into its delivery target.
+func ChannelTarget(channel *storepb.NotificationUserSetting_Channel) *notifier.Target {
+	return &notifier.Target{
+		Service: channelServices[channel.GetType()],
+		URL:     channel.GetUrl(),
+		Token:   channel.GetToken(),
+	}
+}
+
+// DispatchInboxChannels delivers the notification of an inbox entry to every notification
+// channel of the receiver.
+func (d *ChannelDispatcher) DispatchInboxChannels(ctx context.Context, inbox *store.Inbox) error {
+	if inbox == nil || inbox.Message == nil {
+		return nil
+	}
+
+	notificationSetting, err := d.store.GetUserNotificationSetting(ctx, inbox.ReceiverID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get receiver notification setting")
+	}
+	if len(notificationSetting.Channels) == 0 {
+		return nil
+	}
+	if d.content.baseURL() == "" {
+		slog.Warn("Skipping inbox channel notification because instance URL is required",
+			slog.Int64("inbox_id", int64(inbox.ID)),
+			slog.Int64("receiver_id", int64(inbox.ReceiverID)))
+		return nil
+	}
+
+	receiver, err := d.store.GetUser(ctx, &store.FindUser{ID: &inbox.ReceiverID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get notification receiver")
+	}
+	if receiver == nil {
+		return nil
+	}
+	content, err := d.content.renderInboxContent(ctx, inbox, receiver)
+	if err != nil {
+		return err
+	}
+	if content == nil {
+		return nil
+	}
+
+	message := &notifier.Message{
+		Title: strings.TrimPrefix(content.Subject, "[Memos] "),
+		Body:  content.Summary,
+		URL:   content.URL,
+	}
+	for _, channel := range notificationSetting.Channels {
+		if err := notifier.Send(ctx, d.client, ChannelTarget(channel), message); err != nil {
+			slog.Warn("Failed to deliver notification to channel",
+				slog.Any("err", err),
+				slog.String("channel", channel.Type.String()),
+				slog.Int64("inbox_id", int64(inbox.ID)),
+				slog.Int64("receiver_id", int64(inbox.ReceiverID)))
+		}
+	}
+	return nil
+}
+
+// SendTestNotification delivers a test notification to each channel, returning the
+// errors of the channels that failed.
+func (d *ChannelDispatcher) SendTestNotification(ctx context.Context, channels []*storepb.NotificationUserSetting_Channel) error {
+	message := &notifier.Message{
+		Title: "Test notification",
+		Body:  "This is a test notification from your Memos notification settings.",
+		URL:   d.content.baseURL(),
+	}
+	var errs []error
+	for _, channel := range channels {
+		if err := notifier.Send(ctx, d.client, ChannelTarget(channel), message); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return stderrors.Join(errs...)
+}
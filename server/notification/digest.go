@@ -0,0 +1,228 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/email"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+var digestEmailTemplate = template.Must(template.New("digest").Parse(`<!DOCTYPE html>
+<html>
+<body style="margin:0;padding:24px;background:#f5f5f4;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Helvetica,Arial,sans-serif;color:#1c1917;">
+  <div style="max-width:560px;margin:0 auto;background:#ffffff;border-radius:8px;padding:24px;">
+    <p style="margin:0 0 8px;">Hi {{.ReceiverName}},</p>
+    <p style="margin:0 0 16px;">You have {{len .Items}} unread {{if eq (len .Items) 1}}notification{{else}}notifications{{end}} since your last {{.Frequency}} digest.</p>
+    <ul style="margin:0;padding:0;list-style:none;">
+      {{- range .Items}}
+      <li style="padding:12px 0;border-top:1px solid #e7e5e4;">
+        <div>{{.Summary}}</div>
+        <div style="margin-top:4px;font-size:13px;color:#78716c;">{{.Time}} · <a href="{{.URL}}" style="color:#0d9488;">Open in Memos</a></div>
+      </li>
+      {{- end}}
+    </ul>
+    <p style="margin:16px 0 0;"><a href="{{.InboxURL}}" style="color:#0d9488;">View all notifications</a></p>
+    <p style="margin:16px 0 0;font-size:12px;color:#a8a29e;">You are receiving this because you chose {{.Frequency}} digests in your notification preferences.</p>
+  </div>
+</body>
+</html>
+`))
+
+type digestEmailItem struct {
+	Summary string
+	URL     string
+	Time    string
+}
+
+type digestEmailData struct {
+	ReceiverName string
+	Frequency    string
+	Items        []digestEmailItem
+	InboxURL     string
+}
+
+// DispatchDigestEmails sends one digest email to each user who batches notifications with the
+// given delivery. A digest covers the unread notifications created since the user's previous digest.
+func (d *EmailDispatcher) DispatchDigestEmails(ctx context.Context, delivery storepb.NotificationUserSetting_EmailDelivery, now time.Time) error {
+	if delivery != storepb.NotificationUserSetting_HOURLY_DIGEST && delivery != storepb.NotificationUserSetting_DAILY_DIGEST {
+		return errors.Errorf("unsupported digest delivery %s", delivery)
+	}
+
+	setting, err := d.store.GetInstanceNotificationSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get notification setting")
+	}
+	emailSetting := setting.GetEmail()
+	if emailSetting == nil || !emailSetting.Enabled || d.baseURL() == "" {
+		return nil
+	}
+	config := EmailConfigFromInstanceSetting(emailSetting)
+	if err := config.Validate(); err != nil {
+		return errors.Wrap(err, "invalid notification email setting")
+	}
+
+	userSettings, err := d.store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_NOTIFICATION})
+	if err != nil {
+		return errors.Wrap(err, "failed to list notification settings")
+	}
+	for _, userSetting := range userSettings {
+		notificationSetting := userSetting.GetNotification()
+		if notificationSetting == nil {
+			continue
+		}
+		message, err := d.dispatchUserDigest(ctx, userSetting.UserId, notificationSetting, delivery, now)
+		if err != nil {
+			slog.Warn("Failed to dispatch notification digest",
+				slog.Any("err", err),
+				slog.Int64("user_id", int64(userSetting.UserId)))
+			continue
+		}
+		if message != nil {
+			message.ReplyTo = emailSetting.ReplyTo
+			d.sender(config, message)
+		}
+	}
+	return nil
+}
+
+// dispatchUserDigest builds the digest email of a user and advances the user's digest cursor.
+// It returns nil when there is nothing to send.
+func (d *EmailDispatcher) dispatchUserDigest(ctx context.Context, userID int32, notificationSetting *storepb.NotificationUserSetting, delivery storepb.NotificationUserSetting_EmailDelivery, now time.Time) (*email.Message, error) {
+	digestTypes := map[storepb.InboxMessage_Type]bool{}
+	for _, preference := range notificationSetting.Preferences {
+		if preference.EmailDelivery == delivery {
+			digestTypes[preference.Type] = true
+		}
+	}
+	if len(digestTypes) == 0 {
+		return nil, nil
+	}
+
+	cursor := notificationSetting.HourlyDigestTs
+	if delivery == storepb.NotificationUserSetting_DAILY_DIGEST {
+		cursor = notificationSetting.DailyDigestTs
+	}
+	unreadStatus := store.UNREAD
+	inboxes, err := d.store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &userID,
+		Status:     &unreadStatus,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list unread inboxes")
+	}
+	digestInboxes := make([]*store.Inbox, 0, len(inboxes))
+	for _, inbox := range inboxes {
+		if inbox.Message != nil && digestTypes[inbox.Message.Type] && inbox.CreatedTs >= cursor && inbox.CreatedTs < now.Unix() {
+			digestInboxes = append(digestInboxes, inbox)
+		}
+	}
+	if len(digestInboxes) == 0 {
+		return nil, nil
+	}
+
+	message, err := d.buildDigestEmailMessage(ctx, userID, digestInboxes, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	// Re-read the setting so preferences the user changed while the digest was built are kept.
+	updatedSetting, err := d.store.GetUserNotificationSetting(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get notification setting")
+	}
+	if delivery == storepb.NotificationUserSetting_DAILY_DIGEST {
+		updatedSetting.DailyDigestTs = now.Unix()
+	} else {
+		updatedSetting.HourlyDigestTs = now.Unix()
+	}
+	if _, err := d.store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_NOTIFICATION,
+		Value:  &storepb.UserSetting_Notification{Notification: updatedSetting},
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to update digest cursor")
+	}
+	return message, nil
+}
+
+func (d *EmailDispatcher) buildDigestEmailMessage(ctx context.Context, userID int32, inboxes []*store.Inbox, delivery storepb.NotificationUserSetting_EmailDelivery) (*email.Message, error) {
+	receiver, err := d.getEmailReceiver(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if receiver == nil {
+		return nil, nil
+	}
+	memosByID, err := d.listMemosByID(ctx, collectInboxMemoIDs(inboxes))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get notification memos")
+	}
+	receiverScope, err := d.store.GetMemoAccessScope(ctx, receiver.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get notification receiver access scope")
+	}
+
+	sendersByID := map[int32]*store.User{}
+	items := make([]digestEmailItem, 0, len(inboxes))
+	for _, inbox := range inboxes {
+		sender, ok := sendersByID[inbox.SenderID]
+		if !ok {
+			sender, err = d.store.GetUser(ctx, &store.FindUser{ID: &inbox.SenderID})
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get notification sender")
+			}
+			sendersByID[inbox.SenderID] = sender
+		}
+		if sender == nil {
+			continue
+		}
+		content, err := d.buildInboxEmailContent(inbox, receiver, receiverScope, sender, memosByID)
+		if err != nil {
+			return nil, err
+		}
+		if content == nil {
+			continue
+		}
+		items = append(items, digestEmailItem{
+			Summary: content.Summary,
+			URL:     content.URL,
+			Time:    time.Unix(inbox.CreatedTs, 0).UTC().Format("2006-01-02 15:04 UTC"),
+		})
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	frequency := "hourly"
+	if delivery == storepb.NotificationUserSetting_DAILY_DIGEST {
+		frequency = "daily"
+	}
+	var body bytes.Buffer
+	if err := digestEmailTemplate.Execute(&body, digestEmailData{
+		ReceiverName: displayNameForEmail(receiver),
+		Frequency:    frequency,
+		Items:        items,
+		InboxURL:     d.baseURL() + "/inbox",
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to render digest email")
+	}
+
+	subject := fmt.Sprintf("[Memos] Your %s digest: %d new notifications", frequency, len(items))
+	if len(items) == 1 {
+		subject = fmt.Sprintf("[Memos] Your %s digest: 1 new notification", frequency)
+	}
+	return &email.Message{
+		To:      []string{receiver.Email},
+		Subject: subject,
+		Body:    body.String(),
+		IsHTML:  true,
+	}, nil
+}
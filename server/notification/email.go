@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -17,6 +18,15 @@ import (
 // EmailSender sends a prepared email message with the given SMTP configuration.
 type EmailSender func(*email.Config, *email.Message)
 
+// inboxEmailContent is the rendered content of an inbox notification, shared by immediate
+// emails and digests.
+type inboxEmailContent struct {
+	Subject string
+	Summary string
+	URL     string
+	Reason  string
+}
+
 // EmailDispatcher dispatches notification emails for inbox events.
 type EmailDispatcher struct {
 	profile *profile.Profile
@@ -36,7 +46,8 @@ func NewEmailDispatcher(profile *profile.Profile, store *store.Store, sender Ema
 	}
 }
 
-// DispatchInboxEmail sends the email notification for an inbox entry when configured.
+// DispatchInboxEmail sends the email notification for an inbox entry when configured and the
+// receiver wants the notification type emailed immediately.
 func (d *EmailDispatcher) DispatchInboxEmail(ctx context.Context, inbox *store.Inbox) error {
 	if inbox == nil || inbox.Message == nil {
 		return nil
@@ -57,34 +68,30 @@ func (d *EmailDispatcher) DispatchInboxEmail(ctx context.Context, inbox *store.I
 		return nil
 	}
 
-	receiver, err := d.store.GetUser(ctx, &store.FindUser{ID: &inbox.ReceiverID})
+	notificationSetting, err := d.store.GetUserNotificationSetting(ctx, inbox.ReceiverID)
 	if err != nil {
-		return errors.Wrap(err, "failed to get notification receiver")
+		return errors.Wrap(err, "failed to get receiver notification setting")
 	}
-	if receiver == nil || strings.TrimSpace(receiver.Email) == "" {
+	if EmailDeliveryForType(notificationSetting, inbox.Message.Type) != storepb.NotificationUserSetting_IMMEDIATE {
 		return nil
 	}
 
-	sender, err := d.store.GetUser(ctx, &store.FindUser{ID: &inbox.SenderID})
+	receiver, err := d.getEmailReceiver(ctx, inbox.ReceiverID)
 	if err != nil {
-		return errors.Wrap(err, "failed to get notification sender")
+		return err
 	}
-	if sender == nil {
+	if receiver == nil {
 		return nil
 	}
 
-	memosByID, err := d.listMemosByID(ctx, collectInboxMemoIDs([]*store.Inbox{inbox}))
-	if err != nil {
-		return errors.Wrap(err, "failed to get notification memos")
-	}
-
-	message, err := d.buildInboxEmailMessage(inbox, receiver, sender, memosByID)
+	content, err := d.renderInboxContent(ctx, inbox, receiver)
 	if err != nil {
 		return err
 	}
-	if message == nil {
+	if content == nil {
 		return nil
 	}
+	message := newInboxEmailMessage(receiver, content)
 	message.ReplyTo = emailSetting.ReplyTo
 
 	config := EmailConfigFromInstanceSetting(emailSetting)
@@ -96,6 +103,62 @@ func (d *EmailDispatcher) DispatchInboxEmail(ctx context.Context, inbox *store.I
 	return nil
 }
 
+// renderInboxContent renders the notification of a single inbox entry for its receiver.
+// It returns nil when the sender is gone or the receiver can no longer access the memo.
+func (d *EmailDispatcher) renderInboxContent(ctx context.Context, inbox *store.Inbox, receiver *store.User) (*inboxEmailContent, error) {
+	sender, err := d.store.GetUser(ctx, &store.FindUser{ID: &inbox.SenderID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get notification sender")
+	}
+	if sender == nil {
+		return nil, nil
+	}
+
+	memosByID, err := d.listMemosByID(ctx, collectInboxMemoIDs([]*store.Inbox{inbox}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get notification memos")
+	}
+
+	receiverScope, err := d.store.GetMemoAccessScope(ctx, receiver.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get notification receiver access scope")
+	}
+
+	return d.buildInboxEmailContent(inbox, receiver, receiverScope, sender, memosByID)
+}
+
+// EmailDeliveryForType returns how the given inbox message type is emailed. Types without a
+// preference are emailed immediately.
+func EmailDeliveryForType(setting *storepb.NotificationUserSetting, messageType storepb.InboxMessage_Type) storepb.NotificationUserSetting_EmailDelivery {
+	for _, preference := range setting.GetPreferences() {
+		if preference.Type == messageType && preference.EmailDelivery != storepb.NotificationUserSetting_EMAIL_DELIVERY_UNSPECIFIED {
+			return preference.EmailDelivery
+		}
+	}
+	return storepb.NotificationUserSetting_IMMEDIATE
+}
+
+// getEmailReceiver returns the user when they can receive notification emails, or nil otherwise.
+func (d *EmailDispatcher) getEmailReceiver(ctx context.Context, userID int32) (*store.User, error) {
+	receiver, err := d.store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get notification receiver")
+	}
+	if receiver == nil || strings.TrimSpace(receiver.Email) == "" {
+		return nil, nil
+	}
+	if !receiver.EmailVerified {
+		generalSetting, err := d.store.GetInstanceGeneralSetting(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get general setting")
+		}
+		if generalSetting.GetRequireEmailVerification() {
+			return nil, nil
+		}
+	}
+	return receiver, nil
+}
+
 // EmailConfigFromInstanceSetting converts persisted notification settings into SMTP config.
 func EmailConfigFromInstanceSetting(setting *storepb.InstanceNotificationSetting_EmailSetting) *email.Config {
 	if setting == nil {
@@ -133,26 +196,54 @@ func SendTestEmail(setting *storepb.InstanceNotificationSetting_EmailSetting, re
 	return email.Send(EmailConfigFromInstanceSetting(setting), NewTestEmailMessage(recipientEmail, setting.GetReplyTo()))
 }
 
-func (d *EmailDispatcher) buildInboxEmailMessage(inbox *store.Inbox, receiver *store.User, sender *store.User, memosByID map[int32]*store.Memo) (*email.Message, error) {
+func newInboxEmailMessage(receiver *store.User, content *inboxEmailContent) *email.Message {
+	body := []string{
+		fmt.Sprintf("Hi %s,", displayNameForEmail(receiver)),
+		"",
+		content.Summary,
+		"",
+		"Open in Memos:",
+		content.URL,
+		"",
+		content.Reason,
+	}
+	return &email.Message{
+		To:      []string{receiver.Email},
+		Subject: content.Subject,
+		Body:    strings.Join(body, "\n"),
+	}
+}
+
+func (d *EmailDispatcher) buildInboxEmailContent(inbox *store.Inbox, receiver *store.User, receiverScope *store.MemoAccessScope, sender *store.User, memosByID map[int32]*store.Memo) (*inboxEmailContent, error) {
 	senderName := displayNameForEmail(sender)
 	switch inbox.Message.Type {
 	case storepb.InboxMessage_MEMO_COMMENT:
-		return d.buildMemoCommentEmailMessage(inbox.Message, receiver, senderName, memosByID)
+		return d.buildMemoCommentEmailContent(inbox.Message, receiver, receiverScope, senderName, memosByID)
 	case storepb.InboxMessage_MEMO_MENTION:
-		return d.buildMemoMentionEmailMessage(inbox.Message, receiver, senderName, memosByID)
+		return d.buildMemoMentionEmailContent(inbox.Message, receiver, receiverScope, senderName, memosByID)
+	case storepb.InboxMessage_MEMO_COLLABORATOR:
+		return d.buildMemoCollaboratorEmailContent(inbox.Message, receiver, receiverScope, senderName, memosByID)
+	case storepb.InboxMessage_MEMO_REMINDER:
+		return d.buildMemoReminderEmailContent(inbox.Message, receiver, receiverScope, memosByID)
+	case storepb.InboxMessage_MEMO_REACTION:
+		return d.buildMemoReactionEmailContent(inbox.Message, receiver, receiverScope, senderName, memosByID)
+	case storepb.InboxMessage_MEMO_THREAD_REPLY:
+		return d.buildMemoThreadReplyEmailContent(inbox.Message, receiver, receiverScope, senderName, memosByID)
+	case storepb.InboxMessage_MEMO_SHARE_OPENED:
+		return d.buildMemoShareOpenedEmailContent(inbox, receiver, receiverScope, senderName, memosByID)
 	default:
 		return nil, nil
 	}
 }
 
-func (d *EmailDispatcher) buildMemoCommentEmailMessage(message *storepb.InboxMessage, receiver *store.User, senderName string, memosByID map[int32]*store.Memo) (*email.Message, error) {
+func (d *EmailDispatcher) buildMemoCommentEmailContent(message *storepb.InboxMessage, receiver *store.User, receiverScope *store.MemoAccessScope, senderName string, memosByID map[int32]*store.Memo) (*inboxEmailContent, error) {
 	payload := message.GetMemoComment()
 	if payload == nil {
 		return nil, nil
 	}
 	commentMemo := memosByID[payload.MemoId]
 	relatedMemo := memosByID[payload.RelatedMemoId]
-	if !canViewerAccessMemo(receiver, commentMemo) || !canViewerAccessMemo(receiver, relatedMemo) {
+	if !canViewerAccessMemo(receiver, receiverScope, commentMemo) || !canViewerAccessMemo(receiver, receiverScope, relatedMemo) {
 		return nil, nil
 	}
 	url := d.memoCommentURL(relatedMemo, commentMemo)
@@ -160,31 +251,21 @@ func (d *EmailDispatcher) buildMemoCommentEmailMessage(message *storepb.InboxMes
 		return nil, nil
 	}
 
-	body := []string{
-		fmt.Sprintf("Hi %s,", displayNameForEmail(receiver)),
-		"",
-		fmt.Sprintf("%s commented on your memo.", senderName),
-		"",
-		"Open in Memos:",
-		url,
-		"",
-		"You are receiving this because you own this memo.",
-	}
-
-	return &email.Message{
-		To:      []string{receiver.Email},
+	return &inboxEmailContent{
 		Subject: fmt.Sprintf("[Memos] %s commented on your memo", senderName),
-		Body:    strings.Join(body, "\n"),
+		Summary: fmt.Sprintf("%s commented on your memo.", senderName),
+		URL:     url,
+		Reason:  "You are receiving this because you own this memo.",
 	}, nil
 }
 
-func (d *EmailDispatcher) buildMemoMentionEmailMessage(message *storepb.InboxMessage, receiver *store.User, senderName string, memosByID map[int32]*store.Memo) (*email.Message, error) {
+func (d *EmailDispatcher) buildMemoMentionEmailContent(message *storepb.InboxMessage, receiver *store.User, receiverScope *store.MemoAccessScope, senderName string, memosByID map[int32]*store.Memo) (*inboxEmailContent, error) {
 	payload := message.GetMemoMention()
 	if payload == nil {
 		return nil, nil
 	}
 	memo := memosByID[payload.MemoId]
-	if !canViewerAccessMemo(receiver, memo) {
+	if !canViewerAccessMemo(receiver, receiverScope, memo) {
 		return nil, nil
 	}
 	url := d.memoURL(memo)
@@ -192,21 +273,139 @@ func (d *EmailDispatcher) buildMemoMentionEmailMessage(message *storepb.InboxMes
 		return nil, nil
 	}
 
-	body := []string{
-		fmt.Sprintf("Hi %s,", displayNameForEmail(receiver)),
-		"",
-		fmt.Sprintf("%s mentioned you in a memo.", senderName),
-		"",
-		"Open in Memos:",
-		url,
-		"",
-		"You are receiving this because you were mentioned in this memo.",
+	return &inboxEmailContent{
+		Subject: fmt.Sprintf("[Memos] %s mentioned you in a memo", senderName),
+		Summary: fmt.Sprintf("%s mentioned you in a memo.", senderName),
+		URL:     url,
+		Reason:  "You are receiving this because you were mentioned in this memo.",
+	}, nil
+}
+
+func (d *EmailDispatcher) buildMemoCollaboratorEmailContent(message *storepb.InboxMessage, receiver *store.User, receiverScope *store.MemoAccessScope, senderName string, memosByID map[int32]*store.Memo) (*inboxEmailContent, error) {
+	payload := message.GetMemoCollaborator()
+	if payload == nil {
+		return nil, nil
+	}
+	memo := memosByID[payload.MemoId]
+	if !canViewerAccessMemo(receiver, receiverScope, memo) {
+		return nil, nil
+	}
+	url := d.memoURL(memo)
+	if url == "" {
+		return nil, nil
 	}
 
-	return &email.Message{
-		To:      []string{receiver.Email},
-		Subject: fmt.Sprintf("[Memos] %s mentioned you in a memo", senderName),
-		Body:    strings.Join(body, "\n"),
+	action := "view"
+	if payload.Role == store.MemoCollaboratorEditor.String() {
+		action = "edit"
+	}
+	return &inboxEmailContent{
+		Subject: fmt.Sprintf("[Memos] %s invited you to %s a memo", senderName, action),
+		Summary: fmt.Sprintf("%s invited you to %s a memo.", senderName, action),
+		URL:     url,
+		Reason:  "You are receiving this because you were added as a collaborator on this memo.",
+	}, nil
+}
+
+func (d *EmailDispatcher) buildMemoReminderEmailContent(message *storepb.InboxMessage, receiver *store.User, receiverScope *store.MemoAccessScope, memosByID map[int32]*store.Memo) (*inboxEmailContent, error) {
+	payload := message.GetMemoReminder()
+	if payload == nil {
+		return nil, nil
+	}
+	memo := memosByID[payload.MemoId]
+	if !canViewerAccessMemo(receiver, receiverScope, memo) {
+		return nil, nil
+	}
+	url := d.memoURL(memo)
+	if url == "" {
+		return nil, nil
+	}
+
+	subject := "[Memos] Reminder for a memo"
+	line := "This is your reminder for a memo."
+	if memo.DueTs != nil {
+		due := time.Unix(*memo.DueTs, 0).UTC().Format("2006-01-02 15:04 UTC")
+		subject = fmt.Sprintf("[Memos] Reminder: a memo is due %s", due)
+		line = fmt.Sprintf("This is your reminder for a memo due %s.", due)
+	}
+	return &inboxEmailContent{
+		Subject: subject,
+		Summary: line,
+		URL:     url,
+		Reason:  "You are receiving this because you set a reminder on this memo.",
+	}, nil
+}
+
+func (d *EmailDispatcher) buildMemoReactionEmailContent(message *storepb.InboxMessage, receiver *store.User, receiverScope *store.MemoAccessScope, senderName string, memosByID map[int32]*store.Memo) (*inboxEmailContent, error) {
+	payload := message.GetMemoReaction()
+	if payload == nil {
+		return nil, nil
+	}
+	memo := memosByID[payload.MemoId]
+	if !canViewerAccessMemo(receiver, receiverScope, memo) {
+		return nil, nil
+	}
+	url := d.memoURL(memo)
+	if url == "" {
+		return nil, nil
+	}
+
+	return &inboxEmailContent{
+		Subject: fmt.Sprintf("[Memos] %s reacted to your memo", senderName),
+		Summary: fmt.Sprintf("%s reacted %s to your memo.", senderName, payload.ReactionType),
+		URL:     url,
+		Reason:  "You are receiving this because you own this memo.",
+	}, nil
+}
+
+func (d *EmailDispatcher) buildMemoThreadReplyEmailContent(message *storepb.InboxMessage, receiver *store.User, receiverScope *store.MemoAccessScope, senderName string, memosByID map[int32]*store.Memo) (*inboxEmailContent, error) {
+	payload := message.GetMemoThreadReply()
+	if payload == nil {
+		return nil, nil
+	}
+	commentMemo := memosByID[payload.MemoId]
+	relatedMemo := memosByID[payload.RelatedMemoId]
+	if !canViewerAccessMemo(receiver, receiverScope, commentMemo) || !canViewerAccessMemo(receiver, receiverScope, relatedMemo) {
+		return nil, nil
+	}
+	url := d.memoCommentURL(relatedMemo, commentMemo)
+	if url == "" {
+		return nil, nil
+	}
+
+	return &inboxEmailContent{
+		Subject: fmt.Sprintf("[Memos] %s replied in a thread you commented on", senderName),
+		Summary: fmt.Sprintf("%s replied in a thread you commented on.", senderName),
+		URL:     url,
+		Reason:  "You are receiving this because you commented on this memo.",
+	}, nil
+}
+
+// buildMemoShareOpenedEmailContent builds the email for an opened share link. The sender is
+// the receiver when the link was opened anonymously.
+func (d *EmailDispatcher) buildMemoShareOpenedEmailContent(inbox *store.Inbox, receiver *store.User, receiverScope *store.MemoAccessScope, senderName string, memosByID map[int32]*store.Memo) (*inboxEmailContent, error) {
+	payload := inbox.Message.GetMemoShareOpened()
+	if payload == nil {
+		return nil, nil
+	}
+	memo := memosByID[payload.MemoId]
+	if !canViewerAccessMemo(receiver, receiverScope, memo) {
+		return nil, nil
+	}
+	url := d.memoURL(memo)
+	if url == "" {
+		return nil, nil
+	}
+
+	opener := "Someone"
+	if inbox.SenderID != inbox.ReceiverID {
+		opener = senderName
+	}
+	return &inboxEmailContent{
+		Subject: fmt.Sprintf("[Memos] %s opened your share link", opener),
+		Summary: fmt.Sprintf("%s opened a share link you created for a memo.", opener),
+		URL:     url,
+		Reason:  "You are receiving this because you created this share link.",
 	}, nil
 }
 
@@ -260,6 +459,31 @@ func collectInboxMemoIDs(inboxes []*store.Inbox) []int32 {
 			if payload != nil {
 				memoIDs = append(memoIDs, payload.MemoId, payload.RelatedMemoId)
 			}
+		case storepb.InboxMessage_MEMO_COLLABORATOR:
+			payload := inbox.Message.GetMemoCollaborator()
+			if payload != nil {
+				memoIDs = append(memoIDs, payload.MemoId)
+			}
+		case storepb.InboxMessage_MEMO_REMINDER:
+			payload := inbox.Message.GetMemoReminder()
+			if payload != nil {
+				memoIDs = append(memoIDs, payload.MemoId)
+			}
+		case storepb.InboxMessage_MEMO_REACTION:
+			payload := inbox.Message.GetMemoReaction()
+			if payload != nil {
+				memoIDs = append(memoIDs, payload.MemoId)
+			}
+		case storepb.InboxMessage_MEMO_THREAD_REPLY:
+			payload := inbox.Message.GetMemoThreadReply()
+			if payload != nil {
+				memoIDs = append(memoIDs, payload.MemoId, payload.RelatedMemoId)
+			}
+		case storepb.InboxMessage_MEMO_SHARE_OPENED:
+			payload := inbox.Message.GetMemoShareOpened()
+			if payload != nil {
+				memoIDs = append(memoIDs, payload.MemoId)
+			}
 		default:
 			// Ignore notification types without memo references.
 		}
@@ -303,15 +527,15 @@ func (d *EmailDispatcher) memoCommentURL(relatedMemo *store.Memo, commentMemo *s
 	return fmt.Sprintf("%s/memos/%s#%s", baseURL, relatedMemo.UID, commentMemo.UID)
 }
 
-func canViewerAccessMemo(viewer *store.User, memo *store.Memo) bool {
+func canViewerAccessMemo(viewer *store.User, viewerScope *store.MemoAccessScope, memo *store.Memo) bool {
 	if memo == nil {
 		return false
 	}
 	if viewer != nil && viewer.Role == store.RoleAdmin {
 		return true
 	}
-	if memo.Visibility == store.Private {
-		return viewer != nil && viewer.ID == memo.CreatorID
+	if memo.Visibility == store.Private || memo.Visibility == store.Group {
+		return viewer != nil && (viewer.ID == memo.CreatorID || viewerScope.Includes(memo))
 	}
 	if memo.Visibility == store.Protected {
 		return viewer != nil
@@ -0,0 +1,46 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/email"
+	"github.com/usememos/memos/store"
+)
+
+// SendInvitationEmail sends the registration link of an invitation to its email address.
+// Unlike inbox notifications, failures are returned so the caller can report them.
+func (d *EmailDispatcher) SendInvitationEmail(ctx context.Context, invitation *store.Invitation, inviter *store.User) error {
+	if invitation == nil || strings.TrimSpace(invitation.Email) == "" {
+		return errors.New("invitation has no email address")
+	}
+	return d.sendAccountEmail(ctx, func(baseURL string) *email.Message {
+		return buildInvitationEmailMessage(invitation, displayNameForEmail(inviter), baseURL)
+	})
+}
+
+func buildInvitationEmailMessage(invitation *store.Invitation, inviterName, baseURL string) *email.Message {
+	link := fmt.Sprintf("%s/auth/signup?invitation=%s", baseURL, url.QueryEscape(invitation.Code))
+	body := []string{
+		"Hi there,",
+		"",
+		fmt.Sprintf("%s invited you to join Memos.", inviterName),
+		"",
+		"Create your account:",
+		link,
+	}
+	if invitation.ExpiresTs != nil {
+		body = append(body, "", fmt.Sprintf("This invitation expires on %s.", time.Unix(*invitation.ExpiresTs, 0).UTC().Format(time.RFC1123)))
+	}
+
+	return &email.Message{
+		To:      []string{invitation.Email},
+		Subject: fmt.Sprintf("[Memos] %s invited you to join Memos", inviterName),
+		Body:    strings.Join(body, "\n"),
+	}
+}
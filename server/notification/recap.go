@@ -0,0 +1,285 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/email"
+	"github.com/usememos/memos/internal/markdown"
+	"github.com/usememos/memos/internal/profile"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// recapMaxItems is the number of memos listed per recap section.
+	recapMaxItems = 5
+	// recapSnippetLength is the length of the memo snippets in a recap.
+	recapSnippetLength = 160
+)
+
+var recapEmailTemplate = template.Must(template.New("recap").Parse(`<!DOCTYPE html>
+<html>
+<body style="margin:0;padding:24px;background:#f5f5f4;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Helvetica,Arial,sans-serif;color:#1c1917;">
+  <div style="max-width:560px;margin:0 auto;background:#ffffff;border-radius:8px;padding:24px;">
+    <p style="margin:0 0 8px;">Hi {{.ReceiverName}},</p>
+    <p style="margin:0 0 16px;">{{.Activity}}</p>
+    {{- if .OnThisDay}}
+    <h3 style="margin:16px 0 4px;font-size:15px;">On this day</h3>
+    <ul style="margin:0;padding:0;list-style:none;">
+      {{- range .OnThisDay}}
+      <li style="padding:12px 0;border-top:1px solid #e7e5e4;">
+        <div>{{.Snippet}}</div>
+        <div style="margin-top:4px;font-size:13px;color:#78716c;">{{.Label}} · <a href="{{.URL}}" style="color:#0d9488;">Open in Memos</a></div>
+      </li>
+      {{- end}}
+    </ul>
+    {{- end}}
+    {{- if .OpenTasks}}
+    <h3 style="margin:16px 0 4px;font-size:15px;">Open tasks</h3>
+    <ul style="margin:0;padding:0;list-style:none;">
+      {{- range .OpenTasks}}
+      <li style="padding:12px 0;border-top:1px solid #e7e5e4;">
+        <div>{{.Snippet}}</div>
+        <div style="margin-top:4px;font-size:13px;color:#78716c;">{{.Label}} · <a href="{{.URL}}" style="color:#0d9488;">Open in Memos</a></div>
+      </li>
+      {{- end}}
+    </ul>
+    {{- if .MoreOpenTasks}}
+    <p style="margin:8px 0 0;font-size:13px;color:#78716c;">And {{.MoreOpenTasks}} more {{if eq .MoreOpenTasks 1}}memo{{else}}memos{{end}} with open tasks.</p>
+    {{- end}}
+    {{- end}}
+    <p style="margin:16px 0 0;font-size:12px;color:#a8a29e;">You are receiving this because you turned on the weekly recap in your notification preferences.</p>
+  </div>
+</body>
+</html>
+`))
+
+type recapEmailItem struct {
+	Snippet string
+	Label   string
+	URL     string
+}
+
+type recapEmailData struct {
+	ReceiverName  string
+	Activity      string
+	OnThisDay     []recapEmailItem
+	OpenTasks     []recapEmailItem
+	MoreOpenTasks int
+}
+
+// userRecap is the activity of a user that a recap email covers.
+type userRecap struct {
+	createdCount int
+	// completedCount counts the memos updated this week whose task lists are all done. Task
+	// completion is not recorded per task, so this is the closest measure available.
+	completedCount int
+	onThisDayIDs   []int32
+	openTaskIDs    []int32
+}
+
+func (r *userRecap) empty() bool {
+	return r.createdCount == 0 && r.completedCount == 0 && len(r.onThisDayIDs) == 0 && len(r.openTaskIDs) == 0
+}
+
+// RecapDispatcher sends the weekly recap emails.
+type RecapDispatcher struct {
+	store    *store.Store
+	markdown markdown.Service
+	// email sends the recaps with the notification email settings.
+	email *EmailDispatcher
+}
+
+// NewRecapDispatcher creates a weekly recap dispatcher.
+func NewRecapDispatcher(profile *profile.Profile, store *store.Store, markdownService markdown.Service, sender EmailSender) *RecapDispatcher {
+	return &RecapDispatcher{
+		store:    store,
+		markdown: markdownService,
+		email:    NewEmailDispatcher(profile, store, sender),
+	}
+}
+
+// DispatchWeeklyRecaps sends the recap of the week before now to each user who opted in. Dates
+// are compared in UTC, like the digest schedule.
+func (d *RecapDispatcher) DispatchWeeklyRecaps(ctx context.Context, now time.Time) error {
+	setting, err := d.store.GetInstanceNotificationSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get notification setting")
+	}
+	emailSetting := setting.GetEmail()
+	if emailSetting == nil || !emailSetting.Enabled || d.email.baseURL() == "" {
+		return nil
+	}
+	config := EmailConfigFromInstanceSetting(emailSetting)
+	if err := config.Validate(); err != nil {
+		return errors.Wrap(err, "invalid notification email setting")
+	}
+
+	userSettings, err := d.store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_NOTIFICATION})
+	if err != nil {
+		return errors.Wrap(err, "failed to list notification settings")
+	}
+	for _, userSetting := range userSettings {
+		if !userSetting.GetNotification().GetWeeklyRecap() {
+			continue
+		}
+		message, err := d.buildRecapEmailMessage(ctx, userSetting.UserId, now.UTC())
+		if err != nil {
+			slog.Warn("Failed to build weekly recap",
+				slog.Any("err", err),
+				slog.Int64("user_id", int64(userSetting.UserId)))
+			continue
+		}
+		if message != nil {
+			message.ReplyTo = emailSetting.ReplyTo
+			d.email.sender(config, message)
+		}
+	}
+	return nil
+}
+
+// buildRecapEmailMessage builds the recap email of a user. It returns nil when there is
+// nothing to recap.
+func (d *RecapDispatcher) buildRecapEmailMessage(ctx context.Context, userID int32, now time.Time) (*email.Message, error) {
+	receiver, err := d.email.getEmailReceiver(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if receiver == nil {
+		return nil, nil
+	}
+	recap, err := d.collectUserRecap(ctx, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	if recap.empty() {
+		return nil, nil
+	}
+
+	shownOpenTaskIDs := recap.openTaskIDs[:min(len(recap.openTaskIDs), recapMaxItems)]
+	memosByID, err := d.email.listMemosByID(ctx, append(append([]int32{}, recap.onThisDayIDs...), shownOpenTaskIDs...))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get recap memos")
+	}
+	onThisDay, err := d.buildRecapItems(recap.onThisDayIDs, memosByID, func(memo *store.Memo) string {
+		years := now.Year() - time.Unix(memo.CreatedTs, 0).UTC().Year()
+		if years == 1 {
+			return "1 year ago"
+		}
+		return fmt.Sprintf("%d years ago", years)
+	})
+	if err != nil {
+		return nil, err
+	}
+	openTasks, err := d.buildRecapItems(shownOpenTaskIDs, memosByID, func(memo *store.Memo) string {
+		return "Created " + time.Unix(memo.CreatedTs, 0).UTC().Format("2006-01-02")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	if err := recapEmailTemplate.Execute(&body, recapEmailData{
+		ReceiverName:  displayNameForEmail(receiver),
+		Activity:      recapActivitySummary(recap),
+		OnThisDay:     onThisDay,
+		OpenTasks:     openTasks,
+		MoreOpenTasks: len(recap.openTaskIDs) - len(shownOpenTaskIDs),
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to render recap email")
+	}
+	return &email.Message{
+		To:      []string{receiver.Email},
+		Subject: "[Memos] Your weekly recap",
+		Body:    body.String(),
+		IsHTML:  true,
+	}, nil
+}
+
+// collectUserRecap scans the memos of a user for the recap of the week before now.
+func (d *RecapDispatcher) collectUserRecap(ctx context.Context, userID int32, now time.Time) (*userRecap, error) {
+	weekStart := now.AddDate(0, 0, -7).Unix()
+	normalStatus := store.Normal
+	limit := 1000
+	offset := 0
+	memoFind := &store.FindMemo{
+		CreatorID:       &userID,
+		ExcludeComments: true,
+		ExcludeContent:  true,
+		RowStatus:       &normalStatus,
+		Limit:           &limit,
+		Offset:          &offset,
+	}
+
+	recap := &userRecap{}
+	for {
+		memos, err := d.store.ListMemos(ctx, memoFind)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			break
+		}
+		for _, memo := range memos {
+			if memo.CreatedTs >= weekStart && memo.CreatedTs < now.Unix() {
+				recap.createdCount++
+			}
+			property := memo.Payload.GetProperty()
+			if property.GetHasTaskList() && !property.GetHasIncompleteTasks() && memo.UpdatedTs >= weekStart && memo.UpdatedTs < now.Unix() {
+				recap.completedCount++
+			}
+			if property.GetHasIncompleteTasks() {
+				recap.openTaskIDs = append(recap.openTaskIDs, memo.ID)
+			}
+			created := time.Unix(memo.CreatedTs, 0).UTC()
+			if created.Year() < now.Year() && created.Month() == now.Month() && created.Day() == now.Day() && len(recap.onThisDayIDs) < recapMaxItems {
+				recap.onThisDayIDs = append(recap.onThisDayIDs, memo.ID)
+			}
+		}
+		offset += limit
+	}
+	return recap, nil
+}
+
+func (d *RecapDispatcher) buildRecapItems(memoIDs []int32, memosByID map[int32]*store.Memo, label func(*store.Memo) string) ([]recapEmailItem, error) {
+	items := make([]recapEmailItem, 0, len(memoIDs))
+	for _, memoID := range memoIDs {
+		memo := memosByID[memoID]
+		if memo == nil {
+			continue
+		}
+		snippet, err := d.markdown.GenerateSnippet([]byte(memo.Content), recapSnippetLength)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate memo snippet")
+		}
+		if strings.TrimSpace(snippet) == "" {
+			snippet = "(No text)"
+		}
+		items = append(items, recapEmailItem{
+			Snippet: snippet,
+			Label:   label(memo),
+			URL:     d.email.memoURL(memo),
+		})
+	}
+	return items, nil
+}
+
+func recapActivitySummary(recap *userRecap) string {
+	memos := "memos"
+	if recap.createdCount == 1 {
+		memos = "memo"
+	}
+	taskLists := "memos"
+	if recap.completedCount == 1 {
+		taskLists = "memo"
+	}
+	return fmt.Sprintf("This week you created %d %s and completed the tasks of %d %s.", recap.createdCount, memos, recap.completedCount, taskLists)
+}
@@ -0,0 +1,177 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/internal/webhook"
+	"github.com/usememos/memos/internal/webpush"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// webPushMessage is the JSON payload read by the service worker of the web app.
+type webPushMessage struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	URL   string `json:"url"`
+}
+
+// WebPushDispatcher sends inbox notifications to the browsers users enabled push on.
+type WebPushDispatcher struct {
+	store  *store.Store
+	client *http.Client
+	// content renders notifications the same way as notification emails.
+	content *EmailDispatcher
+}
+
+// NewWebPushDispatcher creates a Web Push dispatcher. A nil client means the
+// SSRF-guarded webhook client.
+func NewWebPushDispatcher(profile *profile.Profile, store *store.Store, client *http.Client) *WebPushDispatcher {
+	if client == nil {
+		client = webhook.SafeClient()
+	}
+	return &WebPushDispatcher{
+		store:   store,
+		client:  client,
+		content: NewEmailDispatcher(profile, store, nil),
+	}
+}
+
+// GetOrCreateWebPushKeys returns the VAPID keys of the instance, generating them on first use.
+func GetOrCreateWebPushKeys(ctx context.Context, stores *store.Store) (*webpush.VAPIDKeys, error) {
+	setting, err := stores.GetInstanceWebPushSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if setting.VapidPublicKey != "" && setting.VapidPrivateKey != "" {
+		return &webpush.VAPIDKeys{PublicKey: setting.VapidPublicKey, PrivateKey: setting.VapidPrivateKey}, nil
+	}
+
+	keys, err := webpush.GenerateVAPIDKeys()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stores.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_WEB_PUSH,
+		Value: &storepb.InstanceSetting_WebPushSetting{WebPushSetting: &storepb.InstanceWebPushSetting{
+			VapidPublicKey:  keys.PublicKey,
+			VapidPrivateKey: keys.PrivateKey,
+		}},
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to save VAPID keys")
+	}
+	return keys, nil
+}
+
+// DispatchInboxPush sends the notification of an inbox entry to every push subscription of
+// the receiver. Subscriptions the push service reports as expired are removed.
+func (d *WebPushDispatcher) DispatchInboxPush(ctx context.Context, inbox *store.Inbox) error {
+	if inbox == nil || inbox.Message == nil {
+		return nil
+	}
+
+	subscriptions, err := d.store.GetUserWebPushSubscriptions(ctx, inbox.ReceiverID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get push subscriptions")
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+	baseURL := d.content.baseURL()
+	if baseURL == "" {
+		slog.Warn("Skipping inbox push notification because instance URL is required",
+			slog.Int64("inbox_id", int64(inbox.ID)),
+			slog.Int64("receiver_id", int64(inbox.ReceiverID)))
+		return nil
+	}
+	setting, err := d.store.GetInstanceWebPushSetting(ctx)
+	if err != nil {
+		return err
+	}
+	if setting.VapidPublicKey == "" || setting.VapidPrivateKey == "" {
+		return nil
+	}
+	keys := &webpush.VAPIDKeys{PublicKey: setting.VapidPublicKey, PrivateKey: setting.VapidPrivateKey}
+	subject, err := d.vapidSubject(ctx, baseURL)
+	if err != nil {
+		return err
+	}
+	if subject == "" {
+		slog.Warn("Skipping inbox push notification because an https instance URL or an admin email is required",
+			slog.Int64("inbox_id", int64(inbox.ID)),
+			slog.Int64("receiver_id", int64(inbox.ReceiverID)))
+		return nil
+	}
+
+	receiver, err := d.store.GetUser(ctx, &store.FindUser{ID: &inbox.ReceiverID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get notification receiver")
+	}
+	if receiver == nil {
+		return nil
+	}
+	content, err := d.content.renderInboxContent(ctx, inbox, receiver)
+	if err != nil {
+		return err
+	}
+	if content == nil {
+		return nil
+	}
+	payload, err := json.Marshal(&webPushMessage{
+		Title: strings.TrimPrefix(content.Subject, "[Memos] "),
+		Body:  content.Summary,
+		URL:   content.URL,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal push message")
+	}
+
+	for _, subscription := range subscriptions {
+		err := webpush.Send(ctx, d.client, &webpush.Subscription{
+			Endpoint: subscription.Endpoint,
+			P256dh:   subscription.P256Dh,
+			Auth:     subscription.Auth,
+		}, payload, keys, subject)
+		if errors.Is(err, webpush.ErrSubscriptionGone) {
+			if err := d.store.RemoveUserWebPushSubscription(ctx, inbox.ReceiverID, subscription.Id); err != nil {
+				slog.Warn("Failed to remove expired push subscription",
+					slog.Any("err", err),
+					slog.Int64("receiver_id", int64(inbox.ReceiverID)))
+			}
+			continue
+		}
+		if err != nil {
+			slog.Warn("Failed to send push notification",
+				slog.Any("err", err),
+				slog.Int64("inbox_id", int64(inbox.ID)),
+				slog.Int64("receiver_id", int64(inbox.ReceiverID)))
+		}
+	}
+	return nil
+}
+
+// vapidSubject returns the contact push services are given for the instance: the instance URL
+// when it is served over https, or else the email of an admin. It returns empty if neither exists.
+func (d *WebPushDispatcher) vapidSubject(ctx context.Context, baseURL string) (string, error) {
+	if webpush.ValidateSubject(baseURL) == nil {
+		return baseURL, nil
+	}
+	adminRole := store.RoleAdmin
+	admins, err := d.store.ListUsers(ctx, &store.FindUser{Role: &adminRole})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to list admins")
+	}
+	for _, admin := range admins {
+		if subject := "mailto:" + admin.Email; admin.Email != "" && webpush.ValidateSubject(subject) == nil {
+			return subject, nil
+		}
+	}
+	return "", nil
+}
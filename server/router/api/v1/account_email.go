@@ -0,0 +1,264 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/server/notification"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	invalidAccountEmailTokenError = "invalid or expired token"
+
+	maxAccountEmailRequestsPerAddress = 3
+	maxAccountEmailRequestsPerIP      = 10
+	// accountEmailRequestWindow is how long a client must stay quiet before its request
+	// counters restart.
+	accountEmailRequestWindow = time.Hour
+)
+
+// checkAccountEmailRequestAllowed counts a password reset or email verification request against
+// the requested email address and the client IP, and rejects it once either exceeds its limit.
+// Rejected requests are counted too, so a client that keeps retrying stays blocked.
+func (s *APIV1Service) checkAccountEmailRequestAllowed(ctx context.Context, email string) error {
+	now := time.Now()
+	keys := []signInAttemptKey{{kind: store.SignInAttemptKindEmailRequestAddress, identifier: strings.ToLower(email)}}
+	if clientIP := extractClientIP(ctx); clientIP != "" {
+		keys = append(keys, signInAttemptKey{kind: store.SignInAttemptKindEmailRequestIP, identifier: clientIP})
+	}
+	for _, key := range keys {
+		attempt, err := s.Store.IncrementSignInAttempt(ctx, &store.IncrementSignInAttempt{
+			Kind:          key.kind,
+			Identifier:    key.identifier,
+			FailureTs:     now.Unix(),
+			ResetBeforeTs: now.Add(-accountEmailRequestWindow).Unix(),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to record email request: %v", err)
+		}
+		maxRequests := int32(maxAccountEmailRequestsPerAddress)
+		if key.kind == store.SignInAttemptKindEmailRequestIP {
+			maxRequests = maxAccountEmailRequestsPerIP
+		}
+		if attempt.FailureCount > maxRequests {
+			slog.Warn("account email request throttled", slog.String("limited_by", string(key.kind)))
+			return status.Errorf(codes.ResourceExhausted, "too many email requests, try again later")
+		}
+	}
+	return nil
+}
+
+// RequestPasswordReset emails a password reset link to the active users with the given email.
+// The response does not reveal whether any user matched.
+func (s *APIV1Service) RequestPasswordReset(ctx context.Context, request *v1pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	email := strings.TrimSpace(request.Email)
+	if email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+	instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance general setting: %v", err)
+	}
+	if instanceGeneralSetting.DisallowPasswordAuth {
+		return nil, status.Errorf(codes.PermissionDenied, "password authentication is not allowed")
+	}
+	if err := s.checkAccountEmailRequestAllowed(ctx, email); err != nil {
+		return nil, err
+	}
+
+	users, err := s.listActiveUsersByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	dispatcher := notification.NewEmailDispatcher(s.Profile, s.Store, s.NotificationEmailSender)
+	for _, user := range users {
+		token, _, err := auth.GeneratePasswordResetToken(user.ID, passwordResetFingerprint(user), []byte(s.Secret))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate password reset token: %v", err)
+		}
+		if err := dispatcher.SendPasswordResetEmail(ctx, user, token); err != nil {
+			slog.Warn("Failed to send password reset email",
+				slog.Any("err", err),
+				slog.Int64("user_id", int64(user.ID)))
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ResetPassword sets a new password using a password reset token and signs the user out everywhere.
+func (s *APIV1Service) ResetPassword(ctx context.Context, request *v1pb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if err := validatePassword(request.NewPassword); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	claims, err := auth.ParsePasswordResetToken(request.Token, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, invalidAccountEmailTokenError)
+	}
+	user, err := s.getUserBySubject(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	// The fingerprint changes with the password, so each token can be used only once.
+	if user == nil || user.RowStatus != store.Normal || claims.Fingerprint != passwordResetFingerprint(user) {
+		return nil, status.Errorf(codes.PermissionDenied, invalidAccountEmailTokenError)
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
+	}
+	passwordHashStr := string(passwordHash)
+	// The reset link was delivered to the current email, which proves ownership of it.
+	emailVerified := true
+	currentTs := time.Now().Unix()
+	if _, err := s.Store.UpdateUser(ctx, &store.UpdateUser{
+		ID:            user.ID,
+		UpdatedTs:     &currentTs,
+		PasswordHash:  &passwordHashStr,
+		EmailVerified: &emailVerified,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+	}
+
+	if err := s.Store.RemoveAllUserRefreshTokens(ctx, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions: %v", err)
+	}
+	kind := store.SignInAttemptKindUsername
+	identifier := strings.ToLower(user.Username)
+	if err := s.Store.DeleteSignInAttempts(ctx, &store.DeleteSignInAttempt{Kind: &kind, Identifier: &identifier}); err != nil {
+		slog.Error("failed to reset sign-in attempts", slog.Any("error", err))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RequestEmailVerification emails a verification link to the active, unverified users with the given email.
+// The response does not reveal whether any user matched.
+func (s *APIV1Service) RequestEmailVerification(ctx context.Context, request *v1pb.RequestEmailVerificationRequest) (*emptypb.Empty, error) {
+	email := strings.TrimSpace(request.Email)
+	if email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+	if err := s.checkAccountEmailRequestAllowed(ctx, email); err != nil {
+		return nil, err
+	}
+
+	users, err := s.listActiveUsersByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.EmailVerified {
+			continue
+		}
+		if err := s.sendEmailVerification(ctx, user); err != nil {
+			slog.Warn("Failed to send email verification",
+				slog.Any("err", err),
+				slog.Int64("user_id", int64(user.ID)))
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// VerifyEmail marks the email of a user as verified using an email verification token.
+func (s *APIV1Service) VerifyEmail(ctx context.Context, request *v1pb.VerifyEmailRequest) (*emptypb.Empty, error) {
+	claims, err := auth.ParseEmailVerificationToken(request.Token, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, invalidAccountEmailTokenError)
+	}
+	user, err := s.getUserBySubject(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	// The fingerprint is bound to the email, so changing it invalidates pending tokens.
+	if user == nil || user.Email == "" || claims.Fingerprint != emailVerificationFingerprint(user.Email) {
+		return nil, status.Errorf(codes.PermissionDenied, invalidAccountEmailTokenError)
+	}
+	if user.EmailVerified {
+		return &emptypb.Empty{}, nil
+	}
+
+	emailVerified := true
+	currentTs := time.Now().Unix()
+	if _, err := s.Store.UpdateUser(ctx, &store.UpdateUser{
+		ID:            user.ID,
+		UpdatedTs:     &currentTs,
+		EmailVerified: &emailVerified,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// sendEmailVerificationIfRequired sends a verification email to a user with an unverified email
+// when the instance requires verified emails. Delivery failures are only logged.
+func (s *APIV1Service) sendEmailVerificationIfRequired(ctx context.Context, user *store.User) {
+	if user == nil || user.Email == "" || user.EmailVerified {
+		return
+	}
+	instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
+	if err != nil {
+		slog.Warn("Failed to get instance general setting", slog.Any("err", err))
+		return
+	}
+	if !instanceGeneralSetting.RequireEmailVerification {
+		return
+	}
+	if err := s.sendEmailVerification(ctx, user); err != nil {
+		slog.Warn("Failed to send email verification",
+			slog.Any("err", err),
+			slog.Int64("user_id", int64(user.ID)))
+	}
+}
+
+func (s *APIV1Service) sendEmailVerification(ctx context.Context, user *store.User) error {
+	token, _, err := auth.GenerateEmailVerificationToken(user.ID, emailVerificationFingerprint(user.Email), []byte(s.Secret))
+	if err != nil {
+		return err
+	}
+	dispatcher := notification.NewEmailDispatcher(s.Profile, s.Store, s.NotificationEmailSender)
+	return dispatcher.SendEmailVerificationEmail(ctx, user, token)
+}
+
+func (s *APIV1Service) listActiveUsersByEmail(ctx context.Context, email string) ([]*store.User, error) {
+	normal := store.Normal
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{
+		Email:     &email,
+		RowStatus: &normal,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+	return users, nil
+}
+
+func (s *APIV1Service) getUserBySubject(ctx context.Context, subject string) (*store.User, error) {
+	userID, err := util.ConvertStringToInt32(subject)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, invalidAccountEmailTokenError)
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	return user, nil
+}
+
+// passwordResetFingerprint binds a reset token to the current password and email of the user.
+func passwordResetFingerprint(user *store.User) string {
+	return auth.Fingerprint(user.PasswordHash + "\x00" + strings.ToLower(user.Email))
+}
+
+func emailVerificationFingerprint(email string) string {
+	return auth.Fingerprint(strings.ToLower(strings.TrimSpace(email)))
+}
@@ -1,5 +1,7 @@
 package v1
 
+import "github.com/usememos/memos/server/auth"
+
 // PublicMethods defines API endpoints that don't require authentication.
 // All other endpoints require a valid session or access token.
 //
@@ -13,6 +15,12 @@ var PublicMethods = map[string]struct{}{
 	"/memos.api.v1.AuthService/SignIn":       {},
 	"/memos.api.v1.AuthService/RefreshToken": {}, // Token refresh uses cookie, must be accessible when access token expired
 
+	// Auth Service - account recovery and email verification links are used while signed out
+	"/memos.api.v1.AuthService/RequestPasswordReset":     {},
+	"/memos.api.v1.AuthService/ResetPassword":            {},
+	"/memos.api.v1.AuthService/RequestEmailVerification": {},
+	"/memos.api.v1.AuthService/VerifyEmail":              {},
+
 	// Instance Service - needed before login to show instance info
 	"/memos.api.v1.InstanceService/GetInstanceProfile":       {},
 	"/memos.api.v1.InstanceService/GetInstanceSetting":       {},
@@ -37,7 +45,8 @@ var PublicMethods = map[string]struct{}{
 	"/memos.api.v1.MemoService/BatchGetLinkMetadata": {},
 
 	// Memo sharing - share-token endpoints require no authentication
-	"/memos.api.v1.MemoService/GetMemoByShare": {},
+	"/memos.api.v1.MemoService/GetMemoByShare":   {},
+	"/memos.api.v1.MemoService/ListMemosByShare": {},
 }
 
 // IsPublicMethod checks if a procedure path is public (no authentication required).
@@ -46,3 +55,168 @@ func IsPublicMethod(procedure string) bool {
 	_, ok := PublicMethods[procedure]
 	return ok
 }
+
+// MethodScopes maps each API procedure to the Personal Access Token scope it requires.
+//
+// Scopes only restrict PATs created with an explicit scope list; session tokens and
+// legacy PATs without scopes are unaffected. An empty scope means any valid token may
+// call the method. Procedures missing from this map require auth.ScopeAdmin.
+var MethodScopes = map[string]string{
+	// AI Service
+	"/memos.api.v1.AIService/Transcribe": auth.ScopeMemosWrite,
+
+	// Attachment Service
+	"/memos.api.v1.AttachmentService/CreateAttachment":       auth.ScopeAttachmentsWrite,
+	"/memos.api.v1.AttachmentService/ListAttachments":        auth.ScopeAttachmentsRead,
+	"/memos.api.v1.AttachmentService/GetAttachment":          auth.ScopeAttachmentsRead,
+	"/memos.api.v1.AttachmentService/UpdateAttachment":       auth.ScopeAttachmentsWrite,
+	"/memos.api.v1.AttachmentService/DeleteAttachment":       auth.ScopeAttachmentsWrite,
+	"/memos.api.v1.AttachmentService/BatchDeleteAttachments": auth.ScopeAttachmentsWrite,
+
+	// Auth Service - any valid token may identify itself
+	"/memos.api.v1.AuthService/GetCurrentUser":           "",
+	"/memos.api.v1.AuthService/SignIn":                   "",
+	"/memos.api.v1.AuthService/SignOut":                  "",
+	"/memos.api.v1.AuthService/RefreshToken":             "",
+	"/memos.api.v1.AuthService/RequestPasswordReset":     "",
+	"/memos.api.v1.AuthService/ResetPassword":            "",
+	"/memos.api.v1.AuthService/RequestEmailVerification": "",
+	"/memos.api.v1.AuthService/VerifyEmail":              "",
+
+	// Identity Provider Service
+	"/memos.api.v1.IdentityProviderService/ListIdentityProviders":  auth.ScopeSettingsRead,
+	"/memos.api.v1.IdentityProviderService/GetIdentityProvider":    auth.ScopeSettingsRead,
+	"/memos.api.v1.IdentityProviderService/CreateIdentityProvider": auth.ScopeAdmin,
+	"/memos.api.v1.IdentityProviderService/UpdateIdentityProvider": auth.ScopeAdmin,
+	"/memos.api.v1.IdentityProviderService/DeleteIdentityProvider": auth.ScopeAdmin,
+
+	// Instance Service
+	"/memos.api.v1.InstanceService/GetInstanceProfile":       "",
+	"/memos.api.v1.InstanceService/GetInstanceSetting":       auth.ScopeSettingsRead,
+	"/memos.api.v1.InstanceService/BatchGetInstanceSettings": auth.ScopeSettingsRead,
+	"/memos.api.v1.InstanceService/UpdateInstanceSetting":    auth.ScopeAdmin,
+	"/memos.api.v1.InstanceService/TestInstanceEmailSetting": auth.ScopeAdmin,
+	"/memos.api.v1.InstanceService/GetInstanceStats":         auth.ScopeAdmin,
+
+	// Memo Service
+	"/memos.api.v1.MemoService/CreateMemo":             auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/ListMemos":              auth.ScopeMemosRead,
+	"/memos.api.v1.MemoService/GetMemo":                auth.ScopeMemosRead,
+	"/memos.api.v1.MemoService/UpdateMemo":             auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/DeleteMemo":             auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/SetMemoAttachments":     auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/ListMemoAttachments":    auth.ScopeMemosRead,
+	"/memos.api.v1.MemoService/SetMemoRelations":       auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/ListMemoRelations":      auth.ScopeMemosRead,
+	"/memos.api.v1.MemoService/CreateMemoComment":      auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/ListMemoComments":       auth.ScopeMemosRead,
+	"/memos.api.v1.MemoService/ListMemoReactions":      auth.ScopeMemosRead,
+	"/memos.api.v1.MemoService/UpsertMemoReaction":     auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/DeleteMemoReaction":     auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/ListMemoCollaborators":  auth.ScopeMemosRead,
+	"/memos.api.v1.MemoService/AddMemoCollaborator":    auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/RemoveMemoCollaborator": auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/CreateMemoShare":        auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/ListMemoShares":         auth.ScopeMemosRead,
+	"/memos.api.v1.MemoService/DeleteMemoShare":        auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/GetMemoByShare":         auth.ScopeMemosRead,
+	"/memos.api.v1.MemoService/CreateCollectionShare":  auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/ListCollectionShares":   auth.ScopeMemosRead,
+	"/memos.api.v1.MemoService/DeleteCollectionShare":  auth.ScopeMemosWrite,
+	"/memos.api.v1.MemoService/ListMemosByShare":       auth.ScopeMemosRead,
+	"/memos.api.v1.MemoService/GetLinkMetadata":        auth.ScopeMemosRead,
+	"/memos.api.v1.MemoService/BatchGetLinkMetadata":   auth.ScopeMemosRead,
+
+	// Shortcut Service
+	"/memos.api.v1.ShortcutService/ListShortcuts":  auth.ScopeSettingsRead,
+	"/memos.api.v1.ShortcutService/GetShortcut":    auth.ScopeSettingsRead,
+	"/memos.api.v1.ShortcutService/CreateShortcut": auth.ScopeSettingsWrite,
+	"/memos.api.v1.ShortcutService/UpdateShortcut": auth.ScopeSettingsWrite,
+	"/memos.api.v1.ShortcutService/DeleteShortcut": auth.ScopeSettingsWrite,
+
+	// Invitation Service
+	"/memos.api.v1.InvitationService/ListInvitations":  auth.ScopeAdmin,
+	"/memos.api.v1.InvitationService/CreateInvitation": auth.ScopeAdmin,
+	"/memos.api.v1.InvitationService/RevokeInvitation": auth.ScopeAdmin,
+
+	// OAuth Service - authorizing clients is additionally limited to unscoped credentials
+	"/memos.api.v1.OAuthService/ListOAuthClients":     auth.ScopeSettingsRead,
+	"/memos.api.v1.OAuthService/CreateOAuthClient":    auth.ScopeSettingsWrite,
+	"/memos.api.v1.OAuthService/DeleteOAuthClient":    auth.ScopeSettingsWrite,
+	"/memos.api.v1.OAuthService/GetOAuthConsent":      auth.ScopeAdmin,
+	"/memos.api.v1.OAuthService/AuthorizeOAuthClient": auth.ScopeAdmin,
+
+	// Role Service
+	"/memos.api.v1.RoleService/ListRoles":  auth.ScopeAdmin,
+	"/memos.api.v1.RoleService/GetRole":    auth.ScopeAdmin,
+	"/memos.api.v1.RoleService/CreateRole": auth.ScopeAdmin,
+	"/memos.api.v1.RoleService/UpdateRole": auth.ScopeAdmin,
+	"/memos.api.v1.RoleService/DeleteRole": auth.ScopeAdmin,
+
+	// Audit Service
+	"/memos.api.v1.AuditService/ListAuditLogs": auth.ScopeAdmin,
+
+	// User Service - public profile reads are open to any token
+	"/memos.api.v1.UserService/ListUsers":                  auth.ScopeAdmin,
+	"/memos.api.v1.UserService/BatchGetUsers":              "",
+	"/memos.api.v1.UserService/GetUser":                    "",
+	"/memos.api.v1.UserService/CreateUser":                 auth.ScopeAdmin,
+	"/memos.api.v1.UserService/UpdateUser":                 auth.ScopeAdmin, // Can change the password, email and username.
+	"/memos.api.v1.UserService/DeleteUser":                 auth.ScopeAdmin,
+	"/memos.api.v1.UserService/UnlockUser":                 auth.ScopeAdmin,
+	"/memos.api.v1.UserService/ListAllUserStats":           "",
+	"/memos.api.v1.UserService/GetUserStats":               "",
+	"/memos.api.v1.UserService/GetUserSetting":             auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/UpdateUserSetting":          auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/ListUserSettings":           auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/ListLinkedIdentities":       auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/CreateLinkedIdentity":       auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/GetLinkedIdentity":          auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/DeleteLinkedIdentity":       auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/ListPersonalAccessTokens":   auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/CreatePersonalAccessToken":  auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/DeletePersonalAccessToken":  auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/ListSessions":               auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/RevokeSession":              auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/RevokeAllSessions":          auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/ListUserWebhooks":           auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/CreateUserWebhook":          auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/UpdateUserWebhook":          auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/DeleteUserWebhook":          auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/ListWebhookDeliveries":      auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/RedeliverWebhook":           auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/ListUserNotifications":      auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/UpdateUserNotification":     auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/DeleteUserNotification":     auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/SendTestNotification":       auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/ListUserPushSubscriptions":  auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/CreateUserPushSubscription": auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/DeleteUserPushSubscription": auth.ScopeSettingsWrite,
+	"/memos.api.v1.UserService/ListUserGroups":             auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/GetUserGroup":               auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/CreateUserGroup":            auth.ScopeAdmin,
+	"/memos.api.v1.UserService/UpdateUserGroup":            auth.ScopeAdmin,
+	"/memos.api.v1.UserService/DeleteUserGroup":            auth.ScopeAdmin,
+	"/memos.api.v1.UserService/ListUserGroupMembers":       auth.ScopeSettingsRead,
+	"/memos.api.v1.UserService/AddUserGroupMember":         auth.ScopeAdmin,
+	"/memos.api.v1.UserService/RemoveUserGroupMember":      auth.ScopeAdmin,
+}
+
+// RequiredScope returns the PAT scope needed to call a procedure.
+// Unknown procedures require auth.ScopeAdmin so new endpoints are closed to
+// scoped tokens until they are classified.
+func RequiredScope(procedure string) string {
+	if scope, ok := MethodScopes[procedure]; ok {
+		return scope
+	}
+	return auth.ScopeAdmin
+}
+
+// IsScopeAllowed checks whether an authentication result may call a procedure.
+// Returns true for unauthenticated requests; authentication is enforced separately.
+func IsScopeAllowed(result *auth.AuthResult, procedure string) bool {
+	if result == nil {
+		return true
+	}
+	return auth.HasScope(result.Scopes, RequiredScope(procedure))
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/usememos/memos/server/auth"
 )
 
 // TestPublicMethodsArePublic verifies that methods in PublicMethods are recognized as public.
@@ -88,3 +90,29 @@ func TestUnknownMethodsRequireAuth(t *testing.T) {
 		})
 	}
 }
+
+// TestRequiredScopes verifies that scoped tokens are limited to their methods.
+func TestRequiredScopes(t *testing.T) {
+	memosReader := &auth.AuthResult{Scopes: []string{auth.ScopeMemosRead}}
+	assert.True(t, IsScopeAllowed(memosReader, "/memos.api.v1.MemoService/ListMemos"))
+	assert.False(t, IsScopeAllowed(memosReader, "/memos.api.v1.MemoService/CreateMemo"))
+	assert.False(t, IsScopeAllowed(memosReader, "/memos.api.v1.AttachmentService/ListAttachments"))
+
+	memosWriter := &auth.AuthResult{Scopes: []string{auth.ScopeMemosWrite}}
+	assert.True(t, IsScopeAllowed(memosWriter, "/memos.api.v1.MemoService/ListMemos"))
+	assert.True(t, IsScopeAllowed(memosWriter, "/memos.api.v1.MemoService/CreateMemo"))
+
+	// Account takeover paths need the admin scope even for settings writers.
+	settingsWriter := &auth.AuthResult{Scopes: []string{auth.ScopeSettingsWrite}}
+	assert.True(t, IsScopeAllowed(settingsWriter, "/memos.api.v1.UserService/UpdateUserSetting"))
+	assert.False(t, IsScopeAllowed(settingsWriter, "/memos.api.v1.UserService/UpdateUser"))
+	assert.False(t, IsScopeAllowed(settingsWriter, "/memos.api.v1.UserService/DeleteUser"))
+
+	// Unknown methods require the admin scope.
+	assert.Equal(t, auth.ScopeAdmin, RequiredScope("/memos.api.v1.UnknownService/Method"))
+	assert.False(t, IsScopeAllowed(memosWriter, "/memos.api.v1.UnknownService/Method"))
+
+	// Unscoped tokens and unauthenticated requests are not restricted here.
+	assert.True(t, IsScopeAllowed(&auth.AuthResult{}, "/memos.api.v1.InstanceService/UpdateInstanceSetting"))
+	assert.True(t, IsScopeAllowed(nil, "/memos.api.v1.InstanceService/UpdateInstanceSetting"))
+}
@@ -152,7 +152,11 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 		if memo == nil {
 			return nil, status.Errorf(codes.NotFound, "memo not found: %s", *request.Attachment.Memo)
 		}
-		if !canModifyMemo(user, memo) {
+		canEdit, err := s.canEditMemo(ctx, user, memo)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+		}
+		if !canEdit {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 		create.MemoID = &memo.ID
@@ -702,7 +706,11 @@ func (s *APIV1Service) checkAttachmentAccess(ctx context.Context, attachment *st
 	if user == nil {
 		return status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	if memo.Visibility == store.Private && memo.CreatorID != user.ID && !isSuperUser(user) {
+	allowed, err := s.canAccessRestrictedMemo(ctx, user, memo)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+	}
+	if !allowed {
 		return status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 	return nil
@@ -0,0 +1,222 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// auditLogExportBatchSize is the number of entries read per query while exporting.
+const auditLogExportBatchSize = 500
+
+// ListAuditLogs lists audit log entries, newest first.
+// Only admins may call this.
+func (s *APIV1Service) ListAuditLogs(ctx context.Context, request *v1pb.ListAuditLogsRequest) (*v1pb.ListAuditLogsResponse, error) {
+	if _, err := s.fetchAdminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	find, err := s.buildFindAuditLog(ctx, request.Actor, request.Action, request.StartTime, request.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = normalizePageSize(pageToken.Limit)
+		offset = max(int(pageToken.Offset), 0)
+	} else {
+		limit = normalizePageSize(request.PageSize)
+	}
+	limitPlusOne := limit + 1
+	find.Limit = &limitPlusOne
+	find.Offset = &offset
+	auditLogs, err := s.Store.ListAuditLogs(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit logs: %v", err)
+	}
+
+	response := &v1pb.ListAuditLogsResponse{AuditLogs: []*v1pb.AuditLog{}}
+	if len(auditLogs) == limitPlusOne {
+		auditLogs = auditLogs[:limit]
+		response.NextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+	}
+	for _, auditLog := range auditLogs {
+		response.AuditLogs = append(response.AuditLogs, s.convertAuditLogFromStore(ctx, auditLog))
+	}
+	return response, nil
+}
+
+// RegisterAuditLogRoutes registers the audit log export endpoint on the given Echo router.
+func (s *APIV1Service) RegisterAuditLogRoutes(router routeRegistrar) {
+	authenticator := auth.NewAuthenticator(s.Store, s.Secret)
+	router.GET("/api/v1/auditLogs/export", func(c *echo.Context) error {
+		return s.exportAuditLogs(c, authenticator)
+	})
+}
+
+// exportAuditLogs streams every matching audit log entry as JSON Lines, newest first.
+// It accepts the ListAuditLogs filters as query parameters: actor, action,
+// start_time and end_time (RFC 3339).
+// Authentication is done via Bearer token in the Authorization header.
+func (s *APIV1Service) exportAuditLogs(c *echo.Context, authenticator *auth.Authenticator) error {
+	ctx := c.Request().Context()
+	ctx = auth.ApplyToContext(ctx, authenticator.Authenticate(ctx, c.Request().Header.Get(echo.HeaderAuthorization)))
+	if _, err := s.fetchAdminUser(ctx); err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return echo.NewHTTPError(http.StatusUnauthorized, "authentication required")
+		}
+		return echo.NewHTTPError(http.StatusForbidden, "permission denied")
+	}
+
+	query := c.Request().URL.Query()
+	var action v1pb.AuditLog_Action
+	if value := query.Get("action"); value != "" {
+		v, ok := v1pb.AuditLog_Action_value[value]
+		if !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid action: %s", value))
+		}
+		action = v1pb.AuditLog_Action(v)
+	}
+	startTime, err := parseAuditLogTime(query.Get("start_time"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	endTime, err := parseAuditLogTime(query.Get("end_time"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	find, err := s.buildFindAuditLog(ctx, query.Get("actor"), action, startTime, endTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, status.Convert(err).Message())
+	}
+
+	w := c.Response()
+	w.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+	w.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="audit-logs-%s.jsonl"`, time.Now().UTC().Format("20060102")))
+	w.WriteHeader(http.StatusOK)
+
+	limit, offset := auditLogExportBatchSize, 0
+	find.Limit = &limit
+	find.Offset = &offset
+	for {
+		auditLogs, err := s.Store.ListAuditLogs(ctx, find)
+		if err != nil {
+			// Headers are already sent, so the truncated body is all we can report.
+			slog.Error("failed to export audit logs", "error", err)
+			return nil
+		}
+		for _, auditLog := range auditLogs {
+			line, err := protojson.Marshal(s.convertAuditLogFromStore(ctx, auditLog))
+			if err != nil {
+				return nil
+			}
+			if _, err := w.Write(append(line, '\n')); err != nil {
+				return nil
+			}
+		}
+		if len(auditLogs) < limit {
+			return nil
+		}
+		offset += limit
+	}
+}
+
+// recordAuditLog appends an audit log entry attributed to actorID, capturing the
+// client address and user agent from the request. Failures are logged rather than
+// returned so that auditing never blocks the audited action.
+func (s *APIV1Service) recordAuditLog(ctx context.Context, actorID int32, action store.AuditAction, resource, detail string) {
+	clientInfo := s.extractClientInfo(ctx)
+	auditLog := &store.AuditLog{
+		ActorID:   actorID,
+		Action:    action,
+		Resource:  resource,
+		Detail:    detail,
+		IPAddress: clientInfo.IpAddress,
+		UserAgent: clientInfo.UserAgent,
+	}
+	if _, err := s.Store.CreateAuditLog(ctx, auditLog); err != nil {
+		slog.Error("failed to create audit log", "action", action, "resource", resource, "error", err)
+	}
+}
+
+func (s *APIV1Service) buildFindAuditLog(ctx context.Context, actor string, action v1pb.AuditLog_Action, startTime, endTime *timestamppb.Timestamp) (*store.FindAuditLog, error) {
+	find := &store.FindAuditLog{}
+	if actor != "" {
+		user, err := ResolveUserByName(ctx, s.Store, actor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid actor: %v", err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.NotFound, "actor %q not found", actor)
+		}
+		find.ActorID = &user.ID
+	}
+	if action != v1pb.AuditLog_ACTION_UNSPECIFIED {
+		storeAction := store.AuditAction(action.String())
+		find.Action = &storeAction
+	}
+	if startTime != nil {
+		ts := startTime.AsTime().Unix()
+		find.CreatedTsAfter = &ts
+	}
+	if endTime != nil {
+		ts := endTime.AsTime().Unix()
+		find.CreatedTsBefore = &ts
+	}
+	return find, nil
+}
+
+func parseAuditLogTime(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.Errorf("invalid time %q: must be RFC 3339", value)
+	}
+	return timestamppb.New(t), nil
+}
+
+// convertAuditLogFromStore converts an audit log entry, resolving the actor's current
+// username. The actor is left empty when the user no longer exists.
+func (s *APIV1Service) convertAuditLogFromStore(ctx context.Context, auditLog *store.AuditLog) *v1pb.AuditLog {
+	result := &v1pb.AuditLog{
+		Name:       fmt.Sprintf("%s%d", AuditLogNamePrefix, auditLog.ID),
+		Action:     v1pb.AuditLog_Action(v1pb.AuditLog_Action_value[string(auditLog.Action)]),
+		Resource:   auditLog.Resource,
+		Detail:     auditLog.Detail,
+		IpAddress:  auditLog.IPAddress,
+		UserAgent:  auditLog.UserAgent,
+		CreateTime: timestamppb.New(time.Unix(auditLog.CreatedTs, 0)),
+	}
+	if auditLog.ActorID != 0 {
+		actor, err := s.Store.GetUser(ctx, &store.FindUser{ID: &auditLog.ActorID})
+		if err != nil {
+			slog.Warn("failed to get audit log actor", "userID", auditLog.ActorID, "error", err)
+		} else if actor != nil {
+			result.Actor = BuildUserName(actor.Username)
+		}
+	}
+	return result
+}
@@ -63,9 +63,21 @@ func (s *APIV1Service) GetCurrentUser(ctx context.Context, _ *v1pb.GetCurrentUse
 // Returns: User info, access token, and token expiry.
 func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest) (*v1pb.SignInResponse, error) {
 	var existingUser *store.User
+	var signInMethod string
 
 	// Authentication Method 1: Password-based authentication
 	if passwordCredentials := request.GetPasswordCredentials(); passwordCredentials != nil {
+		instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get instance general setting, error: %v", err)
+		}
+		// Reject the attempt early while the client IP or username is locked out.
+		protection := resolveSignInProtectionPolicy(instanceGeneralSetting.GetSignInProtection())
+		clientIP := extractClientIP(ctx)
+		if err := s.checkSignInAllowed(ctx, protection, passwordCredentials.Username, clientIP); err != nil {
+			return nil, err
+		}
+
 		user, err := s.Store.GetUser(ctx, &store.FindUser{
 			Username: &passwordCredentials.Username,
 		})
@@ -73,21 +85,24 @@ func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest)
 			return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
 		}
 		if user == nil {
+			s.recordSignInFailure(ctx, protection, passwordCredentials.Username, clientIP, "user not found")
 			return nil, status.Errorf(codes.InvalidArgument, unmatchedUsernameAndPasswordError)
 		}
 		// Compare the stored hashed password, with the hashed version of the password that was received.
 		if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(passwordCredentials.Password)); err != nil {
+			s.recordSignInFailure(ctx, protection, passwordCredentials.Username, clientIP, "invalid password")
 			return nil, status.Errorf(codes.InvalidArgument, unmatchedUsernameAndPasswordError)
 		}
-		instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get instance general setting, error: %v", err)
-		}
 		// Check if the password auth in is allowed.
-		if instanceGeneralSetting.DisallowPasswordAuth && user.Role == store.RoleUser {
+		if instanceGeneralSetting.DisallowPasswordAuth && !isSuperUser(user) {
 			return nil, status.Errorf(codes.PermissionDenied, "password signin is not allowed")
 		}
+		if instanceGeneralSetting.RequireEmailVerification && !user.EmailVerified && !isSuperUser(user) {
+			return nil, status.Errorf(codes.FailedPrecondition, "email address is not verified")
+		}
+		s.resetSignInFailures(ctx, protection, passwordCredentials.Username)
 		existingUser = user
+		signInMethod = "password"
 	} else if ssoCredentials := request.GetSsoCredentials(); ssoCredentials != nil {
 		// Authentication Method 2: SSO (OAuth2) authentication
 		identityProvider, userInfo, err := s.resolveSSOIdentity(ctx, ssoCredentials.IdpName, ssoCredentials.Code, ssoCredentials.RedirectUri, ssoCredentials.CodeVerifier)
@@ -99,6 +114,7 @@ func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest)
 			return nil, err
 		}
 		existingUser = user
+		signInMethod = "sso: " + ssoCredentials.IdpName
 	}
 
 	if existingUser == nil {
@@ -112,6 +128,7 @@ func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to sign in: %v", err)
 	}
+	s.recordAuditLog(ctx, existingUser.ID, store.AuditActionSignIn, BuildUserName(existingUser.Username), signInMethod)
 
 	return &v1pb.SignInResponse{
 		User:                 convertUserFromStore(existingUser, existingUser),
@@ -213,6 +230,7 @@ func (s *APIV1Service) resolveSSOUser(ctx context.Context, currentUser *store.Us
 		}
 		return nil, status.Errorf(codes.Internal, "failed to create user identity, error: %v", err)
 	}
+	s.DispatchUserCreatedWebhook(ctx, user)
 	return user, nil
 }
 
@@ -369,11 +387,14 @@ func (s *APIV1Service) doSignIn(ctx context.Context, user *store.User) (string,
 
 	// Store refresh token metadata
 	clientInfo := s.extractClientInfo(ctx)
+	now := timestamppb.Now()
 	refreshTokenRecord := &storepb.RefreshTokensUserSetting_RefreshToken{
 		TokenId:    tokenID,
 		ExpiresAt:  timestamppb.New(refreshExpiresAt),
-		CreatedAt:  timestamppb.Now(),
+		CreatedAt:  now,
 		ClientInfo: clientInfo,
+		LastSeenAt: now,
+		LastSeenIp: clientInfo.IpAddress,
 	}
 	if err := s.Store.AddUserRefreshToken(ctx, user.ID, refreshTokenRecord); err != nil {
 		slog.Error("failed to store refresh token", "error", err)
@@ -429,6 +450,9 @@ func (s *APIV1Service) SignOut(ctx context.Context, _ *v1pb.SignOutRequest) (*em
 	if err := s.clearAuthCookies(ctx); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to clear auth cookies, error: %v", err)
 	}
+	if userID := auth.GetUserID(ctx); userID != 0 {
+		s.recordAuditLog(ctx, userID, store.AuditActionSignOut, "", "")
+	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -476,13 +500,27 @@ func (s *APIV1Service) RefreshToken(ctx context.Context, _ *v1pb.RefreshTokenReq
 		return nil, status.Errorf(codes.Internal, "failed to generate refresh token: %v", err)
 	}
 
-	// Store new refresh token (add before remove to handle race conditions)
+	// Store new refresh token (add before remove to handle race conditions).
+	// The session keeps its original creation time and sign-in client info,
+	// while last-seen time and IP reflect this refresh.
 	clientInfo := s.extractClientInfo(ctx)
+	now := timestamppb.Now()
 	newRefreshTokenRecord := &storepb.RefreshTokensUserSetting_RefreshToken{
 		TokenId:    newTokenID,
 		ExpiresAt:  timestamppb.New(newRefreshExpiresAt),
-		CreatedAt:  timestamppb.Now(),
+		CreatedAt:  now,
 		ClientInfo: clientInfo,
+		LastSeenAt: now,
+		LastSeenIp: clientInfo.IpAddress,
+	}
+	if oldToken, err := s.Store.GetUserRefreshTokenByID(ctx, user.ID, oldTokenID); err == nil && oldToken != nil {
+		if oldToken.CreatedAt != nil {
+			newRefreshTokenRecord.CreatedAt = oldToken.CreatedAt
+		}
+		if oldToken.ClientInfo != nil {
+			newRefreshTokenRecord.ClientInfo = oldToken.ClientInfo
+		}
+		newRefreshTokenRecord.Description = oldToken.Description
 	}
 	if err := s.Store.AddUserRefreshToken(ctx, user.ID, newRefreshTokenRecord); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to store refresh token: %v", err)
@@ -598,6 +636,13 @@ func (s *APIV1Service) fetchCurrentUser(ctx context.Context) (*store.User, error
 	if user.RowStatus == store.Archived {
 		return nil, nil
 	}
+	// A scoped Personal Access Token without the admin scope acts with user
+	// privileges, even when it belongs to an admin or a user with a custom role.
+	if user.Role != store.RoleUser && !auth.HasScopeInContext(ctx, auth.ScopeAdmin) {
+		restricted := *user
+		restricted.Role = store.RoleUser
+		return &restricted, nil
+	}
 	return user, nil
 }
 
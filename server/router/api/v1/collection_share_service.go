@@ -0,0 +1,208 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// CreateCollectionShare creates an opaque share link for the memos matching one of
+// the user's shortcuts or carrying a tag. Only the user themself may call this.
+func (s *APIV1Service) CreateCollectionShare(ctx context.Context, request *v1pb.CreateCollectionShareRequest) (*v1pb.CollectionShare, error) {
+	user, err := s.getCollectionShareOwner(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if request.CollectionShare == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "collection_share is required")
+	}
+
+	create := &store.CollectionShare{
+		// Generate a URL-safe token using shortuuid (base57-encoded UUID v4, 22 chars, 122-bit entropy).
+		UID:       shortuuid.New(),
+		CreatorID: user.ID,
+		Tag:       strings.TrimPrefix(strings.TrimSpace(request.CollectionShare.Tag), "#"),
+	}
+	if request.CollectionShare.Shortcut != "" {
+		shortcutUser, shortcutID, err := s.extractUserAndShortcutIDFromName(ctx, request.CollectionShare.Shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid shortcut name: %v", err)
+		}
+		if shortcutUser.ID != user.ID {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		create.ShortcutID = shortcutID
+	}
+	if (create.ShortcutID == "") == (create.Tag == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "exactly one of shortcut and tag must be set")
+	}
+	if create.ShortcutID != "" {
+		filters, err := s.Store.GetCollectionShareFilters(ctx, create)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut: %v", err)
+		}
+		if filters == nil {
+			return nil, status.Errorf(codes.NotFound, "shortcut not found")
+		}
+	}
+	if request.CollectionShare.ExpireTime != nil {
+		ts := request.CollectionShare.ExpireTime.AsTime().Unix()
+		if ts <= time.Now().Unix() {
+			return nil, status.Errorf(codes.InvalidArgument, "expire_time must be in the future")
+		}
+		create.ExpiresTs = &ts
+	}
+
+	share, err := s.Store.CreateCollectionShare(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create collection share")
+	}
+	// The share token is a bearer credential, so only the shared collection is recorded.
+	auditDetail := "never expires"
+	if share.ExpiresTs != nil {
+		auditDetail = "expires " + time.Unix(*share.ExpiresTs, 0).UTC().Format(time.RFC3339)
+	}
+	collectionShare := convertCollectionShareFromStore(share, user.Username)
+	resource := collectionShare.Shortcut
+	if resource == "" {
+		resource = "#" + share.Tag
+	}
+	s.recordAuditLog(ctx, user.ID, store.AuditActionCreateCollectionShare, resource, auditDetail)
+
+	return collectionShare, nil
+}
+
+// ListCollectionShares lists a user's collection share links.
+// Only the user themself may call this.
+func (s *APIV1Service) ListCollectionShares(ctx context.Context, request *v1pb.ListCollectionSharesRequest) (*v1pb.ListCollectionSharesResponse, error) {
+	user, err := s.getCollectionShareOwner(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.Store.ListCollectionShares(ctx, &store.FindCollectionShare{CreatorID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collection shares")
+	}
+
+	response := &v1pb.ListCollectionSharesResponse{}
+	for _, share := range shares {
+		response.CollectionShares = append(response.CollectionShares, convertCollectionShareFromStore(share, user.Username))
+	}
+	return response, nil
+}
+
+// DeleteCollectionShare revokes a collection share link.
+// Only the user themself may call this.
+func (s *APIV1Service) DeleteCollectionShare(ctx context.Context, request *v1pb.DeleteCollectionShareRequest) (*emptypb.Empty, error) {
+	// name format: users/{username}/collectionShares/{shareToken}
+	tokens, err := GetNameParentTokens(request.Name, UserNamePrefix, CollectionShareNamePrefix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid collection share name: %v", err)
+	}
+	user, err := s.getCollectionShareOwner(ctx, BuildUserName(tokens[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	shareToken := tokens[1]
+	share, err := s.Store.GetCollectionShare(ctx, &store.FindCollectionShare{UID: &shareToken})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection share")
+	}
+	if share == nil || share.CreatorID != user.ID {
+		return nil, status.Errorf(codes.NotFound, "collection share not found")
+	}
+
+	if err := s.Store.DeleteCollectionShare(ctx, &store.DeleteCollectionShare{ID: &share.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete collection share")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListMemosByShare resolves a collection share token to the memos of the collection.
+// No authentication required. The collection only ever contains the share creator's
+// own memos, so a shortcut's filter cannot reach memos of other users.
+// Returns NOT_FOUND for invalid or expired tokens, and for deleted shortcuts.
+func (s *APIV1Service) ListMemosByShare(ctx context.Context, request *v1pb.ListMemosByShareRequest) (*v1pb.ListMemosByShareResponse, error) {
+	share, err := s.Store.GetCollectionShare(ctx, &store.FindCollectionShare{UID: &request.ShareId})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection share")
+	}
+	if share == nil || share.IsExpired() {
+		return nil, status.Errorf(codes.NotFound, "not found")
+	}
+	filters, err := s.Store.GetCollectionShareFilters(ctx, share)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve collection share: %v", err)
+	}
+	if filters == nil {
+		return nil, status.Errorf(codes.NotFound, "not found")
+	}
+
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		RowStatus:        &normalStatus,
+		ExcludeComments:  true,
+		ExcludeScheduled: true,
+		Filters:          filters,
+	}
+	memoMessages, nextPageToken, err := s.listMemoMessages(ctx, memoFind, request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.ListMemosByShareResponse{
+		Memos:         memoMessages,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// getCollectionShareOwner resolves the user owning the collection shares under parent,
+// and checks that it is the current user.
+func (s *APIV1Service) getCollectionShareOwner(ctx context.Context, parent string) (*store.User, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	user, err := ResolveUserByName(ctx, s.Store, parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if user.ID != currentUser.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return user, nil
+}
+
+// convertCollectionShareFromStore converts a store CollectionShare to the proto CollectionShare message.
+// name format: users/{username}/collectionShares/{shareToken}.
+func convertCollectionShareFromStore(share *store.CollectionShare, username string) *v1pb.CollectionShare {
+	pb := &v1pb.CollectionShare{
+		Name:       fmt.Sprintf("%s/%s%s", BuildUserName(username), CollectionShareNamePrefix, share.UID),
+		Tag:        share.Tag,
+		CreateTime: timestamppb.New(time.Unix(share.CreatedTs, 0)),
+	}
+	if share.ShortcutID != "" {
+		pb.Shortcut = constructShortcutName(username, share.ShortcutID)
+	}
+	if share.ExpiresTs != nil {
+		pb.ExpireTime = timestamppb.New(time.Unix(*share.ExpiresTs, 0))
+	}
+	return pb
+}
@@ -42,6 +42,10 @@ func (s *ConnectServiceHandler) RegisterConnectHandlers(mux *http.ServeMux, opts
 		wrap(apiv1connect.NewAIServiceHandler(s, opts...)),
 		wrap(apiv1connect.NewShortcutServiceHandler(s, opts...)),
 		wrap(apiv1connect.NewIdentityProviderServiceHandler(s, opts...)),
+		wrap(apiv1connect.NewInvitationServiceHandler(s, opts...)),
+		wrap(apiv1connect.NewOAuthServiceHandler(s, opts...)),
+		wrap(apiv1connect.NewRoleServiceHandler(s, opts...)),
+		wrap(apiv1connect.NewAuditServiceHandler(s, opts...)),
 	}
 
 	for _, h := range handlers {
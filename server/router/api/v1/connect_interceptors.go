@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"reflect"
 	"runtime/debug"
+	"strings"
 
 	"connectrpc.com/connect"
 	pkgerrors "github.com/pkg/errors"
@@ -41,8 +42,9 @@ func (*MetadataInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc
 		if origin := header.Get("Origin"); origin != "" {
 			md.Set("origin", origin)
 		}
-		if xff := header.Get("X-Forwarded-For"); xff != "" {
-			md.Set("x-forwarded-for", xff)
+		// Keep every X-Forwarded-For line so the proxy hops can be read from the right
+		if xff := header.Values("X-Forwarded-For"); len(xff) > 0 {
+			md.Set("x-forwarded-for", strings.Join(xff, ", "))
 		}
 		if xfp := header.Get("X-Forwarded-Proto"); xfp != "" {
 			md.Set("x-forwarded-proto", xfp)
@@ -57,6 +59,10 @@ func (*MetadataInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc
 		if cookie := header.Get("Cookie"); cookie != "" {
 			md.Set("cookie", cookie)
 		}
+		// Forward the peer address so services can identify clients that connect directly
+		if peerAddr := req.Peer().Addr; peerAddr != "" {
+			md.Set(peerAddrMetadataKey, peerAddr)
+		}
 
 		// Set metadata in context so services can use metadata.FromIncomingContext()
 		ctx = metadata.NewIncomingContext(ctx, md)
@@ -206,7 +212,8 @@ func (in *RecoveryInterceptor) logPanic(procedure string, panicValue any) {
 
 // AuthInterceptor handles authentication for Connect handlers.
 //
-// It enforces authentication for all endpoints except those listed in PublicMethods.
+// It enforces authentication for all endpoints except those listed in PublicMethods,
+// and Personal Access Token scopes as configured in MethodScopes.
 // Role-based authorization (admin checks) remains in the service layer.
 type AuthInterceptor struct {
 	authenticator *auth.Authenticator
@@ -231,6 +238,11 @@ func (in *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 			return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
 		}
 
+		// Enforce Personal Access Token scopes
+		if !IsScopeAllowed(result, req.Spec().Procedure) {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("token is missing required scope %q", RequiredScope(req.Spec().Procedure)))
+		}
+
 		ctx = auth.ApplyToContext(ctx, result)
 
 		return next(ctx, req)
@@ -93,6 +93,38 @@ func (s *ConnectServiceHandler) RefreshToken(ctx context.Context, req *connect.R
 	})
 }
 
+func (s *ConnectServiceHandler) RequestPasswordReset(ctx context.Context, req *connect.Request[v1pb.RequestPasswordResetRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.RequestPasswordReset(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ResetPassword(ctx context.Context, req *connect.Request[v1pb.ResetPasswordRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.ResetPassword(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RequestEmailVerification(ctx context.Context, req *connect.Request[v1pb.RequestEmailVerificationRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.RequestEmailVerification(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) VerifyEmail(ctx context.Context, req *connect.Request[v1pb.VerifyEmailRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.VerifyEmail(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // UserService
 
 func (s *ConnectServiceHandler) ListUsers(ctx context.Context, req *connect.Request[v1pb.ListUsersRequest]) (*connect.Response[v1pb.ListUsersResponse], error) {
@@ -141,6 +173,14 @@ func (s *ConnectServiceHandler) DeleteUser(ctx context.Context, req *connect.Req
 	})
 }
 
+func (s *ConnectServiceHandler) UnlockUser(ctx context.Context, req *connect.Request[v1pb.UnlockUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.UnlockUser(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) ListAllUserStats(ctx context.Context, req *connect.Request[v1pb.ListAllUserStatsRequest]) (*connect.Response[v1pb.ListAllUserStatsResponse], error) {
 	resp, err := s.APIV1Service.ListAllUserStats(ctx, req.Msg)
 	if err != nil {
@@ -237,6 +277,30 @@ func (s *ConnectServiceHandler) DeletePersonalAccessToken(ctx context.Context, r
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListSessions(ctx context.Context, req *connect.Request[v1pb.ListSessionsRequest]) (*connect.Response[v1pb.ListSessionsResponse], error) {
+	resp, err := s.APIV1Service.ListSessions(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RevokeSession(ctx context.Context, req *connect.Request[v1pb.RevokeSessionRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.RevokeSession(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RevokeAllSessions(ctx context.Context, req *connect.Request[v1pb.RevokeAllSessionsRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.RevokeAllSessions(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) ListUserWebhooks(ctx context.Context, req *connect.Request[v1pb.ListUserWebhooksRequest]) (*connect.Response[v1pb.ListUserWebhooksResponse], error) {
 	resp, err := s.APIV1Service.ListUserWebhooks(ctx, req.Msg)
 	if err != nil {
@@ -269,6 +333,22 @@ func (s *ConnectServiceHandler) DeleteUserWebhook(ctx context.Context, req *conn
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1pb.ListWebhookDeliveriesRequest]) (*connect.Response[v1pb.ListWebhookDeliveriesResponse], error) {
+	resp, err := s.APIV1Service.ListWebhookDeliveries(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RedeliverWebhook(ctx context.Context, req *connect.Request[v1pb.RedeliverWebhookRequest]) (*connect.Response[v1pb.WebhookDelivery], error) {
+	resp, err := s.APIV1Service.RedeliverWebhook(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) ListUserNotifications(ctx context.Context, req *connect.Request[v1pb.ListUserNotificationsRequest]) (*connect.Response[v1pb.ListUserNotificationsResponse], error) {
 	resp, err := s.APIV1Service.ListUserNotifications(ctx, req.Msg)
 	if err != nil {
@@ -293,6 +373,102 @@ func (s *ConnectServiceHandler) DeleteUserNotification(ctx context.Context, req
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) SendTestNotification(ctx context.Context, req *connect.Request[v1pb.SendTestNotificationRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.SendTestNotification(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListUserPushSubscriptions(ctx context.Context, req *connect.Request[v1pb.ListUserPushSubscriptionsRequest]) (*connect.Response[v1pb.ListUserPushSubscriptionsResponse], error) {
+	resp, err := s.APIV1Service.ListUserPushSubscriptions(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateUserPushSubscription(ctx context.Context, req *connect.Request[v1pb.CreateUserPushSubscriptionRequest]) (*connect.Response[v1pb.UserPushSubscription], error) {
+	resp, err := s.APIV1Service.CreateUserPushSubscription(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteUserPushSubscription(ctx context.Context, req *connect.Request[v1pb.DeleteUserPushSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteUserPushSubscription(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListUserGroups(ctx context.Context, req *connect.Request[v1pb.ListUserGroupsRequest]) (*connect.Response[v1pb.ListUserGroupsResponse], error) {
+	resp, err := s.APIV1Service.ListUserGroups(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetUserGroup(ctx context.Context, req *connect.Request[v1pb.GetUserGroupRequest]) (*connect.Response[v1pb.UserGroup], error) {
+	resp, err := s.APIV1Service.GetUserGroup(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateUserGroup(ctx context.Context, req *connect.Request[v1pb.CreateUserGroupRequest]) (*connect.Response[v1pb.UserGroup], error) {
+	resp, err := s.APIV1Service.CreateUserGroup(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateUserGroup(ctx context.Context, req *connect.Request[v1pb.UpdateUserGroupRequest]) (*connect.Response[v1pb.UserGroup], error) {
+	resp, err := s.APIV1Service.UpdateUserGroup(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteUserGroup(ctx context.Context, req *connect.Request[v1pb.DeleteUserGroupRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteUserGroup(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListUserGroupMembers(ctx context.Context, req *connect.Request[v1pb.ListUserGroupMembersRequest]) (*connect.Response[v1pb.ListUserGroupMembersResponse], error) {
+	resp, err := s.APIV1Service.ListUserGroupMembers(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) AddUserGroupMember(ctx context.Context, req *connect.Request[v1pb.AddUserGroupMemberRequest]) (*connect.Response[v1pb.UserGroupMember], error) {
+	resp, err := s.APIV1Service.AddUserGroupMember(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RemoveUserGroupMember(ctx context.Context, req *connect.Request[v1pb.RemoveUserGroupMemberRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.RemoveUserGroupMember(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // MemoService
 
 func (s *ConnectServiceHandler) CreateMemo(ctx context.Context, req *connect.Request[v1pb.CreateMemoRequest]) (*connect.Response[v1pb.Memo], error) {
@@ -407,6 +583,30 @@ func (s *ConnectServiceHandler) DeleteMemoReaction(ctx context.Context, req *con
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListMemoCollaborators(ctx context.Context, req *connect.Request[v1pb.ListMemoCollaboratorsRequest]) (*connect.Response[v1pb.ListMemoCollaboratorsResponse], error) {
+	resp, err := s.APIV1Service.ListMemoCollaborators(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) AddMemoCollaborator(ctx context.Context, req *connect.Request[v1pb.AddMemoCollaboratorRequest]) (*connect.Response[v1pb.MemoCollaborator], error) {
+	resp, err := s.APIV1Service.AddMemoCollaborator(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RemoveMemoCollaborator(ctx context.Context, req *connect.Request[v1pb.RemoveMemoCollaboratorRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.RemoveMemoCollaborator(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) CreateMemoShare(ctx context.Context, req *connect.Request[v1pb.CreateMemoShareRequest]) (*connect.Response[v1pb.MemoShare], error) {
 	resp, err := s.APIV1Service.CreateMemoShare(ctx, req.Msg)
 	if err != nil {
@@ -431,8 +631,39 @@ func (s *ConnectServiceHandler) DeleteMemoShare(ctx context.Context, req *connec
 	return connect.NewResponse(resp), nil
 }
 
+// GetMemoByShare sets the access cookie of password-protected shares.
 func (s *ConnectServiceHandler) GetMemoByShare(ctx context.Context, req *connect.Request[v1pb.GetMemoByShareRequest]) (*connect.Response[v1pb.Memo], error) {
-	resp, err := s.APIV1Service.GetMemoByShare(ctx, req.Msg)
+	return connectWithHeaderCarrier(ctx, func(ctx context.Context) (*v1pb.Memo, error) {
+		return s.APIV1Service.GetMemoByShare(ctx, req.Msg)
+	})
+}
+
+func (s *ConnectServiceHandler) CreateCollectionShare(ctx context.Context, req *connect.Request[v1pb.CreateCollectionShareRequest]) (*connect.Response[v1pb.CollectionShare], error) {
+	resp, err := s.APIV1Service.CreateCollectionShare(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListCollectionShares(ctx context.Context, req *connect.Request[v1pb.ListCollectionSharesRequest]) (*connect.Response[v1pb.ListCollectionSharesResponse], error) {
+	resp, err := s.APIV1Service.ListCollectionShares(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteCollectionShare(ctx context.Context, req *connect.Request[v1pb.DeleteCollectionShareRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteCollectionShare(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListMemosByShare(ctx context.Context, req *connect.Request[v1pb.ListMemosByShareRequest]) (*connect.Response[v1pb.ListMemosByShareResponse], error) {
+	resp, err := s.APIV1Service.ListMemosByShare(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
@@ -598,3 +829,123 @@ func (s *ConnectServiceHandler) DeleteIdentityProvider(ctx context.Context, req
 	}
 	return connect.NewResponse(resp), nil
 }
+
+// InvitationService
+
+func (s *ConnectServiceHandler) ListInvitations(ctx context.Context, req *connect.Request[v1pb.ListInvitationsRequest]) (*connect.Response[v1pb.ListInvitationsResponse], error) {
+	resp, err := s.APIV1Service.ListInvitations(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateInvitation(ctx context.Context, req *connect.Request[v1pb.CreateInvitationRequest]) (*connect.Response[v1pb.Invitation], error) {
+	resp, err := s.APIV1Service.CreateInvitation(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RevokeInvitation(ctx context.Context, req *connect.Request[v1pb.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.RevokeInvitation(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// OAuthService
+
+func (s *ConnectServiceHandler) ListOAuthClients(ctx context.Context, req *connect.Request[v1pb.ListOAuthClientsRequest]) (*connect.Response[v1pb.ListOAuthClientsResponse], error) {
+	resp, err := s.APIV1Service.ListOAuthClients(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateOAuthClient(ctx context.Context, req *connect.Request[v1pb.CreateOAuthClientRequest]) (*connect.Response[v1pb.CreateOAuthClientResponse], error) {
+	resp, err := s.APIV1Service.CreateOAuthClient(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteOAuthClient(ctx context.Context, req *connect.Request[v1pb.DeleteOAuthClientRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteOAuthClient(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetOAuthConsent(ctx context.Context, req *connect.Request[v1pb.GetOAuthConsentRequest]) (*connect.Response[v1pb.OAuthConsent], error) {
+	resp, err := s.APIV1Service.GetOAuthConsent(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) AuthorizeOAuthClient(ctx context.Context, req *connect.Request[v1pb.AuthorizeOAuthClientRequest]) (*connect.Response[v1pb.AuthorizeOAuthClientResponse], error) {
+	resp, err := s.APIV1Service.AuthorizeOAuthClient(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RoleService
+
+func (s *ConnectServiceHandler) ListRoles(ctx context.Context, req *connect.Request[v1pb.ListRolesRequest]) (*connect.Response[v1pb.ListRolesResponse], error) {
+	resp, err := s.APIV1Service.ListRoles(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetRole(ctx context.Context, req *connect.Request[v1pb.GetRoleRequest]) (*connect.Response[v1pb.Role], error) {
+	resp, err := s.APIV1Service.GetRole(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateRole(ctx context.Context, req *connect.Request[v1pb.CreateRoleRequest]) (*connect.Response[v1pb.Role], error) {
+	resp, err := s.APIV1Service.CreateRole(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateRole(ctx context.Context, req *connect.Request[v1pb.UpdateRoleRequest]) (*connect.Response[v1pb.Role], error) {
+	resp, err := s.APIV1Service.UpdateRole(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteRole(ctx context.Context, req *connect.Request[v1pb.DeleteRoleRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteRole(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// AuditService
+
+func (s *ConnectServiceHandler) ListAuditLogs(ctx context.Context, req *connect.Request[v1pb.ListAuditLogsRequest]) (*connect.Response[v1pb.ListAuditLogsResponse], error) {
+	resp, err := s.APIV1Service.ListAuditLogs(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,15 +15,9 @@ import (
 )
 
 func (s *APIV1Service) CreateIdentityProvider(ctx context.Context, request *v1pb.CreateIdentityProviderRequest) (*v1pb.IdentityProvider, error) {
-	currentUser, err := s.fetchCurrentUser(ctx)
+	user, err := s.fetchUserWithPermission(ctx, store.PermissionManageIdentityProviders)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
-	}
-	if currentUser == nil {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-	if currentUser.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		return nil, err
 	}
 
 	idpUID, err := ValidateAndGenerateUID(request.IdentityProviderId)
@@ -37,6 +32,7 @@ func (s *APIV1Service) CreateIdentityProvider(ctx context.Context, request *v1pb
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create identity provider, error: %+v", err)
 	}
+	s.recordAuditLog(ctx, user.ID, store.AuditActionCreateIdentityProvider, IdentityProviderNamePrefix+identityProvider.Uid, identityProvider.Name)
 	return convertIdentityProviderFromStore(identityProvider), nil
 }
 
@@ -74,15 +70,9 @@ func (s *APIV1Service) GetIdentityProvider(ctx context.Context, request *v1pb.Ge
 }
 
 func (s *APIV1Service) UpdateIdentityProvider(ctx context.Context, request *v1pb.UpdateIdentityProviderRequest) (*v1pb.IdentityProvider, error) {
-	currentUser, err := s.fetchCurrentUser(ctx)
+	user, err := s.fetchUserWithPermission(ctx, store.PermissionManageIdentityProviders)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
-	}
-	if currentUser == nil {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-	if currentUser.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		return nil, err
 	}
 
 	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
@@ -133,19 +123,14 @@ func (s *APIV1Service) UpdateIdentityProvider(ctx context.Context, request *v1pb
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update identity provider, error: %+v", err)
 	}
+	s.recordAuditLog(ctx, user.ID, store.AuditActionUpdateIdentityProvider, IdentityProviderNamePrefix+identityProvider.Uid, "fields: "+strings.Join(request.UpdateMask.Paths, ", "))
 	return convertIdentityProviderFromStore(identityProvider), nil
 }
 
 func (s *APIV1Service) DeleteIdentityProvider(ctx context.Context, request *v1pb.DeleteIdentityProviderRequest) (*emptypb.Empty, error) {
-	currentUser, err := s.fetchCurrentUser(ctx)
+	user, err := s.fetchUserWithPermission(ctx, store.PermissionManageIdentityProviders)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
-	}
-	if currentUser == nil {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-	if currentUser.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		return nil, err
 	}
 
 	uid, err := ExtractIdentityProviderUIDFromName(request.Name)
@@ -165,6 +150,7 @@ func (s *APIV1Service) DeleteIdentityProvider(ctx context.Context, request *v1pb
 	if err := s.Store.DeleteIdentityProvider(ctx, &store.DeleteIdentityProvider{ID: identityProvider.Id}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete identity provider, error: %+v", err)
 	}
+	s.recordAuditLog(ctx, user.ID, store.AuditActionDeleteIdentityProvider, IdentityProviderNamePrefix+identityProvider.Uid, identityProvider.Name)
 	return &emptypb.Empty{}, nil
 }
 
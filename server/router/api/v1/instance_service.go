@@ -108,6 +108,8 @@ func (s *APIV1Service) getInstanceSettingByName(ctx context.Context, name string
 		_, err = s.Store.GetInstanceNotificationSetting(ctx)
 	case storepb.InstanceSettingKey_AI:
 		_, err = s.Store.GetInstanceAISetting(ctx)
+	case storepb.InstanceSettingKey_WEBHOOKS:
+		_, err = s.Store.GetInstanceWebhooksSetting(ctx)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported instance setting key: %v", instanceSettingKey)
 	}
@@ -125,9 +127,10 @@ func (s *APIV1Service) getInstanceSettingByName(ctx context.Context, name string
 		return nil, status.Errorf(codes.NotFound, "instance setting not found")
 	}
 
-	// Storage and notification settings contain credentials; restrict to admins only.
+	// Storage, notification and webhooks settings contain credentials; restrict to settings managers.
 	if instanceSetting.Key == storepb.InstanceSettingKey_STORAGE ||
-		instanceSetting.Key == storepb.InstanceSettingKey_NOTIFICATION {
+		instanceSetting.Key == storepb.InstanceSettingKey_NOTIFICATION ||
+		instanceSetting.Key == storepb.InstanceSettingKey_WEBHOOKS {
 		user, err := caller.currentUser(ctx, s)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -135,7 +138,11 @@ func (s *APIV1Service) getInstanceSettingByName(ctx context.Context, name string
 		if user == nil {
 			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 		}
-		if user.Role != store.RoleAdmin {
+		canManageSettings, err := s.hasPermission(ctx, user, store.PermissionManageSettings)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+		}
+		if !canManageSettings {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 	}
@@ -148,7 +155,10 @@ func (s *APIV1Service) getInstanceSettingByName(ctx context.Context, name string
 		if user == nil {
 			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 		}
-		isAdminCaller = user.Role == store.RoleAdmin
+		isAdminCaller, err = s.hasPermission(ctx, user, store.PermissionManageSettings)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+		}
 	}
 
 	result := convertInstanceSettingFromStore(instanceSetting)
@@ -174,9 +184,6 @@ func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.
 	if user == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	if user.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-	}
 
 	// TODO: Apply update_mask if specified
 	_ = request.UpdateMask
@@ -186,6 +193,17 @@ func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.
 	}
 
 	updateSetting := convertInstanceSettingToStore(request.Setting)
+	allowed, err := s.hasPermission(ctx, user, store.PermissionManageSettings)
+	if err == nil && !allowed && updateSetting.Key == storepb.InstanceSettingKey_TAGS {
+		// Tag metadata is part of moderation, so moderators may manage it too.
+		allowed, err = s.hasPermission(ctx, user, store.PermissionModerateMemos)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if !allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
 
 	// Preserve write-only credential fields when the caller sends an empty value.
 	// An empty string means "no change", not "clear the credential".
@@ -211,6 +229,10 @@ func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.
 		if err := s.prepareInstanceAISettingForUpdate(ctx, updateSetting.GetAiSetting()); err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid AI setting: %v", err)
 		}
+	case storepb.InstanceSettingKey_WEBHOOKS:
+		if err := s.prepareInstanceWebhooksSettingForUpdate(ctx, updateSetting.GetWebhooksSetting(), request.Setting.GetWebhooksSetting()); err != nil {
+			return nil, err
+		}
 	default:
 		// No credential preservation needed for other setting types.
 	}
@@ -219,20 +241,16 @@ func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert instance setting: %v", err)
 	}
+	// Setting values may carry credentials, so only the setting name is recorded.
+	s.recordAuditLog(ctx, user.ID, store.AuditActionUpdateInstanceSetting, InstanceSettingNamePrefix+updateSetting.Key.String(), "")
 
 	return convertInstanceSettingFromStore(instanceSetting), nil
 }
 
 func (s *APIV1Service) TestInstanceEmailSetting(ctx context.Context, request *v1pb.TestInstanceEmailSettingRequest) (*emptypb.Empty, error) {
-	user, err := s.fetchCurrentUser(ctx)
+	user, err := s.fetchUserWithPermission(ctx, store.PermissionManageSettings)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
-	}
-	if user == nil {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-	if user.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		return nil, err
 	}
 
 	emailSetting, err := s.resolveTestEmailSetting(ctx, request.Email)
@@ -328,6 +346,10 @@ func convertInstanceSettingFromStore(setting *storepb.InstanceSetting) *v1pb.Ins
 		instanceSetting.Value = &v1pb.InstanceSetting_AiSetting{
 			AiSetting: convertInstanceAISettingFromStore(setting.GetAiSetting()),
 		}
+	case *storepb.InstanceSetting_WebhooksSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_WebhooksSetting_{
+			WebhooksSetting: convertInstanceWebhooksSettingFromStore(setting.GetWebhooksSetting()),
+		}
 	default:
 		// Leave Value unset for unsupported setting variants.
 	}
@@ -367,6 +389,10 @@ func convertInstanceSettingToStore(setting *v1pb.InstanceSetting) *storepb.Insta
 		instanceSetting.Value = &storepb.InstanceSetting_AiSetting{
 			AiSetting: convertInstanceAISettingToStore(setting.GetAiSetting()),
 		}
+	case storepb.InstanceSettingKey_WEBHOOKS:
+		instanceSetting.Value = &storepb.InstanceSetting_WebhooksSetting{
+			WebhooksSetting: convertInstanceWebhooksSettingToStore(setting.GetWebhooksSetting()),
+		}
 	default:
 		// Keep the default GeneralSetting value
 	}
@@ -386,6 +412,7 @@ func convertInstanceGeneralSettingFromStore(setting *storepb.InstanceGeneralSett
 		WeekStartDayOffset:       setting.WeekStartDayOffset,
 		DisallowChangeUsername:   setting.DisallowChangeUsername,
 		DisallowChangeNickname:   setting.DisallowChangeNickname,
+		RequireEmailVerification: setting.RequireEmailVerification,
 	}
 	if setting.CustomProfile != nil {
 		generalSetting.CustomProfile = &v1pb.InstanceSetting_GeneralSetting_CustomProfile{
@@ -394,6 +421,15 @@ func convertInstanceGeneralSettingFromStore(setting *storepb.InstanceGeneralSett
 			LogoUrl:     setting.CustomProfile.LogoUrl,
 		}
 	}
+	if setting.SignInProtection != nil {
+		generalSetting.SignInProtection = &v1pb.InstanceSetting_GeneralSetting_SignInProtection{
+			Disabled:               setting.SignInProtection.Disabled,
+			MaxFailuresPerUsername: setting.SignInProtection.MaxFailuresPerUsername,
+			MaxFailuresPerIp:       setting.SignInProtection.MaxFailuresPerIp,
+			LockoutSeconds:         setting.SignInProtection.LockoutSeconds,
+			MaxLockoutSeconds:      setting.SignInProtection.MaxLockoutSeconds,
+		}
+	}
 	return generalSetting
 }
 
@@ -409,6 +445,7 @@ func convertInstanceGeneralSettingToStore(setting *v1pb.InstanceSetting_GeneralS
 		WeekStartDayOffset:       setting.WeekStartDayOffset,
 		DisallowChangeUsername:   setting.DisallowChangeUsername,
 		DisallowChangeNickname:   setting.DisallowChangeNickname,
+		RequireEmailVerification: setting.RequireEmailVerification,
 	}
 	if setting.CustomProfile != nil {
 		generalSetting.CustomProfile = &storepb.InstanceCustomProfile{
@@ -417,6 +454,15 @@ func convertInstanceGeneralSettingToStore(setting *v1pb.InstanceSetting_GeneralS
 			LogoUrl:     setting.CustomProfile.LogoUrl,
 		}
 	}
+	if setting.SignInProtection != nil {
+		generalSetting.SignInProtection = &storepb.InstanceSignInProtectionSetting{
+			Disabled:               setting.SignInProtection.Disabled,
+			MaxFailuresPerUsername: setting.SignInProtection.MaxFailuresPerUsername,
+			MaxFailuresPerIp:       setting.SignInProtection.MaxFailuresPerIp,
+			LockoutSeconds:         setting.SignInProtection.LockoutSeconds,
+			MaxLockoutSeconds:      setting.SignInProtection.MaxLockoutSeconds,
+		}
+	}
 	return generalSetting
 }
 
@@ -472,6 +518,7 @@ func convertInstanceMemoRelatedSettingFromStore(setting *storepb.InstanceMemoRel
 		ContentLengthLimit:    setting.ContentLengthLimit,
 		EnableDoubleClickEdit: setting.EnableDoubleClickEdit,
 		Reactions:             setting.Reactions,
+		BumpTimeOnPublish:     setting.BumpTimeOnPublish,
 	}
 }
 
@@ -483,6 +530,7 @@ func convertInstanceMemoRelatedSettingToStore(setting *v1pb.InstanceSetting_Memo
 		ContentLengthLimit:    setting.ContentLengthLimit,
 		EnableDoubleClickEdit: setting.EnableDoubleClickEdit,
 		Reactions:             setting.Reactions,
+		BumpTimeOnPublish:     setting.BumpTimeOnPublish,
 	}
 }
 
@@ -643,10 +691,21 @@ func validateInstanceSetting(setting *v1pb.InstanceSetting) error {
 	if err != nil {
 		return err
 	}
-	if key != storepb.InstanceSettingKey_TAGS.String() {
+	switch key {
+	case storepb.InstanceSettingKey_TAGS.String():
+		return validateInstanceTagsSetting(setting.GetTagsSetting())
+	case storepb.InstanceSettingKey_WEBHOOKS.String():
+		for _, hook := range setting.GetWebhooksSetting().GetWebhooks() {
+			if _, err := convertUserWebhookFormatToStore(hook.Format, ""); err != nil {
+				return errors.Errorf("unsupported webhook format %v", hook.Format)
+			}
+		}
+		return nil
+	case storepb.InstanceSettingKey_WEB_PUSH.String():
+		return errors.New("web push keys are managed by the server")
+	default:
 		return nil
 	}
-	return validateInstanceTagsSetting(setting.GetTagsSetting())
 }
 
 func (s *APIV1Service) prepareInstanceAISettingForUpdate(ctx context.Context, setting *storepb.InstanceAISetting) error {
@@ -43,17 +43,10 @@ func (c *instanceStatsCache) set(v *v1pb.InstanceStats, ttl time.Duration) {
 	c.expiry = time.Now().Add(ttl)
 }
 
-// GetInstanceStats returns resource usage statistics. Admin only.
+// GetInstanceStats returns resource usage statistics. Requires the view stats permission.
 func (s *APIV1Service) GetInstanceStats(ctx context.Context, _ *v1pb.GetInstanceStatsRequest) (*v1pb.InstanceStats, error) {
-	user, err := s.fetchCurrentUser(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
-	}
-	if user == nil {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-	if user.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	if _, err := s.fetchUserWithPermission(ctx, store.PermissionViewStats); err != nil {
+		return nil, err
 	}
 
 	if cached, ok := s.instanceStatsCache.get(); ok {
@@ -0,0 +1,244 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// instanceWebhookCreatorID is the creator ID of the deliveries of instance webhooks,
+// which belong to no user.
+const instanceWebhookCreatorID int32 = 0
+
+// dispatchInstanceWebhooks enqueues the payload for each instance webhook subscribed to it.
+// Memo events are only delivered for public and protected memos, so scheduled memos are
+// skipped until they are published.
+func (s *APIV1Service) dispatchInstanceWebhooks(ctx context.Context, payload *webhook.WebhookRequestPayload) error {
+	if !slices.Contains(webhook.InstanceEventTypes, payload.ActivityType) {
+		return nil
+	}
+	if payload.Memo != nil {
+		visibility := storedMemoVisibility(payload.Memo)
+		if visibility != v1pb.Visibility_PUBLIC && visibility != v1pb.Visibility_PROTECTED {
+			return nil
+		}
+	}
+	setting, err := s.Store.GetInstanceWebhooksSetting(ctx)
+	if err != nil {
+		return err
+	}
+	for _, hook := range setting.Webhooks {
+		subscribed, err := s.isWebhookSubscribed(ctx, hook, payload)
+		if err != nil {
+			slog.Warn("Failed to match instance webhook subscription", slog.Any("err", err), slog.String("webhook", hook.Id))
+			continue
+		}
+		if !subscribed {
+			continue
+		}
+		hookPayload := *payload
+		if err := s.enqueueWebhookDelivery(ctx, instanceWebhookCreatorID, hook, &hookPayload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storedMemoVisibility returns the visibility a memo is stored with. The Visibility of a
+// scheduled memo holds the visibility it gets once published, while it is stored private
+// until then.
+func storedMemoVisibility(memo *v1pb.Memo) v1pb.Visibility {
+	if memo.PublishTime != nil {
+		return v1pb.Visibility_PRIVATE
+	}
+	return memo.Visibility
+}
+
+// DispatchUserCreatedWebhook dispatches instance webhooks when a user registers or is created.
+func (s *APIV1Service) DispatchUserCreatedWebhook(ctx context.Context, user *store.User) {
+	s.dispatchUserRelatedWebhook(ctx, user, webhook.EventUserCreated)
+}
+
+// DispatchUserDeletedWebhook dispatches instance webhooks when a user is deleted.
+func (s *APIV1Service) DispatchUserDeletedWebhook(ctx context.Context, user *store.User) {
+	s.dispatchUserRelatedWebhook(ctx, user, webhook.EventUserDeleted)
+}
+
+func (s *APIV1Service) dispatchUserRelatedWebhook(ctx context.Context, user *store.User, activityType string) {
+	userMessage := convertUserFromStore(user, user)
+	if err := s.dispatchInstanceWebhooks(ctx, &webhook.WebhookRequestPayload{
+		ActivityType: activityType,
+		Creator:      userMessage.Name,
+		User:         userMessage,
+	}); err != nil {
+		slog.Warn("Failed to dispatch user webhook", slog.Any("err", err), slog.String("activity_type", activityType))
+	}
+}
+
+// findWebhook returns the webhook of a delivery: one of a user's webhooks, or an instance
+// webhook for the instance webhook creator ID. It returns nil if it does not exist.
+func (s *APIV1Service) findWebhook(ctx context.Context, creatorID int32, webhookID string) (*storepb.WebhooksUserSetting_Webhook, error) {
+	if creatorID != instanceWebhookCreatorID {
+		return s.findUserWebhook(ctx, creatorID, webhookID)
+	}
+	setting, err := s.Store.GetInstanceWebhooksSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, hook := range setting.Webhooks {
+		if hook.Id == webhookID {
+			return hook, nil
+		}
+	}
+	return nil, nil
+}
+
+// updateWebhookHealth saves the failure count and disabled state of a webhook found by
+// findWebhook onto its latest stored copy, so concurrent edits of its other fields are kept.
+func (s *APIV1Service) updateWebhookHealth(ctx context.Context, creatorID int32, hook *storepb.WebhooksUserSetting_Webhook) error {
+	if creatorID != instanceWebhookCreatorID {
+		current, err := s.findUserWebhook(ctx, creatorID, hook.Id)
+		if err != nil || current == nil {
+			return err
+		}
+		current.ConsecutiveFailures = hook.ConsecutiveFailures
+		current.Disabled = hook.Disabled
+		return s.Store.UpdateUserWebhook(ctx, creatorID, current)
+	}
+	setting, err := s.Store.GetInstanceWebhooksSetting(ctx)
+	if err != nil {
+		return err
+	}
+	for _, existing := range setting.Webhooks {
+		if existing.Id == hook.Id {
+			existing.ConsecutiveFailures = hook.ConsecutiveFailures
+			existing.Disabled = hook.Disabled
+		}
+	}
+	_, err = s.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_WEBHOOKS,
+		Value: &storepb.InstanceSetting_WebhooksSetting{
+			WebhooksSetting: &storepb.InstanceWebhooksSetting{Webhooks: setting.Webhooks},
+		},
+	})
+	return err
+}
+
+// prepareInstanceWebhooksSettingForUpdate validates the instance webhooks, generates the ID
+// and secret of new webhooks, and keeps the secret and failure count of existing ones.
+// It returns status errors.
+func (s *APIV1Service) prepareInstanceWebhooksSettingForUpdate(ctx context.Context, setting *storepb.InstanceWebhooksSetting, request *v1pb.InstanceSetting_WebhooksSetting) error {
+	if setting == nil {
+		return status.Errorf(codes.InvalidArgument, "webhooks setting is required")
+	}
+	existing, err := s.Store.GetInstanceWebhooksSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get existing webhooks setting: %v", err)
+	}
+	existingWebhooks := map[string]*storepb.WebhooksUserSetting_Webhook{}
+	for _, hook := range existing.Webhooks {
+		existingWebhooks[hook.Id] = hook
+	}
+	rotateSecret := map[string]bool{}
+	for _, hook := range request.GetWebhooks() {
+		if hook.Id != "" && hook.RotateSecret {
+			rotateSecret[hook.Id] = true
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, hook := range setting.Webhooks {
+		hook.Url = strings.TrimSpace(hook.Url)
+		if hook.Url == "" {
+			return status.Errorf(codes.InvalidArgument, "webhook URL is required")
+		}
+		if err := webhook.ValidateURL(hook.Url); err != nil {
+			return err
+		}
+		if err := s.validateWebhookSubscription(ctx, webhook.InstanceEventTypes, hook.EventTypes, hook.Filter); err != nil {
+			return err
+		}
+		if err := webhook.ValidateFormat(webhook.Format(hook.Format), hook.Url); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid webhook format: %v", err)
+		}
+
+		if hook.Id == "" {
+			hook.Id = generateUserWebhookID()
+		} else {
+			previous, ok := existingWebhooks[hook.Id]
+			if !ok {
+				return status.Errorf(codes.InvalidArgument, "webhook %q not found", hook.Id)
+			}
+			hook.Secret = previous.Secret
+			if hook.Disabled == previous.Disabled {
+				hook.ConsecutiveFailures = previous.ConsecutiveFailures
+			}
+		}
+		if seen[hook.Id] {
+			return status.Errorf(codes.InvalidArgument, "duplicate webhook %q", hook.Id)
+		}
+		seen[hook.Id] = true
+		if hook.Secret == "" || rotateSecret[hook.Id] {
+			secret, err := webhook.GenerateSecret()
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+			}
+			hook.Secret = secret
+		}
+	}
+	return nil
+}
+
+func convertInstanceWebhooksSettingFromStore(setting *storepb.InstanceWebhooksSetting) *v1pb.InstanceSetting_WebhooksSetting {
+	if setting == nil {
+		return nil
+	}
+	webhooksSetting := &v1pb.InstanceSetting_WebhooksSetting{
+		Webhooks: make([]*v1pb.InstanceSetting_Webhook, 0, len(setting.Webhooks)),
+	}
+	for _, hook := range setting.Webhooks {
+		webhooksSetting.Webhooks = append(webhooksSetting.Webhooks, &v1pb.InstanceSetting_Webhook{
+			Id:         hook.Id,
+			Title:      hook.Title,
+			Url:        hook.Url,
+			EventTypes: hook.EventTypes,
+			Filter:     hook.Filter,
+			Format:     convertUserWebhookFormatFromStore(hook.Format),
+			Disabled:   hook.Disabled,
+			Secret:     hook.Secret,
+		})
+	}
+	return webhooksSetting
+}
+
+func convertInstanceWebhooksSettingToStore(setting *v1pb.InstanceSetting_WebhooksSetting) *storepb.InstanceWebhooksSetting {
+	if setting == nil {
+		return nil
+	}
+	webhooksSetting := &storepb.InstanceWebhooksSetting{
+		Webhooks: make([]*storepb.WebhooksUserSetting_Webhook, 0, len(setting.Webhooks)),
+	}
+	for _, hook := range setting.Webhooks {
+		// Unsupported formats are rejected by validateInstanceSetting.
+		format, _ := convertUserWebhookFormatToStore(hook.Format, "")
+		webhooksSetting.Webhooks = append(webhooksSetting.Webhooks, &storepb.WebhooksUserSetting_Webhook{
+			Id:         hook.Id,
+			Title:      hook.Title,
+			Url:        hook.Url,
+			EventTypes: hook.EventTypes,
+			Filter:     hook.Filter,
+			Format:     format,
+			Disabled:   hook.Disabled,
+		})
+	}
+	return webhooksSetting
+}
@@ -0,0 +1,204 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/notification"
+	"github.com/usememos/memos/store"
+)
+
+// invitationCodeLength is the length of generated invitation codes.
+const invitationCodeLength = 32
+
+// ListInvitations returns all invitations of the instance.
+// Requires the manage users permission.
+func (s *APIV1Service) ListInvitations(ctx context.Context, _ *v1pb.ListInvitationsRequest) (*v1pb.ListInvitationsResponse, error) {
+	if _, err := s.fetchUserWithPermission(ctx, store.PermissionManageUsers); err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.Store.ListInvitations(ctx, &store.FindInvitation{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list invitations: %v", err)
+	}
+	creatorNames, err := s.listInvitationCreatorNames(ctx, invitations)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1pb.ListInvitationsResponse{}
+	for _, invitation := range invitations {
+		response.Invitations = append(response.Invitations, convertInvitationFromStore(invitation, creatorNames[invitation.CreatorID]))
+	}
+	return response, nil
+}
+
+// CreateInvitation creates an invitation code and optionally emails it.
+// Requires the manage users permission; only admins can invite admins.
+func (s *APIV1Service) CreateInvitation(ctx context.Context, request *v1pb.CreateInvitationRequest) (*v1pb.Invitation, error) {
+	currentUser, err := s.fetchUserWithPermission(ctx, store.PermissionManageUsers)
+	if err != nil {
+		return nil, err
+	}
+	if request.Invitation == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invitation is required")
+	}
+
+	create := &store.Invitation{
+		UID:       shortuuid.New(),
+		CreatorID: currentUser.ID,
+		Role:      store.RoleUser,
+		Email:     strings.TrimSpace(request.Invitation.Email),
+		MaxUses:   1,
+	}
+	if request.Invitation.Role != v1pb.User_ROLE_UNSPECIFIED {
+		create.Role = convertUserRoleToStore(request.Invitation.Role)
+	}
+	if create.Role == store.RoleAdmin && !isSuperUser(currentUser) {
+		return nil, status.Errorf(codes.PermissionDenied, "only admins can invite admins")
+	}
+	if create.Email != "" && !util.ValidateEmail(create.Email) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email: %s", create.Email)
+	}
+	if request.Invitation.MaxUses < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_uses must not be negative")
+	}
+	if request.Invitation.MaxUses > 0 {
+		create.MaxUses = request.Invitation.MaxUses
+	}
+	if request.Invitation.ExpireTime != nil {
+		expiresTs := request.Invitation.ExpireTime.AsTime().Unix()
+		if expiresTs <= time.Now().Unix() {
+			return nil, status.Errorf(codes.InvalidArgument, "expire_time must be in the future")
+		}
+		create.ExpiresTs = &expiresTs
+	}
+	if request.SendEmail && create.Email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required to send the invitation")
+	}
+
+	code, err := util.RandomString(invitationCodeLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate invitation code: %v", err)
+	}
+	create.Code = code
+
+	invitation, err := s.Store.CreateInvitation(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create invitation: %v", err)
+	}
+
+	if request.SendEmail {
+		dispatcher := notification.NewEmailDispatcher(s.Profile, s.Store, s.NotificationEmailSender)
+		if err := dispatcher.SendInvitationEmail(ctx, invitation, currentUser); err != nil {
+			// The invitation is still usable, so keep it and report the delivery failure.
+			return nil, status.Errorf(codes.FailedPrecondition, "invitation %s created but failed to send email: %v", invitation.UID, err)
+		}
+	}
+
+	return convertInvitationFromStore(invitation, BuildUserName(currentUser.Username)), nil
+}
+
+// RevokeInvitation deletes an invitation so it can no longer be used.
+// Requires the manage users permission.
+func (s *APIV1Service) RevokeInvitation(ctx context.Context, request *v1pb.RevokeInvitationRequest) (*emptypb.Empty, error) {
+	if _, err := s.fetchUserWithPermission(ctx, store.PermissionManageUsers); err != nil {
+		return nil, err
+	}
+
+	uid, err := ExtractInvitationUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation name: %v", err)
+	}
+	invitation, err := s.Store.GetInvitation(ctx, &store.FindInvitation{UID: &uid})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get invitation: %v", err)
+	}
+	if invitation == nil {
+		return nil, status.Errorf(codes.NotFound, "invitation not found")
+	}
+
+	if err := s.Store.DeleteInvitation(ctx, &store.DeleteInvitation{ID: &invitation.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete invitation: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// redeemInvitation validates an invitation code for registering the given email and consumes one use.
+// When validateOnly is set, the invitation is checked but not consumed.
+func (s *APIV1Service) redeemInvitation(ctx context.Context, code, email string, validateOnly bool) (*store.Invitation, error) {
+	invitation, err := s.Store.GetInvitation(ctx, &store.FindInvitation{Code: &code})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get invitation: %v", err)
+	}
+	if invitation == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid invitation code")
+	}
+	if invitation.ExpiresTs != nil && *invitation.ExpiresTs <= time.Now().Unix() {
+		return nil, status.Errorf(codes.PermissionDenied, "invitation has expired")
+	}
+	if invitation.Email != "" && !strings.EqualFold(invitation.Email, strings.TrimSpace(email)) {
+		return nil, status.Errorf(codes.PermissionDenied, "invitation is for a different email")
+	}
+	if invitation.UseCount >= invitation.MaxUses {
+		return nil, status.Errorf(codes.PermissionDenied, "invitation has already been used")
+	}
+	if validateOnly {
+		return invitation, nil
+	}
+
+	consumed, err := s.Store.ConsumeInvitation(ctx, invitation.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to consume invitation: %v", err)
+	}
+	if !consumed {
+		return nil, status.Errorf(codes.PermissionDenied, "invitation has already been used")
+	}
+	return invitation, nil
+}
+
+func (s *APIV1Service) listInvitationCreatorNames(ctx context.Context, invitations []*store.Invitation) (map[int32]string, error) {
+	names := map[int32]string{}
+	for _, invitation := range invitations {
+		if _, ok := names[invitation.CreatorID]; ok {
+			continue
+		}
+		creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &invitation.CreatorID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get invitation creator: %v", err)
+		}
+		if creator != nil {
+			names[invitation.CreatorID] = BuildUserName(creator.Username)
+		} else {
+			names[invitation.CreatorID] = ""
+		}
+	}
+	return names, nil
+}
+
+func convertInvitationFromStore(invitation *store.Invitation, creatorName string) *v1pb.Invitation {
+	result := &v1pb.Invitation{
+		Name:       InvitationNamePrefix + invitation.UID,
+		Code:       invitation.Code,
+		Creator:    creatorName,
+		Role:       convertUserRoleFromStore(invitation.Role),
+		Email:      invitation.Email,
+		MaxUses:    invitation.MaxUses,
+		UseCount:   invitation.UseCount,
+		CreateTime: timestamppb.New(time.Unix(invitation.CreatedTs, 0)),
+	}
+	if invitation.ExpiresTs != nil {
+		result.ExpireTime = timestamppb.New(time.Unix(*invitation.ExpiresTs, 0))
+	}
+	return result
+}
@@ -3,7 +3,6 @@ package v1
 import (
 	"context"
 	"slices"
-	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,7 +31,11 @@ func (s *APIV1Service) SetMemoAttachments(ctx context.Context, request *v1pb.Set
 	if memo == nil {
 		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
-	if !canModifyMemo(user, memo) {
+	canEdit, err := s.canEditMemo(ctx, user, memo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if !canEdit {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 	if err := s.setMemoAttachmentsInternal(ctx, user, memo, request.Attachments); err != nil {
@@ -51,16 +54,29 @@ func (s *APIV1Service) SetMemoAttachments(ctx context.Context, request *v1pb.Set
 }
 
 func (s *APIV1Service) setMemoAttachmentsInternal(ctx context.Context, user *store.User, memo *store.Memo, requestAttachments []*v1pb.Attachment) error {
+	attachments, err := s.prepareMemoAttachments(ctx, user, memo, requestAttachments)
+	if err != nil {
+		return err
+	}
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Attachments: attachments}); err != nil {
+		return status.Errorf(codes.Internal, "failed to update memo attachments: %v", err)
+	}
+	return nil
+}
+
+// prepareMemoAttachments validates the requested attachments of a memo and returns the store
+// update that links them, without writing anything.
+func (s *APIV1Service) prepareMemoAttachments(ctx context.Context, user *store.User, memo *store.Memo, requestAttachments []*v1pb.Attachment) (*store.UpdateMemoAttachments, error) {
 	currentAttachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
 		MemoID: &memo.ID,
 	})
 	if err != nil {
-		return status.Errorf(codes.Internal, "failed to list attachments")
+		return nil, status.Errorf(codes.Internal, "failed to list attachments")
 	}
 
 	normalizedAttachments, err := s.normalizeMemoAttachmentRequest(ctx, user, currentAttachments, requestAttachments)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	requestedIDs := make(map[int32]bool, len(normalizedAttachments))
@@ -68,35 +84,18 @@ func (s *APIV1Service) setMemoAttachmentsInternal(ctx context.Context, user *sto
 		requestedIDs[attachment.ID] = true
 	}
 
-	// Delete attachments that are not in the request.
+	// Attachments that are not in the request are deleted.
 	for _, attachment := range currentAttachments {
-		if !requestedIDs[attachment.ID] {
-			if attachment.CreatorID != user.ID && !isSuperUser(user) {
-				return status.Errorf(codes.PermissionDenied, "cannot remove another user's attachment")
-			}
-			if err = s.Store.DeleteAttachment(ctx, &store.DeleteAttachment{
-				ID:     int32(attachment.ID),
-				MemoID: &memo.ID,
-			}); err != nil {
-				return status.Errorf(codes.Internal, "failed to delete attachment")
-			}
+		if !requestedIDs[attachment.ID] && attachment.CreatorID != user.ID && !isSuperUser(user) {
+			return nil, status.Errorf(codes.PermissionDenied, "cannot remove another user's attachment")
 		}
 	}
 
-	slices.Reverse(normalizedAttachments)
-	// Update attachments' memo_id in the request.
-	for index, attachment := range normalizedAttachments {
-		updatedTs := time.Now().Unix() + int64(index)
-		if err := s.Store.UpdateAttachment(ctx, &store.UpdateAttachment{
-			ID:        attachment.ID,
-			MemoID:    &memo.ID,
-			UpdatedTs: &updatedTs,
-		}); err != nil {
-			return status.Errorf(codes.Internal, "failed to update attachment: %v", err)
-		}
+	update := &store.UpdateMemoAttachments{AttachmentIDs: make([]int32, 0, len(normalizedAttachments))}
+	for _, attachment := range normalizedAttachments {
+		update.AttachmentIDs = append(update.AttachmentIDs, attachment.ID)
 	}
-
-	return nil
+	return update, nil
 }
 
 func (s *APIV1Service) normalizeMemoAttachmentRequest(
@@ -118,7 +117,9 @@ func (s *APIV1Service) normalizeMemoAttachmentRequest(
 		if attachment == nil {
 			return nil, status.Errorf(codes.NotFound, "attachment not found: %s", attachmentUID)
 		}
-		if attachment.CreatorID != user.ID && !isSuperUser(user) {
+		// Attachments already linked to the memo may be kept, e.g. by an editor collaborator.
+		isLinked := slices.ContainsFunc(currentAttachments, func(current *store.Attachment) bool { return current.ID == attachment.ID })
+		if attachment.CreatorID != user.ID && !isSuperUser(user) && !isLinked {
 			return nil, status.Errorf(codes.PermissionDenied, "cannot attach another user's attachment")
 		}
 		requestedAttachments = append(requestedAttachments, attachment)
@@ -219,7 +220,11 @@ func (s *APIV1Service) ListMemoAttachments(ctx context.Context, request *v1pb.Li
 		if user == nil {
 			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID && !isSuperUser(user) {
+		allowed, err := s.canAccessRestrictedMemo(ctx, user, memo)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+		}
+		if !allowed {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 	}
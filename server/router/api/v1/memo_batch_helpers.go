@@ -32,3 +32,21 @@ func (s *APIV1Service) listMemosByID(ctx context.Context, memoIDs []int32) (map[
 	}
 	return memosByID, nil
 }
+
+// listMemoSharesByID returns the shares with the given IDs, leaving out deleted ones.
+func (s *APIV1Service) listMemoSharesByID(ctx context.Context, shareIDs []int32) (map[int32]*store.MemoShare, error) {
+	sharesByID := make(map[int32]*store.MemoShare, len(shareIDs))
+	for _, shareID := range shareIDs {
+		if _, seen := sharesByID[shareID]; seen {
+			continue
+		}
+		share, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{ID: &shareID})
+		if err != nil {
+			return nil, err
+		}
+		if share != nil {
+			sharesByID[shareID] = share
+		}
+	}
+	return sharesByID, nil
+}
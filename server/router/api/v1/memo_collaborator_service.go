@@ -0,0 +1,259 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// memoCollaboratorEditablePaths are the update mask paths an editor may change on
+// another user's memo. Visibility, state and pinning stay with the creator.
+var memoCollaboratorEditablePaths = []string{"content", "location", "attachments", "update_time"}
+
+// ListMemoCollaborators lists the collaborators of a memo.
+// The memo's creator, admins and the collaborators themselves may call this.
+func (s *APIV1Service) ListMemoCollaborators(ctx context.Context, request *v1pb.ListMemoCollaboratorsRequest) (*v1pb.ListMemoCollaboratorsResponse, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memo, err := s.getMemoByName(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators, err := s.Store.ListMemoCollaborators(ctx, &store.FindMemoCollaborator{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo collaborators: %v", err)
+	}
+	isCollaborator := slices.ContainsFunc(collaborators, func(collaborator *store.MemoCollaborator) bool {
+		return collaborator.UserID == user.ID
+	})
+	if memo.CreatorID != user.ID && !isSuperUser(user) && !isCollaborator {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	userIDs := make([]int32, 0, len(collaborators))
+	for _, collaborator := range collaborators {
+		userIDs = append(userIDs, collaborator.UserID)
+	}
+	usersByID, err := s.listUsersByID(ctx, userIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collaborator users: %v", err)
+	}
+
+	response := &v1pb.ListMemoCollaboratorsResponse{Collaborators: []*v1pb.MemoCollaborator{}}
+	for _, collaborator := range collaborators {
+		collaboratorUser := usersByID[collaborator.UserID]
+		if collaboratorUser == nil {
+			continue
+		}
+		response.Collaborators = append(response.Collaborators, convertMemoCollaboratorFromStore(memo, collaboratorUser, collaborator))
+	}
+	return response, nil
+}
+
+// AddMemoCollaborator grants a user viewer or editor rights on a memo, or changes
+// the role of an existing collaborator. Newly added collaborators are notified.
+// Only the memo's creator or an admin may call this.
+func (s *APIV1Service) AddMemoCollaborator(ctx context.Context, request *v1pb.AddMemoCollaboratorRequest) (*v1pb.MemoCollaborator, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memo, err := s.getMemoByName(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if !canModifyMemo(user, memo) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if memo.ParentUID != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "collaborators can only be added to memos, not comments")
+	}
+
+	role, err := convertMemoCollaboratorRoleToStore(request.Role)
+	if err != nil {
+		return nil, err
+	}
+	collaboratorUser, err := ResolveUserByName(ctx, s.Store, request.User)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if collaboratorUser == nil || collaboratorUser.RowStatus == store.Archived {
+		return nil, status.Errorf(codes.NotFound, "user %q not found", request.User)
+	}
+	if collaboratorUser.ID == memo.CreatorID {
+		return nil, status.Errorf(codes.InvalidArgument, "the memo creator cannot be a collaborator")
+	}
+
+	existing, err := s.Store.GetMemoCollaborator(ctx, &store.FindMemoCollaborator{MemoID: &memo.ID, UserID: &collaboratorUser.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
+	}
+	collaborator, err := s.Store.UpsertMemoCollaborator(ctx, &store.MemoCollaborator{
+		MemoID: memo.ID,
+		UserID: collaboratorUser.ID,
+		Role:   role,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add memo collaborator: %v", err)
+	}
+
+	if existing == nil {
+		if _, err := s.createInboxWithEmailNotification(ctx, &store.Inbox{
+			SenderID:   user.ID,
+			ReceiverID: collaboratorUser.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type: storepb.InboxMessage_MEMO_COLLABORATOR,
+				Payload: &storepb.InboxMessage_MemoCollaborator{
+					MemoCollaborator: &storepb.InboxMessage_MemoCollaboratorPayload{
+						MemoId: memo.ID,
+						Role:   role.String(),
+					},
+				},
+			},
+		}); err != nil {
+			slog.Warn("Failed to create memo collaborator inbox", slog.Any("err", err), slog.Int64("memo_id", int64(memo.ID)))
+		}
+	}
+
+	return convertMemoCollaboratorFromStore(memo, collaboratorUser, collaborator), nil
+}
+
+// RemoveMemoCollaborator revokes a collaborator's access to a memo.
+// The memo's creator, admins and the collaborator being removed may call this.
+func (s *APIV1Service) RemoveMemoCollaborator(ctx context.Context, request *v1pb.RemoveMemoCollaboratorRequest) (*emptypb.Empty, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	// name format: memos/{memoUID}/collaborators/{username}
+	tokens, err := GetNameParentTokens(request.Name, MemoNamePrefix, MemoCollaboratorNamePrefix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid collaborator name: %v", err)
+	}
+	memo, err := s.getMemoByName(ctx, MemoNamePrefix+tokens[0])
+	if err != nil {
+		return nil, err
+	}
+	collaboratorUser, err := ResolveUserByName(ctx, s.Store, UserNamePrefix+tokens[1])
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if collaboratorUser == nil {
+		return nil, status.Errorf(codes.NotFound, "memo collaborator not found")
+	}
+	if !canModifyMemo(user, memo) && collaboratorUser.ID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	collaborator, err := s.Store.GetMemoCollaborator(ctx, &store.FindMemoCollaborator{MemoID: &memo.ID, UserID: &collaboratorUser.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
+	}
+	if collaborator == nil {
+		return nil, status.Errorf(codes.NotFound, "memo collaborator not found")
+	}
+	if err := s.Store.DeleteMemoCollaborator(ctx, &store.DeleteMemoCollaborator{MemoID: memo.ID, UserID: &collaboratorUser.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove memo collaborator: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getMemoByName returns the memo with the given resource name, or a NotFound error.
+func (s *APIV1Service) getMemoByName(ctx context.Context, name string) (*store.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	return memo, nil
+}
+
+// isMemoCollaborator reports whether the user collaborates on the memo with at least the given role.
+func (s *APIV1Service) isMemoCollaborator(ctx context.Context, user *store.User, memo *store.Memo, role store.MemoCollaboratorRole) (bool, error) {
+	if user == nil || memo == nil {
+		return false, nil
+	}
+	collaborator, err := s.Store.GetMemoCollaborator(ctx, &store.FindMemoCollaborator{MemoID: &memo.ID, UserID: &user.ID})
+	if err != nil {
+		return false, err
+	}
+	if collaborator == nil {
+		return false, nil
+	}
+	return role == store.MemoCollaboratorViewer || collaborator.Role == store.MemoCollaboratorEditor, nil
+}
+
+// canEditMemo reports whether the user may change the memo's content and attachments:
+// its creator, admins and editor collaborators.
+func (s *APIV1Service) canEditMemo(ctx context.Context, user *store.User, memo *store.Memo) (bool, error) {
+	if canModifyMemo(user, memo) {
+		return true, nil
+	}
+	return s.isMemoCollaborator(ctx, user, memo, store.MemoCollaboratorEditor)
+}
+
+// canEditMemoAsCollaborator reports whether the user may apply the update paths to
+// another user's memo as an editor collaborator.
+func (s *APIV1Service) canEditMemoAsCollaborator(ctx context.Context, user *store.User, memo *store.Memo, paths []string) (bool, error) {
+	for _, path := range paths {
+		if !slices.Contains(memoCollaboratorEditablePaths, path) {
+			return false, nil
+		}
+	}
+	return s.isMemoCollaborator(ctx, user, memo, store.MemoCollaboratorEditor)
+}
+
+func convertMemoCollaboratorRoleToStore(role v1pb.MemoCollaborator_Role) (store.MemoCollaboratorRole, error) {
+	switch role {
+	case v1pb.MemoCollaborator_VIEWER:
+		return store.MemoCollaboratorViewer, nil
+	case v1pb.MemoCollaborator_EDITOR:
+		return store.MemoCollaboratorEditor, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "role must be VIEWER or EDITOR")
+	}
+}
+
+func convertMemoCollaboratorFromStore(memo *store.Memo, user *store.User, collaborator *store.MemoCollaborator) *v1pb.MemoCollaborator {
+	role := v1pb.MemoCollaborator_VIEWER
+	if collaborator.Role == store.MemoCollaboratorEditor {
+		role = v1pb.MemoCollaborator_EDITOR
+	}
+	return &v1pb.MemoCollaborator{
+		Name:       fmt.Sprintf("%s%s/%s%s", MemoNamePrefix, memo.UID, MemoCollaboratorNamePrefix, user.Username),
+		User:       BuildUserName(user.Username),
+		Role:       role,
+		CreateTime: timestamppb.New(time.Unix(collaborator.CreatedTs, 0)),
+	}
+}
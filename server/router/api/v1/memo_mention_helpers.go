@@ -53,25 +53,25 @@ func (s *APIV1Service) resolveMentionTargets(ctx context.Context, content string
 	return targets, nil
 }
 
-func canUserAccessMentionContext(target *store.User, memo *store.Memo, relatedMemo *store.Memo) bool {
+func canUserAccessMentionContext(target *store.User, targetScope *store.MemoAccessScope, memo *store.Memo, relatedMemo *store.Memo) bool {
 	if target == nil || memo == nil {
 		return false
 	}
 
-	if relatedMemo != nil {
-		if relatedMemo.Visibility == store.Private && target.ID != relatedMemo.CreatorID {
-			return false
-		}
-	}
-
-	if memo.Visibility == store.Private && target.ID != memo.CreatorID {
+	if relatedMemo != nil && !canTargetAccessMemo(target, targetScope, relatedMemo) {
 		return false
 	}
+	return canTargetAccessMemo(target, targetScope, memo)
+}
 
-	return true
+func canTargetAccessMemo(target *store.User, targetScope *store.MemoAccessScope, memo *store.Memo) bool {
+	if !isRestrictedMemo(memo) {
+		return true
+	}
+	return target.ID == memo.CreatorID || targetScope.Includes(memo)
 }
 
-func shouldSkipMentionInbox(target *store.User, memo *store.Memo, relatedMemo *store.Memo) bool {
+func shouldSkipMentionInbox(target *store.User, targetScope *store.MemoAccessScope, memo *store.Memo, relatedMemo *store.Memo) bool {
 	if target == nil || memo == nil {
 		return true
 	}
@@ -85,7 +85,7 @@ func shouldSkipMentionInbox(target *store.User, memo *store.Memo, relatedMemo *s
 		return true
 	}
 
-	return !canUserAccessMentionContext(target, memo, relatedMemo)
+	return !canUserAccessMentionContext(target, targetScope, memo, relatedMemo)
 }
 
 func (s *APIV1Service) dispatchMemoMentionNotifications(ctx context.Context, memo *store.Memo, relatedMemo *store.Memo, previousContent string) error {
@@ -110,7 +110,14 @@ func (s *APIV1Service) dispatchMemoMentionNotifications(ctx context.Context, mem
 		if _, exists := previousTargets[userID]; exists {
 			continue
 		}
-		if shouldSkipMentionInbox(target, memo, relatedMemo) {
+		var targetScope *store.MemoAccessScope
+		if isRestrictedMemo(memo) || isRestrictedMemo(relatedMemo) {
+			targetScope, err = s.Store.GetMemoAccessScope(ctx, target.ID)
+			if err != nil {
+				return err
+			}
+		}
+		if shouldSkipMentionInbox(target, targetScope, memo, relatedMemo) {
 			continue
 		}
 
@@ -144,3 +151,8 @@ func (s *APIV1Service) dispatchMemoMentionNotificationsBestEffort(ctx context.Co
 		slog.Warn("Failed to dispatch memo mention notifications", slog.Any("err", err), slog.Int64("memo_id", int64(memo.ID)))
 	}
 }
+
+// isRestrictedMemo reports whether reading the memo requires a group membership or collaboration.
+func isRestrictedMemo(memo *store.Memo) bool {
+	return memo != nil && (memo.Visibility == store.Private || memo.Visibility == store.Group)
+}
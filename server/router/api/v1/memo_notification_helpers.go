@@ -0,0 +1,185 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// dispatchMemoReactionNotificationBestEffort notifies the memo owner about a reaction by another user.
+func (s *APIV1Service) dispatchMemoReactionNotificationBestEffort(ctx context.Context, memo *store.Memo, reactor *store.User, reactionType string) {
+	if reactor.ID == memo.CreatorID {
+		return
+	}
+	if _, err := s.createInboxWithEmailNotification(ctx, &store.Inbox{
+		SenderID:   reactor.ID,
+		ReceiverID: memo.CreatorID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type: storepb.InboxMessage_MEMO_REACTION,
+			Payload: &storepb.InboxMessage_MemoReaction{
+				MemoReaction: &storepb.InboxMessage_MemoReactionPayload{
+					MemoId:       memo.ID,
+					ReactionType: reactionType,
+				},
+			},
+		},
+	}); err != nil {
+		slog.Warn("Failed to create memo reaction inbox", slog.Any("err", err), slog.Int64("memo_id", int64(memo.ID)))
+	}
+}
+
+// dispatchMemoThreadReplyNotifications notifies the users who commented on the related memo about
+// a new comment. The memo owner and the mentioned users are skipped, as they get a comment or
+// mention notification instead.
+func (s *APIV1Service) dispatchMemoThreadReplyNotifications(ctx context.Context, comment *store.Memo, relatedMemo *store.Memo) error {
+	if comment.Visibility == store.Private {
+		return nil
+	}
+
+	commentType := store.MemoRelationComment
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		RelatedMemoID: &relatedMemo.ID,
+		Type:          &commentType,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list thread comments")
+	}
+	commentIDs := make([]int32, 0, len(relations))
+	for _, relation := range relations {
+		if relation.MemoID != comment.ID {
+			commentIDs = append(commentIDs, relation.MemoID)
+		}
+	}
+	if len(commentIDs) == 0 {
+		return nil
+	}
+	normalStatus := store.Normal
+	comments, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		IDList:         commentIDs,
+		RowStatus:      &normalStatus,
+		ExcludeContent: true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list thread comments")
+	}
+
+	mentionTargets, err := s.resolveMentionTargets(ctx, comment.Content)
+	if err != nil {
+		return err
+	}
+	notified := map[int32]bool{comment.CreatorID: true, relatedMemo.CreatorID: true}
+	for userID := range mentionTargets {
+		notified[userID] = true
+	}
+	for _, threadComment := range comments {
+		receiverID := threadComment.CreatorID
+		if notified[receiverID] {
+			continue
+		}
+		notified[receiverID] = true
+
+		receiver, err := s.Store.GetUser(ctx, &store.FindUser{ID: &receiverID})
+		if err != nil {
+			return errors.Wrap(err, "failed to get thread participant")
+		}
+		if receiver == nil {
+			continue
+		}
+		var receiverScope *store.MemoAccessScope
+		if isRestrictedMemo(relatedMemo) {
+			receiverScope, err = s.Store.GetMemoAccessScope(ctx, receiver.ID)
+			if err != nil {
+				return err
+			}
+		}
+		if !canViewerAccessMemo(receiver, receiverScope, relatedMemo) {
+			continue
+		}
+
+		if _, err := s.createInboxWithEmailNotification(ctx, &store.Inbox{
+			SenderID:   comment.CreatorID,
+			ReceiverID: receiver.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type: storepb.InboxMessage_MEMO_THREAD_REPLY,
+				Payload: &storepb.InboxMessage_MemoThreadReply{
+					MemoThreadReply: &storepb.InboxMessage_MemoThreadReplyPayload{
+						MemoId:        comment.ID,
+						RelatedMemoId: relatedMemo.ID,
+					},
+				},
+			},
+		}); err != nil {
+			return errors.Wrap(err, "failed to create thread reply inbox")
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) dispatchMemoThreadReplyNotificationsBestEffort(ctx context.Context, comment *store.Memo, relatedMemo *store.Memo) {
+	if err := s.dispatchMemoThreadReplyNotifications(ctx, comment, relatedMemo); err != nil {
+		slog.Warn("Failed to dispatch memo thread reply notifications", slog.Any("err", err), slog.Int64("memo_id", int64(comment.ID)))
+	}
+}
+
+// dispatchMemoShareOpenedNotification notifies the share creator that their share link was opened.
+// The sender is the signed-in viewer, or the share creator for anonymous views. While a notification
+// for the share is unread, further views are not notified again.
+func (s *APIV1Service) dispatchMemoShareOpenedNotification(ctx context.Context, ms *store.MemoShare) error {
+	senderID := ms.CreatorID
+	viewer, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get current user")
+	}
+	if viewer != nil {
+		if viewer.ID == ms.CreatorID {
+			return nil
+		}
+		senderID = viewer.ID
+	}
+
+	unreadStatus := store.UNREAD
+	messageType := storepb.InboxMessage_MEMO_SHARE_OPENED
+	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID:  &ms.CreatorID,
+		Status:      &unreadStatus,
+		MessageType: &messageType,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list share opened inboxes")
+	}
+	for _, inbox := range inboxes {
+		if inbox.Message.GetMemoShareOpened().GetShareId() == ms.ID {
+			return nil
+		}
+	}
+
+	if _, err := s.createInboxWithEmailNotification(ctx, &store.Inbox{
+		SenderID:   senderID,
+		ReceiverID: ms.CreatorID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type: storepb.InboxMessage_MEMO_SHARE_OPENED,
+			Payload: &storepb.InboxMessage_MemoShareOpened{
+				MemoShareOpened: &storepb.InboxMessage_MemoShareOpenedPayload{
+					MemoId:  ms.MemoID,
+					ShareId: ms.ID,
+				},
+			},
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create share opened inbox")
+	}
+	return nil
+}
+
+func (s *APIV1Service) dispatchMemoShareOpenedNotificationBestEffort(ctx context.Context, ms *store.MemoShare) {
+	if err := s.dispatchMemoShareOpenedNotification(ctx, ms); err != nil {
+		slog.Warn("Failed to dispatch memo share opened notification", slog.Any("err", err), slog.Int64("memo_id", int64(ms.MemoID)))
+	}
+}
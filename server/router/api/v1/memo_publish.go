@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// validateMemoPublishTime returns the unix timestamp of a requested publish time,
+// which must be in the future.
+func validateMemoPublishTime(publishTime *timestamppb.Timestamp) (int64, error) {
+	publishTs := publishTime.AsTime().Unix()
+	if publishTs <= time.Now().Unix() {
+		return 0, status.Errorf(codes.InvalidArgument, "publish_time must be in the future")
+	}
+	return publishTs, nil
+}
+
+// getMemoScheduledVisibility returns the visibility a memo has once published: the
+// scheduled visibility of a scheduled memo, and the current visibility otherwise.
+func getMemoScheduledVisibility(memo *store.Memo) store.Visibility {
+	if memo.PublishTs != nil {
+		if visibility := memo.Payload.GetScheduledVisibility(); visibility != "" {
+			return store.Visibility(visibility)
+		}
+	}
+	return memo.Visibility
+}
+
+// applyMemoPublishSchedule applies the publish_time update path, and keeps a scheduled
+// memo private: a visibility change is recorded as the scheduled visibility instead.
+func applyMemoPublishSchedule(memo *store.Memo, update *store.UpdateMemo, request *v1pb.UpdateMemoRequest) error {
+	scheduled := memo.PublishTs != nil
+	if slices.Contains(request.UpdateMask.Paths, "publish_time") {
+		if request.Memo.PublishTime != nil {
+			if memo.ParentUID != nil {
+				return status.Errorf(codes.InvalidArgument, "comments cannot be scheduled")
+			}
+			publishTs, err := validateMemoPublishTime(request.Memo.PublishTime)
+			if err != nil {
+				return err
+			}
+			update.PublishTs = &publishTs
+			scheduled = true
+		} else if scheduled {
+			cleared := int64(0)
+			update.PublishTs = &cleared
+			scheduled = false
+			if memo.Payload != nil {
+				memo.Payload.ScheduledVisibility = ""
+				update.Payload = memo.Payload
+			}
+		}
+	}
+	if !scheduled {
+		return nil
+	}
+
+	visibility := getMemoScheduledVisibility(memo)
+	if update.Visibility != nil {
+		visibility = *update.Visibility
+	}
+	if memo.Payload == nil {
+		memo.Payload = &storepb.MemoPayload{}
+	}
+	memo.Payload.ScheduledVisibility = visibility.String()
+	update.Payload = memo.Payload
+	private := store.Private
+	update.Visibility = &private
+	return nil
+}
+
+// PublishScheduledMemos publishes the scheduled memos whose publish time is at or
+// before now. A published memo gets its scheduled visibility, and optionally its
+// publish time as create and update time, and then dispatches the usual memo created
+// webhooks, live refresh events and mention notifications.
+func (s *APIV1Service) PublishScheduledMemos(ctx context.Context, now time.Time) error {
+	memoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo related setting")
+	}
+	normalStatus := store.Normal
+	nowTs := now.Unix()
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus:        &normalStatus,
+		PublishDueBefore: &nowTs,
+		ExcludeContent:   true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list scheduled memos")
+	}
+
+	for _, memo := range memos {
+		visibility := getMemoScheduledVisibility(memo)
+		payload := memo.Payload
+		if payload == nil {
+			payload = &storepb.MemoPayload{}
+		}
+		payload.ScheduledVisibility = ""
+		cleared := int64(0)
+		update := &store.UpdateMemo{
+			ID:         memo.ID,
+			Visibility: &visibility,
+			Payload:    payload,
+			PublishTs:  &cleared,
+			// Publish each memo once, even when several instances run the job.
+			ExpectedRevision: &memo.Revision,
+		}
+		if memoRelatedSetting.GetBumpTimeOnPublish() {
+			update.CreatedTs = &nowTs
+			update.UpdatedTs = &nowTs
+		}
+		if err := s.Store.UpdateMemo(ctx, update); err != nil {
+			if errors.Is(err, store.ErrMemoRevisionMismatch) {
+				continue
+			}
+			return errors.Wrap(err, "failed to publish memo")
+		}
+		s.dispatchMemoPublishedSideEffects(ctx, memo.ID)
+	}
+	return nil
+}
+
+func (s *APIV1Service) dispatchMemoPublishedSideEffects(ctx context.Context, memoID int32) {
+	memo, _, memoMessage, err := s.buildUpdatedMemoState(ctx, memoID)
+	if err != nil {
+		slog.Warn("Failed to build published memo", slog.Any("err", err), slog.Int64("memo_id", int64(memoID)))
+		return
+	}
+	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
+		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+	}
+	s.SSEHub.Broadcast(&SSEEvent{
+		Type:        SSEEventMemoCreated,
+		Name:        memoMessage.Name,
+		Visibility:  memo.Visibility,
+		CreatorID:   resolveSSECreatorID(memo, nil),
+		AudienceIDs: s.resolveSSEAudienceIDs(ctx, memo, nil),
+	})
+	s.dispatchMemoMentionNotificationsBestEffort(ctx, memo, nil, "")
+}
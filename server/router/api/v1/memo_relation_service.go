@@ -51,15 +51,20 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 }
 
 func (s *APIV1Service) setMemoRelationsInternal(ctx context.Context, memo *store.Memo, relations []*v1pb.MemoRelation) error {
-	referenceType := store.MemoRelationReference
-	// Delete all reference relations first.
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
-		MemoID: &memo.ID,
-		Type:   &referenceType,
-	}); err != nil {
-		return status.Errorf(codes.Internal, "failed to delete memo relation")
+	references, err := s.prepareMemoReferences(ctx, memo, relations)
+	if err != nil {
+		return err
+	}
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, References: references}); err != nil {
+		return status.Errorf(codes.Internal, "failed to update memo relations: %v", err)
 	}
+	return nil
+}
 
+// prepareMemoReferences resolves the requested relations of a memo and returns the store
+// update that replaces its reference relations, without writing anything.
+func (s *APIV1Service) prepareMemoReferences(ctx context.Context, memo *store.Memo, relations []*v1pb.MemoRelation) (*store.UpdateMemoReferences, error) {
+	update := &store.UpdateMemoReferences{RelatedMemoIDs: []int32{}}
 	for _, relation := range relations {
 		// Ignore reflexive relations.
 		if buildMemoName(memo.UID) == relation.RelatedMemo.Name {
@@ -72,22 +77,18 @@ func (s *APIV1Service) setMemoRelationsInternal(ctx context.Context, memo *store
 		}
 		relatedMemoUID, err := ExtractMemoUIDFromName(relation.RelatedMemo.Name)
 		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "invalid related memo name: %v", err)
+			return nil, status.Errorf(codes.InvalidArgument, "invalid related memo name: %v", err)
 		}
 		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &relatedMemoUID})
 		if err != nil {
-			return status.Errorf(codes.Internal, "failed to get related memo")
+			return nil, status.Errorf(codes.Internal, "failed to get related memo")
 		}
-		if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
-			MemoID:        memo.ID,
-			RelatedMemoID: relatedMemo.ID,
-			Type:          convertMemoRelationTypeToStore(relation.Type),
-		}); err != nil {
-			return status.Errorf(codes.Internal, "failed to upsert memo relation")
+		if relatedMemo == nil {
+			return nil, status.Errorf(codes.NotFound, "related memo not found")
 		}
+		update.RelatedMemoIDs = append(update.RelatedMemoIDs, relatedMemo.ID)
 	}
-
-	return nil
+	return update, nil
 }
 
 func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.ListMemoRelationsRequest) (*v1pb.ListMemoRelationsResponse, error) {
@@ -0,0 +1,108 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/webhook"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// extractMemoDueTs returns the due time of the first @due(...) marker in content,
+// or nil if there is none.
+func (s *APIV1Service) extractMemoDueTs(content string) (*int64, error) {
+	data, err := s.MarkdownService.ExtractAll([]byte(content))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to extract markdown metadata")
+	}
+	if data.DueTime == nil {
+		return nil, nil
+	}
+	dueTs := data.DueTime.Unix()
+	return &dueTs, nil
+}
+
+// getUpdatedMemoDueTs returns the due time to store when a memo's content changes from
+// previousContent to content. It returns nil when the @due(...) marker is unchanged, so that
+// a due time set through the API is kept, and 0 to clear the due time when the marker was removed.
+func (s *APIV1Service) getUpdatedMemoDueTs(previousContent, content string) (*int64, error) {
+	previousDueTs, err := s.extractMemoDueTs(previousContent)
+	if err != nil {
+		return nil, err
+	}
+	dueTs, err := s.extractMemoDueTs(content)
+	if err != nil {
+		return nil, err
+	}
+	if previousDueTs == nil && dueTs == nil {
+		return nil, nil
+	}
+	if previousDueTs != nil && dueTs != nil && *previousDueTs == *dueTs {
+		return nil, nil
+	}
+	if dueTs == nil {
+		cleared := int64(0)
+		return &cleared, nil
+	}
+	return dueTs, nil
+}
+
+// DispatchMemoReminders sends the reminders that fell due at or before now. The memo
+// creator gets an inbox notification and email, and the creator's webhooks receive a
+// memos.memo.reminder event. Each reminder is marked as sent before it is delivered,
+// so that it is delivered at most once.
+func (s *APIV1Service) DispatchMemoReminders(ctx context.Context, now time.Time) error {
+	normalStatus := store.Normal
+	nowTs := now.Unix()
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus:         &normalStatus,
+		ReminderDueBefore: &nowTs,
+		ExcludeContent:    true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos with due reminders")
+	}
+
+	for _, memo := range memos {
+		marked, err := s.Store.MarkMemoReminded(ctx, memo.ID, nowTs)
+		if err != nil {
+			return errors.Wrap(err, "failed to mark memo reminded")
+		}
+		if !marked {
+			continue
+		}
+		s.dispatchMemoReminderBestEffort(ctx, memo)
+	}
+	return nil
+}
+
+func (s *APIV1Service) dispatchMemoReminderBestEffort(ctx context.Context, memo *store.Memo) {
+	if _, err := s.createInboxWithEmailNotification(ctx, &store.Inbox{
+		SenderID:   memo.CreatorID,
+		ReceiverID: memo.CreatorID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type: storepb.InboxMessage_MEMO_REMINDER,
+			Payload: &storepb.InboxMessage_MemoReminder{
+				MemoReminder: &storepb.InboxMessage_MemoReminderPayload{
+					MemoId: memo.ID,
+				},
+			},
+		},
+	}); err != nil {
+		slog.Warn("Failed to create memo reminder inbox", slog.Any("err", err), slog.Int64("memo_id", int64(memo.ID)))
+	}
+
+	_, _, memoMessage, err := s.buildUpdatedMemoState(ctx, memo.ID)
+	if err != nil {
+		slog.Warn("Failed to build memo for reminder webhook", slog.Any("err", err), slog.Int64("memo_id", int64(memo.ID)))
+		return
+	}
+	if err := s.dispatchMemoRelatedWebhook(ctx, memoMessage, webhook.EventMemoReminder); err != nil {
+		slog.Warn("Failed to dispatch memo reminder webhook", slog.Any("err", err), slog.Int64("memo_id", int64(memo.ID)))
+	}
+}
@@ -5,6 +5,7 @@ import (
 	stderrors "errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -63,7 +64,34 @@ func (s *APIV1Service) checkMemoReadAccess(ctx context.Context, memo *store.Memo
 		if user == nil {
 			return status.Errorf(codes.Unauthenticated, "user not authenticated")
 		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID {
+		if !isRestrictedMemo(memo) || memo.CreatorID == user.ID {
+			return nil
+		}
+		if memo.ParentUID != nil {
+			// Comments share the visibility of their memo, so its readers can read them.
+			parentMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: memo.ParentUID})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to get parent memo")
+			}
+			if parentMemo != nil {
+				return s.checkMemoReadAccess(ctx, parentMemo)
+			}
+		}
+		isCollaborator, err := s.isMemoCollaborator(ctx, user, memo, store.MemoCollaboratorViewer)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check memo collaborators: %v", err)
+		}
+		if isCollaborator {
+			return nil
+		}
+		if memo.Visibility == store.Private {
+			return status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		isMember, err := s.Store.IsMemoAudienceMember(ctx, memo, user.ID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check memo audience: %v", err)
+		}
+		if !isMember {
 			return status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 	}
@@ -114,6 +142,35 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if request.Memo.Location != nil {
 		create.Payload.Location = convertLocationToStore(request.Memo.Location)
 	}
+	if request.Memo.DueTime != nil {
+		dueTs := request.Memo.DueTime.AsTime().Unix()
+		create.DueTs = &dueTs
+	} else {
+		create.DueTs, err = s.extractMemoDueTs(create.Content)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to extract due time: %v", err)
+		}
+	}
+	if request.Memo.RemindTime != nil {
+		remindTs := request.Memo.RemindTime.AsTime().Unix()
+		create.RemindTs = &remindTs
+	}
+	if create.Visibility == store.Group {
+		audience, err := s.validateMemoAudience(ctx, user, request.Memo.Audience)
+		if err != nil {
+			return nil, err
+		}
+		create.Payload.Audience = audience
+	}
+	if request.Memo.PublishTime != nil {
+		publishTs, err := validateMemoPublishTime(request.Memo.PublishTime)
+		if err != nil {
+			return nil, err
+		}
+		create.PublishTs = &publishTs
+		create.Payload.ScheduledVisibility = create.Visibility.String()
+		create.Visibility = store.Private
+	}
 
 	memo, err := s.Store.CreateMemo(ctx, create)
 	if err != nil {
@@ -156,18 +213,21 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert memo")
 	}
-	// Try to dispatch webhook when memo is created.
-	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
-		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+	// Try to dispatch webhook when memo is created. Scheduled memos dispatch it once published.
+	if memo.PublishTs == nil {
+		if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+		}
 	}
 
 	// Broadcast live refresh event (skipped when called from CreateMemoComment).
 	if !isSSESuppressed(ctx) {
 		s.SSEHub.Broadcast(&SSEEvent{
-			Type:       SSEEventMemoCreated,
-			Name:       memoMessage.Name,
-			Visibility: memo.Visibility,
-			CreatorID:  resolveSSECreatorID(memo, nil),
+			Type:        SSEEventMemoCreated,
+			Name:        memoMessage.Name,
+			Visibility:  memo.Visibility,
+			CreatorID:   resolveSSECreatorID(memo, nil),
+			AudienceIDs: s.resolveSSEAudienceIDs(ctx, memo, nil),
 		})
 	}
 
@@ -220,28 +280,44 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 
 	if currentUser == nil {
 		memoFind.VisibilityList = []store.Visibility{store.Public}
-	} else {
+	} else if memoFind.CreatorID == nil || *memoFind.CreatorID != currentUser.ID {
+		visibilityFilter, err := s.buildMemoVisibilityFilter(ctx, currentUser.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to build visibility filter: %v", err)
+		}
 		if memoFind.CreatorID == nil {
-			filter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
-			memoFind.Filters = append(memoFind.Filters, filter)
-		} else if *memoFind.CreatorID != currentUser.ID {
-			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+			visibilityFilter = fmt.Sprintf(`creator_id == %d || %s`, currentUser.ID, visibilityFilter)
 		}
+		memoFind.Filters = append(memoFind.Filters, visibilityFilter)
 	}
 
+	memoMessages, nextPageToken, err := s.listMemoMessages(ctx, memoFind, request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	response := &v1pb.ListMemosResponse{
+		Memos:         memoMessages,
+		NextPageToken: nextPageToken,
+	}
+	return response, nil
+}
+
+// listMemoMessages lists one page of the memos matching memoFind and converts them,
+// returning the memos and the token of the next page.
+func (s *APIV1Service) listMemoMessages(ctx context.Context, memoFind *store.FindMemo, pageSize int32, pageToken string) ([]*v1pb.Memo, string, error) {
 	var limit, offset int
-	if request.PageToken != "" {
-		var pageToken v1pb.PageToken
-		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	if pageToken != "" {
+		var token v1pb.PageToken
+		if err := unmarshalPageToken(pageToken, &token); err != nil {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
 		}
-		limit = normalizePageSize(pageToken.Limit)
-		offset = int(pageToken.Offset)
+		limit = normalizePageSize(token.Limit)
+		offset = int(token.Offset)
 		if offset < 0 {
 			offset = 0
 		}
 	} else {
-		limit = normalizePageSize(request.PageSize)
+		limit = normalizePageSize(pageSize)
 	}
 	limit = min(limit, MaxPageSize)
 	limitPlusOne := limit + 1
@@ -249,7 +325,7 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	memoFind.Offset = &offset
 	memos, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		return nil, "", status.Errorf(codes.Internal, "failed to list memos: %v", err)
 	}
 
 	memoMessages := []*v1pb.Memo{}
@@ -258,16 +334,12 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		memos = memos[:limit]
 		nextPageToken, err = getPageToken(limit, offset+limit)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+			return nil, "", status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
 	}
 
 	if len(memos) == 0 {
-		response := &v1pb.ListMemosResponse{
-			Memos:         memoMessages,
-			NextPageToken: nextPageToken,
-		}
-		return response, nil
+		return memoMessages, nextPageToken, nil
 	}
 
 	reactionMap := make(map[string][]*store.Reaction)
@@ -284,7 +356,7 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	// REACTIONS
 	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentIDList: contentIDs})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list reactions")
+		return nil, "", status.Errorf(codes.Internal, "failed to list reactions")
 	}
 	for _, reaction := range reactions {
 		reactionMap[reaction.ContentID] = append(reactionMap[reaction.ContentID], reaction)
@@ -293,7 +365,7 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	// ATTACHMENTS
 	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: memoIDs})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list attachments")
+		return nil, "", status.Errorf(codes.Internal, "failed to list attachments")
 	}
 	for _, attachment := range attachments {
 		attachmentMap[*attachment.MemoID] = append(attachmentMap[*attachment.MemoID], attachment)
@@ -302,7 +374,7 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	// RELATIONS (batch load to avoid N+1)
 	relationMap, err := s.batchConvertMemoRelations(ctx, memos, false)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to batch load memo relations")
+		return nil, "", status.Errorf(codes.Internal, "failed to batch load memo relations")
 	}
 	creatorIDs := make([]int32, 0, len(memos)+len(reactions))
 	for _, memo := range memos {
@@ -313,7 +385,7 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	}
 	creatorMap, err := s.listUsersByID(ctx, creatorIDs)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo creators: %v", err)
+		return nil, "", status.Errorf(codes.Internal, "failed to list memo creators: %v", err)
 	}
 	for _, memo := range memos {
 		memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
@@ -331,17 +403,13 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 				)
 				continue
 			}
-			return nil, errors.Wrap(err, "failed to convert memo")
+			return nil, "", errors.Wrap(err, "failed to convert memo")
 		}
 
 		memoMessages = append(memoMessages, memoMessage)
 	}
 
-	response := &v1pb.ListMemosResponse{
-		Memos:         memoMessages,
-		NextPageToken: nextPageToken,
-	}
-	return response, nil
+	return memoMessages, nextPageToken, nil
 }
 
 func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest) (*v1pb.Memo, error) {
@@ -473,16 +541,39 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if user == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	// Only the creator or admin can update the memo.
+	// Only the creator or admin can update the memo. Moderators can archive and
+	// restore memos visible to other users, and editor collaborators can change
+	// the content and attachments.
 	if memo.CreatorID != user.ID && !isSuperUser(user) {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		canModerate, err := s.canModerateMemo(ctx, user, memo, request.UpdateMask.Paths)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+		}
+		canEdit := false
+		if !canModerate {
+			canEdit, err = s.canEditMemoAsCollaborator(ctx, user, memo, request.UpdateMask.Paths)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+			}
+		}
+		if !canModerate && !canEdit {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
 	}
 
 	update := &store.UpdateMemo{
 		ID: memo.ID,
 	}
+	if request.Memo.Etag != "" {
+		expectedRevision, err := s.checkMemoEtag(ctx, memo, request.Memo.Etag)
+		if err != nil {
+			return nil, err
+		}
+		update.ExpectedRevision = &expectedRevision
+	}
 	var previousContent string
 	contentUpdated := false
+	audienceUpdated := false
 	for _, path := range request.UpdateMask.Paths {
 		if path == "content" {
 			contentUpdated = true
@@ -500,6 +591,26 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			}
 			update.Content = &memo.Content
 			update.Payload = memo.Payload
+			// An explicit due_time in the same update takes precedence over the content.
+			if !slices.Contains(request.UpdateMask.Paths, "due_time") {
+				dueTs, err := s.getUpdatedMemoDueTs(previousContent, memo.Content)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to extract due time: %v", err)
+				}
+				update.DueTs = dueTs
+			}
+		} else if path == "due_time" {
+			dueTs := int64(0)
+			if request.Memo.DueTime != nil {
+				dueTs = request.Memo.DueTime.AsTime().Unix()
+			}
+			update.DueTs = &dueTs
+		} else if path == "remind_time" {
+			remindTs := int64(0)
+			if request.Memo.RemindTime != nil {
+				remindTs = request.Memo.RemindTime.AsTime().Unix()
+			}
+			update.RemindTs = &remindTs
 		} else if path == "visibility" {
 			visibility := convertVisibilityToStore(request.Memo.Visibility)
 			if memo.ParentUID != nil {
@@ -513,6 +624,9 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 				visibility = parentMemo.Visibility
 			}
 			update.Visibility = &visibility
+			audienceUpdated = true
+		} else if path == "audience" {
+			audienceUpdated = true
 		} else if path == "pinned" {
 			update.Pinned = &request.Memo.Pinned
 		} else if path == "state" {
@@ -534,17 +648,33 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			payload.Location = convertLocationToStore(request.Memo.Location)
 			update.Payload = payload
 		} else if path == "attachments" {
-			if err := s.setMemoAttachmentsInternal(ctx, user, memo, request.Memo.Attachments); err != nil {
-				return nil, errors.Wrap(err, "failed to set memo attachments")
+			// Attachments and relations are written in the same transaction as the memo.
+			update.Attachments, err = s.prepareMemoAttachments(ctx, user, memo, request.Memo.Attachments)
+			if err != nil {
+				return nil, err
 			}
 		} else if path == "relations" {
-			if err := s.setMemoRelationsInternal(ctx, memo, request.Memo.Relations); err != nil {
-				return nil, errors.Wrap(err, "failed to set memo relations")
+			update.References, err = s.prepareMemoReferences(ctx, memo, request.Memo.Relations)
+			if err != nil {
+				return nil, err
 			}
 		}
 	}
 
+	if audienceUpdated {
+		if err := s.updateMemoAudience(ctx, user, memo, update, request.Memo.Audience); err != nil {
+			return nil, err
+		}
+	}
+	if err := applyMemoPublishSchedule(memo, update, request); err != nil {
+		return nil, err
+	}
+
 	if err = s.Store.UpdateMemo(ctx, update); err != nil {
+		if errors.Is(err, store.ErrMemoRevisionMismatch) {
+			s.broadcastMemoConflict(ctx, memo)
+			return nil, status.Errorf(codes.FailedPrecondition, "memo has been modified; etag mismatch")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update memo")
 	}
 
@@ -592,6 +722,14 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 	if memo.CreatorID != user.ID && !isSuperUser(user) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
+	var expectedRevision *int32
+	if request.Etag != "" {
+		revision, err := s.checkMemoEtag(ctx, memo, request.Etag)
+		if err != nil {
+			return nil, err
+		}
+		expectedRevision = &revision
+	}
 
 	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
 		ContentID: &request.Name,
@@ -607,37 +745,35 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 		return nil, status.Errorf(codes.Internal, "failed to list attachments")
 	}
 
+	// Build the webhook payload and resolve the audience before deleting, and only use them
+	// once the delete has succeeded.
 	deleteRelations, _ := s.loadMemoRelations(ctx, memo)
-	if memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments, deleteRelations); err == nil {
-		// Try to dispatch webhook when memo is deleted.
-		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
-			slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
+	memoMessage, convertErr := s.convertMemoFromStore(ctx, memo, reactions, attachments, deleteRelations)
+	audienceIDs := s.resolveSSEAudienceIDs(ctx, memo, nil)
+
+	// Delete the memo with its comments, relations and attachments in one transaction.
+	if err = s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID, DeleteComments: true, ExpectedRevision: expectedRevision}); err != nil {
+		if errors.Is(err, store.ErrMemoRevisionMismatch) {
+			s.broadcastMemoConflict(ctx, memo)
+			return nil, status.Errorf(codes.FailedPrecondition, "memo has been modified; etag mismatch")
 		}
+		return nil, status.Errorf(codes.Internal, "failed to delete memo")
 	}
 
-	// Delete memo comments first (store.DeleteMemo handles their relations and attachments)
-	commentType := store.MemoRelationComment
-	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID, Type: &commentType})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo comments")
-	}
-	for _, relation := range relations {
-		if err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: relation.MemoID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete memo comment")
+	if convertErr == nil {
+		// Try to dispatch webhook when memo is deleted.
+		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
 		}
 	}
 
-	// Delete the memo (store.DeleteMemo handles relation and attachment cleanup)
-	if err = s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo")
-	}
-
 	// Broadcast live refresh event.
 	s.SSEHub.Broadcast(&SSEEvent{
-		Type:       SSEEventMemoDeleted,
-		Name:       request.Name,
-		Visibility: memo.Visibility,
-		CreatorID:  resolveSSECreatorID(memo, nil),
+		Type:        SSEEventMemoDeleted,
+		Name:        request.Name,
+		Visibility:  memo.Visibility,
+		CreatorID:   resolveSSECreatorID(memo, nil),
+		AudienceIDs: audienceIDs,
 	})
 
 	return &emptypb.Empty{}, nil
@@ -664,7 +800,11 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if user == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	if relatedMemo.Visibility == store.Private && relatedMemo.CreatorID != user.ID && !isSuperUser(user) {
+	allowed, err := s.canAccessRestrictedMemo(ctx, user, relatedMemo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+	}
+	if !allowed {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 	if request.Comment == nil {
@@ -676,6 +816,14 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 		return nil, status.Errorf(codes.Internal, "failed to clone memo comment")
 	}
 	comment.Visibility = convertVisibilityFromStore(relatedMemo.Visibility)
+	comment.Audience = nil
+	// Comments are published together with their memo.
+	comment.PublishTime = nil
+	if relatedMemo.Visibility == store.Group && relatedMemo.Payload != nil {
+		for _, groupName := range relatedMemo.Payload.Audience {
+			comment.Audience = append(comment.Audience, GroupNamePrefix+groupName)
+		}
+	}
 
 	// Create the memo comment first; suppress the generic memo.created SSE event
 	// since CreateMemoComment broadcasts memo.comment.created for the parent instead.
@@ -736,13 +884,15 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	}
 
 	s.dispatchMemoMentionNotificationsBestEffort(ctx, memo, relatedMemo, "")
+	s.dispatchMemoThreadReplyNotificationsBestEffort(ctx, memo, relatedMemo)
 
 	// Broadcast live refresh event for the parent memo so subscribers see the new comment.
 	s.SSEHub.Broadcast(&SSEEvent{
-		Type:       SSEEventMemoCommentCreated,
-		Name:       request.Name,
-		Visibility: relatedMemo.Visibility,
-		CreatorID:  relatedMemo.CreatorID,
+		Type:        SSEEventMemoCommentCreated,
+		Name:        request.Name,
+		Visibility:  relatedMemo.Visibility,
+		CreatorID:   relatedMemo.CreatorID,
+		AudienceIDs: s.resolveSSEAudienceIDs(ctx, relatedMemo, nil),
 	})
 
 	return memoComment, nil
@@ -772,7 +922,15 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 	if currentUser == nil {
 		memoFilter = `visibility == "PUBLIC"`
 	} else {
-		memoFilter = fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
+		visibilityFilter, err := s.buildMemoVisibilityFilter(ctx, currentUser.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to build visibility filter: %v", err)
+		}
+		memoFilter = fmt.Sprintf(`creator_id == %d || %s`, currentUser.ID, visibilityFilter)
+		if isRestrictedMemo(memo) {
+			// Comments share the visibility of their memo, so its readers can read them.
+			memoFilter = fmt.Sprintf(`%s || visibility == %q`, memoFilter, memo.Visibility.String())
+		}
 	}
 	memoRelationComment := store.MemoRelationComment
 	var limit, offset int
@@ -912,35 +1070,30 @@ func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
 
 // DispatchMemoCreatedWebhook dispatches webhook when memo is created.
 func (s *APIV1Service) DispatchMemoCreatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.created")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.EventMemoCreated)
 }
 
 // DispatchMemoUpdatedWebhook dispatches webhook when memo is updated.
 func (s *APIV1Service) DispatchMemoUpdatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.updated")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.EventMemoUpdated)
 }
 
 // DispatchMemoDeletedWebhook dispatches webhook when memo is deleted.
 func (s *APIV1Service) DispatchMemoDeletedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.deleted")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.EventMemoDeleted)
 }
 
 // DispatchMemoCommentCreatedWebhook dispatches webhook to the related memo owner when a comment is created.
 func (s *APIV1Service) DispatchMemoCommentCreatedWebhook(ctx context.Context, commentMemo *v1pb.Memo, relatedMemoCreatorID int32) error {
-	webhooks, err := s.Store.GetUserWebhooks(ctx, relatedMemoCreatorID)
+	payload, err := convertMemoToWebhookPayload(commentMemo)
 	if err != nil {
-		return err
+		return errors.Wrap(err, "failed to convert memo to webhook payload")
 	}
-	for _, hook := range webhooks {
-		payload, err := convertMemoToWebhookPayload(commentMemo)
-		if err != nil {
-			return errors.Wrap(err, "failed to convert memo to webhook payload")
-		}
-		payload.ActivityType = "memos.memo.comment.created"
-		payload.URL = hook.Url
-		webhook.PostAsync(payload)
+	payload.ActivityType = webhook.EventMemoCommentCreated
+	if err := s.dispatchUserWebhooks(ctx, relatedMemoCreatorID, payload); err != nil {
+		return err
 	}
-	return nil
+	return s.dispatchInstanceWebhooks(ctx, payload)
 }
 
 func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *v1pb.Memo, activityType string) error {
@@ -951,25 +1104,68 @@ func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *v1p
 	if creator == nil {
 		return status.Errorf(codes.NotFound, "memo creator not found")
 	}
-	creatorID := creator.ID
-	webhooks, err := s.Store.GetUserWebhooks(ctx, creatorID)
+	payload, err := convertMemoToWebhookPayload(memo)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo to webhook payload")
+	}
+	payload.ActivityType = activityType
+	if err := s.dispatchUserWebhooks(ctx, creator.ID, payload); err != nil {
+		return err
+	}
+	return s.dispatchInstanceWebhooks(ctx, payload)
+}
+
+// dispatchUserWebhooks enqueues the payload for each of the user's webhooks subscribed to it.
+func (s *APIV1Service) dispatchUserWebhooks(ctx context.Context, userID int32, payload *webhook.WebhookRequestPayload) error {
+	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
 	if err != nil {
 		return err
 	}
 	for _, hook := range webhooks {
-		payload, err := convertMemoToWebhookPayload(memo)
+		subscribed, err := s.isWebhookSubscribed(ctx, hook, payload)
 		if err != nil {
-			return errors.Wrap(err, "failed to convert memo to webhook payload")
+			slog.Warn("Failed to match webhook subscription", slog.Any("err", err), slog.String("webhook", hook.Id))
+			continue
+		}
+		if !subscribed {
+			continue
+		}
+		// Each delivery gets its own copy, as enqueueing sets the webhook URL on the payload.
+		hookPayload := *payload
+		if err := s.enqueueWebhookDelivery(ctx, userID, hook, &hookPayload); err != nil {
+			return err
 		}
-		payload.ActivityType = activityType
-		payload.URL = hook.Url
-
-		// Use asynchronous webhook dispatch
-		webhook.PostAsync(payload)
 	}
 	return nil
 }
 
+// isWebhookSubscribed reports whether the webhook subscribes to the payload's event type,
+// and whether the payload's memo matches the webhook filter. Events without a memo are
+// not filtered.
+func (s *APIV1Service) isWebhookSubscribed(ctx context.Context, hook *storepb.WebhooksUserSetting_Webhook, payload *webhook.WebhookRequestPayload) (bool, error) {
+	if len(hook.EventTypes) > 0 && !slices.Contains(hook.EventTypes, payload.ActivityType) {
+		return false, nil
+	}
+	if hook.Filter == "" {
+		return true, nil
+	}
+	if payload.Memo == nil {
+		return true, nil
+	}
+	memoUID, err := ExtractMemoUIDFromName(payload.Memo.Name)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid memo name")
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		UID:     &memoUID,
+		Filters: []string{hook.Filter},
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to match webhook filter")
+	}
+	return len(memos) > 0, nil
+}
+
 func convertMemoToWebhookPayload(memo *v1pb.Memo) (*webhook.WebhookRequestPayload, error) {
 	return &webhook.WebhookRequestPayload{
 		Creator: memo.Creator,
@@ -43,11 +43,28 @@ func (s *APIV1Service) convertMemoFromStoreWithCreators(ctx context.Context, mem
 		Content:    memo.Content,
 		Visibility: convertVisibilityFromStore(memo.Visibility),
 		Pinned:     memo.Pinned,
+		Etag:       buildMemoEtag(memo.Revision),
+	}
+	if memo.DueTs != nil {
+		memoMessage.DueTime = timestamppb.New(time.Unix(*memo.DueTs, 0))
+	}
+	if memo.RemindTs != nil {
+		memoMessage.RemindTime = timestamppb.New(time.Unix(*memo.RemindTs, 0))
+	}
+	visibility := getMemoScheduledVisibility(memo)
+	if memo.PublishTs != nil {
+		memoMessage.PublishTime = timestamppb.New(time.Unix(*memo.PublishTs, 0))
+		memoMessage.Visibility = convertVisibilityFromStore(visibility)
 	}
 	if memo.Payload != nil {
 		memoMessage.Tags = memo.Payload.Tags
 		memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
 		memoMessage.Location = convertLocationFromStore(memo.Payload.Location)
+		if visibility == store.Group {
+			for _, groupName := range memo.Payload.Audience {
+				memoMessage.Audience = append(memoMessage.Audience, GroupNamePrefix+groupName)
+			}
+		}
 	}
 
 	if memo.ParentUID != nil {
@@ -355,6 +372,8 @@ func convertVisibilityFromStore(visibility store.Visibility) v1pb.Visibility {
 		return v1pb.Visibility_PROTECTED
 	case store.Public:
 		return v1pb.Visibility_PUBLIC
+	case store.Group:
+		return v1pb.Visibility_GROUP
 	default:
 		return v1pb.Visibility_VISIBILITY_UNSPECIFIED
 	}
@@ -366,6 +385,8 @@ func convertVisibilityToStore(visibility v1pb.Visibility) store.Visibility {
 		return store.Protected
 	case v1pb.Visibility_PUBLIC:
 		return store.Public
+	case v1pb.Visibility_GROUP:
+		return store.Group
 	default:
 		return store.Private
 	}
@@ -4,6 +4,8 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -13,8 +15,10 @@ import (
 
 	"github.com/lithammer/shortuuid/v4"
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -52,17 +56,46 @@ func (s *APIV1Service) CreateMemoShare(ctx context.Context, request *v1pb.Create
 		}
 		expiresTs = &ts
 	}
+	var passwordHash string
+	if request.MemoShare != nil && request.MemoShare.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(request.MemoShare.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to hash share password")
+		}
+		passwordHash = string(hash)
+	}
+	var maxViews *int32
+	if request.MemoShare != nil && request.MemoShare.MaxViews != nil {
+		if request.MemoShare.GetMaxViews() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "max_views must be positive")
+		}
+		maxViews = request.MemoShare.MaxViews
+	}
 
 	// Generate a URL-safe token using shortuuid (base57-encoded UUID v4, 22 chars, 122-bit entropy).
 	ms, err := s.Store.CreateMemoShare(ctx, &store.MemoShare{
-		UID:       shortuuid.New(),
-		MemoID:    memo.ID,
-		CreatorID: user.ID,
-		ExpiresTs: expiresTs,
+		UID:          shortuuid.New(),
+		MemoID:       memo.ID,
+		CreatorID:    user.ID,
+		ExpiresTs:    expiresTs,
+		PasswordHash: passwordHash,
+		MaxViews:     maxViews,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create memo share")
 	}
+	// The share token and password are bearer credentials, so only the memo is recorded.
+	auditDetails := []string{"never expires"}
+	if expiresTs != nil {
+		auditDetails[0] = "expires " + time.Unix(*expiresTs, 0).UTC().Format(time.RFC3339)
+	}
+	if passwordHash != "" {
+		auditDetails = append(auditDetails, "password protected")
+	}
+	if maxViews != nil {
+		auditDetails = append(auditDetails, fmt.Sprintf("max %d views", *maxViews))
+	}
+	s.recordAuditLog(ctx, user.ID, store.AuditActionCreateMemoShare, MemoNamePrefix+memo.UID, strings.Join(auditDetails, ", "))
 
 	return convertMemoShareFromStore(ms, memo.UID), nil
 }
@@ -148,13 +181,16 @@ func (s *APIV1Service) DeleteMemoShare(ctx context.Context, request *v1pb.Delete
 	return &emptypb.Empty{}, nil
 }
 
-// GetMemoByShare resolves a share token to its memo. No authentication required.
-// Returns NOT_FOUND for invalid or expired tokens (no information leakage).
+// GetMemoByShare resolves a share token to its memo and counts the view. No authentication required.
+// Returns NOT_FOUND for invalid, expired or used up tokens (no information leakage).
 func (s *APIV1Service) GetMemoByShare(ctx context.Context, request *v1pb.GetMemoByShareRequest) (*v1pb.Memo, error) {
 	ms, err := s.getActiveMemoShare(ctx, request.ShareId)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.checkMemoSharePassword(ctx, ms, request.Password); err != nil {
+		return nil, err
+	}
 
 	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &ms.MemoID})
 	if err != nil {
@@ -188,6 +224,27 @@ func (s *APIV1Service) GetMemoByShare(ctx context.Context, request *v1pb.GetMemo
 		}
 		return nil, errors.Wrap(err, "failed to convert memo")
 	}
+
+	// Count the view last, so failed requests don't use up a view-limited share.
+	recorded, err := s.Store.RecordMemoShareView(ctx, ms.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record memo share view")
+	}
+	if !recorded {
+		return nil, status.Errorf(codes.NotFound, "not found")
+	}
+	if ms.PasswordHash != "" {
+		// The attachments are loaded by the browser without the password, so they are
+		// authorized by a short-lived cookie instead.
+		token, expiresAt, err := auth.GenerateMemoShareAccessToken(ms.ID, auth.Fingerprint(ms.PasswordHash), []byte(s.Secret))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate share access token")
+		}
+		if err := SetResponseHeader(ctx, "Set-Cookie", buildMemoShareAccessCookie(ctx, ms.ID, token, expiresAt)); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to set share access cookie")
+		}
+	}
+	s.dispatchMemoShareOpenedNotificationBestEffort(ctx, ms)
 	return memoMessage, nil
 }
 
@@ -196,12 +253,65 @@ func isMemoShareExpired(ms *store.MemoShare) bool {
 	return ms.ExpiresTs != nil && time.Now().Unix() > *ms.ExpiresTs
 }
 
+// isMemoShareExhausted returns true if the share has been viewed as often as it allows.
+func isMemoShareExhausted(ms *store.MemoShare) bool {
+	return ms.MaxViews != nil && ms.ViewCount >= *ms.MaxViews
+}
+
+// checkMemoSharePassword checks the password of a password-protected share; shares without a
+// password accept any input. Failed attempts are counted per share and per client IP, and lock
+// them out under the sign-in protection policy.
+func (s *APIV1Service) checkMemoSharePassword(ctx context.Context, ms *store.MemoShare, password string) error {
+	if ms.PasswordHash == "" {
+		return nil
+	}
+	if password == "" {
+		return status.Errorf(codes.PermissionDenied, "share password required")
+	}
+	instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get instance general setting: %v", err)
+	}
+	policy := resolveSignInProtectionPolicy(instanceGeneralSetting.GetSignInProtection())
+	clientIP := extractClientIP(ctx)
+	keys := []signInAttemptKey{{kind: store.SignInAttemptKindMemoShare, identifier: fmt.Sprint(ms.ID)}}
+	if clientIP != "" {
+		keys = append(keys, signInAttemptKey{kind: store.SignInAttemptKindIP, identifier: clientIP})
+	}
+	if err := s.checkAttemptsAllowed(ctx, policy, keys, "share password"); err != nil {
+		return err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(ms.PasswordHash), []byte(password)) != nil {
+		s.recordAttemptFailure(ctx, policy, keys, "share password failed",
+			slog.Int64("share_id", int64(ms.ID)),
+			slog.String("ip", clientIP))
+		return status.Errorf(codes.PermissionDenied, "invalid share password")
+	}
+	return nil
+}
+
+// buildMemoShareAccessCookie builds the cookie that authorizes the attachment requests of a
+// password-protected share. It is only sent to the file server.
+func buildMemoShareAccessCookie(ctx context.Context, shareID int32, token string, expireTime time.Time) string {
+	attrs := []string{
+		fmt.Sprintf("%s=%s", auth.MemoShareAccessCookieName(shareID), token),
+		"Path=/file/",
+		"HttpOnly",
+		"Expires=" + expireTime.UTC().Format("Mon, 02 Jan 2006 15:04:05 GMT"),
+		"SameSite=Lax",
+	}
+	if isSecureRequest(ctx) {
+		attrs = append(attrs, "Secure")
+	}
+	return strings.Join(attrs, "; ")
+}
+
 func (s *APIV1Service) getActiveMemoShare(ctx context.Context, shareID string) (*store.MemoShare, error) {
 	ms, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{UID: &shareID})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo share")
 	}
-	if ms == nil || isMemoShareExpired(ms) {
+	if ms == nil || isMemoShareExpired(ms) || isMemoShareExhausted(ms) {
 		return nil, status.Errorf(codes.NotFound, "not found")
 	}
 	return ms, nil
@@ -216,11 +326,17 @@ func stringPointer(s string) *string {
 func convertMemoShareFromStore(ms *store.MemoShare, memoUID string) *v1pb.MemoShare {
 	name := fmt.Sprintf("%s%s/%s%s", MemoNamePrefix, memoUID, MemoShareNamePrefix, ms.UID)
 	pb := &v1pb.MemoShare{
-		Name:       name,
-		CreateTime: timestamppb.New(time.Unix(ms.CreatedTs, 0)),
+		Name:              name,
+		CreateTime:        timestamppb.New(time.Unix(ms.CreatedTs, 0)),
+		PasswordProtected: ms.PasswordHash != "",
+		MaxViews:          ms.MaxViews,
+		ViewCount:         ms.ViewCount,
 	}
 	if ms.ExpiresTs != nil {
 		pb.ExpireTime = timestamppb.New(time.Unix(*ms.ExpiresTs, 0))
 	}
+	if ms.LastAccessedTs != nil {
+		pb.LastAccessTime = timestamppb.New(time.Unix(*ms.LastAccessedTs, 0))
+	}
 	return pb
 }
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"log/slog"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -10,6 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -69,10 +71,96 @@ func (s *APIV1Service) dispatchMemoUpdatedSideEffects(ctx context.Context, memo
 	}
 
 	s.SSEHub.Broadcast(&SSEEvent{
-		Type:       SSEEventMemoUpdated,
-		Name:       memoMessage.Name,
-		Parent:     memoMessage.GetParent(),
-		Visibility: memo.Visibility,
-		CreatorID:  resolveSSECreatorID(memo, parentMemo),
+		Type:        SSEEventMemoUpdated,
+		Name:        memoMessage.Name,
+		Parent:      memoMessage.GetParent(),
+		Etag:        memoMessage.Etag,
+		Visibility:  memo.Visibility,
+		CreatorID:   resolveSSECreatorID(memo, parentMemo),
+		AudienceIDs: s.resolveSSEAudienceIDs(ctx, memo, parentMemo),
 	})
 }
+
+// updateMemoAudience sets the audience of a memo whose visibility or audience is being
+// updated. GROUP memos take the requested audience, and comments that of their parent;
+// other memos have their audience cleared.
+func (s *APIV1Service) updateMemoAudience(ctx context.Context, user *store.User, memo *store.Memo, update *store.UpdateMemo, audience []string) error {
+	visibility := memo.Visibility
+	if update.Visibility != nil {
+		visibility = *update.Visibility
+	}
+
+	payload := memo.Payload
+	if payload == nil {
+		payload = &storepb.MemoPayload{}
+	}
+	switch {
+	case visibility != store.Group:
+		payload.Audience = nil
+	case memo.ParentUID != nil:
+		parentMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: memo.ParentUID})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get parent memo")
+		}
+		if parentMemo == nil {
+			return status.Errorf(codes.NotFound, "memo not found")
+		}
+		payload.Audience = nil
+		if parentMemo.Payload != nil {
+			payload.Audience = parentMemo.Payload.Audience
+		}
+	default:
+		groupNames, err := s.validateMemoAudience(ctx, user, audience)
+		if err != nil {
+			return err
+		}
+		payload.Audience = groupNames
+	}
+	update.Payload = payload
+	return nil
+}
+
+// buildMemoEtag returns the etag of a memo at the given revision.
+func buildMemoEtag(revision int32) string {
+	return strconv.FormatInt(int64(revision), 10)
+}
+
+// checkMemoEtag compares a client supplied etag against the memo's current revision
+// and returns the revision to use as the update precondition. On mismatch the
+// conflict is broadcast and a FailedPrecondition error returned.
+func (s *APIV1Service) checkMemoEtag(ctx context.Context, memo *store.Memo, etag string) (int32, error) {
+	revision, err := strconv.ParseInt(etag, 10, 32)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid etag %q", etag)
+	}
+	if int32(revision) != memo.Revision {
+		s.broadcastMemoConflict(ctx, memo)
+		return 0, status.Errorf(codes.FailedPrecondition, "memo has been modified; etag mismatch")
+	}
+	return int32(revision), nil
+}
+
+// broadcastMemoConflict tells the memo's live clients that a stale write was rejected,
+// so editors holding an older copy can reload it.
+func (s *APIV1Service) broadcastMemoConflict(ctx context.Context, memo *store.Memo) {
+	current, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID, ExcludeContent: true})
+	if err != nil || current == nil {
+		current = memo
+	}
+	var parentMemo *store.Memo
+	if current.ParentUID != nil {
+		parentMemo, _ = s.Store.GetMemo(ctx, &store.FindMemo{UID: current.ParentUID})
+	}
+	event := &SSEEvent{
+		Type:        SSEEventMemoConflict,
+		Name:        buildMemoName(current.UID),
+		Etag:        buildMemoEtag(current.Revision),
+		Visibility:  current.Visibility,
+		CreatorID:   resolveSSECreatorID(current, parentMemo),
+		AudienceIDs: s.resolveSSEAudienceIDs(ctx, current, parentMemo),
+	}
+	if parentMemo != nil {
+		event.Parent = buildMemoName(parentMemo.UID)
+	}
+	s.SSEHub.Broadcast(event)
+}
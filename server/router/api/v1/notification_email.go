@@ -3,20 +3,46 @@ package v1
 import (
 	"context"
 	"log/slog"
+	"slices"
+	"time"
 
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/notification"
 	"github.com/usememos/memos/store"
 )
 
+// createInboxWithEmailNotification creates the inbox entry and sends its email, push and channel notifications.
+// It returns nil without creating anything when the receiver muted the notification type.
 func (s *APIV1Service) createInboxWithEmailNotification(ctx context.Context, inbox *store.Inbox) (*store.Inbox, error) {
+	muted, err := s.isNotificationMuted(ctx, inbox.ReceiverID, inbox.Message.GetType())
+	if err != nil {
+		return nil, err
+	}
+	if muted {
+		return nil, nil
+	}
 	createdInbox, err := s.Store.CreateInbox(ctx, inbox)
 	if err != nil {
 		return nil, err
 	}
 	s.dispatchInboxEmailNotificationBestEffort(ctx, createdInbox)
+	s.dispatchInboxPushBestEffort(ctx, createdInbox)
+	s.dispatchInboxChannelsBestEffort(ctx, createdInbox)
 	return createdInbox, nil
 }
 
+// isNotificationMuted reports whether the user opted out of a notification type.
+func (s *APIV1Service) isNotificationMuted(ctx context.Context, userID int32, notificationType storepb.InboxMessage_Type) (bool, error) {
+	setting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(setting.GetGeneral().GetMutedNotificationTypes(), notificationType), nil
+}
+
 func (s *APIV1Service) dispatchInboxEmailNotificationBestEffort(ctx context.Context, inbox *store.Inbox) {
 	dispatcher := notification.NewEmailDispatcher(s.Profile, s.Store, s.NotificationEmailSender)
 	if err := dispatcher.DispatchInboxEmail(ctx, inbox); err != nil {
@@ -26,3 +52,15 @@ func (s *APIV1Service) dispatchInboxEmailNotificationBestEffort(ctx context.Cont
 			slog.Int64("receiver_id", int64(inbox.ReceiverID)))
 	}
 }
+
+// DispatchNotificationDigests sends the hourly or daily notification digest emails.
+func (s *APIV1Service) DispatchNotificationDigests(ctx context.Context, delivery storepb.NotificationUserSetting_EmailDelivery, now time.Time) error {
+	dispatcher := notification.NewEmailDispatcher(s.Profile, s.Store, s.NotificationEmailSender)
+	return dispatcher.DispatchDigestEmails(ctx, delivery, now)
+}
+
+// DispatchWeeklyRecaps sends the weekly recap emails to the users who opted in.
+func (s *APIV1Service) DispatchWeeklyRecaps(ctx context.Context, now time.Time) error {
+	dispatcher := notification.NewRecapDispatcher(s.Profile, s.Store, s.MarkdownService, s.NotificationEmailSender)
+	return dispatcher.DispatchWeeklyRecaps(ctx, now)
+}
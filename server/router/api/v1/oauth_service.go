@@ -0,0 +1,304 @@
+package v1
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// ListOAuthClients returns the OAuth clients created by the current user, or all clients for admins.
+func (s *APIV1Service) ListOAuthClients(ctx context.Context, _ *v1pb.ListOAuthClientsRequest) (*v1pb.ListOAuthClientsResponse, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	find := &store.FindOAuthClient{}
+	if !isSuperUser(currentUser) {
+		find.CreatorID = &currentUser.ID
+	}
+	clients, err := s.Store.ListOAuthClients(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list oauth clients: %v", err)
+	}
+
+	response := &v1pb.ListOAuthClientsResponse{}
+	for _, client := range clients {
+		creatorName, err := s.getOAuthClientCreatorName(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		response.OauthClients = append(response.OauthClients, convertOAuthClientFromStore(client, creatorName))
+	}
+	return response, nil
+}
+
+// CreateOAuthClient registers a new OAuth client owned by the current user.
+// The client secret of a confidential client is only returned in this response.
+func (s *APIV1Service) CreateOAuthClient(ctx context.Context, request *v1pb.CreateOAuthClientRequest) (*v1pb.CreateOAuthClientResponse, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if request.OauthClient == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "oauth client is required")
+	}
+
+	displayName := strings.TrimSpace(request.OauthClient.DisplayName)
+	if displayName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "display name is required")
+	}
+	if len(request.OauthClient.RedirectUris) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one redirect URI is required")
+	}
+	for _, redirectURI := range request.OauthClient.RedirectUris {
+		if err := auth.ValidateOAuthRedirectURI(redirectURI); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+	scopes, err := auth.NormalizeOAuthScopes(request.OauthClient.Scopes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	create := &store.OAuthClient{
+		ClientID:     shortuuid.New(),
+		Name:         displayName,
+		CreatorID:    currentUser.ID,
+		RedirectURIs: request.OauthClient.RedirectUris,
+		Scopes:       scopes,
+	}
+	clientSecret := ""
+	if request.OauthClient.Confidential {
+		clientSecret, err = auth.GenerateOAuthClientSecret()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate client secret: %v", err)
+		}
+		create.ClientSecretHash = auth.HashOAuthSecret(clientSecret)
+	}
+
+	client, err := s.Store.CreateOAuthClient(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create oauth client: %v", err)
+	}
+	return &v1pb.CreateOAuthClientResponse{
+		OauthClient:  convertOAuthClientFromStore(client, BuildUserName(currentUser.Username)),
+		ClientSecret: clientSecret,
+	}, nil
+}
+
+// DeleteOAuthClient deletes an OAuth client and every token issued to it.
+// Only the creator or an admin may delete a client.
+func (s *APIV1Service) DeleteOAuthClient(ctx context.Context, request *v1pb.DeleteOAuthClientRequest) (*emptypb.Empty, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	clientID, err := ExtractOAuthClientIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid oauth client name: %v", err)
+	}
+	client, err := s.Store.GetOAuthClient(ctx, &store.FindOAuthClient{ClientID: &clientID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get oauth client: %v", err)
+	}
+	if client == nil {
+		return nil, status.Errorf(codes.NotFound, "oauth client not found")
+	}
+	if client.CreatorID != currentUser.ID && !isSuperUser(currentUser) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	if err := s.Store.DeleteOAuthClient(ctx, &store.DeleteOAuthClient{ID: client.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete oauth client: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetOAuthConsent validates an authorization request and returns the data shown on the consent screen.
+func (s *APIV1Service) GetOAuthConsent(ctx context.Context, request *v1pb.GetOAuthConsentRequest) (*v1pb.OAuthConsent, error) {
+	if _, err := s.fetchOAuthAuthorizingUser(ctx); err != nil {
+		return nil, err
+	}
+	client, scopes, err := s.validateOAuthAuthorizationRequest(ctx, request.Request)
+	if err != nil {
+		return nil, err
+	}
+	creatorName, err := s.getOAuthClientCreatorName(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.OAuthConsent{
+		Client:      convertOAuthClientFromStore(client, creatorName),
+		Scopes:      scopes,
+		RedirectUri: request.Request.RedirectUri,
+	}, nil
+}
+
+// AuthorizeOAuthClient issues an authorization code when the user approves the request,
+// or an access_denied error otherwise. Either way the result is returned as a redirect URL.
+func (s *APIV1Service) AuthorizeOAuthClient(ctx context.Context, request *v1pb.AuthorizeOAuthClientRequest) (*v1pb.AuthorizeOAuthClientResponse, error) {
+	currentUser, err := s.fetchOAuthAuthorizingUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client, scopes, err := s.validateOAuthAuthorizationRequest(ctx, request.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if request.Request.State != "" {
+		params.Set("state", request.Request.State)
+	}
+	if !request.Approve {
+		params.Set("error", "access_denied")
+		return &v1pb.AuthorizeOAuthClientResponse{RedirectUri: appendQuery(request.Request.RedirectUri, params)}, nil
+	}
+
+	code, err := auth.GenerateOAuthAuthorizationCode()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate authorization code: %v", err)
+	}
+	now := time.Now()
+	// Opportunistically drop codes and refresh tokens that can no longer be used.
+	nowTs := now.Unix()
+	if _, err := s.Store.DeleteOAuthTokens(ctx, &store.DeleteOAuthTokens{ExpiredTs: &nowTs}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete expired oauth tokens: %v", err)
+	}
+	if _, err := s.Store.CreateOAuthToken(ctx, &store.OAuthToken{
+		Kind:          store.OAuthTokenKindAuthorizationCode,
+		TokenHash:     auth.HashOAuthSecret(code),
+		ClientID:      client.ClientID,
+		UserID:        currentUser.ID,
+		Scopes:        scopes,
+		RedirectURI:   request.Request.RedirectUri,
+		CodeChallenge: request.Request.CodeChallenge,
+		ExpiresTs:     now.Add(auth.OAuthAuthorizationCodeDuration).Unix(),
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create authorization code: %v", err)
+	}
+
+	params.Set("code", code)
+	return &v1pb.AuthorizeOAuthClientResponse{RedirectUri: appendQuery(request.Request.RedirectUri, params)}, nil
+}
+
+// fetchOAuthAuthorizingUser returns the user deciding on an authorization request.
+// Scoped credentials, including OAuth access tokens, cannot grant access to other clients.
+func (s *APIV1Service) fetchOAuthAuthorizingUser(ctx context.Context) (*store.User, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if len(auth.GetScopes(ctx)) > 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "scoped tokens cannot authorize oauth clients")
+	}
+	return currentUser, nil
+}
+
+// validateOAuthAuthorizationRequest checks an authorization request against the registered client
+// and returns the client together with the scopes to grant.
+func (s *APIV1Service) validateOAuthAuthorizationRequest(ctx context.Context, request *v1pb.OAuthAuthorizationRequest) (*store.OAuthClient, []string, error) {
+	if request == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "authorization request is required")
+	}
+	client, err := s.Store.GetOAuthClient(ctx, &store.FindOAuthClient{ClientID: &request.ClientId})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get oauth client: %v", err)
+	}
+	if client == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "unknown client_id")
+	}
+	if !slices.Contains(client.RedirectURIs, request.RedirectUri) {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "redirect_uri is not registered for the client")
+	}
+	if request.ResponseType != "code" {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "unsupported response_type %q", request.ResponseType)
+	}
+	if request.CodeChallenge == "" || request.CodeChallengeMethod != auth.PKCEMethodS256 {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "a PKCE code_challenge with method S256 is required")
+	}
+
+	scopes, err := auth.NormalizeOAuthScopes(strings.Fields(request.Scope))
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	for _, scope := range scopes {
+		if !auth.OAuthClientAllowsScope(client.Scopes, scope) {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "scope %q is not registered for the client", scope)
+		}
+	}
+	if len(scopes) == 0 {
+		scopes = client.Scopes
+	}
+	if len(scopes) == 0 {
+		scopes = auth.DefaultOAuthScopes
+	}
+	return client, scopes, nil
+}
+
+func (s *APIV1Service) getOAuthClientCreatorName(ctx context.Context, client *store.OAuthClient) (string, error) {
+	if client.CreatorID == 0 {
+		return "", nil
+	}
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &client.CreatorID})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get oauth client creator: %v", err)
+	}
+	if creator == nil {
+		return "", nil
+	}
+	return BuildUserName(creator.Username), nil
+}
+
+// appendQuery adds params to the query of a URL that has already been validated.
+func appendQuery(rawURL string, params url.Values) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	query := u.Query()
+	for key, values := range params {
+		query[key] = values
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func convertOAuthClientFromStore(client *store.OAuthClient, creatorName string) *v1pb.OAuthClient {
+	return &v1pb.OAuthClient{
+		Name:         OAuthClientNamePrefix + client.ClientID,
+		ClientId:     client.ClientID,
+		DisplayName:  client.Name,
+		RedirectUris: client.RedirectURIs,
+		Scopes:       client.Scopes,
+		Confidential: client.ClientSecretHash != "",
+		Creator:      creatorName,
+		CreateTime:   timestamppb.New(time.Unix(client.CreatedTs, 0)),
+	}
+}
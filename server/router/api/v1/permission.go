@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/store"
+)
+
+// hasPermission reports whether the user holds the permission.
+// Admins hold every permission; users with a custom role hold the permissions it grants.
+func (s *APIV1Service) hasPermission(ctx context.Context, user *store.User, permission store.Permission) (bool, error) {
+	if user == nil {
+		return false, nil
+	}
+	if isSuperUser(user) {
+		return true, nil
+	}
+	if !user.Role.IsCustom() {
+		return false, nil
+	}
+	name := string(user.Role)
+	role, err := s.Store.GetCustomRole(ctx, &store.FindCustomRole{Name: &name})
+	if err != nil {
+		return false, err
+	}
+	return role != nil && role.HasPermission(permission), nil
+}
+
+// fetchUserWithPermission returns the current user if they hold the permission.
+func (s *APIV1Service) fetchUserWithPermission(ctx context.Context, permission store.Permission) (*store.User, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	allowed, err := s.hasPermission(ctx, currentUser, permission)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if !allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, nil
+}
+
+func (s *APIV1Service) fetchAdminUser(ctx context.Context) (*store.User, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(currentUser) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, nil
+}
+
+// canManageUser reports whether the current user may manage another user's account.
+// Holders of the manage users permission manage everyone except admins, whom only
+// admins can manage, and users whose role grants a permission the manager lacks.
+func (s *APIV1Service) canManageUser(ctx context.Context, currentUser, user *store.User) (bool, error) {
+	if currentUser.ID == user.ID || isSuperUser(currentUser) {
+		return true, nil
+	}
+	if isSuperUser(user) {
+		return false, nil
+	}
+	canManageUsers, err := s.hasPermission(ctx, currentUser, store.PermissionManageUsers)
+	if err != nil || !canManageUsers || !user.Role.IsCustom() {
+		return canManageUsers, err
+	}
+	name := string(user.Role)
+	role, err := s.Store.GetCustomRole(ctx, &store.FindCustomRole{Name: &name})
+	if err != nil || role == nil {
+		return err == nil, err
+	}
+	for _, permission := range role.Permissions {
+		allowed, err := s.hasPermission(ctx, currentUser, permission)
+		if err != nil || !allowed {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// canModerateMemo reports whether the user may apply the update paths to another user's memo
+// as a moderator. Moderators can only change the state of public and protected memos.
+func (s *APIV1Service) canModerateMemo(ctx context.Context, user *store.User, memo *store.Memo, paths []string) (bool, error) {
+	if memo.Visibility == store.Private || memo.Visibility == store.Group {
+		return false, nil
+	}
+	for _, path := range paths {
+		if path != "state" {
+			return false, nil
+		}
+	}
+	return s.hasPermission(ctx, user, store.PermissionModerateMemos)
+}
+
+// canAccessRestrictedMemo reports whether the signed-in user may access a memo's
+// comments, reactions and attachments. PRIVATE memos are limited to their creator,
+// admins and collaborators; GROUP memos are also open to members of their audience groups.
+func (s *APIV1Service) canAccessRestrictedMemo(ctx context.Context, user *store.User, memo *store.Memo) (bool, error) {
+	if !isRestrictedMemo(memo) || memo.CreatorID == user.ID || isSuperUser(user) {
+		return true, nil
+	}
+	isCollaborator, err := s.isMemoCollaborator(ctx, user, memo, store.MemoCollaboratorViewer)
+	if err != nil || isCollaborator {
+		return isCollaborator, err
+	}
+	return s.Store.IsMemoAudienceMember(ctx, memo, user.ID)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/usememos/memos/internal/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	"github.com/usememos/memos/store"
 )
@@ -35,7 +37,11 @@ func (s *APIV1Service) ListMemoReactions(ctx context.Context, request *v1pb.List
 		if user == nil {
 			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID && !isSuperUser(user) {
+		allowed, err := s.canAccessRestrictedMemo(ctx, user, memo)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+		}
+		if !allowed {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 	}
@@ -80,7 +86,11 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	}
 
 	// Check memo visibility.
-	if memo.Visibility == store.Private && memo.CreatorID != user.ID && !isSuperUser(user) {
+	allowed, err := s.canAccessRestrictedMemo(ctx, user, memo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+	}
+	if !allowed {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -103,11 +113,42 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	if memo.ParentUID != nil {
 		parentMemo, _ = s.Store.GetMemo(ctx, &store.FindMemo{UID: memo.ParentUID})
 	}
-	s.SSEHub.Broadcast(buildMemoReactionSSEEvent(SSEEventReactionUpserted, request.Reaction.ContentId, memo, parentMemo))
+	event := buildMemoReactionSSEEvent(SSEEventReactionUpserted, request.Reaction.ContentId, memo, parentMemo)
+	event.AudienceIDs = s.resolveSSEAudienceIDs(ctx, memo, parentMemo)
+	s.SSEHub.Broadcast(event)
+
+	// Try to dispatch webhook when a reaction is upserted.
+	if err := s.DispatchMemoReactionUpsertedWebhook(ctx, memo, reactionMessage); err != nil {
+		slog.Warn("Failed to dispatch memo reaction upserted webhook", slog.Any("err", err))
+	}
+	s.dispatchMemoReactionNotificationBestEffort(ctx, memo, user, reaction.ReactionType)
 
 	return reactionMessage, nil
 }
 
+// DispatchMemoReactionUpsertedWebhook dispatches webhook to the memo owner when a reaction is upserted.
+func (s *APIV1Service) DispatchMemoReactionUpsertedWebhook(ctx context.Context, memo *store.Memo, reaction *v1pb.Reaction) error {
+	webhooks, err := s.Store.GetUserWebhooks(ctx, memo.CreatorID)
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+	_, _, memoMessage, err := s.buildUpdatedMemoState(ctx, memo.ID)
+	if err != nil {
+		return err
+	}
+	payload, err := convertMemoToWebhookPayload(memoMessage)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo to webhook payload")
+	}
+	payload.ActivityType = webhook.EventMemoReactionUpserted
+	payload.Creator = reaction.Creator
+	payload.Reaction = reaction
+	return s.dispatchUserWebhooks(ctx, memo.CreatorID, payload)
+}
+
 func (s *APIV1Service) DeleteMemoReaction(ctx context.Context, request *v1pb.DeleteMemoReactionRequest) (*emptypb.Empty, error) {
 	user, err := s.fetchCurrentUser(ctx)
 	if err != nil {
@@ -158,7 +199,9 @@ func (s *APIV1Service) DeleteMemoReaction(ctx context.Context, request *v1pb.Del
 	if memo != nil && memo.ParentUID != nil {
 		parentMemo, _ = s.Store.GetMemo(ctx, &store.FindMemo{UID: memo.ParentUID})
 	}
-	s.SSEHub.Broadcast(buildMemoReactionSSEEvent(SSEEventReactionDeleted, reaction.ContentID, memo, parentMemo))
+	event := buildMemoReactionSSEEvent(SSEEventReactionDeleted, reaction.ContentID, memo, parentMemo)
+	event.AudienceIDs = s.resolveSSEAudienceIDs(ctx, memo, parentMemo)
+	s.SSEHub.Broadcast(event)
 
 	return &emptypb.Empty{}, nil
 }
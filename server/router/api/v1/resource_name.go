@@ -18,11 +18,20 @@ const (
 	UserNamePrefix             = "users/"
 	MemoNamePrefix             = "memos/"
 	MemoShareNamePrefix        = "shares/"
+	CollectionShareNamePrefix  = "collectionShares/"
+	MemoCollaboratorNamePrefix = "collaborators/"
 	AttachmentNamePrefix       = "attachments/"
 	ReactionNamePrefix         = "reactions/"
 	InboxNamePrefix            = "inboxes/"
 	IdentityProviderNamePrefix = "identity-providers/"
 	WebhookNamePrefix          = "webhooks/"
+	SessionNamePrefix          = "sessions/"
+	InvitationNamePrefix       = "invitations/"
+	OAuthClientNamePrefix      = "oauth-clients/"
+	RoleNamePrefix             = "roles/"
+	AuditLogNamePrefix         = "auditLogs/"
+	GroupNamePrefix            = "groups/"
+	GroupMemberNamePrefix      = "members/"
 )
 
 // GetNameParentTokens returns the tokens from a resource name.
@@ -134,6 +143,52 @@ func ExtractIdentityProviderUIDFromName(name string) (string, error) {
 	return tokens[0], nil
 }
 
+// ExtractInvitationUIDFromName returns the invitation UID from a resource name.
+func ExtractInvitationUIDFromName(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, InvitationNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
+// ExtractOAuthClientIDFromName returns the OAuth client ID from a resource name.
+func ExtractOAuthClientIDFromName(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, OAuthClientNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
+// ExtractRoleNameFromName returns the custom role name from a resource name.
+func ExtractRoleNameFromName(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, RoleNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
+// ExtractGroupNameFromName returns the user group name from a resource name.
+func ExtractGroupNameFromName(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, GroupNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
+// ExtractGroupMemberFromName returns the group name and member username from a
+// group membership resource name.
+func ExtractGroupMemberFromName(name string) (string, string, error) {
+	tokens, err := GetNameParentTokens(name, GroupNamePrefix, GroupMemberNamePrefix)
+	if err != nil {
+		return "", "", err
+	}
+	return tokens[0], tokens[1], nil
+}
+
 // ValidateAndGenerateUID validates a user-provided UID or generates a new one.
 // If provided is empty, a new shortuuid is generated.
 // If provided is non-empty, it is validated against base.UIDMatcher.
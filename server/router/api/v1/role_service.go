@@ -0,0 +1,218 @@
+package v1
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// roleIDMatcher matches custom role IDs. IDs are lowercase so they never collide
+// with the built-in ADMIN and USER roles stored in the same column.
+var roleIDMatcher = regexp.MustCompile(`^[a-z][a-z0-9-]{0,31}$`)
+
+// ListRoles returns all custom roles of the instance.
+// Only admins may call this.
+func (s *APIV1Service) ListRoles(ctx context.Context, _ *v1pb.ListRolesRequest) (*v1pb.ListRolesResponse, error) {
+	if _, err := s.fetchAdminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	roles, err := s.Store.ListCustomRoles(ctx, &store.FindCustomRole{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list roles: %v", err)
+	}
+	response := &v1pb.ListRolesResponse{Roles: []*v1pb.Role{}}
+	for _, role := range roles {
+		response.Roles = append(response.Roles, convertRoleFromStore(role))
+	}
+	return response, nil
+}
+
+// GetRole returns a custom role.
+// Only admins may call this.
+func (s *APIV1Service) GetRole(ctx context.Context, request *v1pb.GetRoleRequest) (*v1pb.Role, error) {
+	if _, err := s.fetchAdminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	role, err := s.getCustomRoleByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return convertRoleFromStore(role), nil
+}
+
+// CreateRole creates a custom role.
+// Only admins may call this.
+func (s *APIV1Service) CreateRole(ctx context.Context, request *v1pb.CreateRoleRequest) (*v1pb.Role, error) {
+	if _, err := s.fetchAdminUser(ctx); err != nil {
+		return nil, err
+	}
+	if request.Role == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "role is required")
+	}
+	if !roleIDMatcher.MatchString(request.RoleId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role_id %q: must start with a lowercase letter and contain only lowercase letters, digits and hyphens", request.RoleId)
+	}
+	permissions, err := convertPermissionsToStore(request.Role.Permissions)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	existing, err := s.Store.GetCustomRole(ctx, &store.FindCustomRole{Name: &request.RoleId})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get role: %v", err)
+	}
+	if existing != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "role %q already exists", request.RoleId)
+	}
+
+	role, err := s.Store.CreateCustomRole(ctx, &store.CustomRole{
+		Name:        request.RoleId,
+		Description: strings.TrimSpace(request.Role.Description),
+		Permissions: permissions,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create role: %v", err)
+	}
+	return convertRoleFromStore(role), nil
+}
+
+// UpdateRole updates the description or permissions of a custom role.
+// Only admins may call this.
+func (s *APIV1Service) UpdateRole(ctx context.Context, request *v1pb.UpdateRoleRequest) (*v1pb.Role, error) {
+	if _, err := s.fetchAdminUser(ctx); err != nil {
+		return nil, err
+	}
+	if request.Role == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "role is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask is required")
+	}
+
+	role, err := s.getCustomRoleByName(ctx, request.Role.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedTs := time.Now().Unix()
+	update := &store.UpdateCustomRole{
+		ID:        role.ID,
+		UpdatedTs: &updatedTs,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "description":
+			description := strings.TrimSpace(request.Role.Description)
+			update.Description = &description
+		case "permissions":
+			permissions, err := convertPermissionsToStore(request.Role.Permissions)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+			}
+			update.Permissions = permissions
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+
+	role, err = s.Store.UpdateCustomRole(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update role: %v", err)
+	}
+	return convertRoleFromStore(role), nil
+}
+
+// DeleteRole deletes a custom role that is not assigned to any user.
+// Only admins may call this.
+func (s *APIV1Service) DeleteRole(ctx context.Context, request *v1pb.DeleteRoleRequest) (*emptypb.Empty, error) {
+	if _, err := s.fetchAdminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	role, err := s.getCustomRoleByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	userRole := store.Role(role.Name)
+	limitOne := 1
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{Role: &userRole, Limit: &limitOne})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+	if len(users) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "role %q is still assigned to users", role.Name)
+	}
+
+	if err := s.Store.DeleteCustomRole(ctx, &store.DeleteCustomRole{ID: role.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete role: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// resolveCustomRole returns the user role stored for a custom role resource name.
+func (s *APIV1Service) resolveCustomRole(ctx context.Context, name string) (store.Role, error) {
+	role, err := s.getCustomRoleByName(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	return store.Role(role.Name), nil
+}
+
+func (s *APIV1Service) getCustomRoleByName(ctx context.Context, name string) (*store.CustomRole, error) {
+	roleName, err := ExtractRoleNameFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role name: %v", err)
+	}
+	role, err := s.Store.GetCustomRole(ctx, &store.FindCustomRole{Name: &roleName})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get role: %v", err)
+	}
+	if role == nil {
+		return nil, status.Errorf(codes.NotFound, "role %q not found", roleName)
+	}
+	return role, nil
+}
+
+func convertRoleFromStore(role *store.CustomRole) *v1pb.Role {
+	result := &v1pb.Role{
+		Name:        RoleNamePrefix + role.Name,
+		Description: role.Description,
+		Permissions: []v1pb.Permission{},
+		CreateTime:  timestamppb.New(time.Unix(role.CreatedTs, 0)),
+		UpdateTime:  timestamppb.New(time.Unix(role.UpdatedTs, 0)),
+	}
+	for _, permission := range role.Permissions {
+		if value, ok := v1pb.Permission_value[string(permission)]; ok {
+			result.Permissions = append(result.Permissions, v1pb.Permission(value))
+		}
+	}
+	return result
+}
+
+// convertPermissionsToStore converts and deduplicates API permissions.
+func convertPermissionsToStore(permissions []v1pb.Permission) ([]store.Permission, error) {
+	result := []store.Permission{}
+	for _, permission := range permissions {
+		value := store.Permission(permission.String())
+		if !slices.Contains(store.AllPermissions, value) {
+			return nil, errors.Errorf("invalid permission: %s", permission)
+		}
+		if !slices.Contains(result, value) {
+			result = append(result, value)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,253 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// peerAddrMetadataKey carries the network address of the connected client.
+	peerAddrMetadataKey = "x-peer-addr"
+
+	defaultMaxSignInFailuresPerUsername = 5
+	defaultMaxSignInFailuresPerIP       = 20
+	defaultSignInLockout                = time.Minute
+	defaultMaxSignInLockout             = time.Hour
+)
+
+// signInProtectionPolicy is the resolved brute-force protection configuration.
+type signInProtectionPolicy struct {
+	maxFailuresPerUsername int32
+	maxFailuresPerIP       int32
+	lockout                time.Duration
+	maxLockout             time.Duration
+}
+
+// resolveSignInProtectionPolicy applies defaults to the instance setting.
+// Returns nil when sign-in protection is disabled.
+func resolveSignInProtectionPolicy(setting *storepb.InstanceSignInProtectionSetting) *signInProtectionPolicy {
+	if setting.GetDisabled() {
+		return nil
+	}
+	policy := &signInProtectionPolicy{
+		maxFailuresPerUsername: defaultMaxSignInFailuresPerUsername,
+		maxFailuresPerIP:       defaultMaxSignInFailuresPerIP,
+		lockout:                defaultSignInLockout,
+		maxLockout:             defaultMaxSignInLockout,
+	}
+	if setting.GetMaxFailuresPerUsername() > 0 {
+		policy.maxFailuresPerUsername = setting.GetMaxFailuresPerUsername()
+	}
+	if setting.GetMaxFailuresPerIp() > 0 {
+		policy.maxFailuresPerIP = setting.GetMaxFailuresPerIp()
+	}
+	if setting.GetLockoutSeconds() > 0 {
+		policy.lockout = time.Duration(setting.GetLockoutSeconds()) * time.Second
+	}
+	if setting.GetMaxLockoutSeconds() > 0 {
+		policy.maxLockout = time.Duration(setting.GetMaxLockoutSeconds()) * time.Second
+	}
+	if policy.maxLockout < policy.lockout {
+		policy.maxLockout = policy.lockout
+	}
+	return policy
+}
+
+// maxFailures returns the failure threshold for a counter kind. Client IP counters use the
+// per-IP threshold, and counters of a single target, such as a username, the per-username one.
+func (p *signInProtectionPolicy) maxFailures(kind store.SignInAttemptKind) int32 {
+	if kind == store.SignInAttemptKindIP {
+		return p.maxFailuresPerIP
+	}
+	return p.maxFailuresPerUsername
+}
+
+// lockoutDuration returns how long a counter is locked after reaching the given failure count.
+// The duration doubles with every failure past the threshold, capped at maxLockout.
+func (p *signInProtectionPolicy) lockoutDuration(kind store.SignInAttemptKind, failureCount int32) time.Duration {
+	excess := failureCount - p.maxFailures(kind)
+	if excess < 0 {
+		return 0
+	}
+	duration := p.lockout
+	for i := int32(0); i < excess && duration < p.maxLockout; i++ {
+		duration *= 2
+	}
+	return min(duration, p.maxLockout)
+}
+
+// signInAttemptKey identifies a single sign-in attempt counter.
+type signInAttemptKey struct {
+	kind       store.SignInAttemptKind
+	identifier string
+}
+
+// signInAttemptKeys returns the counters that apply to a sign-in request.
+func signInAttemptKeys(username, clientIP string) []signInAttemptKey {
+	keys := []signInAttemptKey{}
+	if clientIP != "" {
+		keys = append(keys, signInAttemptKey{kind: store.SignInAttemptKindIP, identifier: clientIP})
+	}
+	if username = strings.ToLower(strings.TrimSpace(username)); username != "" {
+		keys = append(keys, signInAttemptKey{kind: store.SignInAttemptKindUsername, identifier: username})
+	}
+	return keys
+}
+
+// checkSignInAllowed rejects the request while the client IP or the username is locked out.
+func (s *APIV1Service) checkSignInAllowed(ctx context.Context, policy *signInProtectionPolicy, username, clientIP string) error {
+	return s.checkAttemptsAllowed(ctx, policy, signInAttemptKeys(username, clientIP), "sign-in")
+}
+
+// checkAttemptsAllowed rejects the request while any of the counters is locked out. The action
+// names what the counters guard in the error message.
+func (s *APIV1Service) checkAttemptsAllowed(ctx context.Context, policy *signInProtectionPolicy, keys []signInAttemptKey, action string) error {
+	if policy == nil {
+		return nil
+	}
+	now := time.Now()
+	for _, key := range keys {
+		attempt, err := s.Store.GetSignInAttempt(ctx, &store.FindSignInAttempt{
+			Kind:       &key.kind,
+			Identifier: &key.identifier,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get sign-in attempts: %v", err)
+		}
+		if attempt == nil || attempt.LockedUntilTs <= now.Unix() {
+			continue
+		}
+		retryAfter := attempt.LockedUntilTs - now.Unix()
+		slog.Warn(action+" blocked by lockout",
+			slog.String("locked_by", string(key.kind)),
+			slog.String("identifier", key.identifier),
+			slog.Int64("retry_after_seconds", retryAfter))
+		return status.Errorf(codes.ResourceExhausted, "too many failed %s attempts, try again in %d seconds", action, retryAfter)
+	}
+	return nil
+}
+
+// recordSignInFailure increments the failure counters for the client IP and the username,
+// locking them once the configured threshold is reached.
+func (s *APIV1Service) recordSignInFailure(ctx context.Context, policy *signInProtectionPolicy, username, clientIP, reason string) {
+	s.recordAttemptFailure(ctx, policy, signInAttemptKeys(username, clientIP), "sign-in failed",
+		slog.String("username", username),
+		slog.String("ip", clientIP),
+		slog.String("reason", reason))
+}
+
+// recordAttemptFailure increments the failure counters, locking them once the configured
+// threshold is reached, and logs the failure with attrs. Counters are incremented atomically so
+// concurrent failures are all counted.
+func (s *APIV1Service) recordAttemptFailure(ctx context.Context, policy *signInProtectionPolicy, keys []signInAttemptKey, message string, attrs ...any) {
+	if policy == nil {
+		return
+	}
+	now := time.Now()
+	for _, key := range keys {
+		attempt, err := s.Store.IncrementSignInAttempt(ctx, &store.IncrementSignInAttempt{
+			Kind:          key.kind,
+			Identifier:    key.identifier,
+			FailureTs:     now.Unix(),
+			ResetBeforeTs: now.Add(-policy.maxLockout).Unix(),
+		})
+		if err != nil {
+			slog.Error("failed to record sign-in attempt", slog.Any("error", err))
+			continue
+		}
+		if lockout := policy.lockoutDuration(key.kind, attempt.FailureCount); lockout > 0 {
+			lockedUntil := now.Add(lockout).Unix()
+			if err := s.Store.LockSignInAttempt(ctx, &store.LockSignInAttempt{
+				Kind:          key.kind,
+				Identifier:    key.identifier,
+				LockedUntilTs: lockedUntil,
+			}); err != nil {
+				slog.Error("failed to lock sign-in attempts", slog.Any("error", err))
+			} else {
+				attempt.LockedUntilTs = max(attempt.LockedUntilTs, lockedUntil)
+			}
+		}
+		attrs = append(attrs, slog.Int(strings.ToLower(string(key.kind))+"_failures", int(attempt.FailureCount)))
+		if attempt.LockedUntilTs > now.Unix() {
+			attrs = append(attrs, slog.Int64(strings.ToLower(string(key.kind))+"_locked_until", attempt.LockedUntilTs))
+		}
+	}
+	slog.Warn(message, attrs...)
+}
+
+// resetSignInFailures clears the username counter after a successful sign-in.
+// The IP counter is kept so a single valid account cannot be used to reset password spraying limits.
+func (s *APIV1Service) resetSignInFailures(ctx context.Context, policy *signInProtectionPolicy, username string) {
+	if policy == nil {
+		return
+	}
+	kind := store.SignInAttemptKindUsername
+	identifier := strings.ToLower(strings.TrimSpace(username))
+	if err := s.Store.DeleteSignInAttempts(ctx, &store.DeleteSignInAttempt{
+		Kind:       &kind,
+		Identifier: &identifier,
+	}); err != nil {
+		slog.Error("failed to reset sign-in attempts", slog.Any("error", err))
+	}
+}
+
+// extractClientIP returns the IP address of the client making the request.
+// Proxy headers are only trusted when the connection comes from a loopback or private address,
+// and X-Forwarded-For is read from the right so a client cannot choose the address it is keyed on:
+// the rightmost hop that is not itself a trusted proxy is the client.
+func extractClientIP(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	// The server sets the peer address after any client-supplied metadata, so the last value wins.
+	peerIP := ""
+	if peerAddr := md.Get(peerAddrMetadataKey); len(peerAddr) > 0 && peerAddr[len(peerAddr)-1] != "" {
+		peerIP = peerAddr[len(peerAddr)-1]
+		if host, _, err := net.SplitHostPort(peerIP); err == nil {
+			peerIP = host
+		}
+	}
+	if peerIP == "" || !isTrustedProxyIP(peerIP) {
+		return peerIP
+	}
+
+	if forwardedFor := md.Get("x-forwarded-for"); len(forwardedFor) > 0 {
+		hops := strings.Split(strings.Join(forwardedFor, ","), ",")
+		clientIP := peerIP
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if net.ParseIP(hop) == nil {
+				break
+			}
+			clientIP = hop
+			if !isTrustedProxyIP(hop) {
+				break
+			}
+		}
+		return clientIP
+	}
+	if realIP := md.Get("x-real-ip"); len(realIP) > 0 {
+		if ip := strings.TrimSpace(realIP[0]); net.ParseIP(ip) != nil {
+			return ip
+		}
+	}
+	return peerIP
+}
+
+// isTrustedProxyIP reports whether an address belongs to a reverse proxy whose forwarding headers
+// can be trusted.
+func isTrustedProxyIP(value string) bool {
+	ip := net.ParseIP(value)
+	return ip != nil && (ip.IsLoopback() || ip.IsPrivate())
+}
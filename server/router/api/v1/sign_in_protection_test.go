@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestSignInLockoutDuration(t *testing.T) {
+	policy := resolveSignInProtectionPolicy(&storepb.InstanceSignInProtectionSetting{
+		MaxFailuresPerUsername: 3,
+		LockoutSeconds:         60,
+		MaxLockoutSeconds:      300,
+	})
+	kind := store.SignInAttemptKindUsername
+
+	assert.Equal(t, time.Duration(0), policy.lockoutDuration(kind, 2))
+	assert.Equal(t, time.Minute, policy.lockoutDuration(kind, 3))
+	assert.Equal(t, 2*time.Minute, policy.lockoutDuration(kind, 4))
+	assert.Equal(t, 4*time.Minute, policy.lockoutDuration(kind, 5))
+	assert.Equal(t, 5*time.Minute, policy.lockoutDuration(kind, 6))
+	assert.Equal(t, 5*time.Minute, policy.lockoutDuration(kind, 100))
+
+	// The IP threshold falls back to its default.
+	assert.Equal(t, time.Duration(0), policy.lockoutDuration(store.SignInAttemptKindIP, defaultMaxSignInFailuresPerIP-1))
+	assert.Equal(t, time.Minute, policy.lockoutDuration(store.SignInAttemptKindIP, defaultMaxSignInFailuresPerIP))
+}
+
+func TestResolveSignInProtectionPolicyDisabled(t *testing.T) {
+	assert.Nil(t, resolveSignInProtectionPolicy(&storepb.InstanceSignInProtectionSetting{Disabled: true}))
+	assert.NotNil(t, resolveSignInProtectionPolicy(nil))
+}
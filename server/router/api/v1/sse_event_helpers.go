@@ -1,6 +1,12 @@
 package v1
 
-import "github.com/usememos/memos/store"
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/usememos/memos/store"
+)
 
 func buildMemoName(uid string) string {
 	return MemoNamePrefix + uid
@@ -19,6 +25,34 @@ func resolveSSECreatorID(memo *store.Memo, parentMemo *store.Memo) int32 {
 	return memo.CreatorID
 }
 
+// resolveSSEAudienceIDs returns the AudienceIDs used for SSE delivery filtering of
+// PRIVATE and GROUP memo events. Like resolveSSECreatorID, a comment is scoped by its parent memo.
+// Lookup failures are logged and leave the event to the creator and admins.
+func (s *APIV1Service) resolveSSEAudienceIDs(ctx context.Context, memo *store.Memo, parentMemo *store.Memo) []int32 {
+	if parentMemo != nil {
+		memo = parentMemo
+	}
+	if !isRestrictedMemo(memo) {
+		return nil
+	}
+	userIDs, err := s.listMemoAudienceUserIDs(ctx, memo)
+	if err != nil {
+		slog.Warn("failed to resolve SSE audience", "memo", memo.UID, "error", err)
+		return nil
+	}
+	collaborators, err := s.Store.ListMemoCollaborators(ctx, &store.FindMemoCollaborator{MemoID: &memo.ID})
+	if err != nil {
+		slog.Warn("failed to resolve SSE collaborators", "memo", memo.UID, "error", err)
+		return userIDs
+	}
+	for _, collaborator := range collaborators {
+		if !slices.Contains(userIDs, collaborator.UserID) {
+			userIDs = append(userIDs, collaborator.UserID)
+		}
+	}
+	return userIDs
+}
+
 // buildMemoReactionSSEEvent constructs an SSEEvent for a reaction on a memo.
 // Pass parentMemo when the memo is a comment (memo.ParentUID != nil).
 func buildMemoReactionSSEEvent(eventType SSEEventType, contentID string, memo *store.Memo, parentMemo *store.Memo) *SSEEvent {
@@ -17,12 +17,12 @@ const (
 	sseHeartbeatInterval = 30 * time.Second
 )
 
-type sseRouteRegistrar interface {
+type routeRegistrar interface {
 	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
 }
 
 // RegisterSSERoutes registers the SSE endpoint on the given Echo router.
-func RegisterSSERoutes(router sseRouteRegistrar, hub *SSEHub, storeInstance *store.Store, secret string) {
+func RegisterSSERoutes(router routeRegistrar, hub *SSEHub, storeInstance *store.Store, secret string) {
 	authenticator := auth.NewAuthenticator(storeInstance, secret)
 	router.GET("/api/v1/sse", func(c *echo.Context) error {
 		return handleSSE(c, hub, authenticator)
@@ -42,6 +42,10 @@ func handleSSE(c *echo.Context, hub *SSEHub, authenticator *auth.Authenticator)
 	if userID == 0 {
 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "authentication required"})
 	}
+	// The stream carries memo events, so scoped tokens need the memo read scope.
+	if !auth.HasScope(result.Scopes, auth.ScopeMemosRead) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "token is missing required scope"})
+	}
 
 	// Set SSE headers.
 	w := c.Response()
@@ -113,11 +117,19 @@ func getSSEClientIdentity(result *auth.AuthResult) (int32, store.Role) {
 	if result == nil {
 		return 0, store.RoleUser
 	}
-	if result.Claims != nil {
-		return result.Claims.UserID, store.Role(result.Claims.Role)
+	var userID int32
+	var role store.Role
+	switch {
+	case result.Claims != nil:
+		userID, role = result.Claims.UserID, store.Role(result.Claims.Role)
+	case result.User != nil:
+		userID, role = result.User.ID, result.User.Role
+	default:
+		return 0, store.RoleUser
 	}
-	if result.User != nil {
-		return result.User.ID, result.User.Role
+	// Like fetchCurrentUser, a scoped token without the admin scope acts with user privileges.
+	if role != store.RoleUser && !auth.HasScope(result.Scopes, auth.ScopeAdmin) {
+		role = store.RoleUser
 	}
-	return 0, store.RoleUser
+	return userID, role
 }
@@ -3,6 +3,7 @@ package v1
 import (
 	"encoding/json"
 	"log/slog"
+	"slices"
 	"sync"
 
 	"github.com/usememos/memos/store"
@@ -15,6 +16,7 @@ const (
 	SSEEventMemoCreated        SSEEventType = "memo.created"
 	SSEEventMemoUpdated        SSEEventType = "memo.updated"
 	SSEEventMemoDeleted        SSEEventType = "memo.deleted"
+	SSEEventMemoConflict       SSEEventType = "memo.conflict"
 	SSEEventMemoCommentCreated SSEEventType = "memo.comment.created"
 	SSEEventReactionUpserted   SSEEventType = "reaction.upserted"
 	SSEEventReactionDeleted    SSEEventType = "reaction.deleted"
@@ -28,9 +30,14 @@ type SSEEvent struct {
 	Name string `json:"name"`
 	// Parent is the parent memo resource name when the affected resource is a comment.
 	Parent string `json:"parent,omitempty"`
-	// Visibility and CreatorID are used only for server-side delivery filtering.
+	// Etag is the memo's current etag on memo update and conflict events.
+	Etag string `json:"etag,omitempty"`
+	// Visibility, CreatorID and AudienceIDs are used only for server-side delivery filtering.
 	Visibility store.Visibility `json:"-"`
 	CreatorID  int32            `json:"-"`
+	// AudienceIDs are the collaborators of a restricted memo and, for GROUP memos,
+	// the members of its audience groups.
+	AudienceIDs []int32 `json:"-"`
 }
 
 // JSON returns the JSON representation of the event.
@@ -133,8 +140,8 @@ func (h *SSEHub) Broadcast(event *SSEEvent) {
 
 func (c *SSEClient) canReceive(event *SSEEvent) bool {
 	switch event.Visibility {
-	case store.Private:
-		return c.userID == event.CreatorID || c.role == store.RoleAdmin
+	case store.Private, store.Group:
+		return c.userID == event.CreatorID || c.role == store.RoleAdmin || slices.Contains(event.AudienceIDs, c.userID)
 	case store.Public, store.Protected, "":
 		return true
 	default:
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/usememos/memos/internal/profile"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
@@ -312,3 +313,39 @@ func TestSetMemoRelations_EmitsMemoUpdatedSSEEvent(t *testing.T) {
 	assert.Contains(t, payload, memo1.Name)
 	mustNotReceive(t, client.events, 100*time.Millisecond)
 }
+
+func TestUpdateMemo_StaleEtagEmitsMemoConflictSSEEvent(t *testing.T) {
+	ctx := context.Background()
+	svc := newIntegrationService(t)
+
+	user, err := svc.Store.CreateUser(ctx, &store.User{
+		Username: "user", Role: store.RoleAdmin, Email: "user@example.com",
+	})
+	require.NoError(t, err)
+	uctx := userCtx(ctx, user.ID)
+
+	memo, err := svc.CreateMemo(uctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "first", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	updated, err := svc.UpdateMemo(uctx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "second", Etag: memo.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+
+	client := svc.SSEHub.Subscribe(user.ID, store.RoleAdmin)
+	defer svc.SSEHub.Unsubscribe(client)
+
+	_, err = svc.UpdateMemo(uctx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "stale", Etag: memo.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.Error(t, err)
+
+	data := mustReceive(t, client.events, time.Second)
+	payload := string(data)
+	assert.Contains(t, payload, `"memo.conflict"`)
+	assert.Contains(t, payload, `"etag":"`+updated.Etag+`"`)
+	mustNotReceive(t, client.events, 100*time.Millisecond)
+}
@@ -0,0 +1,286 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// enableNotificationEmail configures SMTP delivery and captures sent messages.
+func enableNotificationEmail(ctx context.Context, t *testing.T, ts *TestService) *[]*email.Message {
+	t.Helper()
+	_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_NOTIFICATION,
+		Value: &storepb.InstanceSetting_NotificationSetting{
+			NotificationSetting: &storepb.InstanceNotificationSetting{
+				Email: &storepb.InstanceNotificationSetting_EmailSetting{
+					Enabled:   true,
+					SmtpHost:  "smtp.example.com",
+					SmtpPort:  587,
+					FromEmail: "bot@example.com",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	messages := []*email.Message{}
+	ts.Service.NotificationEmailSender = func(_ *email.Config, message *email.Message) {
+		messages = append(messages, message)
+	}
+	return &messages
+}
+
+// requireEmailVerification turns on the instance option requiring verified emails.
+func requireEmailVerification(ctx context.Context, t *testing.T, ts *TestService) {
+	t.Helper()
+	_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_GENERAL,
+		Value: &storepb.InstanceSetting_GeneralSetting{
+			GeneralSetting: &storepb.InstanceGeneralSetting{
+				RequireEmailVerification: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+// extractEmailToken returns the token query parameter of the link in an email body.
+func extractEmailToken(t *testing.T, message *email.Message) string {
+	t.Helper()
+	for _, line := range strings.Split(message.Body, "\n") {
+		if !strings.HasPrefix(line, "http") {
+			continue
+		}
+		link, err := url.Parse(line)
+		require.NoError(t, err)
+		return link.Query().Get("token")
+	}
+	t.Fatalf("no link in email body: %s", message.Body)
+	return ""
+}
+
+func setUserEmail(ctx context.Context, t *testing.T, ts *TestService, user *store.User, address string) {
+	t.Helper()
+	_, err := ts.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, Email: &address})
+	require.NoError(t, err)
+}
+
+func TestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	messages := enableNotificationEmail(ctx, t, ts)
+	user := createLegacyPasswordUser(ctx, t, ts, "alice", "old-password")
+	setUserEmail(ctx, t, ts, user, "alice@example.com")
+	addTestingSession(ctx, t, ts, user.ID, "session-1", timestamppb.Now().AsTime())
+
+	// Unknown emails succeed without sending anything.
+	_, err := ts.Service.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: "nobody@example.com"})
+	require.NoError(t, err)
+	require.Empty(t, *messages)
+
+	_, err = ts.Service.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: "alice@example.com"})
+	require.NoError(t, err)
+	require.Len(t, *messages, 1)
+	require.Equal(t, []string{"alice@example.com"}, (*messages)[0].To)
+	require.Contains(t, (*messages)[0].Body, "http://localhost:8080/auth/reset-password?token=")
+	token := extractEmailToken(t, (*messages)[0])
+
+	_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: "invalid", NewPassword: "new-password"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: token, NewPassword: "new-password"})
+	require.NoError(t, err)
+
+	// The token cannot be reused once the password changed.
+	_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: token, NewPassword: "another-password"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	sessions, err := ts.Store.GetUserRefreshTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+
+	updatedUser, err := ts.Store.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.True(t, updatedUser.EmailVerified)
+
+	_, err = ts.Service.SignIn(apiv1.WithHeaderCarrier(ctx), &v1pb.SignInRequest{
+		Credentials: &v1pb.SignInRequest_PasswordCredentials_{
+			PasswordCredentials: &v1pb.SignInRequest_PasswordCredentials{Username: "alice", Password: "new-password"},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestAccountEmailRequestsAreThrottled(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	messages := enableNotificationEmail(ctx, t, ts)
+	user := createLegacyPasswordUser(ctx, t, ts, "alice", "password")
+	setUserEmail(ctx, t, ts, user, "alice@example.com")
+	fromIP := func(ip string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("x-peer-addr", ip+":40000"))
+	}
+
+	// Requests for one address are limited across clients and request kinds.
+	for i, ip := range []string{"203.0.113.1", "203.0.113.2", "203.0.113.3"} {
+		_, err := ts.Service.RequestPasswordReset(fromIP(ip), &v1pb.RequestPasswordResetRequest{Email: "alice@example.com"})
+		require.NoError(t, err)
+		require.Len(t, *messages, i+1)
+	}
+	_, err := ts.Service.RequestPasswordReset(fromIP("203.0.113.4"), &v1pb.RequestPasswordResetRequest{Email: "Alice@example.com"})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = ts.Service.RequestEmailVerification(fromIP("203.0.113.4"), &v1pb.RequestEmailVerificationRequest{Email: "alice@example.com"})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Len(t, *messages, 3)
+
+	// Requests from one client are limited across addresses.
+	for i := range 10 {
+		_, err := ts.Service.RequestPasswordReset(fromIP("198.51.100.1"), &v1pb.RequestPasswordResetRequest{Email: fmt.Sprintf("user%d@example.com", i)})
+		require.NoError(t, err)
+	}
+	_, err = ts.Service.RequestPasswordReset(fromIP("198.51.100.1"), &v1pb.RequestPasswordResetRequest{Email: "another@example.com"})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestPasswordResetTokenBoundToEmail(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user := createLegacyPasswordUser(ctx, t, ts, "alice", "old-password")
+	setUserEmail(ctx, t, ts, user, "alice@example.com")
+	user, err := ts.Store.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+
+	token, _, err := auth.GeneratePasswordResetToken(user.ID, auth.Fingerprint(user.PasswordHash+"\x00alice@example.com"), []byte(ts.Secret))
+	require.NoError(t, err)
+
+	// Changing the email invalidates reset links sent to the previous address.
+	setUserEmail(ctx, t, ts, user, "mallory@example.com")
+	_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: token, NewPassword: "new-password"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestEmailVerification(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	messages := enableNotificationEmail(ctx, t, ts)
+	_, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	requireEmailVerification(ctx, t, ts)
+
+	// Registering with an email sends a verification link.
+	created, err := ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User: &v1pb.User{Username: "alice", Email: "alice@example.com", Password: "password123"},
+	})
+	require.NoError(t, err)
+	require.False(t, created.EmailVerified)
+	require.Len(t, *messages, 1)
+	require.Contains(t, (*messages)[0].Body, "http://localhost:8080/auth/verify-email?token=")
+
+	signIn := func() error {
+		_, err := ts.Service.SignIn(apiv1.WithHeaderCarrier(ctx), &v1pb.SignInRequest{
+			Credentials: &v1pb.SignInRequest_PasswordCredentials_{
+				PasswordCredentials: &v1pb.SignInRequest_PasswordCredentials{Username: "alice", Password: "password123"},
+			},
+		})
+		return err
+	}
+	require.Equal(t, codes.FailedPrecondition, status.Code(signIn()))
+
+	// A new link can be requested while signed out.
+	_, err = ts.Service.RequestEmailVerification(ctx, &v1pb.RequestEmailVerificationRequest{Email: "alice@example.com"})
+	require.NoError(t, err)
+	require.Len(t, *messages, 2)
+
+	_, err = ts.Service.VerifyEmail(ctx, &v1pb.VerifyEmailRequest{Token: "invalid"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.VerifyEmail(ctx, &v1pb.VerifyEmailRequest{Token: extractEmailToken(t, (*messages)[1])})
+	require.NoError(t, err)
+	require.NoError(t, signIn())
+
+	// Verified users do not get further verification emails.
+	_, err = ts.Service.RequestEmailVerification(ctx, &v1pb.RequestEmailVerificationRequest{Email: "alice@example.com"})
+	require.NoError(t, err)
+	require.Len(t, *messages, 2)
+}
+
+func TestUpdateUserEmailResetsVerification(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	messages := enableNotificationEmail(ctx, t, ts)
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	setUserEmail(ctx, t, ts, user, "alice@example.com")
+	verified := true
+	_, err = ts.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, EmailVerified: &verified})
+	require.NoError(t, err)
+
+	oldToken, _, err := auth.GenerateEmailVerificationToken(user.ID, auth.Fingerprint("alice@example.com"), []byte(ts.Secret))
+	require.NoError(t, err)
+
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	updated, err := ts.Service.UpdateUser(userCtx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: apiv1.BuildUserName("alice"), Email: "alice@new.example.com"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email"}},
+	})
+	require.NoError(t, err)
+	require.False(t, updated.EmailVerified)
+	// No verification email is sent unless the instance requires it.
+	require.Empty(t, *messages)
+
+	// Tokens for the previous email no longer verify the account.
+	_, err = ts.Service.VerifyEmail(ctx, &v1pb.VerifyEmailRequest{Token: oldToken})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestInboxEmailRequiresVerifiedEmail(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	messages := enableNotificationEmail(ctx, t, ts)
+	requireEmailVerification(ctx, t, ts)
+
+	owner, err := ts.CreateRegularUser(ctx, "owner")
+	require.NoError(t, err)
+	setUserEmail(ctx, t, ts, owner, "owner@example.com")
+	commenter, err := ts.CreateRegularUser(ctx, "commenter")
+	require.NoError(t, err)
+
+	memo, err := ts.Service.CreateMemo(ts.CreateUserContext(ctx, owner.ID), &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Base memo", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(ts.CreateUserContext(ctx, commenter.ID), &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "Comment", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	require.Empty(t, *messages)
+}
@@ -0,0 +1,161 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+)
+
+func TestAuditLogRecording(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	user := createLegacyPasswordUser(ctx, t, ts, "alice", "password123")
+
+	require.NoError(t, signInWithPassword(ctx, ts, "203.0.113.9", user.Username, "password123"))
+
+	_, err = ts.Service.UpdateInstanceSetting(adminCtx, &v1pb.UpdateInstanceSettingRequest{
+		Setting: &v1pb.InstanceSetting{
+			Name: "instance/settings/GENERAL",
+			Value: &v1pb.InstanceSetting_GeneralSetting_{
+				GeneralSetting: &v1pb.InstanceSetting_GeneralSetting{DisallowUserRegistration: true},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreatePersonalAccessToken(adminCtx, &v1pb.CreatePersonalAccessTokenRequest{
+		Parent:      "users/admin",
+		Description: "ci",
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.DeleteUser(adminCtx, &v1pb.DeleteUserRequest{Name: "users/alice"})
+	require.NoError(t, err)
+
+	resp, err := ts.Service.ListAuditLogs(adminCtx, &v1pb.ListAuditLogsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.AuditLogs, 4)
+	// Newest first.
+	require.Equal(t, v1pb.AuditLog_DELETE_USER, resp.AuditLogs[0].Action)
+	require.Equal(t, "users/admin", resp.AuditLogs[0].Actor)
+	require.Equal(t, "users/alice", resp.AuditLogs[0].Resource)
+	require.Equal(t, v1pb.AuditLog_CREATE_PERSONAL_ACCESS_TOKEN, resp.AuditLogs[1].Action)
+	require.Equal(t, v1pb.AuditLog_UPDATE_INSTANCE_SETTING, resp.AuditLogs[2].Action)
+	require.Equal(t, "instance/settings/GENERAL", resp.AuditLogs[2].Resource)
+	signIn := resp.AuditLogs[3]
+	require.Equal(t, v1pb.AuditLog_SIGN_IN, signIn.Action)
+	require.Equal(t, "203.0.113.9", signIn.IpAddress)
+	require.Equal(t, "password", signIn.Detail)
+	// The actor was deleted, so it can no longer be resolved.
+	require.Empty(t, signIn.Actor)
+
+	// Filter by action.
+	resp, err = ts.Service.ListAuditLogs(adminCtx, &v1pb.ListAuditLogsRequest{Action: v1pb.AuditLog_UPDATE_INSTANCE_SETTING})
+	require.NoError(t, err)
+	require.Len(t, resp.AuditLogs, 1)
+
+	// Filter by actor.
+	resp, err = ts.Service.ListAuditLogs(adminCtx, &v1pb.ListAuditLogsRequest{Actor: "users/admin"})
+	require.NoError(t, err)
+	require.Len(t, resp.AuditLogs, 3)
+
+	// Pagination.
+	resp, err = ts.Service.ListAuditLogs(adminCtx, &v1pb.ListAuditLogsRequest{PageSize: 3})
+	require.NoError(t, err)
+	require.Len(t, resp.AuditLogs, 3)
+	require.NotEmpty(t, resp.NextPageToken)
+	resp, err = ts.Service.ListAuditLogs(adminCtx, &v1pb.ListAuditLogsRequest{PageToken: resp.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, resp.AuditLogs, 1)
+	require.Empty(t, resp.NextPageToken)
+}
+
+func TestListAuditLogsRequiresAdmin(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+
+	_, err = ts.Service.ListAuditLogs(ts.CreateUserContext(ctx, user.ID), &v1pb.ListAuditLogsRequest{})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestExportAuditLogs(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	for i := 0; i < 3; i++ {
+		_, err = ts.Service.CreatePersonalAccessToken(adminCtx, &v1pb.CreatePersonalAccessTokenRequest{Parent: "users/admin"})
+		require.NoError(t, err)
+	}
+
+	e := echo.New()
+	ts.Service.RegisterAuditLogRoutes(e)
+	export := func(user int32, username, role, query string) *httptest.ResponseRecorder {
+		token, _, err := auth.GenerateAccessTokenV2(user, username, role, "NORMAL", []byte(ts.Secret))
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/auditLogs/export"+query, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("no token returns 401", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/auditLogs/export", nil))
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("non-admin returns 403", func(t *testing.T) {
+		rec := export(user.ID, user.Username, string(user.Role), "")
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("invalid action returns 400", func(t *testing.T) {
+		rec := export(admin.ID, admin.Username, string(admin.Role), "?action=NOPE")
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("admin receives JSON Lines", func(t *testing.T) {
+		rec := export(admin.ID, admin.Username, string(admin.Role), "?actor=users/admin&action=CREATE_PERSONAL_ACCESS_TOKEN")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+
+		scanner := bufio.NewScanner(rec.Body)
+		lines := 0
+		for scanner.Scan() {
+			auditLog := &v1pb.AuditLog{}
+			require.NoError(t, protojson.Unmarshal(scanner.Bytes(), auditLog))
+			require.Equal(t, v1pb.AuditLog_CREATE_PERSONAL_ACCESS_TOKEN, auditLog.Action)
+			require.Equal(t, "users/admin", auditLog.Actor)
+			lines++
+		}
+		require.Equal(t, 3, lines)
+	})
+}
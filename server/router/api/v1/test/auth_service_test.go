@@ -208,6 +208,43 @@ func TestCreateLinkedIdentityRejectsSecondIdentityForSameProvider(t *testing.T)
 	require.Equal(t, codes.AlreadyExists, status.Code(err))
 }
 
+func TestPasswordSignInSettingsApplyToCustomRoles(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name    string
+		setting *storepb.InstanceGeneralSetting
+		code    codes.Code
+	}{
+		{name: "disallow password auth", setting: &storepb.InstanceGeneralSetting{DisallowPasswordAuth: true}, code: codes.PermissionDenied},
+		{name: "require email verification", setting: &storepb.InstanceGeneralSetting{RequireEmailVerification: true}, code: codes.FailedPrecondition},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := NewTestService(t)
+			defer ts.Cleanup()
+
+			admin, err := ts.CreateHostUser(ctx, "admin")
+			require.NoError(t, err)
+			adminCtx := ts.CreateUserContext(ctx, admin.ID)
+			user := createLegacyPasswordUser(ctx, t, ts, "alice", "password123")
+			createTestRole(adminCtx, t, ts, "editor")
+			assignTestRole(adminCtx, t, ts, user, "roles/editor")
+			_, err = ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+				Key:   storepb.InstanceSettingKey_GENERAL,
+				Value: &storepb.InstanceSetting_GeneralSetting{GeneralSetting: tc.setting},
+			})
+			require.NoError(t, err)
+
+			// Only admins are exempt, not users with a custom role.
+			_, err = ts.Service.SignIn(apiv1.WithHeaderCarrier(ctx), &v1pb.SignInRequest{
+				Credentials: &v1pb.SignInRequest_PasswordCredentials_{
+					PasswordCredentials: &v1pb.SignInRequest_PasswordCredentials{Username: user.Username, Password: "password123"},
+				},
+			})
+			require.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
+
 func createTestingOAuthIdentityProvider(ctx context.Context, t *testing.T, ts *TestService, serverURL, uid string) string {
 	t.Helper()
 
@@ -0,0 +1,177 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestCollectionShareManagement(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	shortcut, err := ts.Service.CreateShortcut(aliceCtx, &v1pb.CreateShortcutRequest{
+		Parent:   "users/alice",
+		Shortcut: &v1pb.Shortcut{Title: "Work", Filter: `tag in ["work"]`},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreateCollectionShare(bobCtx, &v1pb.CreateCollectionShareRequest{Parent: "users/alice", CollectionShare: &v1pb.CollectionShare{Tag: "work"}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.CreateCollectionShare(bobCtx, &v1pb.CreateCollectionShareRequest{Parent: "users/bob", CollectionShare: &v1pb.CollectionShare{Shortcut: shortcut.Name}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.CreateCollectionShare(aliceCtx, &v1pb.CreateCollectionShareRequest{Parent: "users/alice", CollectionShare: &v1pb.CollectionShare{}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.CreateCollectionShare(aliceCtx, &v1pb.CreateCollectionShareRequest{Parent: "users/alice", CollectionShare: &v1pb.CollectionShare{Shortcut: shortcut.Name, Tag: "work"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.CreateCollectionShare(aliceCtx, &v1pb.CreateCollectionShareRequest{Parent: "users/alice", CollectionShare: &v1pb.CollectionShare{Shortcut: "users/alice/shortcuts/missing"}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = ts.Service.CreateCollectionShare(aliceCtx, &v1pb.CreateCollectionShareRequest{Parent: "users/alice", CollectionShare: &v1pb.CollectionShare{Tag: "work", ExpireTime: timestamppb.New(time.Now().Add(-time.Hour))}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	tagShare, err := ts.Service.CreateCollectionShare(aliceCtx, &v1pb.CreateCollectionShareRequest{Parent: "users/alice", CollectionShare: &v1pb.CollectionShare{Tag: "#work"}})
+	require.NoError(t, err)
+	require.Regexp(t, `^users/alice/collectionShares/.+$`, tagShare.Name)
+	require.Equal(t, "work", tagShare.Tag)
+	shortcutShare, err := ts.Service.CreateCollectionShare(aliceCtx, &v1pb.CreateCollectionShareRequest{Parent: "users/alice", CollectionShare: &v1pb.CollectionShare{Shortcut: shortcut.Name}})
+	require.NoError(t, err)
+	require.Equal(t, shortcut.Name, shortcutShare.Shortcut)
+
+	shares, err := ts.Service.ListCollectionShares(aliceCtx, &v1pb.ListCollectionSharesRequest{Parent: "users/alice"})
+	require.NoError(t, err)
+	require.Len(t, shares.CollectionShares, 2)
+	_, err = ts.Service.ListCollectionShares(bobCtx, &v1pb.ListCollectionSharesRequest{Parent: "users/alice"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Creating a collection share is recorded in the audit log.
+	action := store.AuditActionCreateCollectionShare
+	auditLogs, err := ts.Store.ListAuditLogs(ctx, &store.FindAuditLog{Action: &action})
+	require.NoError(t, err)
+	require.Len(t, auditLogs, 2)
+
+	_, err = ts.Service.DeleteCollectionShare(bobCtx, &v1pb.DeleteCollectionShareRequest{Name: tagShare.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DeleteCollectionShare(aliceCtx, &v1pb.DeleteCollectionShareRequest{Name: tagShare.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteCollectionShare(aliceCtx, &v1pb.DeleteCollectionShareRequest{Name: tagShare.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListMemosByShare(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	privateWork, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "private #work", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	publicWork, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "public #work", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "private #home", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(bobCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "bob #work", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+
+	shareToken := func(share *v1pb.CollectionShare) string {
+		return share.Name[strings.LastIndex(share.Name, "/")+1:]
+	}
+
+	// A tag share lists the creator's memos carrying the tag, whatever their visibility.
+	tagShare, err := ts.Service.CreateCollectionShare(aliceCtx, &v1pb.CreateCollectionShareRequest{Parent: "users/alice", CollectionShare: &v1pb.CollectionShare{Tag: "work"}})
+	require.NoError(t, err)
+	resp, err := ts.Service.ListMemosByShare(ctx, &v1pb.ListMemosByShareRequest{ShareId: shareToken(tagShare)})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 2)
+	require.ElementsMatch(t, []string{privateWork.Name, publicWork.Name}, []string{resp.Memos[0].Name, resp.Memos[1].Name})
+
+	resp, err = ts.Service.ListMemosByShare(ctx, &v1pb.ListMemosByShareRequest{ShareId: shareToken(tagShare), PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 1)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	// A shortcut share follows the shortcut's filter, and stops working once the shortcut is deleted.
+	shortcut, err := ts.Service.CreateShortcut(aliceCtx, &v1pb.CreateShortcutRequest{
+		Parent:   "users/alice",
+		Shortcut: &v1pb.Shortcut{Title: "Public", Filter: `visibility == "PUBLIC"`},
+	})
+	require.NoError(t, err)
+	shortcutShare, err := ts.Service.CreateCollectionShare(aliceCtx, &v1pb.CreateCollectionShareRequest{Parent: "users/alice", CollectionShare: &v1pb.CollectionShare{Shortcut: shortcut.Name}})
+	require.NoError(t, err)
+	resp, err = ts.Service.ListMemosByShare(ctx, &v1pb.ListMemosByShareRequest{ShareId: shareToken(shortcutShare)})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 1)
+	require.Equal(t, publicWork.Name, resp.Memos[0].Name)
+	_, err = ts.Service.DeleteShortcut(aliceCtx, &v1pb.DeleteShortcutRequest{Name: shortcut.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.ListMemosByShare(ctx, &v1pb.ListMemosByShareRequest{ShareId: shareToken(shortcutShare)})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Unknown, expired and revoked tokens are all reported as not found.
+	_, err = ts.Service.ListMemosByShare(ctx, &v1pb.ListMemosByShareRequest{ShareId: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	expiredTs := time.Now().Add(-time.Hour).Unix()
+	expired, err := ts.Store.CreateCollectionShare(ctx, &store.CollectionShare{UID: "expired-share", CreatorID: alice.ID, Tag: "work", ExpiresTs: &expiredTs})
+	require.NoError(t, err)
+	_, err = ts.Service.ListMemosByShare(ctx, &v1pb.ListMemosByShareRequest{ShareId: expired.UID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = ts.Service.DeleteCollectionShare(aliceCtx, &v1pb.DeleteCollectionShareRequest{Name: tagShare.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.ListMemosByShare(ctx, &v1pb.ListMemosByShareRequest{ShareId: shareToken(tagShare)})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListMemosByShareHidesScheduledMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+
+	published, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "published #work", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	publishTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	scheduled, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "scheduled #work", Visibility: v1pb.Visibility_PUBLIC, PublishTime: timestamppb.New(publishTime)},
+	})
+	require.NoError(t, err)
+	share, err := ts.Service.CreateCollectionShare(aliceCtx, &v1pb.CreateCollectionShareRequest{Parent: "users/alice", CollectionShare: &v1pb.CollectionShare{Tag: "work"}})
+	require.NoError(t, err)
+	shareID := share.Name[strings.LastIndex(share.Name, "/")+1:]
+
+	// A scheduled memo is only shared once it is published.
+	resp, err := ts.Service.ListMemosByShare(ctx, &v1pb.ListMemosByShareRequest{ShareId: shareID})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 1)
+	require.Equal(t, published.Name, resp.Memos[0].Name)
+
+	require.NoError(t, ts.Service.PublishScheduledMemos(ctx, publishTime))
+	resp, err = ts.Service.ListMemosByShare(ctx, &v1pb.ListMemosByShareRequest{ShareId: shareID})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 2)
+	require.ElementsMatch(t, []string{published.Name, scheduled.Name}, []string{resp.Memos[0].Name, resp.Memos[1].Name})
+}
@@ -0,0 +1,172 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func updateInstanceWebhooks(ctx context.Context, ts *TestService, webhooks ...*v1pb.InstanceSetting_Webhook) (*v1pb.InstanceSetting, error) {
+	return ts.Service.UpdateInstanceSetting(ctx, &v1pb.UpdateInstanceSettingRequest{
+		Setting: &v1pb.InstanceSetting{
+			Name: "instance/settings/WEBHOOKS",
+			Value: &v1pb.InstanceSetting_WebhooksSetting_{
+				WebhooksSetting: &v1pb.InstanceSetting_WebhooksSetting{Webhooks: webhooks},
+			},
+		},
+	})
+}
+
+func listInstanceWebhookActivityTypes(ctx context.Context, t *testing.T, ts *TestService) []string {
+	t.Helper()
+	creatorID := int32(0)
+	deliveries, err := ts.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{CreatorID: &creatorID})
+	require.NoError(t, err)
+	activityTypes := []string{}
+	for _, delivery := range deliveries {
+		activityTypes = append(activityTypes, delivery.ActivityType)
+	}
+	return activityTypes
+}
+
+func TestInstanceWebhooks(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	endpoint := &fakeWebhookEndpoint{statusCode: http.StatusOK}
+	ts.Service.WebhookSender = endpoint.send
+
+	admin, err := ts.CreateHostUser(ctx, "webhook-admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	user, err := ts.CreateRegularUser(ctx, "webhook-member")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	setting, err := updateInstanceWebhooks(adminCtx, ts, &v1pb.InstanceSetting_Webhook{
+		Title: "Moderation",
+		Url:   "https://93.184.215.14/moderation",
+	})
+	require.NoError(t, err)
+	hook := setting.GetWebhooksSetting().Webhooks[0]
+	require.NotEmpty(t, hook.Id)
+	require.NotEmpty(t, hook.Secret)
+
+	// Only settings managers can read the webhooks and their secrets.
+	_, err = ts.Service.GetInstanceSetting(userCtx, &v1pb.GetInstanceSettingRequest{Name: "instance/settings/WEBHOOKS"})
+	require.Error(t, err)
+
+	// Memo events are delivered for public and protected memos only.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Hello everyone", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Note to self", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{webhook.EventMemoCreated}, listInstanceWebhookActivityTypes(ctx, t, ts))
+
+	// User registrations and deletions are delivered too.
+	created, err := ts.Service.CreateUser(adminCtx, &v1pb.CreateUserRequest{
+		User: &v1pb.User{Username: "newcomer", Password: "password", Role: v1pb.User_USER},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteUser(adminCtx, &v1pb.DeleteUserRequest{Name: created.Name})
+	require.NoError(t, err)
+	require.Equal(t,
+		[]string{webhook.EventUserDeleted, webhook.EventUserCreated, webhook.EventMemoCreated},
+		listInstanceWebhookActivityTypes(ctx, t, ts))
+
+	// Updating keeps the secret unless it is rotated.
+	setting, err = updateInstanceWebhooks(adminCtx, ts, &v1pb.InstanceSetting_Webhook{
+		Id:         hook.Id,
+		Title:      "Moderation",
+		Url:        "https://93.184.215.14/moderation",
+		EventTypes: []string{webhook.EventUserCreated},
+	})
+	require.NoError(t, err)
+	require.Equal(t, hook.Secret, setting.GetWebhooksSetting().Webhooks[0].Secret)
+	setting, err = updateInstanceWebhooks(adminCtx, ts, &v1pb.InstanceSetting_Webhook{
+		Id:           hook.Id,
+		Url:          "https://93.184.215.14/moderation",
+		RotateSecret: true,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, hook.Secret, setting.GetWebhooksSetting().Webhooks[0].Secret)
+}
+
+func TestInstanceWebhooksSkipScheduledMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	endpoint := &fakeWebhookEndpoint{statusCode: http.StatusOK}
+	ts.Service.WebhookSender = endpoint.send
+
+	admin, err := ts.CreateHostUser(ctx, "webhook-admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	user, err := ts.CreateRegularUser(ctx, "webhook-member")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	_, err = updateInstanceWebhooks(adminCtx, ts, &v1pb.InstanceSetting_Webhook{
+		Title: "Moderation",
+		Url:   "https://93.184.215.14/moderation",
+	})
+	require.NoError(t, err)
+
+	// Scheduled memos stay private until they are published.
+	publishTime := time.Now().Add(time.Hour)
+	scheduled, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Coming soon", Visibility: v1pb.Visibility_PUBLIC, PublishTime: timestamppb.New(publishTime)},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: scheduled.Name, Content: "Coming very soon"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, listInstanceWebhookActivityTypes(ctx, t, ts))
+	require.NoError(t, ts.Service.PublishScheduledMemos(ctx, publishTime.Add(time.Minute)))
+	require.Equal(t, []string{webhook.EventMemoCreated}, listInstanceWebhookActivityTypes(ctx, t, ts))
+}
+
+func TestInstanceWebhooksValidation(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "webhook-admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	user, err := ts.CreateRegularUser(ctx, "webhook-member")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = updateInstanceWebhooks(userCtx, ts, &v1pb.InstanceSetting_Webhook{Url: "https://93.184.215.14/hook"})
+	require.Error(t, err)
+
+	// Reminders are personal, so they are not part of the instance catalog.
+	_, err = updateInstanceWebhooks(adminCtx, ts, &v1pb.InstanceSetting_Webhook{
+		Url:        "https://93.184.215.14/hook",
+		EventTypes: []string{webhook.EventMemoReminder},
+	})
+	require.ErrorContains(t, err, "unsupported event type")
+
+	_, err = updateInstanceWebhooks(adminCtx, ts, &v1pb.InstanceSetting_Webhook{
+		Id:  "unknown",
+		Url: "https://93.184.215.14/hook",
+	})
+	require.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,228 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func disableUserRegistration(ctx context.Context, t *testing.T, ts *TestService) {
+	t.Helper()
+	_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_GENERAL,
+		Value: &storepb.InstanceSetting_GeneralSetting{
+			GeneralSetting: &storepb.InstanceGeneralSetting{
+				DisallowUserRegistration: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestInvitationServicePermissions(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	_, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Service.CreateInvitation(userCtx, &v1pb.CreateInvitationRequest{Invitation: &v1pb.Invitation{}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.ListInvitations(userCtx, &v1pb.ListInvitationsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.ListInvitations(ctx, &v1pb.ListInvitationsRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestCreateUserWithInvitation(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	disableUserRegistration(ctx, t, ts)
+
+	invitation, err := ts.Service.CreateInvitation(adminCtx, &v1pb.CreateInvitationRequest{
+		Invitation: &v1pb.Invitation{
+			Role:    v1pb.User_ADMIN,
+			MaxUses: 2,
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, invitation.Code)
+	require.Equal(t, "users/admin", invitation.Creator)
+	require.Equal(t, int32(2), invitation.MaxUses)
+
+	// Registration without a code is still closed.
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User: &v1pb.User{Username: "alice", Password: "password123"},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User:           &v1pb.User{Username: "alice", Password: "password123"},
+		InvitationCode: "wrong",
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Validation does not consume the invitation.
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User:           &v1pb.User{Username: "alice", Password: "password123"},
+		InvitationCode: invitation.Code,
+		ValidateOnly:   true,
+	})
+	require.NoError(t, err)
+
+	// A registration that fails to create the user does not use up the invitation.
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User:           &v1pb.User{Username: "admin", Password: "password123"},
+		InvitationCode: invitation.Code,
+	})
+	require.Error(t, err)
+
+	for _, username := range []string{"alice", "bob"} {
+		user, err := ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+			User:           &v1pb.User{Username: username, Password: "password123"},
+			InvitationCode: invitation.Code,
+		})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.User_ADMIN, user.Role)
+	}
+
+	// The invitation is exhausted after its maximum number of uses.
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User:           &v1pb.User{Username: "carol", Password: "password123"},
+		InvitationCode: invitation.Code,
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	response, err := ts.Service.ListInvitations(adminCtx, &v1pb.ListInvitationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Invitations, 1)
+	require.Equal(t, int32(2), response.Invitations[0].UseCount)
+}
+
+func TestCreateUserWithRestrictedInvitation(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	disableUserRegistration(ctx, t, ts)
+
+	invitation, err := ts.Service.CreateInvitation(adminCtx, &v1pb.CreateInvitationRequest{
+		Invitation: &v1pb.Invitation{Email: "alice@example.com"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.User_USER, invitation.Role)
+	require.Equal(t, int32(1), invitation.MaxUses)
+
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User:           &v1pb.User{Username: "mallory", Email: "mallory@example.com", Password: "password123"},
+		InvitationCode: invitation.Code,
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	user, err := ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User:           &v1pb.User{Username: "alice", Email: "Alice@Example.com", Password: "password123"},
+		InvitationCode: invitation.Code,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.User_USER, user.Role)
+
+	// Expired invitations are rejected.
+	expiresTs := time.Now().Add(-time.Minute).Unix()
+	_, err = ts.Store.CreateInvitation(ctx, &store.Invitation{
+		UID:       "expired",
+		Code:      "expired-code",
+		CreatorID: admin.ID,
+		Role:      store.RoleUser,
+		MaxUses:   1,
+		ExpiresTs: &expiresTs,
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User:           &v1pb.User{Username: "bob", Password: "password123"},
+		InvitationCode: "expired-code",
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestRevokeInvitation(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	disableUserRegistration(ctx, t, ts)
+
+	invitation, err := ts.Service.CreateInvitation(adminCtx, &v1pb.CreateInvitationRequest{
+		Invitation: &v1pb.Invitation{ExpireTime: timestamppb.New(time.Now().Add(time.Hour))},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, invitation.ExpireTime)
+
+	_, err = ts.Service.RevokeInvitation(adminCtx, &v1pb.RevokeInvitationRequest{Name: invitation.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.RevokeInvitation(adminCtx, &v1pb.RevokeInvitationRequest{Name: invitation.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User:           &v1pb.User{Username: "alice", Password: "password123"},
+		InvitationCode: invitation.Code,
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestCreateInvitationSendsEmail(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+
+	// Sending fails while email delivery is not configured.
+	_, err = ts.Service.CreateInvitation(adminCtx, &v1pb.CreateInvitationRequest{
+		Invitation: &v1pb.Invitation{Email: "alice@example.com"},
+		SendEmail:  true,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	messages := enableNotificationEmail(ctx, t, ts)
+	invitation, err := ts.Service.CreateInvitation(adminCtx, &v1pb.CreateInvitationRequest{
+		Invitation: &v1pb.Invitation{Email: "alice@example.com"},
+		SendEmail:  true,
+	})
+	require.NoError(t, err)
+	require.Len(t, *messages, 1)
+	require.Equal(t, []string{"alice@example.com"}, (*messages)[0].To)
+	require.Contains(t, (*messages)[0].Body, "http://localhost:8080/auth/signup?invitation="+invitation.Code)
+
+	// An email address is required to send the invitation.
+	_, err = ts.Service.CreateInvitation(adminCtx, &v1pb.CreateInvitationRequest{
+		Invitation: &v1pb.Invitation{},
+		SendEmail:  true,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
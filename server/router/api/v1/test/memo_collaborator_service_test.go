@@ -0,0 +1,127 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoCollaboratorManagement(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+	carol, err := ts.CreateRegularUser(ctx, "carol")
+	require.NoError(t, err)
+	carolCtx := ts.CreateUserContext(ctx, carol.ID)
+
+	memo, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "draft", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+
+	_, err = ts.Service.AddMemoCollaborator(bobCtx, &v1pb.AddMemoCollaboratorRequest{Parent: memo.Name, User: "users/carol", Role: v1pb.MemoCollaborator_VIEWER})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.AddMemoCollaborator(aliceCtx, &v1pb.AddMemoCollaboratorRequest{Parent: memo.Name, User: "users/alice", Role: v1pb.MemoCollaborator_VIEWER})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.AddMemoCollaborator(aliceCtx, &v1pb.AddMemoCollaboratorRequest{Parent: memo.Name, User: "users/bob"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.AddMemoCollaborator(aliceCtx, &v1pb.AddMemoCollaboratorRequest{Parent: memo.Name, User: "users/missing", Role: v1pb.MemoCollaborator_VIEWER})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	collaborator, err := ts.Service.AddMemoCollaborator(aliceCtx, &v1pb.AddMemoCollaboratorRequest{Parent: memo.Name, User: "users/bob", Role: v1pb.MemoCollaborator_VIEWER})
+	require.NoError(t, err)
+	require.Equal(t, memo.Name+"/collaborators/bob", collaborator.Name)
+	require.Equal(t, "users/bob", collaborator.User)
+	require.Equal(t, v1pb.MemoCollaborator_VIEWER, collaborator.Role)
+
+	// The new collaborator is notified.
+	notifications, err := ts.Service.ListUserNotifications(bobCtx, &v1pb.ListUserNotificationsRequest{Parent: "users/bob"})
+	require.NoError(t, err)
+	require.Len(t, notifications.Notifications, 1)
+	require.Equal(t, v1pb.UserNotification_MEMO_COLLABORATOR, notifications.Notifications[0].Type)
+	require.Equal(t, memo.Name, notifications.Notifications[0].GetMemoCollaborator().Memo)
+
+	collaborators, err := ts.Service.ListMemoCollaborators(bobCtx, &v1pb.ListMemoCollaboratorsRequest{Parent: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, collaborators.Collaborators, 1)
+	_, err = ts.Service.ListMemoCollaborators(carolCtx, &v1pb.ListMemoCollaboratorsRequest{Parent: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Only the creator can remove other collaborators; collaborators can leave.
+	_, err = ts.Service.RemoveMemoCollaborator(carolCtx, &v1pb.RemoveMemoCollaboratorRequest{Name: memo.Name + "/collaborators/bob"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.RemoveMemoCollaborator(bobCtx, &v1pb.RemoveMemoCollaboratorRequest{Name: memo.Name + "/collaborators/bob"})
+	require.NoError(t, err)
+	_, err = ts.Service.RemoveMemoCollaborator(aliceCtx, &v1pb.RemoveMemoCollaboratorRequest{Name: memo.Name + "/collaborators/bob"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestMemoCollaboratorAccess(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	memo, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "draft", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+
+	_, err = ts.Service.GetMemo(bobCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.AddMemoCollaborator(aliceCtx, &v1pb.AddMemoCollaboratorRequest{Parent: memo.Name, User: "users/bob", Role: v1pb.MemoCollaborator_VIEWER})
+	require.NoError(t, err)
+
+	// Viewers can read the memo and see it in their list, but not edit it.
+	_, err = ts.Service.GetMemo(bobCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	memos, err := ts.Service.ListMemos(bobCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	require.Equal(t, memo.Name, memos.Memos[0].Name)
+	_, err = ts.Service.UpdateMemo(bobCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "edited"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Editors can change the content, but not the visibility.
+	_, err = ts.Service.AddMemoCollaborator(aliceCtx, &v1pb.AddMemoCollaboratorRequest{Parent: memo.Name, User: "users/bob", Role: v1pb.MemoCollaborator_EDITOR})
+	require.NoError(t, err)
+	updated, err := ts.Service.UpdateMemo(bobCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "edited"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "edited", updated.Content)
+	_, err = ts.Service.UpdateMemo(bobCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Visibility: v1pb.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Removing the collaborator revokes access.
+	_, err = ts.Service.RemoveMemoCollaborator(aliceCtx, &v1pb.RemoveMemoCollaboratorRequest{Name: memo.Name + "/collaborators/bob"})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(bobCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	memos, err = ts.Service.ListMemos(bobCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, memos.Memos)
+}
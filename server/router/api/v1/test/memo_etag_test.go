@@ -0,0 +1,155 @@
+package test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestUpdateMemoWithEtag(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "first", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	require.NotEmpty(t, memo.Etag)
+
+	updated, err := ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "second", Etag: memo.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, memo.Etag, updated.Etag)
+
+	// A write based on the old etag is rejected and leaves the memo unchanged.
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "stale", Etag: memo.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "invalid", Etag: "not-an-etag"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	current, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "second", current.Content)
+	require.Equal(t, updated.Etag, current.Etag)
+
+	// Updates without an etag are unconditional.
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Pinned: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name, Etag: updated.Etag})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	current, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name, Etag: current.Etag})
+	require.NoError(t, err)
+}
+
+func TestStaleMemoWritesChangeNothing(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "kept.txt", Content: []byte("kept"), Type: "text/plain"},
+	})
+	require.NoError(t, err)
+	related, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "related", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "first", Visibility: v1pb.Visibility_PRIVATE, Attachments: []*v1pb.Attachment{attachment}},
+	})
+	require.NoError(t, err)
+	comment, err := ts.Service.CreateMemoComment(userCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "comment", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	current, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	staleEtag := current.Etag
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "second"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+
+	// A stale update neither unlinks the attachments nor replaces the relations.
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:        memo.Name,
+			Etag:        staleEtag,
+			Attachments: []*v1pb.Attachment{},
+			Relations: []*v1pb.MemoRelation{{
+				RelatedMemo: &v1pb.MemoRelation_Memo{Name: related.Name},
+				Type:        v1pb.MemoRelation_REFERENCE,
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"attachments", "relations"}},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	current, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, current.Attachments, 1)
+	for _, relation := range current.Relations {
+		require.NotEqual(t, v1pb.MemoRelation_REFERENCE, relation.Type)
+	}
+	_, err = ts.Service.GetAttachment(userCtx, &v1pb.GetAttachmentRequest{Name: attachment.Name})
+	require.NoError(t, err)
+
+	// A stale delete keeps the comments.
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name, Etag: staleEtag})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: comment.Name})
+	require.NoError(t, err)
+
+	// A current update applies everything at once.
+	updated, err := ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:        memo.Name,
+			Etag:        current.Etag,
+			Attachments: []*v1pb.Attachment{},
+			Relations: []*v1pb.MemoRelation{{
+				RelatedMemo: &v1pb.MemoRelation_Memo{Name: related.Name},
+				Type:        v1pb.MemoRelation_REFERENCE,
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"attachments", "relations"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, updated.Attachments)
+	require.True(t, slices.ContainsFunc(updated.Relations, func(relation *v1pb.MemoRelation) bool {
+		return relation.Type == v1pb.MemoRelation_REFERENCE && relation.RelatedMemo.Name == related.Name
+	}))
+	require.NotEqual(t, current.Etag, updated.Etag)
+	_, err = ts.Service.GetAttachment(userCtx, &v1pb.GetAttachmentRequest{Name: attachment.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name, Etag: updated.Etag})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: comment.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,150 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestScheduledMemoPublishing(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "scheduled-author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	reader, err := ts.CreateRegularUser(ctx, "scheduled-reader")
+	require.NoError(t, err)
+	readerCtx := ts.CreateUserContext(ctx, reader.ID)
+
+	publishTime := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:     "Launch announcement",
+			Visibility:  v1pb.Visibility_PUBLIC,
+			PublishTime: timestamppb.New(publishTime),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, publishTime, memo.PublishTime.AsTime())
+	require.Equal(t, v1pb.Visibility_PUBLIC, memo.Visibility)
+
+	memoUID := strings.TrimPrefix(memo.Name, "memos/")
+	stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+	require.Equal(t, store.Private, stored.Visibility)
+
+	// Until published, only the creator can see the memo.
+	_, err = ts.Service.GetMemo(readerCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Error(t, err)
+	_, err = ts.Service.GetMemo(authorCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+
+	// Changing the visibility of a scheduled memo changes the scheduled visibility.
+	memo, err = ts.Service.UpdateMemo(authorCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Visibility: v1pb.Visibility_PROTECTED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PROTECTED, memo.Visibility)
+	_, err = ts.Service.GetMemo(readerCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Error(t, err)
+
+	// Publishing before the publish time does nothing.
+	require.NoError(t, ts.Service.PublishScheduledMemos(ctx, publishTime.Add(-time.Minute)))
+	_, err = ts.Service.GetMemo(readerCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Error(t, err)
+
+	require.NoError(t, ts.Service.PublishScheduledMemos(ctx, publishTime))
+	published, err := ts.Service.GetMemo(readerCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PROTECTED, published.Visibility)
+	require.Nil(t, published.PublishTime)
+	// Timestamps are kept unless configured otherwise.
+	require.Equal(t, memo.CreateTime.AsTime(), published.CreateTime.AsTime())
+}
+
+func TestScheduledMemoPublishingBumpsTime(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_MEMO_RELATED,
+		Value: &storepb.InstanceSetting_MemoRelatedSetting{
+			MemoRelatedSetting: &storepb.InstanceMemoRelatedSetting{
+				ContentLengthLimit: 8 * 1024,
+				BumpTimeOnPublish:  true,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	author, err := ts.CreateRegularUser(ctx, "bump-author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+
+	publishTime := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC()
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:     "Tomorrow's news",
+			Visibility:  v1pb.Visibility_PUBLIC,
+			PublishTime: timestamppb.New(publishTime),
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ts.Service.PublishScheduledMemos(ctx, publishTime))
+	published, err := ts.Service.GetMemo(ctx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PUBLIC, published.Visibility)
+	require.Equal(t, publishTime, published.CreateTime.AsTime())
+	require.Equal(t, publishTime, published.UpdateTime.AsTime())
+}
+
+func TestScheduledMemoValidation(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "schedule-validator")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+
+	_, err = ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:     "Too late",
+			Visibility:  v1pb.Visibility_PUBLIC,
+			PublishTime: timestamppb.New(time.Now().Add(-time.Hour)),
+		},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "publish_time must be in the future")
+
+	// Clearing the publish time cancels the schedule and keeps the memo private.
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:     "Maybe later",
+			Visibility:  v1pb.Visibility_PUBLIC,
+			PublishTime: timestamppb.New(time.Now().Add(time.Hour)),
+		},
+	})
+	require.NoError(t, err)
+	memo, err = ts.Service.UpdateMemo(authorCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"publish_time"}},
+	})
+	require.NoError(t, err)
+	require.Nil(t, memo.PublishTime)
+	require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+}
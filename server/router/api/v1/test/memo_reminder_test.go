@@ -0,0 +1,171 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoDueTime(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "due-owner")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	t.Run("parsed from content", func(t *testing.T) {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Renew passport @due(2026-11-01 09:00)", Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, memo.DueTime)
+		require.Equal(t, time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC), memo.DueTime.AsTime())
+		require.Nil(t, memo.RemindTime)
+
+		// Editing the content without touching the marker keeps the due time.
+		memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Renew passport soon @due(2026-11-01 09:00)"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC), memo.DueTime.AsTime())
+
+		// Changing the marker moves the due time.
+		memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Renew passport @due(2026-12-01)"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC), memo.DueTime.AsTime())
+
+		// Removing the marker clears it.
+		memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Renew passport"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		require.Nil(t, memo.DueTime)
+	})
+
+	t.Run("set through the API", func(t *testing.T) {
+		dueTime := time.Date(2026, 11, 5, 12, 0, 0, 0, time.UTC)
+		remindTime := time.Date(2026, 11, 4, 12, 0, 0, 0, time.UTC)
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{
+				Content:    "Dentist @due(2026-11-01)",
+				Visibility: v1pb.Visibility_PRIVATE,
+				DueTime:    timestamppb.New(dueTime),
+				RemindTime: timestamppb.New(remindTime),
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, dueTime, memo.DueTime.AsTime())
+		require.Equal(t, remindTime, memo.RemindTime.AsTime())
+
+		// A content edit without a marker change keeps the API-set due time.
+		memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Dentist at noon @due(2026-11-01)"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, dueTime, memo.DueTime.AsTime())
+
+		memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"due_time", "remind_time"}},
+		})
+		require.NoError(t, err)
+		require.Nil(t, memo.DueTime)
+		require.Nil(t, memo.RemindTime)
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		resp, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{
+			Filter: "due_time != null && due_time < 1800000000",
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 0)
+
+		_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Taxes @due(2026-11-10)", Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		resp, err = ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{
+			Filter: "due_time != null && due_time < 1800000000",
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.Contains(t, resp.Memos[0].Content, "Taxes")
+	})
+}
+
+func TestDispatchMemoReminders(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	messages := enableNotificationEmail(ctx, t, ts)
+
+	user, err := ts.CreateRegularUser(ctx, "reminder-owner")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	now := time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)
+	due, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Call the bank @due(2026-11-01 09:00)", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    "Later",
+			Visibility: v1pb.Visibility_PRIVATE,
+			DueTime:    timestamppb.New(now.Add(2 * time.Hour)),
+			RemindTime: timestamppb.New(now.Add(time.Hour)),
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ts.Service.DispatchMemoReminders(ctx, now))
+
+	notifications, err := ts.Service.ListUserNotifications(userCtx, &v1pb.ListUserNotificationsRequest{
+		Parent: "users/" + user.Username,
+	})
+	require.NoError(t, err)
+	require.Len(t, notifications.Notifications, 1)
+	notification := notifications.Notifications[0]
+	require.Equal(t, v1pb.UserNotification_MEMO_REMINDER, notification.Type)
+	require.Equal(t, due.Name, notification.GetMemoReminder().Memo)
+	require.Equal(t, now, notification.GetMemoReminder().DueTime.AsTime())
+
+	require.Len(t, *messages, 1)
+	require.Equal(t, []string{user.Email}, (*messages)[0].To)
+	require.Contains(t, (*messages)[0].Subject, "Reminder")
+	require.Contains(t, (*messages)[0].Body, "http://localhost:8080/"+due.Name)
+
+	// Reminders are only sent once.
+	require.NoError(t, ts.Service.DispatchMemoReminders(ctx, now.Add(30*time.Minute)))
+	require.Len(t, *messages, 1)
+
+	// The explicit reminder time fires before the due time.
+	require.NoError(t, ts.Service.DispatchMemoReminders(ctx, now.Add(time.Hour)))
+	require.Len(t, *messages, 2)
+
+	// Moving the due time re-arms the reminder.
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: due.Name, DueTime: timestamppb.New(now.Add(3 * time.Hour))},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"due_time"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.Service.DispatchMemoReminders(ctx, now.Add(2*time.Hour)))
+	require.Len(t, *messages, 2)
+	require.NoError(t, ts.Service.DispatchMemoReminders(ctx, now.Add(3*time.Hour)))
+	require.Len(t, *messages, 3)
+}
@@ -2,15 +2,18 @@ package test
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1server "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/store"
 )
 
@@ -261,3 +264,119 @@ func parseMemoIDFromNameForTest(t *testing.T, ts *TestService, memoName string)
 
 	return memo.ID
 }
+
+func TestGetMemoByShare_RequiresPassword(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "share-password")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "memo with protected share",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+
+	share, err := ts.Service.CreateMemoShare(userCtx, &apiv1.CreateMemoShareRequest{
+		Parent:    memo.Name,
+		MemoShare: &apiv1.MemoShare{Password: "secret"},
+	})
+	require.NoError(t, err)
+	require.True(t, share.PasswordProtected)
+	require.Empty(t, share.Password)
+	shareID := share.Name[strings.LastIndex(share.Name, "/")+1:]
+
+	_, err = ts.Service.GetMemoByShare(ctx, &apiv1.GetMemoByShareRequest{ShareId: shareID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GetMemoByShare(ctx, &apiv1.GetMemoByShareRequest{ShareId: shareID, Password: "wrong"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := ts.Service.GetMemoByShare(apiv1server.WithHeaderCarrier(ctx), &apiv1.GetMemoByShareRequest{ShareId: shareID, Password: "secret"})
+	require.NoError(t, err)
+	require.Equal(t, memo.Name, resp.Name)
+}
+
+func TestGetMemoByShare_LimitsPasswordAttempts(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "share-guess")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "memo with guessed share", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	share, err := ts.Service.CreateMemoShare(userCtx, &apiv1.CreateMemoShareRequest{
+		Parent:    memo.Name,
+		MemoShare: &apiv1.MemoShare{Password: "secret"},
+	})
+	require.NoError(t, err)
+	shareID := share.Name[strings.LastIndex(share.Name, "/")+1:]
+
+	// Failed attempts lock the share, even for guesses from different clients.
+	for i := range 5 {
+		requestCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("x-peer-addr", fmt.Sprintf("203.0.113.%d:40000", i+1)))
+		_, err = ts.Service.GetMemoByShare(requestCtx, &apiv1.GetMemoByShareRequest{ShareId: shareID, Password: "wrong"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	}
+	requestCtx := metadata.NewIncomingContext(apiv1server.WithHeaderCarrier(ctx), metadata.Pairs("x-peer-addr", "198.51.100.1:40000"))
+	_, err = ts.Service.GetMemoByShare(requestCtx, &apiv1.GetMemoByShareRequest{ShareId: shareID, Password: "secret"})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestGetMemoByShare_EnforcesMaxViews(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "share-views")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "memo with view-limited share",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+
+	zero := int32(0)
+	_, err = ts.Service.CreateMemoShare(userCtx, &apiv1.CreateMemoShareRequest{
+		Parent:    memo.Name,
+		MemoShare: &apiv1.MemoShare{MaxViews: &zero},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	maxViews := int32(2)
+	share, err := ts.Service.CreateMemoShare(userCtx, &apiv1.CreateMemoShareRequest{
+		Parent:    memo.Name,
+		MemoShare: &apiv1.MemoShare{MaxViews: &maxViews},
+	})
+	require.NoError(t, err)
+	shareID := share.Name[strings.LastIndex(share.Name, "/")+1:]
+
+	for range maxViews {
+		_, err = ts.Service.GetMemoByShare(ctx, &apiv1.GetMemoByShareRequest{ShareId: shareID})
+		require.NoError(t, err)
+	}
+	_, err = ts.Service.GetMemoByShare(ctx, &apiv1.GetMemoByShareRequest{ShareId: shareID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	shares, err := ts.Service.ListMemoShares(userCtx, &apiv1.ListMemoSharesRequest{Parent: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, shares.MemoShares, 1)
+	require.Equal(t, maxViews, shares.MemoShares[0].GetMaxViews())
+	require.Equal(t, maxViews, shares.MemoShares[0].ViewCount)
+	require.NotNil(t, shares.MemoShares[0].LastAccessTime)
+}
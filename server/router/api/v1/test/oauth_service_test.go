@@ -0,0 +1,145 @@
+package test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+)
+
+func TestOAuthClientManagement(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	_, err = ts.Service.CreateOAuthClient(aliceCtx, &v1pb.CreateOAuthClientRequest{OauthClient: &v1pb.OAuthClient{
+		DisplayName:  "Insecure",
+		RedirectUris: []string{"http://app.example.com/callback"},
+	}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	created, err := ts.Service.CreateOAuthClient(aliceCtx, &v1pb.CreateOAuthClientRequest{OauthClient: &v1pb.OAuthClient{
+		DisplayName:  "Alice's App",
+		RedirectUris: []string{"https://app.example.com/callback"},
+		Scopes:       []string{"memos:write", "openid"},
+		Confidential: true,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, "oauth-clients/"+created.OauthClient.ClientId, created.OauthClient.Name)
+	require.Equal(t, "users/alice", created.OauthClient.Creator)
+	require.Equal(t, []string{"openid", "memos:write"}, created.OauthClient.Scopes)
+	require.True(t, created.OauthClient.Confidential)
+	require.NotEmpty(t, created.ClientSecret)
+
+	// Users only see their own clients; admins see every client.
+	list, err := ts.Service.ListOAuthClients(bobCtx, &v1pb.ListOAuthClientsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, list.OauthClients)
+	list, err = ts.Service.ListOAuthClients(adminCtx, &v1pb.ListOAuthClientsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.OauthClients, 1)
+
+	_, err = ts.Service.DeleteOAuthClient(bobCtx, &v1pb.DeleteOAuthClientRequest{Name: created.OauthClient.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DeleteOAuthClient(aliceCtx, &v1pb.DeleteOAuthClientRequest{Name: created.OauthClient.Name})
+	require.NoError(t, err)
+	list, err = ts.Service.ListOAuthClients(aliceCtx, &v1pb.ListOAuthClientsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, list.OauthClients)
+}
+
+func TestOAuthConsentAndAuthorization(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	redirectURI := "https://app.example.com/callback?source=memos"
+	created, err := ts.Service.CreateOAuthClient(userCtx, &v1pb.CreateOAuthClientRequest{OauthClient: &v1pb.OAuthClient{
+		DisplayName:  "Notes Sync",
+		RedirectUris: []string{redirectURI},
+		Scopes:       []string{"memos:write"},
+	}})
+	require.NoError(t, err)
+
+	request := &v1pb.OAuthAuthorizationRequest{
+		ResponseType:        "code",
+		ClientId:            created.OauthClient.ClientId,
+		RedirectUri:         redirectURI,
+		State:               "state-1",
+		CodeChallenge:       "challenge",
+		CodeChallengeMethod: auth.PKCEMethodS256,
+	}
+
+	consent, err := ts.Service.GetOAuthConsent(userCtx, &v1pb.GetOAuthConsentRequest{Request: request})
+	require.NoError(t, err)
+	require.Equal(t, "Notes Sync", consent.Client.DisplayName)
+	// Without a scope parameter the scopes registered for the client are requested.
+	require.Equal(t, []string{"memos:write"}, consent.Scopes)
+
+	// Identity scopes can always be requested.
+	identityRequest := proto.Clone(request).(*v1pb.OAuthAuthorizationRequest)
+	identityRequest.Scope = "openid memos:write"
+	consent, err = ts.Service.GetOAuthConsent(userCtx, &v1pb.GetOAuthConsentRequest{Request: identityRequest})
+	require.NoError(t, err)
+	require.Equal(t, []string{"openid", "memos:write"}, consent.Scopes)
+
+	_, err = ts.Service.GetOAuthConsent(ctx, &v1pb.GetOAuthConsentRequest{Request: request})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	for _, invalid := range []*v1pb.OAuthAuthorizationRequest{
+		{ResponseType: "code", ClientId: "unknown", RedirectUri: redirectURI, CodeChallenge: "challenge", CodeChallengeMethod: "S256"},
+		{ResponseType: "code", ClientId: request.ClientId, RedirectUri: "https://evil.example.com/callback", CodeChallenge: "challenge", CodeChallengeMethod: "S256"},
+		{ResponseType: "token", ClientId: request.ClientId, RedirectUri: redirectURI, CodeChallenge: "challenge", CodeChallengeMethod: "S256"},
+		{ResponseType: "code", ClientId: request.ClientId, RedirectUri: redirectURI, CodeChallenge: "challenge", CodeChallengeMethod: "plain"},
+		{ResponseType: "code", ClientId: request.ClientId, RedirectUri: redirectURI, CodeChallenge: "challenge", CodeChallengeMethod: "S256", Scope: "everything"},
+		// Scopes outside the registered set are rejected.
+		{ResponseType: "code", ClientId: request.ClientId, RedirectUri: redirectURI, CodeChallenge: "challenge", CodeChallengeMethod: "S256", Scope: "admin"},
+		{ResponseType: "code", ClientId: request.ClientId, RedirectUri: redirectURI, CodeChallenge: "challenge", CodeChallengeMethod: "S256", Scope: "settings:read"},
+	} {
+		_, err = ts.Service.GetOAuthConsent(userCtx, &v1pb.GetOAuthConsentRequest{Request: invalid})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	// Denying redirects back with access_denied and the original state.
+	denied, err := ts.Service.AuthorizeOAuthClient(userCtx, &v1pb.AuthorizeOAuthClientRequest{Request: request})
+	require.NoError(t, err)
+	deniedURL, err := url.Parse(denied.RedirectUri)
+	require.NoError(t, err)
+	require.Equal(t, "access_denied", deniedURL.Query().Get("error"))
+	require.Equal(t, "state-1", deniedURL.Query().Get("state"))
+	require.Equal(t, "memos", deniedURL.Query().Get("source"))
+	require.Empty(t, deniedURL.Query().Get("code"))
+
+	approved, err := ts.Service.AuthorizeOAuthClient(userCtx, &v1pb.AuthorizeOAuthClientRequest{Request: request, Approve: true})
+	require.NoError(t, err)
+	approvedURL, err := url.Parse(approved.RedirectUri)
+	require.NoError(t, err)
+	require.NotEmpty(t, approvedURL.Query().Get("code"))
+	require.Equal(t, "state-1", approvedURL.Query().Get("state"))
+
+	// Scoped credentials, such as OAuth access tokens, cannot approve other clients.
+	oauthCtx := context.WithValue(userCtx, auth.ScopesContextKey, []string{auth.ScopeAdmin})
+	_, err = ts.Service.AuthorizeOAuthClient(oauthCtx, &v1pb.AuthorizeOAuthClientRequest{Request: request, Approve: true})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
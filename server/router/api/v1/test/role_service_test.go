@@ -0,0 +1,292 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+func createTestRole(adminCtx context.Context, t *testing.T, ts *TestService, id string, permissions ...v1pb.Permission) *v1pb.Role {
+	t.Helper()
+	role, err := ts.Service.CreateRole(adminCtx, &v1pb.CreateRoleRequest{
+		RoleId: id,
+		Role:   &v1pb.Role{Permissions: permissions},
+	})
+	require.NoError(t, err)
+	return role
+}
+
+func assignTestRole(adminCtx context.Context, t *testing.T, ts *TestService, user *store.User, role string) {
+	t.Helper()
+	_, err := ts.Service.UpdateUser(adminCtx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: "users/" + user.Username, CustomRole: role},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"custom_role"}},
+	})
+	require.NoError(t, err)
+}
+
+func TestRoleManagement(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Service.CreateRole(userCtx, &v1pb.CreateRoleRequest{RoleId: "moderator", Role: &v1pb.Role{}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	for _, id := range []string{"", "Moderator", "1st", "ADMIN", "with space"} {
+		_, err = ts.Service.CreateRole(adminCtx, &v1pb.CreateRoleRequest{RoleId: id, Role: &v1pb.Role{}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), id)
+	}
+	_, err = ts.Service.CreateRole(adminCtx, &v1pb.CreateRoleRequest{
+		RoleId: "broken",
+		Role:   &v1pb.Role{Permissions: []v1pb.Permission{v1pb.Permission_PERMISSION_UNSPECIFIED}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	role, err := ts.Service.CreateRole(adminCtx, &v1pb.CreateRoleRequest{
+		RoleId: "moderator",
+		Role: &v1pb.Role{
+			Description: "Keeps public memos tidy",
+			Permissions: []v1pb.Permission{v1pb.Permission_MODERATE_MEMOS, v1pb.Permission_MODERATE_MEMOS},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "roles/moderator", role.Name)
+	require.Equal(t, []v1pb.Permission{v1pb.Permission_MODERATE_MEMOS}, role.Permissions)
+
+	_, err = ts.Service.CreateRole(adminCtx, &v1pb.CreateRoleRequest{RoleId: "moderator", Role: &v1pb.Role{}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	role, err = ts.Service.UpdateRole(adminCtx, &v1pb.UpdateRoleRequest{
+		Role:       &v1pb.Role{Name: "roles/moderator", Permissions: []v1pb.Permission{v1pb.Permission_MODERATE_MEMOS, v1pb.Permission_VIEW_STATS}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"permissions"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Keeps public memos tidy", role.Description)
+	require.Len(t, role.Permissions, 2)
+
+	listResponse, err := ts.Service.ListRoles(adminCtx, &v1pb.ListRolesRequest{})
+	require.NoError(t, err)
+	require.Len(t, listResponse.Roles, 1)
+
+	// Assigning a custom role keeps the USER role.
+	assignTestRole(adminCtx, t, ts, user, "roles/moderator")
+	userResponse, err := ts.Service.GetUser(adminCtx, &v1pb.GetUserRequest{Name: "users/alice"})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.User_USER, userResponse.Role)
+	require.Equal(t, "roles/moderator", userResponse.CustomRole)
+
+	// Only admins can assign roles, and admins cannot hold a custom role.
+	_, err = ts.Service.UpdateUser(userCtx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: "users/alice", CustomRole: ""},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"custom_role"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.UpdateUser(adminCtx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: "users/admin", CustomRole: "roles/moderator"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"custom_role"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.UpdateUser(adminCtx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: "users/alice", CustomRole: "roles/missing"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"custom_role"}},
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Roles in use cannot be deleted.
+	_, err = ts.Service.DeleteRole(adminCtx, &v1pb.DeleteRoleRequest{Name: "roles/moderator"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Setting the role clears the custom role.
+	userResponse, err = ts.Service.UpdateUser(adminCtx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: "users/alice", Role: v1pb.User_USER},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, userResponse.CustomRole)
+
+	_, err = ts.Service.DeleteRole(adminCtx, &v1pb.DeleteRoleRequest{Name: "roles/moderator"})
+	require.NoError(t, err)
+	_, err = ts.Service.GetRole(adminCtx, &v1pb.GetRoleRequest{Name: "roles/moderator"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCustomRolePermissions(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	moderator, err := ts.CreateRegularUser(ctx, "moderator")
+	require.NoError(t, err)
+	moderatorCtx := ts.CreateUserContext(ctx, moderator.ID)
+	auditor, err := ts.CreateRegularUser(ctx, "auditor")
+	require.NoError(t, err)
+	auditorCtx := ts.CreateUserContext(ctx, auditor.ID)
+	manager, err := ts.CreateRegularUser(ctx, "manager")
+	require.NoError(t, err)
+	managerCtx := ts.CreateUserContext(ctx, manager.ID)
+
+	createTestRole(adminCtx, t, ts, "moderator", v1pb.Permission_MODERATE_MEMOS)
+	createTestRole(adminCtx, t, ts, "auditor", v1pb.Permission_VIEW_STATS)
+	createTestRole(adminCtx, t, ts, "user-manager", v1pb.Permission_MANAGE_USERS)
+	assignTestRole(adminCtx, t, ts, moderator, "roles/moderator")
+	assignTestRole(adminCtx, t, ts, auditor, "roles/auditor")
+	assignTestRole(adminCtx, t, ts, manager, "roles/user-manager")
+
+	t.Run("moderators archive memos visible to others", func(t *testing.T) {
+		publicMemo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "spam", Visibility: v1pb.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		privateMemo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "diary", Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.CreateUserGroup(adminCtx, &v1pb.CreateUserGroupRequest{GroupId: "authors", Group: &v1pb.UserGroup{}})
+		require.NoError(t, err)
+		_, err = ts.Service.AddUserGroupMember(adminCtx, &v1pb.AddUserGroupMemberRequest{Parent: "groups/authors", User: "users/author"})
+		require.NoError(t, err)
+		groupMemo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "team notes", Visibility: v1pb.Visibility_GROUP, Audience: []string{"groups/authors"}},
+		})
+		require.NoError(t, err)
+
+		archived, err := ts.Service.UpdateMemo(moderatorCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: publicMemo.Name, State: v1pb.State_ARCHIVED},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.State_ARCHIVED, archived.State)
+
+		_, err = ts.Service.UpdateMemo(moderatorCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: publicMemo.Name, Content: "edited"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		// Memos that are not visible to the moderator cannot be moderated.
+		for _, name := range []string{privateMemo.Name, groupMemo.Name} {
+			_, err = ts.Service.UpdateMemo(moderatorCtx, &v1pb.UpdateMemoRequest{
+				Memo:       &v1pb.Memo{Name: name, State: v1pb.State_ARCHIVED},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+			})
+			require.Equal(t, codes.PermissionDenied, status.Code(err))
+		}
+		_, err = ts.Service.UpdateMemo(auditorCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: publicMemo.Name, State: v1pb.State_NORMAL},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("moderators manage tags but not other settings", func(t *testing.T) {
+		_, err := ts.Service.UpdateInstanceSetting(moderatorCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name: "instance/settings/TAGS",
+				Value: &v1pb.InstanceSetting_TagsSetting_{
+					TagsSetting: &v1pb.InstanceSetting_TagsSetting{
+						Tags: map[string]*v1pb.InstanceSetting_TagMetadata{"nsfw": {BlurContent: true}},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.UpdateInstanceSetting(moderatorCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name: "instance/settings/GENERAL",
+				Value: &v1pb.InstanceSetting_GeneralSetting_{
+					GeneralSetting: &v1pb.InstanceSetting_GeneralSetting{},
+				},
+			},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("auditors view stats", func(t *testing.T) {
+		_, err := ts.Service.GetInstanceStats(auditorCtx, &v1pb.GetInstanceStatsRequest{})
+		require.NoError(t, err)
+		_, err = ts.Service.GetInstanceStats(moderatorCtx, &v1pb.GetInstanceStatsRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.ListUsers(auditorCtx, &v1pb.ListUsersRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("user managers manage non-admin users", func(t *testing.T) {
+		_, err := ts.Service.ListUsers(managerCtx, &v1pb.ListUsersRequest{})
+		require.NoError(t, err)
+
+		archived, err := ts.Service.UpdateUser(managerCtx, &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Name: "users/author", State: v1pb.State_ARCHIVED},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.State_ARCHIVED, archived.State)
+
+		_, err = ts.Service.UpdateUser(managerCtx, &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Name: "users/admin", State: v1pb.State_ARCHIVED},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.UpdateUser(managerCtx, &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Name: "users/manager", Role: v1pb.User_ADMIN},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role"}},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// Users whose role grants permissions the manager lacks are off limits.
+		settingsManager, err := ts.CreateRegularUser(ctx, "settings-manager")
+		require.NoError(t, err)
+		createTestRole(adminCtx, t, ts, "settings-manager", v1pb.Permission_MANAGE_USERS, v1pb.Permission_MANAGE_SETTINGS)
+		assignTestRole(adminCtx, t, ts, settingsManager, "roles/settings-manager")
+		_, err = ts.Service.UpdateUser(managerCtx, &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Name: "users/settings-manager", Password: "hijacked123"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"password"}},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.DeleteUser(managerCtx, &v1pb.DeleteUserRequest{Name: "users/settings-manager"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		// The settings manager holds every permission of the user manager role.
+		_, err = ts.Service.UpdateUser(ts.CreateUserContext(ctx, settingsManager.ID), &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Name: "users/manager", Description: "reviewed"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+		})
+		require.NoError(t, err)
+
+		created, err := ts.Service.CreateUser(managerCtx, &v1pb.CreateUserRequest{
+			User: &v1pb.User{Username: "newcomer", Password: "password123"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.User_USER, created.Role)
+		_, err = ts.Service.CreateUser(managerCtx, &v1pb.CreateUserRequest{
+			User: &v1pb.User{Username: "sneaky", Password: "password123", Role: v1pb.User_ADMIN},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("scoped tokens without the admin scope drop custom permissions", func(t *testing.T) {
+		scopedCtx := context.WithValue(auditorCtx, auth.ScopesContextKey, []string{auth.ScopeMemosRead})
+		_, err := ts.Service.GetInstanceStats(scopedCtx, &v1pb.GetInstanceStatsRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
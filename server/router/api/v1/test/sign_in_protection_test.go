@@ -0,0 +1,204 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// signInWithPassword signs in through a local reverse proxy that forwards the client IP.
+func signInWithPassword(ctx context.Context, ts *TestService, ip, username, password string) error {
+	return signInWithMetadata(ctx, ts, metadata.Pairs("x-peer-addr", "127.0.0.1:54321", "x-forwarded-for", ip), username, password)
+}
+
+func signInWithMetadata(ctx context.Context, ts *TestService, md metadata.MD, username, password string) error {
+	requestCtx := metadata.NewIncomingContext(apiv1.WithHeaderCarrier(ctx), md)
+	_, err := ts.Service.SignIn(requestCtx, &v1pb.SignInRequest{
+		Credentials: &v1pb.SignInRequest_PasswordCredentials_{
+			PasswordCredentials: &v1pb.SignInRequest_PasswordCredentials{
+				Username: username,
+				Password: password,
+			},
+		},
+	})
+	return err
+}
+
+func setSignInProtection(ctx context.Context, t *testing.T, ts *TestService, protection *storepb.InstanceSignInProtectionSetting) {
+	t.Helper()
+	_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_GENERAL,
+		Value: &storepb.InstanceSetting_GeneralSetting{
+			GeneralSetting: &storepb.InstanceGeneralSetting{SignInProtection: protection},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestSignInLocksUsernameAfterFailures(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user := createLegacyPasswordUser(ctx, t, ts, "alice", "password123")
+	setSignInProtection(ctx, t, ts, &storepb.InstanceSignInProtectionSetting{MaxFailuresPerUsername: 3})
+
+	for i := 0; i < 3; i++ {
+		err := signInWithPassword(ctx, ts, "203.0.113.1", user.Username, "wrong")
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	// The correct password is rejected while the account is locked, even from another IP.
+	err := signInWithPassword(ctx, ts, "203.0.113.2", user.Username, "password123")
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	kind := store.SignInAttemptKindUsername
+	attempt, err := ts.Store.GetSignInAttempt(ctx, &store.FindSignInAttempt{Kind: &kind, Identifier: &user.Username})
+	require.NoError(t, err)
+	require.NotNil(t, attempt)
+	require.Equal(t, int32(3), attempt.FailureCount)
+	require.NotZero(t, attempt.LockedUntilTs)
+}
+
+func TestSignInLocksIPAfterFailures(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user := createLegacyPasswordUser(ctx, t, ts, "alice", "password123")
+	setSignInProtection(ctx, t, ts, &storepb.InstanceSignInProtectionSetting{MaxFailuresPerIp: 2})
+
+	// Password spraying across usernames trips the IP counter.
+	require.Error(t, signInWithPassword(ctx, ts, "203.0.113.1", "bob", "wrong"))
+	require.Error(t, signInWithPassword(ctx, ts, "203.0.113.1", "carol", "wrong"))
+
+	err := signInWithPassword(ctx, ts, "203.0.113.1", user.Username, "password123")
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Other clients are unaffected.
+	require.NoError(t, signInWithPassword(ctx, ts, "203.0.113.2", user.Username, "password123"))
+}
+
+func TestSignInIgnoresSpoofedForwardedFor(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user := createLegacyPasswordUser(ctx, t, ts, "alice", "password123")
+	setSignInProtection(ctx, t, ts, &storepb.InstanceSignInProtectionSetting{MaxFailuresPerIp: 2})
+
+	// A client connecting directly cannot pick its address with proxy headers.
+	for _, spoofed := range []string{"198.51.100.1", "198.51.100.2"} {
+		md := metadata.Pairs("x-peer-addr", "203.0.113.1:40000", "x-forwarded-for", spoofed, "x-real-ip", spoofed)
+		require.Error(t, signInWithMetadata(ctx, ts, md, "bob", "wrong"))
+	}
+	md := metadata.Pairs("x-peer-addr", "203.0.113.1:40000", "x-forwarded-for", "198.51.100.3")
+	err := signInWithMetadata(ctx, ts, md, user.Username, "password123")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Behind a proxy, the hops a client prepends are ignored.
+	for _, spoofed := range []string{"198.51.100.4", "198.51.100.5"} {
+		md := metadata.Pairs("x-peer-addr", "10.0.0.2:40000", "x-forwarded-for", spoofed+", 203.0.113.2, 10.0.0.1")
+		require.Error(t, signInWithMetadata(ctx, ts, md, "bob", "wrong"))
+	}
+	err = signInWithPassword(ctx, ts, "203.0.113.2", user.Username, "password123")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.NoError(t, signInWithPassword(ctx, ts, "198.51.100.4", user.Username, "password123"))
+}
+
+func TestGatewaySignInIgnoresSpoofedPeerAddr(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user := createLegacyPasswordUser(ctx, t, ts, "alice", "password123")
+	setSignInProtection(ctx, t, ts, &storepb.InstanceSignInProtectionSetting{MaxFailuresPerIp: 2})
+	e := echo.New()
+	require.NoError(t, ts.Service.RegisterGateway(ctx, e))
+
+	// A client cannot pick the address it is keyed on with a metadata header.
+	signIn := func(username, password, spoofed string) int {
+		body := fmt.Sprintf(`{"passwordCredentials":{"username":%q,"password":%q}}`, username, password)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/signin", strings.NewReader(body))
+		req.RemoteAddr = "203.0.113.1:40000"
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Grpc-Metadata-X-Peer-Addr", spoofed+":40000")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	require.Equal(t, http.StatusBadRequest, signIn("bob", "wrong", "198.51.100.1"))
+	require.Equal(t, http.StatusBadRequest, signIn("bob", "wrong", "198.51.100.2"))
+	require.Equal(t, http.StatusTooManyRequests, signIn(user.Username, "password123", "198.51.100.3"))
+}
+
+func TestSignInResetsFailuresOnSuccess(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user := createLegacyPasswordUser(ctx, t, ts, "alice", "password123")
+	setSignInProtection(ctx, t, ts, &storepb.InstanceSignInProtectionSetting{MaxFailuresPerUsername: 3})
+
+	require.Error(t, signInWithPassword(ctx, ts, "203.0.113.1", user.Username, "wrong"))
+	require.Error(t, signInWithPassword(ctx, ts, "203.0.113.1", user.Username, "wrong"))
+	require.NoError(t, signInWithPassword(ctx, ts, "203.0.113.1", user.Username, "password123"))
+
+	kind := store.SignInAttemptKindUsername
+	attempt, err := ts.Store.GetSignInAttempt(ctx, &store.FindSignInAttempt{Kind: &kind, Identifier: &user.Username})
+	require.NoError(t, err)
+	require.Nil(t, attempt)
+}
+
+func TestSignInProtectionDisabled(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user := createLegacyPasswordUser(ctx, t, ts, "alice", "password123")
+	setSignInProtection(ctx, t, ts, &storepb.InstanceSignInProtectionSetting{Disabled: true, MaxFailuresPerUsername: 1})
+
+	require.Error(t, signInWithPassword(ctx, ts, "203.0.113.1", user.Username, "wrong"))
+	require.Error(t, signInWithPassword(ctx, ts, "203.0.113.1", user.Username, "wrong"))
+	require.NoError(t, signInWithPassword(ctx, ts, "203.0.113.1", user.Username, "password123"))
+}
+
+func TestUnlockUser(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	user := createLegacyPasswordUser(ctx, t, ts, "alice", "password123")
+	setSignInProtection(ctx, t, ts, &storepb.InstanceSignInProtectionSetting{MaxFailuresPerUsername: 1})
+
+	require.Error(t, signInWithPassword(ctx, ts, "203.0.113.1", user.Username, "wrong"))
+	err = signInWithPassword(ctx, ts, "203.0.113.2", user.Username, "password123")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Regular users cannot unlock accounts.
+	_, err = ts.Service.UnlockUser(ts.CreateUserContext(ctx, user.ID), &v1pb.UnlockUserRequest{Name: apiv1.BuildUserName(user.Username)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.UnlockUser(ts.CreateUserContext(ctx, admin.ID), &v1pb.UnlockUserRequest{Name: apiv1.BuildUserName(user.Username)})
+	require.NoError(t, err)
+	require.NoError(t, signInWithPassword(ctx, ts, "203.0.113.2", user.Username, "password123"))
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 
 	"github.com/usememos/memos/server/auth"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
 )
 
 func TestSSEHandler_Authentication(t *testing.T) {
@@ -133,3 +135,69 @@ func TestSSEHandler_Authentication(t *testing.T) {
 		}
 	})
 }
+
+func TestSSEHandler_Scopes(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "sse-admin")
+	require.NoError(t, err)
+	oauthToken := func(scopes ...string) string {
+		token, _, err := auth.GenerateOAuthAccessToken(admin.ID, admin.Username, string(admin.Role), string(admin.RowStatus), "sse-client", scopes, []byte(ts.Secret))
+		require.NoError(t, err)
+		return token
+	}
+
+	e := echo.New()
+	apiv1.RegisterSSERoutes(e, ts.Service.SSEHub, ts.Store, ts.Secret)
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	t.Run("token without memo read scope returns 403", func(t *testing.T) {
+		// The timeout ends the stream should the request be accepted.
+		reqCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/sse", nil).WithContext(reqCtx)
+		req.Header.Set("Authorization", "Bearer "+oauthToken(auth.ScopeAttachmentsRead))
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	// firstEvent connects with the token, broadcasts a private memo event of another
+	// user and then a public one, and returns the first event received.
+	firstEvent := func(t *testing.T, token string) string {
+		reqCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, server.URL+"/api/v1/sse", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := server.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		reader := bufio.NewReader(resp.Body)
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, ": connected\n", line)
+
+		ts.Service.SSEHub.Broadcast(&apiv1.SSEEvent{Type: apiv1.SSEEventMemoCreated, Name: "memos/private", Visibility: store.Private, CreatorID: admin.ID + 1})
+		ts.Service.SSEHub.Broadcast(&apiv1.SSEEvent{Type: apiv1.SSEEventMemoCreated, Name: "memos/public", Visibility: store.Public, CreatorID: admin.ID + 1})
+		for {
+			line, err := reader.ReadString('\n')
+			require.NoError(t, err)
+			if strings.HasPrefix(line, "data: ") {
+				return line
+			}
+		}
+	}
+
+	t.Run("admin token receives private memo events", func(t *testing.T) {
+		require.Contains(t, firstEvent(t, oauthToken(auth.ScopeAdmin)), "memos/private")
+	})
+
+	t.Run("scoped admin token without admin scope acts as a user", func(t *testing.T) {
+		require.Contains(t, firstEvent(t, oauthToken(auth.ScopeMemosRead)), "memos/public")
+	})
+}
@@ -0,0 +1,222 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestUserGroupManagement(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	_, err = ts.Service.CreateUserGroup(aliceCtx, &v1pb.CreateUserGroupRequest{GroupId: "team", Group: &v1pb.UserGroup{}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	for _, id := range []string{"", "Team", "1st", "with space"} {
+		_, err = ts.Service.CreateUserGroup(adminCtx, &v1pb.CreateUserGroupRequest{GroupId: id, Group: &v1pb.UserGroup{}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), id)
+	}
+
+	group, err := ts.Service.CreateUserGroup(adminCtx, &v1pb.CreateUserGroupRequest{
+		GroupId: "team",
+		Group:   &v1pb.UserGroup{Description: "The team"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "groups/team", group.Name)
+	require.Equal(t, "The team", group.Description)
+
+	_, err = ts.Service.CreateUserGroup(adminCtx, &v1pb.CreateUserGroupRequest{GroupId: "team", Group: &v1pb.UserGroup{}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	group, err = ts.Service.UpdateUserGroup(adminCtx, &v1pb.UpdateUserGroupRequest{
+		Group:      &v1pb.UserGroup{Name: "groups/team", Description: "Core team"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Core team", group.Description)
+
+	member, err := ts.Service.AddUserGroupMember(adminCtx, &v1pb.AddUserGroupMemberRequest{Parent: "groups/team", User: "users/alice"})
+	require.NoError(t, err)
+	require.Equal(t, "groups/team/members/alice", member.Name)
+	require.Equal(t, "users/alice", member.User)
+	_, err = ts.Service.AddUserGroupMember(adminCtx, &v1pb.AddUserGroupMemberRequest{Parent: "groups/team", User: "users/alice"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	group, err = ts.Service.GetUserGroup(adminCtx, &v1pb.GetUserGroupRequest{Name: "groups/team"})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), group.MemberCount)
+
+	// Members see their own groups; other users do not.
+	groups, err := ts.Service.ListUserGroups(aliceCtx, &v1pb.ListUserGroupsRequest{})
+	require.NoError(t, err)
+	require.Len(t, groups.Groups, 1)
+	groups, err = ts.Service.ListUserGroups(bobCtx, &v1pb.ListUserGroupsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, groups.Groups)
+	_, err = ts.Service.GetUserGroup(bobCtx, &v1pb.GetUserGroupRequest{Name: "groups/team"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	members, err := ts.Service.ListUserGroupMembers(aliceCtx, &v1pb.ListUserGroupMembersRequest{Parent: "groups/team"})
+	require.NoError(t, err)
+	require.Len(t, members.Members, 1)
+
+	_, err = ts.Service.RemoveUserGroupMember(adminCtx, &v1pb.RemoveUserGroupMemberRequest{Name: "groups/team/members/alice"})
+	require.NoError(t, err)
+	members, err = ts.Service.ListUserGroupMembers(adminCtx, &v1pb.ListUserGroupMembersRequest{Parent: "groups/team"})
+	require.NoError(t, err)
+	require.Empty(t, members.Members)
+
+	_, err = ts.Service.DeleteUserGroup(adminCtx, &v1pb.DeleteUserGroupRequest{Name: "groups/team"})
+	require.NoError(t, err)
+	_, err = ts.Service.GetUserGroup(adminCtx, &v1pb.GetUserGroupRequest{Name: "groups/team"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGroupVisibleMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+	carol, err := ts.CreateRegularUser(ctx, "carol")
+	require.NoError(t, err)
+	carolCtx := ts.CreateUserContext(ctx, carol.ID)
+
+	_, err = ts.Service.CreateUserGroup(adminCtx, &v1pb.CreateUserGroupRequest{GroupId: "team", Group: &v1pb.UserGroup{}})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateUserGroup(adminCtx, &v1pb.CreateUserGroupRequest{GroupId: "ops", Group: &v1pb.UserGroup{}})
+	require.NoError(t, err)
+	for _, username := range []string{"alice", "bob"} {
+		_, err = ts.Service.AddUserGroupMember(adminCtx, &v1pb.AddUserGroupMemberRequest{Parent: "groups/team", User: "users/" + username})
+		require.NoError(t, err)
+	}
+
+	// The audience is required, and members can only share with their own groups.
+	_, err = ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "no audience", Visibility: v1pb.Visibility_GROUP}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "ops", Visibility: v1pb.Visibility_GROUP, Audience: []string{"groups/ops"}}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "missing", Visibility: v1pb.Visibility_GROUP, Audience: []string{"groups/missing"}}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	memo, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "team only", Visibility: v1pb.Visibility_GROUP, Audience: []string{"groups/team"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_GROUP, memo.Visibility)
+	require.Equal(t, []string{"groups/team"}, memo.Audience)
+
+	_, err = ts.Service.GetMemo(bobCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(carolCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	memos, err := ts.Service.ListMemos(bobCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	memos, err = ts.Service.ListMemos(bobCtx, &v1pb.ListMemosRequest{Filter: `audience == "groups/team"`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	memos, err = ts.Service.ListMemos(carolCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, memos.Memos)
+
+	// Comments inherit the audience of the memo they reply to.
+	comment, err := ts.Service.CreateMemoComment(bobCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "reply", Visibility: v1pb.Visibility_GROUP},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"groups/team"}, comment.Audience)
+	_, err = ts.Service.CreateMemoComment(carolCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "intruder", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Leaving the group revokes access.
+	_, err = ts.Service.RemoveUserGroupMember(adminCtx, &v1pb.RemoveUserGroupMemberRequest{Name: "groups/team/members/bob"})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(bobCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Switching to another visibility clears the audience.
+	memo, err = ts.Service.UpdateMemo(aliceCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Visibility: v1pb.Visibility_PROTECTED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, memo.Audience)
+	_, err = ts.Service.GetMemo(carolCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+}
+
+func TestDeletedGroupIsRemovedFromMemoAudiences(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	mallory, err := ts.CreateRegularUser(ctx, "mallory")
+	require.NoError(t, err)
+	malloryCtx := ts.CreateUserContext(ctx, mallory.ID)
+
+	for _, groupID := range []string{"team", "ops"} {
+		_, err = ts.Service.CreateUserGroup(adminCtx, &v1pb.CreateUserGroupRequest{GroupId: groupID, Group: &v1pb.UserGroup{}})
+		require.NoError(t, err)
+		_, err = ts.Service.AddUserGroupMember(adminCtx, &v1pb.AddUserGroupMemberRequest{Parent: "groups/" + groupID, User: "users/alice"})
+		require.NoError(t, err)
+	}
+	memo, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "team only", Visibility: v1pb.Visibility_GROUP, Audience: []string{"groups/team", "groups/ops"}},
+	})
+	require.NoError(t, err)
+
+	// Deleting the group removes it from the audience of the memos shared with it.
+	_, err = ts.Service.DeleteUserGroup(adminCtx, &v1pb.DeleteUserGroupRequest{Name: "groups/team"})
+	require.NoError(t, err)
+	memo, err = ts.Service.GetMemo(aliceCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, []string{"groups/ops"}, memo.Audience)
+
+	// A new group with the same name does not inherit access.
+	_, err = ts.Service.CreateUserGroup(adminCtx, &v1pb.CreateUserGroupRequest{GroupId: "team", Group: &v1pb.UserGroup{}})
+	require.NoError(t, err)
+	_, err = ts.Service.AddUserGroupMember(adminCtx, &v1pb.AddUserGroupMemberRequest{Parent: "groups/team", User: "users/mallory"})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(malloryCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	memos, err := ts.Service.ListMemos(malloryCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, memos.Memos)
+}
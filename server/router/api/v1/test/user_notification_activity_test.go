@@ -0,0 +1,227 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func listNotifications(ctx context.Context, t *testing.T, ts *TestService, username string) []*apiv1.UserNotification {
+	t.Helper()
+	resp, err := ts.Service.ListUserNotifications(ctx, &apiv1.ListUserNotificationsRequest{
+		Parent: fmt.Sprintf("users/%s", username),
+	})
+	require.NoError(t, err)
+	return resp.Notifications
+}
+
+func TestMemoReactionNotifiesMemoOwner(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateRegularUser(ctx, "reaction-owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	reactor, err := ts.CreateRegularUser(ctx, "reaction-reactor")
+	require.NoError(t, err)
+	reactorCtx := ts.CreateUserContext(ctx, reactor.ID)
+
+	memo, err := ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Reacted memo", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	// Reacting to your own memo does not notify.
+	_, err = ts.Service.UpsertMemoReaction(ownerCtx, &apiv1.UpsertMemoReactionRequest{
+		Name:     memo.Name,
+		Reaction: &apiv1.Reaction{ContentId: memo.Name, ReactionType: "👀"},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpsertMemoReaction(reactorCtx, &apiv1.UpsertMemoReactionRequest{
+		Name:     memo.Name,
+		Reaction: &apiv1.Reaction{ContentId: memo.Name, ReactionType: "👍"},
+	})
+	require.NoError(t, err)
+
+	notifications := listNotifications(ownerCtx, t, ts, owner.Username)
+	require.Len(t, notifications, 1)
+	require.Equal(t, apiv1.UserNotification_MEMO_REACTION, notifications[0].Type)
+	require.Equal(t, fmt.Sprintf("users/%s", reactor.Username), notifications[0].Sender)
+	require.Equal(t, memo.Name, notifications[0].GetMemoReaction().Memo)
+	require.Equal(t, "Reacted memo", notifications[0].GetMemoReaction().MemoSnippet)
+	require.Equal(t, "👍", notifications[0].GetMemoReaction().ReactionType)
+}
+
+func TestMemoCommentNotifiesThreadParticipants(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateRegularUser(ctx, "thread-owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	participant, err := ts.CreateRegularUser(ctx, "thread-participant")
+	require.NoError(t, err)
+	participantCtx := ts.CreateUserContext(ctx, participant.ID)
+	replier, err := ts.CreateRegularUser(ctx, "thread-replier")
+	require.NoError(t, err)
+	replierCtx := ts.CreateUserContext(ctx, replier.ID)
+
+	memo, err := ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Thread memo", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(participantCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &apiv1.Memo{Content: "First comment", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(ownerCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &apiv1.Memo{Content: "Owner reply", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	reply, err := ts.Service.CreateMemoComment(replierCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &apiv1.Memo{Content: "Second comment", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	notifications := listNotifications(participantCtx, t, ts, participant.Username)
+	require.Len(t, notifications, 2)
+	replierNotifications := []*apiv1.UserNotification{}
+	for _, notification := range notifications {
+		require.Equal(t, apiv1.UserNotification_MEMO_THREAD_REPLY, notification.Type)
+		if notification.Sender == fmt.Sprintf("users/%s", replier.Username) {
+			replierNotifications = append(replierNotifications, notification)
+		}
+	}
+	require.Len(t, replierNotifications, 1)
+	payload := replierNotifications[0].GetMemoThreadReply()
+	require.Equal(t, reply.Name, payload.Memo)
+	require.Equal(t, memo.Name, payload.RelatedMemo)
+	require.Equal(t, "Second comment", payload.MemoSnippet)
+	require.Equal(t, "Thread memo", payload.RelatedMemoSnippet)
+
+	// The owner gets comment notifications rather than thread replies.
+	for _, notification := range listNotifications(ownerCtx, t, ts, owner.Username) {
+		require.Equal(t, apiv1.UserNotification_MEMO_COMMENT, notification.Type)
+	}
+	require.Empty(t, listNotifications(replierCtx, t, ts, replier.Username))
+}
+
+func TestMemoShareOpenedNotifiesShareCreatorOnce(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateRegularUser(ctx, "share-owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+
+	memo, err := ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Shared memo", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	share, err := ts.Service.CreateMemoShare(ownerCtx, &apiv1.CreateMemoShareRequest{
+		Parent:    memo.Name,
+		MemoShare: &apiv1.MemoShare{},
+	})
+	require.NoError(t, err)
+	shareID := share.Name[strings.LastIndex(share.Name, "/")+1:]
+
+	// Opening your own share does not notify.
+	_, err = ts.Service.GetMemoByShare(ownerCtx, &apiv1.GetMemoByShareRequest{ShareId: shareID})
+	require.NoError(t, err)
+	require.Empty(t, listNotifications(ownerCtx, t, ts, owner.Username))
+
+	for range 2 {
+		_, err = ts.Service.GetMemoByShare(ctx, &apiv1.GetMemoByShareRequest{ShareId: shareID})
+		require.NoError(t, err)
+	}
+	notifications := listNotifications(ownerCtx, t, ts, owner.Username)
+	require.Len(t, notifications, 1)
+	require.Equal(t, apiv1.UserNotification_MEMO_SHARE_OPENED, notifications[0].Type)
+	require.Equal(t, memo.Name, notifications[0].GetMemoShareOpened().Memo)
+	require.Equal(t, share.Name, notifications[0].GetMemoShareOpened().Share)
+	require.Equal(t, "Shared memo", notifications[0].GetMemoShareOpened().MemoSnippet)
+
+	// The share token is not kept in the inbox.
+	inboxes, err := ts.Store.ListInboxes(ctx, &store.FindInbox{ReceiverID: &owner.ID})
+	require.NoError(t, err)
+	require.Len(t, inboxes, 1)
+	message, err := protojson.Marshal(inboxes[0].Message)
+	require.NoError(t, err)
+	require.NotContains(t, string(message), shareID)
+
+	_, err = ts.Service.DeleteMemoShare(ownerCtx, &apiv1.DeleteMemoShareRequest{Name: share.Name})
+	require.NoError(t, err)
+	notifications = listNotifications(ownerCtx, t, ts, owner.Username)
+	require.Len(t, notifications, 1)
+	require.Empty(t, notifications[0].GetMemoShareOpened().Share)
+}
+
+func TestMutedNotificationTypesAreNotDelivered(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateRegularUser(ctx, "muted-owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	reactor, err := ts.CreateRegularUser(ctx, "muted-reactor")
+	require.NoError(t, err)
+	reactorCtx := ts.CreateUserContext(ctx, reactor.ID)
+
+	settingName := fmt.Sprintf("users/%s/settings/GENERAL", owner.Username)
+	setting, err := ts.Service.UpdateUserSetting(ownerCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: settingName,
+			Value: &apiv1.UserSetting_GeneralSetting_{GeneralSetting: &apiv1.UserSetting_GeneralSetting{
+				MutedNotificationTypes: []apiv1.UserNotification_Type{apiv1.UserNotification_MEMO_REACTION},
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"muted_notification_types"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []apiv1.UserNotification_Type{apiv1.UserNotification_MEMO_REACTION}, setting.GetGeneralSetting().MutedNotificationTypes)
+
+	memo, err := ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Quiet memo", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpsertMemoReaction(reactorCtx, &apiv1.UpsertMemoReactionRequest{
+		Name:     memo.Name,
+		Reaction: &apiv1.Reaction{ContentId: memo.Name, ReactionType: "👍"},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(reactorCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &apiv1.Memo{Content: "Still notified", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	notifications := listNotifications(ownerCtx, t, ts, owner.Username)
+	require.Len(t, notifications, 1)
+	require.Equal(t, apiv1.UserNotification_MEMO_COMMENT, notifications[0].Type)
+
+	_, err = ts.Service.UpdateUserSetting(ownerCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: settingName,
+			Value: &apiv1.UserSetting_GeneralSetting_{GeneralSetting: &apiv1.UserSetting_GeneralSetting{
+				MutedNotificationTypes: []apiv1.UserNotification_Type{apiv1.UserNotification_TYPE_UNSPECIFIED},
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"muted_notification_types"}},
+	})
+	require.Error(t, err)
+}
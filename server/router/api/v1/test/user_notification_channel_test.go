@@ -0,0 +1,219 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// channelTransport records notification channel requests instead of sending them.
+type channelTransport struct {
+	// statusCode is the status the push services respond with.
+	statusCode atomic.Int32
+	requests   chan channelRequest
+}
+
+type channelRequest struct {
+	URL    string
+	Header http.Header
+	Body   map[string]any
+}
+
+func newChannelTransport() *channelTransport {
+	transport := &channelTransport{requests: make(chan channelRequest, 8)}
+	transport.statusCode.Store(http.StatusOK)
+	return transport
+}
+
+func (c *channelTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	body, _ := io.ReadAll(r.Body)
+	request := channelRequest{URL: r.URL.String(), Header: r.Header.Clone()}
+	_ = json.Unmarshal(body, &request.Body)
+	c.requests <- request
+	return &http.Response{
+		StatusCode: int(c.statusCode.Load()),
+		Body:       io.NopCloser(strings.NewReader("")),
+		Header:     http.Header{},
+		Request:    r,
+	}, nil
+}
+
+func (c *channelTransport) nextRequest(t *testing.T) channelRequest {
+	t.Helper()
+	select {
+	case request := <-c.requests:
+		return request
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for channel notification")
+		return channelRequest{}
+	}
+}
+
+func TestNotificationChannelsDeliverInboxNotifications(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	transport := newChannelTransport()
+	ts.Service.NotificationChannelClient = &http.Client{Transport: transport}
+
+	owner, err := ts.CreateRegularUser(ctx, "channel-owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	commenter, err := ts.CreateRegularUser(ctx, "channel-commenter")
+	require.NoError(t, err)
+	commenterCtx := ts.CreateUserContext(ctx, commenter.ID)
+	settingName := fmt.Sprintf("users/%s/settings/NOTIFICATION", owner.Username)
+
+	setting, err := ts.Service.UpdateUserSetting(ownerCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: settingName,
+			Value: &apiv1.UserSetting_NotificationSetting_{NotificationSetting: &apiv1.UserSetting_NotificationSetting{
+				Channels: []*apiv1.UserSetting_NotificationSetting_Channel{
+					{Type: apiv1.UserSetting_NotificationSetting_Channel_NTFY, Url: "https://93.184.215.14/memos-topic", Token: "tk_ntfy"},
+					{Type: apiv1.UserSetting_NotificationSetting_Channel_GOTIFY, Url: "https://93.184.215.14/gotify", Token: "gotify-app"},
+				},
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"channels"}},
+	})
+	require.NoError(t, err)
+	channels := setting.GetNotificationSetting().Channels
+	require.Len(t, channels, 2)
+	for _, channel := range channels {
+		require.Empty(t, channel.Token)
+	}
+
+	// Updating the preferences keeps the channel tokens.
+	_, err = ts.Service.UpdateUserSetting(ownerCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: settingName,
+			Value: &apiv1.UserSetting_NotificationSetting_{NotificationSetting: &apiv1.UserSetting_NotificationSetting{
+				Preferences: []*apiv1.UserSetting_NotificationSetting_Preference{
+					{Type: apiv1.UserNotification_MEMO_COMMENT, EmailDelivery: apiv1.UserSetting_NotificationSetting_OFF},
+				},
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"preferences"}},
+	})
+	require.NoError(t, err)
+
+	memo, err := ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Channel memo", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	comment, err := ts.Service.CreateMemoComment(commenterCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &apiv1.Memo{Content: "Channel comment", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	commentURL := fmt.Sprintf("http://localhost:8080/%s#%s", memo.Name, strings.TrimPrefix(comment.Name, "memos/"))
+
+	requests := map[string]channelRequest{}
+	for range 2 {
+		request := transport.nextRequest(t)
+		requests[request.URL] = request
+	}
+	ntfyRequest := requests["https://93.184.215.14/"]
+	require.Equal(t, "Bearer tk_ntfy", ntfyRequest.Header.Get("Authorization"))
+	require.Equal(t, map[string]any{
+		"topic":   "memos-topic",
+		"title":   "channel-commenter commented on your memo",
+		"message": "channel-commenter commented on your memo.",
+		"click":   commentURL,
+	}, ntfyRequest.Body)
+	gotifyRequest := requests["https://93.184.215.14/gotify/message"]
+	require.Equal(t, "gotify-app", gotifyRequest.Header.Get("X-Gotify-Key"))
+	require.Equal(t, "channel-commenter commented on your memo", gotifyRequest.Body["title"])
+}
+
+func TestSendTestNotification(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	transport := newChannelTransport()
+	ts.Service.NotificationChannelClient = &http.Client{Transport: transport}
+
+	user, err := ts.CreateRegularUser(ctx, "channel-tester")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "channel-other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	name := fmt.Sprintf("users/%s", user.Username)
+
+	_, err = ts.Service.SendTestNotification(userCtx, &apiv1.SendTestNotificationRequest{Name: name})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// A Gotify channel needs an application token.
+	gotifyChannel := &apiv1.UserSetting_NotificationSetting_Channel{
+		Type: apiv1.UserSetting_NotificationSetting_Channel_GOTIFY,
+		Url:  "https://93.184.215.14/gotify",
+	}
+	updateChannels := func(channels ...*apiv1.UserSetting_NotificationSetting_Channel) error {
+		_, err := ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+			Setting: &apiv1.UserSetting{
+				Name: name + "/settings/NOTIFICATION",
+				Value: &apiv1.UserSetting_NotificationSetting_{NotificationSetting: &apiv1.UserSetting_NotificationSetting{
+					Channels: channels,
+				}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"channels"}},
+		})
+		return err
+	}
+	require.Equal(t, codes.InvalidArgument, status.Code(updateChannels(gotifyChannel)))
+	require.Equal(t, codes.InvalidArgument, status.Code(updateChannels(&apiv1.UserSetting_NotificationSetting_Channel{
+		Type: apiv1.UserSetting_NotificationSetting_Channel_NTFY,
+		Url:  "http://127.0.0.1/memos-topic",
+	})))
+	gotifyChannel.Token = "gotify-app"
+	require.NoError(t, updateChannels(gotifyChannel))
+	// Saving the channel again without its token keeps the saved token.
+	gotifyChannel.Token = ""
+	require.NoError(t, updateChannels(gotifyChannel))
+	require.Equal(t, codes.InvalidArgument, status.Code(updateChannels(gotifyChannel, gotifyChannel)))
+
+	_, err = ts.Service.SendTestNotification(otherCtx, &apiv1.SendTestNotificationRequest{Name: name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.SendTestNotification(userCtx, &apiv1.SendTestNotificationRequest{Name: name})
+	require.NoError(t, err)
+	request := transport.nextRequest(t)
+	require.Equal(t, "https://93.184.215.14/gotify/message", request.URL)
+	require.Equal(t, "gotify-app", request.Header.Get("X-Gotify-Key"))
+	require.Equal(t, "Test notification", request.Body["title"])
+
+	// An unsaved channel can be tested before it is saved.
+	_, err = ts.Service.SendTestNotification(userCtx, &apiv1.SendTestNotificationRequest{
+		Name: name,
+		Channel: &apiv1.UserSetting_NotificationSetting_Channel{
+			Type: apiv1.UserSetting_NotificationSetting_Channel_APPRISE,
+			Url:  "https://93.184.215.14/notify/memos",
+		},
+	})
+	require.NoError(t, err)
+	request = transport.nextRequest(t)
+	require.Equal(t, "https://93.184.215.14/notify/memos", request.URL)
+	require.Equal(t, "info", request.Body["type"])
+
+	// Failed deliveries are reported.
+	transport.statusCode.Store(http.StatusUnauthorized)
+	_, err = ts.Service.SendTestNotification(userCtx, &apiv1.SendTestNotificationRequest{Name: name})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.ErrorContains(t, err, "status 401")
+}
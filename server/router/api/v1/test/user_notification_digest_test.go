@@ -0,0 +1,134 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestHourlyDigestBatchesNotificationEmails(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	sentMessages := enableNotificationEmail(ctx, t, ts)
+
+	owner, err := ts.CreateRegularUser(ctx, "digest-owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	commenter, err := ts.CreateRegularUser(ctx, "digest-commenter")
+	require.NoError(t, err)
+	commenterCtx := ts.CreateUserContext(ctx, commenter.ID)
+
+	setting, err := ts.Service.UpdateUserSetting(ownerCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: fmt.Sprintf("users/%s/settings/NOTIFICATION", owner.Username),
+			Value: &apiv1.UserSetting_NotificationSetting_{NotificationSetting: &apiv1.UserSetting_NotificationSetting{
+				Preferences: []*apiv1.UserSetting_NotificationSetting_Preference{
+					{Type: apiv1.UserNotification_MEMO_COMMENT, EmailDelivery: apiv1.UserSetting_NotificationSetting_HOURLY_DIGEST},
+					{Type: apiv1.UserNotification_MEMO_REACTION, EmailDelivery: apiv1.UserSetting_NotificationSetting_OFF},
+				},
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"preferences"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, setting.GetNotificationSetting().Preferences, 2)
+
+	memo, err := ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Digest memo", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	for _, content := range []string{"First comment", "Second comment"} {
+		_, err = ts.Service.CreateMemoComment(commenterCtx, &apiv1.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+	}
+	_, err = ts.Service.UpsertMemoReaction(commenterCtx, &apiv1.UpsertMemoReactionRequest{
+		Name:     memo.Name,
+		Reaction: &apiv1.Reaction{ContentId: memo.Name, ReactionType: "👍"},
+	})
+	require.NoError(t, err)
+	// Digested and disabled notification types are not emailed right away.
+	require.Empty(t, *sentMessages)
+	require.Len(t, listNotifications(ownerCtx, t, ts, owner.Username), 3)
+
+	now := time.Now().Add(time.Minute)
+	require.NoError(t, ts.Service.DispatchNotificationDigests(ctx, storepb.NotificationUserSetting_DAILY_DIGEST, now))
+	require.Empty(t, *sentMessages)
+	require.NoError(t, ts.Service.DispatchNotificationDigests(ctx, storepb.NotificationUserSetting_HOURLY_DIGEST, now))
+	require.Len(t, *sentMessages, 1)
+	message := (*sentMessages)[0]
+	require.True(t, message.IsHTML)
+	require.Equal(t, []string{owner.Email}, message.To)
+	require.Equal(t, "[Memos] Your hourly digest: 2 new notifications", message.Subject)
+	require.Contains(t, message.Body, "Hi digest-owner,")
+	require.Contains(t, message.Body, "digest-commenter commented on your memo.")
+	require.Contains(t, message.Body, fmt.Sprintf("http://localhost:8080/%s#", memo.Name))
+	require.NotContains(t, message.Body, "reacted")
+	require.NotContains(t, message.Body, "First comment")
+
+	// Notifications are only included in one digest.
+	require.NoError(t, ts.Service.DispatchNotificationDigests(ctx, storepb.NotificationUserSetting_HOURLY_DIGEST, now.Add(time.Hour)))
+	require.Len(t, *sentMessages, 1)
+
+	// The digest cursor is kept when preferences change.
+	_, err = ts.Service.UpdateUserSetting(ownerCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: fmt.Sprintf("users/%s/settings/NOTIFICATION", owner.Username),
+			Value: &apiv1.UserSetting_NotificationSetting_{NotificationSetting: &apiv1.UserSetting_NotificationSetting{
+				Preferences: []*apiv1.UserSetting_NotificationSetting_Preference{
+					{Type: apiv1.UserNotification_MEMO_COMMENT, EmailDelivery: apiv1.UserSetting_NotificationSetting_HOURLY_DIGEST},
+				},
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"preferences"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.Service.DispatchNotificationDigests(ctx, storepb.NotificationUserSetting_HOURLY_DIGEST, now.Add(2*time.Hour)))
+	require.Len(t, *sentMessages, 1)
+}
+
+func TestUpdateNotificationSettingRejectsInvalidPreferences(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "digest-invalid")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	settingName := fmt.Sprintf("users/%s/settings/NOTIFICATION", user.Username)
+
+	setting, err := ts.Service.GetUserSetting(userCtx, &apiv1.GetUserSettingRequest{Name: settingName})
+	require.NoError(t, err)
+	require.Empty(t, setting.GetNotificationSetting().Preferences)
+
+	for _, preferences := range [][]*apiv1.UserSetting_NotificationSetting_Preference{
+		{{Type: apiv1.UserNotification_TYPE_UNSPECIFIED, EmailDelivery: apiv1.UserSetting_NotificationSetting_OFF}},
+		{{Type: apiv1.UserNotification_MEMO_COMMENT, EmailDelivery: apiv1.UserSetting_NotificationSetting_EMAIL_DELIVERY_UNSPECIFIED}},
+		{
+			{Type: apiv1.UserNotification_MEMO_COMMENT, EmailDelivery: apiv1.UserSetting_NotificationSetting_OFF},
+			{Type: apiv1.UserNotification_MEMO_COMMENT, EmailDelivery: apiv1.UserSetting_NotificationSetting_DAILY_DIGEST},
+		},
+	} {
+		_, err = ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+			Setting: &apiv1.UserSetting{
+				Name: settingName,
+				Value: &apiv1.UserSetting_NotificationSetting_{NotificationSetting: &apiv1.UserSetting_NotificationSetting{
+					Preferences: preferences,
+				}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"preferences"}},
+		})
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,80 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestWeeklyRecapEmail(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	sentMessages := enableNotificationEmail(ctx, t, ts)
+
+	user, err := ts.CreateRegularUser(ctx, "recap-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "recap-other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	now := time.Now().UTC()
+	createMemo := func(memoCtx context.Context, content string, createdTs int64) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(memoCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		uid := strings.TrimPrefix(memo.Name, "memos/")
+		storeMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+		require.NoError(t, err)
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: storeMemo.ID, CreatedTs: &createdTs, UpdatedTs: &createdTs}))
+		return memo
+	}
+	anniversary := createMemo(userCtx, "# Trip\n\nHiked the **ridge** trail at dawn.", now.AddDate(-2, 0, 0).Unix())
+	openTasks := createMemo(userCtx, "Groceries\n\n- [ ] buy oat milk\n- [x] buy bread", now.AddDate(0, -1, 0).Unix())
+	createMemo(userCtx, "Chores\n\n- [x] water the plants", now.Add(-time.Hour).Unix())
+	createMemo(userCtx, "A quiet week", now.Add(-2*time.Hour).Unix())
+	createMemo(otherCtx, "Someone else's memo\n\n- [ ] not mine", now.AddDate(-1, 0, 0).Unix())
+
+	// The recap is opt-in.
+	require.NoError(t, ts.Service.DispatchWeeklyRecaps(ctx, now))
+	require.Empty(t, *sentMessages)
+
+	setting, err := ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: fmt.Sprintf("users/%s/settings/NOTIFICATION", user.Username),
+			Value: &apiv1.UserSetting_NotificationSetting_{NotificationSetting: &apiv1.UserSetting_NotificationSetting{
+				WeeklyRecap: true,
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"weekly_recap"}},
+	})
+	require.NoError(t, err)
+	require.True(t, setting.GetNotificationSetting().WeeklyRecap)
+
+	require.NoError(t, ts.Service.DispatchWeeklyRecaps(ctx, now))
+	require.Len(t, *sentMessages, 1)
+	message := (*sentMessages)[0]
+	require.True(t, message.IsHTML)
+	require.Equal(t, []string{user.Email}, message.To)
+	require.Equal(t, "[Memos] Your weekly recap", message.Subject)
+	require.Contains(t, message.Body, "Hi recap-user,")
+	require.Contains(t, message.Body, "This week you created 2 memos and completed the tasks of 1 memo.")
+	// Snippets are plain text.
+	require.Contains(t, message.Body, "Trip Hiked the ridge trail at dawn.")
+	require.Contains(t, message.Body, "2 years ago")
+	require.Contains(t, message.Body, "http://localhost:8080/"+anniversary.Name)
+	require.Contains(t, message.Body, "buy oat milk")
+	require.Contains(t, message.Body, "http://localhost:8080/"+openTasks.Name)
+	require.NotContains(t, message.Body, "not mine")
+}
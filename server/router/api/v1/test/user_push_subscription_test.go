@@ -0,0 +1,262 @@
+package test
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// pushServiceStandIn is a local push service that decrypts messages like the subscribed browser.
+type pushServiceStandIn struct {
+	server     *httptest.Server
+	privateKey *ecdh.PrivateKey
+	authSecret []byte
+	// statusCode is the status the push service responds with.
+	statusCode atomic.Int32
+	messages   chan pushMessage
+}
+
+type pushMessage struct {
+	Authorization string
+	Payload       map[string]string
+}
+
+func newPushServiceStandIn(t *testing.T) *pushServiceStandIn {
+	t.Helper()
+	privateKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	authSecret := make([]byte, 16)
+	_, err = rand.Read(authSecret)
+	require.NoError(t, err)
+
+	p := &pushServiceStandIn{
+		privateKey: privateKey,
+		authSecret: authSecret,
+		messages:   make(chan pushMessage, 8),
+	}
+	p.statusCode.Store(http.StatusCreated)
+	p.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		message := pushMessage{Authorization: r.Header.Get("Authorization")}
+		if plaintext, err := p.decrypt(body); err == nil {
+			_ = json.Unmarshal(plaintext, &message.Payload)
+		}
+		w.WriteHeader(int(p.statusCode.Load()))
+		p.messages <- message
+	}))
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+func (p *pushServiceStandIn) subscription(description string) *apiv1.UserPushSubscription {
+	return &apiv1.UserPushSubscription{
+		Endpoint:    p.server.URL + "/push/" + description,
+		P256DhKey:   base64.RawURLEncoding.EncodeToString(p.privateKey.PublicKey().Bytes()),
+		AuthKey:     base64.RawURLEncoding.EncodeToString(p.authSecret),
+		Description: description,
+	}
+}
+
+// decrypt decrypts an aes128gcm message per RFC 8291.
+func (p *pushServiceStandIn) decrypt(body []byte) ([]byte, error) {
+	if len(body) < 21+65 || body[20] != 65 {
+		return nil, fmt.Errorf("invalid aes128gcm header")
+	}
+	salt, serverPublicBytes, ciphertext := body[:16], body[21:86], body[86:]
+	serverPublicKey, err := ecdh.P256().NewPublicKey(serverPublicBytes)
+	if err != nil {
+		return nil, err
+	}
+	ecdhSecret, err := p.privateKey.ECDH(serverPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	keyInfo := "WebPush: info\x00" + string(p.privateKey.PublicKey().Bytes()) + string(serverPublicBytes)
+	ikm, err := hkdf.Key(sha256.New, ecdhSecret, p.authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	contentKey, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(contentKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	record, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, err
+	}
+	return record[:len(record)-1], nil
+}
+
+func (p *pushServiceStandIn) nextMessage(t *testing.T) pushMessage {
+	t.Helper()
+	select {
+	case message := <-p.messages:
+		return message
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for push message")
+		return pushMessage{}
+	}
+}
+
+func TestWebPushNotificationIsDeliveredToSubscribedDevice(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	pushService := newPushServiceStandIn(t)
+	ts.Service.WebPushClient = pushService.server.Client()
+
+	// The instance URL is not https, so push services are given the admin email as contact.
+	_, err := ts.CreateHostUser(ctx, "push-admin")
+	require.NoError(t, err)
+	owner, err := ts.CreateRegularUser(ctx, "push-owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	commenter, err := ts.CreateRegularUser(ctx, "push-commenter")
+	require.NoError(t, err)
+	commenterCtx := ts.CreateUserContext(ctx, commenter.ID)
+	parent := fmt.Sprintf("users/%s", owner.Username)
+
+	listResponse, err := ts.Service.ListUserPushSubscriptions(ownerCtx, &apiv1.ListUserPushSubscriptionsRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Empty(t, listResponse.PushSubscriptions)
+	vapidPublicKey := listResponse.VapidPublicKey
+	require.NotEmpty(t, vapidPublicKey)
+
+	subscription, err := ts.Service.CreateUserPushSubscription(ownerCtx, &apiv1.CreateUserPushSubscriptionRequest{
+		Parent:           parent,
+		PushSubscription: pushService.subscription("laptop"),
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(subscription.Name, parent+"/pushSubscriptions/"))
+	require.Empty(t, subscription.P256DhKey)
+	require.Empty(t, subscription.AuthKey)
+
+	// The VAPID keys are generated once.
+	listResponse, err = ts.Service.ListUserPushSubscriptions(ownerCtx, &apiv1.ListUserPushSubscriptionsRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Equal(t, vapidPublicKey, listResponse.VapidPublicKey)
+	require.Len(t, listResponse.PushSubscriptions, 1)
+	require.Equal(t, "laptop", listResponse.PushSubscriptions[0].Description)
+
+	memo, err := ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Push memo", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	comment, err := ts.Service.CreateMemoComment(commenterCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &apiv1.Memo{Content: "Push comment", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	message := pushService.nextMessage(t)
+	require.True(t, strings.HasPrefix(message.Authorization, "vapid t="))
+	require.True(t, strings.HasSuffix(message.Authorization, ", k="+vapidPublicKey))
+	claims := jwt.MapClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(strings.TrimSuffix(strings.TrimPrefix(message.Authorization, "vapid t="), ", k="+vapidPublicKey), claims)
+	require.NoError(t, err)
+	require.Equal(t, "mailto:push-admin@example.com", claims["sub"])
+	require.Equal(t, map[string]string{
+		"title": "push-commenter commented on your memo",
+		"body":  "push-commenter commented on your memo.",
+		"url":   fmt.Sprintf("http://localhost:8080/%s#%s", memo.Name, strings.TrimPrefix(comment.Name, "memos/")),
+	}, message.Payload)
+
+	// An expired subscription is removed when the push service reports it gone.
+	pushService.statusCode.Store(http.StatusGone)
+	_, err = ts.Service.CreateMemoComment(commenterCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &apiv1.Memo{Content: "Another comment", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	pushService.nextMessage(t)
+	require.Eventually(t, func() bool {
+		subscriptions, err := ts.Store.GetUserWebPushSubscriptions(ctx, owner.ID)
+		return err == nil && len(subscriptions) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestUserPushSubscriptionManagement(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	pushService := newPushServiceStandIn(t)
+	user, err := ts.CreateRegularUser(ctx, "push-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "push-other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	parent := fmt.Sprintf("users/%s", user.Username)
+
+	insecure := pushService.subscription("phone")
+	insecure.Endpoint = strings.Replace(insecure.Endpoint, "https://", "http://", 1)
+	_, err = ts.Service.CreateUserPushSubscription(userCtx, &apiv1.CreateUserPushSubscriptionRequest{Parent: parent, PushSubscription: insecure})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	withoutKeys := pushService.subscription("phone")
+	withoutKeys.AuthKey = ""
+	_, err = ts.Service.CreateUserPushSubscription(userCtx, &apiv1.CreateUserPushSubscriptionRequest{Parent: parent, PushSubscription: withoutKeys})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Other users cannot manage the subscriptions.
+	_, err = ts.Service.CreateUserPushSubscription(otherCtx, &apiv1.CreateUserPushSubscriptionRequest{Parent: parent, PushSubscription: pushService.subscription("phone")})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.ListUserPushSubscriptions(otherCtx, &apiv1.ListUserPushSubscriptionsRequest{Parent: parent})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Subscribing the same endpoint again replaces the subscription.
+	_, err = ts.Service.CreateUserPushSubscription(userCtx, &apiv1.CreateUserPushSubscriptionRequest{Parent: parent, PushSubscription: pushService.subscription("phone")})
+	require.NoError(t, err)
+	subscription, err := ts.Service.CreateUserPushSubscription(userCtx, &apiv1.CreateUserPushSubscriptionRequest{Parent: parent, PushSubscription: pushService.subscription("phone")})
+	require.NoError(t, err)
+	listResponse, err := ts.Service.ListUserPushSubscriptions(userCtx, &apiv1.ListUserPushSubscriptionsRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Len(t, listResponse.PushSubscriptions, 1)
+	require.Equal(t, subscription.Name, listResponse.PushSubscriptions[0].Name)
+
+	_, err = ts.Service.DeleteUserPushSubscription(otherCtx, &apiv1.DeleteUserPushSubscriptionRequest{Name: subscription.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DeleteUserPushSubscription(userCtx, &apiv1.DeleteUserPushSubscriptionRequest{Name: subscription.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteUserPushSubscription(userCtx, &apiv1.DeleteUserPushSubscriptionRequest{Name: subscription.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// The VAPID keys are not exposed as an instance setting.
+	_, err = ts.Service.GetInstanceSetting(userCtx, &apiv1.GetInstanceSettingRequest{Name: "instance/settings/WEB_PUSH"})
+	require.Error(t, err)
+}
@@ -0,0 +1,214 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func addTestingSession(ctx context.Context, t *testing.T, ts *TestService, userID int32, tokenID string, lastSeenAt time.Time) {
+	t.Helper()
+	err := ts.Store.AddUserRefreshToken(ctx, userID, &storepb.RefreshTokensUserSetting_RefreshToken{
+		TokenId:    tokenID,
+		ExpiresAt:  timestamppb.New(time.Now().Add(auth.RefreshTokenDuration)),
+		CreatedAt:  timestamppb.New(lastSeenAt),
+		LastSeenAt: timestamppb.New(lastSeenAt),
+		LastSeenIp: "203.0.113.1",
+		ClientInfo: &storepb.RefreshTokensUserSetting_ClientInfo{
+			UserAgent: "test-agent",
+			Browser:   "Chrome 119.0",
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestListSessions(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	other, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+
+	addTestingSession(ctx, t, ts, user.ID, "older", time.Now().Add(-2*time.Hour))
+	addTestingSession(ctx, t, ts, user.ID, "newer", time.Now().Add(-time.Minute))
+	require.NoError(t, ts.Store.AddUserRefreshToken(ctx, user.ID, &storepb.RefreshTokensUserSetting_RefreshToken{
+		TokenId:   "expired",
+		ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour)),
+		CreatedAt: timestamppb.New(time.Now().Add(-48 * time.Hour)),
+	}))
+
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	response, err := ts.Service.ListSessions(userCtx, &v1pb.ListSessionsRequest{Parent: apiv1.BuildUserName(user.Username)})
+	require.NoError(t, err)
+	require.Len(t, response.Sessions, 2)
+	require.Equal(t, "users/alice/sessions/newer", response.Sessions[0].Name)
+	require.Equal(t, "users/alice/sessions/older", response.Sessions[1].Name)
+	require.Equal(t, "203.0.113.1", response.Sessions[0].LastSeenIp)
+	require.Equal(t, "Chrome 119.0", response.Sessions[0].ClientInfo.Browser)
+
+	// Other regular users cannot list the sessions.
+	_, err = ts.Service.ListSessions(ts.CreateUserContext(ctx, other.ID), &v1pb.ListSessionsRequest{Parent: apiv1.BuildUserName(user.Username)})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestListSessionsMarksCurrentSession(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	addTestingSession(ctx, t, ts, user.ID, "current", time.Now())
+	addTestingSession(ctx, t, ts, user.ID, "other", time.Now().Add(-time.Hour))
+
+	refreshToken, _, err := auth.GenerateRefreshToken(user.ID, "current", []byte(ts.Secret))
+	require.NoError(t, err)
+	requestCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("cookie", auth.RefreshTokenCookieName+"="+refreshToken))
+
+	response, err := ts.Service.ListSessions(ts.CreateUserContext(requestCtx, user.ID), &v1pb.ListSessionsRequest{Parent: apiv1.BuildUserName(user.Username)})
+	require.NoError(t, err)
+	require.Len(t, response.Sessions, 2)
+	require.True(t, response.Sessions[0].Current)
+	require.False(t, response.Sessions[1].Current)
+}
+
+func TestRevokeSession(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	other, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	addTestingSession(ctx, t, ts, user.ID, "session-1", time.Now())
+	addTestingSession(ctx, t, ts, user.ID, "session-2", time.Now())
+
+	// Other users cannot revoke the session.
+	_, err = ts.Service.RevokeSession(ts.CreateUserContext(ctx, other.ID), &v1pb.RevokeSessionRequest{Name: "users/alice/sessions/session-1"})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	userCtx := ts.CreateUserContext(apiv1.WithHeaderCarrier(ctx), user.ID)
+	_, err = ts.Service.RevokeSession(userCtx, &v1pb.RevokeSessionRequest{Name: "users/alice/sessions/session-1"})
+	require.NoError(t, err)
+
+	tokens, err := ts.Store.GetUserRefreshTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, "session-2", tokens[0].TokenId)
+
+	_, err = ts.Service.RevokeSession(userCtx, &v1pb.RevokeSessionRequest{Name: "users/alice/sessions/session-1"})
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = ts.Service.RevokeSession(userCtx, &v1pb.RevokeSessionRequest{Name: "users/alice/tokens/session-2"})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRevokeAllSessions(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	other, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+
+	addTestingSession(ctx, t, ts, user.ID, "session-1", time.Now())
+	addTestingSession(ctx, t, ts, user.ID, "session-2", time.Now())
+	require.NoError(t, ts.Store.AddUserPersonalAccessToken(ctx, user.ID, &storepb.PersonalAccessTokensUserSetting_PersonalAccessToken{
+		TokenId:   util.GenUUID(),
+		TokenHash: auth.HashPersonalAccessToken(auth.GeneratePersonalAccessToken()),
+		CreatedAt: timestamppb.Now(),
+	}))
+
+	// Regular users cannot revoke other users' sessions.
+	_, err = ts.Service.RevokeAllSessions(ts.CreateUserContext(ctx, other.ID), &v1pb.RevokeAllSessionsRequest{Parent: apiv1.BuildUserName(user.Username)})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Signing out everywhere keeps personal access tokens by default.
+	userCtx := ts.CreateUserContext(apiv1.WithHeaderCarrier(ctx), user.ID)
+	_, err = ts.Service.RevokeAllSessions(userCtx, &v1pb.RevokeAllSessionsRequest{Parent: apiv1.BuildUserName(user.Username)})
+	require.NoError(t, err)
+
+	sessions, err := ts.Store.GetUserRefreshTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+	pats, err := ts.Store.GetUserPersonalAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, pats, 1)
+
+	// Admins can revoke sessions and personal access tokens of other users.
+	addTestingSession(ctx, t, ts, user.ID, "session-3", time.Now())
+	adminCtx := ts.CreateUserContext(apiv1.WithHeaderCarrier(ctx), admin.ID)
+	_, err = ts.Service.RevokeAllSessions(adminCtx, &v1pb.RevokeAllSessionsRequest{
+		Parent:                      apiv1.BuildUserName(user.Username),
+		IncludePersonalAccessTokens: true,
+	})
+	require.NoError(t, err)
+
+	sessions, err = ts.Store.GetUserRefreshTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+	pats, err = ts.Store.GetUserPersonalAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, pats)
+}
+
+func TestRefreshTokenRecordsLastSeen(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	createdAt := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	require.NoError(t, ts.Store.AddUserRefreshToken(ctx, user.ID, &storepb.RefreshTokensUserSetting_RefreshToken{
+		TokenId:   "original",
+		ExpiresAt: timestamppb.New(time.Now().Add(auth.RefreshTokenDuration)),
+		CreatedAt: timestamppb.New(createdAt),
+		ClientInfo: &storepb.RefreshTokensUserSetting_ClientInfo{
+			IpAddress: "198.51.100.1",
+		},
+	}))
+
+	refreshToken, _, err := auth.GenerateRefreshToken(user.ID, "original", []byte(ts.Secret))
+	require.NoError(t, err)
+	requestCtx := metadata.NewIncomingContext(apiv1.WithHeaderCarrier(ctx), metadata.Pairs(
+		"cookie", auth.RefreshTokenCookieName+"="+refreshToken,
+		"x-forwarded-for", "203.0.113.7",
+	))
+	_, err = ts.Service.RefreshToken(requestCtx, &v1pb.RefreshTokenRequest{})
+	require.NoError(t, err)
+
+	tokens, err := ts.Store.GetUserRefreshTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.NotEqual(t, "original", tokens[0].TokenId)
+	require.Equal(t, createdAt.Unix(), tokens[0].CreatedAt.AsTime().Unix())
+	require.Equal(t, "198.51.100.1", tokens[0].ClientInfo.IpAddress)
+	require.Equal(t, "203.0.113.7", tokens[0].LastSeenIp)
+	require.WithinDuration(t, time.Now(), tokens[0].LastSeenAt.AsTime(), time.Minute)
+}
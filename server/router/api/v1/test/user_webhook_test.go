@@ -0,0 +1,99 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestUserWebhookSecret(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "webhook-owner")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	created, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + user.Username,
+		Webhook: &v1pb.UserWebhook{Url: "https://93.184.215.14/hook", DisplayName: "Example"},
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(created.Secret, "whsec_"))
+
+	// Other updates keep the secret.
+	updated, err := ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+		Webhook:    &v1pb.UserWebhook{Name: created.Name, DisplayName: "Renamed", Secret: "ignored"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Renamed", updated.DisplayName)
+	require.Equal(t, created.Secret, updated.Secret)
+
+	// Updating the secret path rotates it, ignoring any client-provided value.
+	rotated, err := ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+		Webhook:    &v1pb.UserWebhook{Name: created.Name, Secret: "whsec_chosen"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"secret"}},
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(rotated.Secret, "whsec_"))
+	require.NotEqual(t, created.Secret, rotated.Secret)
+	require.NotEqual(t, "whsec_chosen", rotated.Secret)
+	require.Equal(t, "Renamed", rotated.DisplayName)
+
+	webhooks, err := ts.Store.GetUserWebhooks(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	require.Equal(t, rotated.Secret, webhooks[0].Secret)
+}
+
+func TestUserWebhookFormat(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	endpoint := &fakeWebhookEndpoint{statusCode: http.StatusOK}
+	ts.Service.WebhookSender = endpoint.send
+
+	user, err := ts.CreateRegularUser(ctx, "chat-owner")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Telegram webhooks need the chat to send to.
+	_, err = ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + user.Username,
+		Webhook: &v1pb.UserWebhook{Url: "https://93.184.215.14/bot1:token/sendMessage", Format: v1pb.UserWebhook_TELEGRAM},
+	})
+	require.ErrorContains(t, err, "chat_id")
+
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + user.Username,
+		Webhook: &v1pb.UserWebhook{Url: "https://93.184.215.14/hook", Format: v1pb.UserWebhook_DISCORD},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.UserWebhook_DISCORD, hook.Format)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "#release v1 is out", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	waitForWebhookDelivery(userCtx, t, ts, hook.Name, 1)
+	body := string(endpoint.lastDelivery().Body)
+	require.Contains(t, body, `"embeds"`)
+	require.Contains(t, body, `"url":"http://localhost:8080/`+memo.Name+`"`)
+	require.Contains(t, body, "#release")
+
+	hook, err = ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+		Webhook:    &v1pb.UserWebhook{Name: hook.Name, Format: v1pb.UserWebhook_MEMOS},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"format"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.UserWebhook_MEMOS, hook.Format)
+}
@@ -0,0 +1,285 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/internal/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// fakeWebhookEndpoint records webhook deliveries and answers with a configurable status code.
+type fakeWebhookEndpoint struct {
+	mu         sync.Mutex
+	statusCode int
+	deliveries []*webhook.Delivery
+}
+
+func (e *fakeWebhookEndpoint) send(_ context.Context, delivery *webhook.Delivery) (*webhook.Result, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.deliveries = append(e.deliveries, delivery)
+	result := &webhook.Result{StatusCode: e.statusCode, Snippet: http.StatusText(e.statusCode)}
+	if e.statusCode != http.StatusOK {
+		return result, errors.Errorf("status code: %d", e.statusCode)
+	}
+	return result, nil
+}
+
+func (e *fakeWebhookEndpoint) setStatusCode(statusCode int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.statusCode = statusCode
+}
+
+func (e *fakeWebhookEndpoint) lastDelivery() *webhook.Delivery {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.deliveries[len(e.deliveries)-1]
+}
+
+// waitForWebhookDelivery waits until the latest delivery of a webhook has made attemptCount
+// attempts, since the first attempt is made in the background right after it is queued.
+func waitForWebhookDelivery(userCtx context.Context, t *testing.T, ts *TestService, webhookName string, attemptCount int32) *v1pb.WebhookDelivery {
+	t.Helper()
+	var delivery *v1pb.WebhookDelivery
+	require.Eventually(t, func() bool {
+		response, err := ts.Service.ListWebhookDeliveries(userCtx, &v1pb.ListWebhookDeliveriesRequest{Parent: webhookName})
+		require.NoError(t, err)
+		if len(response.Deliveries) == 0 {
+			return false
+		}
+		delivery = response.Deliveries[0]
+		return delivery.AttemptCount == attemptCount
+	}, 5*time.Second, 10*time.Millisecond)
+	return delivery
+}
+
+func TestWebhookDeliveryRetries(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	endpoint := &fakeWebhookEndpoint{statusCode: http.StatusInternalServerError}
+	ts.Service.WebhookSender = endpoint.send
+
+	user, err := ts.CreateRegularUser(ctx, "delivery-owner")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + user.Username,
+		Webhook: &v1pb.UserWebhook{Url: "https://93.184.215.14/hook", DisplayName: "Example"},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Hello webhooks", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	// The first attempt fails and is scheduled for a retry.
+	delivery := waitForWebhookDelivery(userCtx, t, ts, hook.Name, 1)
+	require.Equal(t, v1pb.WebhookDelivery_PENDING, delivery.State)
+	require.Equal(t, "memos.memo.created", delivery.ActivityType)
+	require.Equal(t, int32(http.StatusInternalServerError), delivery.StatusCode)
+	require.Equal(t, "Internal Server Error", delivery.ResponseSnippet)
+	require.NotNil(t, delivery.NextAttemptTime)
+	sent := endpoint.lastDelivery()
+	require.Equal(t, hook.Secret, sent.Secret)
+	require.True(t, strings.HasSuffix(delivery.Name, "/deliveries/"+sent.ID))
+	require.Contains(t, string(sent.Body), "Hello webhooks")
+
+	// Retries wait for the backoff.
+	now := time.Now()
+	require.NoError(t, ts.Service.DeliverPendingWebhooks(ctx, now))
+	delivery = waitForWebhookDelivery(userCtx, t, ts, hook.Name, 1)
+	require.NoError(t, ts.Service.DeliverPendingWebhooks(ctx, delivery.NextAttemptTime.AsTime()))
+	delivery = waitForWebhookDelivery(userCtx, t, ts, hook.Name, 2)
+	require.Equal(t, v1pb.WebhookDelivery_PENDING, delivery.State)
+	require.Equal(t, 2*time.Minute, delivery.NextAttemptTime.AsTime().Sub(delivery.LastAttemptTime.AsTime()))
+
+	// A retry keeps the delivery ID.
+	endpoint.setStatusCode(http.StatusOK)
+	require.NoError(t, ts.Service.DeliverPendingWebhooks(ctx, delivery.NextAttemptTime.AsTime()))
+	delivery = waitForWebhookDelivery(userCtx, t, ts, hook.Name, 3)
+	require.Equal(t, v1pb.WebhookDelivery_SUCCEEDED, delivery.State)
+	require.Equal(t, int32(http.StatusOK), delivery.StatusCode)
+	require.Nil(t, delivery.NextAttemptTime)
+	require.Equal(t, sent.ID, endpoint.lastDelivery().ID)
+
+	// A redelivery is sent again with the same delivery ID.
+	redelivered, err := ts.Service.RedeliverWebhook(userCtx, &v1pb.RedeliverWebhookRequest{Name: delivery.Name})
+	require.NoError(t, err)
+	require.Equal(t, delivery.Name, redelivered.Name)
+	delivery = waitForWebhookDelivery(userCtx, t, ts, hook.Name, 1)
+	require.Equal(t, v1pb.WebhookDelivery_SUCCEEDED, delivery.State)
+	require.Equal(t, sent.ID, endpoint.lastDelivery().ID)
+
+	// Other users cannot see the deliveries.
+	other, err := ts.CreateRegularUser(ctx, "delivery-stranger")
+	require.NoError(t, err)
+	_, err = ts.Service.ListWebhookDeliveries(ts.CreateUserContext(ctx, other.ID), &v1pb.ListWebhookDeliveriesRequest{Parent: hook.Name})
+	require.Error(t, err)
+	_, err = ts.Service.RedeliverWebhook(ts.CreateUserContext(ctx, other.ID), &v1pb.RedeliverWebhookRequest{Name: delivery.Name})
+	require.Error(t, err)
+
+	// Deleting the webhook deletes its deliveries.
+	_, err = ts.Service.DeleteUserWebhook(userCtx, &v1pb.DeleteUserWebhookRequest{Name: hook.Name})
+	require.NoError(t, err)
+	response, err := ts.Service.ListWebhookDeliveries(userCtx, &v1pb.ListWebhookDeliveriesRequest{Parent: hook.Name})
+	require.NoError(t, err)
+	require.Empty(t, response.Deliveries)
+}
+
+func TestWebhookDisabledAfterRepeatedFailures(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	endpoint := &fakeWebhookEndpoint{statusCode: http.StatusBadGateway}
+	ts.Service.WebhookSender = endpoint.send
+
+	user, err := ts.CreateRegularUser(ctx, "failing-owner")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + user.Username,
+		Webhook: &v1pb.UserWebhook{Url: "https://93.184.215.14/hook"},
+	})
+	require.NoError(t, err)
+
+	// Each delivery runs out of attempts; the webhook is disabled after five of them.
+	now := time.Now()
+	for i := range 5 {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Failing delivery", Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		waitForWebhookDelivery(userCtx, t, ts, hook.Name, 1)
+		for range 7 {
+			now = now.Add(3 * time.Hour)
+			require.NoError(t, ts.Service.DeliverPendingWebhooks(ctx, now))
+		}
+		delivery := waitForWebhookDelivery(userCtx, t, ts, hook.Name, 8)
+		require.Equal(t, v1pb.WebhookDelivery_FAILED, delivery.State)
+
+		webhooks, err := ts.Service.ListUserWebhooks(userCtx, &v1pb.ListUserWebhooksRequest{Parent: "users/" + user.Username})
+		require.NoError(t, err)
+		require.Equal(t, i == 4, webhooks.Webhooks[0].Disabled)
+	}
+
+	// Disabled webhooks get no deliveries and cannot be redelivered.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Not delivered", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	response, err := ts.Service.ListWebhookDeliveries(userCtx, &v1pb.ListWebhookDeliveriesRequest{Parent: hook.Name, PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, response.Deliveries, 2)
+	require.NotEmpty(t, response.NextPageToken)
+	require.Equal(t, v1pb.WebhookDelivery_FAILED, response.Deliveries[0].State)
+	_, err = ts.Service.RedeliverWebhook(userCtx, &v1pb.RedeliverWebhookRequest{Name: response.Deliveries[0].Name})
+	require.ErrorContains(t, err, "webhook is disabled")
+
+	// Re-enabling the webhook resumes deliveries.
+	updated, err := ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+		Webhook:    &v1pb.UserWebhook{Name: hook.Name, Disabled: false},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"disabled"}},
+	})
+	require.NoError(t, err)
+	require.False(t, updated.Disabled)
+	endpoint.setStatusCode(http.StatusOK)
+	_, err = ts.Service.RedeliverWebhook(userCtx, &v1pb.RedeliverWebhookRequest{Name: response.Deliveries[0].Name})
+	require.NoError(t, err)
+	delivery := waitForWebhookDelivery(userCtx, t, ts, hook.Name, 1)
+	require.Equal(t, v1pb.WebhookDelivery_SUCCEEDED, delivery.State)
+}
+
+func TestWebhookEditedDuringDeliveryIsKept(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "editing-owner")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + user.Username,
+		Webhook: &v1pb.UserWebhook{Url: "https://93.184.215.14/hook", DisplayName: "Before"},
+	})
+	require.NoError(t, err)
+
+	// The owner renames the webhook while each attempt is in flight, and every attempt fails.
+	attempts := 0
+	ts.Service.WebhookSender = func(_ context.Context, _ *webhook.Delivery) (*webhook.Result, error) {
+		attempts++
+		_, err := ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+			Webhook:    &v1pb.UserWebhook{Name: hook.Name, DisplayName: fmt.Sprintf("Attempt %d", attempts)},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+		})
+		require.NoError(t, err)
+		return &webhook.Result{StatusCode: http.StatusBadGateway}, errors.New("bad gateway")
+	}
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Edited mid-delivery", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	waitForWebhookDelivery(userCtx, t, ts, hook.Name, 1)
+	now := time.Now()
+	for range 7 {
+		now = now.Add(3 * time.Hour)
+		require.NoError(t, ts.Service.DeliverPendingWebhooks(ctx, now))
+	}
+	delivery := waitForWebhookDelivery(userCtx, t, ts, hook.Name, 8)
+	require.Equal(t, v1pb.WebhookDelivery_FAILED, delivery.State)
+
+	// Recording the failed delivery keeps the rename.
+	webhooks, err := ts.Store.GetUserWebhooks(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Attempt 8", webhooks[0].Title)
+	require.Equal(t, int32(1), webhooks[0].ConsecutiveFailures)
+}
+
+func TestWebhookDeliveryKeepsItsFormat(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	endpoint := &fakeWebhookEndpoint{statusCode: http.StatusBadGateway}
+	ts.Service.WebhookSender = endpoint.send
+	user, err := ts.CreateRegularUser(ctx, "format-owner")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + user.Username,
+		Webhook: &v1pb.UserWebhook{Url: "https://93.184.215.14/hook", DisplayName: "Format"},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Rendered as memos", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	waitForWebhookDelivery(userCtx, t, ts, hook.Name, 1)
+	require.Equal(t, webhook.FormatMemos, endpoint.lastDelivery().Format)
+
+	// A retry after the webhook switched to Slack still sends the memos body as memos.
+	_, err = ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+		Webhook:    &v1pb.UserWebhook{Name: hook.Name, Format: v1pb.UserWebhook_SLACK},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"format"}},
+	})
+	require.NoError(t, err)
+	endpoint.setStatusCode(http.StatusOK)
+	require.NoError(t, ts.Service.DeliverPendingWebhooks(ctx, time.Now().Add(time.Hour)))
+	delivery := waitForWebhookDelivery(userCtx, t, ts, hook.Name, 2)
+	require.Equal(t, v1pb.WebhookDelivery_SUCCEEDED, delivery.State)
+	require.Equal(t, webhook.FormatMemos, endpoint.lastDelivery().Format)
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/internal/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func listWebhookActivityTypes(userCtx context.Context, t *testing.T, ts *TestService, webhookName string) []string {
+	t.Helper()
+	response, err := ts.Service.ListWebhookDeliveries(userCtx, &v1pb.ListWebhookDeliveriesRequest{Parent: webhookName})
+	require.NoError(t, err)
+	activityTypes := []string{}
+	for _, delivery := range response.Deliveries {
+		activityTypes = append(activityTypes, delivery.ActivityType)
+	}
+	return activityTypes
+}
+
+func TestUserWebhookSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	endpoint := &fakeWebhookEndpoint{statusCode: http.StatusOK}
+	ts.Service.WebhookSender = endpoint.send
+
+	user, err := ts.CreateRegularUser(ctx, "subscriber")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	deployHook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent: "users/" + user.Username,
+		Webhook: &v1pb.UserWebhook{
+			Url:        "https://93.184.215.14/deploy",
+			EventTypes: []string{webhook.EventMemoUpdated},
+			Filter:     `tag in ["deploy"]`,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{webhook.EventMemoUpdated}, deployHook.EventTypes)
+	require.Equal(t, `tag in ["deploy"]`, deployHook.Filter)
+	allHook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + user.Username,
+		Webhook: &v1pb.UserWebhook{Url: "https://93.184.215.14/all"},
+	})
+	require.NoError(t, err)
+
+	deployMemo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "#deploy ship it", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	otherMemo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "#misc notes", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	for _, memo := range []*v1pb.Memo{deployMemo, otherMemo} {
+		_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: memo.Content + " again"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+	}
+	_, err = ts.Service.UpsertMemoReaction(userCtx, &v1pb.UpsertMemoReactionRequest{
+		Name:     deployMemo.Name,
+		Reaction: &v1pb.Reaction{ContentId: deployMemo.Name, ReactionType: "👍"},
+	})
+	require.NoError(t, err)
+
+	// Only the update of the memo matching the filter is delivered.
+	require.Equal(t, []string{webhook.EventMemoUpdated}, listWebhookActivityTypes(userCtx, t, ts, deployHook.Name))
+	require.Equal(t, []string{
+		webhook.EventMemoReactionUpserted,
+		webhook.EventMemoUpdated,
+		webhook.EventMemoUpdated,
+		webhook.EventMemoCreated,
+		webhook.EventMemoCreated,
+	}, listWebhookActivityTypes(userCtx, t, ts, allHook.Name))
+
+	// Subscribing to reactions delivers them with the reaction.
+	deployHook, err = ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+		Webhook:    &v1pb.UserWebhook{Name: deployHook.Name, EventTypes: []string{webhook.EventMemoReactionUpserted}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"event_types"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `tag in ["deploy"]`, deployHook.Filter)
+	_, err = ts.Service.UpsertMemoReaction(userCtx, &v1pb.UpsertMemoReactionRequest{
+		Name:     deployMemo.Name,
+		Reaction: &v1pb.Reaction{ContentId: deployMemo.Name, ReactionType: "🎉"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{webhook.EventMemoReactionUpserted, webhook.EventMemoUpdated}, listWebhookActivityTypes(userCtx, t, ts, deployHook.Name))
+	// Deliveries that did not get an immediate attempt are sent by the scheduled job.
+	require.NoError(t, ts.Service.DeliverPendingWebhooks(ctx, time.Now()))
+	delivery := waitForWebhookDelivery(userCtx, t, ts, deployHook.Name, 1)
+	endpoint.mu.Lock()
+	defer endpoint.mu.Unlock()
+	var sent *webhook.Delivery
+	for _, d := range endpoint.deliveries {
+		if strings.HasSuffix(delivery.Name, "/deliveries/"+d.ID) {
+			sent = d
+		}
+	}
+	require.NotNil(t, sent)
+	require.Contains(t, string(sent.Body), "🎉")
+}
+
+func TestUserWebhookSubscriptionValidation(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "subscription-validator")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + user.Username,
+		Webhook: &v1pb.UserWebhook{Url: "https://93.184.215.14/hook", EventTypes: []string{"memos.memo.archived"}},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported event type")
+
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + user.Username,
+		Webhook: &v1pb.UserWebhook{Url: "https://93.184.215.14/hook"},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+		Webhook:    &v1pb.UserWebhook{Name: hook.Name, Filter: "tag in"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"filter"}},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid filter")
+}
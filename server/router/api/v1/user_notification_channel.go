@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/notifier"
+	"github.com/usememos/memos/internal/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/notification"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) SendTestNotification(ctx context.Context, request *v1pb.SendTestNotificationRequest) (*emptypb.Empty, error) {
+	user, err := s.resolveUserFromName(ctx, request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if _, err := s.authorizeUserResourceAccess(ctx, user.ID, false); err != nil {
+		return nil, err
+	}
+
+	notificationSetting, err := s.Store.GetUserNotificationSetting(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get notification setting: %v", err)
+	}
+	channels := notificationSetting.Channels
+	if request.Channel != nil {
+		channel := convertNotificationChannelToStore(request.Channel)
+		preserveNotificationChannelTokens([]*storepb.NotificationUserSetting_Channel{channel}, notificationSetting.Channels)
+		if err := validateNotificationChannel(channel); err != nil {
+			return nil, err
+		}
+		channels = []*storepb.NotificationUserSetting_Channel{channel}
+	}
+	if len(channels) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no notification channels are configured")
+	}
+
+	dispatcher := notification.NewChannelDispatcher(s.Profile, s.Store, s.NotificationChannelClient)
+	if err := dispatcher.SendTestNotification(ctx, channels); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to send test notification: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// convertNotificationChannelToStore converts a notification channel, trimming its URL and token.
+func convertNotificationChannelToStore(channel *v1pb.UserSetting_NotificationSetting_Channel) *storepb.NotificationUserSetting_Channel {
+	return &storepb.NotificationUserSetting_Channel{
+		Type:  storepb.NotificationUserSetting_Channel_Type(channel.Type),
+		Url:   strings.TrimSpace(channel.Url),
+		Token: strings.TrimSpace(channel.Token),
+	}
+}
+
+// preserveNotificationChannelTokens gives each channel without a token the token of the existing
+// channel with the same type and URL.
+func preserveNotificationChannelTokens(channels, existing []*storepb.NotificationUserSetting_Channel) {
+	for _, channel := range channels {
+		if channel.Token != "" {
+			continue
+		}
+		for _, existingChannel := range existing {
+			if existingChannel.Type == channel.Type && existingChannel.Url == channel.Url {
+				channel.Token = existingChannel.Token
+				break
+			}
+		}
+	}
+}
+
+// validateNotificationChannels validates updated notification channels. Channels sent without a
+// token are validated with the token they keep from the existing channels.
+func validateNotificationChannels(channels, existing []*storepb.NotificationUserSetting_Channel) error {
+	seen := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		key := channel.Type.String() + " " + channel.Url
+		if seen[key] {
+			return status.Errorf(codes.InvalidArgument, "duplicate notification channel %s", channel.Url)
+		}
+		seen[key] = true
+
+		candidate := &storepb.NotificationUserSetting_Channel{Type: channel.Type, Url: channel.Url, Token: channel.Token}
+		preserveNotificationChannelTokens([]*storepb.NotificationUserSetting_Channel{candidate}, existing)
+		if err := validateNotificationChannel(candidate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateNotificationChannel(channel *storepb.NotificationUserSetting_Channel) error {
+	if channel.Type == storepb.NotificationUserSetting_Channel_TYPE_UNSPECIFIED {
+		return status.Errorf(codes.InvalidArgument, "notification channel type is required")
+	}
+	target := notification.ChannelTarget(channel)
+	if target.Service == "" {
+		return status.Errorf(codes.InvalidArgument, "unsupported notification channel type %d", channel.Type)
+	}
+	if err := notifier.Validate(target); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid notification channel: %v", err)
+	}
+	return webhook.ValidateURL(channel.Url)
+}
+
+// dispatchInboxChannelsBestEffort delivers the notification of an inbox entry to the receiver's
+// notification channels in the background.
+func (s *APIV1Service) dispatchInboxChannelsBestEffort(ctx context.Context, inbox *store.Inbox) {
+	dispatcher := notification.NewChannelDispatcher(s.Profile, s.Store, s.NotificationChannelClient)
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := dispatcher.DispatchInboxChannels(ctx, inbox); err != nil {
+			slog.Warn("Failed to dispatch inbox channel notifications",
+				slog.Any("err", err),
+				slog.Int64("inbox_id", int64(inbox.ID)),
+				slog.Int64("receiver_id", int64(inbox.ReceiverID)))
+		}
+	}()
+}
@@ -0,0 +1,150 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/notification"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListUserPushSubscriptions(ctx context.Context, request *v1pb.ListUserPushSubscriptionsRequest) (*v1pb.ListUserPushSubscriptionsResponse, error) {
+	user, err := s.resolveUserFromName(ctx, request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+	}
+	if _, err := s.authorizeUserResourceAccess(ctx, user.ID, false); err != nil {
+		return nil, err
+	}
+
+	keys, err := notification.GetOrCreateWebPushKeys(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get VAPID keys: %v", err)
+	}
+	subscriptions, err := s.Store.GetUserWebPushSubscriptions(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get push subscriptions: %v", err)
+	}
+
+	pushSubscriptions := make([]*v1pb.UserPushSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		pushSubscriptions = append(pushSubscriptions, convertUserPushSubscriptionFromStore(subscription, user))
+	}
+	return &v1pb.ListUserPushSubscriptionsResponse{
+		PushSubscriptions: pushSubscriptions,
+		VapidPublicKey:    keys.PublicKey,
+	}, nil
+}
+
+func (s *APIV1Service) CreateUserPushSubscription(ctx context.Context, request *v1pb.CreateUserPushSubscriptionRequest) (*v1pb.UserPushSubscription, error) {
+	user, err := s.resolveUserFromName(ctx, request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+	}
+	if _, err := s.authorizeUserResourceAccess(ctx, user.ID, false); err != nil {
+		return nil, err
+	}
+	if request.PushSubscription == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "push subscription is required")
+	}
+
+	endpoint := strings.TrimSpace(request.PushSubscription.Endpoint)
+	if parsed, err := url.Parse(endpoint); err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "push endpoint must be an https URL")
+	}
+	if request.PushSubscription.P256DhKey == "" || request.PushSubscription.AuthKey == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "p256dh and auth keys are required")
+	}
+
+	subscription := &storepb.WebPushSubscriptionsUserSetting_Subscription{
+		Id:          generateUserWebhookID(),
+		Endpoint:    endpoint,
+		P256Dh:      request.PushSubscription.P256DhKey,
+		Auth:        request.PushSubscription.AuthKey,
+		Description: request.PushSubscription.Description,
+		CreateTime:  timestamppb.Now(),
+	}
+	if err := s.Store.AddUserWebPushSubscription(ctx, user.ID, subscription); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create push subscription: %v", err)
+	}
+	return convertUserPushSubscriptionFromStore(subscription, user), nil
+}
+
+func (s *APIV1Service) DeleteUserPushSubscription(ctx context.Context, request *v1pb.DeleteUserPushSubscriptionRequest) (*emptypb.Empty, error) {
+	user, subscriptionID, err := s.resolveUserAndPushSubscriptionIDFromName(ctx, request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid push subscription name: %v", err)
+	}
+	if _, err := s.authorizeUserResourceAccess(ctx, user.ID, false); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.Store.GetUserWebPushSubscriptions(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get push subscriptions: %v", err)
+	}
+	found := false
+	for _, subscription := range subscriptions {
+		if subscription.Id == subscriptionID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "push subscription not found")
+	}
+
+	if err := s.Store.RemoveUserWebPushSubscription(ctx, user.ID, subscriptionID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete push subscription: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) resolveUserAndPushSubscriptionIDFromName(ctx context.Context, name string) (*store.User, string, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "pushSubscriptions" {
+		return nil, "", errors.New("invalid push subscription name format")
+	}
+
+	user, err := s.resolveUserFromName(ctx, BuildUserName(parts[1]))
+	if err != nil {
+		return nil, "", err
+	}
+	return user, parts[3], nil
+}
+
+// convertUserPushSubscriptionFromStore converts a push subscription, leaving out its keys.
+func convertUserPushSubscriptionFromStore(subscription *storepb.WebPushSubscriptionsUserSetting_Subscription, user *store.User) *v1pb.UserPushSubscription {
+	return &v1pb.UserPushSubscription{
+		Name:        fmt.Sprintf("%s/pushSubscriptions/%s", BuildUserName(user.Username), subscription.Id),
+		Endpoint:    subscription.Endpoint,
+		Description: subscription.Description,
+		CreateTime:  subscription.CreateTime,
+	}
+}
+
+// dispatchInboxPushBestEffort sends the push notification of an inbox entry in the background,
+// so that slow push services do not hold up the request that created it.
+func (s *APIV1Service) dispatchInboxPushBestEffort(ctx context.Context, inbox *store.Inbox) {
+	dispatcher := notification.NewWebPushDispatcher(s.Profile, s.Store, s.WebPushClient)
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := dispatcher.DispatchInboxPush(ctx, inbox); err != nil {
+			slog.Warn("Failed to dispatch inbox push notification",
+				slog.Any("err", err),
+				slog.Int64("inbox_id", int64(inbox.ID)),
+				slog.Int64("receiver_id", int64(inbox.ReceiverID)))
+		}
+	}()
+}
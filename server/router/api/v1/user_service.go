@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -38,15 +40,9 @@ func validatePassword(password string) error {
 }
 
 func (s *APIV1Service) ListUsers(ctx context.Context, request *v1pb.ListUsersRequest) (*v1pb.ListUsersResponse, error) {
-	currentUser, err := s.fetchCurrentUser(ctx)
+	currentUser, err := s.fetchUserWithPermission(ctx, store.PermissionManageUsers)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
-	}
-	if currentUser == nil {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-	if currentUser.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		return nil, err
 	}
 
 	userFind := &store.FindUser{}
@@ -157,11 +153,29 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 	}
 
 	roleToAssign := store.RoleUser
-	if currentUser != nil && currentUser.Role == store.RoleAdmin {
-		// Authenticated ADMIN user can create users with any role specified in request
+	// redeemedInvitation is the invitation consumed by this registration, which is released
+	// again if the user cannot be created.
+	var redeemedInvitation *store.Invitation
+	canManageUsers, err := s.hasPermission(ctx, currentUser, store.PermissionManageUsers)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if canManageUsers {
+		// User managers can create users directly, but only admins can assign roles.
+		if request.User.Role == v1pb.User_ADMIN || request.User.CustomRole != "" {
+			if !isSuperUser(currentUser) {
+				return nil, status.Errorf(codes.PermissionDenied, "only admins can assign roles")
+			}
+		}
 		if request.User.Role != v1pb.User_ROLE_UNSPECIFIED {
 			roleToAssign = convertUserRoleToStore(request.User.Role)
 		}
+		if request.User.CustomRole != "" && roleToAssign == store.RoleUser {
+			roleToAssign, err = s.resolveCustomRole(ctx, request.User.CustomRole)
+			if err != nil {
+				return nil, err
+			}
+		}
 	} else {
 		limitOne := 1
 		allUsers, err := s.Store.ListUsers(ctx, &store.FindUser{Limit: &limitOne})
@@ -192,18 +206,28 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 			}
 		}
 
-		// Only allow user registration if it is enabled in the settings, or if the user is a superuser
+		// Only allow user registration if it is enabled in the settings, or if the user is a superuser.
+		// A valid invitation code allows registering while registration is disabled.
 		if roleToAssign != store.RoleAdmin {
 			instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to get instance general setting, error: %v", err)
 			}
-			if instanceGeneralSetting.DisallowUserRegistration {
-				return nil, status.Errorf(codes.PermissionDenied, "user registration is not allowed")
-			}
 			if instanceGeneralSetting.DisallowPasswordAuth {
 				return nil, status.Errorf(codes.PermissionDenied, "password signup is not allowed")
 			}
+			if request.InvitationCode != "" {
+				invitation, err := s.redeemInvitation(ctx, request.InvitationCode, request.User.Email, request.ValidateOnly)
+				if err != nil {
+					return nil, err
+				}
+				roleToAssign = invitation.Role
+				if !request.ValidateOnly {
+					redeemedInvitation = invitation
+				}
+			} else if instanceGeneralSetting.DisallowUserRegistration {
+				return nil, status.Errorf(codes.PermissionDenied, "user registration is not allowed")
+			}
 		}
 	}
 
@@ -218,23 +242,38 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 		}, nil
 	}
 
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.User.Password), bcrypt.DefaultCost)
+	user, err := s.createUserWithPassword(ctx, &store.User{
+		Username: request.User.Username,
+		Role:     roleToAssign,
+		Email:    request.User.Email,
+		Nickname: request.User.DisplayName,
+	}, request.User.Password)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
+		if redeemedInvitation != nil {
+			if releaseErr := s.Store.ReleaseInvitation(ctx, redeemedInvitation.ID); releaseErr != nil {
+				slog.Error("failed to release invitation", slog.Any("error", releaseErr), slog.Int64("invitation_id", int64(redeemedInvitation.ID)))
+			}
+		}
+		return nil, err
 	}
+	s.sendEmailVerificationIfRequired(ctx, user)
+	s.DispatchUserCreatedWebhook(ctx, user)
 
-	user, err := s.Store.CreateUser(ctx, &store.User{
-		Username:     request.User.Username,
-		Role:         roleToAssign,
-		Email:        request.User.Email,
-		Nickname:     request.User.DisplayName,
-		PasswordHash: string(passwordHash),
-	})
+	return convertUserFromStore(user, user), nil
+}
+
+// createUserWithPassword hashes the password and creates the user. It returns status errors.
+func (s *APIV1Service) createUserWithPassword(ctx context.Context, create *store.User, password string) (*store.User, error) {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
+	}
+	create.PasswordHash = string(passwordHash)
+	user, err := s.Store.CreateUser(ctx, create)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
-
-	return convertUserFromStore(user, user), nil
+	return user, nil
 }
 
 func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserRequest) (*v1pb.User, error) {
@@ -262,9 +301,11 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 	if currentUser == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	// Check permission.
-	// Only allow admin or self to update user.
-	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin {
+	canManage, err := s.canManageUser(ctx, currentUser, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if !canManage {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -277,6 +318,7 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get instance general setting: %v", err)
 	}
+	var customRole *store.Role
 	for _, field := range request.UpdateMask.Paths {
 		switch field {
 		case "username":
@@ -294,6 +336,10 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 			update.Nickname = &request.User.DisplayName
 		case "email":
 			update.Email = &request.User.Email
+			if request.User.Email != user.Email {
+				emailVerified := false
+				update.EmailVerified = &emailVerified
+			}
 		case "avatar_url":
 			// Validate avatar MIME type to prevent XSS during upload
 			if request.User.AvatarUrl != "" {
@@ -318,11 +364,23 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 			update.Description = &request.User.Description
 		case "role":
 			// Only allow admin to update role.
-			if currentUser.Role != store.RoleAdmin {
+			if !isSuperUser(currentUser) {
 				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 			}
 			role := convertUserRoleToStore(request.User.Role)
 			update.Role = &role
+		case "custom_role":
+			if !isSuperUser(currentUser) {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+			}
+			role := store.RoleUser
+			if request.User.CustomRole != "" {
+				role, err = s.resolveCustomRole(ctx, request.User.CustomRole)
+				if err != nil {
+					return nil, err
+				}
+			}
+			customRole = &role
 		case "password":
 			if err := validatePassword(request.User.Password); err != nil {
 				return nil, status.Errorf(codes.InvalidArgument, "%v", err)
@@ -334,7 +392,7 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 			passwordHashStr := string(passwordHash)
 			update.PasswordHash = &passwordHashStr
 		case "state":
-			if currentUser.Role != store.RoleAdmin {
+			if currentUser.ID == userID && !isSuperUser(currentUser) {
 				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 			}
 			rowStatus := convertStateToStore(request.User.State)
@@ -343,11 +401,28 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", field)
 		}
 	}
+	// Custom roles build on the USER role, so they are applied after any role change.
+	if customRole != nil {
+		baseRole := user.Role
+		if update.Role != nil {
+			baseRole = *update.Role
+		}
+		if baseRole == store.RoleAdmin {
+			if customRole.IsCustom() {
+				return nil, status.Errorf(codes.InvalidArgument, "custom roles can only be assigned to users with the USER role")
+			}
+		} else {
+			update.Role = customRole
+		}
+	}
 
 	updatedUser, err := s.Store.UpdateUser(ctx, update)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
+	if update.EmailVerified != nil {
+		s.sendEmailVerificationIfRequired(ctx, updatedUser)
+	}
 
 	return convertUserFromStore(updatedUser, currentUser), nil
 }
@@ -368,7 +443,11 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 	if currentUser == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin {
+	canManage, err := s.canManageUser(ctx, currentUser, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if !canManage {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 	isSelfDelete := currentUser.ID == userID
@@ -379,6 +458,8 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 	}
+	s.recordAuditLog(ctx, currentUser.ID, store.AuditActionDeleteUser, BuildUserName(user.Username), "")
+	s.DispatchUserDeletedWebhook(ctx, user)
 	var attachmentCleanupErr error
 	failedAttachmentIDs := make([]int32, 0)
 	attachmentStorageSetting, attachmentStorageSettingErr := getDeleteUserAttachmentStorageSetting(ctx, s.Store, attachments)
@@ -415,6 +496,36 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 	return &emptypb.Empty{}, nil
 }
 
+// UnlockUser clears the failed sign-in attempt counter of a user, lifting any lockout.
+//
+// Authentication: Required
+// Authorization: Requires the manage users permission.
+func (s *APIV1Service) UnlockUser(ctx context.Context, request *v1pb.UnlockUserRequest) (*emptypb.Empty, error) {
+	currentUser, err := s.fetchUserWithPermission(ctx, store.PermissionManageUsers)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := ResolveUserByName(ctx, s.Store, request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	kind := store.SignInAttemptKindUsername
+	identifier := strings.ToLower(user.Username)
+	if err := s.Store.DeleteSignInAttempts(ctx, &store.DeleteSignInAttempt{
+		Kind:       &kind,
+		Identifier: &identifier,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unlock user: %v", err)
+	}
+	slog.Info("user unlocked", slog.String("username", user.Username), slog.Int("unlocked_by", int(currentUser.ID)))
+	return &emptypb.Empty{}, nil
+}
+
 func getDeleteUserAttachmentStorageSetting(ctx context.Context, stores *store.Store, attachments []*store.Attachment) (*storepb.InstanceStorageSetting, error) {
 	for _, attachment := range attachments {
 		if store.AttachmentNeedsInstanceStorageSetting(attachment) {
@@ -601,6 +712,9 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 			Locale:         generalSetting.GetLocale(),
 			Theme:          generalSetting.GetTheme(),
 		}
+		for _, notificationType := range generalSetting.GetMutedNotificationTypes() {
+			updatedGeneral.MutedNotificationTypes = append(updatedGeneral.MutedNotificationTypes, convertNotificationTypeFromStore(notificationType))
+		}
 
 		incomingGeneral := request.Setting.GetGeneralSetting()
 		if incomingGeneral == nil {
@@ -614,6 +728,13 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 				updatedGeneral.Theme = incomingGeneral.Theme
 			case "locale":
 				updatedGeneral.Locale = incomingGeneral.Locale
+			case "muted_notification_types":
+				for _, notificationType := range incomingGeneral.MutedNotificationTypes {
+					if _, ok := notificationTypesToStore[notificationType]; !ok {
+						return nil, status.Errorf(codes.InvalidArgument, "unsupported notification type %q", notificationType)
+					}
+				}
+				updatedGeneral.MutedNotificationTypes = incomingGeneral.MutedNotificationTypes
 			default:
 				// Ignore unsupported fields.
 			}
@@ -625,6 +746,57 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 				GeneralSetting: updatedGeneral,
 			},
 		}
+	case storepb.UserSetting_NOTIFICATION:
+		existingNotification, err := s.Store.GetUserNotificationSetting(ctx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get notification setting: %v", err)
+		}
+		updatedNotification := convertNotificationSettingFromStore(existingNotification)
+
+		incomingNotification := request.Setting.GetNotificationSetting()
+		if incomingNotification == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "notification setting is required")
+		}
+		for _, field := range request.UpdateMask.Paths {
+			switch field {
+			case "preferences":
+				seenTypes := make(map[v1pb.UserNotification_Type]bool, len(incomingNotification.Preferences))
+				for _, preference := range incomingNotification.Preferences {
+					if _, ok := notificationTypesToStore[preference.Type]; !ok {
+						return nil, status.Errorf(codes.InvalidArgument, "unsupported notification type %q", preference.Type)
+					}
+					if seenTypes[preference.Type] {
+						return nil, status.Errorf(codes.InvalidArgument, "duplicate preference for notification type %q", preference.Type)
+					}
+					seenTypes[preference.Type] = true
+					if _, ok := v1pb.UserSetting_NotificationSetting_EmailDelivery_name[int32(preference.EmailDelivery)]; !ok ||
+						preference.EmailDelivery == v1pb.UserSetting_NotificationSetting_EMAIL_DELIVERY_UNSPECIFIED {
+						return nil, status.Errorf(codes.InvalidArgument, "unsupported email delivery %q", preference.EmailDelivery)
+					}
+				}
+				updatedNotification.Preferences = incomingNotification.Preferences
+			case "channels":
+				channels := make([]*storepb.NotificationUserSetting_Channel, 0, len(incomingNotification.Channels))
+				for _, channel := range incomingNotification.Channels {
+					channels = append(channels, convertNotificationChannelToStore(channel))
+				}
+				if err := validateNotificationChannels(channels, existingNotification.Channels); err != nil {
+					return nil, err
+				}
+				updatedNotification.Channels = incomingNotification.Channels
+			case "weekly_recap":
+				updatedNotification.WeeklyRecap = incomingNotification.WeeklyRecap
+			default:
+				// Ignore unsupported fields.
+			}
+		}
+
+		updatedSetting = &v1pb.UserSetting{
+			Name: request.Setting.Name,
+			Value: &v1pb.UserSetting_NotificationSetting_{
+				NotificationSetting: updatedNotification,
+			},
+		}
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "setting type %s should not be updated via UpdateUserSetting", storeKey.String())
 	}
@@ -634,6 +806,24 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to convert setting: %v", err)
 	}
+	if notification := storeSetting.GetNotification(); notification != nil {
+		// Keep the digest cursors so that digests do not repeat notifications. A new cursor starts
+		// now, so the first digest does not include notifications that were already emailed.
+		existingNotification, err := s.Store.GetUserNotificationSetting(ctx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get notification setting: %v", err)
+		}
+		notification.HourlyDigestTs = existingNotification.HourlyDigestTs
+		notification.DailyDigestTs = existingNotification.DailyDigestTs
+		// Channel tokens are never returned, so keep the saved token of a channel sent without one.
+		preserveNotificationChannelTokens(notification.Channels, existingNotification.Channels)
+		if notification.HourlyDigestTs == 0 {
+			notification.HourlyDigestTs = time.Now().Unix()
+		}
+		if notification.DailyDigestTs == 0 {
+			notification.DailyDigestTs = time.Now().Unix()
+		}
+	}
 
 	// Upsert the setting
 	if _, err := s.Store.UpsertUserSetting(ctx, storeSetting); err != nil {
@@ -878,6 +1068,7 @@ func (s *APIV1Service) ListPersonalAccessTokens(ctx context.Context, request *v1
 			ExpiresAt:   token.ExpiresAt,
 			CreatedAt:   token.CreatedAt,
 			LastUsedAt:  token.LastUsedAt,
+			Scopes:      token.Scopes,
 		}
 	}
 
@@ -896,6 +1087,7 @@ func (s *APIV1Service) ListPersonalAccessTokens(ctx context.Context, request *v1
 // - SHA-256 hash stored in database
 // - Optional expiration time (can be never-expiring)
 // - User-provided description for identification
+// - Optional scopes limiting what the token can do (empty = full access)
 //
 // Security considerations:
 // - Full token is only shown ONCE (in this response)
@@ -912,10 +1104,20 @@ func (s *APIV1Service) CreatePersonalAccessToken(ctx context.Context, request *v
 	userID := user.ID
 
 	// Verify permission
-	if _, err := s.authorizeUserResourceAccess(ctx, userID, false); err != nil {
+	currentUser, err := s.authorizeUserResourceAccess(ctx, userID, false)
+	if err != nil {
 		return nil, err
 	}
 
+	scopes, err := auth.NormalizeScopes(request.Scopes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scopes: %v", err)
+	}
+	// A scoped token cannot mint a token with broader access than its own.
+	if !auth.ScopesSubset(scopes, auth.GetScopes(ctx)) {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot grant scopes beyond those of the current token")
+	}
+
 	// Generate PAT
 	tokenID := util.GenUUID()
 	token := auth.GeneratePersonalAccessToken()
@@ -932,18 +1134,26 @@ func (s *APIV1Service) CreatePersonalAccessToken(ctx context.Context, request *v
 		Description: request.Description,
 		ExpiresAt:   expiresAt,
 		CreatedAt:   timestamppb.Now(),
+		Scopes:      scopes,
 	}
 
 	if err := s.Store.AddUserPersonalAccessToken(ctx, userID, patRecord); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create access token: %v", err)
 	}
+	patName := fmt.Sprintf("%s/personalAccessTokens/%s", BuildUserName(user.Username), tokenID)
+	auditDetail := "scopes: all"
+	if len(scopes) > 0 {
+		auditDetail = "scopes: " + strings.Join(scopes, ", ")
+	}
+	s.recordAuditLog(ctx, currentUser.ID, store.AuditActionCreateAccessToken, patName, auditDetail)
 
 	return &v1pb.CreatePersonalAccessTokenResponse{
 		PersonalAccessToken: &v1pb.PersonalAccessToken{
-			Name:        fmt.Sprintf("%s/personalAccessTokens/%s", BuildUserName(user.Username), tokenID),
+			Name:        patName,
 			Description: request.Description,
 			ExpiresAt:   expiresAt,
 			CreatedAt:   patRecord.CreatedAt,
+			Scopes:      scopes,
 		},
 		Token: token, // Only returned on creation
 	}, nil
@@ -987,6 +1197,165 @@ func (s *APIV1Service) DeletePersonalAccessToken(ctx context.Context, request *v
 	return &emptypb.Empty{}, nil
 }
 
+// ListSessions returns the active sign-in sessions of a user.
+//
+// Each session is backed by a refresh token stored in user settings.
+// Expired tokens are omitted, and the session making the request is marked as current.
+//
+// Authentication: Required
+// Authorization: User can list their own sessions, admins can list any user's sessions.
+func (s *APIV1Service) ListSessions(ctx context.Context, request *v1pb.ListSessionsRequest) (*v1pb.ListSessionsResponse, error) {
+	user, err := s.resolveUserFromName(ctx, request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+
+	currentUser, err := s.authorizeUserResourceAccess(ctx, user.ID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.Store.GetUserRefreshTokens(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get sessions: %v", err)
+	}
+
+	currentTokenID := ""
+	if currentUser.ID == user.ID {
+		currentTokenID = s.currentRefreshTokenID(ctx)
+	}
+
+	now := time.Now()
+	sessions := make([]*v1pb.Session, 0, len(tokens))
+	for _, token := range tokens {
+		if token.ExpiresAt != nil && token.ExpiresAt.AsTime().Before(now) {
+			continue
+		}
+		sessions = append(sessions, convertSessionFromStore(user, token, currentTokenID))
+	}
+	slices.SortStableFunc(sessions, func(a, b *v1pb.Session) int {
+		return b.LastSeenAt.AsTime().Compare(a.LastSeenAt.AsTime())
+	})
+
+	return &v1pb.ListSessionsResponse{Sessions: sessions}, nil
+}
+
+// RevokeSession signs out a single session by removing its refresh token.
+// Access tokens already issued to the session stay valid until they expire.
+//
+// Authentication: Required
+// Authorization: User can only revoke their own sessions.
+func (s *APIV1Service) RevokeSession(ctx context.Context, request *v1pb.RevokeSessionRequest) (*emptypb.Empty, error) {
+	tokens, err := GetNameParentTokens(request.Name, UserNamePrefix, SessionNamePrefix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session name: %v", err)
+	}
+
+	user, err := s.resolveUserFromName(ctx, BuildUserName(tokens[0]))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if _, err := s.authorizeUserResourceAccess(ctx, user.ID, false); err != nil {
+		return nil, err
+	}
+
+	token, err := s.Store.GetUserRefreshTokenByID(ctx, user.ID, tokens[1])
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get session: %v", err)
+	}
+	if token == nil {
+		return nil, status.Errorf(codes.NotFound, "session not found")
+	}
+	if err := s.Store.RemoveUserRefreshToken(ctx, user.ID, token.TokenId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke session: %v", err)
+	}
+
+	if token.TokenId == s.currentRefreshTokenID(ctx) {
+		if err := s.clearAuthCookies(ctx); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to clear auth cookies: %v", err)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RevokeAllSessions signs a user out everywhere by removing all of their refresh tokens,
+// and optionally deletes all of their Personal Access Tokens.
+//
+// Authentication: Required
+// Authorization: User can revoke their own sessions, admins can revoke any user's sessions.
+func (s *APIV1Service) RevokeAllSessions(ctx context.Context, request *v1pb.RevokeAllSessionsRequest) (*emptypb.Empty, error) {
+	user, err := s.resolveUserFromName(ctx, request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+
+	currentUser, err := s.authorizeUserResourceAccess(ctx, user.ID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.RemoveAllUserRefreshTokens(ctx, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions: %v", err)
+	}
+	if request.IncludePersonalAccessTokens {
+		if err := s.Store.RemoveAllUserPersonalAccessTokens(ctx, user.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete access tokens: %v", err)
+		}
+	}
+
+	if currentUser.ID == user.ID {
+		if err := s.clearAuthCookies(ctx); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to clear auth cookies: %v", err)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// currentRefreshTokenID returns the ID of the refresh token sent with the request, if any.
+func (s *APIV1Service) currentRefreshTokenID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	cookies := md.Get("cookie")
+	if len(cookies) == 0 {
+		return ""
+	}
+	refreshToken := auth.ExtractRefreshTokenFromCookie(cookies[0])
+	if refreshToken == "" {
+		return ""
+	}
+	claims, err := auth.ParseRefreshToken(refreshToken, []byte(s.Secret))
+	if err != nil {
+		return ""
+	}
+	return claims.TokenID
+}
+
+func convertSessionFromStore(user *store.User, token *storepb.RefreshTokensUserSetting_RefreshToken, currentTokenID string) *v1pb.Session {
+	session := &v1pb.Session{
+		Name:       fmt.Sprintf("%s/%s%s", BuildUserName(user.Username), SessionNamePrefix, token.TokenId),
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt,
+		LastSeenAt: token.LastSeenAt,
+		LastSeenIp: token.LastSeenIp,
+		Current:    currentTokenID != "" && token.TokenId == currentTokenID,
+	}
+	if session.LastSeenAt == nil {
+		session.LastSeenAt = token.CreatedAt
+	}
+	if clientInfo := token.ClientInfo; clientInfo != nil {
+		session.ClientInfo = &v1pb.Session_ClientInfo{
+			UserAgent:  clientInfo.UserAgent,
+			IpAddress:  clientInfo.IpAddress,
+			DeviceType: clientInfo.DeviceType,
+			Os:         clientInfo.Os,
+			Browser:    clientInfo.Browser,
+		}
+	}
+	return session
+}
+
 func (s *APIV1Service) ListUserWebhooks(ctx context.Context, request *v1pb.ListUserWebhooksRequest) (*v1pb.ListUserWebhooksResponse, error) {
 	user, err := s.resolveUserFromName(ctx, request.Parent)
 	if err != nil {
@@ -1044,12 +1413,27 @@ func (s *APIV1Service) CreateUserWebhook(ctx context.Context, request *v1pb.Crea
 	if err := webhook.ValidateURL(strings.TrimSpace(request.Webhook.Url)); err != nil {
 		return nil, err
 	}
+	if err := s.validateWebhookSubscription(ctx, webhook.EventTypes, request.Webhook.EventTypes, request.Webhook.Filter); err != nil {
+		return nil, err
+	}
+	format, err := convertUserWebhookFormatToStore(request.Webhook.Format, strings.TrimSpace(request.Webhook.Url))
+	if err != nil {
+		return nil, err
+	}
 
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+	}
 	webhookID := generateUserWebhookID()
 	webhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    webhookID,
-		Title: request.Webhook.DisplayName,
-		Url:   strings.TrimSpace(request.Webhook.Url),
+		Id:         webhookID,
+		Title:      request.Webhook.DisplayName,
+		Url:        strings.TrimSpace(request.Webhook.Url),
+		Secret:     secret,
+		EventTypes: request.Webhook.EventTypes,
+		Filter:     request.Webhook.Filter,
+		Format:     format,
 	}
 
 	err = s.Store.AddUserWebhook(ctx, userID, webhook)
@@ -1103,9 +1487,15 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 
 	// Update the webhook
 	updatedWebhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    webhookID,
-		Title: targetWebhook.Title,
-		Url:   targetWebhook.Url,
+		Id:                  webhookID,
+		Title:               targetWebhook.Title,
+		Url:                 targetWebhook.Url,
+		Secret:              targetWebhook.Secret,
+		Disabled:            targetWebhook.Disabled,
+		ConsecutiveFailures: targetWebhook.ConsecutiveFailures,
+		EventTypes:          targetWebhook.EventTypes,
+		Filter:              targetWebhook.Filter,
+		Format:              targetWebhook.Format,
 	}
 
 	if request.UpdateMask != nil {
@@ -1121,6 +1511,34 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 				}
 			case "display_name":
 				updatedWebhook.Title = request.Webhook.DisplayName
+			case "secret":
+				// The secret is always generated by the server; updating it rotates it.
+				secret, err := webhook.GenerateSecret()
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+				}
+				updatedWebhook.Secret = secret
+			case "disabled":
+				updatedWebhook.Disabled = request.Webhook.Disabled
+				if !updatedWebhook.Disabled {
+					updatedWebhook.ConsecutiveFailures = 0
+				}
+			case "event_types":
+				if err := s.validateWebhookSubscription(ctx, webhook.EventTypes, request.Webhook.EventTypes, ""); err != nil {
+					return nil, err
+				}
+				updatedWebhook.EventTypes = request.Webhook.EventTypes
+			case "filter":
+				if err := s.validateWebhookSubscription(ctx, nil, nil, request.Webhook.Filter); err != nil {
+					return nil, err
+				}
+				updatedWebhook.Filter = request.Webhook.Filter
+			case "format":
+				format, err := convertUserWebhookFormatToStore(request.Webhook.Format, "")
+				if err != nil {
+					return nil, err
+				}
+				updatedWebhook.Format = format
 			default:
 				// Ignore unsupported fields
 			}
@@ -1137,6 +1555,10 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 		updatedWebhook.Title = request.Webhook.DisplayName
 	}
 
+	if err := webhook.ValidateFormat(webhook.Format(updatedWebhook.Format), updatedWebhook.Url); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook format: %v", err)
+	}
+
 	err = s.Store.UpdateUserWebhook(ctx, userID, updatedWebhook)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update webhook: %v", err)
@@ -1186,6 +1608,12 @@ func (s *APIV1Service) DeleteUserWebhook(ctx context.Context, request *v1pb.Dele
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
 	}
+	if _, err := s.Store.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDeliveries{
+		CreatorID: &userID,
+		WebhookID: &webhookID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook deliveries: %v", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -1199,12 +1627,74 @@ func generateUserWebhookID() string {
 	return hex.EncodeToString(b)
 }
 
+// validateWebhookSubscription checks the event types from a catalog and the memo filter
+// a webhook subscribes to.
+func (s *APIV1Service) validateWebhookSubscription(ctx context.Context, catalog, eventTypes []string, filter string) error {
+	for _, eventType := range eventTypes {
+		if !slices.Contains(catalog, eventType) {
+			return status.Errorf(codes.InvalidArgument, "unsupported event type %q", eventType)
+		}
+	}
+	if filter != "" {
+		if err := s.validateFilter(ctx, filter); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+	}
+	return nil
+}
+
+// convertUserWebhookFormatToStore converts a webhook format, and checks that the webhook
+// URL can be used with it unless url is empty.
+func convertUserWebhookFormatToStore(format v1pb.UserWebhook_Format, url string) (string, error) {
+	var storeFormat webhook.Format
+	switch format {
+	case v1pb.UserWebhook_FORMAT_UNSPECIFIED, v1pb.UserWebhook_MEMOS:
+		storeFormat = webhook.FormatMemos
+	case v1pb.UserWebhook_SLACK:
+		storeFormat = webhook.FormatSlack
+	case v1pb.UserWebhook_DISCORD:
+		storeFormat = webhook.FormatDiscord
+	case v1pb.UserWebhook_TEAMS:
+		storeFormat = webhook.FormatTeams
+	case v1pb.UserWebhook_TELEGRAM:
+		storeFormat = webhook.FormatTelegram
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "unsupported webhook format %v", format)
+	}
+	if url != "" {
+		if err := webhook.ValidateFormat(storeFormat, url); err != nil {
+			return "", status.Errorf(codes.InvalidArgument, "invalid webhook format: %v", err)
+		}
+	}
+	return string(storeFormat), nil
+}
+
+func convertUserWebhookFormatFromStore(format string) v1pb.UserWebhook_Format {
+	switch webhook.Format(format) {
+	case webhook.FormatSlack:
+		return v1pb.UserWebhook_SLACK
+	case webhook.FormatDiscord:
+		return v1pb.UserWebhook_DISCORD
+	case webhook.FormatTeams:
+		return v1pb.UserWebhook_TEAMS
+	case webhook.FormatTelegram:
+		return v1pb.UserWebhook_TELEGRAM
+	default:
+		return v1pb.UserWebhook_MEMOS
+	}
+}
+
 // convertUserWebhookFromUserSetting converts a storepb webhook to a v1pb UserWebhook.
 func convertUserWebhookFromUserSetting(webhook *storepb.WebhooksUserSetting_Webhook, user *store.User) *v1pb.UserWebhook {
 	return &v1pb.UserWebhook{
 		Name:        fmt.Sprintf("%s/webhooks/%s", BuildUserName(user.Username), webhook.Id),
 		Url:         webhook.Url,
 		DisplayName: webhook.Title,
+		Secret:      webhook.Secret,
+		Disabled:    webhook.Disabled,
+		EventTypes:  webhook.EventTypes,
+		Filter:      webhook.Filter,
+		Format:      convertUserWebhookFormatFromStore(webhook.Format),
 		// Note: create_time and update_time are not available in the user setting webhook structure
 		// This is a limitation of storing webhooks in user settings vs the dedicated webhook table
 	}
@@ -1222,8 +1712,12 @@ func convertUserFromStore(user *store.User, viewer *store.User) *v1pb.User {
 		AvatarUrl:   user.AvatarURL,
 		Description: user.Description,
 	}
+	if user.Role.IsCustom() {
+		userpb.CustomRole = RoleNamePrefix + string(user.Role)
+	}
 	if canViewerAccessUserEmail(viewer, user) {
 		userpb.Email = user.Email
+		userpb.EmailVerified = user.EmailVerified
 	}
 	// Use the avatar URL instead of raw base64 image data to reduce the response size.
 	if user.AvatarURL != "" {
@@ -1252,6 +1746,10 @@ func convertUserRoleFromStore(role store.Role) v1pb.User_Role {
 	case store.RoleUser:
 		return v1pb.User_USER
 	default:
+		// Custom roles build on the USER role.
+		if role.IsCustom() {
+			return v1pb.User_USER
+		}
 		return v1pb.User_ROLE_UNSPECIFIED
 	}
 }
@@ -1285,6 +1783,8 @@ func convertSettingKeyToStore(key string) (storepb.UserSetting_Key, error) {
 		return storepb.UserSetting_GENERAL, nil
 	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]:
 		return storepb.UserSetting_WEBHOOKS, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_NOTIFICATION)]:
+		return storepb.UserSetting_NOTIFICATION, nil
 	default:
 		return storepb.UserSetting_KEY_UNSPECIFIED, errors.Errorf("unknown setting key: %s", key)
 	}
@@ -1299,6 +1799,8 @@ func convertSettingKeyFromStore(key storepb.UserSetting_Key) string {
 		return "SHORTCUTS" // Not defined in API proto
 	case storepb.UserSetting_WEBHOOKS:
 		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]
+	case storepb.UserSetting_NOTIFICATION:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_NOTIFICATION)]
 	default:
 		return "unknown"
 	}
@@ -1324,6 +1826,10 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, user *store.
 					Webhooks: []*v1pb.UserWebhook{},
 				},
 			}
+		case storepb.UserSetting_NOTIFICATION:
+			setting.Value = &v1pb.UserSetting_NotificationSetting_{
+				NotificationSetting: convertNotificationSettingFromStore(nil),
+			}
 		default:
 			return nil
 		}
@@ -1338,12 +1844,16 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, user *store.
 	switch storeSetting.Key {
 	case storepb.UserSetting_GENERAL:
 		if general := storeSetting.GetGeneral(); general != nil {
+			generalSetting := &v1pb.UserSetting_GeneralSetting{
+				Locale:         general.Locale,
+				MemoVisibility: general.MemoVisibility,
+				Theme:          general.Theme,
+			}
+			for _, notificationType := range general.MutedNotificationTypes {
+				generalSetting.MutedNotificationTypes = append(generalSetting.MutedNotificationTypes, convertNotificationTypeFromStore(notificationType))
+			}
 			setting.Value = &v1pb.UserSetting_GeneralSetting_{
-				GeneralSetting: &v1pb.UserSetting_GeneralSetting{
-					Locale:         general.Locale,
-					MemoVisibility: general.MemoVisibility,
-					Theme:          general.Theme,
-				},
+				GeneralSetting: generalSetting,
 			}
 		} else {
 			setting.Value = &v1pb.UserSetting_GeneralSetting_{
@@ -1369,6 +1879,10 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, user *store.
 				Webhooks: apiWebhooks,
 			},
 		}
+	case storepb.UserSetting_NOTIFICATION:
+		setting.Value = &v1pb.UserSetting_NotificationSetting_{
+			NotificationSetting: convertNotificationSettingFromStore(storeSetting.GetNotification()),
+		}
 	default:
 		return nil
 	}
@@ -1376,6 +1890,28 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, user *store.
 	return setting
 }
 
+// convertNotificationSettingFromStore converts the notification preferences and channels, leaving
+// out the digest cursors that are internal to the digest job and the channel tokens.
+func convertNotificationSettingFromStore(notification *storepb.NotificationUserSetting) *v1pb.UserSetting_NotificationSetting {
+	setting := &v1pb.UserSetting_NotificationSetting{
+		Preferences: []*v1pb.UserSetting_NotificationSetting_Preference{},
+		WeeklyRecap: notification.GetWeeklyRecap(),
+	}
+	for _, preference := range notification.GetPreferences() {
+		setting.Preferences = append(setting.Preferences, &v1pb.UserSetting_NotificationSetting_Preference{
+			Type:          convertNotificationTypeFromStore(preference.Type),
+			EmailDelivery: v1pb.UserSetting_NotificationSetting_EmailDelivery(preference.EmailDelivery),
+		})
+	}
+	for _, channel := range notification.GetChannels() {
+		setting.Channels = append(setting.Channels, &v1pb.UserSetting_NotificationSetting_Channel{
+			Type: v1pb.UserSetting_NotificationSetting_Channel_Type(channel.Type),
+			Url:  channel.Url,
+		})
+	}
+	return setting
+}
+
 // convertUserSettingToStore converts API UserSetting to store UserSetting.
 func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key storepb.UserSetting_Key) (*storepb.UserSetting, error) {
 	storeSetting := &storepb.UserSetting{
@@ -1386,12 +1922,20 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 	switch key {
 	case storepb.UserSetting_GENERAL:
 		if general := apiSetting.GetGeneralSetting(); general != nil {
+			generalSetting := &storepb.GeneralUserSetting{
+				Locale:         general.Locale,
+				MemoVisibility: general.MemoVisibility,
+				Theme:          general.Theme,
+			}
+			for _, notificationType := range general.MutedNotificationTypes {
+				storeType, ok := notificationTypesToStore[notificationType]
+				if !ok {
+					return nil, errors.Errorf("unsupported notification type %q", notificationType)
+				}
+				generalSetting.MutedNotificationTypes = append(generalSetting.MutedNotificationTypes, storeType)
+			}
 			storeSetting.Value = &storepb.UserSetting_General{
-				General: &storepb.GeneralUserSetting{
-					Locale:         general.Locale,
-					MemoVisibility: general.MemoVisibility,
-					Theme:          general.Theme,
-				},
+				General: generalSetting,
 			}
 		} else {
 			return nil, errors.Errorf("general setting is required")
@@ -1415,6 +1959,29 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 		} else {
 			return nil, errors.Errorf("webhooks setting is required")
 		}
+	case storepb.UserSetting_NOTIFICATION:
+		notification := apiSetting.GetNotificationSetting()
+		if notification == nil {
+			return nil, errors.Errorf("notification setting is required")
+		}
+		notificationSetting := &storepb.NotificationUserSetting{}
+		for _, preference := range notification.Preferences {
+			storeType, ok := notificationTypesToStore[preference.Type]
+			if !ok {
+				return nil, errors.Errorf("unsupported notification type %q", preference.Type)
+			}
+			notificationSetting.Preferences = append(notificationSetting.Preferences, &storepb.NotificationUserSetting_Preference{
+				Type:          storeType,
+				EmailDelivery: storepb.NotificationUserSetting_EmailDelivery(preference.EmailDelivery),
+			})
+		}
+		for _, channel := range notification.Channels {
+			notificationSetting.Channels = append(notificationSetting.Channels, convertNotificationChannelToStore(channel))
+		}
+		notificationSetting.WeeklyRecap = notification.WeeklyRecap
+		storeSetting.Value = &storepb.UserSetting_Notification{
+			Notification: notificationSetting,
+		}
 	default:
 		return nil, errors.Errorf("unsupported setting key: %v", key)
 	}
@@ -1568,10 +2135,18 @@ func (s *APIV1Service) ListUserNotifications(ctx context.Context, request *v1pb.
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list notification memos: %v", err)
 	}
+	sharesByID, err := s.listMemoSharesByID(ctx, collectInboxMemoShareIDs(inboxes))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list notification shares: %v", err)
+	}
+	viewerScope, err := s.Store.GetMemoAccessScope(ctx, currentUser.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get viewer access scope: %v", err)
+	}
 
 	notifications := []*v1pb.UserNotification{}
 	for _, inbox := range inboxes {
-		notification, err := s.convertInboxToUserNotificationWithUsersAndMemos(inbox, currentUser, usersByID, memosByID)
+		notification, err := s.convertInboxToUserNotificationWithUsersAndMemos(inbox, currentUser, viewerScope, usersByID, memosByID, sharesByID)
 		if err != nil {
 			if status.Code(err) == codes.NotFound {
 				slog.Warn("Skipping notification with missing user",
@@ -1723,7 +2298,29 @@ func (s *APIV1Service) convertInboxToUserNotification(ctx context.Context, inbox
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list notification memos: %v", err)
 	}
-	return s.convertInboxToUserNotificationWithUsersAndMemos(inbox, viewer, usersByID, memosByID)
+	sharesByID, err := s.listMemoSharesByID(ctx, collectInboxMemoShareIDs([]*store.Inbox{inbox}))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list notification shares: %v", err)
+	}
+	viewerScope, err := s.Store.GetMemoAccessScope(ctx, viewer.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get viewer access scope: %v", err)
+	}
+	return s.convertInboxToUserNotificationWithUsersAndMemos(inbox, viewer, viewerScope, usersByID, memosByID, sharesByID)
+}
+
+// collectInboxMemoShareIDs returns the IDs of the shares referenced by share opened notifications.
+func collectInboxMemoShareIDs(inboxes []*store.Inbox) []int32 {
+	shareIDs := []int32{}
+	for _, inbox := range inboxes {
+		if inbox == nil || inbox.Message == nil || inbox.Message.Type != storepb.InboxMessage_MEMO_SHARE_OPENED {
+			continue
+		}
+		if payload := inbox.Message.GetMemoShareOpened(); payload != nil && payload.ShareId != 0 {
+			shareIDs = append(shareIDs, payload.ShareId)
+		}
+	}
+	return shareIDs
 }
 
 func collectInboxMemoIDs(inboxes []*store.Inbox) []int32 {
@@ -1743,6 +2340,31 @@ func collectInboxMemoIDs(inboxes []*store.Inbox) []int32 {
 			if payload != nil {
 				memoIDs = append(memoIDs, payload.MemoId, payload.RelatedMemoId)
 			}
+		case storepb.InboxMessage_MEMO_COLLABORATOR:
+			payload := inbox.Message.GetMemoCollaborator()
+			if payload != nil {
+				memoIDs = append(memoIDs, payload.MemoId)
+			}
+		case storepb.InboxMessage_MEMO_REMINDER:
+			payload := inbox.Message.GetMemoReminder()
+			if payload != nil {
+				memoIDs = append(memoIDs, payload.MemoId)
+			}
+		case storepb.InboxMessage_MEMO_REACTION:
+			payload := inbox.Message.GetMemoReaction()
+			if payload != nil {
+				memoIDs = append(memoIDs, payload.MemoId)
+			}
+		case storepb.InboxMessage_MEMO_THREAD_REPLY:
+			payload := inbox.Message.GetMemoThreadReply()
+			if payload != nil {
+				memoIDs = append(memoIDs, payload.MemoId, payload.RelatedMemoId)
+			}
+		case storepb.InboxMessage_MEMO_SHARE_OPENED:
+			payload := inbox.Message.GetMemoShareOpened()
+			if payload != nil {
+				memoIDs = append(memoIDs, payload.MemoId)
+			}
 		default:
 			// Ignore notification types without memo references.
 		}
@@ -1750,7 +2372,7 @@ func collectInboxMemoIDs(inboxes []*store.Inbox) []int32 {
 	return memoIDs
 }
 
-func (s *APIV1Service) convertInboxToUserNotificationWithUsersAndMemos(inbox *store.Inbox, viewer *store.User, usersByID map[int32]*store.User, memosByID map[int32]*store.Memo) (*v1pb.UserNotification, error) {
+func (s *APIV1Service) convertInboxToUserNotificationWithUsersAndMemos(inbox *store.Inbox, viewer *store.User, viewerScope *store.MemoAccessScope, usersByID map[int32]*store.User, memosByID map[int32]*store.Memo, sharesByID map[int32]*store.MemoShare) (*v1pb.UserNotification, error) {
 	receiver := usersByID[inbox.ReceiverID]
 	if receiver == nil {
 		return nil, status.Errorf(codes.NotFound, "notification receiver not found")
@@ -1782,7 +2404,7 @@ func (s *APIV1Service) convertInboxToUserNotificationWithUsersAndMemos(inbox *st
 		switch inbox.Message.Type {
 		case storepb.InboxMessage_MEMO_COMMENT:
 			notification.Type = v1pb.UserNotification_MEMO_COMMENT
-			payload, err := s.convertMemoCommentNotificationPayload(viewer, inbox.Message, memosByID)
+			payload, err := s.convertMemoCommentNotificationPayload(viewer, viewerScope, inbox.Message, memosByID)
 			if err != nil {
 				return nil, err
 			}
@@ -1793,7 +2415,7 @@ func (s *APIV1Service) convertInboxToUserNotificationWithUsersAndMemos(inbox *st
 			}
 		case storepb.InboxMessage_MEMO_MENTION:
 			notification.Type = v1pb.UserNotification_MEMO_MENTION
-			payload, err := s.convertMemoMentionNotificationPayload(viewer, inbox.Message, memosByID)
+			payload, err := s.convertMemoMentionNotificationPayload(viewer, viewerScope, inbox.Message, memosByID)
 			if err != nil {
 				return nil, err
 			}
@@ -1802,6 +2424,61 @@ func (s *APIV1Service) convertInboxToUserNotificationWithUsersAndMemos(inbox *st
 					MemoMention: payload,
 				}
 			}
+		case storepb.InboxMessage_MEMO_COLLABORATOR:
+			notification.Type = v1pb.UserNotification_MEMO_COLLABORATOR
+			payload, err := s.convertMemoCollaboratorNotificationPayload(viewer, viewerScope, inbox.Message, memosByID)
+			if err != nil {
+				return nil, err
+			}
+			if payload != nil {
+				notification.Payload = &v1pb.UserNotification_MemoCollaborator{
+					MemoCollaborator: payload,
+				}
+			}
+		case storepb.InboxMessage_MEMO_REMINDER:
+			notification.Type = v1pb.UserNotification_MEMO_REMINDER
+			payload, err := s.convertMemoReminderNotificationPayload(viewer, viewerScope, inbox.Message, memosByID)
+			if err != nil {
+				return nil, err
+			}
+			if payload != nil {
+				notification.Payload = &v1pb.UserNotification_MemoReminder{
+					MemoReminder: payload,
+				}
+			}
+		case storepb.InboxMessage_MEMO_REACTION:
+			notification.Type = v1pb.UserNotification_MEMO_REACTION
+			payload, err := s.convertMemoReactionNotificationPayload(viewer, viewerScope, inbox.Message, memosByID)
+			if err != nil {
+				return nil, err
+			}
+			if payload != nil {
+				notification.Payload = &v1pb.UserNotification_MemoReaction{
+					MemoReaction: payload,
+				}
+			}
+		case storepb.InboxMessage_MEMO_THREAD_REPLY:
+			notification.Type = v1pb.UserNotification_MEMO_THREAD_REPLY
+			payload, err := s.convertMemoThreadReplyNotificationPayload(viewer, viewerScope, inbox.Message, memosByID)
+			if err != nil {
+				return nil, err
+			}
+			if payload != nil {
+				notification.Payload = &v1pb.UserNotification_MemoThreadReply{
+					MemoThreadReply: payload,
+				}
+			}
+		case storepb.InboxMessage_MEMO_SHARE_OPENED:
+			notification.Type = v1pb.UserNotification_MEMO_SHARE_OPENED
+			payload, err := s.convertMemoShareOpenedNotificationPayload(viewer, viewerScope, inbox.Message, memosByID, sharesByID)
+			if err != nil {
+				return nil, err
+			}
+			if payload != nil {
+				notification.Payload = &v1pb.UserNotification_MemoShareOpened{
+					MemoShareOpened: payload,
+				}
+			}
 		default:
 			notification.Type = v1pb.UserNotification_TYPE_UNSPECIFIED
 		}
@@ -1810,15 +2487,17 @@ func (s *APIV1Service) convertInboxToUserNotificationWithUsersAndMemos(inbox *st
 	return notification, nil
 }
 
-func canViewerAccessMemo(viewer *store.User, memo *store.Memo) bool {
+// canViewerAccessMemo reports whether the viewer, whose group memberships and
+// collaborations are in viewerScope, can see the memo.
+func canViewerAccessMemo(viewer *store.User, viewerScope *store.MemoAccessScope, memo *store.Memo) bool {
 	if memo == nil {
 		return false
 	}
 	if viewer != nil && isSuperUser(viewer) {
 		return true
 	}
-	if memo.Visibility == store.Private {
-		return viewer != nil && viewer.ID == memo.CreatorID
+	if memo.Visibility == store.Private || memo.Visibility == store.Group {
+		return viewer != nil && (viewer.ID == memo.CreatorID || viewerScope.Includes(memo))
 	}
 	if memo.Visibility == store.Protected {
 		return viewer != nil
@@ -1838,19 +2517,19 @@ func (s *APIV1Service) memoNotificationSnippet(memo *store.Memo) (string, error)
 	return snippet, nil
 }
 
-func (s *APIV1Service) convertMemoCommentNotificationPayload(viewer *store.User, message *storepb.InboxMessage, memosByID map[int32]*store.Memo) (*v1pb.UserNotification_MemoCommentPayload, error) {
+func (s *APIV1Service) convertMemoCommentNotificationPayload(viewer *store.User, viewerScope *store.MemoAccessScope, message *storepb.InboxMessage, memosByID map[int32]*store.Memo) (*v1pb.UserNotification_MemoCommentPayload, error) {
 	memoComment := message.GetMemoComment()
 	if message == nil || message.Type != storepb.InboxMessage_MEMO_COMMENT || memoComment == nil {
 		return nil, nil
 	}
 
 	commentMemo := memosByID[memoComment.MemoId]
-	if !canViewerAccessMemo(viewer, commentMemo) {
+	if !canViewerAccessMemo(viewer, viewerScope, commentMemo) {
 		return nil, nil
 	}
 
 	relatedMemo := memosByID[memoComment.RelatedMemoId]
-	if !canViewerAccessMemo(viewer, relatedMemo) {
+	if !canViewerAccessMemo(viewer, viewerScope, relatedMemo) {
 		return nil, nil
 	}
 
@@ -1871,14 +2550,14 @@ func (s *APIV1Service) convertMemoCommentNotificationPayload(viewer *store.User,
 	}, nil
 }
 
-func (s *APIV1Service) convertMemoMentionNotificationPayload(viewer *store.User, message *storepb.InboxMessage, memosByID map[int32]*store.Memo) (*v1pb.UserNotification_MemoMentionPayload, error) {
+func (s *APIV1Service) convertMemoMentionNotificationPayload(viewer *store.User, viewerScope *store.MemoAccessScope, message *storepb.InboxMessage, memosByID map[int32]*store.Memo) (*v1pb.UserNotification_MemoMentionPayload, error) {
 	memoMention := message.GetMemoMention()
 	if message == nil || message.Type != storepb.InboxMessage_MEMO_MENTION || memoMention == nil {
 		return nil, nil
 	}
 
 	memo := memosByID[memoMention.MemoId]
-	if !canViewerAccessMemo(viewer, memo) {
+	if !canViewerAccessMemo(viewer, viewerScope, memo) {
 		return nil, nil
 	}
 
@@ -1893,7 +2572,7 @@ func (s *APIV1Service) convertMemoMentionNotificationPayload(viewer *store.User,
 	}
 	if memoMention.RelatedMemoId != 0 {
 		relatedMemo := memosByID[memoMention.RelatedMemoId]
-		if canViewerAccessMemo(viewer, relatedMemo) {
+		if canViewerAccessMemo(viewer, viewerScope, relatedMemo) {
 			payload.RelatedMemo = fmt.Sprintf("%s%s", MemoNamePrefix, relatedMemo.UID)
 			relatedMemoSnippet, err := s.memoNotificationSnippet(relatedMemo)
 			if err != nil {
@@ -1905,3 +2584,152 @@ func (s *APIV1Service) convertMemoMentionNotificationPayload(viewer *store.User,
 
 	return payload, nil
 }
+
+func (s *APIV1Service) convertMemoCollaboratorNotificationPayload(viewer *store.User, viewerScope *store.MemoAccessScope, message *storepb.InboxMessage, memosByID map[int32]*store.Memo) (*v1pb.UserNotification_MemoCollaboratorPayload, error) {
+	memoCollaborator := message.GetMemoCollaborator()
+	if message == nil || message.Type != storepb.InboxMessage_MEMO_COLLABORATOR || memoCollaborator == nil {
+		return nil, nil
+	}
+
+	// The payload is hidden once the viewer is no longer a collaborator of a restricted memo.
+	memo := memosByID[memoCollaborator.MemoId]
+	if !canViewerAccessMemo(viewer, viewerScope, memo) {
+		return nil, nil
+	}
+
+	memoSnippet, err := s.memoNotificationSnippet(memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get collaborator memo snippet")
+	}
+	return &v1pb.UserNotification_MemoCollaboratorPayload{
+		Memo:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+		MemoSnippet: memoSnippet,
+		Role:        memoCollaborator.Role,
+	}, nil
+}
+
+func (s *APIV1Service) convertMemoReminderNotificationPayload(viewer *store.User, viewerScope *store.MemoAccessScope, message *storepb.InboxMessage, memosByID map[int32]*store.Memo) (*v1pb.UserNotification_MemoReminderPayload, error) {
+	memoReminder := message.GetMemoReminder()
+	if message == nil || message.Type != storepb.InboxMessage_MEMO_REMINDER || memoReminder == nil {
+		return nil, nil
+	}
+
+	memo := memosByID[memoReminder.MemoId]
+	if !canViewerAccessMemo(viewer, viewerScope, memo) {
+		return nil, nil
+	}
+
+	memoSnippet, err := s.memoNotificationSnippet(memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reminder memo snippet")
+	}
+	payload := &v1pb.UserNotification_MemoReminderPayload{
+		Memo:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+		MemoSnippet: memoSnippet,
+	}
+	if memo.DueTs != nil {
+		payload.DueTime = timestamppb.New(time.Unix(*memo.DueTs, 0))
+	}
+	return payload, nil
+}
+
+func (s *APIV1Service) convertMemoReactionNotificationPayload(viewer *store.User, viewerScope *store.MemoAccessScope, message *storepb.InboxMessage, memosByID map[int32]*store.Memo) (*v1pb.UserNotification_MemoReactionPayload, error) {
+	memoReaction := message.GetMemoReaction()
+	if message == nil || message.Type != storepb.InboxMessage_MEMO_REACTION || memoReaction == nil {
+		return nil, nil
+	}
+
+	memo := memosByID[memoReaction.MemoId]
+	if !canViewerAccessMemo(viewer, viewerScope, memo) {
+		return nil, nil
+	}
+
+	memoSnippet, err := s.memoNotificationSnippet(memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reaction memo snippet")
+	}
+	return &v1pb.UserNotification_MemoReactionPayload{
+		Memo:         fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+		MemoSnippet:  memoSnippet,
+		ReactionType: memoReaction.ReactionType,
+	}, nil
+}
+
+func (s *APIV1Service) convertMemoThreadReplyNotificationPayload(viewer *store.User, viewerScope *store.MemoAccessScope, message *storepb.InboxMessage, memosByID map[int32]*store.Memo) (*v1pb.UserNotification_MemoThreadReplyPayload, error) {
+	memoThreadReply := message.GetMemoThreadReply()
+	if message == nil || message.Type != storepb.InboxMessage_MEMO_THREAD_REPLY || memoThreadReply == nil {
+		return nil, nil
+	}
+
+	commentMemo := memosByID[memoThreadReply.MemoId]
+	if !canViewerAccessMemo(viewer, viewerScope, commentMemo) {
+		return nil, nil
+	}
+
+	relatedMemo := memosByID[memoThreadReply.RelatedMemoId]
+	if !canViewerAccessMemo(viewer, viewerScope, relatedMemo) {
+		return nil, nil
+	}
+
+	memoSnippet, err := s.memoNotificationSnippet(commentMemo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reply memo snippet")
+	}
+	relatedMemoSnippet, err := s.memoNotificationSnippet(relatedMemo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get related memo snippet")
+	}
+
+	return &v1pb.UserNotification_MemoThreadReplyPayload{
+		Memo:               fmt.Sprintf("%s%s", MemoNamePrefix, commentMemo.UID),
+		RelatedMemo:        fmt.Sprintf("%s%s", MemoNamePrefix, relatedMemo.UID),
+		MemoSnippet:        memoSnippet,
+		RelatedMemoSnippet: relatedMemoSnippet,
+	}, nil
+}
+
+func (s *APIV1Service) convertMemoShareOpenedNotificationPayload(viewer *store.User, viewerScope *store.MemoAccessScope, message *storepb.InboxMessage, memosByID map[int32]*store.Memo, sharesByID map[int32]*store.MemoShare) (*v1pb.UserNotification_MemoShareOpenedPayload, error) {
+	memoShareOpened := message.GetMemoShareOpened()
+	if message == nil || message.Type != storepb.InboxMessage_MEMO_SHARE_OPENED || memoShareOpened == nil {
+		return nil, nil
+	}
+
+	memo := memosByID[memoShareOpened.MemoId]
+	if !canViewerAccessMemo(viewer, viewerScope, memo) {
+		return nil, nil
+	}
+
+	memoSnippet, err := s.memoNotificationSnippet(memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get shared memo snippet")
+	}
+	payload := &v1pb.UserNotification_MemoShareOpenedPayload{
+		Memo:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+		MemoSnippet: memoSnippet,
+	}
+	// The share is left out once it has been deleted.
+	if share := sharesByID[memoShareOpened.ShareId]; share != nil && share.MemoID == memo.ID {
+		payload.Share = fmt.Sprintf("%s%s/%s%s", MemoNamePrefix, memo.UID, MemoShareNamePrefix, share.UID)
+	}
+	return payload, nil
+}
+
+// notificationTypesToStore maps the notification types users can mute to inbox message types.
+var notificationTypesToStore = map[v1pb.UserNotification_Type]storepb.InboxMessage_Type{
+	v1pb.UserNotification_MEMO_COMMENT:      storepb.InboxMessage_MEMO_COMMENT,
+	v1pb.UserNotification_MEMO_MENTION:      storepb.InboxMessage_MEMO_MENTION,
+	v1pb.UserNotification_MEMO_COLLABORATOR: storepb.InboxMessage_MEMO_COLLABORATOR,
+	v1pb.UserNotification_MEMO_REMINDER:     storepb.InboxMessage_MEMO_REMINDER,
+	v1pb.UserNotification_MEMO_REACTION:     storepb.InboxMessage_MEMO_REACTION,
+	v1pb.UserNotification_MEMO_THREAD_REPLY: storepb.InboxMessage_MEMO_THREAD_REPLY,
+	v1pb.UserNotification_MEMO_SHARE_OPENED: storepb.InboxMessage_MEMO_SHARE_OPENED,
+}
+
+func convertNotificationTypeFromStore(notificationType storepb.InboxMessage_Type) v1pb.UserNotification_Type {
+	for apiType, storeType := range notificationTypesToStore {
+		if storeType == notificationType {
+			return apiType
+		}
+	}
+	return v1pb.UserNotification_TYPE_UNSPECIFIED
+}
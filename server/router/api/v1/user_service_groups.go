@@ -0,0 +1,428 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// groupIDMatcher matches user group IDs.
+var groupIDMatcher = regexp.MustCompile(`^[a-z][a-z0-9-]{0,31}$`)
+
+// ListUserGroups lists user groups. User managers see every group; other users
+// see the groups they belong to.
+func (s *APIV1Service) ListUserGroups(ctx context.Context, _ *v1pb.ListUserGroupsRequest) (*v1pb.ListUserGroupsResponse, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	canManage, err := s.hasPermission(ctx, currentUser, store.PermissionManageUsers)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+
+	find := &store.FindUserGroup{}
+	if !canManage {
+		find.MemberID = &currentUser.ID
+	}
+	groups, err := s.Store.ListUserGroups(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+	response := &v1pb.ListUserGroupsResponse{Groups: []*v1pb.UserGroup{}}
+	for _, group := range groups {
+		groupMessage, err := s.convertUserGroupFromStore(ctx, group)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+		}
+		response.Groups = append(response.Groups, groupMessage)
+	}
+	return response, nil
+}
+
+// GetUserGroup returns a user group the current user manages or belongs to.
+func (s *APIV1Service) GetUserGroup(ctx context.Context, request *v1pb.GetUserGroupRequest) (*v1pb.UserGroup, error) {
+	group, err := s.getReadableUserGroup(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	groupMessage, err := s.convertUserGroupFromStore(ctx, group)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	return groupMessage, nil
+}
+
+// CreateUserGroup creates a user group.
+// Requires the manage users permission.
+func (s *APIV1Service) CreateUserGroup(ctx context.Context, request *v1pb.CreateUserGroupRequest) (*v1pb.UserGroup, error) {
+	if _, err := s.fetchUserWithPermission(ctx, store.PermissionManageUsers); err != nil {
+		return nil, err
+	}
+	if request.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group is required")
+	}
+	if !groupIDMatcher.MatchString(request.GroupId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group_id %q: must start with a lowercase letter and contain only lowercase letters, digits and hyphens", request.GroupId)
+	}
+
+	existing, err := s.Store.GetUserGroup(ctx, &store.FindUserGroup{Name: &request.GroupId})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if existing != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "group %q already exists", request.GroupId)
+	}
+
+	group, err := s.Store.CreateUserGroup(ctx, &store.UserGroup{
+		Name:        request.GroupId,
+		Description: strings.TrimSpace(request.Group.Description),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create group: %v", err)
+	}
+	groupMessage, err := s.convertUserGroupFromStore(ctx, group)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	return groupMessage, nil
+}
+
+// UpdateUserGroup updates the description of a user group.
+// Requires the manage users permission.
+func (s *APIV1Service) UpdateUserGroup(ctx context.Context, request *v1pb.UpdateUserGroupRequest) (*v1pb.UserGroup, error) {
+	if _, err := s.fetchUserWithPermission(ctx, store.PermissionManageUsers); err != nil {
+		return nil, err
+	}
+	if request.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask is required")
+	}
+
+	group, err := s.getUserGroupByName(ctx, request.Group.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedTs := time.Now().Unix()
+	update := &store.UpdateUserGroup{
+		ID:        group.ID,
+		UpdatedTs: &updatedTs,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "description":
+			description := strings.TrimSpace(request.Group.Description)
+			update.Description = &description
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+
+	group, err = s.Store.UpdateUserGroup(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update group: %v", err)
+	}
+	groupMessage, err := s.convertUserGroupFromStore(ctx, group)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	return groupMessage, nil
+}
+
+// DeleteUserGroup deletes a user group and its memberships, and removes it from memo
+// audiences. Memos shared with the group stay visible to their creators and to members
+// of their other audience groups.
+// Requires the manage users permission.
+func (s *APIV1Service) DeleteUserGroup(ctx context.Context, request *v1pb.DeleteUserGroupRequest) (*emptypb.Empty, error) {
+	if _, err := s.fetchUserWithPermission(ctx, store.PermissionManageUsers); err != nil {
+		return nil, err
+	}
+
+	group, err := s.getUserGroupByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteUserGroup(ctx, &store.DeleteUserGroup{ID: group.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete group: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListUserGroupMembers lists the members of a group the current user manages or belongs to.
+func (s *APIV1Service) ListUserGroupMembers(ctx context.Context, request *v1pb.ListUserGroupMembersRequest) (*v1pb.ListUserGroupMembersResponse, error) {
+	group, err := s.getReadableUserGroup(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &group.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+	}
+	response := &v1pb.ListUserGroupMembersResponse{Members: []*v1pb.UserGroupMember{}}
+	for _, member := range members {
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &member.UserID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if user == nil {
+			continue
+		}
+		response.Members = append(response.Members, convertUserGroupMemberFromStore(group, user, member))
+	}
+	return response, nil
+}
+
+// AddUserGroupMember adds a user to a group.
+// Requires the manage users permission.
+func (s *APIV1Service) AddUserGroupMember(ctx context.Context, request *v1pb.AddUserGroupMemberRequest) (*v1pb.UserGroupMember, error) {
+	if _, err := s.fetchUserWithPermission(ctx, store.PermissionManageUsers); err != nil {
+		return nil, err
+	}
+
+	group, err := s.getUserGroupByName(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	user, err := ResolveUserByName(ctx, s.Store, request.User)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user %q not found", request.User)
+	}
+
+	existing, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &group.ID, UserID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+	}
+	if len(existing) > 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "user %q is already a member of group %q", user.Username, group.Name)
+	}
+
+	member, err := s.Store.CreateUserGroupMember(ctx, &store.UserGroupMember{GroupID: group.ID, UserID: user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add group member: %v", err)
+	}
+	return convertUserGroupMemberFromStore(group, user, member), nil
+}
+
+// RemoveUserGroupMember removes a user from a group.
+// Requires the manage users permission.
+func (s *APIV1Service) RemoveUserGroupMember(ctx context.Context, request *v1pb.RemoveUserGroupMemberRequest) (*emptypb.Empty, error) {
+	if _, err := s.fetchUserWithPermission(ctx, store.PermissionManageUsers); err != nil {
+		return nil, err
+	}
+
+	groupName, username, err := ExtractGroupMemberFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group member name: %v", err)
+	}
+	group, err := s.getUserGroupByName(ctx, GroupNamePrefix+groupName)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &username})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user %q not found", username)
+	}
+
+	existing, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &group.ID, UserID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+	}
+	if len(existing) == 0 {
+		return nil, status.Errorf(codes.NotFound, "user %q is not a member of group %q", username, group.Name)
+	}
+	if err := s.Store.DeleteUserGroupMember(ctx, &store.DeleteUserGroupMember{GroupID: group.ID, UserID: user.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove group member: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getReadableUserGroup returns the named group if the current user manages users or
+// belongs to it. Non-members get NotFound so that group names are not disclosed.
+func (s *APIV1Service) getReadableUserGroup(ctx context.Context, name string) (*store.UserGroup, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	group, err := s.getUserGroupByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	canManage, err := s.hasPermission(ctx, currentUser, store.PermissionManageUsers)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if canManage {
+		return group, nil
+	}
+	members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &group.ID, UserID: &currentUser.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+	}
+	if len(members) == 0 {
+		return nil, status.Errorf(codes.NotFound, "group %q not found", group.Name)
+	}
+	return group, nil
+}
+
+func (s *APIV1Service) getUserGroupByName(ctx context.Context, name string) (*store.UserGroup, error) {
+	groupName, err := ExtractGroupNameFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group name: %v", err)
+	}
+	group, err := s.Store.GetUserGroup(ctx, &store.FindUserGroup{Name: &groupName})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if group == nil {
+		return nil, status.Errorf(codes.NotFound, "group %q not found", groupName)
+	}
+	return group, nil
+}
+
+// validateMemoAudience converts a memo audience from group resource names to the
+// group names stored in the memo payload. Every group must exist, and the creator
+// must belong to each of them unless they manage users.
+func (s *APIV1Service) validateMemoAudience(ctx context.Context, creator *store.User, audience []string) ([]string, error) {
+	if len(audience) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "audience is required for GROUP visibility")
+	}
+	groupNames := []string{}
+	for _, name := range audience {
+		groupName, err := ExtractGroupNameFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid audience group %q: %v", name, err)
+		}
+		if !slices.Contains(groupNames, groupName) {
+			groupNames = append(groupNames, groupName)
+		}
+	}
+
+	groups, err := s.Store.ListUserGroups(ctx, &store.FindUserGroup{NameList: groupNames})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+	if len(groups) != len(groupNames) {
+		for _, groupName := range groupNames {
+			if !slices.ContainsFunc(groups, func(group *store.UserGroup) bool { return group.Name == groupName }) {
+				return nil, status.Errorf(codes.InvalidArgument, "group %q not found", groupName)
+			}
+		}
+	}
+
+	canManage, err := s.hasPermission(ctx, creator, store.PermissionManageUsers)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if !canManage {
+		memberships, err := s.Store.ListUserGroupNames(ctx, creator.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+		}
+		for _, groupName := range groupNames {
+			if !slices.Contains(memberships, groupName) {
+				return nil, status.Errorf(codes.PermissionDenied, "you are not a member of group %q", groupName)
+			}
+		}
+	}
+	return groupNames, nil
+}
+
+// buildMemoVisibilityFilter returns a filter matching the memos of other users that
+// the signed-in user can see: public and protected memos, GROUP memos shared
+// with any group the user belongs to, and memos the user collaborates on.
+func (s *APIV1Service) buildMemoVisibilityFilter(ctx context.Context, userID int32) (string, error) {
+	filter := `visibility in ["PUBLIC", "PROTECTED"]`
+	scope, err := s.Store.GetMemoAccessScope(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if len(scope.GroupNames) > 0 {
+		audience := make([]string, 0, len(scope.GroupNames))
+		for _, groupName := range scope.GroupNames {
+			audience = append(audience, fmt.Sprintf("%q", GroupNamePrefix+groupName))
+		}
+		filter = fmt.Sprintf(`%s || (visibility == "GROUP" && audience in [%s])`, filter, strings.Join(audience, ", "))
+	}
+	if len(scope.CollaboratorMemoIDs) > 0 {
+		memoIDs := make([]string, 0, len(scope.CollaboratorMemoIDs))
+		for _, memoID := range scope.CollaboratorMemoIDs {
+			memoIDs = append(memoIDs, strconv.Itoa(int(memoID)))
+		}
+		filter = fmt.Sprintf(`%s || id in [%s]`, filter, strings.Join(memoIDs, ", "))
+	}
+	return filter, nil
+}
+
+// listMemoAudienceUserIDs returns the IDs of the members of a GROUP memo's audience groups.
+func (s *APIV1Service) listMemoAudienceUserIDs(ctx context.Context, memo *store.Memo) ([]int32, error) {
+	if memo.Visibility != store.Group || memo.Payload == nil || len(memo.Payload.Audience) == 0 {
+		return nil, nil
+	}
+	groups, err := s.Store.ListUserGroups(ctx, &store.FindUserGroup{NameList: memo.Payload.Audience})
+	if err != nil {
+		return nil, err
+	}
+	userIDs := []int32{}
+	for _, group := range groups {
+		members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &group.ID})
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if !slices.Contains(userIDs, member.UserID) {
+				userIDs = append(userIDs, member.UserID)
+			}
+		}
+	}
+	return userIDs, nil
+}
+
+func (s *APIV1Service) convertUserGroupFromStore(ctx context.Context, group *store.UserGroup) (*v1pb.UserGroup, error) {
+	members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &group.ID})
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.UserGroup{
+		Name:        GroupNamePrefix + group.Name,
+		Description: group.Description,
+		MemberCount: int32(len(members)),
+		CreateTime:  timestamppb.New(time.Unix(group.CreatedTs, 0)),
+		UpdateTime:  timestamppb.New(time.Unix(group.UpdatedTs, 0)),
+	}, nil
+}
+
+func convertUserGroupMemberFromStore(group *store.UserGroup, user *store.User, member *store.UserGroupMember) *v1pb.UserGroupMember {
+	return &v1pb.UserGroupMember{
+		Name:       fmt.Sprintf("%s%s/%s%s", GroupNamePrefix, group.Name, GroupMemberNamePrefix, user.Username),
+		User:       BuildUserName(user.Username),
+		CreateTime: timestamppb.New(time.Unix(member.CreatedTs, 0)),
+	}
+}
@@ -82,13 +82,15 @@ func (s *APIV1Service) ListAllUserStats(ctx context.Context, request *v1pb.ListA
 		memoFind.CreatorID = &currentUser.ID
 	} else if currentUser == nil {
 		memoFind.VisibilityList = []store.Visibility{store.Public}
-	} else {
+	} else if memoFind.CreatorID == nil || *memoFind.CreatorID != currentUser.ID {
+		visibilityFilter, err := s.buildMemoVisibilityFilter(ctx, currentUser.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to build visibility filter: %v", err)
+		}
 		if memoFind.CreatorID == nil {
-			filter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
-			memoFind.Filters = append(memoFind.Filters, filter)
-		} else if *memoFind.CreatorID != currentUser.ID {
-			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+			visibilityFilter = fmt.Sprintf(`creator_id == %d || %s`, currentUser.ID, visibilityFilter)
 		}
+		memoFind.Filters = append(memoFind.Filters, visibilityFilter)
 	}
 
 	userMemoStatMap := make(map[int32]*v1pb.UserStats)
@@ -217,7 +219,11 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 	if currentUser == nil {
 		memoFind.VisibilityList = []store.Visibility{store.Public}
 	} else if currentUser.ID != userID {
-		memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+		visibilityFilter, err := s.buildMemoVisibilityFilter(ctx, currentUser.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to build visibility filter: %v", err)
+		}
+		memoFind.Filters = append(memoFind.Filters, visibilityFilter)
 	}
 
 	createdTimestamps := []*timestamppb.Timestamp{}
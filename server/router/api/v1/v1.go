@@ -11,9 +11,11 @@ import (
 	"github.com/labstack/echo/v5"
 	"github.com/labstack/echo/v5/middleware"
 	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/usememos/memos/internal/markdown"
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/internal/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	"github.com/usememos/memos/server/auth"
 	"github.com/usememos/memos/server/notification"
@@ -31,6 +33,10 @@ type APIV1Service struct {
 	v1pb.UnimplementedAIServiceServer
 	v1pb.UnimplementedShortcutServiceServer
 	v1pb.UnimplementedIdentityProviderServiceServer
+	v1pb.UnimplementedInvitationServiceServer
+	v1pb.UnimplementedOAuthServiceServer
+	v1pb.UnimplementedRoleServiceServer
+	v1pb.UnimplementedAuditServiceServer
 
 	Secret                  string
 	Profile                 *profile.Profile
@@ -38,6 +44,12 @@ type APIV1Service struct {
 	MarkdownService         markdown.Service
 	SSEHub                  *SSEHub
 	NotificationEmailSender notification.EmailSender
+	// WebhookSender sends webhook deliveries; nil means webhook.Deliver.
+	WebhookSender webhook.Sender
+	// WebPushClient sends Web Push requests; nil means webhook.SafeClient.
+	WebPushClient *http.Client
+	// NotificationChannelClient sends ntfy, Gotify and Apprise notifications; nil means webhook.SafeClient.
+	NotificationChannelClient *http.Client
 
 	// thumbnailSemaphore limits concurrent thumbnail generation to prevent memory exhaustion
 	thumbnailSemaphore       *semaphore.Weighted
@@ -51,6 +63,7 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 	markdownService := markdown.NewService(
 		markdown.WithTagExtension(),
 		markdown.WithMentionExtension(),
+		markdown.WithDueExtension(),
 	)
 	return &APIV1Service{
 		Secret:                   secret,
@@ -88,6 +101,13 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 				return
 			}
 
+			// Enforce Personal Access Token scopes. Scoped tokens are rejected when
+			// the method cannot be determined, since its required scope is unknown.
+			if result != nil && len(result.Scopes) > 0 && (!ok || !IsScopeAllowed(result, rpcMethod)) {
+				http.Error(w, `{"code": 7, "message": "token is missing required scope"}`, http.StatusForbidden)
+				return
+			}
+
 			// Apply auth result to context (no-op when result is nil for public endpoints)
 			if result != nil {
 				ctx = auth.ApplyToContext(ctx, result)
@@ -101,6 +121,21 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	// Create gRPC-Gateway mux with auth middleware.
 	gwMux := runtime.NewServeMux(
 		runtime.WithMiddlewares(gatewayAuthMiddleware),
+		// The peer address is set from the connection only, never from a client header,
+		// including one passed through with the Grpc-Metadata- prefix.
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			name := key
+			if len(name) > len(runtime.MetadataHeaderPrefix) && strings.EqualFold(name[:len(runtime.MetadataHeaderPrefix)], runtime.MetadataHeaderPrefix) {
+				name = name[len(runtime.MetadataHeaderPrefix):]
+			}
+			if strings.EqualFold(name, peerAddrMetadataKey) {
+				return "", false
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+		runtime.WithMetadata(func(_ context.Context, r *http.Request) metadata.MD {
+			return metadata.Pairs(peerAddrMetadataKey, r.RemoteAddr)
+		}),
 	)
 	if err := v1pb.RegisterInstanceServiceHandlerServer(ctx, gwMux, s); err != nil {
 		return err
@@ -126,12 +161,25 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterIdentityProviderServiceHandlerServer(ctx, gwMux, s); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterInvitationServiceHandlerServer(ctx, gwMux, s); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterOAuthServiceHandlerServer(ctx, gwMux, s); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterRoleServiceHandlerServer(ctx, gwMux, s); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterAuditServiceHandlerServer(ctx, gwMux, s); err != nil {
+		return err
+	}
 	gwGroup := echoServer.Group("")
 	gwGroup.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"},
 	}))
 	// Register SSE endpoint with same CORS as rest of /api/v1.
 	RegisterSSERoutes(gwGroup, s.SSEHub, s.Store, s.Secret)
+	s.RegisterAuditLogRoutes(gwGroup)
 	handler := echo.WrapHandler(http.MaxBytesHandler(gwMux, maxAPIRequestBytes))
 
 	gwGroup.Any("/api/v1/*", handler)
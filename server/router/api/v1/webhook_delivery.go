@@ -0,0 +1,384 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// webhookDeliveryMaxAttempts is the number of attempts made before a delivery fails.
+	webhookDeliveryMaxAttempts = 8
+	// webhookDeliveryBaseBackoff is the wait before the first retry. It doubles with each
+	// retry, so that the last attempt is made about two hours after the first one.
+	webhookDeliveryBaseBackoff = time.Minute
+	// webhookDeliveryLease is how long a claimed delivery is left to its attempt before it
+	// can be claimed again. It must be longer than the webhook request timeout.
+	webhookDeliveryLease = 2 * time.Minute
+	// webhookDeliveryRetention is how long deliveries are kept in the delivery log.
+	webhookDeliveryRetention = 30 * 24 * time.Hour
+	// webhookMaxConsecutiveFailures is the number of consecutive failed deliveries after
+	// which a webhook is disabled.
+	webhookMaxConsecutiveFailures = 5
+)
+
+// webhookDeliverySemaphore bounds the deliveries attempted right after they are queued.
+// Deliveries that find it full wait for the next run of the delivery job instead.
+var webhookDeliverySemaphore = semaphore.NewWeighted(4)
+
+// getWebhookDeliveryBackoff returns the wait before the next attempt of a delivery that
+// failed its attemptCount-th attempt.
+func getWebhookDeliveryBackoff(attemptCount int32) time.Duration {
+	return webhookDeliveryBaseBackoff << (attemptCount - 1)
+}
+
+// enqueueWebhookDelivery records a delivery of the payload to one of a user's webhooks and
+// attempts it right away in the background. Disabled webhooks get no deliveries.
+func (s *APIV1Service) enqueueWebhookDelivery(ctx context.Context, userID int32, hook *storepb.WebhooksUserSetting_Webhook, payload *webhook.WebhookRequestPayload) error {
+	if hook.Disabled {
+		return nil
+	}
+	payload.URL = hook.Url
+	payload.Format = webhook.Format(hook.Format)
+	if s.Profile != nil {
+		payload.InstanceURL = s.Profile.InstanceURL
+	}
+	delivery, err := webhook.NewDelivery(payload)
+	if err != nil {
+		return err
+	}
+	created, err := s.Store.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		UID:           delivery.ID,
+		CreatorID:     userID,
+		WebhookID:     hook.Id,
+		ActivityType:  payload.ActivityType,
+		URL:           hook.Url,
+		Payload:       string(delivery.Body),
+		Format:        string(delivery.Format),
+		Status:        store.WebhookDeliveryPending,
+		NextAttemptTs: time.Now().Unix(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create webhook delivery")
+	}
+	s.attemptWebhookDeliveryAsync(created)
+	return nil
+}
+
+func (s *APIV1Service) attemptWebhookDeliveryAsync(delivery *store.WebhookDelivery) {
+	if !webhookDeliverySemaphore.TryAcquire(1) {
+		return
+	}
+	go func() {
+		defer webhookDeliverySemaphore.Release(1)
+		if err := s.attemptWebhookDelivery(context.Background(), delivery, time.Now()); err != nil {
+			slog.Warn("Failed to attempt webhook delivery", slog.Any("err", err), slog.String("delivery", delivery.UID))
+		}
+	}()
+}
+
+// DeliverPendingWebhooks makes the next attempt of the pending webhook deliveries that are
+// due at now, and purges the deliveries older than the retention period.
+func (s *APIV1Service) DeliverPendingWebhooks(ctx context.Context, now time.Time) error {
+	pending := store.WebhookDeliveryPending
+	nowTs := now.Unix()
+	deliveries, err := s.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		Status:            &pending,
+		NextAttemptBefore: &nowTs,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list pending webhook deliveries")
+	}
+	// A failing delivery must not hold up the others.
+	for _, delivery := range deliveries {
+		if err := s.attemptWebhookDelivery(ctx, delivery, now); err != nil {
+			slog.Warn("Failed to attempt webhook delivery", slog.Any("err", err), slog.String("delivery", delivery.UID))
+		}
+	}
+
+	cutoff := now.Add(-webhookDeliveryRetention).Unix()
+	if _, err := s.Store.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDeliveries{CreatedTsBefore: &cutoff}); err != nil {
+		return errors.Wrap(err, "failed to purge webhook deliveries")
+	}
+	return nil
+}
+
+// attemptWebhookDelivery claims a pending delivery and makes one attempt at now. A failed
+// attempt is retried with exponential backoff until the delivery runs out of attempts.
+func (s *APIV1Service) attemptWebhookDelivery(ctx context.Context, delivery *store.WebhookDelivery, now time.Time) error {
+	nowTs := now.Unix()
+	claimed, err := s.Store.ClaimWebhookDelivery(ctx, delivery.ID, delivery.NextAttemptTs, now.Add(webhookDeliveryLease).Unix())
+	if err != nil {
+		return errors.Wrap(err, "failed to claim webhook delivery")
+	}
+	if !claimed {
+		return nil
+	}
+
+	hook, err := s.findWebhook(ctx, delivery.CreatorID, delivery.WebhookID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find webhook")
+	}
+	update := &store.UpdateWebhookDelivery{ID: delivery.ID}
+	if hook == nil || hook.Disabled {
+		failed := store.WebhookDeliveryFailed
+		reason := "webhook was deleted"
+		if hook != nil {
+			reason = "webhook is disabled"
+		}
+		update.Status, update.ResponseBody = &failed, &reason
+		return s.Store.UpdateWebhookDelivery(ctx, update)
+	}
+
+	// The payload is sent in the format it was rendered in, even if the webhook format changed since.
+	result, sendErr := s.sendWebhook(ctx, &webhook.Delivery{
+		ID:     delivery.UID,
+		URL:    hook.Url,
+		Secret: hook.Secret,
+		Format: webhook.Format(delivery.Format),
+		Body:   []byte(delivery.Payload),
+	})
+	attemptCount := delivery.AttemptCount + 1
+	responseCode, responseBody := int32(0), ""
+	if result != nil {
+		responseCode, responseBody = int32(result.StatusCode), result.Snippet
+	} else if sendErr != nil {
+		responseBody = sendErr.Error()
+	}
+	update.URL = &hook.Url
+	update.AttemptCount = &attemptCount
+	update.LastAttemptTs = &nowTs
+	update.ResponseCode = &responseCode
+	update.ResponseBody = &responseBody
+
+	var deliveryStatus store.WebhookDeliveryStatus
+	switch {
+	case sendErr == nil:
+		deliveryStatus = store.WebhookDeliverySucceeded
+	case attemptCount >= webhookDeliveryMaxAttempts:
+		deliveryStatus = store.WebhookDeliveryFailed
+		slog.Warn("Webhook delivery failed",
+			slog.String("url", hook.Url),
+			slog.String("delivery", delivery.UID),
+			slog.Any("err", sendErr))
+	default:
+		deliveryStatus = store.WebhookDeliveryPending
+		nextAttemptTs := now.Add(getWebhookDeliveryBackoff(attemptCount)).Unix()
+		update.NextAttemptTs = &nextAttemptTs
+	}
+	update.Status = &deliveryStatus
+	if err := s.Store.UpdateWebhookDelivery(ctx, update); err != nil {
+		return errors.Wrap(err, "failed to update webhook delivery")
+	}
+
+	if deliveryStatus != store.WebhookDeliveryPending {
+		if err := s.recordWebhookDeliveryOutcome(ctx, delivery.CreatorID, hook.Id, deliveryStatus == store.WebhookDeliverySucceeded); err != nil {
+			return errors.Wrap(err, "failed to update webhook")
+		}
+	}
+	return nil
+}
+
+// recordWebhookDeliveryOutcome counts the consecutive failed deliveries of a webhook, and
+// disables the webhook once it keeps failing.
+func (s *APIV1Service) recordWebhookDeliveryOutcome(ctx context.Context, creatorID int32, webhookID string, succeeded bool) error {
+	// Re-read the webhook, since it may have been edited while the delivery was in flight.
+	hook, err := s.findWebhook(ctx, creatorID, webhookID)
+	if err != nil || hook == nil {
+		return err
+	}
+	if succeeded {
+		if hook.ConsecutiveFailures == 0 {
+			return nil
+		}
+		hook.ConsecutiveFailures = 0
+	} else {
+		hook.ConsecutiveFailures++
+		if hook.ConsecutiveFailures >= webhookMaxConsecutiveFailures {
+			hook.Disabled = true
+			slog.Warn("Disabled webhook after repeated failed deliveries", slog.String("url", hook.Url), slog.Int64("creator_id", int64(creatorID)))
+		}
+	}
+	return s.updateWebhookHealth(ctx, creatorID, hook)
+}
+
+func (s *APIV1Service) sendWebhook(ctx context.Context, delivery *webhook.Delivery) (*webhook.Result, error) {
+	if s.WebhookSender != nil {
+		return s.WebhookSender(ctx, delivery)
+	}
+	return webhook.Deliver(ctx, delivery)
+}
+
+// findUserWebhook returns one of a user's webhooks, or nil if it does not exist.
+func (s *APIV1Service) findUserWebhook(ctx context.Context, userID int32, webhookID string) (*storepb.WebhooksUserSetting_Webhook, error) {
+	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, hook := range webhooks {
+		if hook.Id == webhookID {
+			return hook, nil
+		}
+	}
+	return nil, nil
+}
+
+// ListWebhookDeliveries lists the deliveries of a webhook, newest first.
+func (s *APIV1Service) ListWebhookDeliveries(ctx context.Context, request *v1pb.ListWebhookDeliveriesRequest) (*v1pb.ListWebhookDeliveriesResponse, error) {
+	user, webhookID, err := s.resolveUserAndWebhookIDFromName(ctx, request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook name: %v", err)
+	}
+	if err := s.checkUserWebhookAccess(ctx, user); err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = normalizePageSize(pageToken.Limit)
+		offset = max(int(pageToken.Offset), 0)
+	} else {
+		limit = normalizePageSize(request.PageSize)
+	}
+	limitPlusOne := limit + 1
+	deliveries, err := s.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		CreatorID: &user.ID,
+		WebhookID: &webhookID,
+		Limit:     &limitPlusOne,
+		Offset:    &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	response := &v1pb.ListWebhookDeliveriesResponse{Deliveries: []*v1pb.WebhookDelivery{}}
+	if len(deliveries) == limitPlusOne {
+		deliveries = deliveries[:limit]
+		response.NextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, convertWebhookDeliveryFromStore(delivery, user))
+	}
+	return response, nil
+}
+
+// RedeliverWebhook queues a delivery to be sent again, with the same delivery ID and a
+// fresh set of attempts, and attempts it right away.
+func (s *APIV1Service) RedeliverWebhook(ctx context.Context, request *v1pb.RedeliverWebhookRequest) (*v1pb.WebhookDelivery, error) {
+	webhookName, deliveryID, ok := strings.Cut(request.Name, "/deliveries/")
+	if !ok || deliveryID == "" || strings.Contains(deliveryID, "/") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook delivery name: %s", request.Name)
+	}
+	user, webhookID, err := s.resolveUserAndWebhookIDFromName(ctx, webhookName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook delivery name: %v", err)
+	}
+	if err := s.checkUserWebhookAccess(ctx, user); err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.Store.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{
+		UID:       &deliveryID,
+		CreatorID: &user.ID,
+		WebhookID: &webhookID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook delivery: %v", err)
+	}
+	if delivery == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook delivery not found")
+	}
+	hook, err := s.findUserWebhook(ctx, user.ID, webhookID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook: %v", err)
+	}
+	if hook == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+	if hook.Disabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "webhook is disabled")
+	}
+
+	pending := store.WebhookDeliveryPending
+	attemptCount := int32(0)
+	nextAttemptTs := time.Now().Unix()
+	if err := s.Store.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+		ID:            delivery.ID,
+		Status:        &pending,
+		AttemptCount:  &attemptCount,
+		NextAttemptTs: &nextAttemptTs,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update webhook delivery: %v", err)
+	}
+	delivery.Status, delivery.AttemptCount, delivery.NextAttemptTs = pending, attemptCount, nextAttemptTs
+	s.attemptWebhookDeliveryAsync(delivery)
+	return convertWebhookDeliveryFromStore(delivery, user), nil
+}
+
+// checkUserWebhookAccess checks that the current user may manage the webhooks of user.
+func (s *APIV1Service) checkUserWebhookAccess(ctx context.Context, user *store.User) error {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != user.ID && currentUser.Role != store.RoleAdmin {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+func convertWebhookDeliveryFromStore(delivery *store.WebhookDelivery, user *store.User) *v1pb.WebhookDelivery {
+	deliveryMessage := &v1pb.WebhookDelivery{
+		Name:            fmt.Sprintf("%s/webhooks/%s/deliveries/%s", BuildUserName(user.Username), delivery.WebhookID, delivery.UID),
+		ActivityType:    delivery.ActivityType,
+		Url:             delivery.URL,
+		State:           convertWebhookDeliveryStateFromStore(delivery.Status),
+		AttemptCount:    delivery.AttemptCount,
+		StatusCode:      delivery.ResponseCode,
+		ResponseSnippet: delivery.ResponseBody,
+		CreateTime:      timestamppb.New(time.Unix(delivery.CreatedTs, 0)),
+	}
+	if delivery.LastAttemptTs != nil {
+		deliveryMessage.LastAttemptTime = timestamppb.New(time.Unix(*delivery.LastAttemptTs, 0))
+	}
+	if delivery.Status == store.WebhookDeliveryPending {
+		deliveryMessage.NextAttemptTime = timestamppb.New(time.Unix(delivery.NextAttemptTs, 0))
+	}
+	return deliveryMessage
+}
+
+func convertWebhookDeliveryStateFromStore(deliveryStatus store.WebhookDeliveryStatus) v1pb.WebhookDelivery_State {
+	switch deliveryStatus {
+	case store.WebhookDeliveryPending:
+		return v1pb.WebhookDelivery_PENDING
+	case store.WebhookDeliverySucceeded:
+		return v1pb.WebhookDelivery_SUCCEEDED
+	case store.WebhookDeliveryFailed:
+		return v1pb.WebhookDelivery_FAILED
+	default:
+		return v1pb.WebhookDelivery_STATE_UNSPECIFIED
+	}
+}
@@ -47,6 +47,10 @@ const (
 
 	// cacheMaxAge is the max-age value for Cache-Control headers (1 hour).
 	cacheMaxAge = "public, max-age=3600"
+
+	// memoShareViewGracePeriod is how long a share that has used up its views keeps serving
+	// attachments after its last view, so the page of the final view can still load them.
+	memoShareViewGracePeriod = 10 * time.Minute
 )
 
 // xssUnsafeTypes contains MIME types that could execute scripts if served directly.
@@ -102,6 +106,7 @@ type FileServerService struct {
 	Profile       *profile.Profile
 	Store         *store.Store
 	authenticator *auth.Authenticator
+	secret        string
 
 	// thumbnailSemaphore limits concurrent thumbnail generation.
 	thumbnailSemaphore *semaphore.Weighted
@@ -113,6 +118,7 @@ func NewFileServerService(profile *profile.Profile, store *store.Store, secret s
 		Profile:            profile,
 		Store:              store,
 		authenticator:      auth.NewAuthenticator(store, secret),
+		secret:             secret,
 		thumbnailSemaphore: semaphore.NewWeighted(maxConcurrentThumbnails),
 	}
 }
@@ -663,11 +669,16 @@ func (s *FileServerService) checkAttachmentPermission(ctx context.Context, c *ec
 	}
 
 	// Check share token fallback: allow access if request carries a valid, non-expired share token
-	// that was issued for this specific memo. This covers attachment requests made from the shared
-	// memo page for private or protected memos.
+	// that was issued for this specific memo, along with the share's access cookie if it has a
+	// password. This covers attachment requests made from the shared memo page for private or
+	// protected memos.
+	// Collection share tokens are accepted for the memos of the shared collection.
 	if shareToken := (*c).QueryParam("share_token"); shareToken != "" {
 		ms, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{UID: &shareToken})
-		if err == nil && ms != nil && !isMemoShareExpired(ms) && ms.MemoID == memo.ID {
+		if err == nil && ms != nil && ms.MemoID == memo.ID && isMemoShareActive(ms) && s.hasMemoShareAccess(c, ms) {
+			return nil
+		}
+		if s.isMemoInCollectionShare(ctx, shareToken, memo) {
 			return nil
 		}
 	}
@@ -680,8 +691,14 @@ func (s *FileServerService) checkAttachmentPermission(ctx context.Context, c *ec
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized access")
 	}
 
-	if memo.Visibility == store.Private && user.ID != memo.CreatorID && user.Role != store.RoleAdmin {
-		return echo.NewHTTPError(http.StatusForbidden, "forbidden access")
+	if (memo.Visibility == store.Private || memo.Visibility == store.Group) && user.ID != memo.CreatorID && user.Role != store.RoleAdmin {
+		scope, err := s.Store.GetMemoAccessScope(ctx, user.ID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to check memo access").Wrap(err)
+		}
+		if !scope.Includes(memo) {
+			return echo.NewHTTPError(http.StatusForbidden, "forbidden access")
+		}
 	}
 
 	return nil
@@ -758,7 +775,56 @@ func setMediaHeaders(c *echo.Context, contentType, originalType string) {
 	}
 }
 
-// isMemoShareExpired returns true if the share has a defined expiry that has already passed.
-func isMemoShareExpired(ms *store.MemoShare) bool {
-	return ms.ExpiresTs != nil && time.Now().Unix() > *ms.ExpiresTs
+// isMemoInCollectionShare reports whether the token is an active collection share
+// whose collection contains the memo.
+func (s *FileServerService) isMemoInCollectionShare(ctx context.Context, shareToken string, memo *store.Memo) bool {
+	share, err := s.Store.GetCollectionShare(ctx, &store.FindCollectionShare{UID: &shareToken})
+	if err != nil || share == nil || share.IsExpired() {
+		return false
+	}
+	filters, err := s.Store.GetCollectionShareFilters(ctx, share)
+	if err != nil || filters == nil {
+		return false
+	}
+	normalStatus := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		ID:               &memo.ID,
+		RowStatus:        &normalStatus,
+		ExcludeComments:  true,
+		ExcludeScheduled: true,
+		Filters:          filters,
+	})
+	return err == nil && len(memos) > 0
+}
+
+// isMemoShareActive returns true if the share has not expired and, when view-limited,
+// has views left or was last viewed within memoShareViewGracePeriod.
+// Attachment requests do not count as views.
+func isMemoShareActive(ms *store.MemoShare) bool {
+	now := time.Now()
+	if ms.ExpiresTs != nil && now.Unix() > *ms.ExpiresTs {
+		return false
+	}
+	if ms.MaxViews != nil && ms.ViewCount >= *ms.MaxViews {
+		return ms.LastAccessedTs != nil && now.Sub(time.Unix(*ms.LastAccessedTs, 0)) <= memoShareViewGracePeriod
+	}
+	return true
+}
+
+// hasMemoShareAccess reports whether the request may use the share. Password-protected shares
+// require the access cookie set when the share was opened with its password, which stops
+// working once the password changes.
+func (s *FileServerService) hasMemoShareAccess(c *echo.Context, ms *store.MemoShare) bool {
+	if ms.PasswordHash == "" {
+		return true
+	}
+	token := auth.ExtractCookie(c.Request().Header.Get("Cookie"), auth.MemoShareAccessCookieName(ms.ID))
+	if token == "" {
+		return false
+	}
+	claims, err := auth.ParseMemoShareAccessToken(token, []byte(s.secret))
+	if err != nil {
+		return false
+	}
+	return claims.Subject == fmt.Sprint(ms.ID) && claims.Fingerprint == auth.Fingerprint(ms.PasswordHash)
 }
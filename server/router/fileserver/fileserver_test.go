@@ -13,9 +13,11 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v5"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/internal/markdown"
 	"github.com/usememos/memos/internal/profile"
@@ -79,6 +81,90 @@ func TestServeAttachmentFile_ShareTokenAllowsDirectMemoAttachment(t *testing.T)
 	require.Equal(t, "memo attachment", rec.Body.String())
 }
 
+func TestServeAttachmentFile_ShareTokenRequiresSharePassword(t *testing.T) {
+	ctx := context.Background()
+	svc, fs, _, cleanup := newShareAttachmentTestServices(ctx, t)
+	defer cleanup()
+
+	creator, err := svc.Store.CreateUser(ctx, &store.User{
+		Username: "share-password-owner",
+		Role:     store.RoleUser,
+		Email:    "share-password-owner@example.com",
+	})
+	require.NoError(t, err)
+
+	creatorCtx := context.WithValue(ctx, auth.UserIDContextKey, creator.ID)
+
+	attachment, err := svc.CreateAttachment(creatorCtx, &apiv1.CreateAttachmentRequest{
+		Attachment: &apiv1.Attachment{
+			Filename: "memo.txt",
+			Type:     "text/plain",
+			Content:  []byte("memo attachment"),
+		},
+	})
+	require.NoError(t, err)
+
+	memo, err := svc.CreateMemo(creatorCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:     "protected share",
+			Visibility:  apiv1.Visibility_PRIVATE,
+			Attachments: []*apiv1.Attachment{{Name: attachment.Name}},
+		},
+	})
+	require.NoError(t, err)
+
+	share, err := svc.CreateMemoShare(creatorCtx, &apiv1.CreateMemoShareRequest{
+		Parent:    memo.Name,
+		MemoShare: &apiv1.MemoShare{Password: "secret"},
+	})
+	require.NoError(t, err)
+	shareToken := share.Name[strings.LastIndex(share.Name, "/")+1:]
+
+	e := echo.New()
+	fs.RegisterRoutes(e)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/file/%s/%s?share_token=%s", attachment.Name, attachment.Filename, shareToken), nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// The password is not accepted in the URL.
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/file/%s/%s?share_token=%s&share_password=secret", attachment.Name, attachment.Filename, shareToken), nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// Opening the share with its password sets the cookie that authorizes its attachments.
+	openCtx := apiv1service.WithHeaderCarrier(ctx)
+	_, err = svc.GetMemoByShare(openCtx, &apiv1.GetMemoByShareRequest{ShareId: shareToken, Password: "secret"})
+	require.NoError(t, err)
+	cookie := apiv1service.GetHeaderCarrier(openCtx).Get("Set-Cookie")
+	require.Contains(t, cookie, "Path=/file/")
+	require.Contains(t, cookie, "HttpOnly")
+
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/file/%s/%s?share_token=%s", attachment.Name, attachment.Filename, shareToken), nil)
+	req.Header.Set("Cookie", strings.SplitN(cookie, ";", 2)[0])
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "memo attachment", rec.Body.String())
+
+}
+
+func TestIsMemoShareActive(t *testing.T) {
+	now := time.Now().Unix()
+	past := now - 60
+	maxViews := int32(1)
+	stale := now - int64(memoShareViewGracePeriod.Seconds()) - 60
+
+	require.True(t, isMemoShareActive(&store.MemoShare{}))
+	require.False(t, isMemoShareActive(&store.MemoShare{ExpiresTs: &past}))
+	require.True(t, isMemoShareActive(&store.MemoShare{MaxViews: &maxViews}))
+	// A used up share keeps serving attachments shortly after its last view.
+	require.True(t, isMemoShareActive(&store.MemoShare{MaxViews: &maxViews, ViewCount: 1, LastAccessedTs: &now}))
+	require.False(t, isMemoShareActive(&store.MemoShare{MaxViews: &maxViews, ViewCount: 1, LastAccessedTs: &stale}))
+}
+
 func TestServeAttachmentFile_LocalStaticFileSupportsRangeRequests(t *testing.T) {
 	ctx := context.Background()
 	svc, fs, _, cleanup := newShareAttachmentTestServices(ctx, t)
@@ -192,6 +278,62 @@ func TestServeAttachmentFile_ShareTokenRejectsCommentAttachment(t *testing.T) {
 	require.Equal(t, http.StatusUnauthorized, rec.Code)
 }
 
+func TestServeAttachmentFile_CollectionShareHidesScheduledMemo(t *testing.T) {
+	ctx := context.Background()
+	svc, fs, _, cleanup := newShareAttachmentTestServices(ctx, t)
+	defer cleanup()
+
+	creator, err := svc.Store.CreateUser(ctx, &store.User{
+		Username: "collection-owner",
+		Role:     store.RoleUser,
+		Email:    "collection-owner@example.com",
+	})
+	require.NoError(t, err)
+	creatorCtx := context.WithValue(ctx, auth.UserIDContextKey, creator.ID)
+
+	attachment, err := svc.CreateAttachment(creatorCtx, &apiv1.CreateAttachmentRequest{
+		Attachment: &apiv1.Attachment{
+			Filename: "scheduled.txt",
+			Type:     "text/plain",
+			Content:  []byte("scheduled attachment"),
+		},
+	})
+	require.NoError(t, err)
+	publishTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	_, err = svc.CreateMemo(creatorCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:     "scheduled #work",
+			Visibility:  apiv1.Visibility_PRIVATE,
+			PublishTime: timestamppb.New(publishTime),
+			Attachments: []*apiv1.Attachment{
+				{Name: attachment.Name},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	share, err := svc.CreateCollectionShare(creatorCtx, &apiv1.CreateCollectionShareRequest{
+		Parent:          "users/collection-owner",
+		CollectionShare: &apiv1.CollectionShare{Tag: "work"},
+	})
+	require.NoError(t, err)
+	shareToken := share.Name[strings.LastIndex(share.Name, "/")+1:]
+
+	e := echo.New()
+	fs.RegisterRoutes(e)
+	fetch := func() int {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/file/%s/%s?share_token=%s", attachment.Name, attachment.Filename, shareToken), nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// The attachments of a scheduled memo are only shared once it is published.
+	require.Equal(t, http.StatusUnauthorized, fetch())
+	require.NoError(t, svc.PublishScheduledMemos(ctx, publishTime))
+	require.Equal(t, http.StatusOK, fetch())
+}
+
 func TestServeAttachmentFile_MotionClip(t *testing.T) {
 	ctx := context.Background()
 	svc, fs, _, cleanup := newShareAttachmentTestServices(ctx, t)
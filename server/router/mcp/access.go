@@ -14,7 +14,7 @@ import (
 
 // checkMemoAccess returns an error if the caller cannot read the memo.
 // userID == 0 means anonymous.
-func checkMemoAccess(memo *store.Memo, userID int32) error {
+func (s *MCPService) checkMemoAccess(ctx context.Context, memo *store.Memo, userID int32) error {
 	if memo.RowStatus == store.Archived && memo.CreatorID != userID {
 		return errors.New("permission denied")
 	}
@@ -24,8 +24,18 @@ func checkMemoAccess(memo *store.Memo, userID int32) error {
 		if userID == 0 {
 			return errors.New("permission denied")
 		}
-	case store.Private:
-		if memo.CreatorID != userID {
+	case store.Private, store.Group:
+		if memo.CreatorID == userID {
+			return nil
+		}
+		if userID == 0 {
+			return errors.New("permission denied")
+		}
+		scope, err := s.store.GetMemoAccessScope(ctx, userID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo access scope")
+		}
+		if !scope.Includes(memo) {
 			return errors.New("permission denied")
 		}
 	default:
@@ -34,8 +44,17 @@ func checkMemoAccess(memo *store.Memo, userID int32) error {
 	return nil
 }
 
-func checkMemoOwnership(memo *store.Memo, userID int32) error {
-	if memo.CreatorID != userID {
+// checkMemoEditAccess returns an error unless the caller created the memo or
+// collaborates on it as an editor.
+func (s *MCPService) checkMemoEditAccess(ctx context.Context, memo *store.Memo, userID int32) error {
+	if memo.CreatorID == userID {
+		return nil
+	}
+	collaborator, err := s.store.GetMemoCollaborator(ctx, &store.FindMemoCollaborator{MemoID: &memo.ID, UserID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo collaborator")
+	}
+	if collaborator == nil || collaborator.Role != store.MemoCollaboratorEditor {
 		return errors.New("permission denied")
 	}
 	return nil
@@ -46,21 +65,41 @@ func hasMemoOwnership(memo *store.Memo, userID int32) bool {
 }
 
 // applyVisibilityFilter restricts find to memos the caller may see.
-func applyVisibilityFilter(find *store.FindMemo, userID int32, rowStatus *store.RowStatus) {
+func (s *MCPService) applyVisibilityFilter(ctx context.Context, find *store.FindMemo, userID int32, rowStatus *store.RowStatus) error {
 	if rowStatus != nil && *rowStatus == store.Archived {
 		if userID == 0 {
 			impossibleCreatorID := int32(-1)
 			find.CreatorID = &impossibleCreatorID
-			return
+			return nil
 		}
 		find.CreatorID = &userID
-		return
+		return nil
 	}
 	if userID == 0 {
 		find.VisibilityList = []store.Visibility{store.Public}
-		return
+		return nil
 	}
-	find.Filters = append(find.Filters, "creator_id == "+itoa32(userID)+` || visibility in ["PUBLIC", "PROTECTED"]`)
+	filter := "creator_id == " + itoa32(userID) + ` || visibility in ["PUBLIC", "PROTECTED"]`
+	scope, err := s.store.GetMemoAccessScope(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo access scope")
+	}
+	if len(scope.GroupNames) > 0 {
+		audience := make([]string, 0, len(scope.GroupNames))
+		for _, groupName := range scope.GroupNames {
+			audience = append(audience, strconv.Quote("groups/"+groupName))
+		}
+		filter += ` || (visibility == "GROUP" && audience in [` + strings.Join(audience, ", ") + `])`
+	}
+	if len(scope.CollaboratorMemoIDs) > 0 {
+		memoIDs := make([]string, 0, len(scope.CollaboratorMemoIDs))
+		for _, memoID := range scope.CollaboratorMemoIDs {
+			memoIDs = append(memoIDs, itoa32(memoID))
+		}
+		filter += ` || id in [` + strings.Join(memoIDs, ", ") + `]`
+	}
+	find.Filters = append(find.Filters, filter)
+	return nil
 }
 
 func (s *MCPService) checkAttachmentAccess(ctx context.Context, attachment *store.Attachment, userID int32) error {
@@ -78,7 +117,7 @@ func (s *MCPService) checkAttachmentAccess(ctx context.Context, attachment *stor
 	if memo == nil {
 		return errors.New("linked memo not found")
 	}
-	return checkMemoAccess(memo, userID)
+	return s.checkMemoAccess(ctx, memo, userID)
 }
 
 func (s *MCPService) isAllowedOrigin(r *http.Request) bool {
@@ -16,6 +16,8 @@ func visibilityToProto(visibility store.Visibility) v1pb.Visibility {
 		return v1pb.Visibility_PROTECTED
 	case store.Public:
 		return v1pb.Visibility_PUBLIC
+	case store.Group:
+		return v1pb.Visibility_GROUP
 	default:
 		return v1pb.Visibility_PRIVATE
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/server/auth"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/router/oauth"
 	"github.com/usememos/memos/store"
 )
 
@@ -87,15 +88,23 @@ func (s *MCPService) RegisterRoutes(echoServer *echo.Echo) {
 					headerMCPExcludeTools,
 				}, ", "))
 				headers.Set("Access-Control-Allow-Methods", "GET, POST, DELETE, OPTIONS")
+				headers.Set("Access-Control-Expose-Headers", "Mcp-Session-Id, WWW-Authenticate")
 				if c.Request().Method == http.MethodOptions {
 					return c.NoContent(http.StatusNoContent)
 				}
 			}
 
 			authHeader := c.Request().Header.Get("Authorization")
+			if authHeader == "" && isOAuthMCPPath(c.Request().URL.Path) {
+				// The /mcp/oauth endpoints require credentials, which prompts OAuth-capable
+				// clients to discover the authorization server and sign the user in.
+				s.setAuthenticateChallenge(c, "")
+				return c.JSON(http.StatusUnauthorized, map[string]string{"message": "authentication required"})
+			}
 			if authHeader != "" {
 				result := s.authenticator.Authenticate(c.Request().Context(), authHeader)
 				if result == nil {
+					s.setAuthenticateChallenge(c, "invalid_token")
 					return c.JSON(http.StatusUnauthorized, map[string]string{"message": "invalid or expired token"})
 				}
 				ctx := auth.ApplyToContext(c.Request().Context(), result)
@@ -108,6 +117,27 @@ func (s *MCPService) RegisterRoutes(echoServer *echo.Echo) {
 	mcpGroup.Any("/mcp/readonly", echo.WrapHandler(httpHandler))
 	mcpGroup.Any("/mcp/x/:toolsets", echo.WrapHandler(httpHandler))
 	mcpGroup.Any("/mcp/x/:toolsets/readonly", echo.WrapHandler(httpHandler))
+	mcpGroup.Any("/mcp/oauth", echo.WrapHandler(httpHandler))
+	mcpGroup.Any("/mcp/oauth/readonly", echo.WrapHandler(httpHandler))
+	mcpGroup.Any("/mcp/oauth/x/:toolsets", echo.WrapHandler(httpHandler))
+	mcpGroup.Any("/mcp/oauth/x/:toolsets/readonly", echo.WrapHandler(httpHandler))
+}
+
+// setAuthenticateChallenge points clients at the protected resource metadata (RFC 9728)
+// so OAuth-capable clients can find the authorization server.
+func (s *MCPService) setAuthenticateChallenge(c *echo.Context, errorCode string) {
+	challenge := "Bearer "
+	if errorCode != "" {
+		challenge += fmt.Sprintf("error=%q, ", errorCode)
+	}
+	challenge += fmt.Sprintf("resource_metadata=%q", oauth.ProtectedResourceMetadataURL(s.profile, c.Request(), c.Request().URL.Path))
+	c.Response().Header().Set("WWW-Authenticate", challenge)
+}
+
+// isOAuthMCPPath reports whether the path is one of the /mcp/oauth endpoints that require credentials.
+func isOAuthMCPPath(path string) bool {
+	trimmed := strings.Trim(path, "/")
+	return trimmed == "mcp/oauth" || strings.HasPrefix(trimmed, "mcp/oauth/")
 }
 
 func (*MCPService) withRequestConfig(ctx context.Context, r *http.Request) context.Context {
@@ -118,7 +148,7 @@ func (*MCPService) filterTools(ctx context.Context, tools []mcp.Tool) []mcp.Tool
 	cfg := mcpRequestConfigFromContext(ctx)
 	filtered := make([]mcp.Tool, 0, len(tools))
 	for _, tool := range tools {
-		if cfg.allowsTool(tool.Name) {
+		if cfg.allowsTool(tool.Name) && auth.HasScopeInContext(ctx, requiredToolScope(tool.Name)) {
 			filtered = append(filtered, tool)
 		}
 	}
@@ -131,6 +161,9 @@ func (*MCPService) enforceToolAccess(next mcpserver.ToolHandlerFunc) mcpserver.T
 		if !cfg.allowsTool(req.Params.Name) {
 			return mcp.NewToolResultError(fmt.Sprintf("tool %q is not enabled by MCP configuration", req.Params.Name)), nil
 		}
+		if scope := requiredToolScope(req.Params.Name); !auth.HasScopeInContext(ctx, scope) {
+			return mcp.NewToolResultError(fmt.Sprintf("tool %q requires token scope %q", req.Params.Name, scope)), nil
+		}
 		return next(ctx, req)
 	}
 }
@@ -163,6 +196,10 @@ func parseMCPRequestConfig(r *http.Request) mcpRequestConfig {
 
 func parseMCPPathConfig(path string) (map[string]struct{}, bool) {
 	trimmed := strings.Trim(path, "/")
+	// The /mcp/oauth endpoints accept the same configuration as /mcp.
+	if isOAuthMCPPath(path) {
+		trimmed = "mcp" + strings.TrimPrefix(trimmed, "mcp/oauth")
+	}
 	if trimmed == "mcp/readonly" {
 		return nil, true
 	}
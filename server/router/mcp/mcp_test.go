@@ -483,6 +483,37 @@ func TestMCPToolFilteringRoutesAndHeaders(t *testing.T) {
 	})
 }
 
+func TestMCPOAuthEndpointRequiresCredentials(t *testing.T) {
+	ts := newTestMCPService(t)
+	e := echo.New()
+	ts.service.RegisterRoutes(e)
+
+	t.Run("anonymous request receives a challenge", func(t *testing.T) {
+		resp := postMCPHTTP(t, e, "/mcp/oauth", "", nil, map[string]any{"jsonrpc": "2.0", "id": 1, "method": "initialize"})
+		require.Equal(t, http.StatusUnauthorized, resp.Code)
+		require.Equal(t, `Bearer resource_metadata="https://notes.example.com/.well-known/oauth-protected-resource/mcp/oauth"`, resp.Header().Get("WWW-Authenticate"))
+	})
+
+	t.Run("invalid token receives an invalid_token challenge", func(t *testing.T) {
+		resp := postMCPHTTP(t, e, "/mcp", "", map[string]string{"Authorization": "Bearer invalid"}, map[string]any{"jsonrpc": "2.0", "id": 1, "method": "initialize"})
+		require.Equal(t, http.StatusUnauthorized, resp.Code)
+		require.Contains(t, resp.Header().Get("WWW-Authenticate"), `error="invalid_token"`)
+	})
+
+	t.Run("oauth access token is limited to its scopes", func(t *testing.T) {
+		user := ts.createUser(t, "oauth-user")
+		token, _, err := auth.GenerateOAuthAccessToken(user.ID, user.Username, string(user.Role), string(store.Normal), "client-1", []string{auth.ScopeMemosRead}, []byte("test-secret"))
+		require.NoError(t, err)
+		headers := map[string]string{"Authorization": "Bearer " + token}
+
+		sessionID := initializeMCPHTTP(t, e, "/mcp/oauth/readonly", headers)
+		response := callMCPHTTP(t, e, "/mcp/oauth/readonly", sessionID, headers, "tools/list", map[string]any{})
+		names := toolNamesFromListResponse(t, response)
+		requireToolPresent(t, names, "list_memos")
+		requireToolAbsent(t, names, "create_memo")
+	})
+}
+
 func TestMCPMemoAndReactionMutationsEmitSSEEvents(t *testing.T) {
 	ts := newTestMCPService(t)
 	user := ts.createUser(t, "author")
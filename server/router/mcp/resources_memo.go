@@ -30,6 +30,9 @@ func (s *MCPService) registerMemoResources(mcpSrv *mcpserver.MCPServer) {
 
 func (s *MCPService) handleReadMemoResource(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
 	userID := auth.GetUserID(ctx)
+	if !auth.HasScopeInContext(ctx, auth.ScopeMemosRead) {
+		return nil, errors.Errorf("token is missing required scope %q", auth.ScopeMemosRead)
+	}
 
 	// URI format: memo://memos/{uid}
 	uid := strings.TrimPrefix(req.Params.URI, "memo://memos/")
@@ -44,7 +47,7 @@ func (s *MCPService) handleReadMemoResource(ctx context.Context, req mcp.ReadRes
 	if memo == nil {
 		return nil, errors.Errorf("memo not found: %s", uid)
 	}
-	if err := checkMemoAccess(memo, userID); err != nil {
+	if err := s.checkMemoAccess(ctx, memo, userID); err != nil {
 		return nil, err
 	}
 
@@ -1,6 +1,10 @@
 package mcp
 
-import "github.com/mark3labs/mcp-go/mcp"
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/usememos/memos/server/auth"
+)
 
 var mcpToolsByToolset = map[string]map[string]struct{}{
 	"memos": stringSet(
@@ -57,6 +61,32 @@ var mcpMutationTools = stringSet(
 	"delete_reaction",
 )
 
+// mcpToolsetScopeResource maps each toolset to the resource half of the
+// Personal Access Token scope its tools require.
+var mcpToolsetScopeResource = map[string]string{
+	"memos":       "memos",
+	"tags":        "memos",
+	"attachments": "attachments",
+	"relations":   "memos",
+	"reactions":   "memos",
+}
+
+// requiredToolScope returns the Personal Access Token scope needed to call a tool.
+// Mutation tools need the write scope of their resource, all others the read scope.
+func requiredToolScope(name string) string {
+	for toolset, tools := range mcpToolsByToolset {
+		if _, ok := tools[name]; !ok {
+			continue
+		}
+		resource := mcpToolsetScopeResource[toolset]
+		if _, mutates := mcpMutationTools[name]; mutates {
+			return resource + ":write"
+		}
+		return resource + ":read"
+	}
+	return auth.ScopeAdmin
+}
+
 type deletedJSON struct {
 	Deleted bool `json:"deleted"`
 }
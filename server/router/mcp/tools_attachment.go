@@ -282,7 +282,7 @@ func (s *MCPService) handleLinkAttachmentToMemo(ctx context.Context, req mcp.Cal
 	if memo == nil {
 		return mcp.NewToolResultError("memo not found"), nil
 	}
-	if err := checkMemoOwnership(memo, userID); err != nil {
+	if err := s.checkMemoEditAccess(ctx, memo, userID); err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
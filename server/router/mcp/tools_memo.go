@@ -3,6 +3,7 @@ package mcp
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -37,6 +38,7 @@ type memoJSON struct {
 	State      string        `json:"state"`
 	Property   *propertyJSON `json:"property,omitempty"`
 	Parent     string        `json:"parent,omitempty"`
+	Etag       string        `json:"etag"`
 }
 
 type memoListJSON struct {
@@ -54,6 +56,7 @@ func storeMemoToJSON(m *store.Memo) memoJSON {
 		Pinned:     m.Pinned,
 		State:      string(m.RowStatus),
 		Tags:       []string{},
+		Etag:       strconv.FormatInt(int64(m.Revision), 10),
 	}
 	if m.Payload != nil {
 		if len(m.Payload.Tags) > 0 {
@@ -211,7 +214,7 @@ func (s *MCPService) registerMemoTools(mcpSrv *mcpserver.MCPServer) {
 	), s.handleCreateMemo)
 
 	mcpSrv.AddTool(mcp.NewTool("update_memo",
-		updateToolOptions("Update memo", "Update a memo's content, visibility, pin state, or archive state. Requires authentication and ownership. Omit any field to leave it unchanged.",
+		updateToolOptions("Update memo", "Update a memo's content, visibility, pin state, or archive state. Requires authentication and ownership; editor collaborators may update the content only. Omit any field to leave it unchanged.",
 			mcp.WithString("name", mcp.Required(), mcp.Description(`Memo resource name, e.g. "memos/abc123"`)),
 			mcp.WithString("content", mcp.Description("New Markdown content")),
 			mcp.WithString("visibility",
@@ -223,6 +226,7 @@ func (s *MCPService) registerMemoTools(mcpSrv *mcpserver.MCPServer) {
 				mcp.Enum("NORMAL", "ARCHIVED"),
 				mcp.Description("Set to ARCHIVED to archive, NORMAL to restore"),
 			),
+			mcp.WithString("etag", mcp.Description("The memo's etag from a previous read. When set, the update fails if the memo was changed since")),
 			mcp.WithOutputSchema[memoJSON](),
 		)...,
 	), s.handleUpdateMemo)
@@ -230,6 +234,7 @@ func (s *MCPService) registerMemoTools(mcpSrv *mcpserver.MCPServer) {
 	mcpSrv.AddTool(mcp.NewTool("delete_memo",
 		updateToolOptions("Delete memo", "Permanently delete a memo. Requires authentication and ownership.",
 			mcp.WithString("name", mcp.Required(), mcp.Description(`Memo resource name, e.g. "memos/abc123"`)),
+			mcp.WithString("etag", mcp.Description("The memo's etag from a previous read. When set, the delete fails if the memo was changed since")),
 			mcp.WithOutputSchema[deletedJSON](),
 		)...,
 	), s.handleDeleteMemo)
@@ -288,7 +293,9 @@ func (s *MCPService) handleListMemos(ctx context.Context, req mcp.CallToolReques
 		Offset:          &offset,
 		OrderByPinned:   req.GetBool("order_by_pinned", false),
 	}
-	applyVisibilityFilter(find, userID, rowStatus)
+	if err := s.applyVisibilityFilter(ctx, find, userID, rowStatus); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list memos: %v", err)), nil
+	}
 	if filter := req.GetString("filter", ""); filter != "" {
 		find.Filters = append(find.Filters, filter)
 	}
@@ -338,7 +345,7 @@ func (s *MCPService) handleGetMemo(ctx context.Context, req mcp.CallToolRequest)
 	if memo == nil {
 		return mcp.NewToolResultError("memo not found"), nil
 	}
-	if err := checkMemoAccess(memo, userID); err != nil {
+	if err := s.checkMemoAccess(ctx, memo, userID); err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
@@ -390,7 +397,7 @@ func (s *MCPService) handleUpdateMemo(ctx context.Context, req mcp.CallToolReque
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	update := &v1pb.Memo{Name: "memos/" + uid}
+	update := &v1pb.Memo{Name: "memos/" + uid, Etag: req.GetString("etag", "")}
 	updateMask := &fieldmaskpb.FieldMask{}
 	args := req.GetArguments()
 
@@ -448,7 +455,7 @@ func (s *MCPService) handleDeleteMemo(ctx context.Context, req mcp.CallToolReque
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	if _, err := s.apiV1Service.DeleteMemo(ctx, &v1pb.DeleteMemoRequest{Name: "memos/" + uid}); err != nil {
+	if _, err := s.apiV1Service.DeleteMemo(ctx, &v1pb.DeleteMemoRequest{Name: "memos/" + uid, Etag: req.GetString("etag", "")}); err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("failed to delete memo: %v", err)), nil
 	}
 	return newDeletedToolResult()
@@ -472,7 +479,9 @@ func (s *MCPService) handleSearchMemos(ctx context.Context, req mcp.CallToolRequ
 		Offset:          &zero,
 		Filters:         []string{fmt.Sprintf(`content.contains(%q)`, query)},
 	}
-	applyVisibilityFilter(find, userID, find.RowStatus)
+	if err := s.applyVisibilityFilter(ctx, find, userID, find.RowStatus); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to search memos: %v", err)), nil
+	}
 
 	memos, err := s.store.ListMemos(ctx, find)
 	if err != nil {
@@ -513,7 +522,7 @@ func (s *MCPService) handleListMemoComments(ctx context.Context, req mcp.CallToo
 	if parent == nil {
 		return mcp.NewToolResultError("memo not found"), nil
 	}
-	if err := checkMemoAccess(parent, userID); err != nil {
+	if err := s.checkMemoAccess(ctx, parent, userID); err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
@@ -540,7 +549,7 @@ func (s *MCPService) handleListMemoComments(ctx context.Context, req mcp.CallToo
 	}
 	creatorIDs := make([]int32, 0, len(memos))
 	for _, memo := range memos {
-		if checkMemoAccess(memo, userID) == nil {
+		if s.checkMemoAccess(ctx, memo, userID) == nil {
 			creatorIDs = append(creatorIDs, memo.CreatorID)
 		}
 	}
@@ -551,7 +560,7 @@ func (s *MCPService) handleListMemoComments(ctx context.Context, req mcp.CallToo
 
 	results := make([]memoJSON, 0, len(memos))
 	for _, m := range memos {
-		if checkMemoAccess(m, userID) == nil {
+		if s.checkMemoAccess(ctx, m, userID) == nil {
 			result, err := storeMemoToJSONWithUsernames(m, usernamesByID)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("failed to resolve memo creator: %v", err)), nil
@@ -584,7 +593,7 @@ func (s *MCPService) handleCreateMemoComment(ctx context.Context, req mcp.CallTo
 	if parent == nil {
 		return mcp.NewToolResultError("memo not found"), nil
 	}
-	if err := checkMemoAccess(parent, userID); err != nil {
+	if err := s.checkMemoAccess(ctx, parent, userID); err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
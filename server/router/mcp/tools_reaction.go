@@ -57,7 +57,7 @@ func (s *MCPService) handleListReactions(ctx context.Context, req mcp.CallToolRe
 	if memo == nil {
 		return mcp.NewToolResultError("memo not found"), nil
 	}
-	if err := checkMemoAccess(memo, userID); err != nil {
+	if err := s.checkMemoAccess(ctx, memo, userID); err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
@@ -114,7 +114,7 @@ func (s *MCPService) handleUpsertReaction(ctx context.Context, req mcp.CallToolR
 	if memo == nil {
 		return mcp.NewToolResultError("memo not found"), nil
 	}
-	if err := checkMemoAccess(memo, userID); err != nil {
+	if err := s.checkMemoAccess(ctx, memo, userID); err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
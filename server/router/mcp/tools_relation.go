@@ -61,7 +61,7 @@ func (s *MCPService) handleListMemoRelations(ctx context.Context, req mcp.CallTo
 	if memo == nil {
 		return mcp.NewToolResultError("memo not found"), nil
 	}
-	if err := checkMemoAccess(memo, userID); err != nil {
+	if err := s.checkMemoAccess(ctx, memo, userID); err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
@@ -109,7 +109,7 @@ func (s *MCPService) handleListMemoRelations(ctx context.Context, req mcp.CallTo
 		if !ok1 || !ok2 {
 			continue
 		}
-		if checkMemoAccess(srcMemo, userID) != nil || checkMemoAccess(relatedMemo, userID) != nil {
+		if s.checkMemoAccess(ctx, srcMemo, userID) != nil || s.checkMemoAccess(ctx, relatedMemo, userID) != nil {
 			continue
 		}
 		results = append(results, relationJSON{
@@ -158,7 +158,7 @@ func (s *MCPService) handleCreateMemoRelation(ctx context.Context, req mcp.CallT
 	if dstMemo == nil {
 		return mcp.NewToolResultError("related memo not found"), nil
 	}
-	if err := checkMemoAccess(dstMemo, userID); err != nil {
+	if err := s.checkMemoAccess(ctx, dstMemo, userID); err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
@@ -215,7 +215,7 @@ func (s *MCPService) handleDeleteMemoRelation(ctx context.Context, req mcp.CallT
 	if dstMemo == nil {
 		return mcp.NewToolResultError("related memo not found"), nil
 	}
-	if err := checkMemoAccess(dstMemo, userID); err != nil {
+	if err := s.checkMemoAccess(ctx, dstMemo, userID); err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
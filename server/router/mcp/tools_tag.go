@@ -32,7 +32,9 @@ func (s *MCPService) handleListTags(ctx context.Context, _ mcp.CallToolRequest)
 		ExcludeContent:  true,
 		RowStatus:       &rowStatus,
 	}
-	applyVisibilityFilter(find, userID, find.RowStatus)
+	if err := s.applyVisibilityFilter(ctx, find, userID, find.RowStatus); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list memos: %v", err)), nil
+	}
 
 	memos, err := s.store.ListMemos(ctx, find)
 	if err != nil {
@@ -0,0 +1,172 @@
+// Package oauth implements the protocol endpoints of the OAuth2 authorization server
+// that lets third-party apps, including MCP clients, act on behalf of Memos users.
+//
+// The consent screen is served by the frontend at /oauth/authorize and talks to the
+// OAuthService API. This package serves the endpoints clients call directly:
+// server metadata (RFC 8414, RFC 9728), dynamic client registration (RFC 7591),
+// the token endpoint with PKCE (RFC 7636), token revocation (RFC 7009) and userinfo.
+//
+// Access tokens are the regular short-lived JWT access tokens, carrying the granted
+// scopes and the client ID, so every API that accepts them enforces the same scopes
+// as for Personal Access Tokens. ID tokens are not issued; clients read identity
+// claims from the userinfo endpoint instead.
+package oauth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v5"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// AuthorizePath is the consent page served by the frontend.
+	AuthorizePath = "/oauth/authorize"
+	// TokenPath is the token endpoint.
+	TokenPath = "/oauth/token"
+	// RegisterPath is the dynamic client registration endpoint.
+	RegisterPath = "/oauth/register"
+	// RevokePath is the token revocation endpoint.
+	RevokePath = "/oauth/revoke"
+	// UserInfoPath is the userinfo endpoint.
+	UserInfoPath = "/oauth/userinfo"
+
+	// ProtectedResourceMetadataPath is the protected resource metadata endpoint (RFC 9728).
+	ProtectedResourceMetadataPath = "/.well-known/oauth-protected-resource"
+
+	// maxRequestBodySize limits the size of registration requests.
+	maxRequestBodySize = 64 * 1024
+)
+
+// OAuthService serves the OAuth2 protocol endpoints.
+type OAuthService struct {
+	profile       *profile.Profile
+	store         *store.Store
+	secret        string
+	authenticator *auth.Authenticator
+}
+
+// NewOAuthService creates a new OAuthService.
+func NewOAuthService(profile *profile.Profile, store *store.Store, secret string) *OAuthService {
+	return &OAuthService{
+		profile:       profile,
+		store:         store,
+		secret:        secret,
+		authenticator: auth.NewAuthenticator(store, secret),
+	}
+}
+
+// RegisterRoutes registers the OAuth2 endpoints.
+func (s *OAuthService) RegisterRoutes(echoServer *echo.Echo) {
+	g := echoServer.Group("")
+	g.Use(corsMiddleware)
+
+	g.GET("/.well-known/oauth-authorization-server", s.getAuthorizationServerMetadata)
+	g.GET("/.well-known/openid-configuration", s.getAuthorizationServerMetadata)
+	g.GET(ProtectedResourceMetadataPath, s.getProtectedResourceMetadata)
+	g.GET(ProtectedResourceMetadataPath+"/*", s.getProtectedResourceMetadata)
+
+	g.POST(RegisterPath, s.registerClient)
+	g.POST(TokenPath, s.token)
+	g.POST(RevokePath, s.revoke)
+	g.GET(UserInfoPath, s.userInfo)
+	g.POST(UserInfoPath, s.userInfo)
+
+	for _, path := range []string{
+		"/.well-known/oauth-authorization-server",
+		"/.well-known/openid-configuration",
+		ProtectedResourceMetadataPath,
+		ProtectedResourceMetadataPath + "/*",
+		RegisterPath,
+		TokenPath,
+		RevokePath,
+		UserInfoPath,
+	} {
+		g.OPTIONS(path, func(c *echo.Context) error {
+			return c.NoContent(http.StatusNoContent)
+		})
+	}
+}
+
+// corsMiddleware allows browser-based clients to call the protocol endpoints.
+// None of them rely on cookies, so any origin is allowed.
+func corsMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c *echo.Context) error {
+		if c.Request().Header.Get("Origin") != "" {
+			headers := c.Response().Header()
+			headers.Set("Access-Control-Allow-Origin", "*")
+			headers.Set("Access-Control-Allow-Headers", "Authorization, Content-Type, MCP-Protocol-Version")
+			headers.Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		}
+		return next(c)
+	}
+}
+
+// IssuerURL returns the issuer identifier, which is also the base URL of every endpoint.
+// The configured instance URL wins; otherwise it is derived from the request.
+func IssuerURL(profile *profile.Profile, r *http.Request) string {
+	if profile != nil && strings.TrimSpace(profile.InstanceURL) != "" {
+		return strings.TrimRight(strings.TrimSpace(profile.InstanceURL), "/")
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := r.Header.Get("X-Forwarded-Proto"); forwarded != "" {
+		scheme = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	}
+	return scheme + "://" + r.Host
+}
+
+// ProtectedResourceMetadataURL returns the metadata URL advertised in WWW-Authenticate challenges
+// for the resource at resourcePath, e.g. /mcp.
+func ProtectedResourceMetadataURL(profile *profile.Profile, r *http.Request, resourcePath string) string {
+	return IssuerURL(profile, r) + ProtectedResourceMetadataPath + resourcePath
+}
+
+func (s *OAuthService) getAuthorizationServerMetadata(c *echo.Context) error {
+	issuer := IssuerURL(s.profile, c.Request())
+	return c.JSON(http.StatusOK, map[string]any{
+		"issuer":                                     issuer,
+		"authorization_endpoint":                     issuer + AuthorizePath,
+		"token_endpoint":                             issuer + TokenPath,
+		"registration_endpoint":                      issuer + RegisterPath,
+		"revocation_endpoint":                        issuer + RevokePath,
+		"userinfo_endpoint":                          issuer + UserInfoPath,
+		"scopes_supported":                           auth.OAuthScopes,
+		"response_types_supported":                   []string{"code"},
+		"response_modes_supported":                   []string{"query"},
+		"grant_types_supported":                      []string{grantTypeAuthorizationCode, grantTypeRefreshToken},
+		"code_challenge_methods_supported":           []string{auth.PKCEMethodS256},
+		"token_endpoint_auth_methods_supported":      supportedTokenEndpointAuthMethods,
+		"revocation_endpoint_auth_methods_supported": supportedTokenEndpointAuthMethods,
+		"subject_types_supported":                    []string{"public"},
+		"claims_supported":                           []string{"sub", "preferred_username", "name", "email", "email_verified"},
+	})
+}
+
+func (s *OAuthService) getProtectedResourceMetadata(c *echo.Context) error {
+	issuer := IssuerURL(s.profile, c.Request())
+	resource := issuer + strings.TrimPrefix(c.Request().URL.Path, ProtectedResourceMetadataPath)
+	return c.JSON(http.StatusOK, map[string]any{
+		"resource":                 resource,
+		"resource_name":            "Memos",
+		"authorization_servers":    []string{issuer},
+		"scopes_supported":         auth.AllScopes,
+		"bearer_methods_supported": []string{"header"},
+	})
+}
+
+// writeError writes an OAuth2 error response (RFC 6749 section 5.2).
+func writeError(c *echo.Context, statusCode int, code, description string) error {
+	c.Response().Header().Set("Cache-Control", "no-store")
+	body := map[string]string{"error": code}
+	if description != "" {
+		body["error_description"] = description
+	}
+	return c.JSON(statusCode, body)
+}
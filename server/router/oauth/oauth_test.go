@@ -0,0 +1,325 @@
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+const testSecret = "test-secret"
+
+type testOAuthServer struct {
+	echo       *echo.Echo
+	store      *store.Store
+	apiService *apiv1.APIV1Service
+}
+
+func newTestOAuthServer(t *testing.T) *testOAuthServer {
+	t.Helper()
+
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	t.Cleanup(func() {
+		require.NoError(t, stores.Close())
+	})
+
+	profile := &profile.Profile{
+		Driver:      "sqlite",
+		InstanceURL: "https://notes.example.com/",
+	}
+	e := echo.New()
+	NewOAuthService(profile, stores, testSecret).RegisterRoutes(e)
+	return &testOAuthServer{
+		echo:       e,
+		store:      stores,
+		apiService: apiv1.NewAPIV1Service(testSecret, profile, stores),
+	}
+}
+
+func (s *testOAuthServer) do(t *testing.T, req *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+	resp := httptest.NewRecorder()
+	s.echo.ServeHTTP(resp, req)
+	return resp
+}
+
+func (s *testOAuthServer) postForm(t *testing.T, path string, form url.Values) (*httptest.ResponseRecorder, map[string]any) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp := s.do(t, req)
+	body := map[string]any{}
+	if resp.Body.Len() > 0 {
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	}
+	return resp, body
+}
+
+func (s *testOAuthServer) registerClient(t *testing.T, metadata map[string]any) map[string]any {
+	t.Helper()
+	raw, err := json.Marshal(metadata)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, RegisterPath, bytes.NewReader(raw))
+	req.Header.Set("Content-Type", "application/json")
+	resp := s.do(t, req)
+	require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+	body := map[string]any{}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	return body
+}
+
+// authorize approves an authorization request as the user and returns the issued code.
+func (s *testOAuthServer) authorize(t *testing.T, userID int32, clientID, redirectURI, scope, verifier string) string {
+	t.Helper()
+	hash := sha256.Sum256([]byte(verifier))
+	ctx := context.WithValue(context.Background(), auth.UserIDContextKey, userID)
+	resp, err := s.apiService.AuthorizeOAuthClient(ctx, &v1pb.AuthorizeOAuthClientRequest{
+		Request: &v1pb.OAuthAuthorizationRequest{
+			ResponseType:        "code",
+			ClientId:            clientID,
+			RedirectUri:         redirectURI,
+			Scope:               scope,
+			State:               "xyz",
+			CodeChallenge:       base64.RawURLEncoding.EncodeToString(hash[:]),
+			CodeChallengeMethod: auth.PKCEMethodS256,
+		},
+		Approve: true,
+	})
+	require.NoError(t, err)
+	redirect, err := url.Parse(resp.RedirectUri)
+	require.NoError(t, err)
+	require.Equal(t, "xyz", redirect.Query().Get("state"))
+	code := redirect.Query().Get("code")
+	require.NotEmpty(t, code)
+	return code
+}
+
+func TestMetadata(t *testing.T) {
+	t.Parallel()
+	s := newTestOAuthServer(t)
+
+	resp := s.do(t, httptest.NewRequest(http.MethodGet, "/.well-known/oauth-authorization-server", nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+	metadata := map[string]any{}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &metadata))
+	require.Equal(t, "https://notes.example.com", metadata["issuer"])
+	require.Equal(t, "https://notes.example.com/oauth/authorize", metadata["authorization_endpoint"])
+	require.Equal(t, "https://notes.example.com/oauth/token", metadata["token_endpoint"])
+	require.Equal(t, []any{"S256"}, metadata["code_challenge_methods_supported"])
+
+	resp = s.do(t, httptest.NewRequest(http.MethodGet, "/.well-known/oauth-protected-resource/mcp", nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+	resource := map[string]any{}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &resource))
+	require.Equal(t, "https://notes.example.com/mcp", resource["resource"])
+	require.Equal(t, []any{"https://notes.example.com"}, resource["authorization_servers"])
+}
+
+func TestRegisterClientRejectsInvalidRedirectURI(t *testing.T) {
+	t.Parallel()
+	s := newTestOAuthServer(t)
+
+	raw, err := json.Marshal(map[string]any{"redirect_uris": []string{"http://evil.example.com/callback"}})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, RegisterPath, bytes.NewReader(raw))
+	resp := s.do(t, req)
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Contains(t, resp.Body.String(), "invalid_redirect_uri")
+}
+
+func TestRegisterClientRejectsAdminScope(t *testing.T) {
+	t.Parallel()
+	s := newTestOAuthServer(t)
+
+	raw, err := json.Marshal(map[string]any{
+		"redirect_uris": []string{"http://127.0.0.1:33418/callback"},
+		"scope":         "memos:read admin",
+	})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, RegisterPath, bytes.NewReader(raw))
+	resp := s.do(t, req)
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Contains(t, resp.Body.String(), "invalid_client_metadata")
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := newTestOAuthServer(t)
+
+	user, err := s.store.CreateUser(ctx, &store.User{
+		Username: "alice",
+		Nickname: "Alice",
+		Role:     store.RoleUser,
+		Email:    "alice@example.com",
+	})
+	require.NoError(t, err)
+
+	redirectURI := "http://127.0.0.1:33418/callback"
+	client := s.registerClient(t, map[string]any{
+		"client_name":                "Test MCP Client",
+		"redirect_uris":              []string{redirectURI},
+		"token_endpoint_auth_method": "none",
+	})
+	require.Empty(t, client["client_secret"])
+	clientID := client["client_id"].(string)
+
+	verifier := strings.Repeat("v", 64)
+	code := s.authorize(t, user.ID, clientID, redirectURI, "openid profile memos:read", verifier)
+
+	// A wrong verifier burns the code.
+	resp, body := s.postForm(t, TokenPath, url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {clientID},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {strings.Repeat("x", 64)},
+	})
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Equal(t, "invalid_grant", body["error"])
+
+	code = s.authorize(t, user.ID, clientID, redirectURI, "openid profile memos:read", verifier)
+	exchange := url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {clientID},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {verifier},
+	}
+	resp, body = s.postForm(t, TokenPath, exchange)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	require.Equal(t, "Bearer", body["token_type"])
+	require.Equal(t, "openid profile memos:read", body["scope"])
+	require.Equal(t, "no-store", resp.Header().Get("Cache-Control"))
+	accessToken := body["access_token"].(string)
+	refreshToken := body["refresh_token"].(string)
+
+	// The code is single-use.
+	resp, body = s.postForm(t, TokenPath, exchange)
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Equal(t, "invalid_grant", body["error"])
+
+	// The access token maps onto the regular user claims with the granted scopes.
+	claims, err := auth.NewAuthenticator(s.store, testSecret).AuthenticateByAccessTokenV2(accessToken)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, claims.UserID)
+	require.Equal(t, clientID, claims.ClientID)
+	require.Equal(t, []string{auth.ScopeOpenID, auth.ScopeProfile, auth.ScopeMemosRead}, claims.Scopes)
+
+	req := httptest.NewRequest(http.MethodGet, UserInfoPath, nil)
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	resp = s.do(t, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+	userInfo := map[string]any{}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &userInfo))
+	require.Equal(t, "alice", userInfo["preferred_username"])
+	require.Equal(t, "Alice", userInfo["name"])
+	require.NotContains(t, userInfo, "email")
+
+	// Refresh tokens rotate and can be narrowed to a subset of the grant.
+	resp, body = s.postForm(t, TokenPath, url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {clientID},
+		"refresh_token": {refreshToken},
+		"scope":         {"memos:read"},
+	})
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	require.Equal(t, "memos:read", body["scope"])
+	rotatedToken := body["refresh_token"].(string)
+
+	resp, body = s.postForm(t, TokenPath, url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {clientID},
+		"refresh_token": {refreshToken},
+	})
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Equal(t, "invalid_grant", body["error"])
+
+	resp, body = s.postForm(t, TokenPath, url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {clientID},
+		"refresh_token": {rotatedToken},
+		"scope":         {"memos:write"},
+	})
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Equal(t, "invalid_scope", body["error"])
+
+	// A failed scope check does not consume the token, but revocation does.
+	resp, body = s.postForm(t, TokenPath, url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {clientID},
+		"refresh_token": {rotatedToken},
+	})
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	rotatedToken = body["refresh_token"].(string)
+	resp, _ = s.postForm(t, RevokePath, url.Values{"client_id": {clientID}, "token": {rotatedToken}})
+	require.Equal(t, http.StatusOK, resp.Code)
+	resp, body = s.postForm(t, TokenPath, url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {clientID},
+		"refresh_token": {rotatedToken},
+	})
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Equal(t, "invalid_grant", body["error"])
+}
+
+func TestConfidentialClientAuthentication(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := newTestOAuthServer(t)
+
+	user, err := s.store.CreateUser(ctx, &store.User{Username: "bob", Role: store.RoleUser})
+	require.NoError(t, err)
+
+	redirectURI := "https://app.example.com/callback"
+	client := s.registerClient(t, map[string]any{
+		"redirect_uris": []string{redirectURI},
+	})
+	clientID := client["client_id"].(string)
+	clientSecret := client["client_secret"].(string)
+	require.True(t, strings.HasPrefix(clientSecret, auth.OAuthClientSecretPrefix))
+	require.Equal(t, "app.example.com", client["client_name"])
+
+	verifier := strings.Repeat("v", 64)
+	code := s.authorize(t, user.ID, clientID, redirectURI, "", verifier)
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {verifier},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, TokenPath, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(clientID, "wrong-secret")
+	resp := s.do(t, req)
+	require.Equal(t, http.StatusUnauthorized, resp.Code)
+	require.Contains(t, resp.Body.String(), "invalid_client")
+
+	req = httptest.NewRequest(http.MethodPost, TokenPath, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(clientID, clientSecret)
+	resp = s.do(t, req)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	body := map[string]any{}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	// Without requested or registered scopes the client gets read access to memos.
+	require.Equal(t, auth.ScopeMemosRead, body["scope"])
+}
@@ -0,0 +1,123 @@
+package oauth
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/labstack/echo/v5"
+	"github.com/lithammer/shortuuid/v4"
+
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// clientMetadata is the subset of RFC 7591 client metadata supported by dynamic registration.
+type clientMetadata struct {
+	RedirectURIs            []string `json:"redirect_uris"`
+	ClientName              string   `json:"client_name,omitempty"`
+	TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method,omitempty"`
+	GrantTypes              []string `json:"grant_types,omitempty"`
+	ResponseTypes           []string `json:"response_types,omitempty"`
+	Scope                   string   `json:"scope,omitempty"`
+}
+
+type clientInformationResponse struct {
+	clientMetadata
+	ClientID              string `json:"client_id"`
+	ClientSecret          string `json:"client_secret,omitempty"`
+	ClientIDIssuedAt      int64  `json:"client_id_issued_at"`
+	ClientSecretExpiresAt *int64 `json:"client_secret_expires_at,omitempty"`
+}
+
+// registerClient implements open dynamic client registration (RFC 7591).
+// Registered clients gain no access by themselves; every grant still requires user consent.
+func (s *OAuthService) registerClient(c *echo.Context) error {
+	metadata := &clientMetadata{}
+	if err := json.NewDecoder(io.LimitReader(c.Request().Body, maxRequestBodySize)).Decode(metadata); err != nil {
+		return writeError(c, http.StatusBadRequest, "invalid_client_metadata", "request body must be a JSON object")
+	}
+
+	if len(metadata.RedirectURIs) == 0 {
+		return writeError(c, http.StatusBadRequest, "invalid_redirect_uri", "at least one redirect URI is required")
+	}
+	for _, redirectURI := range metadata.RedirectURIs {
+		if err := auth.ValidateOAuthRedirectURI(redirectURI); err != nil {
+			return writeError(c, http.StatusBadRequest, "invalid_redirect_uri", err.Error())
+		}
+	}
+	if metadata.TokenEndpointAuthMethod == "" {
+		metadata.TokenEndpointAuthMethod = authMethodClientSecretBasic
+	}
+	if !slices.Contains(supportedTokenEndpointAuthMethods, metadata.TokenEndpointAuthMethod) {
+		return writeError(c, http.StatusBadRequest, "invalid_client_metadata", "unsupported token_endpoint_auth_method")
+	}
+	if len(metadata.GrantTypes) == 0 {
+		metadata.GrantTypes = []string{grantTypeAuthorizationCode, grantTypeRefreshToken}
+	}
+	for _, grantType := range metadata.GrantTypes {
+		if grantType != grantTypeAuthorizationCode && grantType != grantTypeRefreshToken {
+			return writeError(c, http.StatusBadRequest, "invalid_client_metadata", "unsupported grant type "+grantType)
+		}
+	}
+	if len(metadata.ResponseTypes) == 0 {
+		metadata.ResponseTypes = []string{"code"}
+	}
+	for _, responseType := range metadata.ResponseTypes {
+		if responseType != "code" {
+			return writeError(c, http.StatusBadRequest, "invalid_client_metadata", "unsupported response type "+responseType)
+		}
+	}
+	scopes, err := auth.NormalizeOAuthScopes(strings.Fields(metadata.Scope))
+	if err != nil {
+		return writeError(c, http.StatusBadRequest, "invalid_client_metadata", err.Error())
+	}
+	// Anyone can register a client, so the admin scope is kept for clients created by users.
+	if slices.Contains(scopes, auth.ScopeAdmin) {
+		return writeError(c, http.StatusBadRequest, "invalid_client_metadata", "the admin scope cannot be registered dynamically")
+	}
+	metadata.Scope = strings.Join(scopes, " ")
+	metadata.ClientName = strings.TrimSpace(metadata.ClientName)
+	if metadata.ClientName == "" {
+		metadata.ClientName = defaultClientName(metadata.RedirectURIs[0])
+	}
+
+	create := &store.OAuthClient{
+		ClientID:     shortuuid.New(),
+		Name:         metadata.ClientName,
+		RedirectURIs: metadata.RedirectURIs,
+		Scopes:       scopes,
+	}
+	response := &clientInformationResponse{clientMetadata: *metadata}
+	if metadata.TokenEndpointAuthMethod != authMethodNone {
+		clientSecret, err := auth.GenerateOAuthClientSecret()
+		if err != nil {
+			return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+		}
+		create.ClientSecretHash = auth.HashOAuthSecret(clientSecret)
+		response.ClientSecret = clientSecret
+		neverExpires := int64(0)
+		response.ClientSecretExpiresAt = &neverExpires
+	}
+
+	client, err := s.store.CreateOAuthClient(c.Request().Context(), create)
+	if err != nil {
+		return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+	response.ClientID = client.ClientID
+	response.ClientIDIssuedAt = client.CreatedTs
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	return c.JSON(http.StatusCreated, response)
+}
+
+// defaultClientName names a client after the host it redirects to.
+func defaultClientName(redirectURI string) string {
+	if u, err := url.Parse(redirectURI); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	return redirectURI
+}
@@ -0,0 +1,273 @@
+package oauth
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v5"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	grantTypeAuthorizationCode = "authorization_code"
+	grantTypeRefreshToken      = "refresh_token"
+
+	authMethodNone              = "none"
+	authMethodClientSecretBasic = "client_secret_basic"
+	authMethodClientSecretPost  = "client_secret_post"
+)
+
+var supportedTokenEndpointAuthMethods = []string{authMethodNone, authMethodClientSecretBasic, authMethodClientSecretPost}
+
+// errInvalidClient is returned when client authentication fails.
+var errInvalidClient = errors.New("client authentication failed")
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	Scope        string `json:"scope"`
+}
+
+func (s *OAuthService) token(c *echo.Context) error {
+	ctx := c.Request().Context()
+	client, err := s.authenticateClient(ctx, c.Request())
+	if err != nil {
+		if errors.Is(err, errInvalidClient) {
+			c.Response().Header().Set("WWW-Authenticate", `Basic realm="memos"`)
+			return writeError(c, http.StatusUnauthorized, "invalid_client", err.Error())
+		}
+		return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+
+	switch c.Request().PostFormValue("grant_type") {
+	case grantTypeAuthorizationCode:
+		return s.exchangeAuthorizationCode(c, client)
+	case grantTypeRefreshToken:
+		return s.exchangeRefreshToken(c, client)
+	default:
+		return writeError(c, http.StatusBadRequest, "unsupported_grant_type", "")
+	}
+}
+
+func (s *OAuthService) exchangeAuthorizationCode(c *echo.Context, client *store.OAuthClient) error {
+	ctx := c.Request().Context()
+	code := c.Request().PostFormValue("code")
+	if code == "" {
+		return writeError(c, http.StatusBadRequest, "invalid_request", "code is required")
+	}
+
+	// Consume the code before validating it so it can never be used twice.
+	grant, err := s.findOAuthToken(ctx, store.OAuthTokenKindAuthorizationCode, code)
+	if err != nil {
+		return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+	if grant != nil {
+		consumed, err := s.consumeOAuthToken(ctx, grant)
+		if err != nil {
+			return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+		}
+		if !consumed {
+			grant = nil
+		}
+	}
+	if grant == nil || grant.ClientID != client.ClientID {
+		return writeError(c, http.StatusBadRequest, "invalid_grant", "authorization code is invalid or has already been used")
+	}
+	if grant.ExpiresTs <= time.Now().Unix() {
+		return writeError(c, http.StatusBadRequest, "invalid_grant", "authorization code has expired")
+	}
+	if c.Request().PostFormValue("redirect_uri") != grant.RedirectURI {
+		return writeError(c, http.StatusBadRequest, "invalid_grant", "redirect_uri does not match the authorization request")
+	}
+	if !auth.VerifyPKCE(c.Request().PostFormValue("code_verifier"), grant.CodeChallenge) {
+		return writeError(c, http.StatusBadRequest, "invalid_grant", "code_verifier does not match the code challenge")
+	}
+
+	return s.issueTokens(c, client, grant.UserID, grant.Scopes)
+}
+
+func (s *OAuthService) exchangeRefreshToken(c *echo.Context, client *store.OAuthClient) error {
+	ctx := c.Request().Context()
+	refreshToken := c.Request().PostFormValue("refresh_token")
+	if refreshToken == "" {
+		return writeError(c, http.StatusBadRequest, "invalid_request", "refresh_token is required")
+	}
+
+	grant, err := s.findOAuthToken(ctx, store.OAuthTokenKindRefreshToken, refreshToken)
+	if err != nil {
+		return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+	if grant == nil || grant.ClientID != client.ClientID {
+		return writeError(c, http.StatusBadRequest, "invalid_grant", "refresh token is invalid or has been revoked")
+	}
+	if grant.ExpiresTs <= time.Now().Unix() {
+		return writeError(c, http.StatusBadRequest, "invalid_grant", "refresh token has expired")
+	}
+
+	scopes := grant.Scopes
+	if requested := strings.Fields(c.Request().PostFormValue("scope")); len(requested) > 0 {
+		normalized, err := auth.NormalizeOAuthScopes(requested)
+		if err != nil {
+			return writeError(c, http.StatusBadRequest, "invalid_scope", err.Error())
+		}
+		for _, scope := range normalized {
+			if !slices.Contains(grant.Scopes, scope) {
+				return writeError(c, http.StatusBadRequest, "invalid_scope", "requested scope exceeds the original grant")
+			}
+		}
+		scopes = normalized
+	}
+
+	// Refresh tokens are rotated: the presented token is consumed and a new one is issued.
+	consumed, err := s.consumeOAuthToken(ctx, grant)
+	if err != nil {
+		return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+	if !consumed {
+		return writeError(c, http.StatusBadRequest, "invalid_grant", "refresh token is invalid or has been revoked")
+	}
+
+	return s.issueTokens(c, client, grant.UserID, scopes)
+}
+
+// issueTokens mints an access token and a rotated refresh token for the user.
+func (s *OAuthService) issueTokens(c *echo.Context, client *store.OAuthClient, userID int32, scopes []string) error {
+	ctx := c.Request().Context()
+	user, err := s.store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+	if user == nil || user.RowStatus == store.Archived {
+		return writeError(c, http.StatusBadRequest, "invalid_grant", "user is not available")
+	}
+
+	accessToken, expiresAt, err := auth.GenerateOAuthAccessToken(
+		user.ID,
+		user.Username,
+		string(user.Role),
+		string(user.RowStatus),
+		client.ClientID,
+		scopes,
+		[]byte(s.secret),
+	)
+	if err != nil {
+		return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+	refreshToken, err := auth.GenerateOAuthRefreshToken()
+	if err != nil {
+		return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+	if _, err := s.store.CreateOAuthToken(ctx, &store.OAuthToken{
+		Kind:      store.OAuthTokenKindRefreshToken,
+		TokenHash: auth.HashOAuthSecret(refreshToken),
+		ClientID:  client.ClientID,
+		UserID:    user.ID,
+		Scopes:    scopes,
+		ExpiresTs: time.Now().Add(auth.OAuthRefreshTokenDuration).Unix(),
+	}); err != nil {
+		return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	c.Response().Header().Set("Pragma", "no-cache")
+	return c.JSON(http.StatusOK, &tokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(time.Until(expiresAt).Seconds()),
+		RefreshToken: refreshToken,
+		Scope:        strings.Join(scopes, " "),
+	})
+}
+
+// revoke implements token revocation (RFC 7009). Only refresh tokens are stateful;
+// access tokens are short-lived and expire on their own.
+func (s *OAuthService) revoke(c *echo.Context) error {
+	ctx := c.Request().Context()
+	client, err := s.authenticateClient(ctx, c.Request())
+	if err != nil {
+		if errors.Is(err, errInvalidClient) {
+			return writeError(c, http.StatusUnauthorized, "invalid_client", err.Error())
+		}
+		return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+
+	token := c.Request().PostFormValue("token")
+	if token == "" {
+		return writeError(c, http.StatusBadRequest, "invalid_request", "token is required")
+	}
+	grant, err := s.findOAuthToken(ctx, store.OAuthTokenKindRefreshToken, token)
+	if err != nil {
+		return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+	// Unknown tokens and tokens of other clients are ignored, as required by the RFC.
+	if grant != nil && grant.ClientID == client.ClientID {
+		if _, err := s.store.DeleteOAuthTokens(ctx, &store.DeleteOAuthTokens{ID: &grant.ID}); err != nil {
+			return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+		}
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// authenticateClient identifies the client of a token endpoint request.
+// Confidential clients must present their secret via HTTP Basic or the request body;
+// public clients only send their client_id and are bound to the grant by PKCE.
+func (s *OAuthService) authenticateClient(ctx context.Context, r *http.Request) (*store.OAuthClient, error) {
+	clientID, clientSecret, hasBasic := r.BasicAuth()
+	if hasBasic {
+		// Credentials in the Basic header are form-urlencoded (RFC 6749 section 2.3.1).
+		var err error
+		if clientID, err = url.QueryUnescape(clientID); err != nil {
+			return nil, errInvalidClient
+		}
+		if clientSecret, err = url.QueryUnescape(clientSecret); err != nil {
+			return nil, errInvalidClient
+		}
+	} else {
+		clientID = r.PostFormValue("client_id")
+		clientSecret = r.PostFormValue("client_secret")
+	}
+	if clientID == "" {
+		return nil, errInvalidClient
+	}
+
+	client, err := s.store.GetOAuthClient(ctx, &store.FindOAuthClient{ClientID: &clientID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get oauth client")
+	}
+	if client == nil {
+		return nil, errInvalidClient
+	}
+	if client.ClientSecretHash != "" && !auth.VerifyOAuthClientSecret(clientSecret, client.ClientSecretHash) {
+		return nil, errInvalidClient
+	}
+	return client, nil
+}
+
+// findOAuthToken looks up an authorization code or refresh token by its value.
+func (s *OAuthService) findOAuthToken(ctx context.Context, kind store.OAuthTokenKind, token string) (*store.OAuthToken, error) {
+	tokenHash := auth.HashOAuthSecret(token)
+	grant, err := s.store.GetOAuthToken(ctx, &store.FindOAuthToken{Kind: &kind, TokenHash: &tokenHash})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get oauth token")
+	}
+	return grant, nil
+}
+
+// consumeOAuthToken deletes a code or refresh token atomically.
+// Returns false when the token was already consumed by a concurrent request.
+func (s *OAuthService) consumeOAuthToken(ctx context.Context, grant *store.OAuthToken) (bool, error) {
+	deleted, err := s.store.DeleteOAuthTokens(ctx, &store.DeleteOAuthTokens{ID: &grant.ID})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to consume oauth token")
+	}
+	return deleted == 1, nil
+}
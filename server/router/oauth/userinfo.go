@@ -0,0 +1,68 @@
+package oauth
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v5"
+
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// userInfo returns claims about the user the access token was issued for.
+// Profile claims require the profile scope and email claims the email scope;
+// unrestricted tokens receive all of them.
+func (s *OAuthService) userInfo(c *echo.Context) error {
+	ctx := c.Request().Context()
+	result := s.authenticator.Authenticate(ctx, c.Request().Header.Get("Authorization"))
+	if result == nil {
+		c.Response().Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		return writeError(c, http.StatusUnauthorized, "invalid_token", "access token is missing, invalid or expired")
+	}
+
+	user := result.User
+	if user == nil {
+		var err error
+		user, err = s.store.GetUser(ctx, &store.FindUser{ID: &result.Claims.UserID})
+		if err != nil {
+			return writeError(c, http.StatusInternalServerError, "server_error", err.Error())
+		}
+		if user == nil {
+			c.Response().Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			return writeError(c, http.StatusUnauthorized, "invalid_token", "user not found")
+		}
+	}
+
+	claims := map[string]any{
+		"sub": fmt.Sprint(user.ID),
+	}
+	if grantsClaims(result.Scopes, auth.ScopeProfile) {
+		claims["preferred_username"] = user.Username
+		claims["name"] = user.Nickname
+		if user.Nickname == "" {
+			claims["name"] = user.Username
+		}
+	}
+	if grantsClaims(result.Scopes, auth.ScopeEmail) && user.Email != "" {
+		claims["email"] = user.Email
+		claims["email_verified"] = user.EmailVerified
+	}
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	return c.JSON(http.StatusOK, claims)
+}
+
+// grantsClaims reports whether a token may read the claims covered by an OpenID Connect scope.
+// OIDC scopes are not API scopes, so HasScope cannot be used here.
+func grantsClaims(granted []string, scope string) bool {
+	if len(granted) == 0 {
+		return true
+	}
+	for _, s := range granted {
+		if s == scope || s == auth.ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
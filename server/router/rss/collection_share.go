@@ -0,0 +1,196 @@
+package rss
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v5"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// collectionShareTemplate renders the read-only page of a collection share.
+var collectionShareTemplate = template.Must(template.New("collection-share").Parse(`<!DOCTYPE html>
+<html lang="{{.Language}}">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>{{.Title}}</title>
+<link rel="alternate" type="application/rss+xml" title="{{.Title}}" href="{{.FeedURL}}">
+<style>
+body { max-width: 42rem; margin: 0 auto; padding: 1rem; font-family: system-ui, sans-serif; line-height: 1.6; color: #1f2937; }
+header { border-bottom: 1px solid #e5e7eb; margin-bottom: 1rem; }
+article { border-bottom: 1px solid #e5e7eb; padding: 0.5rem 0 1rem; overflow-wrap: anywhere; }
+time { color: #6b7280; font-size: 0.875rem; }
+img { max-width: 100%; }
+</style>
+</head>
+<body>
+<header>
+<h1>{{.Title}}</h1>
+<p>{{.Author}} · <a href="{{.FeedURL}}">RSS</a></p>
+</header>
+<main>
+{{range .Memos}}<article id="{{.UID}}">
+<time datetime="{{.CreateTime.Format "2006-01-02T15:04:05Z07:00"}}">{{.CreateTime.Format "2006-01-02 15:04"}}</time>
+{{.Content}}
+</article>
+{{else}}<p>No memos yet.</p>
+{{end}}</main>
+</body>
+</html>
+`))
+
+type collectionSharePage struct {
+	Language string
+	Title    string
+	Author   string
+	FeedURL  string
+	Memos    []collectionShareMemo
+}
+
+type collectionShareMemo struct {
+	UID        string
+	CreateTime time.Time
+	Content    template.HTML
+}
+
+// GetCollectionShareRSS serves the RSS feed of a collection share.
+func (s *RSSService) GetCollectionShareRSS(c *echo.Context) error {
+	ctx := c.Request().Context()
+	token := c.Param("token")
+	share, creator, memoList, err := s.listCollectionShareMemos(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	baseURL := c.Scheme() + "://" + c.Request().Host
+	shareURL := baseURL + "/shares/" + share.UID
+	// Link items to the share page, since viewers may not be able to open the memos themselves.
+	rss, lastModified, err := s.generateRSSFromMemoList(ctx, memoList, shareURL, creator, func(memo *store.Memo) string {
+		return shareURL + "#" + memo.UID
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate rss").Wrap(err)
+	}
+
+	// Share feeds are not cached, so that revoking a share takes effect immediately.
+	s.setRSSHeaders(c, feedETag(rss), lastModified)
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-cache")
+	return c.String(http.StatusOK, rss)
+}
+
+// GetCollectionShareHTML serves the read-only page of a collection share.
+func (s *RSSService) GetCollectionShareHTML(c *echo.Context) error {
+	ctx := c.Request().Context()
+	token := c.Param("token")
+	share, creator, memoList, err := s.listCollectionShareMemos(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	rssHeading, err := getRSSHeading(ctx, s.Store)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get instance profile").Wrap(err)
+	}
+	title, err := s.getCollectionShareTitle(ctx, share)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get collection title").Wrap(err)
+	}
+	author := creator.Nickname
+	if author == "" {
+		author = creator.Username
+	}
+	page := collectionSharePage{
+		Language: rssHeading.Language,
+		Title:    title,
+		Author:   author,
+		FeedURL:  "/shares/" + share.UID + "/rss.xml",
+		Memos:    make([]collectionShareMemo, 0, len(memoList)),
+	}
+	for _, memo := range memoList {
+		content, err := s.getRSSItemDescription(memo.Content)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to render memo").Wrap(err)
+		}
+		page.Memos = append(page.Memos, collectionShareMemo{
+			UID:        memo.UID,
+			CreateTime: time.Unix(memo.CreatedTs, 0).UTC(),
+			// The markdown renderer escapes raw HTML, so the output is safe to embed.
+			Content: template.HTML(content),
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := collectionShareTemplate.Execute(&buf, page); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to render page").Wrap(err)
+	}
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-cache")
+	return c.HTMLBlob(http.StatusOK, buf.Bytes())
+}
+
+// listCollectionShareMemos resolves a collection share token to its creator and the
+// latest memos of the collection. Invalid and expired tokens, and shares of deleted
+// shortcuts, are all reported as not found.
+func (s *RSSService) listCollectionShareMemos(ctx context.Context, token string) (*store.CollectionShare, *store.User, []*store.Memo, error) {
+	share, err := s.Store.GetCollectionShare(ctx, &store.FindCollectionShare{UID: &token})
+	if err != nil {
+		return nil, nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to find collection share").Wrap(err)
+	}
+	if share == nil || share.IsExpired() {
+		return nil, nil, nil, echo.NewHTTPError(http.StatusNotFound, "Collection share not found")
+	}
+	filters, err := s.Store.GetCollectionShareFilters(ctx, share)
+	if err != nil {
+		return nil, nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to resolve collection share").Wrap(err)
+	}
+	if filters == nil {
+		return nil, nil, nil, echo.NewHTTPError(http.StatusNotFound, "Collection share not found")
+	}
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &share.CreatorID})
+	if err != nil {
+		return nil, nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").Wrap(err)
+	}
+	if creator == nil {
+		return nil, nil, nil, echo.NewHTTPError(http.StatusNotFound, "Collection share not found")
+	}
+
+	normalStatus := store.Normal
+	limit := maxRSSItemCount
+	memoList, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus:        &normalStatus,
+		ExcludeComments:  true,
+		ExcludeScheduled: true,
+		Filters:          filters,
+		Limit:            &limit,
+	})
+	if err != nil {
+		return nil, nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").Wrap(err)
+	}
+	return share, creator, memoList, nil
+}
+
+// getCollectionShareTitle returns the shared tag, or the title of the shared shortcut.
+func (s *RSSService) getCollectionShareTitle(ctx context.Context, share *store.CollectionShare) (string, error) {
+	if share.Tag != "" {
+		return "#" + share.Tag, nil
+	}
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &share.CreatorID,
+		Key:    storepb.UserSetting_SHORTCUTS,
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, shortcut := range userSetting.GetShortcuts().GetShortcuts() {
+		if shortcut.GetId() == share.ShortcutID {
+			return shortcut.GetTitle(), nil
+		}
+	}
+	return "", nil
+}
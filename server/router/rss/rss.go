@@ -67,6 +67,8 @@ func NewRSSService(profile *profile.Profile, store *store.Store, markdownService
 func (s *RSSService) RegisterRoutes(g *echo.Group) {
 	g.GET("/explore/rss.xml", s.GetExploreRSS)
 	g.GET("/u/:username/rss.xml", s.GetUserRSS)
+	g.GET("/shares/:token", s.GetCollectionShareHTML)
+	g.GET("/shares/:token/rss.xml", s.GetCollectionShareRSS)
 }
 
 func (s *RSSService) GetExploreRSS(c *echo.Context) error {
@@ -97,7 +99,7 @@ func (s *RSSService) GetExploreRSS(c *echo.Context) error {
 	}
 
 	baseURL := c.Scheme() + "://" + c.Request().Host
-	rss, lastModified, err := s.generateRSSFromMemoList(ctx, memoList, baseURL, nil)
+	rss, lastModified, err := s.generateRSSFromMemoList(ctx, memoList, baseURL, nil, memoURL(baseURL))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate rss").Wrap(err)
 	}
@@ -148,7 +150,7 @@ func (s *RSSService) GetUserRSS(c *echo.Context) error {
 	}
 
 	baseURL := c.Scheme() + "://" + c.Request().Host
-	rss, lastModified, err := s.generateRSSFromMemoList(ctx, memoList, baseURL, user)
+	rss, lastModified, err := s.generateRSSFromMemoList(ctx, memoList, baseURL, user, memoURL(baseURL))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate rss").Wrap(err)
 	}
@@ -159,7 +161,7 @@ func (s *RSSService) GetUserRSS(c *echo.Context) error {
 	return c.String(http.StatusOK, rss)
 }
 
-func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*store.Memo, baseURL string, user *store.User) (string, time.Time, error) {
+func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*store.Memo, baseURL string, user *store.User, itemURL func(memo *store.Memo) string) (string, time.Time, error) {
 	rssHeading, err := getRSSHeading(ctx, s.Store)
 	if err != nil {
 		return "", time.Time{}, err
@@ -248,7 +250,7 @@ func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*st
 			return "", lastModified, err
 		}
 
-		link := &feeds.Link{Href: baseURL + "/memos/" + memo.UID}
+		link := &feeds.Link{Href: itemURL(memo)}
 
 		item := &feeds.Item{
 			Title:       title,
@@ -299,6 +301,13 @@ func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*st
 	return rss, lastModified, nil
 }
 
+// memoURL returns the item URL of a memo on the memo page.
+func memoURL(baseURL string) func(memo *store.Memo) string {
+	return func(memo *store.Memo) string {
+		return baseURL + "/memos/" + memo.UID
+	}
+}
+
 func (*RSSService) generateItemTitle(content string) string {
 	// Extract first line as title
 	lines := strings.Split(content, "\n")
@@ -370,9 +379,7 @@ func (s *RSSService) putInCache(key, content string, lastModified time.Time) str
 	s.cacheMutex.Lock()
 	defer s.cacheMutex.Unlock()
 
-	// Generate ETag from content hash
-	hash := sha256.Sum256([]byte(content))
-	etag := fmt.Sprintf(`"%x"`, hash[:8])
+	etag := feedETag(content)
 
 	// Implement simple LRU: if cache is too large, remove oldest entries
 	if len(s.cache) >= maxCacheSize {
@@ -399,6 +406,12 @@ func (s *RSSService) putInCache(key, content string, lastModified time.Time) str
 	return etag
 }
 
+// feedETag generates an ETag from the feed content hash.
+func feedETag(content string) string {
+	hash := sha256.Sum256([]byte(content))
+	return fmt.Sprintf(`"%x"`, hash[:8])
+}
+
 // setRSSHeaders sets appropriate HTTP headers for RSS responses.
 func (*RSSService) setRSSHeaders(c *echo.Context, etag string, lastModified time.Time) {
 	c.Response().Header().Set(echo.HeaderContentType, "application/rss+xml; charset=utf-8")
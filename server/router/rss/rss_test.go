@@ -12,6 +12,7 @@ import (
 
 	"github.com/usememos/memos/internal/markdown"
 	"github.com/usememos/memos/internal/profile"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 	teststore "github.com/usememos/memos/store/test"
 )
@@ -84,3 +85,69 @@ func renderRSS(t *testing.T, service *RSSService, target string, username string
 	require.Equal(t, http.StatusOK, rec.Code)
 	return rec.Body.String()
 }
+
+func TestCollectionShareViews(t *testing.T) {
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	defer stores.Close()
+
+	user, err := stores.CreateUser(ctx, &store.User{
+		Username: "rss-share-owner",
+		Role:     store.RoleUser,
+		Email:    "rss-share-owner@example.com",
+	})
+	require.NoError(t, err)
+
+	_, err = stores.CreateMemo(ctx, &store.Memo{
+		UID:        "rss-share-private",
+		CreatorID:  user.ID,
+		Content:    "private recipe <script>alert(1)</script>",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoPayload{Tags: []string{"recipes"}},
+	})
+	require.NoError(t, err)
+	_, err = stores.CreateMemo(ctx, &store.Memo{
+		UID:        "rss-share-other",
+		CreatorID:  user.ID,
+		Content:    "unrelated memo",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	share, err := stores.CreateCollectionShare(ctx, &store.CollectionShare{UID: "rss-share-token", CreatorID: user.ID, Tag: "recipes"})
+	require.NoError(t, err)
+
+	service := NewRSSService(&profile.Profile{}, stores, markdown.NewService())
+
+	rec := renderCollectionShare(t, service.GetCollectionShareRSS, share.UID)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "private recipe")
+	require.Contains(t, rec.Body.String(), "http://example.com/shares/rss-share-token#rss-share-private")
+	require.NotContains(t, rec.Body.String(), "unrelated memo")
+
+	rec = renderCollectionShare(t, service.GetCollectionShareHTML, share.UID)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "<h1>#recipes</h1>")
+	require.Contains(t, rec.Body.String(), "private recipe")
+	require.NotContains(t, rec.Body.String(), "<script>")
+	require.NotContains(t, rec.Body.String(), "unrelated memo")
+
+	// Revoked shares are not found.
+	require.NoError(t, stores.DeleteCollectionShare(ctx, &store.DeleteCollectionShare{ID: &share.ID}))
+	e := echo.New()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/shares/"+share.UID, nil), httptest.NewRecorder())
+	c.SetPathValues(echo.PathValues{{Name: "token", Value: share.UID}})
+	require.Equal(t, http.StatusNotFound, echo.StatusCode(service.GetCollectionShareHTML(c)))
+}
+
+func renderCollectionShare(t *testing.T, handler echo.HandlerFunc, token string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/shares/"+token, nil)
+	req.Host = "example.com"
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPathValues(echo.PathValues{{Name: "token", Value: token}})
+	require.NoError(t, handler(c))
+	return rec
+}
@@ -0,0 +1,59 @@
+package auditlog
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/store"
+)
+
+// DefaultRetention is how long audit log entries are kept before they are purged.
+const DefaultRetention = 180 * 24 * time.Hour
+
+// Schedule runner every 24 hours.
+const runnerInterval = time.Hour * 24
+
+// Runner purges audit log entries older than its retention period.
+type Runner struct {
+	Store     *store.Store
+	Retention time.Duration
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store:     store,
+		Retention: DefaultRetention,
+	}
+}
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	r.PurgeExpired(ctx)
+}
+
+// PurgeExpired deletes audit log entries created before the retention cutoff.
+func (r *Runner) PurgeExpired(ctx context.Context) {
+	cutoff := time.Now().Add(-r.Retention).Unix()
+	deleted, err := r.Store.DeleteAuditLogs(ctx, &store.DeleteAuditLogs{CreatedTsBefore: cutoff})
+	if err != nil {
+		slog.Error("failed to purge expired audit logs", "error", err)
+		return
+	}
+	if deleted > 0 {
+		slog.Info("purged expired audit logs", "count", deleted)
+	}
+}
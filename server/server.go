@@ -16,12 +16,15 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/internal/scheduler"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/server/router/fileserver"
 	"github.com/usememos/memos/server/router/frontend"
 	mcprouter "github.com/usememos/memos/server/router/mcp"
+	"github.com/usememos/memos/server/router/oauth"
 	"github.com/usememos/memos/server/router/rss"
+	"github.com/usememos/memos/server/runner/auditlog"
 	"github.com/usememos/memos/server/runner/s3presign"
 	"github.com/usememos/memos/store"
 )
@@ -36,6 +39,7 @@ type Server struct {
 	echoServer *echo.Echo
 	httpServer *http.Server
 	sseHub     *apiv1.SSEHub
+	scheduler  *scheduler.Scheduler
 
 	backgroundRunnerCancels []context.CancelFunc
 	backgroundRunnerWG      sync.WaitGroup
@@ -75,6 +79,10 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store)
 	s.sseHub = apiV1Service.SSEHub
 
+	if err := s.registerScheduledJobs(apiV1Service); err != nil {
+		return nil, errors.Wrap(err, "failed to register scheduled jobs")
+	}
+
 	// Register HTTP file server routes BEFORE gRPC-Gateway to ensure proper range request handling for Safari.
 	// This uses native HTTP serving (http.ServeContent) instead of gRPC for video/audio files.
 	fileServerService := fileserver.NewFileServerService(s.Profile, s.Store, s.Secret)
@@ -92,6 +100,9 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	mcpService := mcprouter.NewMCPService(s.Profile, s.Store, s.Secret, apiV1Service)
 	mcpService.RegisterRoutes(echoServer)
 
+	// Register OAuth2 authorization server endpoints for third-party apps.
+	oauth.NewOAuthService(s.Profile, s.Store, s.Secret).RegisterRoutes(echoServer)
+
 	return s, nil
 }
 
@@ -138,6 +149,9 @@ func (s *Server) Shutdown(ctx context.Context) {
 	s.closeLongLivedConnections()
 	s.shutdownHTTPServer(ctx)
 	s.waitBackgroundRunners(ctx)
+	if err := s.scheduler.Stop(ctx); err != nil {
+		slog.Error("failed to stop scheduler", slog.String("error", err.Error()))
+	}
 
 	// Close database connection.
 	if err := s.Store.Close(); err != nil {
@@ -167,9 +181,114 @@ func (s *Server) startBackgroundRunners(ctx context.Context) {
 		slog.Info("s3presign runner stopped")
 	}()
 
+	// Create and start audit log retention runner
+	auditLogContext, auditLogCancel := context.WithCancel(ctx)
+	s.backgroundRunnerCancels = append(s.backgroundRunnerCancels, auditLogCancel)
+	auditLogRunner := auditlog.NewRunner(s.Store)
+	auditLogRunner.RunOnce(ctx)
+	s.backgroundRunnerWG.Add(1)
+	go func() {
+		defer s.backgroundRunnerWG.Done()
+		auditLogRunner.Run(auditLogContext)
+		slog.Info("auditlog runner stopped")
+	}()
+
+	if err := s.scheduler.Start(); err != nil {
+		slog.Error("failed to start scheduler", slog.String("error", err.Error()))
+	}
+
 	slog.Info("background runners started")
 }
 
+// registerScheduledJobs registers the periodic jobs run by the scheduler.
+func (s *Server) registerScheduledJobs(apiV1Service *apiv1.APIV1Service) error {
+	s.scheduler = scheduler.New(scheduler.WithMiddleware(scheduler.Recovery(func(jobName string, recovered any) {
+		slog.Error("scheduled job panicked", slog.String("job", jobName), slog.Any("panic", recovered))
+	})))
+	if err := s.scheduler.Register(&scheduler.Job{
+		Name:        "memo-publishing",
+		Schedule:    "* * * * *",
+		Description: "Publish the scheduled memos whose publish time has come",
+		Handler: func(ctx context.Context) error {
+			if err := apiV1Service.PublishScheduledMemos(ctx, time.Now()); err != nil {
+				slog.Error("failed to publish scheduled memos", slog.String("error", err.Error()))
+				return err
+			}
+			return nil
+		},
+	}); err != nil {
+		return err
+	}
+	if err := s.scheduler.Register(&scheduler.Job{
+		Name:        "memo-reminders",
+		Schedule:    "* * * * *",
+		Description: "Send the reminders of memos that fell due",
+		Handler: func(ctx context.Context) error {
+			if err := apiV1Service.DispatchMemoReminders(ctx, time.Now()); err != nil {
+				slog.Error("failed to dispatch memo reminders", slog.String("error", err.Error()))
+				return err
+			}
+			return nil
+		},
+	}); err != nil {
+		return err
+	}
+	if err := s.scheduler.Register(&scheduler.Job{
+		Name:        "notification-digest-hourly",
+		Schedule:    "0 * * * *",
+		Description: "Send the hourly notification digest emails",
+		Handler: func(ctx context.Context) error {
+			if err := apiV1Service.DispatchNotificationDigests(ctx, storepb.NotificationUserSetting_HOURLY_DIGEST, time.Now()); err != nil {
+				slog.Error("failed to dispatch hourly notification digests", slog.String("error", err.Error()))
+				return err
+			}
+			return nil
+		},
+	}); err != nil {
+		return err
+	}
+	if err := s.scheduler.Register(&scheduler.Job{
+		Name:        "notification-digest-daily",
+		Schedule:    "0 8 * * *",
+		Description: "Send the daily notification digest emails at 08:00 UTC",
+		Handler: func(ctx context.Context) error {
+			if err := apiV1Service.DispatchNotificationDigests(ctx, storepb.NotificationUserSetting_DAILY_DIGEST, time.Now()); err != nil {
+				slog.Error("failed to dispatch daily notification digests", slog.String("error", err.Error()))
+				return err
+			}
+			return nil
+		},
+	}); err != nil {
+		return err
+	}
+	if err := s.scheduler.Register(&scheduler.Job{
+		Name:        "weekly-recap",
+		Schedule:    "0 8 * * 1",
+		Description: "Send the weekly recap emails on Mondays at 08:00 UTC",
+		Handler: func(ctx context.Context) error {
+			if err := apiV1Service.DispatchWeeklyRecaps(ctx, time.Now()); err != nil {
+				slog.Error("failed to dispatch weekly recaps", slog.String("error", err.Error()))
+				return err
+			}
+			return nil
+		},
+	}); err != nil {
+		return err
+	}
+	return s.scheduler.Register(&scheduler.Job{
+		Name:        "webhook-deliveries",
+		Schedule:    "* * * * *",
+		Description: "Retry the pending webhook deliveries and purge old ones",
+		Handler: func(ctx context.Context) error {
+			if err := apiV1Service.DeliverPendingWebhooks(ctx, time.Now()); err != nil {
+				slog.Error("failed to deliver pending webhooks", slog.String("error", err.Error()))
+				return err
+			}
+			return nil
+		},
+	})
+}
+
 func (s *Server) stopBackgroundRunners() {
 	for _, cancelFunc := range s.backgroundRunnerCancels {
 		if cancelFunc != nil {
@@ -167,7 +167,15 @@ func (s *Store) DeleteAttachments(ctx context.Context, attachments []*Attachment
 	if err := s.driver.DeleteAttachments(ctx, deletes); err != nil {
 		return err
 	}
+	return s.deleteAttachmentsStorage(ctx, attachments)
+}
 
+// deleteAttachmentsStorage deletes the files of deleted attachments. It stops at the first local
+// file that cannot be deleted and only logs failures of other storage types.
+func (s *Store) deleteAttachmentsStorage(ctx context.Context, attachments []*Attachment) error {
+	if len(attachments) == 0 {
+		return nil
+	}
 	instanceStorageSetting, instanceStorageSettingErr := s.getAttachmentStorageCleanupInstanceSetting(ctx, attachments)
 	for _, attachment := range attachments {
 		if attachment == nil {
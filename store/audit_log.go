@@ -0,0 +1,72 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// AuditAction names a security-relevant action recorded in the audit log.
+type AuditAction string
+
+const (
+	AuditActionSignIn                 AuditAction = "SIGN_IN"
+	AuditActionSignOut                AuditAction = "SIGN_OUT"
+	AuditActionUpdateInstanceSetting  AuditAction = "UPDATE_INSTANCE_SETTING"
+	AuditActionDeleteUser             AuditAction = "DELETE_USER"
+	AuditActionCreateAccessToken      AuditAction = "CREATE_PERSONAL_ACCESS_TOKEN"
+	AuditActionCreateIdentityProvider AuditAction = "CREATE_IDENTITY_PROVIDER"
+	AuditActionUpdateIdentityProvider AuditAction = "UPDATE_IDENTITY_PROVIDER"
+	AuditActionDeleteIdentityProvider AuditAction = "DELETE_IDENTITY_PROVIDER"
+	AuditActionCreateMemoShare        AuditAction = "CREATE_MEMO_SHARE"
+	AuditActionCreateCollectionShare  AuditAction = "CREATE_COLLECTION_SHARE"
+)
+
+// AuditLog is an append-only record of who did what and from where.
+type AuditLog struct {
+	ID        int32
+	CreatedTs int64
+	// ActorID is the user who performed the action, or 0 when unknown.
+	ActorID int32
+	Action  AuditAction
+	// Resource is the API resource name the action applied to, e.g. "users/1".
+	Resource  string
+	Detail    string
+	IPAddress string
+	UserAgent string
+}
+
+// FindAuditLog is used to filter audit logs. Results are ordered newest first.
+type FindAuditLog struct {
+	ActorID        *int32
+	Action         *AuditAction
+	CreatedTsAfter *int64 // inclusive
+	// CreatedTsBefore is exclusive.
+	CreatedTsBefore *int64
+
+	Limit  *int
+	Offset *int
+}
+
+// DeleteAuditLogs removes audit logs created before CreatedTsBefore.
+// It exists only for retention; audit logs are never updated.
+type DeleteAuditLogs struct {
+	CreatedTsBefore int64
+}
+
+// CreateAuditLog appends an entry to the audit log.
+func (s *Store) CreateAuditLog(ctx context.Context, create *AuditLog) (*AuditLog, error) {
+	if create.CreatedTs == 0 {
+		create.CreatedTs = time.Now().Unix()
+	}
+	return s.driver.CreateAuditLog(ctx, create)
+}
+
+// ListAuditLogs returns audit logs matching the filter, newest first.
+func (s *Store) ListAuditLogs(ctx context.Context, find *FindAuditLog) ([]*AuditLog, error) {
+	return s.driver.ListAuditLogs(ctx, find)
+}
+
+// DeleteAuditLogs purges expired audit logs and returns how many were removed.
+func (s *Store) DeleteAuditLogs(ctx context.Context, delete *DeleteAuditLogs) (int64, error) {
+	return s.driver.DeleteAuditLogs(ctx, delete)
+}
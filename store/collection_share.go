@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// CollectionShare is an access grant that permits read-only access to the memos
+// matching one of the creator's shortcuts, or carrying a tag, via a bearer token.
+type CollectionShare struct {
+	ID        int32
+	UID       string
+	CreatorID int32
+	// Exactly one of ShortcutID and Tag is set.
+	ShortcutID string
+	Tag        string
+	CreatedTs  int64
+	ExpiresTs  *int64 // nil means the share never expires
+}
+
+// FindCollectionShare is used to filter collection shares in list/get queries.
+type FindCollectionShare struct {
+	ID        *int32
+	UID       *string
+	CreatorID *int32
+}
+
+// DeleteCollectionShare identifies a collection share grant to remove.
+type DeleteCollectionShare struct {
+	ID  *int32
+	UID *string
+}
+
+// CreateCollectionShare creates a new collection share grant.
+func (s *Store) CreateCollectionShare(ctx context.Context, create *CollectionShare) (*CollectionShare, error) {
+	return s.driver.CreateCollectionShare(ctx, create)
+}
+
+// ListCollectionShares returns all collection share grants matching the filter.
+func (s *Store) ListCollectionShares(ctx context.Context, find *FindCollectionShare) ([]*CollectionShare, error) {
+	return s.driver.ListCollectionShares(ctx, find)
+}
+
+// GetCollectionShare returns the first collection share grant matching the filter, or nil if none found.
+func (s *Store) GetCollectionShare(ctx context.Context, find *FindCollectionShare) (*CollectionShare, error) {
+	list, err := s.ListCollectionShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// DeleteCollectionShare removes a collection share grant.
+func (s *Store) DeleteCollectionShare(ctx context.Context, delete *DeleteCollectionShare) error {
+	return s.driver.DeleteCollectionShare(ctx, delete)
+}
+
+// IsExpired reports whether the collection share has passed its expiry time.
+func (share *CollectionShare) IsExpired() bool {
+	return share.ExpiresTs != nil && time.Now().Unix() > *share.ExpiresTs
+}
+
+// GetCollectionShareFilters returns the memo filters selecting the memos exposed by a
+// collection share: the shared shortcut's filter or tag, limited to the memos of the
+// share's creator. Callers must also exclude scheduled memos, which are not published yet.
+// It returns nil if the shared shortcut no longer exists.
+func (s *Store) GetCollectionShareFilters(ctx context.Context, share *CollectionShare) ([]string, error) {
+	creatorFilter := fmt.Sprintf("creator_id == %d", share.CreatorID)
+	if share.Tag != "" {
+		return []string{creatorFilter, fmt.Sprintf("tag in [%q]", share.Tag)}, nil
+	}
+
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &share.CreatorID,
+		Key:    storepb.UserSetting_SHORTCUTS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, shortcut := range userSetting.GetShortcuts().GetShortcuts() {
+		if shortcut.GetId() != share.ShortcutID {
+			continue
+		}
+		filters := []string{creatorFilter}
+		if shortcut.GetFilter() != "" {
+			filters = append(filters, shortcut.GetFilter())
+		}
+		return filters, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,120 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"strings"
+)
+
+// Permission is a named capability granted by a role.
+type Permission string
+
+const (
+	// PermissionManageUsers allows creating, updating, archiving and unlocking users and managing invitations.
+	PermissionManageUsers Permission = "MANAGE_USERS"
+	// PermissionManageSettings allows reading and updating instance settings.
+	PermissionManageSettings Permission = "MANAGE_SETTINGS"
+	// PermissionModerateMemos allows archiving and restoring other users' public and protected memos
+	// and managing instance tag metadata.
+	PermissionModerateMemos Permission = "MODERATE_MEMOS"
+	// PermissionViewStats allows reading instance statistics.
+	PermissionViewStats Permission = "VIEW_STATS"
+	// PermissionManageIdentityProviders allows creating, updating and deleting identity providers.
+	PermissionManageIdentityProviders Permission = "MANAGE_IDENTITY_PROVIDERS"
+)
+
+// AllPermissions lists every permission, in display order.
+var AllPermissions = []Permission{
+	PermissionManageUsers,
+	PermissionManageSettings,
+	PermissionModerateMemos,
+	PermissionViewStats,
+	PermissionManageIdentityProviders,
+}
+
+// CustomRole is an admin-defined role. Users assigned a custom role keep the
+// privileges of the USER role and additionally hold the role's permissions.
+// The role's Name is stored as the user's Role.
+type CustomRole struct {
+	ID          int32
+	Name        string
+	Description string
+	Permissions []Permission
+	CreatedTs   int64
+	UpdatedTs   int64
+}
+
+// HasPermission reports whether the role grants the permission.
+func (r *CustomRole) HasPermission(permission Permission) bool {
+	return slices.Contains(r.Permissions, permission)
+}
+
+// FormatPermissions serializes permissions as a space-separated list for storage.
+func FormatPermissions(permissions []Permission) string {
+	list := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		list = append(list, string(permission))
+	}
+	return strings.Join(list, " ")
+}
+
+// ParsePermissions parses a list serialized by FormatPermissions.
+func ParsePermissions(s string) []Permission {
+	permissions := []Permission{}
+	for _, field := range strings.Fields(s) {
+		permissions = append(permissions, Permission(field))
+	}
+	return permissions
+}
+
+// FindCustomRole is used to filter custom roles in list/get queries.
+type FindCustomRole struct {
+	ID   *int32
+	Name *string
+}
+
+// UpdateCustomRole describes changes to a custom role. The name is immutable
+// because it is referenced by users.
+type UpdateCustomRole struct {
+	ID          int32
+	UpdatedTs   *int64
+	Description *string
+	Permissions []Permission // nil leaves permissions unchanged
+}
+
+// DeleteCustomRole identifies a custom role to remove.
+type DeleteCustomRole struct {
+	ID int32
+}
+
+// CreateCustomRole creates a new custom role.
+func (s *Store) CreateCustomRole(ctx context.Context, create *CustomRole) (*CustomRole, error) {
+	return s.driver.CreateCustomRole(ctx, create)
+}
+
+// ListCustomRoles returns all custom roles matching the filter.
+func (s *Store) ListCustomRoles(ctx context.Context, find *FindCustomRole) ([]*CustomRole, error) {
+	return s.driver.ListCustomRoles(ctx, find)
+}
+
+// GetCustomRole returns the first custom role matching the filter, or nil if none found.
+func (s *Store) GetCustomRole(ctx context.Context, find *FindCustomRole) (*CustomRole, error) {
+	list, err := s.ListCustomRoles(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// UpdateCustomRole updates a custom role.
+func (s *Store) UpdateCustomRole(ctx context.Context, update *UpdateCustomRole) (*CustomRole, error) {
+	return s.driver.UpdateCustomRole(ctx, update)
+}
+
+// DeleteCustomRole removes a custom role. Callers must make sure no user is assigned to it.
+func (s *Store) DeleteCustomRole(ctx context.Context, delete *DeleteCustomRole) error {
+	return s.driver.DeleteCustomRole(ctx, delete)
+}
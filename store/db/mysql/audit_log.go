@@ -0,0 +1,89 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAuditLog(ctx context.Context, create *store.AuditLog) (*store.AuditLog, error) {
+	fields := []string{"`created_ts`", "`actor_id`", "`action`", "`resource`", "`detail`", "`ip_address`", "`user_agent`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
+	args := []any{create.CreatedTs, create.ActorID, create.Action, create.Resource, create.Detail, create.IPAddress, create.UserAgent}
+
+	stmt := "INSERT INTO `audit_log` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.ID = int32(rawID)
+	return create, nil
+}
+
+func (d *DB) ListAuditLogs(ctx context.Context, find *store.FindAuditLog) ([]*store.AuditLog, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ActorID != nil {
+		where, args = append(where, "`actor_id` = ?"), append(args, *find.ActorID)
+	}
+	if find.Action != nil {
+		where, args = append(where, "`action` = ?"), append(args, *find.Action)
+	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "`created_ts` >= ?"), append(args, *find.CreatedTsAfter)
+	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "`created_ts` < ?"), append(args, *find.CreatedTsBefore)
+	}
+
+	query := "SELECT `id`, `created_ts`, `actor_id`, `action`, `resource`, `detail`, `ip_address`, `user_agent` FROM `audit_log` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.AuditLog{}
+	for rows.Next() {
+		auditLog := &store.AuditLog{}
+		if err := rows.Scan(
+			&auditLog.ID,
+			&auditLog.CreatedTs,
+			&auditLog.ActorID,
+			&auditLog.Action,
+			&auditLog.Resource,
+			&auditLog.Detail,
+			&auditLog.IPAddress,
+			&auditLog.UserAgent,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, auditLog)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteAuditLogs(ctx context.Context, delete *store.DeleteAuditLogs) (int64, error) {
+	result, err := d.db.ExecContext(ctx, "DELETE FROM `audit_log` WHERE `created_ts` < ?", delete.CreatedTsBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
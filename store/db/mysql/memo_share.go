@@ -19,6 +19,16 @@ func (d *DB) CreateMemoShare(ctx context.Context, create *store.MemoShare) (*sto
 		placeholders = append(placeholders, "?")
 		args = append(args, *create.ExpiresTs)
 	}
+	if create.PasswordHash != "" {
+		fields = append(fields, "`password_hash`")
+		placeholders = append(placeholders, "?")
+		args = append(args, create.PasswordHash)
+	}
+	if create.MaxViews != nil {
+		fields = append(fields, "`max_views`")
+		placeholders = append(placeholders, "?")
+		args = append(args, *create.MaxViews)
+	}
 
 	stmt := "INSERT INTO `memo_share` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholders, ", ") + ")"
 	result, err := d.db.ExecContext(ctx, stmt, args...)
@@ -64,7 +74,11 @@ func (d *DB) ListMemoShares(ctx context.Context, find *store.FindMemoShare) ([]*
 			memo_id,
 			creator_id,
 			created_ts,
-			expires_ts
+			expires_ts,
+			password_hash,
+			max_views,
+			view_count,
+			last_accessed_ts
 		FROM memo_share
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY id ASC`,
@@ -85,6 +99,10 @@ func (d *DB) ListMemoShares(ctx context.Context, find *store.FindMemoShare) ([]*
 			&ms.CreatorID,
 			&ms.CreatedTs,
 			&ms.ExpiresTs,
+			&ms.PasswordHash,
+			&ms.MaxViews,
+			&ms.ViewCount,
+			&ms.LastAccessedTs,
 		); err != nil {
 			return nil, err
 		}
@@ -108,6 +126,21 @@ func (d *DB) GetMemoShare(ctx context.Context, find *store.FindMemoShare) (*stor
 	return list[0], nil
 }
 
+func (d *DB) RecordMemoShareView(ctx context.Context, id int32, accessedTs int64) (bool, error) {
+	result, err := d.db.ExecContext(ctx,
+		"UPDATE `memo_share` SET `view_count` = `view_count` + 1, `last_accessed_ts` = ? WHERE `id` = ? AND (`max_views` IS NULL OR `view_count` < `max_views`)",
+		accessedTs, id,
+	)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
 func (d *DB) DeleteMemoShare(ctx context.Context, delete *store.DeleteMemoShare) error {
 	where, args := []string{"1 = 1"}, []any{}
 	if delete.ID != nil {
@@ -0,0 +1,220 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateOAuthClient(ctx context.Context, create *store.OAuthClient) (*store.OAuthClient, error) {
+	stmt := "INSERT INTO `oauth_client` (`client_id`, `client_secret_hash`, `name`, `creator_id`, `redirect_uris`, `scope`) VALUES (?, ?, ?, ?, ?, ?)"
+	result, err := d.db.ExecContext(ctx, stmt,
+		create.ClientID,
+		create.ClientSecretHash,
+		create.Name,
+		create.CreatorID,
+		strings.Join(create.RedirectURIs, " "),
+		strings.Join(create.Scopes, " "),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListOAuthClients(ctx, &store.FindOAuthClient{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("failed to create oauth client")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListOAuthClients(ctx context.Context, find *store.FindOAuthClient) ([]*store.OAuthClient, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.ClientID != nil {
+		where, args = append(where, "`client_id` = ?"), append(args, *find.ClientID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			client_id,
+			client_secret_hash,
+			name,
+			creator_id,
+			redirect_uris,
+			scope,
+			created_ts
+		FROM oauth_client
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts DESC, id DESC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.OAuthClient{}
+	for rows.Next() {
+		client := &store.OAuthClient{}
+		var redirectURIs, scope string
+		if err := rows.Scan(
+			&client.ID,
+			&client.ClientID,
+			&client.ClientSecretHash,
+			&client.Name,
+			&client.CreatorID,
+			&redirectURIs,
+			&scope,
+			&client.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		client.RedirectURIs = strings.Fields(redirectURIs)
+		client.Scopes = strings.Fields(scope)
+		list = append(list, client)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteOAuthClient(ctx context.Context, delete *store.DeleteOAuthClient) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `oauth_client` WHERE `id` = ?", delete.ID)
+	return err
+}
+
+func (d *DB) CreateOAuthToken(ctx context.Context, create *store.OAuthToken) (*store.OAuthToken, error) {
+	stmt := "INSERT INTO `oauth_token` (`kind`, `token_hash`, `client_id`, `user_id`, `scope`, `redirect_uri`, `code_challenge`, `expires_ts`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	result, err := d.db.ExecContext(ctx, stmt,
+		create.Kind,
+		create.TokenHash,
+		create.ClientID,
+		create.UserID,
+		strings.Join(create.Scopes, " "),
+		create.RedirectURI,
+		create.CodeChallenge,
+		create.ExpiresTs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := result.LastInsertId(); err != nil {
+		return nil, err
+	}
+	list, err := d.ListOAuthTokens(ctx, &store.FindOAuthToken{TokenHash: &create.TokenHash})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("failed to create oauth token")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListOAuthTokens(ctx context.Context, find *store.FindOAuthToken) ([]*store.OAuthToken, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.Kind != nil {
+		where, args = append(where, "`kind` = ?"), append(args, *find.Kind)
+	}
+	if find.TokenHash != nil {
+		where, args = append(where, "`token_hash` = ?"), append(args, *find.TokenHash)
+	}
+	if find.ClientID != nil {
+		where, args = append(where, "`client_id` = ?"), append(args, *find.ClientID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			kind,
+			token_hash,
+			client_id,
+			user_id,
+			scope,
+			redirect_uri,
+			code_challenge,
+			created_ts,
+			expires_ts
+		FROM oauth_token
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts DESC, id DESC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.OAuthToken{}
+	for rows.Next() {
+		token := &store.OAuthToken{}
+		var scope string
+		if err := rows.Scan(
+			&token.ID,
+			&token.Kind,
+			&token.TokenHash,
+			&token.ClientID,
+			&token.UserID,
+			&scope,
+			&token.RedirectURI,
+			&token.CodeChallenge,
+			&token.CreatedTs,
+			&token.ExpiresTs,
+		); err != nil {
+			return nil, err
+		}
+		token.Scopes = strings.Fields(scope)
+		list = append(list, token)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteOAuthTokens(ctx context.Context, delete *store.DeleteOAuthTokens) (int64, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *delete.ID)
+	}
+	if delete.ClientID != nil {
+		where, args = append(where, "`client_id` = ?"), append(args, *delete.ClientID)
+	}
+	if delete.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *delete.UserID)
+	}
+	if delete.ExpiredTs != nil {
+		where, args = append(where, "`expires_ts` < ?"), append(args, *delete.ExpiredTs)
+	}
+	result, err := d.db.ExecContext(ctx, "DELETE FROM `oauth_token` WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
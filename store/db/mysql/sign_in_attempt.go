@@ -0,0 +1,124 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertSignInAttempt(ctx context.Context, upsert *store.SignInAttempt) (*store.SignInAttempt, error) {
+	stmt := "INSERT INTO `sign_in_attempt` (`kind`, `identifier`, `failure_count`, `last_failure_ts`, `locked_until_ts`) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `failure_count` = ?, `last_failure_ts` = ?, `locked_until_ts` = ?"
+	_, err := d.db.ExecContext(
+		ctx,
+		stmt,
+		string(upsert.Kind),
+		upsert.Identifier,
+		upsert.FailureCount,
+		upsert.LastFailureTs,
+		upsert.LockedUntilTs,
+		upsert.FailureCount,
+		upsert.LastFailureTs,
+		upsert.LockedUntilTs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) IncrementSignInAttempt(ctx context.Context, increment *store.IncrementSignInAttempt) (*store.SignInAttempt, error) {
+	// MySQL evaluates assignments left to right, so failure_count is updated before last_failure_ts.
+	stmt := "INSERT INTO `sign_in_attempt` (`kind`, `identifier`, `failure_count`, `last_failure_ts`) VALUES (?, ?, 1, ?) " +
+		"ON DUPLICATE KEY UPDATE `failure_count` = IF(`last_failure_ts` < ?, 1, `failure_count` + 1), `last_failure_ts` = VALUES(`last_failure_ts`)"
+	if _, err := d.db.ExecContext(ctx, stmt, string(increment.Kind), increment.Identifier, increment.FailureTs, increment.ResetBeforeTs); err != nil {
+		return nil, err
+	}
+	kind := increment.Kind
+	list, err := d.ListSignInAttempts(ctx, &store.FindSignInAttempt{Kind: &kind, Identifier: &increment.Identifier})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.New("sign-in attempt not found after increment")
+	}
+	return list[0], nil
+}
+
+func (d *DB) LockSignInAttempt(ctx context.Context, lock *store.LockSignInAttempt) error {
+	stmt := "UPDATE `sign_in_attempt` SET `locked_until_ts` = GREATEST(`locked_until_ts`, ?) WHERE `kind` = ? AND `identifier` = ?"
+	if _, err := d.db.ExecContext(ctx, stmt, lock.LockedUntilTs, string(lock.Kind), lock.Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) ListSignInAttempts(ctx context.Context, find *store.FindSignInAttempt) ([]*store.SignInAttempt, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.Kind != nil {
+		where, args = append(where, "`kind` = ?"), append(args, string(*find.Kind))
+	}
+	if find.Identifier != nil {
+		where, args = append(where, "`identifier` = ?"), append(args, *find.Identifier)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			kind,
+			identifier,
+			failure_count,
+			last_failure_ts,
+			locked_until_ts
+		FROM sign_in_attempt
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.SignInAttempt{}
+	for rows.Next() {
+		attempt := &store.SignInAttempt{}
+		var kind string
+		if err := rows.Scan(
+			&attempt.ID,
+			&kind,
+			&attempt.Identifier,
+			&attempt.FailureCount,
+			&attempt.LastFailureTs,
+			&attempt.LockedUntilTs,
+		); err != nil {
+			return nil, err
+		}
+		attempt.Kind = store.SignInAttemptKind(kind)
+		list = append(list, attempt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteSignInAttempts(ctx context.Context, delete *store.DeleteSignInAttempt) error {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if delete.Kind != nil {
+		where, args = append(where, "`kind` = ?"), append(args, string(*delete.Kind))
+	}
+	if delete.Identifier != nil {
+		where, args = append(where, "`identifier` = ?"), append(args, *delete.Identifier)
+	}
+
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM sign_in_attempt WHERE "+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	return nil
+}
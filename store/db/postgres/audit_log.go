@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAuditLog(ctx context.Context, create *store.AuditLog) (*store.AuditLog, error) {
+	fields := []string{"created_ts", "actor_id", "action", "resource", "detail", "ip_address", "user_agent"}
+	args := []any{create.CreatedTs, create.ActorID, create.Action, create.Resource, create.Detail, create.IPAddress, create.UserAgent}
+
+	stmt := "INSERT INTO audit_log (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListAuditLogs(ctx context.Context, find *store.FindAuditLog) ([]*store.AuditLog, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ActorID != nil {
+		where, args = append(where, "actor_id = "+placeholder(len(args)+1)), append(args, *find.ActorID)
+	}
+	if find.Action != nil {
+		where, args = append(where, "action = "+placeholder(len(args)+1)), append(args, *find.Action)
+	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts >= "+placeholder(len(args)+1)), append(args, *find.CreatedTsAfter)
+	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts < "+placeholder(len(args)+1)), append(args, *find.CreatedTsBefore)
+	}
+
+	query := "SELECT id, created_ts, actor_id, action, resource, detail, ip_address, user_agent FROM audit_log WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC, id DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.AuditLog{}
+	for rows.Next() {
+		auditLog := &store.AuditLog{}
+		if err := rows.Scan(
+			&auditLog.ID,
+			&auditLog.CreatedTs,
+			&auditLog.ActorID,
+			&auditLog.Action,
+			&auditLog.Resource,
+			&auditLog.Detail,
+			&auditLog.IPAddress,
+			&auditLog.UserAgent,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, auditLog)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteAuditLogs(ctx context.Context, delete *store.DeleteAuditLogs) (int64, error) {
+	result, err := d.db.ExecContext(ctx, "DELETE FROM audit_log WHERE created_ts < $1", delete.CreatedTsBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
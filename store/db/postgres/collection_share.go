@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateCollectionShare(ctx context.Context, create *store.CollectionShare) (*store.CollectionShare, error) {
+	fields := []string{"uid", "creator_id", "shortcut_id", "tag"}
+	args := []any{create.UID, create.CreatorID, create.ShortcutID, create.Tag}
+
+	if create.ExpiresTs != nil {
+		fields = append(fields, "expires_ts")
+		args = append(args, *create.ExpiresTs)
+	}
+
+	stmt := "INSERT INTO collection_share (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListCollectionShares(ctx context.Context, find *store.FindCollectionShare) ([]*store.CollectionShare, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.UID != nil {
+		where, args = append(where, "uid = "+placeholder(len(args)+1)), append(args, *find.UID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *find.CreatorID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			uid,
+			creator_id,
+			shortcut_id,
+			tag,
+			created_ts,
+			expires_ts
+		FROM collection_share
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.CollectionShare{}
+	for rows.Next() {
+		share := &store.CollectionShare{}
+		if err := rows.Scan(
+			&share.ID,
+			&share.UID,
+			&share.CreatorID,
+			&share.ShortcutID,
+			&share.Tag,
+			&share.CreatedTs,
+			&share.ExpiresTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, share)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteCollectionShare(ctx context.Context, delete *store.DeleteCollectionShare) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *delete.ID)
+	}
+	if delete.UID != nil {
+		where, args = append(where, "uid = "+placeholder(len(args)+1)), append(args, *delete.UID)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM collection_share WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoCollaborator(ctx context.Context, upsert *store.MemoCollaborator) (*store.MemoCollaborator, error) {
+	stmt := `
+		INSERT INTO memo_collaborator (
+			memo_id, user_id, role
+		)
+		VALUES ($1, $2, $3)
+		ON CONFLICT(memo_id, user_id) DO UPDATE SET role = EXCLUDED.role
+		RETURNING memo_id, user_id, role, created_ts
+	`
+	collaborator := &store.MemoCollaborator{}
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.MemoID, upsert.UserID, upsert.Role.String()).Scan(
+		&collaborator.MemoID,
+		&collaborator.UserID,
+		&collaborator.Role,
+		&collaborator.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return collaborator, nil
+}
+
+func (d *DB) ListMemoCollaborators(ctx context.Context, find *store.FindMemoCollaborator) ([]*store.MemoCollaborator, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+	if find.Role != nil {
+		where, args = append(where, "role = "+placeholder(len(args)+1)), append(args, find.Role.String())
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			memo_id,
+			user_id,
+			role,
+			created_ts
+		FROM memo_collaborator
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts ASC, user_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoCollaborator{}
+	for rows.Next() {
+		collaborator := &store.MemoCollaborator{}
+		if err := rows.Scan(
+			&collaborator.MemoID,
+			&collaborator.UserID,
+			&collaborator.Role,
+			&collaborator.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, collaborator)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteMemoCollaborator(ctx context.Context, delete *store.DeleteMemoCollaborator) error {
+	where, args := []string{"memo_id = $1"}, []any{delete.MemoID}
+	if delete.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *delete.UserID)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM memo_collaborator WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
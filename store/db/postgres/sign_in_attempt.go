@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertSignInAttempt(ctx context.Context, upsert *store.SignInAttempt) (*store.SignInAttempt, error) {
+	stmt := `
+		INSERT INTO sign_in_attempt (
+			kind, identifier, failure_count, last_failure_ts, locked_until_ts
+		)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT(kind, identifier) DO UPDATE
+		SET
+			failure_count = EXCLUDED.failure_count,
+			last_failure_ts = EXCLUDED.last_failure_ts,
+			locked_until_ts = EXCLUDED.locked_until_ts
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, string(upsert.Kind), upsert.Identifier, upsert.FailureCount, upsert.LastFailureTs, upsert.LockedUntilTs); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) IncrementSignInAttempt(ctx context.Context, increment *store.IncrementSignInAttempt) (*store.SignInAttempt, error) {
+	stmt := `
+		INSERT INTO sign_in_attempt (
+			kind, identifier, failure_count, last_failure_ts
+		)
+		VALUES ($1, $2, 1, $3)
+		ON CONFLICT(kind, identifier) DO UPDATE
+		SET
+			failure_count = CASE WHEN sign_in_attempt.last_failure_ts < $4 THEN 1 ELSE sign_in_attempt.failure_count + 1 END,
+			last_failure_ts = EXCLUDED.last_failure_ts
+		RETURNING id, failure_count, last_failure_ts, locked_until_ts
+	`
+	attempt := &store.SignInAttempt{Kind: increment.Kind, Identifier: increment.Identifier}
+	if err := d.db.QueryRowContext(ctx, stmt, string(increment.Kind), increment.Identifier, increment.FailureTs, increment.ResetBeforeTs).Scan(
+		&attempt.ID,
+		&attempt.FailureCount,
+		&attempt.LastFailureTs,
+		&attempt.LockedUntilTs,
+	); err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+func (d *DB) LockSignInAttempt(ctx context.Context, lock *store.LockSignInAttempt) error {
+	stmt := "UPDATE sign_in_attempt SET locked_until_ts = GREATEST(locked_until_ts, $1) WHERE kind = $2 AND identifier = $3"
+	if _, err := d.db.ExecContext(ctx, stmt, lock.LockedUntilTs, string(lock.Kind), lock.Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) ListSignInAttempts(ctx context.Context, find *store.FindSignInAttempt) ([]*store.SignInAttempt, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.Kind != nil {
+		where, args = append(where, "kind = "+placeholder(len(args)+1)), append(args, string(*find.Kind))
+	}
+	if find.Identifier != nil {
+		where, args = append(where, "identifier = "+placeholder(len(args)+1)), append(args, *find.Identifier)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			kind,
+			identifier,
+			failure_count,
+			last_failure_ts,
+			locked_until_ts
+		FROM sign_in_attempt
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.SignInAttempt{}
+	for rows.Next() {
+		attempt := &store.SignInAttempt{}
+		var kind string
+		if err := rows.Scan(
+			&attempt.ID,
+			&kind,
+			&attempt.Identifier,
+			&attempt.FailureCount,
+			&attempt.LastFailureTs,
+			&attempt.LockedUntilTs,
+		); err != nil {
+			return nil, err
+		}
+		attempt.Kind = store.SignInAttemptKind(kind)
+		list = append(list, attempt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteSignInAttempts(ctx context.Context, delete *store.DeleteSignInAttempt) error {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if delete.Kind != nil {
+		where, args = append(where, "kind = "+placeholder(len(args)+1)), append(args, string(*delete.Kind))
+	}
+	if delete.Identifier != nil {
+		where, args = append(where, "identifier = "+placeholder(len(args)+1)), append(args, *delete.Identifier)
+	}
+
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM sign_in_attempt WHERE "+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -13,10 +13,11 @@ import (
 func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, error) {
 	fields := []string{"username", "role", "email", "nickname", "password_hash", "avatar_url"}
 	args := []any{create.Username, create.Role, create.Email, create.Nickname, create.PasswordHash, create.AvatarURL}
-	stmt := "INSERT INTO \"user\" (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, description, created_ts, updated_ts, row_status"
+	stmt := "INSERT INTO \"user\" (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, description, email_verified, created_ts, updated_ts, row_status"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.Description,
+		&create.EmailVerified,
 		&create.CreatedTs,
 		&create.UpdatedTs,
 		&create.RowStatus,
@@ -56,12 +57,15 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	if v := update.Role; v != nil {
 		set, args = append(set, "role = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := update.EmailVerified; v != nil {
+		set, args = append(set, "email_verified = "+placeholder(len(args)+1)), append(args, *v)
+	}
 
 	query := `
 		UPDATE "user"
 		SET ` + strings.Join(set, ", ") + `
 		WHERE id = ` + placeholder(len(args)+1) + `
-		RETURNING id, username, role, email, nickname, password_hash, avatar_url, description, created_ts, updated_ts, row_status
+		RETURNING id, username, role, email, nickname, password_hash, avatar_url, description, email_verified, created_ts, updated_ts, row_status
 	`
 	args = append(args, update.ID)
 	user := &store.User{}
@@ -74,6 +78,7 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 		&user.PasswordHash,
 		&user.AvatarURL,
 		&user.Description,
+		&user.EmailVerified,
 		&user.CreatedTs,
 		&user.UpdatedTs,
 		&user.RowStatus,
@@ -149,6 +154,7 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			password_hash,
 			avatar_url,
 			description,
+			email_verified,
 			created_ts,
 			updated_ts,
 			row_status
@@ -175,6 +181,7 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			&user.PasswordHash,
 			&user.AvatarURL,
 			&user.Description,
+			&user.EmailVerified,
 			&user.CreatedTs,
 			&user.UpdatedTs,
 			&user.RowStatus,
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateWebhookDelivery(ctx context.Context, create *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	fields := []string{"uid", "creator_id", "webhook_id", "activity_type", "url", "payload", "format", "status", "next_attempt_ts"}
+	args := []any{create.UID, create.CreatorID, create.WebhookID, create.ActivityType, create.URL, create.Payload, create.Format, create.Status, create.NextAttemptTs}
+
+	stmt := "INSERT INTO webhook_delivery (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListWebhookDeliveries(ctx context.Context, find *store.FindWebhookDelivery) ([]*store.WebhookDelivery, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.UID != nil {
+		where, args = append(where, "uid = "+placeholder(len(args)+1)), append(args, *find.UID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *find.CreatorID)
+	}
+	if find.WebhookID != nil {
+		where, args = append(where, "webhook_id = "+placeholder(len(args)+1)), append(args, *find.WebhookID)
+	}
+	if find.Status != nil {
+		where, args = append(where, "status = "+placeholder(len(args)+1)), append(args, *find.Status)
+	}
+	if find.NextAttemptBefore != nil {
+		where, args = append(where, "next_attempt_ts <= "+placeholder(len(args)+1)), append(args, *find.NextAttemptBefore)
+	}
+
+	query := "SELECT id, uid, creator_id, webhook_id, activity_type, url, payload, format, status, attempt_count, next_attempt_ts, last_attempt_ts, response_code, response_body, created_ts FROM webhook_delivery WHERE " + strings.Join(where, " AND ") + " ORDER BY id DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.WebhookDelivery{}
+	for rows.Next() {
+		delivery := &store.WebhookDelivery{}
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.UID,
+			&delivery.CreatorID,
+			&delivery.WebhookID,
+			&delivery.ActivityType,
+			&delivery.URL,
+			&delivery.Payload,
+			&delivery.Format,
+			&delivery.Status,
+			&delivery.AttemptCount,
+			&delivery.NextAttemptTs,
+			&delivery.LastAttemptTs,
+			&delivery.ResponseCode,
+			&delivery.ResponseBody,
+			&delivery.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) UpdateWebhookDelivery(ctx context.Context, update *store.UpdateWebhookDelivery) error {
+	set, args := []string{}, []any{}
+	if v := update.URL; v != nil {
+		set, args = append(set, "url = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Status; v != nil {
+		set, args = append(set, "status = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.AttemptCount; v != nil {
+		set, args = append(set, "attempt_count = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.NextAttemptTs; v != nil {
+		set, args = append(set, "next_attempt_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.LastAttemptTs; v != nil {
+		set, args = append(set, "last_attempt_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ResponseCode; v != nil {
+		set, args = append(set, "response_code = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ResponseBody; v != nil {
+		set, args = append(set, "response_body = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	stmt := "UPDATE webhook_delivery SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)+1)
+	args = append(args, update.ID)
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
+
+func (d *DB) ClaimWebhookDelivery(ctx context.Context, id int32, nextAttemptTs, leaseTs int64) (bool, error) {
+	result, err := d.db.ExecContext(ctx, `
+		UPDATE webhook_delivery
+		SET next_attempt_ts = $1
+		WHERE id = $2 AND status = $3 AND next_attempt_ts = $4`,
+		leaseTs, id, store.WebhookDeliveryPending, nextAttemptTs,
+	)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (d *DB) DeleteWebhookDeliveries(ctx context.Context, delete *store.DeleteWebhookDeliveries) (int64, error) {
+	where, args := []string{}, []any{}
+	if delete.CreatorID != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *delete.CreatorID)
+	}
+	if delete.WebhookID != nil {
+		where, args = append(where, "webhook_id = "+placeholder(len(args)+1)), append(args, *delete.WebhookID)
+	}
+	if delete.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts < "+placeholder(len(args)+1)), append(args, *delete.CreatedTsBefore)
+	}
+	if len(where) == 0 {
+		return 0, errors.New("no condition to delete webhook deliveries")
+	}
+	result, err := d.db.ExecContext(ctx, "DELETE FROM webhook_delivery WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
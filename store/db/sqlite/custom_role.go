@@ -0,0 +1,107 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateCustomRole(ctx context.Context, create *store.CustomRole) (*store.CustomRole, error) {
+	stmt := "INSERT INTO `custom_role` (`name`, `description`, `permissions`) VALUES (?, ?, ?) RETURNING `id`, `created_ts`, `updated_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, create.Name, create.Description, store.FormatPermissions(create.Permissions)).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListCustomRoles(ctx context.Context, find *store.FindCustomRole) ([]*store.CustomRole, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.Name != nil {
+		where, args = append(where, "`name` = ?"), append(args, *find.Name)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			name,
+			description,
+			permissions,
+			created_ts,
+			updated_ts
+		FROM custom_role
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY name ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.CustomRole{}
+	for rows.Next() {
+		role := &store.CustomRole{}
+		var permissions string
+		if err := rows.Scan(
+			&role.ID,
+			&role.Name,
+			&role.Description,
+			&permissions,
+			&role.CreatedTs,
+			&role.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		role.Permissions = store.ParsePermissions(permissions)
+		list = append(list, role)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) UpdateCustomRole(ctx context.Context, update *store.UpdateCustomRole) (*store.CustomRole, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "`description` = ?"), append(args, *v)
+	}
+	if v := update.Permissions; v != nil {
+		set, args = append(set, "`permissions` = ?"), append(args, store.FormatPermissions(v))
+	}
+	args = append(args, update.ID)
+
+	role := &store.CustomRole{}
+	var permissions string
+	stmt := "UPDATE `custom_role` SET " + strings.Join(set, ", ") + " WHERE `id` = ? RETURNING `id`, `name`, `description`, `permissions`, `created_ts`, `updated_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&role.ID,
+		&role.Name,
+		&role.Description,
+		&permissions,
+		&role.CreatedTs,
+		&role.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	role.Permissions = store.ParsePermissions(permissions)
+	return role, nil
+}
+
+func (d *DB) DeleteCustomRole(ctx context.Context, delete *store.DeleteCustomRole) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `custom_role` WHERE `id` = ?", delete.ID)
+	return err
+}
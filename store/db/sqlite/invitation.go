@@ -0,0 +1,123 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateInvitation(ctx context.Context, create *store.Invitation) (*store.Invitation, error) {
+	fields := []string{"`uid`", "`code`", "`creator_id`", "`role`", "`email`", "`max_uses`"}
+	placeholders := []string{"?", "?", "?", "?", "?", "?"}
+	args := []any{create.UID, create.Code, create.CreatorID, create.Role, create.Email, create.MaxUses}
+
+	if create.ExpiresTs != nil {
+		fields = append(fields, "`expires_ts`")
+		placeholders = append(placeholders, "?")
+		args = append(args, *create.ExpiresTs)
+	}
+
+	stmt := "INSERT INTO `invitation` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholders, ", ") + ") RETURNING `id`, `use_count`, `created_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.UseCount,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListInvitations(ctx context.Context, find *store.FindInvitation) ([]*store.Invitation, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.UID != nil {
+		where, args = append(where, "`uid` = ?"), append(args, *find.UID)
+	}
+	if find.Code != nil {
+		where, args = append(where, "`code` = ?"), append(args, *find.Code)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			uid,
+			code,
+			creator_id,
+			role,
+			email,
+			max_uses,
+			use_count,
+			created_ts,
+			expires_ts
+		FROM invitation
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts DESC, id DESC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Invitation{}
+	for rows.Next() {
+		invitation := &store.Invitation{}
+		if err := rows.Scan(
+			&invitation.ID,
+			&invitation.UID,
+			&invitation.Code,
+			&invitation.CreatorID,
+			&invitation.Role,
+			&invitation.Email,
+			&invitation.MaxUses,
+			&invitation.UseCount,
+			&invitation.CreatedTs,
+			&invitation.ExpiresTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, invitation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) ConsumeInvitation(ctx context.Context, id int32) (bool, error) {
+	result, err := d.db.ExecContext(ctx, "UPDATE `invitation` SET `use_count` = `use_count` + 1 WHERE `id` = ? AND `use_count` < `max_uses`", id)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (d *DB) ReleaseInvitation(ctx context.Context, id int32) error {
+	_, err := d.db.ExecContext(ctx, "UPDATE `invitation` SET `use_count` = `use_count` - 1 WHERE `id` = ? AND `use_count` > 0", id)
+	return err
+}
+
+func (d *DB) DeleteInvitation(ctx context.Context, delete *store.DeleteInvitation) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *delete.ID)
+	}
+	if delete.UID != nil {
+		where, args = append(where, "`uid` = ?"), append(args, *delete.UID)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `invitation` WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
@@ -37,6 +37,21 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		placeholder = append(placeholder, "?")
 		args = append(args, create.UpdatedTs)
 	}
+	if create.DueTs != nil {
+		fields = append(fields, "`due_ts`")
+		placeholder = append(placeholder, "?")
+		args = append(args, *create.DueTs)
+	}
+	if create.RemindTs != nil {
+		fields = append(fields, "`remind_ts`")
+		placeholder = append(placeholder, "?")
+		args = append(args, *create.RemindTs)
+	}
+	if create.PublishTs != nil {
+		fields = append(fields, "`publish_ts`")
+		placeholder = append(placeholder, "?")
+		args = append(args, *create.PublishTs)
+	}
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`, `row_status`"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -104,6 +119,15 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		where = append(where, "`parent_uid` IS NULL")
 	}
+	if v := find.ReminderDueBefore; v != nil {
+		where, args = append(where, "`memo`.`reminded_ts` IS NULL AND COALESCE(`memo`.`remind_ts`, `memo`.`due_ts`) <= ?"), append(args, *v)
+	}
+	if v := find.PublishDueBefore; v != nil {
+		where, args = append(where, "`memo`.`publish_ts` <= ?"), append(args, *v)
+	}
+	if find.ExcludeScheduled {
+		where = append(where, "`memo`.`publish_ts` IS NULL")
+	}
 
 	order := "DESC"
 	if find.OrderByTimeAsc {
@@ -130,6 +154,11 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`pinned` AS `pinned`",
 		"`memo`.`payload` AS `payload`",
+		"`memo`.`revision` AS `revision`",
+		"`memo`.`due_ts` AS `due_ts`",
+		"`memo`.`remind_ts` AS `remind_ts`",
+		"`memo`.`reminded_ts` AS `reminded_ts`",
+		"`memo`.`publish_ts` AS `publish_ts`",
 		"CASE WHEN `parent_memo`.`uid` IS NOT NULL THEN `parent_memo`.`uid` ELSE NULL END AS `parent_uid`",
 	}
 	if !find.ExcludeContent {
@@ -169,6 +198,11 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.Visibility,
 			&memo.Pinned,
 			&payloadBytes,
+			&memo.Revision,
+			&memo.DueTs,
+			&memo.RemindTs,
+			&memo.RemindedTs,
+			&memo.PublishTs,
 			&memo.ParentUID,
 		}
 		if !find.ExcludeContent {
@@ -222,27 +256,62 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 		}
 		set, args = append(set, "`payload` = ?"), append(args, string(payloadBytes))
 	}
-	if len(set) == 0 {
+	if v := update.DueTs; v != nil {
+		set, args = append(set, "`due_ts` = ?"), append(args, nullableTs(*v))
+	}
+	if v := update.RemindTs; v != nil {
+		set, args = append(set, "`remind_ts` = ?"), append(args, nullableTs(*v))
+	}
+	if update.DueTs != nil || update.RemindTs != nil {
+		set = append(set, "`reminded_ts` = NULL")
+	}
+	if v := update.PublishTs; v != nil {
+		set, args = append(set, "`publish_ts` = ?"), append(args, nullableTs(*v))
+	}
+	if len(set) == 0 && update.ExpectedRevision == nil {
 		return nil
 	}
+	set = append(set, "`revision` = `revision` + 1")
+	where := []string{"`id` = ?"}
 	args = append(args, update.ID)
+	if v := update.ExpectedRevision; v != nil {
+		where, args = append(where, "`revision` = ?"), append(args, *v)
+	}
 
-	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if update.ExpectedRevision != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoRevisionMismatch
+		}
+	}
 	return nil
 }
 
-func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
-	where, args := []string{"`id` = ?"}, []any{delete.ID}
-	stmt := "DELETE FROM `memo` WHERE " + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+func (d *DB) MarkMemoReminded(ctx context.Context, id int32, remindedTs int64) (bool, error) {
+	stmt := "UPDATE `memo` SET `reminded_ts` = ? WHERE `id` = ? AND `reminded_ts` IS NULL"
+	result, err := d.db.ExecContext(ctx, stmt, remindedTs, id)
 	if err != nil {
-		return err
+		return false, err
 	}
-	if _, err := result.RowsAffected(); err != nil {
-		return err
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
 	}
-	return nil
+	return rows > 0, nil
+}
+
+// nullableTs maps a zero timestamp to NULL.
+func nullableTs(ts int64) any {
+	if ts == 0 {
+		return nil
+	}
+	return ts
 }
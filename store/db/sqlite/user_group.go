@@ -0,0 +1,160 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateUserGroup(ctx context.Context, create *store.UserGroup) (*store.UserGroup, error) {
+	stmt := "INSERT INTO `user_group` (`name`, `description`) VALUES (?, ?) RETURNING `id`, `created_ts`, `updated_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, create.Name, create.Description).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListUserGroups(ctx context.Context, find *store.FindUserGroup) ([]*store.UserGroup, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.Name != nil {
+		where, args = append(where, "`name` = ?"), append(args, *find.Name)
+	}
+	if len(find.NameList) > 0 {
+		placeholders := make([]string, 0, len(find.NameList))
+		for _, name := range find.NameList {
+			placeholders = append(placeholders, "?")
+			args = append(args, name)
+		}
+		where = append(where, "`name` IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if find.MemberID != nil {
+		where, args = append(where, "`id` IN (SELECT `group_id` FROM `user_group_member` WHERE `user_id` = ?)"), append(args, *find.MemberID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			name,
+			description,
+			created_ts,
+			updated_ts
+		FROM user_group
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY name ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroup{}
+	for rows.Next() {
+		group := &store.UserGroup{}
+		if err := rows.Scan(
+			&group.ID,
+			&group.Name,
+			&group.Description,
+			&group.CreatedTs,
+			&group.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) UpdateUserGroup(ctx context.Context, update *store.UpdateUserGroup) (*store.UserGroup, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "`description` = ?"), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	group := &store.UserGroup{}
+	stmt := "UPDATE `user_group` SET " + strings.Join(set, ", ") + " WHERE `id` = ? RETURNING `id`, `name`, `description`, `created_ts`, `updated_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&group.ID,
+		&group.Name,
+		&group.Description,
+		&group.CreatedTs,
+		&group.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (d *DB) CreateUserGroupMember(ctx context.Context, create *store.UserGroupMember) (*store.UserGroupMember, error) {
+	stmt := "INSERT INTO `user_group_member` (`group_id`, `user_id`) VALUES (?, ?) RETURNING `created_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, create.GroupID, create.UserID).Scan(&create.CreatedTs); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListUserGroupMembers(ctx context.Context, find *store.FindUserGroupMember) ([]*store.UserGroupMember, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.GroupID != nil {
+		where, args = append(where, "`group_id` = ?"), append(args, *find.GroupID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			group_id,
+			user_id,
+			created_ts
+		FROM user_group_member
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts ASC, user_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroupMember{}
+	for rows.Next() {
+		member := &store.UserGroupMember{}
+		if err := rows.Scan(
+			&member.GroupID,
+			&member.UserID,
+			&member.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteUserGroupMember(ctx context.Context, delete *store.DeleteUserGroupMember) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `user_group_member` WHERE `group_id` = ? AND `user_id` = ?", delete.GroupID, delete.UserID)
+	return err
+}
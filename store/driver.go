@@ -29,7 +29,7 @@ type Driver interface {
 	CreateMemo(ctx context.Context, create *Memo) (*Memo, error)
 	ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error)
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
-	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
+	MarkMemoReminded(ctx context.Context, id int32, remindedTs int64) (bool, error)
 
 	// MemoRelation model related methods.
 	UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error)
@@ -75,10 +75,69 @@ type Driver interface {
 	CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error)
 	ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error)
 	GetMemoShare(ctx context.Context, find *FindMemoShare) (*MemoShare, error)
+	RecordMemoShareView(ctx context.Context, id int32, accessedTs int64) (bool, error)
 	DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error
 
+	// CollectionShare model related methods.
+	CreateCollectionShare(ctx context.Context, create *CollectionShare) (*CollectionShare, error)
+	ListCollectionShares(ctx context.Context, find *FindCollectionShare) ([]*CollectionShare, error)
+	DeleteCollectionShare(ctx context.Context, delete *DeleteCollectionShare) error
+
 	// UserIdentity model related methods.
 	CreateUserIdentity(ctx context.Context, create *UserIdentity) (*UserIdentity, error)
 	ListUserIdentities(ctx context.Context, find *FindUserIdentity) ([]*UserIdentity, error)
 	DeleteUserIdentities(ctx context.Context, delete *DeleteUserIdentity) error
+
+	// SignInAttempt model related methods.
+	UpsertSignInAttempt(ctx context.Context, upsert *SignInAttempt) (*SignInAttempt, error)
+	IncrementSignInAttempt(ctx context.Context, increment *IncrementSignInAttempt) (*SignInAttempt, error)
+	LockSignInAttempt(ctx context.Context, lock *LockSignInAttempt) error
+	ListSignInAttempts(ctx context.Context, find *FindSignInAttempt) ([]*SignInAttempt, error)
+	DeleteSignInAttempts(ctx context.Context, delete *DeleteSignInAttempt) error
+
+	// Invitation model related methods.
+	CreateInvitation(ctx context.Context, create *Invitation) (*Invitation, error)
+	ListInvitations(ctx context.Context, find *FindInvitation) ([]*Invitation, error)
+	ConsumeInvitation(ctx context.Context, id int32) (bool, error)
+	ReleaseInvitation(ctx context.Context, id int32) error
+	DeleteInvitation(ctx context.Context, delete *DeleteInvitation) error
+
+	// OAuth model related methods.
+	CreateOAuthClient(ctx context.Context, create *OAuthClient) (*OAuthClient, error)
+	ListOAuthClients(ctx context.Context, find *FindOAuthClient) ([]*OAuthClient, error)
+	DeleteOAuthClient(ctx context.Context, delete *DeleteOAuthClient) error
+	CreateOAuthToken(ctx context.Context, create *OAuthToken) (*OAuthToken, error)
+	ListOAuthTokens(ctx context.Context, find *FindOAuthToken) ([]*OAuthToken, error)
+	DeleteOAuthTokens(ctx context.Context, delete *DeleteOAuthTokens) (int64, error)
+
+	// CustomRole model related methods.
+	CreateCustomRole(ctx context.Context, create *CustomRole) (*CustomRole, error)
+	ListCustomRoles(ctx context.Context, find *FindCustomRole) ([]*CustomRole, error)
+	UpdateCustomRole(ctx context.Context, update *UpdateCustomRole) (*CustomRole, error)
+	DeleteCustomRole(ctx context.Context, delete *DeleteCustomRole) error
+
+	// AuditLog model related methods.
+	CreateAuditLog(ctx context.Context, create *AuditLog) (*AuditLog, error)
+	ListAuditLogs(ctx context.Context, find *FindAuditLog) ([]*AuditLog, error)
+	DeleteAuditLogs(ctx context.Context, delete *DeleteAuditLogs) (int64, error)
+
+	// WebhookDelivery model related methods.
+	CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) error
+	ClaimWebhookDelivery(ctx context.Context, id int32, nextAttemptTs, leaseTs int64) (bool, error)
+	DeleteWebhookDeliveries(ctx context.Context, delete *DeleteWebhookDeliveries) (int64, error)
+
+	// UserGroup model related methods.
+	CreateUserGroup(ctx context.Context, create *UserGroup) (*UserGroup, error)
+	ListUserGroups(ctx context.Context, find *FindUserGroup) ([]*UserGroup, error)
+	UpdateUserGroup(ctx context.Context, update *UpdateUserGroup) (*UserGroup, error)
+	CreateUserGroupMember(ctx context.Context, create *UserGroupMember) (*UserGroupMember, error)
+	ListUserGroupMembers(ctx context.Context, find *FindUserGroupMember) ([]*UserGroupMember, error)
+	DeleteUserGroupMember(ctx context.Context, delete *DeleteUserGroupMember) error
+
+	// MemoCollaborator model related methods.
+	UpsertMemoCollaborator(ctx context.Context, upsert *MemoCollaborator) (*MemoCollaborator, error)
+	ListMemoCollaborators(ctx context.Context, find *FindMemoCollaborator) ([]*MemoCollaborator, error)
+	DeleteMemoCollaborator(ctx context.Context, delete *DeleteMemoCollaborator) error
 }
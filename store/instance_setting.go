@@ -43,6 +43,10 @@ func (s *Store) UpsertInstanceSetting(ctx context.Context, upsert *storepb.Insta
 		valueBytes, err = protojson.Marshal(upsert.GetNotificationSetting())
 	} else if upsert.Key == storepb.InstanceSettingKey_AI {
 		valueBytes, err = protojson.Marshal(upsert.GetAiSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_WEBHOOKS {
+		valueBytes, err = protojson.Marshal(upsert.GetWebhooksSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_WEB_PUSH {
+		valueBytes, err = protojson.Marshal(upsert.GetWebPushSetting())
 	} else {
 		return nil, errors.Errorf("unsupported instance setting key: %v", upsert.Key)
 	}
@@ -238,6 +242,43 @@ func (s *Store) GetInstanceAISetting(ctx context.Context) (*storepb.InstanceAISe
 	return instanceAISetting, nil
 }
 
+// GetInstanceWebhooksSetting gets the instance-wide webhooks.
+func (s *Store) GetInstanceWebhooksSetting(ctx context.Context) (*storepb.InstanceWebhooksSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_WEBHOOKS.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance webhooks setting")
+	}
+
+	instanceWebhooksSetting := &storepb.InstanceWebhooksSetting{}
+	if instanceSetting != nil {
+		instanceWebhooksSetting = instanceSetting.GetWebhooksSetting()
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_WEBHOOKS.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_WEBHOOKS,
+		Value: &storepb.InstanceSetting_WebhooksSetting{WebhooksSetting: instanceWebhooksSetting},
+	})
+	return instanceWebhooksSetting, nil
+}
+
+// GetInstanceWebPushSetting gets the Web Push VAPID keys of the instance.
+// The keys are empty until the server generates them.
+func (s *Store) GetInstanceWebPushSetting(ctx context.Context) (*storepb.InstanceWebPushSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_WEB_PUSH.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance web push setting")
+	}
+
+	instanceWebPushSetting := &storepb.InstanceWebPushSetting{}
+	if instanceSetting != nil {
+		instanceWebPushSetting = instanceSetting.GetWebPushSetting()
+	}
+	return instanceWebPushSetting, nil
+}
+
 const (
 	defaultInstanceStorageType       = storepb.InstanceStorageSetting_LOCAL
 	defaultInstanceUploadSizeLimitMb = 30
@@ -319,6 +360,18 @@ func convertInstanceSettingFromRaw(instanceSettingRaw *InstanceSetting) (*storep
 			return nil, err
 		}
 		instanceSetting.Value = &storepb.InstanceSetting_AiSetting{AiSetting: aiSetting}
+	case storepb.InstanceSettingKey_WEBHOOKS.String():
+		webhooksSetting := &storepb.InstanceWebhooksSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), webhooksSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_WebhooksSetting{WebhooksSetting: webhooksSetting}
+	case storepb.InstanceSettingKey_WEB_PUSH.String():
+		webPushSetting := &storepb.InstanceWebPushSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), webPushSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_WebPushSetting{WebPushSetting: webPushSetting}
 	default:
 		// Skip unsupported instance setting key.
 		return nil, nil
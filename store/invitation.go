@@ -0,0 +1,73 @@
+package store
+
+import "context"
+
+// Invitation is an admin-issued code that allows registering an account
+// while open registration is disabled.
+type Invitation struct {
+	ID        int32
+	UID       string
+	Code      string
+	CreatorID int32
+	// Role is assigned to users who register with the invitation.
+	Role Role
+	// Email restricts the invitation to a single address when not empty.
+	Email     string
+	MaxUses   int32
+	UseCount  int32
+	CreatedTs int64
+	ExpiresTs *int64 // nil means the invitation never expires
+}
+
+// FindInvitation is used to filter invitations in list/get queries.
+type FindInvitation struct {
+	ID        *int32
+	UID       *string
+	Code      *string
+	CreatorID *int32
+}
+
+// DeleteInvitation identifies an invitation to remove.
+type DeleteInvitation struct {
+	ID  *int32
+	UID *string
+}
+
+// CreateInvitation creates a new invitation.
+func (s *Store) CreateInvitation(ctx context.Context, create *Invitation) (*Invitation, error) {
+	return s.driver.CreateInvitation(ctx, create)
+}
+
+// ListInvitations returns all invitations matching the filter.
+func (s *Store) ListInvitations(ctx context.Context, find *FindInvitation) ([]*Invitation, error) {
+	return s.driver.ListInvitations(ctx, find)
+}
+
+// GetInvitation returns the first invitation matching the filter, or nil if none found.
+func (s *Store) GetInvitation(ctx context.Context, find *FindInvitation) (*Invitation, error) {
+	list, err := s.ListInvitations(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// ConsumeInvitation atomically records one use of the invitation.
+// Returns false when the invitation has no uses left.
+func (s *Store) ConsumeInvitation(ctx context.Context, id int32) (bool, error) {
+	return s.driver.ConsumeInvitation(ctx, id)
+}
+
+// ReleaseInvitation gives back a use recorded by ConsumeInvitation, for a registration
+// that failed after the invitation was consumed.
+func (s *Store) ReleaseInvitation(ctx context.Context, id int32) error {
+	return s.driver.ReleaseInvitation(ctx, id)
+}
+
+// DeleteInvitation removes an invitation.
+func (s *Store) DeleteInvitation(ctx context.Context, delete *DeleteInvitation) error {
+	return s.driver.DeleteInvitation(ctx, delete)
+}
@@ -19,6 +19,8 @@ const (
 	Protected Visibility = "PROTECTED"
 	// Private is the PRIVATE visibility.
 	Private Visibility = "PRIVATE"
+	// Group is the GROUP visibility; the memo is visible to members of its audience groups.
+	Group Visibility = "GROUP"
 )
 
 func (v Visibility) String() string {
@@ -27,6 +29,8 @@ func (v Visibility) String() string {
 		return "PUBLIC"
 	case Protected:
 		return "PROTECTED"
+	case Group:
+		return "GROUP"
 	default:
 		return "PRIVATE"
 	}
@@ -49,6 +53,17 @@ type Memo struct {
 	Visibility Visibility
 	Pinned     bool
 	Payload    *storepb.MemoPayload
+	// Revision is incremented on every update and backs optimistic concurrency control.
+	Revision int32
+	// DueTs and RemindTs are optional unix timestamps. The reminder fires at RemindTs,
+	// or at DueTs when no explicit reminder time is set.
+	DueTs    *int64
+	RemindTs *int64
+	// RemindedTs records when the reminder was sent; nil while it is still pending.
+	RemindedTs *int64
+	// PublishTs is the unix timestamp a scheduled memo is published at. Until then the
+	// memo is private, and its payload holds the visibility it gets once published.
+	PublishTs *int64
 
 	// Composed fields
 	ParentUID *string
@@ -70,6 +85,12 @@ type FindMemo struct {
 	ExcludeContent  bool
 	ExcludeComments bool
 	Filters         []string
+	// ReminderDueBefore selects memos whose pending reminder fires at or before the given unix timestamp.
+	ReminderDueBefore *int64
+	// PublishDueBefore selects scheduled memos to publish at or before the given unix timestamp.
+	PublishDueBefore *int64
+	// ExcludeScheduled leaves out scheduled memos that are not published yet.
+	ExcludeScheduled bool
 
 	// Pagination
 	Limit  *int
@@ -100,12 +121,37 @@ type UpdateMemo struct {
 	Visibility *Visibility
 	Pinned     *bool
 	Payload    *storepb.MemoPayload
+	// DueTs and RemindTs set the memo's due and reminder times; a zero value clears them.
+	// Changing either re-arms the reminder.
+	DueTs    *int64
+	RemindTs *int64
+	// PublishTs sets the time a scheduled memo is published at; a zero value clears it.
+	PublishTs *int64
+
+	// Attachments and References replace the memo's attachments and reference relations
+	// in the same transaction as the rest of the update.
+	Attachments *UpdateMemoAttachments
+	References  *UpdateMemoReferences
+
+	// ExpectedRevision makes the update conditional on the memo's current revision.
+	// A mismatch fails with ErrMemoRevisionMismatch.
+	ExpectedRevision *int32
 }
 
 type DeleteMemo struct {
 	ID int32
+	// DeleteComments also deletes the comments of the memo.
+	DeleteComments bool
+
+	// ExpectedRevision makes the delete conditional on the memo's current revision.
+	// A mismatch fails with ErrMemoRevisionMismatch.
+	ExpectedRevision *int32
 }
 
+// ErrMemoRevisionMismatch is returned when a conditional memo update or delete
+// targets a revision that is no longer current.
+var ErrMemoRevisionMismatch = errors.New("memo revision mismatch")
+
 func (s *Store) CreateMemo(ctx context.Context, create *Memo) (*Memo, error) {
 	if !base.UIDMatcher.MatchString(create.UID) {
 		return nil, errors.New("invalid uid")
@@ -134,26 +180,14 @@ func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 	if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
+	if update.Attachments != nil || update.References != nil {
+		return s.updateMemoWithLinks(ctx, update)
+	}
 	return s.driver.UpdateMemo(ctx, update)
 }
 
-func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
-	// Clean up memo_relation records where this memo is either the source or target.
-	if err := s.driver.DeleteMemoRelation(ctx, &DeleteMemoRelation{MemoID: &delete.ID}); err != nil {
-		return err
-	}
-	if err := s.driver.DeleteMemoRelation(ctx, &DeleteMemoRelation{RelatedMemoID: &delete.ID}); err != nil {
-		return err
-	}
-	// Clean up attachments linked to this memo.
-	attachments, err := s.ListAttachments(ctx, &FindAttachment{MemoID: &delete.ID})
-	if err != nil {
-		return err
-	}
-	for _, attachment := range attachments {
-		if err := s.DeleteAttachment(ctx, &DeleteAttachment{ID: attachment.ID}); err != nil {
-			return err
-		}
-	}
-	return s.driver.DeleteMemo(ctx, delete)
+// MarkMemoReminded records that the memo's reminder was sent. It reports false when the
+// reminder was already marked, so that concurrent senders deliver each reminder once.
+func (s *Store) MarkMemoReminded(ctx context.Context, id int32, remindedTs int64) (bool, error) {
+	return s.driver.MarkMemoReminded(ctx, id, remindedTs)
 }
@@ -0,0 +1,117 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/pkg/errors"
+)
+
+// DeleteMemo deletes a memo with its relations, collaborators and attachments, and its comments
+// when DeleteComments is set, in one transaction. The revision check is part of the transaction,
+// so a stale delete changes nothing. Files of deleted attachments are removed after commit.
+func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
+	dialect, err := getDeleteUserDialect(s.profile.Driver)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.driver.GetDB().BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin delete memo transaction")
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if delete.ExpectedRevision != nil {
+		// Advancing the revision locks the memo row until commit, so concurrent conditional
+		// writes based on the same revision fail.
+		result, err := tx.ExecContext(ctx,
+			"UPDATE memo SET revision = revision + 1 WHERE id = "+deleteUserPlaceholder(dialect, 1)+" AND revision = "+deleteUserPlaceholder(dialect, 2),
+			delete.ID, *delete.ExpectedRevision)
+		if err != nil {
+			return err
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return ErrMemoRevisionMismatch
+		}
+	}
+
+	memoIDs := []int32{delete.ID}
+	if delete.DeleteComments {
+		commentIDs, err := listMemoCommentIDsTx(ctx, tx, dialect, delete.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed to list memo comments")
+		}
+		memoIDs = append(memoIDs, commentIDs...)
+	}
+	attachments := make([]*Attachment, 0)
+	seen := make(map[int32]struct{})
+	for _, batch := range deleteUserBatches(memoIDs, deleteUserBatchSize) {
+		clause, args := deleteUserInClause(dialect, 1, batch)
+		if err := appendDeleteUserAttachments(ctx, tx, `
+			SELECT
+				id,
+				uid,
+				creator_id,
+				memo_id,
+				storage_type,
+				reference,
+				payload
+			FROM attachment
+			WHERE memo_id IN `+clause, args, seen, &attachments); err != nil {
+			return errors.Wrap(err, "failed to list memo attachments")
+		}
+	}
+
+	if err := deleteAttachmentsByIDsTx(ctx, tx, dialect, attachmentIDsFromList(attachments)); err != nil {
+		return err
+	}
+	if err := deleteMemoRelationsTx(ctx, tx, dialect, memoIDs); err != nil {
+		return err
+	}
+	for _, batch := range deleteUserBatches(memoIDs, deleteUserBatchSize) {
+		clause, args := deleteUserInClause(dialect, 1, batch)
+		if _, err := tx.ExecContext(ctx, `DELETE FROM memo_collaborator WHERE memo_id IN `+clause, args...); err != nil {
+			return err
+		}
+	}
+	if err := deleteMemosTx(ctx, tx, dialect, memoIDs); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit delete memo transaction")
+	}
+
+	if err := s.deleteAttachmentsStorage(ctx, attachments); err != nil {
+		slog.Warn("Failed to delete memo attachments", slog.Any("err", err), slog.Int64("memo_id", int64(delete.ID)))
+	}
+	return nil
+}
+
+func listMemoCommentIDsTx(ctx context.Context, tx *sql.Tx, dialect deleteUserDialect, memoID int32) ([]int32, error) {
+	rows, err := tx.QueryContext(ctx,
+		"SELECT memo_id FROM memo_relation WHERE related_memo_id = "+deleteUserPlaceholder(dialect, 1)+" AND type = "+deleteUserPlaceholder(dialect, 2),
+		memoID, string(MemoRelationComment))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]int32, 0)
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
@@ -1,6 +1,9 @@
 package store
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // MemoShare is an access grant that permits read-only access to a memo via a bearer token.
 type MemoShare struct {
@@ -10,6 +13,14 @@ type MemoShare struct {
 	CreatorID int32
 	CreatedTs int64
 	ExpiresTs *int64 // nil means the share never expires
+
+	// PasswordHash is the bcrypt hash of the share password; empty means no password.
+	PasswordHash string
+	// MaxViews caps how many times the memo can be viewed through the share; nil means unlimited.
+	MaxViews *int32
+	// ViewCount and LastAccessedTs record the views through the share.
+	ViewCount      int32
+	LastAccessedTs *int64
 }
 
 // FindMemoShare is used to filter memo shares in list/get queries.
@@ -41,6 +52,12 @@ func (s *Store) GetMemoShare(ctx context.Context, find *FindMemoShare) (*MemoSha
 	return s.driver.GetMemoShare(ctx, find)
 }
 
+// RecordMemoShareView counts a view through the share and stamps its access time.
+// It returns false without recording anything if the share has used up its views.
+func (s *Store) RecordMemoShareView(ctx context.Context, id int32) (bool, error) {
+	return s.driver.RecordMemoShareView(ctx, id, time.Now().Unix())
+}
+
 // DeleteMemoShare removes a share grant.
 func (s *Store) DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error {
 	return s.driver.DeleteMemoShare(ctx, delete)
@@ -0,0 +1,216 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// UpdateMemoAttachments replaces the attachments of a memo.
+type UpdateMemoAttachments struct {
+	// AttachmentIDs lists the attachments of the memo in display order. Attachments of the
+	// memo that are not listed are deleted.
+	AttachmentIDs []int32
+}
+
+// UpdateMemoReferences replaces the reference relations of a memo.
+type UpdateMemoReferences struct {
+	RelatedMemoIDs []int32
+}
+
+// updateMemoWithLinks applies a memo update that also replaces its attachments or references.
+// The revision check and all writes run in one transaction, so a stale update changes nothing.
+// Files of deleted attachments are removed after commit, since external storage cannot
+// participate in SQL transactions.
+func (s *Store) updateMemoWithLinks(ctx context.Context, update *UpdateMemo) error {
+	dialect, err := getDeleteUserDialect(s.profile.Driver)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.driver.GetDB().BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin update memo transaction")
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := updateMemoRowTx(ctx, tx, dialect, update); err != nil {
+		return err
+	}
+	var removedAttachments []*Attachment
+	if update.Attachments != nil {
+		removedAttachments, err = replaceMemoAttachmentsTx(ctx, tx, dialect, update.ID, update.Attachments.AttachmentIDs)
+		if err != nil {
+			return errors.Wrap(err, "failed to update memo attachments")
+		}
+	}
+	if update.References != nil {
+		if err := replaceMemoReferencesTx(ctx, tx, dialect, update.ID, update.References.RelatedMemoIDs); err != nil {
+			return errors.Wrap(err, "failed to update memo references")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit update memo transaction")
+	}
+
+	if err := s.deleteAttachmentsStorage(ctx, removedAttachments); err != nil {
+		slog.Warn("Failed to delete removed memo attachments", slog.Any("err", err), slog.Int64("memo_id", int64(update.ID)))
+	}
+	return nil
+}
+
+func updateMemoRowTx(ctx context.Context, tx *sql.Tx, dialect deleteUserDialect, update *UpdateMemo) error {
+	set, args := []string{}, []any{}
+	assign := func(column string, value any) {
+		args = append(args, value)
+		set = append(set, column+" = "+deleteUserPlaceholder(dialect, len(args)))
+	}
+	if v := update.UID; v != nil {
+		assign("uid", *v)
+	}
+	if v := update.CreatedTs; v != nil {
+		assign("created_ts", *v)
+	}
+	if v := update.UpdatedTs; v != nil {
+		assign("updated_ts", *v)
+	}
+	if v := update.RowStatus; v != nil {
+		assign("row_status", *v)
+	}
+	if v := update.Content; v != nil {
+		assign("content", *v)
+	}
+	if v := update.Visibility; v != nil {
+		assign("visibility", *v)
+	}
+	if v := update.Pinned; v != nil {
+		assign("pinned", *v)
+	}
+	if v := update.Payload; v != nil {
+		payloadBytes, err := protojson.Marshal(v)
+		if err != nil {
+			return err
+		}
+		assign("payload", string(payloadBytes))
+	}
+	if v := update.DueTs; v != nil {
+		assign("due_ts", nullableMemoTs(*v))
+	}
+	if v := update.RemindTs; v != nil {
+		assign("remind_ts", nullableMemoTs(*v))
+	}
+	if update.DueTs != nil || update.RemindTs != nil {
+		set = append(set, "reminded_ts = NULL")
+	}
+	if v := update.PublishTs; v != nil {
+		assign("publish_ts", nullableMemoTs(*v))
+	}
+	if len(set) == 0 && update.ExpectedRevision == nil {
+		return nil
+	}
+	set = append(set, "revision = revision + 1")
+
+	args = append(args, update.ID)
+	where := []string{"id = " + deleteUserPlaceholder(dialect, len(args))}
+	if v := update.ExpectedRevision; v != nil {
+		args = append(args, *v)
+		where = append(where, "revision = "+deleteUserPlaceholder(dialect, len(args)))
+	}
+	result, err := tx.ExecContext(ctx, "UPDATE memo SET "+strings.Join(set, ", ")+" WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return err
+	}
+	if update.ExpectedRevision != nil {
+		// The revision always changes, so MySQL reports the row as affected when it matched.
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return ErrMemoRevisionMismatch
+		}
+	}
+	return nil
+}
+
+// replaceMemoAttachmentsTx links the listed attachments to the memo and deletes its other
+// attachments, returning the deleted ones. The first listed attachment gets the latest update
+// time, which orders the attachments for display.
+func replaceMemoAttachmentsTx(ctx context.Context, tx *sql.Tx, dialect deleteUserDialect, memoID int32, attachmentIDs []int32) ([]*Attachment, error) {
+	current := make([]*Attachment, 0)
+	if err := appendDeleteUserAttachments(ctx, tx, `
+		SELECT
+			id,
+			uid,
+			creator_id,
+			memo_id,
+			storage_type,
+			reference,
+			payload
+		FROM attachment
+		WHERE memo_id = `+deleteUserPlaceholder(dialect, 1), []any{memoID}, map[int32]struct{}{}, &current); err != nil {
+		return nil, err
+	}
+	kept := make(map[int32]bool, len(attachmentIDs))
+	for _, id := range attachmentIDs {
+		kept[id] = true
+	}
+	removed := make([]*Attachment, 0)
+	for _, attachment := range current {
+		if !kept[attachment.ID] {
+			removed = append(removed, attachment)
+		}
+	}
+	if err := deleteAttachmentsByIDsTx(ctx, tx, dialect, attachmentIDsFromList(removed)); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	stmt := "UPDATE attachment SET memo_id = " + deleteUserPlaceholder(dialect, 1) +
+		", updated_ts = " + deleteUserPlaceholder(dialect, 2) +
+		" WHERE id = " + deleteUserPlaceholder(dialect, 3)
+	for index, id := range attachmentIDs {
+		updatedTs := now + int64(len(attachmentIDs)-1-index)
+		if _, err := tx.ExecContext(ctx, stmt, memoID, updatedTs, id); err != nil {
+			return nil, err
+		}
+	}
+	return removed, nil
+}
+
+func replaceMemoReferencesTx(ctx context.Context, tx *sql.Tx, dialect deleteUserDialect, memoID int32, relatedMemoIDs []int32) error {
+	if _, err := tx.ExecContext(ctx,
+		"DELETE FROM memo_relation WHERE memo_id = "+deleteUserPlaceholder(dialect, 1)+" AND type = "+deleteUserPlaceholder(dialect, 2),
+		memoID, string(MemoRelationReference)); err != nil {
+		return err
+	}
+	stmt := "INSERT INTO memo_relation (memo_id, related_memo_id, type) VALUES (" +
+		deleteUserPlaceholder(dialect, 1) + ", " + deleteUserPlaceholder(dialect, 2) + ", " + deleteUserPlaceholder(dialect, 3) + ")"
+	inserted := make(map[int32]bool, len(relatedMemoIDs))
+	for _, relatedMemoID := range relatedMemoIDs {
+		if inserted[relatedMemoID] {
+			continue
+		}
+		inserted[relatedMemoID] = true
+		if _, err := tx.ExecContext(ctx, stmt, memoID, relatedMemoID, string(MemoRelationReference)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nullableMemoTs maps a zero timestamp to NULL.
+func nullableMemoTs(ts int64) any {
+	if ts == 0 {
+		return nil
+	}
+	return ts
+}
@@ -0,0 +1,11 @@
+-- sign_in_attempt tracks failed sign-in attempts per client IP and per username
+-- so that brute-force protection survives restarts.
+CREATE TABLE `sign_in_attempt` (
+  `id`              INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `kind`            VARCHAR(32)  NOT NULL,
+  `identifier`      VARCHAR(256) NOT NULL,
+  `failure_count`   INT          NOT NULL DEFAULT 0,
+  `last_failure_ts` BIGINT       NOT NULL DEFAULT 0,
+  `locked_until_ts` BIGINT       NOT NULL DEFAULT 0,
+  UNIQUE (`kind`, `identifier`)
+);
@@ -0,0 +1,13 @@
+-- invitation stores registration invite codes created by admins.
+CREATE TABLE `invitation` (
+  `id`         INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid`        VARCHAR(256) NOT NULL UNIQUE,
+  `code`       VARCHAR(256) NOT NULL UNIQUE,
+  `creator_id` INT          NOT NULL,
+  `role`       VARCHAR(256) NOT NULL DEFAULT 'USER',
+  `email`      VARCHAR(256) NOT NULL DEFAULT '',
+  `max_uses`   INT          NOT NULL DEFAULT 1,
+  `use_count`  INT          NOT NULL DEFAULT 0,
+  `created_ts` BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `expires_ts` BIGINT
+);
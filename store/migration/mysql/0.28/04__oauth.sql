@@ -0,0 +1,27 @@
+-- oauth_client stores third-party applications registered with the OAuth2 authorization server.
+CREATE TABLE `oauth_client` (
+  `id`                 INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `client_id`          VARCHAR(256) NOT NULL UNIQUE,
+  `client_secret_hash` VARCHAR(256) NOT NULL DEFAULT '',
+  `name`               VARCHAR(256) NOT NULL,
+  `creator_id`         INT          NOT NULL DEFAULT 0,
+  `redirect_uris`      TEXT         NOT NULL,
+  `scope`              TEXT         NOT NULL,
+  `created_ts`         BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
+
+-- oauth_token stores hashed authorization codes and refresh tokens issued to OAuth clients.
+CREATE TABLE `oauth_token` (
+  `id`             INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `kind`           VARCHAR(256) NOT NULL,
+  `token_hash`     VARCHAR(256) NOT NULL UNIQUE,
+  `client_id`      VARCHAR(256) NOT NULL,
+  `user_id`        INT          NOT NULL,
+  `scope`          TEXT         NOT NULL,
+  `redirect_uri`   TEXT         NOT NULL,
+  `code_challenge` VARCHAR(256) NOT NULL DEFAULT '',
+  `created_ts`     BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `expires_ts`     BIGINT       NOT NULL
+);
+
+CREATE INDEX `idx_oauth_token_client_id` ON `oauth_token` (`client_id`);
@@ -0,0 +1,9 @@
+-- custom_role stores admin-defined roles that grant named permissions.
+CREATE TABLE `custom_role` (
+  `id`          INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `name`        VARCHAR(256) NOT NULL UNIQUE,
+  `description` TEXT         NOT NULL,
+  `permissions` TEXT         NOT NULL,
+  `created_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `updated_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
@@ -0,0 +1,14 @@
+-- audit_log is an append-only record of security-relevant actions.
+CREATE TABLE `audit_log` (
+  `id`          INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `actor_id`    INT          NOT NULL DEFAULT 0,
+  `action`      VARCHAR(256) NOT NULL,
+  `resource`    VARCHAR(256) NOT NULL DEFAULT '',
+  `detail`      TEXT         NOT NULL,
+  `ip_address`  VARCHAR(256) NOT NULL DEFAULT '',
+  `user_agent`  TEXT         NOT NULL
+);
+
+CREATE INDEX `idx_audit_log_created_ts` ON `audit_log` (`created_ts`);
+CREATE INDEX `idx_audit_log_actor_id` ON `audit_log` (`actor_id`);
@@ -0,0 +1,18 @@
+-- user_group stores named groups of users that memos can be shared with.
+CREATE TABLE `user_group` (
+  `id`          INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `name`        VARCHAR(256) NOT NULL UNIQUE,
+  `description` TEXT         NOT NULL,
+  `created_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `updated_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
+
+-- user_group_member stores group memberships.
+CREATE TABLE `user_group_member` (
+  `group_id`    INT          NOT NULL,
+  `user_id`     INT          NOT NULL,
+  `created_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  UNIQUE(`group_id`, `user_id`)
+);
+
+CREATE INDEX `idx_user_group_member_user_id` ON `user_group_member` (`user_id`);
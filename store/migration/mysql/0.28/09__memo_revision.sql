@@ -0,0 +1 @@
+ALTER TABLE `memo` ADD COLUMN `revision` INT NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+ALTER TABLE `memo_share` ADD COLUMN `password_hash` VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE `memo_share` ADD COLUMN `max_views` INT DEFAULT NULL;
+ALTER TABLE `memo_share` ADD COLUMN `view_count` INT NOT NULL DEFAULT 0;
+ALTER TABLE `memo_share` ADD COLUMN `last_accessed_ts` BIGINT DEFAULT NULL;
@@ -0,0 +1,12 @@
+-- collection_share grants read-only access to the memos matching a shortcut or tag via a bearer token.
+CREATE TABLE `collection_share` (
+  `id`          INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid`         VARCHAR(255) NOT NULL UNIQUE,
+  `creator_id`  INT          NOT NULL,
+  `shortcut_id` VARCHAR(256) NOT NULL DEFAULT '',
+  `tag`         VARCHAR(256) NOT NULL DEFAULT '',
+  `created_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `expires_ts`  BIGINT       DEFAULT NULL
+);
+
+CREATE INDEX `idx_collection_share_creator_id` ON `collection_share` (`creator_id`);
@@ -0,0 +1,20 @@
+-- webhook_delivery records each webhook delivery and its attempts, and queues the pending ones.
+CREATE TABLE `webhook_delivery` (
+  `id`              INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid`             VARCHAR(255) NOT NULL UNIQUE,
+  `creator_id`      INT          NOT NULL,
+  `webhook_id`      VARCHAR(255) NOT NULL,
+  `activity_type`   VARCHAR(256) NOT NULL DEFAULT '',
+  `url`             TEXT         NOT NULL,
+  `payload`         MEDIUMTEXT   NOT NULL,
+  `status`          VARCHAR(32)  NOT NULL DEFAULT 'PENDING',
+  `attempt_count`   INT          NOT NULL DEFAULT 0,
+  `next_attempt_ts` BIGINT       NOT NULL DEFAULT 0,
+  `last_attempt_ts` BIGINT       DEFAULT NULL,
+  `response_code`   INT          NOT NULL DEFAULT 0,
+  `response_body`   TEXT         NOT NULL,
+  `created_ts`      BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
+
+CREATE INDEX `idx_webhook_delivery_creator_id_webhook_id` ON `webhook_delivery` (`creator_id`, `webhook_id`);
+CREATE INDEX `idx_webhook_delivery_status_next_attempt_ts` ON `webhook_delivery` (`status`, `next_attempt_ts`);
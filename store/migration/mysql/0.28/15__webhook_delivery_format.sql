@@ -0,0 +1 @@
+ALTER TABLE `webhook_delivery` ADD COLUMN `format` VARCHAR(32) NOT NULL DEFAULT '';
@@ -17,7 +17,8 @@ CREATE TABLE `user` (
   `nickname` VARCHAR(256) NOT NULL DEFAULT '',
   `password_hash` VARCHAR(256) NOT NULL,
   `avatar_url` LONGTEXT NOT NULL,
-  `description` VARCHAR(256) NOT NULL DEFAULT ''
+  `description` VARCHAR(256) NOT NULL DEFAULT '',
+  `email_verified` BOOLEAN NOT NULL DEFAULT FALSE
 );
 
 -- user_setting
@@ -39,7 +40,12 @@ CREATE TABLE `memo` (
   `content` TEXT NOT NULL,
   `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
   `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
-  `payload` JSON NOT NULL
+  `payload` JSON NOT NULL,
+  `revision` INT NOT NULL DEFAULT 0,
+  `due_ts` BIGINT DEFAULT NULL,
+  `remind_ts` BIGINT DEFAULT NULL,
+  `reminded_ts` BIGINT DEFAULT NULL,
+  `publish_ts` BIGINT DEFAULT NULL
 );
 
 -- memo_relation
@@ -105,6 +111,10 @@ CREATE TABLE `memo_share` (
   `creator_id` INT          NOT NULL,
   `created_ts` BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
   `expires_ts` BIGINT       DEFAULT NULL,
+  `password_hash` VARCHAR(255) NOT NULL DEFAULT '',
+  `max_views` INT DEFAULT NULL,
+  `view_count` INT NOT NULL DEFAULT 0,
+  `last_accessed_ts` BIGINT DEFAULT NULL,
   FOREIGN KEY (`memo_id`) REFERENCES `memo`(`id`) ON DELETE CASCADE
 );
 
@@ -123,3 +133,146 @@ CREATE TABLE `user_identity` (
 );
 
 CREATE INDEX `idx_user_identity_user_id` ON `user_identity`(`user_id`);
+
+-- sign_in_attempt
+CREATE TABLE `sign_in_attempt` (
+  `id`              INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `kind`            VARCHAR(32)  NOT NULL,
+  `identifier`      VARCHAR(256) NOT NULL,
+  `failure_count`   INT          NOT NULL DEFAULT 0,
+  `last_failure_ts` BIGINT       NOT NULL DEFAULT 0,
+  `locked_until_ts` BIGINT       NOT NULL DEFAULT 0,
+  UNIQUE (`kind`, `identifier`)
+);
+
+-- invitation
+CREATE TABLE `invitation` (
+  `id`         INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid`        VARCHAR(256) NOT NULL UNIQUE,
+  `code`       VARCHAR(256) NOT NULL UNIQUE,
+  `creator_id` INT          NOT NULL,
+  `role`       VARCHAR(256) NOT NULL DEFAULT 'USER',
+  `email`      VARCHAR(256) NOT NULL DEFAULT '',
+  `max_uses`   INT          NOT NULL DEFAULT 1,
+  `use_count`  INT          NOT NULL DEFAULT 0,
+  `created_ts` BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `expires_ts` BIGINT
+);
+
+-- oauth_client
+CREATE TABLE `oauth_client` (
+  `id`                 INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `client_id`          VARCHAR(256) NOT NULL UNIQUE,
+  `client_secret_hash` VARCHAR(256) NOT NULL DEFAULT '',
+  `name`               VARCHAR(256) NOT NULL,
+  `creator_id`         INT          NOT NULL DEFAULT 0,
+  `redirect_uris`      TEXT         NOT NULL,
+  `scope`              TEXT         NOT NULL,
+  `created_ts`         BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
+
+-- oauth_token
+CREATE TABLE `oauth_token` (
+  `id`             INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `kind`           VARCHAR(256) NOT NULL,
+  `token_hash`     VARCHAR(256) NOT NULL UNIQUE,
+  `client_id`      VARCHAR(256) NOT NULL,
+  `user_id`        INT          NOT NULL,
+  `scope`          TEXT         NOT NULL,
+  `redirect_uri`   TEXT         NOT NULL,
+  `code_challenge` VARCHAR(256) NOT NULL DEFAULT '',
+  `created_ts`     BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `expires_ts`     BIGINT       NOT NULL
+);
+
+CREATE INDEX `idx_oauth_token_client_id` ON `oauth_token` (`client_id`);
+
+-- custom_role
+CREATE TABLE `custom_role` (
+  `id`          INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `name`        VARCHAR(256) NOT NULL UNIQUE,
+  `description` TEXT         NOT NULL,
+  `permissions` TEXT         NOT NULL,
+  `created_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `updated_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
+
+-- audit_log
+CREATE TABLE `audit_log` (
+  `id`          INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `actor_id`    INT          NOT NULL DEFAULT 0,
+  `action`      VARCHAR(256) NOT NULL,
+  `resource`    VARCHAR(256) NOT NULL DEFAULT '',
+  `detail`      TEXT         NOT NULL,
+  `ip_address`  VARCHAR(256) NOT NULL DEFAULT '',
+  `user_agent`  TEXT         NOT NULL
+);
+
+CREATE INDEX `idx_audit_log_created_ts` ON `audit_log` (`created_ts`);
+CREATE INDEX `idx_audit_log_actor_id` ON `audit_log` (`actor_id`);
+
+-- user_group
+CREATE TABLE `user_group` (
+  `id`          INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `name`        VARCHAR(256) NOT NULL UNIQUE,
+  `description` TEXT         NOT NULL,
+  `created_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `updated_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
+
+-- user_group_member
+CREATE TABLE `user_group_member` (
+  `group_id`    INT          NOT NULL,
+  `user_id`     INT          NOT NULL,
+  `created_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  UNIQUE(`group_id`, `user_id`)
+);
+
+CREATE INDEX `idx_user_group_member_user_id` ON `user_group_member` (`user_id`);
+
+-- memo_collaborator
+CREATE TABLE `memo_collaborator` (
+  `memo_id`     INT          NOT NULL,
+  `user_id`     INT          NOT NULL,
+  `role`        VARCHAR(256) NOT NULL DEFAULT 'VIEWER',
+  `created_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  UNIQUE(`memo_id`, `user_id`)
+);
+
+CREATE INDEX `idx_memo_collaborator_user_id` ON `memo_collaborator` (`user_id`);
+
+-- collection_share
+CREATE TABLE `collection_share` (
+  `id`          INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid`         VARCHAR(255) NOT NULL UNIQUE,
+  `creator_id`  INT          NOT NULL,
+  `shortcut_id` VARCHAR(256) NOT NULL DEFAULT '',
+  `tag`         VARCHAR(256) NOT NULL DEFAULT '',
+  `created_ts`  BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `expires_ts`  BIGINT       DEFAULT NULL
+);
+
+CREATE INDEX `idx_collection_share_creator_id` ON `collection_share` (`creator_id`);
+
+-- webhook_delivery
+CREATE TABLE `webhook_delivery` (
+  `id`              INT          NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid`             VARCHAR(255) NOT NULL UNIQUE,
+  `creator_id`      INT          NOT NULL,
+  `webhook_id`      VARCHAR(255) NOT NULL,
+  `activity_type`   VARCHAR(256) NOT NULL DEFAULT '',
+  `url`             TEXT         NOT NULL,
+  `payload`         MEDIUMTEXT   NOT NULL,
+  `format`          VARCHAR(32)  NOT NULL DEFAULT '',
+  `status`          VARCHAR(32)  NOT NULL DEFAULT 'PENDING',
+  `attempt_count`   INT          NOT NULL DEFAULT 0,
+  `next_attempt_ts` BIGINT       NOT NULL DEFAULT 0,
+  `last_attempt_ts` BIGINT       DEFAULT NULL,
+  `response_code`   INT          NOT NULL DEFAULT 0,
+  `response_body`   TEXT         NOT NULL,
+  `created_ts`      BIGINT       NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
+
+CREATE INDEX `idx_webhook_delivery_creator_id_webhook_id` ON `webhook_delivery` (`creator_id`, `webhook_id`);
+CREATE INDEX `idx_webhook_delivery_status_next_attempt_ts` ON `webhook_delivery` (`status`, `next_attempt_ts`);
@@ -0,0 +1,13 @@
+-- invitation stores registration invite codes created by admins.
+CREATE TABLE invitation (
+  id         SERIAL  PRIMARY KEY,
+  uid        TEXT    NOT NULL UNIQUE,
+  code       TEXT    NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  role       TEXT    NOT NULL DEFAULT 'USER',
+  email      TEXT    NOT NULL DEFAULT '',
+  max_uses   INTEGER NOT NULL DEFAULT 1,
+  use_count  INTEGER NOT NULL DEFAULT 0,
+  created_ts BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  expires_ts BIGINT
+);
@@ -0,0 +1,4 @@
+ALTER TABLE "user" ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Existing emails predate verification, so they are treated as verified.
+UPDATE "user" SET email_verified = TRUE WHERE email <> '';
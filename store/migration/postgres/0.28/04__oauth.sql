@@ -0,0 +1,27 @@
+-- oauth_client stores third-party applications registered with the OAuth2 authorization server.
+CREATE TABLE oauth_client (
+  id                 SERIAL  PRIMARY KEY,
+  client_id          TEXT    NOT NULL UNIQUE,
+  client_secret_hash TEXT    NOT NULL DEFAULT '',
+  name               TEXT    NOT NULL,
+  creator_id         INTEGER NOT NULL DEFAULT 0,
+  redirect_uris      TEXT    NOT NULL DEFAULT '',
+  scope              TEXT    NOT NULL DEFAULT '',
+  created_ts         BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+-- oauth_token stores hashed authorization codes and refresh tokens issued to OAuth clients.
+CREATE TABLE oauth_token (
+  id             SERIAL  PRIMARY KEY,
+  kind           TEXT    NOT NULL,
+  token_hash     TEXT    NOT NULL UNIQUE,
+  client_id      TEXT    NOT NULL,
+  user_id        INTEGER NOT NULL,
+  scope          TEXT    NOT NULL DEFAULT '',
+  redirect_uri   TEXT    NOT NULL DEFAULT '',
+  code_challenge TEXT    NOT NULL DEFAULT '',
+  created_ts     BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  expires_ts     BIGINT  NOT NULL
+);
+
+CREATE INDEX idx_oauth_token_client_id ON oauth_token (client_id);
@@ -0,0 +1,9 @@
+-- custom_role stores admin-defined roles that grant named permissions.
+CREATE TABLE custom_role (
+  id          SERIAL  PRIMARY KEY,
+  name        TEXT    NOT NULL UNIQUE,
+  description TEXT    NOT NULL DEFAULT '',
+  permissions TEXT    NOT NULL DEFAULT '',
+  created_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
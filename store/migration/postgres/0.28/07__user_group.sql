@@ -0,0 +1,18 @@
+-- user_group stores named groups of users that memos can be shared with.
+CREATE TABLE user_group (
+  id          SERIAL  PRIMARY KEY,
+  name        TEXT    NOT NULL UNIQUE,
+  description TEXT    NOT NULL DEFAULT '',
+  created_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+-- user_group_member stores group memberships.
+CREATE TABLE user_group_member (
+  group_id    INTEGER NOT NULL,
+  user_id     INTEGER NOT NULL,
+  created_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member (user_id);
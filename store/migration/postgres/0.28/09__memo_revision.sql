@@ -0,0 +1 @@
+ALTER TABLE memo ADD COLUMN revision INTEGER NOT NULL DEFAULT 0;
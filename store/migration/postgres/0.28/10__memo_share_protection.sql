@@ -0,0 +1,4 @@
+ALTER TABLE memo_share ADD COLUMN password_hash TEXT NOT NULL DEFAULT '';
+ALTER TABLE memo_share ADD COLUMN max_views INTEGER DEFAULT NULL;
+ALTER TABLE memo_share ADD COLUMN view_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE memo_share ADD COLUMN last_accessed_ts BIGINT DEFAULT NULL;
@@ -17,7 +17,8 @@ CREATE TABLE "user" (
   nickname TEXT NOT NULL DEFAULT '',
   password_hash TEXT NOT NULL,
   avatar_url TEXT NOT NULL,
-  description TEXT NOT NULL DEFAULT ''
+  description TEXT NOT NULL DEFAULT '',
+  email_verified BOOLEAN NOT NULL DEFAULT FALSE
 );
 
 -- user_setting
@@ -39,7 +40,12 @@ CREATE TABLE memo (
   content TEXT NOT NULL,
   visibility TEXT NOT NULL DEFAULT 'PRIVATE',
   pinned BOOLEAN NOT NULL DEFAULT FALSE,
-  payload JSONB NOT NULL DEFAULT '{}'
+  payload JSONB NOT NULL DEFAULT '{}',
+  revision INTEGER NOT NULL DEFAULT 0,
+  due_ts BIGINT,
+  remind_ts BIGINT,
+  reminded_ts BIGINT,
+  publish_ts BIGINT
 );
 
 -- memo_relation
@@ -105,6 +111,10 @@ CREATE TABLE memo_share (
   creator_id INTEGER NOT NULL,
   created_ts BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   expires_ts BIGINT  DEFAULT NULL,
+  password_hash TEXT NOT NULL DEFAULT '',
+  max_views INTEGER DEFAULT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  last_accessed_ts BIGINT DEFAULT NULL,
   FOREIGN KEY (memo_id) REFERENCES memo(id) ON DELETE CASCADE
 );
 
@@ -123,3 +133,146 @@ CREATE TABLE user_identity (
 );
 
 CREATE INDEX idx_user_identity_user_id ON user_identity(user_id);
+
+-- sign_in_attempt
+CREATE TABLE sign_in_attempt (
+  id              SERIAL  PRIMARY KEY,
+  kind            TEXT    NOT NULL,
+  identifier      TEXT    NOT NULL,
+  failure_count   INTEGER NOT NULL DEFAULT 0,
+  last_failure_ts BIGINT  NOT NULL DEFAULT 0,
+  locked_until_ts BIGINT  NOT NULL DEFAULT 0,
+  UNIQUE (kind, identifier)
+);
+
+-- invitation
+CREATE TABLE invitation (
+  id         SERIAL  PRIMARY KEY,
+  uid        TEXT    NOT NULL UNIQUE,
+  code       TEXT    NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  role       TEXT    NOT NULL DEFAULT 'USER',
+  email      TEXT    NOT NULL DEFAULT '',
+  max_uses   INTEGER NOT NULL DEFAULT 1,
+  use_count  INTEGER NOT NULL DEFAULT 0,
+  created_ts BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  expires_ts BIGINT
+);
+
+-- oauth_client
+CREATE TABLE oauth_client (
+  id                 SERIAL  PRIMARY KEY,
+  client_id          TEXT    NOT NULL UNIQUE,
+  client_secret_hash TEXT    NOT NULL DEFAULT '',
+  name               TEXT    NOT NULL,
+  creator_id         INTEGER NOT NULL DEFAULT 0,
+  redirect_uris      TEXT    NOT NULL DEFAULT '',
+  scope              TEXT    NOT NULL DEFAULT '',
+  created_ts         BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+-- oauth_token
+CREATE TABLE oauth_token (
+  id             SERIAL  PRIMARY KEY,
+  kind           TEXT    NOT NULL,
+  token_hash     TEXT    NOT NULL UNIQUE,
+  client_id      TEXT    NOT NULL,
+  user_id        INTEGER NOT NULL,
+  scope          TEXT    NOT NULL DEFAULT '',
+  redirect_uri   TEXT    NOT NULL DEFAULT '',
+  code_challenge TEXT    NOT NULL DEFAULT '',
+  created_ts     BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  expires_ts     BIGINT  NOT NULL
+);
+
+CREATE INDEX idx_oauth_token_client_id ON oauth_token (client_id);
+
+-- custom_role
+CREATE TABLE custom_role (
+  id          SERIAL  PRIMARY KEY,
+  name        TEXT    NOT NULL UNIQUE,
+  description TEXT    NOT NULL DEFAULT '',
+  permissions TEXT    NOT NULL DEFAULT '',
+  created_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+-- audit_log
+CREATE TABLE audit_log (
+  id          SERIAL  PRIMARY KEY,
+  created_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  actor_id    INTEGER NOT NULL DEFAULT 0,
+  action      TEXT    NOT NULL,
+  resource    TEXT    NOT NULL DEFAULT '',
+  detail      TEXT    NOT NULL DEFAULT '',
+  ip_address  TEXT    NOT NULL DEFAULT '',
+  user_agent  TEXT    NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_audit_log_created_ts ON audit_log (created_ts);
+CREATE INDEX idx_audit_log_actor_id ON audit_log (actor_id);
+
+-- user_group
+CREATE TABLE user_group (
+  id          SERIAL  PRIMARY KEY,
+  name        TEXT    NOT NULL UNIQUE,
+  description TEXT    NOT NULL DEFAULT '',
+  created_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id    INTEGER NOT NULL,
+  user_id     INTEGER NOT NULL,
+  created_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member (user_id);
+
+-- memo_collaborator
+CREATE TABLE memo_collaborator (
+  memo_id     INTEGER NOT NULL,
+  user_id     INTEGER NOT NULL,
+  role        TEXT    NOT NULL DEFAULT 'VIEWER',
+  created_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(memo_id, user_id)
+);
+
+CREATE INDEX idx_memo_collaborator_user_id ON memo_collaborator (user_id);
+
+-- collection_share
+CREATE TABLE collection_share (
+  id          SERIAL  PRIMARY KEY,
+  uid         TEXT    NOT NULL UNIQUE,
+  creator_id  INTEGER NOT NULL,
+  shortcut_id TEXT    NOT NULL DEFAULT '',
+  tag         TEXT    NOT NULL DEFAULT '',
+  created_ts  BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  expires_ts  BIGINT  DEFAULT NULL
+);
+
+CREATE INDEX idx_collection_share_creator_id ON collection_share (creator_id);
+
+-- webhook_delivery
+CREATE TABLE webhook_delivery (
+  id              SERIAL  PRIMARY KEY,
+  uid             TEXT    NOT NULL UNIQUE,
+  creator_id      INTEGER NOT NULL,
+  webhook_id      TEXT    NOT NULL,
+  activity_type   TEXT    NOT NULL DEFAULT '',
+  url             TEXT    NOT NULL,
+  payload         TEXT    NOT NULL DEFAULT '',
+  format          TEXT    NOT NULL DEFAULT '',
+  status          TEXT    NOT NULL DEFAULT 'PENDING',
+  attempt_count   INTEGER NOT NULL DEFAULT 0,
+  next_attempt_ts BIGINT  NOT NULL DEFAULT 0,
+  last_attempt_ts BIGINT  DEFAULT NULL,
+  response_code   INTEGER NOT NULL DEFAULT 0,
+  response_body   TEXT    NOT NULL DEFAULT '',
+  created_ts      BIGINT  NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_webhook_delivery_creator_id_webhook_id ON webhook_delivery (creator_id, webhook_id);
+CREATE INDEX idx_webhook_delivery_status_next_attempt_ts ON webhook_delivery (status, next_attempt_ts);
@@ -0,0 +1,11 @@
+-- sign_in_attempt tracks failed sign-in attempts per client IP and per username
+-- so that brute-force protection survives restarts.
+CREATE TABLE sign_in_attempt (
+  id              INTEGER PRIMARY KEY AUTOINCREMENT,
+  kind            TEXT    NOT NULL,
+  identifier      TEXT    NOT NULL,
+  failure_count   INTEGER NOT NULL DEFAULT 0,
+  last_failure_ts BIGINT  NOT NULL DEFAULT 0,
+  locked_until_ts BIGINT  NOT NULL DEFAULT 0,
+  UNIQUE (kind, identifier)
+);
@@ -0,0 +1,4 @@
+ALTER TABLE user ADD COLUMN email_verified INTEGER NOT NULL CHECK (email_verified IN (0, 1)) DEFAULT 0;
+
+-- Existing emails predate verification, so they are treated as verified.
+UPDATE user SET email_verified = 1 WHERE email <> '';
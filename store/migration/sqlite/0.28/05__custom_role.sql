@@ -0,0 +1,9 @@
+-- custom_role stores admin-defined roles that grant named permissions.
+CREATE TABLE custom_role (
+  id          INTEGER PRIMARY KEY AUTOINCREMENT,
+  name        TEXT    NOT NULL UNIQUE,
+  description TEXT    NOT NULL DEFAULT '',
+  permissions TEXT    NOT NULL DEFAULT '',
+  created_ts  BIGINT  NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts  BIGINT  NOT NULL DEFAULT (strftime('%s', 'now'))
+);
@@ -0,0 +1,14 @@
+-- audit_log is an append-only record of security-relevant actions.
+CREATE TABLE audit_log (
+  id          INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts  BIGINT  NOT NULL DEFAULT (strftime('%s', 'now')),
+  actor_id    INTEGER NOT NULL DEFAULT 0,
+  action      TEXT    NOT NULL,
+  resource    TEXT    NOT NULL DEFAULT '',
+  detail      TEXT    NOT NULL DEFAULT '',
+  ip_address  TEXT    NOT NULL DEFAULT '',
+  user_agent  TEXT    NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_audit_log_created_ts ON audit_log (created_ts);
+CREATE INDEX idx_audit_log_actor_id ON audit_log (actor_id);
@@ -0,0 +1,39 @@
+-- user_group stores named groups of users that memos can be shared with.
+CREATE TABLE user_group (
+  id          INTEGER PRIMARY KEY AUTOINCREMENT,
+  name        TEXT    NOT NULL UNIQUE,
+  description TEXT    NOT NULL DEFAULT '',
+  created_ts  BIGINT  NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts  BIGINT  NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+-- user_group_member stores group memberships.
+CREATE TABLE user_group_member (
+  group_id    INTEGER NOT NULL,
+  user_id     INTEGER NOT NULL,
+  created_ts  BIGINT  NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member (user_id);
+
+-- Allow the GROUP memo visibility.
+CREATE TABLE _memo_new (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  uid TEXT NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE', 'GROUP')) DEFAULT 'PRIVATE',
+  pinned INTEGER NOT NULL CHECK (pinned IN (0, 1)) DEFAULT 0,
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+INSERT INTO _memo_new (id, uid, creator_id, created_ts, updated_ts, row_status, content, visibility, pinned, payload)
+SELECT id, uid, creator_id, created_ts, updated_ts, row_status, content, visibility, pinned, payload FROM memo;
+
+DROP TABLE memo;
+
+ALTER TABLE _memo_new RENAME TO memo;
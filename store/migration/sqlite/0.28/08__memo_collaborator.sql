@@ -0,0 +1,10 @@
+-- memo_collaborator grants individual users viewer or editor rights on a memo.
+CREATE TABLE memo_collaborator (
+  memo_id     INTEGER NOT NULL,
+  user_id     INTEGER NOT NULL,
+  role        TEXT    NOT NULL CHECK (role IN ('VIEWER', 'EDITOR')) DEFAULT 'VIEWER',
+  created_ts  BIGINT  NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(memo_id, user_id)
+);
+
+CREATE INDEX idx_memo_collaborator_user_id ON memo_collaborator (user_id);
@@ -0,0 +1,12 @@
+-- collection_share grants read-only access to the memos matching a shortcut or tag via a bearer token.
+CREATE TABLE collection_share (
+  id          INTEGER PRIMARY KEY AUTOINCREMENT,
+  uid         TEXT    NOT NULL UNIQUE,
+  creator_id  INTEGER NOT NULL,
+  shortcut_id TEXT    NOT NULL DEFAULT '',
+  tag         TEXT    NOT NULL DEFAULT '',
+  created_ts  BIGINT  NOT NULL DEFAULT (strftime('%s', 'now')),
+  expires_ts  BIGINT  DEFAULT NULL
+);
+
+CREATE INDEX idx_collection_share_creator_id ON collection_share (creator_id);
@@ -0,0 +1,3 @@
+ALTER TABLE memo ADD COLUMN due_ts BIGINT;
+ALTER TABLE memo ADD COLUMN remind_ts BIGINT;
+ALTER TABLE memo ADD COLUMN reminded_ts BIGINT;
@@ -0,0 +1 @@
+ALTER TABLE memo ADD COLUMN publish_ts BIGINT;
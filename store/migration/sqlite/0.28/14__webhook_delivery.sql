@@ -0,0 +1,20 @@
+-- webhook_delivery records each webhook delivery and its attempts, and queues the pending ones.
+CREATE TABLE webhook_delivery (
+  id              INTEGER PRIMARY KEY AUTOINCREMENT,
+  uid             TEXT    NOT NULL UNIQUE,
+  creator_id      INTEGER NOT NULL,
+  webhook_id      TEXT    NOT NULL,
+  activity_type   TEXT    NOT NULL DEFAULT '',
+  url             TEXT    NOT NULL,
+  payload         TEXT    NOT NULL DEFAULT '',
+  status          TEXT    NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')) DEFAULT 'PENDING',
+  attempt_count   INTEGER NOT NULL DEFAULT 0,
+  next_attempt_ts BIGINT  NOT NULL DEFAULT 0,
+  last_attempt_ts BIGINT  DEFAULT NULL,
+  response_code   INTEGER NOT NULL DEFAULT 0,
+  response_body   TEXT    NOT NULL DEFAULT '',
+  created_ts      BIGINT  NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE INDEX idx_webhook_delivery_creator_id_webhook_id ON webhook_delivery (creator_id, webhook_id);
+CREATE INDEX idx_webhook_delivery_status_next_attempt_ts ON webhook_delivery (status, next_attempt_ts);
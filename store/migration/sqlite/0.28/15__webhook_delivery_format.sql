@@ -0,0 +1 @@
+ALTER TABLE webhook_delivery ADD COLUMN format TEXT NOT NULL DEFAULT '';
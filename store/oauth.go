@@ -0,0 +1,136 @@
+package store
+
+import "context"
+
+// OAuthTokenKind distinguishes the credentials stored in the oauth_token table.
+type OAuthTokenKind string
+
+const (
+	// OAuthTokenKindAuthorizationCode is a single-use code exchanged at the token endpoint.
+	OAuthTokenKindAuthorizationCode OAuthTokenKind = "AUTHORIZATION_CODE"
+	// OAuthTokenKindRefreshToken is a long-lived token used to mint new access tokens.
+	OAuthTokenKindRefreshToken OAuthTokenKind = "REFRESH_TOKEN"
+)
+
+// OAuthClient is a third-party application registered with the OAuth2 authorization server.
+type OAuthClient struct {
+	ID       int32
+	ClientID string
+	// ClientSecretHash is empty for public clients, which must authenticate with PKCE only.
+	ClientSecretHash string
+	Name             string
+	// CreatorID is 0 for clients created through dynamic client registration.
+	CreatorID    int32
+	RedirectURIs []string
+	// Scopes are the scopes requested when the client omits the scope parameter.
+	Scopes    []string
+	CreatedTs int64
+}
+
+// FindOAuthClient is used to filter OAuth clients in list/get queries.
+type FindOAuthClient struct {
+	ID        *int32
+	ClientID  *string
+	CreatorID *int32
+}
+
+// DeleteOAuthClient identifies an OAuth client to remove.
+type DeleteOAuthClient struct {
+	ID int32
+}
+
+// OAuthToken is a hashed authorization code or refresh token issued to an OAuth client.
+type OAuthToken struct {
+	ID        int32
+	Kind      OAuthTokenKind
+	TokenHash string
+	ClientID  string
+	UserID    int32
+	Scopes    []string
+	// RedirectURI and CodeChallenge are only set for authorization codes.
+	RedirectURI   string
+	CodeChallenge string
+	CreatedTs     int64
+	ExpiresTs     int64
+}
+
+// FindOAuthToken is used to filter OAuth tokens in list/get queries.
+type FindOAuthToken struct {
+	Kind      *OAuthTokenKind
+	TokenHash *string
+	ClientID  *string
+	UserID    *int32
+}
+
+// DeleteOAuthTokens identifies OAuth tokens to remove.
+type DeleteOAuthTokens struct {
+	ID        *int32
+	ClientID  *string
+	UserID    *int32
+	ExpiredTs *int64 // delete tokens that expired before this timestamp
+}
+
+// CreateOAuthClient registers a new OAuth client.
+func (s *Store) CreateOAuthClient(ctx context.Context, create *OAuthClient) (*OAuthClient, error) {
+	return s.driver.CreateOAuthClient(ctx, create)
+}
+
+// ListOAuthClients returns all OAuth clients matching the filter.
+func (s *Store) ListOAuthClients(ctx context.Context, find *FindOAuthClient) ([]*OAuthClient, error) {
+	return s.driver.ListOAuthClients(ctx, find)
+}
+
+// GetOAuthClient returns the first OAuth client matching the filter, or nil if none found.
+func (s *Store) GetOAuthClient(ctx context.Context, find *FindOAuthClient) (*OAuthClient, error) {
+	list, err := s.ListOAuthClients(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// DeleteOAuthClient removes an OAuth client together with every token issued to it.
+func (s *Store) DeleteOAuthClient(ctx context.Context, delete *DeleteOAuthClient) error {
+	client, err := s.GetOAuthClient(ctx, &FindOAuthClient{ID: &delete.ID})
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return nil
+	}
+	if _, err := s.driver.DeleteOAuthTokens(ctx, &DeleteOAuthTokens{ClientID: &client.ClientID}); err != nil {
+		return err
+	}
+	return s.driver.DeleteOAuthClient(ctx, delete)
+}
+
+// CreateOAuthToken stores a hashed authorization code or refresh token.
+func (s *Store) CreateOAuthToken(ctx context.Context, create *OAuthToken) (*OAuthToken, error) {
+	return s.driver.CreateOAuthToken(ctx, create)
+}
+
+// ListOAuthTokens returns all OAuth tokens matching the filter.
+func (s *Store) ListOAuthTokens(ctx context.Context, find *FindOAuthToken) ([]*OAuthToken, error) {
+	return s.driver.ListOAuthTokens(ctx, find)
+}
+
+// GetOAuthToken returns the first OAuth token matching the filter, or nil if none found.
+func (s *Store) GetOAuthToken(ctx context.Context, find *FindOAuthToken) (*OAuthToken, error) {
+	list, err := s.ListOAuthTokens(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// DeleteOAuthTokens removes OAuth tokens and returns how many rows were deleted.
+// Deleting a single token by ID and checking for a count of 1 consumes it atomically.
+func (s *Store) DeleteOAuthTokens(ctx context.Context, delete *DeleteOAuthTokens) (int64, error) {
+	return s.driver.DeleteOAuthTokens(ctx, delete)
+}
@@ -0,0 +1,100 @@
+package store
+
+import "context"
+
+// SignInAttemptKind is the dimension a sign-in attempt counter is keyed on.
+type SignInAttemptKind string
+
+const (
+	// SignInAttemptKindIP tracks failures from a single client IP address.
+	SignInAttemptKindIP SignInAttemptKind = "IP"
+	// SignInAttemptKindUsername tracks failures against a single username.
+	SignInAttemptKindUsername SignInAttemptKind = "USERNAME"
+	// SignInAttemptKindMemoShare tracks failed password attempts against a single memo share.
+	SignInAttemptKindMemoShare SignInAttemptKind = "MEMO_SHARE"
+	// SignInAttemptKindEmailRequestIP tracks password reset and email verification requests
+	// from a single client IP address.
+	SignInAttemptKindEmailRequestIP SignInAttemptKind = "EMAIL_REQUEST_IP"
+	// SignInAttemptKindEmailRequestAddress tracks password reset and email verification
+	// requests for a single email address.
+	SignInAttemptKindEmailRequestAddress SignInAttemptKind = "EMAIL_REQUEST_ADDRESS"
+)
+
+// SignInAttempt is a persisted counter of consecutive failed sign-in attempts.
+type SignInAttempt struct {
+	ID            int32
+	Kind          SignInAttemptKind
+	Identifier    string
+	FailureCount  int32
+	LastFailureTs int64
+	// LockedUntilTs is the unix timestamp until which sign-in is blocked; 0 means not locked.
+	LockedUntilTs int64
+}
+
+// FindSignInAttempt is used to filter sign-in attempts in list/get queries.
+type FindSignInAttempt struct {
+	Kind       *SignInAttemptKind
+	Identifier *string
+}
+
+// DeleteSignInAttempt identifies sign-in attempt counters to remove.
+type DeleteSignInAttempt struct {
+	Kind       *SignInAttemptKind
+	Identifier *string
+}
+
+// IncrementSignInAttempt adds one failure to the counter for (Kind, Identifier).
+type IncrementSignInAttempt struct {
+	Kind       SignInAttemptKind
+	Identifier string
+	// FailureTs is the unix timestamp of the failure.
+	FailureTs int64
+	// ResetBeforeTs restarts the count when the previous failure happened before it.
+	ResetBeforeTs int64
+}
+
+// LockSignInAttempt extends the lockout of the counter for (Kind, Identifier).
+type LockSignInAttempt struct {
+	Kind       SignInAttemptKind
+	Identifier string
+	// LockedUntilTs is kept when the counter is already locked for longer.
+	LockedUntilTs int64
+}
+
+// UpsertSignInAttempt creates or replaces the counter for (Kind, Identifier).
+func (s *Store) UpsertSignInAttempt(ctx context.Context, upsert *SignInAttempt) (*SignInAttempt, error) {
+	return s.driver.UpsertSignInAttempt(ctx, upsert)
+}
+
+// IncrementSignInAttempt atomically records a failure, creating the counter when needed, and
+// returns the updated counter. Concurrent failures are never lost.
+func (s *Store) IncrementSignInAttempt(ctx context.Context, increment *IncrementSignInAttempt) (*SignInAttempt, error) {
+	return s.driver.IncrementSignInAttempt(ctx, increment)
+}
+
+// LockSignInAttempt locks a counter until the given time unless it is already locked for longer.
+func (s *Store) LockSignInAttempt(ctx context.Context, lock *LockSignInAttempt) error {
+	return s.driver.LockSignInAttempt(ctx, lock)
+}
+
+// ListSignInAttempts returns all counters matching the filter.
+func (s *Store) ListSignInAttempts(ctx context.Context, find *FindSignInAttempt) ([]*SignInAttempt, error) {
+	return s.driver.ListSignInAttempts(ctx, find)
+}
+
+// GetSignInAttempt returns the counter matching the filter, or nil if none found.
+func (s *Store) GetSignInAttempt(ctx context.Context, find *FindSignInAttempt) (*SignInAttempt, error) {
+	list, err := s.ListSignInAttempts(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// DeleteSignInAttempts removes all counters matching the filter.
+func (s *Store) DeleteSignInAttempts(ctx context.Context, delete *DeleteSignInAttempt) error {
+	return s.driver.DeleteSignInAttempts(ctx, delete)
+}
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestAuditLogStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	old, err := ts.CreateAuditLog(ctx, &store.AuditLog{
+		CreatedTs: now - 3600,
+		ActorID:   user.ID,
+		Action:    store.AuditActionSignIn,
+		Resource:  "users/1",
+		IPAddress: "203.0.113.7",
+		UserAgent: "test-agent",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, old.ID)
+
+	recent, err := ts.CreateAuditLog(ctx, &store.AuditLog{
+		ActorID:  user.ID,
+		Action:   store.AuditActionUpdateInstanceSetting,
+		Resource: "instance/settings/GENERAL",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, recent.CreatedTs)
+
+	_, err = ts.CreateAuditLog(ctx, &store.AuditLog{Action: store.AuditActionSignIn, Detail: "anonymous"})
+	require.NoError(t, err)
+
+	// Newest first.
+	list, err := ts.ListAuditLogs(ctx, &store.FindAuditLog{})
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	require.Equal(t, old.ID, list[2].ID)
+	require.Equal(t, "203.0.113.7", list[2].IPAddress)
+	require.Equal(t, "test-agent", list[2].UserAgent)
+
+	list, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{ActorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	action := store.AuditActionSignIn
+	list, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{Action: &action})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	after := now - 60
+	list, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{CreatedTsAfter: &after})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	limit, offset := 1, 2
+	list, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{Limit: &limit, Offset: &offset})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, old.ID, list[0].ID)
+
+	deleted, err := ts.DeleteAuditLogs(ctx, &store.DeleteAuditLogs{CreatedTsBefore: after})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+	list, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+}
@@ -0,0 +1,92 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestCollectionShareStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	expiresTs := time.Now().Add(time.Hour).Unix()
+	share, err := ts.CreateCollectionShare(ctx, &store.CollectionShare{UID: "tag-share", CreatorID: user.ID, Tag: "recipes", ExpiresTs: &expiresTs})
+	require.NoError(t, err)
+	require.NotZero(t, share.ID)
+	require.NotZero(t, share.CreatedTs)
+	require.False(t, share.IsExpired())
+
+	_, err = ts.CreateCollectionShare(ctx, &store.CollectionShare{UID: "shortcut-share", CreatorID: user.ID, ShortcutID: "work"})
+	require.NoError(t, err)
+
+	shares, err := ts.ListCollectionShares(ctx, &store.FindCollectionShare{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, shares, 2)
+
+	uid := "tag-share"
+	found, err := ts.GetCollectionShare(ctx, &store.FindCollectionShare{UID: &uid})
+	require.NoError(t, err)
+	require.Equal(t, "recipes", found.Tag)
+	require.Equal(t, expiresTs, *found.ExpiresTs)
+
+	require.NoError(t, ts.DeleteCollectionShare(ctx, &store.DeleteCollectionShare{UID: &uid}))
+	found, err = ts.GetCollectionShare(ctx, &store.FindCollectionShare{UID: &uid})
+	require.NoError(t, err)
+	require.Nil(t, found)
+}
+
+func TestCollectionShareFilters(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	other, err := createTestingUserWithRole(ctx, ts, "other", store.RoleUser)
+	require.NoError(t, err)
+
+	_, err = ts.CreateMemo(ctx, &store.Memo{UID: "tagged", CreatorID: user.ID, Content: "#recipes", Visibility: store.Private, Payload: &storepb.MemoPayload{Tags: []string{"recipes"}}})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{UID: "untagged", CreatorID: user.ID, Content: "plain", Visibility: store.Public})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{UID: "others-tagged", CreatorID: other.ID, Content: "#recipes", Visibility: store.Public, Payload: &storepb.MemoPayload{Tags: []string{"recipes"}}})
+	require.NoError(t, err)
+
+	// A tag share only selects the creator's memos carrying the tag.
+	filters, err := ts.GetCollectionShareFilters(ctx, &store.CollectionShare{CreatorID: user.ID, Tag: "recipes"})
+	require.NoError(t, err)
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{Filters: filters})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, "tagged", memos[0].UID)
+
+	// A shortcut share uses the shortcut's filter, and resolves to nil once the shortcut is gone.
+	share := &store.CollectionShare{CreatorID: user.ID, ShortcutID: "public"}
+	filters, err = ts.GetCollectionShareFilters(ctx, share)
+	require.NoError(t, err)
+	require.Nil(t, filters)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_SHORTCUTS,
+		Value: &storepb.UserSetting_Shortcuts{Shortcuts: &storepb.ShortcutsUserSetting{
+			Shortcuts: []*storepb.ShortcutsUserSetting_Shortcut{{Id: "public", Title: "Public", Filter: `visibility == "PUBLIC"`}},
+		}},
+	})
+	require.NoError(t, err)
+	filters, err = ts.GetCollectionShareFilters(ctx, share)
+	require.NoError(t, err)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{Filters: filters})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, "untagged", memos[0].UID)
+}
@@ -0,0 +1,76 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestCustomRoleStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	role, err := ts.CreateCustomRole(ctx, &store.CustomRole{
+		Name:        "moderator",
+		Description: "Keeps public memos tidy",
+		Permissions: []store.Permission{store.PermissionModerateMemos},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, role.ID)
+	require.NotZero(t, role.CreatedTs)
+
+	_, err = ts.CreateCustomRole(ctx, &store.CustomRole{Name: "auditor", Permissions: []store.Permission{store.PermissionViewStats}})
+	require.NoError(t, err)
+
+	// Role names are unique.
+	_, err = ts.CreateCustomRole(ctx, &store.CustomRole{Name: "moderator"})
+	require.Error(t, err)
+
+	roles, err := ts.ListCustomRoles(ctx, &store.FindCustomRole{})
+	require.NoError(t, err)
+	require.Len(t, roles, 2)
+	require.Equal(t, "auditor", roles[0].Name)
+
+	name := "moderator"
+	found, err := ts.GetCustomRole(ctx, &store.FindCustomRole{Name: &name})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, "Keeps public memos tidy", found.Description)
+	require.True(t, found.HasPermission(store.PermissionModerateMemos))
+	require.False(t, found.HasPermission(store.PermissionManageUsers))
+
+	description := "Moderates memos and views stats"
+	updated, err := ts.UpdateCustomRole(ctx, &store.UpdateCustomRole{
+		ID:          role.ID,
+		Description: &description,
+		Permissions: []store.Permission{store.PermissionModerateMemos, store.PermissionViewStats},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "moderator", updated.Name)
+	require.Equal(t, description, updated.Description)
+	require.Equal(t, []store.Permission{store.PermissionModerateMemos, store.PermissionViewStats}, updated.Permissions)
+
+	// Clearing permissions is distinct from leaving them unchanged.
+	updated, err = ts.UpdateCustomRole(ctx, &store.UpdateCustomRole{ID: role.ID, Permissions: []store.Permission{}})
+	require.NoError(t, err)
+	require.Empty(t, updated.Permissions)
+	require.Equal(t, description, updated.Description)
+
+	require.NoError(t, ts.DeleteCustomRole(ctx, &store.DeleteCustomRole{ID: role.ID}))
+	found, err = ts.GetCustomRole(ctx, &store.FindCustomRole{Name: &name})
+	require.NoError(t, err)
+	require.Nil(t, found)
+}
+
+func TestRoleIsCustom(t *testing.T) {
+	t.Parallel()
+	require.False(t, store.RoleAdmin.IsCustom())
+	require.False(t, store.RoleUser.IsCustom())
+	require.False(t, store.Role("").IsCustom())
+	require.True(t, store.Role("moderator").IsCustom())
+}
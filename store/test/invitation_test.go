@@ -0,0 +1,79 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestInvitationStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	expiresTs := int64(4102444800)
+	invitation, err := ts.CreateInvitation(ctx, &store.Invitation{
+		UID:       "invitation-1",
+		Code:      "code-1",
+		CreatorID: user.ID,
+		Role:      store.RoleUser,
+		Email:     "alice@example.com",
+		MaxUses:   2,
+		ExpiresTs: &expiresTs,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, invitation.ID)
+	require.Zero(t, invitation.UseCount)
+
+	code := "code-1"
+	found, err := ts.GetInvitation(ctx, &store.FindInvitation{Code: &code})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, "invitation-1", found.UID)
+	require.Equal(t, "alice@example.com", found.Email)
+	require.Equal(t, int32(2), found.MaxUses)
+	require.NotNil(t, found.ExpiresTs)
+	require.Equal(t, expiresTs, *found.ExpiresTs)
+
+	// Each use is recorded until the invitation is exhausted.
+	for i := 0; i < 2; i++ {
+		consumed, err := ts.ConsumeInvitation(ctx, invitation.ID)
+		require.NoError(t, err)
+		require.True(t, consumed)
+	}
+	consumed, err := ts.ConsumeInvitation(ctx, invitation.ID)
+	require.NoError(t, err)
+	require.False(t, consumed)
+
+	found, err = ts.GetInvitation(ctx, &store.FindInvitation{ID: &invitation.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), found.UseCount)
+
+	_, err = ts.CreateInvitation(ctx, &store.Invitation{
+		UID:       "invitation-2",
+		Code:      "code-2",
+		CreatorID: user.ID,
+		Role:      store.RoleAdmin,
+		MaxUses:   1,
+	})
+	require.NoError(t, err)
+	list, err := ts.ListInvitations(ctx, &store.FindInvitation{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	uid := "invitation-1"
+	require.NoError(t, ts.DeleteInvitation(ctx, &store.DeleteInvitation{UID: &uid}))
+	list, err = ts.ListInvitations(ctx, &store.FindInvitation{})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, "invitation-2", list[0].UID)
+	require.Equal(t, store.RoleAdmin, list[0].Role)
+	require.Nil(t, list[0].ExpiresTs)
+}
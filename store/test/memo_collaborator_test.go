@@ -0,0 +1,66 @@
+package test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoCollaboratorStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	collaboratorUser, err := createTestingUserWithRole(ctx, ts, "collaborator", store.RoleUser)
+	require.NoError(t, err)
+
+	memo, err := ts.CreateMemo(ctx, &store.Memo{UID: "shared-memo", CreatorID: user.ID, Content: "shared", Visibility: store.Private})
+	require.NoError(t, err)
+	other, err := ts.CreateMemo(ctx, &store.Memo{UID: "other-memo", CreatorID: user.ID, Content: "other", Visibility: store.Private})
+	require.NoError(t, err)
+
+	collaborator, err := ts.UpsertMemoCollaborator(ctx, &store.MemoCollaborator{MemoID: memo.ID, UserID: collaboratorUser.ID, Role: store.MemoCollaboratorViewer})
+	require.NoError(t, err)
+	require.Equal(t, store.MemoCollaboratorViewer, collaborator.Role)
+	require.NotZero(t, collaborator.CreatedTs)
+
+	// Upserting an existing collaborator changes its role.
+	collaborator, err = ts.UpsertMemoCollaborator(ctx, &store.MemoCollaborator{MemoID: memo.ID, UserID: collaboratorUser.ID, Role: store.MemoCollaboratorEditor})
+	require.NoError(t, err)
+	require.Equal(t, store.MemoCollaboratorEditor, collaborator.Role)
+
+	collaborators, err := ts.ListMemoCollaborators(ctx, &store.FindMemoCollaborator{UserID: &collaboratorUser.ID})
+	require.NoError(t, err)
+	require.Len(t, collaborators, 1)
+	require.Equal(t, memo.ID, collaborators[0].MemoID)
+
+	scope, err := ts.GetMemoAccessScope(ctx, collaboratorUser.ID)
+	require.NoError(t, err)
+	require.Equal(t, []int32{memo.ID}, scope.CollaboratorMemoIDs)
+	require.True(t, scope.Includes(memo))
+	require.False(t, scope.Includes(other))
+
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{Filters: []string{`id in [` + strconv.Itoa(int(memo.ID)) + `]`}})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, "shared-memo", memos[0].UID)
+
+	require.NoError(t, ts.DeleteMemoCollaborator(ctx, &store.DeleteMemoCollaborator{MemoID: memo.ID, UserID: &collaboratorUser.ID}))
+	collaborator, err = ts.GetMemoCollaborator(ctx, &store.FindMemoCollaborator{MemoID: &memo.ID, UserID: &collaboratorUser.ID})
+	require.NoError(t, err)
+	require.Nil(t, collaborator)
+
+	// Deleting a memo removes its collaborators.
+	_, err = ts.UpsertMemoCollaborator(ctx, &store.MemoCollaborator{MemoID: other.ID, UserID: collaboratorUser.ID, Role: store.MemoCollaboratorViewer})
+	require.NoError(t, err)
+	require.NoError(t, ts.DeleteMemo(ctx, &store.DeleteMemo{ID: other.ID}))
+	collaborators, err = ts.ListMemoCollaborators(ctx, &store.FindMemoCollaborator{MemoID: &other.ID})
+	require.NoError(t, err)
+	require.Empty(t, collaborators)
+}
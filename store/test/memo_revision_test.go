@@ -0,0 +1,121 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoConditionalUpdate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	memo, err := ts.CreateMemo(ctx, &store.Memo{UID: "revision-memo", CreatorID: user.ID, Content: "first", Visibility: store.Public})
+	require.NoError(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), memo.Revision)
+
+	content := "second"
+	revision := memo.Revision
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content, ExpectedRevision: &revision}))
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), memo.Revision)
+
+	// A stale revision neither updates nor deletes the memo.
+	stale := "stale"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &stale, ExpectedRevision: &revision})
+	require.ErrorIs(t, err, store.ErrMemoRevisionMismatch)
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID, ExpectedRevision: &revision})
+	require.ErrorIs(t, err, store.ErrMemoRevisionMismatch)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, "second", memo.Content)
+
+	// Unconditional updates also advance the revision.
+	pinned := true
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Pinned: &pinned}))
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), memo.Revision)
+
+	require.NoError(t, ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID, ExpectedRevision: &memo.Revision}))
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Nil(t, memo)
+}
+
+func TestMemoConditionalWritesAreAtomic(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	memo, err := ts.CreateMemo(ctx, &store.Memo{UID: "atomic-memo", CreatorID: user.ID, Content: "memo", Visibility: store.Public})
+	require.NoError(t, err)
+	related, err := ts.CreateMemo(ctx, &store.Memo{UID: "atomic-related", CreatorID: user.ID, Content: "related", Visibility: store.Public})
+	require.NoError(t, err)
+	comment, err := ts.CreateMemo(ctx, &store.Memo{UID: "atomic-comment", CreatorID: user.ID, Content: "comment", Visibility: store.Public})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: comment.ID, RelatedMemoID: memo.ID, Type: store.MemoRelationComment})
+	require.NoError(t, err)
+	attachment, err := ts.CreateAttachment(ctx, &store.Attachment{UID: "atomic-attachment", CreatorID: user.ID, Filename: "a.txt", Blob: []byte("a"), Type: "text/plain", Size: 1, MemoID: &memo.ID})
+	require.NoError(t, err)
+
+	// A stale update leaves the attachments and references alone.
+	stale := int32(5)
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:               memo.ID,
+		Attachments:      &store.UpdateMemoAttachments{},
+		References:       &store.UpdateMemoReferences{RelatedMemoIDs: []int32{related.ID}},
+		ExpectedRevision: &stale,
+	})
+	require.ErrorIs(t, err, store.ErrMemoRevisionMismatch)
+	attachments, err := ts.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	referenceType := store.MemoRelationReference
+	references, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memo.ID, Type: &referenceType})
+	require.NoError(t, err)
+	require.Empty(t, references)
+
+	revision := int32(0)
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:               memo.ID,
+		Attachments:      &store.UpdateMemoAttachments{},
+		References:       &store.UpdateMemoReferences{RelatedMemoIDs: []int32{related.ID}},
+		ExpectedRevision: &revision,
+	}))
+	deleted, err := ts.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID})
+	require.NoError(t, err)
+	require.Nil(t, deleted)
+	references, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memo.ID, Type: &referenceType})
+	require.NoError(t, err)
+	require.Len(t, references, 1)
+
+	// A stale delete keeps the memo and its comments.
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID, DeleteComments: true, ExpectedRevision: &revision})
+	require.ErrorIs(t, err, store.ErrMemoRevisionMismatch)
+	found, err := ts.GetMemo(ctx, &store.FindMemo{ID: &comment.ID})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+
+	revision++
+	require.NoError(t, ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID, DeleteComments: true, ExpectedRevision: &revision}))
+	found, err = ts.GetMemo(ctx, &store.FindMemo{ID: &comment.ID})
+	require.NoError(t, err)
+	require.Nil(t, found)
+	relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &related.ID})
+	require.NoError(t, err)
+	require.Empty(t, relations)
+}
@@ -476,3 +476,57 @@ func TestMemoWithPayload(t *testing.T) {
 
 	ts.Close()
 }
+
+func TestMemoReminder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	dueTs, remindTs := int64(2000), int64(1500)
+	dueMemo, err := ts.CreateMemo(ctx, &store.Memo{UID: "due-memo", CreatorID: user.ID, Content: "due", Visibility: store.Private, DueTs: &dueTs})
+	require.NoError(t, err)
+	remindMemo, err := ts.CreateMemo(ctx, &store.Memo{UID: "remind-memo", CreatorID: user.ID, Content: "remind", Visibility: store.Private, DueTs: &dueTs, RemindTs: &remindTs})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{UID: "plain-memo", CreatorID: user.ID, Content: "plain", Visibility: store.Private})
+	require.NoError(t, err)
+
+	listPending := func(before int64) []string {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{ReminderDueBefore: &before})
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range memos {
+			uids = append(uids, memo.UID)
+		}
+		return uids
+	}
+	require.Empty(t, listPending(1000))
+	require.ElementsMatch(t, []string{"remind-memo"}, listPending(1500))
+	require.ElementsMatch(t, []string{"due-memo", "remind-memo"}, listPending(2000))
+
+	// Marking a reminder succeeds once and does not bump the revision.
+	marked, err := ts.MarkMemoReminded(ctx, remindMemo.ID, 1500)
+	require.NoError(t, err)
+	require.True(t, marked)
+	marked, err = ts.MarkMemoReminded(ctx, remindMemo.ID, 1600)
+	require.NoError(t, err)
+	require.False(t, marked)
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &remindMemo.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1500), *memo.RemindedTs)
+	require.Equal(t, remindMemo.Revision, memo.Revision)
+	require.ElementsMatch(t, []string{"due-memo"}, listPending(2000))
+
+	// Changing the reminder time re-arms it, and a zero value clears the due time.
+	newRemindTs, cleared := int64(2500), int64(0)
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: remindMemo.ID, RemindTs: &newRemindTs}))
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: dueMemo.ID, DueTs: &cleared}))
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &dueMemo.ID})
+	require.NoError(t, err)
+	require.Nil(t, memo.DueTs)
+	require.Empty(t, listPending(2000))
+	require.ElementsMatch(t, []string{"remind-memo"}, listPending(2500))
+
+	ts.Close()
+}
@@ -0,0 +1,124 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestOAuthClientStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	client, err := ts.CreateOAuthClient(ctx, &store.OAuthClient{
+		ClientID:     "client-1",
+		Name:         "Test App",
+		CreatorID:    user.ID,
+		RedirectURIs: []string{"https://app.example.com/callback", "http://127.0.0.1/callback"},
+		Scopes:       []string{"memos:read", "memos:write"},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, client.ID)
+	require.NotZero(t, client.CreatedTs)
+
+	clientID := "client-1"
+	found, err := ts.GetOAuthClient(ctx, &store.FindOAuthClient{ClientID: &clientID})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, "Test App", found.Name)
+	require.Empty(t, found.ClientSecretHash)
+	require.Equal(t, []string{"https://app.example.com/callback", "http://127.0.0.1/callback"}, found.RedirectURIs)
+	require.Equal(t, []string{"memos:read", "memos:write"}, found.Scopes)
+
+	_, err = ts.CreateOAuthClient(ctx, &store.OAuthClient{
+		ClientID:     "client-2",
+		Name:         "Dynamic App",
+		RedirectURIs: []string{"http://localhost:3000/callback"},
+	})
+	require.NoError(t, err)
+
+	list, err := ts.ListOAuthClients(ctx, &store.FindOAuthClient{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	// Deleting a client also removes the tokens issued to it.
+	_, err = ts.CreateOAuthToken(ctx, &store.OAuthToken{
+		Kind:      store.OAuthTokenKindRefreshToken,
+		TokenHash: "hash-1",
+		ClientID:  clientID,
+		UserID:    user.ID,
+		Scopes:    []string{"memos:read"},
+		ExpiresTs: 4102444800,
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.DeleteOAuthClient(ctx, &store.DeleteOAuthClient{ID: client.ID}))
+
+	found, err = ts.GetOAuthClient(ctx, &store.FindOAuthClient{ClientID: &clientID})
+	require.NoError(t, err)
+	require.Nil(t, found)
+	tokens, err := ts.ListOAuthTokens(ctx, &store.FindOAuthToken{ClientID: &clientID})
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+}
+
+func TestOAuthTokenStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	code, err := ts.CreateOAuthToken(ctx, &store.OAuthToken{
+		Kind:          store.OAuthTokenKindAuthorizationCode,
+		TokenHash:     "code-hash",
+		ClientID:      "client-1",
+		UserID:        user.ID,
+		Scopes:        []string{"openid", "memos:read"},
+		RedirectURI:   "https://app.example.com/callback",
+		CodeChallenge: "challenge",
+		ExpiresTs:     4102444800,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, code.ID)
+
+	tokenHash := "code-hash"
+	kind := store.OAuthTokenKindAuthorizationCode
+	found, err := ts.GetOAuthToken(ctx, &store.FindOAuthToken{Kind: &kind, TokenHash: &tokenHash})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, user.ID, found.UserID)
+	require.Equal(t, []string{"openid", "memos:read"}, found.Scopes)
+	require.Equal(t, "https://app.example.com/callback", found.RedirectURI)
+	require.Equal(t, "challenge", found.CodeChallenge)
+
+	// Consuming a code succeeds exactly once.
+	deleted, err := ts.DeleteOAuthTokens(ctx, &store.DeleteOAuthTokens{ID: &found.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+	deleted, err = ts.DeleteOAuthTokens(ctx, &store.DeleteOAuthTokens{ID: &found.ID})
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+
+	_, err = ts.CreateOAuthToken(ctx, &store.OAuthToken{
+		Kind:      store.OAuthTokenKindRefreshToken,
+		TokenHash: "expired-hash",
+		ClientID:  "client-1",
+		UserID:    user.ID,
+		ExpiresTs: 100,
+	})
+	require.NoError(t, err)
+	now := int64(1000)
+	deleted, err = ts.DeleteOAuthTokens(ctx, &store.DeleteOAuthTokens{ExpiredTs: &now})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+}
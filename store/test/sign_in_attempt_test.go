@@ -0,0 +1,126 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestSignInAttemptUpsertAndDelete(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	kind := store.SignInAttemptKindUsername
+	identifier := "alice"
+	_, err := ts.UpsertSignInAttempt(ctx, &store.SignInAttempt{
+		Kind:          kind,
+		Identifier:    identifier,
+		FailureCount:  1,
+		LastFailureTs: 100,
+	})
+	require.NoError(t, err)
+
+	// Upserting the same key replaces the counter.
+	_, err = ts.UpsertSignInAttempt(ctx, &store.SignInAttempt{
+		Kind:          kind,
+		Identifier:    identifier,
+		FailureCount:  2,
+		LastFailureTs: 200,
+		LockedUntilTs: 260,
+	})
+	require.NoError(t, err)
+
+	// A counter for the same identifier with a different kind is independent.
+	_, err = ts.UpsertSignInAttempt(ctx, &store.SignInAttempt{
+		Kind:          store.SignInAttemptKindIP,
+		Identifier:    identifier,
+		FailureCount:  7,
+		LastFailureTs: 200,
+	})
+	require.NoError(t, err)
+
+	attempt, err := ts.GetSignInAttempt(ctx, &store.FindSignInAttempt{Kind: &kind, Identifier: &identifier})
+	require.NoError(t, err)
+	require.NotNil(t, attempt)
+	require.Equal(t, int32(2), attempt.FailureCount)
+	require.Equal(t, int64(200), attempt.LastFailureTs)
+	require.Equal(t, int64(260), attempt.LockedUntilTs)
+
+	list, err := ts.ListSignInAttempts(ctx, &store.FindSignInAttempt{Identifier: &identifier})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	err = ts.DeleteSignInAttempts(ctx, &store.DeleteSignInAttempt{Kind: &kind, Identifier: &identifier})
+	require.NoError(t, err)
+
+	attempt, err = ts.GetSignInAttempt(ctx, &store.FindSignInAttempt{Kind: &kind, Identifier: &identifier})
+	require.NoError(t, err)
+	require.Nil(t, attempt)
+
+	list, err = ts.ListSignInAttempts(ctx, &store.FindSignInAttempt{})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, store.SignInAttemptKindIP, list[0].Kind)
+}
+
+func TestSignInAttemptIncrement(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	kind := store.SignInAttemptKindIP
+	identifier := "203.0.113.1"
+	increment := func(failureTs, resetBeforeTs int64) *store.SignInAttempt {
+		attempt, err := ts.IncrementSignInAttempt(ctx, &store.IncrementSignInAttempt{
+			Kind:          kind,
+			Identifier:    identifier,
+			FailureTs:     failureTs,
+			ResetBeforeTs: resetBeforeTs,
+		})
+		require.NoError(t, err)
+		return attempt
+	}
+
+	attempt := increment(100, 0)
+	require.Equal(t, int32(1), attempt.FailureCount)
+	require.Equal(t, int64(100), attempt.LastFailureTs)
+
+	// Concurrent failures are all counted.
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := ts.IncrementSignInAttempt(ctx, &store.IncrementSignInAttempt{
+				Kind:       kind,
+				Identifier: identifier,
+				FailureTs:  110,
+			})
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	attempt = increment(120, 0)
+	require.Equal(t, int32(10), attempt.FailureCount)
+	require.Equal(t, int64(120), attempt.LastFailureTs)
+
+	// Locking never shortens an existing lockout.
+	require.NoError(t, ts.LockSignInAttempt(ctx, &store.LockSignInAttempt{Kind: kind, Identifier: identifier, LockedUntilTs: 500}))
+	require.NoError(t, ts.LockSignInAttempt(ctx, &store.LockSignInAttempt{Kind: kind, Identifier: identifier, LockedUntilTs: 300}))
+	attempt, err := ts.GetSignInAttempt(ctx, &store.FindSignInAttempt{Kind: &kind, Identifier: &identifier})
+	require.NoError(t, err)
+	require.Equal(t, int64(500), attempt.LockedUntilTs)
+
+	// A failure long after the previous one restarts the count.
+	attempt = increment(1000, 900)
+	require.Equal(t, int32(1), attempt.FailureCount)
+	require.Equal(t, int64(1000), attempt.LastFailureTs)
+	require.Equal(t, int64(500), attempt.LockedUntilTs)
+}
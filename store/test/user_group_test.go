@@ -0,0 +1,112 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestUserGroupStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	group, err := ts.CreateUserGroup(ctx, &store.UserGroup{Name: "team", Description: "The team"})
+	require.NoError(t, err)
+	require.NotZero(t, group.ID)
+	require.NotZero(t, group.CreatedTs)
+
+	// Group names are unique.
+	_, err = ts.CreateUserGroup(ctx, &store.UserGroup{Name: "team"})
+	require.Error(t, err)
+
+	other, err := ts.CreateUserGroup(ctx, &store.UserGroup{Name: "alpha"})
+	require.NoError(t, err)
+
+	groups, err := ts.ListUserGroups(ctx, &store.FindUserGroup{})
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	require.Equal(t, "alpha", groups[0].Name)
+
+	description := "Core team"
+	updated, err := ts.UpdateUserGroup(ctx, &store.UpdateUserGroup{ID: group.ID, Description: &description})
+	require.NoError(t, err)
+	require.Equal(t, "team", updated.Name)
+	require.Equal(t, description, updated.Description)
+
+	_, err = ts.CreateUserGroupMember(ctx, &store.UserGroupMember{GroupID: group.ID, UserID: user.ID})
+	require.NoError(t, err)
+	// A user can only join a group once.
+	_, err = ts.CreateUserGroupMember(ctx, &store.UserGroupMember{GroupID: group.ID, UserID: user.ID})
+	require.Error(t, err)
+
+	members, err := ts.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &group.ID})
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, user.ID, members[0].UserID)
+
+	names, err := ts.ListUserGroupNames(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"team"}, names)
+
+	memo := &store.Memo{Visibility: store.Group, CreatorID: user.ID, Payload: &storepb.MemoPayload{Audience: []string{"team"}}}
+	isMember, err := ts.IsMemoAudienceMember(ctx, memo, user.ID)
+	require.NoError(t, err)
+	require.True(t, isMember)
+	memo.Payload.Audience = []string{"alpha"}
+	isMember, err = ts.IsMemoAudienceMember(ctx, memo, user.ID)
+	require.NoError(t, err)
+	require.False(t, isMember)
+
+	// Deleting a group removes its memberships.
+	require.NoError(t, ts.DeleteUserGroup(ctx, &store.DeleteUserGroup{ID: group.ID}))
+	members, err = ts.ListUserGroupMembers(ctx, &store.FindUserGroupMember{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Empty(t, members)
+
+	require.NoError(t, ts.DeleteUserGroup(ctx, &store.DeleteUserGroup{ID: other.ID}))
+	groups, err = ts.ListUserGroups(ctx, &store.FindUserGroup{})
+	require.NoError(t, err)
+	require.Empty(t, groups)
+}
+
+func TestUserGroupMemoFilter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for uid, audience := range map[string][]string{"team-memo": {"team"}, "ops-memo": {"ops"}} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    uid,
+			Visibility: store.Group,
+			Payload:    &storepb.MemoPayload{Audience: audience},
+		})
+		require.NoError(t, err)
+	}
+
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{Filters: []string{`audience == "groups/team"`}})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, "team-memo", memos[0].UID)
+
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{Filters: []string{`audience in ["groups/team", "groups/ops"]`}})
+	require.NoError(t, err)
+	require.Len(t, memos, 2)
+
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{Filters: []string{`audience != "groups/team"`}})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, "ops-memo", memos[0].UID)
+}
@@ -162,6 +162,14 @@ func TestUserSettingRefreshTokens(t *testing.T) {
 	require.Len(t, tokens, 1)
 	require.Equal(t, "token-2", tokens[0].TokenId)
 
+	// Remove all tokens
+	err = ts.RemoveAllUserRefreshTokens(ctx, user.ID)
+	require.NoError(t, err)
+
+	tokens, err = ts.GetUserRefreshTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+
 	ts.Close()
 }
 
@@ -0,0 +1,99 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestWebhookDeliveryStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	delivery, err := ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		UID:           "delivery-1",
+		CreatorID:     user.ID,
+		WebhookID:     "hook-1",
+		ActivityType:  "memos.memo.created",
+		URL:           "https://example.com/hook",
+		Payload:       `{"activityType":"memos.memo.created"}`,
+		Status:        store.WebhookDeliveryPending,
+		NextAttemptTs: 100,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, delivery.ID)
+	require.NotZero(t, delivery.CreatedTs)
+	_, err = ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		UID:           "delivery-2",
+		CreatorID:     user.ID,
+		WebhookID:     "hook-2",
+		URL:           "https://example.com/other",
+		Status:        store.WebhookDeliveryPending,
+		NextAttemptTs: 200,
+	})
+	require.NoError(t, err)
+
+	// Only the deliveries due at the given time are selected.
+	pending := store.WebhookDeliveryPending
+	dueTs := int64(150)
+	due, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{Status: &pending, NextAttemptBefore: &dueTs})
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, "delivery-1", due[0].UID)
+	require.Equal(t, `{"activityType":"memos.memo.created"}`, due[0].Payload)
+
+	// A delivery can be claimed once for a given attempt.
+	claimed, err := ts.ClaimWebhookDelivery(ctx, delivery.ID, 100, 220)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	claimed, err = ts.ClaimWebhookDelivery(ctx, delivery.ID, 100, 220)
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	succeeded := store.WebhookDeliverySucceeded
+	attemptCount, lastAttemptTs, responseCode, responseBody := int32(1), int64(160), int32(200), `{"code":0}`
+	require.NoError(t, ts.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+		ID:            delivery.ID,
+		Status:        &succeeded,
+		AttemptCount:  &attemptCount,
+		LastAttemptTs: &lastAttemptTs,
+		ResponseCode:  &responseCode,
+		ResponseBody:  &responseBody,
+	}))
+	uid := "delivery-1"
+	found, err := ts.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{UID: &uid})
+	require.NoError(t, err)
+	require.Equal(t, store.WebhookDeliverySucceeded, found.Status)
+	require.Equal(t, int32(1), found.AttemptCount)
+	require.Equal(t, int64(160), *found.LastAttemptTs)
+	require.Equal(t, int32(200), found.ResponseCode)
+	require.Equal(t, `{"code":0}`, found.ResponseBody)
+	claimed, err = ts.ClaimWebhookDelivery(ctx, delivery.ID, 220, 300)
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	// Deliveries are listed newest first.
+	deliveries, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, "delivery-2", deliveries[0].UID)
+
+	webhookID := "hook-2"
+	deleted, err := ts.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDeliveries{CreatorID: &user.ID, WebhookID: &webhookID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), deleted)
+	_, err = ts.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDeliveries{})
+	require.Error(t, err)
+
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, "delivery-1", deliveries[0].UID)
+}
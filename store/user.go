@@ -15,6 +15,11 @@ const (
 	RoleUser Role = "USER"
 )
 
+// IsCustom reports whether the role names a CustomRole rather than a built-in role.
+func (e Role) IsCustom() bool {
+	return e != "" && e != RoleAdmin && e != RoleUser
+}
+
 func (e Role) String() string {
 	switch e {
 	case RoleAdmin:
@@ -40,21 +45,24 @@ type User struct {
 	PasswordHash string
 	AvatarURL    string
 	Description  string
+	// EmailVerified reports whether the user confirmed ownership of Email.
+	EmailVerified bool
 }
 
 type UpdateUser struct {
 	ID int32
 
-	UpdatedTs    *int64
-	RowStatus    *RowStatus
-	Username     *string
-	Role         *Role
-	Email        *string
-	Nickname     *string
-	Password     *string
-	AvatarURL    *string
-	PasswordHash *string
-	Description  *string
+	UpdatedTs     *int64
+	RowStatus     *RowStatus
+	Username      *string
+	Role          *Role
+	Email         *string
+	Nickname      *string
+	Password      *string
+	AvatarURL     *string
+	PasswordHash  *string
+	Description   *string
+	EmailVerified *bool
 }
 
 type FindUser struct {
@@ -166,6 +166,15 @@ func deleteUserTargetsTx(ctx context.Context, tx *sql.Tx, dialect deleteUserDial
 	if err := deleteUserSettingsTx(ctx, tx, dialect, userID); err != nil {
 		return err
 	}
+	if err := deleteWebhookDeliveriesTx(ctx, tx, dialect, userID); err != nil {
+		return err
+	}
+	if err := deleteUserGroupMembershipsTx(ctx, tx, dialect, userID); err != nil {
+		return err
+	}
+	if err := deleteMemoCollaboratorsTx(ctx, tx, dialect, userID, memoIDs); err != nil {
+		return err
+	}
 	if err := deleteMemoRelationsTx(ctx, tx, dialect, memoIDs); err != nil {
 		return err
 	}
@@ -555,6 +564,29 @@ func deleteUserSettingsTx(ctx context.Context, tx *sql.Tx, dialect deleteUserDia
 	return err
 }
 
+func deleteWebhookDeliveriesTx(ctx context.Context, tx *sql.Tx, dialect deleteUserDialect, userID int32) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM webhook_delivery WHERE creator_id = `+deleteUserPlaceholder(dialect, 1), userID)
+	return err
+}
+
+func deleteUserGroupMembershipsTx(ctx context.Context, tx *sql.Tx, dialect deleteUserDialect, userID int32) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM user_group_member WHERE user_id = `+deleteUserPlaceholder(dialect, 1), userID)
+	return err
+}
+
+func deleteMemoCollaboratorsTx(ctx context.Context, tx *sql.Tx, dialect deleteUserDialect, userID int32, memoIDs []int32) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM memo_collaborator WHERE user_id = `+deleteUserPlaceholder(dialect, 1), userID); err != nil {
+		return err
+	}
+	for _, batch := range deleteUserBatches(memoIDs, deleteUserBatchSize) {
+		clause, args := deleteUserInClause(dialect, 1, batch)
+		if _, err := tx.ExecContext(ctx, `DELETE FROM memo_collaborator WHERE memo_id IN `+clause, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func deleteMemoRelationsTx(ctx context.Context, tx *sql.Tx, dialect deleteUserDialect, memoIDs []int32) error {
 	for _, batch := range deleteUserBatches(memoIDs, deleteUserBatchSize) {
 		memoClause, args := deleteUserInClause(dialect, 1, batch)
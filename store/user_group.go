@@ -0,0 +1,138 @@
+package store
+
+import (
+	"context"
+	"slices"
+)
+
+// UserGroup is a named set of users that GROUP memos can be shared with.
+// The group's Name is the slug used in memo audiences.
+type UserGroup struct {
+	ID          int32
+	Name        string
+	Description string
+	CreatedTs   int64
+	UpdatedTs   int64
+}
+
+// FindUserGroup is used to filter user groups in list/get queries.
+type FindUserGroup struct {
+	ID       *int32
+	Name     *string
+	NameList []string
+	// MemberID limits the result to groups the user belongs to.
+	MemberID *int32
+}
+
+// UpdateUserGroup describes changes to a user group. The name is immutable
+// because it is referenced by memo audiences.
+type UpdateUserGroup struct {
+	ID          int32
+	UpdatedTs   *int64
+	Description *string
+}
+
+// DeleteUserGroup identifies a user group to remove.
+type DeleteUserGroup struct {
+	ID int32
+}
+
+// UserGroupMember is a user's membership in a group.
+type UserGroupMember struct {
+	GroupID   int32
+	UserID    int32
+	CreatedTs int64
+}
+
+// FindUserGroupMember is used to filter group memberships.
+type FindUserGroupMember struct {
+	GroupID *int32
+	UserID  *int32
+}
+
+// DeleteUserGroupMember identifies a membership to remove.
+type DeleteUserGroupMember struct {
+	GroupID int32
+	UserID  int32
+}
+
+// CreateUserGroup creates a new user group.
+func (s *Store) CreateUserGroup(ctx context.Context, create *UserGroup) (*UserGroup, error) {
+	return s.driver.CreateUserGroup(ctx, create)
+}
+
+// ListUserGroups returns all user groups matching the filter, ordered by name.
+func (s *Store) ListUserGroups(ctx context.Context, find *FindUserGroup) ([]*UserGroup, error) {
+	return s.driver.ListUserGroups(ctx, find)
+}
+
+// GetUserGroup returns the first user group matching the filter, or nil if none found.
+func (s *Store) GetUserGroup(ctx context.Context, find *FindUserGroup) (*UserGroup, error) {
+	list, err := s.ListUserGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// UpdateUserGroup updates a user group.
+func (s *Store) UpdateUserGroup(ctx context.Context, update *UpdateUserGroup) (*UserGroup, error) {
+	return s.driver.UpdateUserGroup(ctx, update)
+}
+
+// CreateUserGroupMember adds a user to a group.
+func (s *Store) CreateUserGroupMember(ctx context.Context, create *UserGroupMember) (*UserGroupMember, error) {
+	return s.driver.CreateUserGroupMember(ctx, create)
+}
+
+// ListUserGroupMembers returns all memberships matching the filter.
+func (s *Store) ListUserGroupMembers(ctx context.Context, find *FindUserGroupMember) ([]*UserGroupMember, error) {
+	return s.driver.ListUserGroupMembers(ctx, find)
+}
+
+// DeleteUserGroupMember removes a user from a group.
+func (s *Store) DeleteUserGroupMember(ctx context.Context, delete *DeleteUserGroupMember) error {
+	return s.driver.DeleteUserGroupMember(ctx, delete)
+}
+
+// ListUserGroupNames returns the names of the groups the user belongs to.
+func (s *Store) ListUserGroupNames(ctx context.Context, userID int32) ([]string, error) {
+	groups, err := s.ListUserGroups(ctx, &FindUserGroup{MemberID: &userID})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(groups))
+	for _, group := range groups {
+		names = append(names, group.Name)
+	}
+	return names, nil
+}
+
+// IsMemoAudienceMember reports whether the user belongs to any group in the memo's audience.
+// It is always false for memos that are not GROUP visible.
+func (s *Store) IsMemoAudienceMember(ctx context.Context, memo *Memo, userID int32) (bool, error) {
+	if memo.Visibility != Group || userID == 0 || memo.Payload == nil || len(memo.Payload.Audience) == 0 {
+		return false, nil
+	}
+	names, err := s.ListUserGroupNames(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return IsMemoAudience(memo, names), nil
+}
+
+// IsMemoAudience reports whether any of the named groups is in the audience of a GROUP memo.
+func IsMemoAudience(memo *Memo, groupNames []string) bool {
+	if memo == nil || memo.Visibility != Group || memo.Payload == nil {
+		return false
+	}
+	for _, name := range memo.Payload.Audience {
+		if slices.Contains(groupNames, name) {
+			return true
+		}
+	}
+	return false
+}
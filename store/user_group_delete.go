@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// DeleteUserGroup removes a user group and its memberships, and removes the group from the
+// audience of GROUP memos, in one transaction. Audiences reference groups by name, so a group
+// created later with the same name does not gain access to memos shared with the deleted one.
+func (s *Store) DeleteUserGroup(ctx context.Context, delete *DeleteUserGroup) error {
+	dialect, err := getDeleteUserDialect(s.profile.Driver)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.driver.GetDB().BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin delete user group transaction")
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var name string
+	if err := tx.QueryRowContext(ctx, "SELECT name FROM user_group WHERE id = "+deleteUserPlaceholder(dialect, 1), delete.ID).Scan(&name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if err := removeMemoAudienceGroupTx(ctx, tx, dialect, name); err != nil {
+		return errors.Wrap(err, "failed to remove group from memo audiences")
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_group_member WHERE group_id = "+deleteUserPlaceholder(dialect, 1), delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_group WHERE id = "+deleteUserPlaceholder(dialect, 1), delete.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// removeMemoAudienceGroupTx removes a group name from the audience of all GROUP memos.
+func removeMemoAudienceGroupTx(ctx context.Context, tx *sql.Tx, dialect deleteUserDialect, name string) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id, payload FROM memo WHERE visibility = "+deleteUserPlaceholder(dialect, 1), string(Group))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	payloads := make(map[int32]*storepb.MemoPayload)
+	for rows.Next() {
+		var id int32
+		var payloadBytes []byte
+		if err := rows.Scan(&id, &payloadBytes); err != nil {
+			return err
+		}
+		payload := &storepb.MemoPayload{}
+		if len(payloadBytes) > 0 {
+			if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+				return err
+			}
+		}
+		if slices.Contains(payload.Audience, name) {
+			payload.Audience = slices.DeleteFunc(payload.Audience, func(audience string) bool {
+				return audience == name
+			})
+			payloads[id] = payload
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	stmt := "UPDATE memo SET payload = " + deleteUserPlaceholder(dialect, 1) + " WHERE id = " + deleteUserPlaceholder(dialect, 2)
+	for id, payload := range payloads {
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, stmt, string(payloadBytes), id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
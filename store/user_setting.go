@@ -206,6 +206,20 @@ func (s *Store) RemoveUserRefreshToken(ctx context.Context, userID int32, tokenI
 	return err
 }
 
+// RemoveAllUserRefreshTokens removes every refresh token of the user, signing out all sessions.
+func (s *Store) RemoveAllUserRefreshTokens(ctx context.Context, userID int32) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_REFRESH_TOKENS,
+		Value: &storepb.UserSetting_RefreshTokens{
+			RefreshTokens: &storepb.RefreshTokensUserSetting{
+				RefreshTokens: []*storepb.RefreshTokensUserSetting_RefreshToken{},
+			},
+		},
+	})
+	return err
+}
+
 // GetUserRefreshTokenByID returns a specific refresh token.
 func (s *Store) GetUserRefreshTokenByID(ctx context.Context, userID int32, tokenID string) (*storepb.RefreshTokensUserSetting_RefreshToken, error) {
 	tokens, err := s.GetUserRefreshTokens(ctx, userID)
@@ -282,6 +296,20 @@ func (s *Store) RemoveUserPersonalAccessToken(ctx context.Context, userID int32,
 	return err
 }
 
+// RemoveAllUserPersonalAccessTokens removes every PAT of the user.
+func (s *Store) RemoveAllUserPersonalAccessTokens(ctx context.Context, userID int32) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_PERSONAL_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_PersonalAccessTokens{
+			PersonalAccessTokens: &storepb.PersonalAccessTokensUserSetting{
+				Tokens: []*storepb.PersonalAccessTokensUserSetting_PersonalAccessToken{},
+			},
+		},
+	})
+	return err
+}
+
 // UpdatePATLastUsed updates the last_used_at timestamp of a PAT.
 func (s *Store) UpdatePATLastUsed(ctx context.Context, userID int32, tokenID string, lastUsed *timestamppb.Timestamp) error {
 	tokens, err := s.GetUserPersonalAccessTokens(ctx, userID)
@@ -325,6 +353,21 @@ func (s *Store) GetUserWebhooks(ctx context.Context, userID int32) ([]*storepb.W
 	return webhooksUserSetting.Webhooks, nil
 }
 
+// GetUserNotificationSetting returns the notification delivery preferences of the user.
+func (s *Store) GetUserNotificationSetting(ctx context.Context, userID int32) (*storepb.NotificationUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_NOTIFICATION,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetNotification() == nil {
+		return &storepb.NotificationUserSetting{}, nil
+	}
+	return userSetting.GetNotification(), nil
+}
+
 // AddUserWebhook adds a new webhook for the user.
 func (s *Store) AddUserWebhook(ctx context.Context, userID int32, webhook *storepb.WebhooksUserSetting_Webhook) error {
 	existingWebhooks, err := s.GetUserWebhooks(ctx, userID)
@@ -416,6 +459,75 @@ func (s *Store) UpdateUserWebhook(ctx context.Context, userID int32, webhook *st
 	return err
 }
 
+// GetUserWebPushSubscriptions returns the Web Push subscriptions of the user's devices.
+func (s *Store) GetUserWebPushSubscriptions(ctx context.Context, userID int32) ([]*storepb.WebPushSubscriptionsUserSetting_Subscription, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_WEB_PUSH_SUBSCRIPTIONS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.WebPushSubscriptionsUserSetting_Subscription{}, nil
+	}
+	return userSetting.GetWebPushSubscriptions().GetSubscriptions(), nil
+}
+
+// AddUserWebPushSubscription adds a Web Push subscription for the user.
+// A subscription with the same endpoint is replaced.
+func (s *Store) AddUserWebPushSubscription(ctx context.Context, userID int32, subscription *storepb.WebPushSubscriptionsUserSetting_Subscription) error {
+	existingSubscriptions, err := s.GetUserWebPushSubscriptions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	updatedSubscriptions := make([]*storepb.WebPushSubscriptionsUserSetting_Subscription, 0, len(existingSubscriptions)+1)
+	for _, existing := range existingSubscriptions {
+		if existing.Endpoint != subscription.Endpoint {
+			updatedSubscriptions = append(updatedSubscriptions, existing)
+		}
+	}
+	updatedSubscriptions = append(updatedSubscriptions, subscription)
+
+	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_WEB_PUSH_SUBSCRIPTIONS,
+		Value: &storepb.UserSetting_WebPushSubscriptions{
+			WebPushSubscriptions: &storepb.WebPushSubscriptionsUserSetting{
+				Subscriptions: updatedSubscriptions,
+			},
+		},
+	})
+	return err
+}
+
+// RemoveUserWebPushSubscription removes a Web Push subscription of the user.
+func (s *Store) RemoveUserWebPushSubscription(ctx context.Context, userID int32, subscriptionID string) error {
+	oldSubscriptions, err := s.GetUserWebPushSubscriptions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	newSubscriptions := make([]*storepb.WebPushSubscriptionsUserSetting_Subscription, 0, len(oldSubscriptions))
+	for _, subscription := range oldSubscriptions {
+		if subscription.Id != subscriptionID {
+			newSubscriptions = append(newSubscriptions, subscription)
+		}
+	}
+
+	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_WEB_PUSH_SUBSCRIPTIONS,
+		Value: &storepb.UserSetting_WebPushSubscriptions{
+			WebPushSubscriptions: &storepb.WebPushSubscriptionsUserSetting{
+				Subscriptions: newSubscriptions,
+			},
+		},
+	})
+	return err
+}
+
 func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 	userSetting := &storepb.UserSetting{
 		UserId: raw.UserID,
@@ -453,6 +565,18 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_Webhooks{Webhooks: webhooksUserSetting}
+	case storepb.UserSetting_NOTIFICATION:
+		notificationUserSetting := &storepb.NotificationUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), notificationUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Notification{Notification: notificationUserSetting}
+	case storepb.UserSetting_WEB_PUSH_SUBSCRIPTIONS:
+		webPushUserSetting := &storepb.WebPushSubscriptionsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), webPushUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_WebPushSubscriptions{WebPushSubscriptions: webPushUserSetting}
 	default:
 		return nil, nil
 	}
@@ -501,6 +625,20 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSetting_NOTIFICATION:
+		notificationUserSetting := userSetting.GetNotification()
+		value, err := protojson.Marshal(notificationUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_WEB_PUSH_SUBSCRIPTIONS:
+		webPushUserSetting := userSetting.GetWebPushSubscriptions()
+		value, err := protojson.Marshal(webPushUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}
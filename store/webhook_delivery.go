@@ -0,0 +1,120 @@
+package store
+
+import (
+	"context"
+)
+
+// WebhookDeliveryStatus is the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is a delivery waiting for its next attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliverySucceeded is a delivery accepted by the endpoint.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryFailed is a delivery that ran out of attempts, or whose webhook is gone.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "FAILED"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// WebhookDelivery is a webhook event to deliver to one of a user's webhooks.
+type WebhookDelivery struct {
+	ID int32
+	// UID is the delivery ID sent to the endpoint, kept across retries and redeliveries.
+	UID          string
+	CreatorID    int32
+	WebhookID    string
+	ActivityType string
+	URL          string
+	// Payload is the JSON request body.
+	Payload string
+	// Format is the webhook format the payload was rendered in.
+	Format string
+
+	Status        WebhookDeliveryStatus
+	AttemptCount  int32
+	NextAttemptTs int64
+	LastAttemptTs *int64
+	// ResponseCode and ResponseBody record the outcome of the last attempt. The body
+	// holds a snippet of the response, or the error when there was no response.
+	ResponseCode int32
+	ResponseBody string
+
+	CreatedTs int64
+}
+
+// FindWebhookDelivery is used to filter webhook deliveries. Results are ordered newest first.
+type FindWebhookDelivery struct {
+	ID        *int32
+	UID       *string
+	CreatorID *int32
+	WebhookID *string
+	Status    *WebhookDeliveryStatus
+	// NextAttemptBefore selects the deliveries whose next attempt is at or before it.
+	NextAttemptBefore *int64
+
+	Limit  *int
+	Offset *int
+}
+
+// UpdateWebhookDelivery records the outcome of a delivery attempt.
+type UpdateWebhookDelivery struct {
+	ID            int32
+	URL           *string
+	Status        *WebhookDeliveryStatus
+	AttemptCount  *int32
+	NextAttemptTs *int64
+	LastAttemptTs *int64
+	ResponseCode  *int32
+	ResponseBody  *string
+}
+
+// DeleteWebhookDeliveries removes webhook deliveries. At least one field must be set.
+type DeleteWebhookDeliveries struct {
+	CreatorID       *int32
+	WebhookID       *string
+	CreatedTsBefore *int64
+}
+
+// CreateWebhookDelivery queues a webhook delivery.
+func (s *Store) CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error) {
+	return s.driver.CreateWebhookDelivery(ctx, create)
+}
+
+// ListWebhookDeliveries returns the webhook deliveries matching the filter, newest first.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error) {
+	return s.driver.ListWebhookDeliveries(ctx, find)
+}
+
+// GetWebhookDelivery returns the first webhook delivery matching the filter, or nil if none found.
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDelivery) (*WebhookDelivery, error) {
+	list, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// UpdateWebhookDelivery updates a webhook delivery.
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) error {
+	return s.driver.UpdateWebhookDelivery(ctx, update)
+}
+
+// ClaimWebhookDelivery claims a pending delivery whose next attempt is at nextAttemptTs,
+// by moving its next attempt to leaseTs. It returns false if the delivery is no longer
+// pending at that time, e.g. because another instance claimed it first. A claimed
+// delivery that is never updated is retried once the lease runs out.
+func (s *Store) ClaimWebhookDelivery(ctx context.Context, id int32, nextAttemptTs, leaseTs int64) (bool, error) {
+	return s.driver.ClaimWebhookDelivery(ctx, id, nextAttemptTs, leaseTs)
+}
+
+// DeleteWebhookDeliveries removes webhook deliveries and returns how many were removed.
+func (s *Store) DeleteWebhookDeliveries(ctx context.Context, delete *DeleteWebhookDeliveries) (int64, error) {
+	return s.driver.DeleteWebhookDeliveries(ctx, delete)
+}
@@ -0,0 +1,34 @@
+// Service worker showing Web Push notifications sent by the Memos server.
+self.addEventListener("push", (event) => {
+  if (!event.data) return;
+  let message;
+  try {
+    message = event.data.json();
+  } catch {
+    message = { title: "Memos", body: event.data.text() };
+  }
+  event.waitUntil(
+    self.registration.showNotification(message.title || "Memos", {
+      body: message.body,
+      icon: "/android-chrome-192x192.png",
+      badge: "/android-chrome-192x192.png",
+      data: { url: message.url || "/inbox" },
+    }),
+  );
+});
+
+self.addEventListener("notificationclick", (event) => {
+  event.notification.close();
+  const url = event.notification.data?.url || "/inbox";
+  event.waitUntil(
+    self.clients.matchAll({ type: "window", includeUncontrolled: true }).then((clients) => {
+      for (const client of clients) {
+        if ("focus" in client) {
+          client.navigate(url);
+          return client.focus();
+        }
+      }
+      return self.clients.openWindow(url);
+    }),
+  );
+});
@@ -3,13 +3,16 @@ import { FieldMaskSchema } from "@bufbuild/protobuf/wkt";
 import React, { useEffect, useState } from "react";
 import { toast } from "react-hot-toast";
 import { Button } from "@/components/ui/button";
+import { Checkbox } from "@/components/ui/checkbox";
 import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle } from "@/components/ui/dialog";
 import { Input } from "@/components/ui/input";
 import { Label } from "@/components/ui/label";
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
 import { userServiceClient } from "@/connect";
 import useCurrentUser from "@/hooks/useCurrentUser";
 import useLoading from "@/hooks/useLoading";
 import { handleError } from "@/lib/error";
+import { UserWebhook_Format } from "@/types/proto/api/v1/user_service_pb";
 import { useTranslate } from "@/utils/i18n";
 
 interface Props {
@@ -22,14 +25,38 @@ interface Props {
 interface State {
   displayName: string;
   url: string;
+  eventTypes: string[];
+  filter: string;
+  format: UserWebhook_Format;
 }
 
+// Event types a webhook can subscribe to. Subscribing to none delivers every event.
+const EVENT_TYPES = [
+  "memos.memo.created",
+  "memos.memo.updated",
+  "memos.memo.deleted",
+  "memos.memo.comment.created",
+  "memos.memo.reaction.upserted",
+  "memos.memo.reminder",
+];
+
+const FORMATS = [
+  { value: UserWebhook_Format.MEMOS, label: "Memos" },
+  { value: UserWebhook_Format.SLACK, label: "Slack" },
+  { value: UserWebhook_Format.DISCORD, label: "Discord" },
+  { value: UserWebhook_Format.TEAMS, label: "Microsoft Teams" },
+  { value: UserWebhook_Format.TELEGRAM, label: "Telegram" },
+];
+
 function CreateWebhookDialog({ open, onOpenChange, webhookName, onSuccess }: Props) {
   const t = useTranslate();
   const currentUser = useCurrentUser();
   const [state, setState] = useState<State>({
     displayName: "",
     url: "",
+    eventTypes: [],
+    filter: "",
+    format: UserWebhook_Format.MEMOS,
   });
   const requestState = useLoading(false);
   const isCreating = webhookName === undefined;
@@ -48,6 +75,9 @@ function CreateWebhookDialog({ open, onOpenChange, webhookName, onSuccess }: Pro
             setState({
               displayName: webhook.displayName,
               url: webhook.url,
+              eventTypes: webhook.eventTypes,
+              filter: webhook.filter,
+              format: webhook.format,
             });
           }
         });
@@ -73,6 +103,18 @@ function CreateWebhookDialog({ open, onOpenChange, webhookName, onSuccess }: Pro
     });
   };
 
+  const handleEventTypeCheckedChange = (eventType: string, checked: boolean) => {
+    setPartialState({
+      eventTypes: checked ? [...state.eventTypes, eventType] : state.eventTypes.filter((type) => type !== eventType),
+    });
+  };
+
+  const handleFilterInputChange = (e: React.ChangeEvent<HTMLInputElement>) => {
+    setPartialState({
+      filter: e.target.value,
+    });
+  };
+
   const handleSaveBtnClick = async () => {
     if (!state.displayName || !state.url) {
       toast.error(t("message.fill-all-required-fields"));
@@ -92,6 +134,9 @@ function CreateWebhookDialog({ open, onOpenChange, webhookName, onSuccess }: Pro
           webhook: {
             displayName: state.displayName,
             url: state.url,
+            eventTypes: state.eventTypes,
+            filter: state.filter,
+            format: state.format,
           },
         });
       } else {
@@ -100,8 +145,11 @@ function CreateWebhookDialog({ open, onOpenChange, webhookName, onSuccess }: Pro
             name: webhookName,
             displayName: state.displayName,
             url: state.url,
+            eventTypes: state.eventTypes,
+            filter: state.filter,
+            format: state.format,
           },
-          updateMask: create(FieldMaskSchema, { paths: ["display_name", "url"] }),
+          updateMask: create(FieldMaskSchema, { paths: ["display_name", "url", "event_types", "filter", "format"] }),
         });
       }
 
@@ -149,6 +197,47 @@ function CreateWebhookDialog({ open, onOpenChange, webhookName, onSuccess }: Pro
               onChange={handleUrlInputChange}
             />
           </div>
+          <div className="grid gap-2">
+            <Label>{t("setting.webhook.create-dialog.format")}</Label>
+            <Select
+              value={String(state.format)}
+              onValueChange={(value) => setPartialState({ format: Number(value) as UserWebhook_Format })}
+            >
+              <SelectTrigger className="w-full">
+                <SelectValue />
+              </SelectTrigger>
+              <SelectContent>
+                {FORMATS.map((format) => (
+                  <SelectItem key={format.value} value={String(format.value)}>
+                    {format.label}
+                  </SelectItem>
+                ))}
+              </SelectContent>
+            </Select>
+            {state.format === UserWebhook_Format.TELEGRAM && (
+              <p className="text-xs text-muted-foreground">{t("setting.webhook.create-dialog.telegram-url-description")}</p>
+            )}
+          </div>
+          <div className="grid gap-2">
+            <Label>{t("setting.webhook.create-dialog.event-types")}</Label>
+            <div className="grid gap-1.5">
+              {EVENT_TYPES.map((eventType) => (
+                <label key={eventType} className="flex items-center gap-2 text-sm">
+                  <Checkbox
+                    checked={state.eventTypes.includes(eventType)}
+                    onCheckedChange={(checked) => handleEventTypeCheckedChange(eventType, checked === true)}
+                  />
+                  <span className="font-mono">{eventType}</span>
+                </label>
+              ))}
+            </div>
+            <p className="text-xs text-muted-foreground">{t("setting.webhook.create-dialog.event-types-description")}</p>
+          </div>
+          <div className="grid gap-2">
+            <Label htmlFor="filter">{t("setting.webhook.create-dialog.filter")}</Label>
+            <Input id="filter" type="text" placeholder='tag in ["deploy"]' value={state.filter} onChange={handleFilterInputChange} />
+            <p className="text-xs text-muted-foreground">{t("setting.webhook.create-dialog.filter-description")}</p>
+          </div>
         </div>
         <DialogFooter>
           <Button variant="ghost" disabled={requestState.isLoading} onClick={() => onOpenChange(false)}>
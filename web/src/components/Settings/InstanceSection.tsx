@@ -140,6 +140,16 @@ const InstanceSection = () => {
             />
           </SettingListItem>
 
+          <SettingListItem
+            label={t("setting.instance.require-email-verification")}
+            description={t("setting.instance.require-email-verification-description")}
+          >
+            <Switch
+              checked={instanceGeneralSetting.requireEmailVerification}
+              onCheckedChange={(checked) => updatePartialSetting({ requireEmailVerification: checked })}
+            />
+          </SettingListItem>
+
           <SettingListItem label={t("setting.instance.week-start-day")} description={t("setting.instance.week-start-day-description")}>
             <Select
               value={instanceGeneralSetting.weekStartDayOffset.toString()}
@@ -0,0 +1,330 @@
+import { create } from "@bufbuild/protobuf";
+import copy from "copy-to-clipboard";
+import { MoreVerticalIcon, PlusIcon } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import ConfirmDialog from "@/components/ConfirmDialog";
+import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
+import { Checkbox } from "@/components/ui/checkbox";
+import { Dialog, DialogContent, DialogDescription, DialogFooter, DialogHeader, DialogTitle } from "@/components/ui/dialog";
+import { DropdownMenu, DropdownMenuContent, DropdownMenuItem, DropdownMenuTrigger } from "@/components/ui/dropdown-menu";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
+import { useInstance } from "@/contexts/InstanceContext";
+import {
+  InstanceSetting_Key,
+  InstanceSetting_Webhook,
+  InstanceSetting_WebhookSchema,
+  InstanceSetting_WebhooksSettingSchema,
+  InstanceSettingSchema,
+} from "@/types/proto/api/v1/instance_service_pb";
+import { UserWebhook_Format } from "@/types/proto/api/v1/user_service_pb";
+import { useTranslate } from "@/utils/i18n";
+import SettingSection from "./SettingSection";
+import SettingTable from "./SettingTable";
+import useInstanceSettingUpdater, { buildInstanceSettingName } from "./useInstanceSettingUpdater";
+
+// Event types an instance webhook can subscribe to. Subscribing to none delivers every event.
+const EVENT_TYPES = [
+  "memos.memo.created",
+  "memos.memo.updated",
+  "memos.memo.deleted",
+  "memos.memo.comment.created",
+  "memos.user.created",
+  "memos.user.deleted",
+];
+
+const FORMATS = [
+  { value: UserWebhook_Format.MEMOS, label: "Memos" },
+  { value: UserWebhook_Format.SLACK, label: "Slack" },
+  { value: UserWebhook_Format.DISCORD, label: "Discord" },
+  { value: UserWebhook_Format.TEAMS, label: "Microsoft Teams" },
+  { value: UserWebhook_Format.TELEGRAM, label: "Telegram" },
+];
+
+const newWebhook = () => create(InstanceSetting_WebhookSchema, { format: UserWebhook_Format.MEMOS });
+
+const InstanceWebhookSection = () => {
+  const t = useTranslate();
+  const saveInstanceSetting = useInstanceSettingUpdater();
+  const { webhooksSetting } = useInstance();
+  const [editingWebhook, setEditingWebhook] = useState<InstanceSetting_Webhook | undefined>();
+  const [deleteTarget, setDeleteTarget] = useState<InstanceSetting_Webhook | undefined>();
+  const [rotateTarget, setRotateTarget] = useState<InstanceSetting_Webhook | undefined>();
+
+  // The secret is output only; the server keeps the stored secret of webhooks with an ID.
+  const persistWebhooks = async (webhooks: InstanceSetting_Webhook[], errorContext: string) => {
+    return saveInstanceSetting({
+      key: InstanceSetting_Key.WEBHOOKS,
+      setting: create(InstanceSettingSchema, {
+        name: buildInstanceSettingName(InstanceSetting_Key.WEBHOOKS),
+        value: {
+          case: "webhooksSetting",
+          value: create(InstanceSetting_WebhooksSettingSchema, { webhooks }),
+        },
+      }),
+      errorContext,
+    });
+  };
+
+  const replaceWebhook = (webhook: InstanceSetting_Webhook) =>
+    webhooksSetting.webhooks.map((item) => (item.id === webhook.id ? webhook : item));
+
+  const handleSaveWebhook = async (webhook: InstanceSetting_Webhook) => {
+    const url = webhook.url.trim();
+    if (!url) {
+      toast.error(t("setting.instance-webhook.url-required"));
+      return;
+    }
+    const normalized = create(InstanceSetting_WebhookSchema, {
+      ...webhook,
+      title: webhook.title.trim(),
+      url,
+      filter: webhook.filter.trim(),
+    });
+    const nextWebhooks = normalized.id ? replaceWebhook(normalized) : [...webhooksSetting.webhooks, normalized];
+    const ok = await persistWebhooks(nextWebhooks, "Update instance webhook");
+    if (!ok) return;
+    setEditingWebhook(undefined);
+  };
+
+  const handleDeleteWebhook = async () => {
+    if (!deleteTarget) return;
+    const target = deleteTarget;
+    const ok = await persistWebhooks(
+      webhooksSetting.webhooks.filter((item) => item.id !== target.id),
+      "Delete instance webhook",
+    );
+    if (!ok) return;
+    setDeleteTarget(undefined);
+  };
+
+  const handleEnableWebhook = async (webhook: InstanceSetting_Webhook) => {
+    await persistWebhooks(
+      replaceWebhook(create(InstanceSetting_WebhookSchema, { ...webhook, disabled: false })),
+      "Enable instance webhook",
+    );
+  };
+
+  const handleCopySecret = (webhook: InstanceSetting_Webhook) => {
+    copy(webhook.secret);
+    toast.success(t("setting.webhook.secret-copied"));
+  };
+
+  const handleRotateSecret = async () => {
+    if (!rotateTarget) return;
+    const target = rotateTarget;
+    const ok = await persistWebhooks(
+      replaceWebhook(create(InstanceSetting_WebhookSchema, { ...target, rotateSecret: true })),
+      "Rotate instance webhook secret",
+    );
+    if (!ok) return;
+    setRotateTarget(undefined);
+  };
+
+  return (
+    <SettingSection
+      title={t("setting.instance-webhook.title")}
+      description={t("setting.instance-webhook.description")}
+      actions={
+        <Button onClick={() => setEditingWebhook(newWebhook())}>
+          <PlusIcon className="w-4 h-4 mr-2" />
+          {t("common.create")}
+        </Button>
+      }
+    >
+      <SettingTable
+        columns={[
+          {
+            key: "title",
+            header: t("common.name"),
+            render: (_, webhook: InstanceSetting_Webhook) => (
+              <div className="flex items-center gap-2">
+                <span className="text-foreground">{webhook.title || webhook.id}</span>
+                {webhook.disabled && (
+                  <Badge
+                    variant="destructive"
+                    className="cursor-pointer"
+                    title={t("setting.webhook.enable")}
+                    onClick={() => handleEnableWebhook(webhook)}
+                  >
+                    {t("setting.webhook.disabled")}
+                  </Badge>
+                )}
+              </div>
+            ),
+          },
+          {
+            key: "url",
+            header: t("setting.webhook.url"),
+            render: (_, webhook: InstanceSetting_Webhook) => (
+              <span className="max-w-[300px] inline-block truncate text-foreground" title={webhook.url}>
+                {webhook.url}
+              </span>
+            ),
+          },
+          {
+            key: "actions",
+            header: "",
+            className: "text-right",
+            render: (_, webhook: InstanceSetting_Webhook) => (
+              <DropdownMenu>
+                <DropdownMenuTrigger asChild>
+                  <Button variant="outline" size="sm">
+                    <MoreVerticalIcon className="w-4 h-auto" />
+                  </Button>
+                </DropdownMenuTrigger>
+                <DropdownMenuContent align="end" sideOffset={2}>
+                  <DropdownMenuItem onClick={() => setEditingWebhook(webhook)}>{t("common.edit")}</DropdownMenuItem>
+                  {webhook.secret && (
+                    <DropdownMenuItem onClick={() => handleCopySecret(webhook)}>{t("setting.webhook.copy-secret")}</DropdownMenuItem>
+                  )}
+                  <DropdownMenuItem onClick={() => setRotateTarget(webhook)}>{t("setting.webhook.rotate-secret")}</DropdownMenuItem>
+                  <DropdownMenuItem onClick={() => setDeleteTarget(webhook)} className="text-destructive focus:text-destructive">
+                    {t("common.delete")}
+                  </DropdownMenuItem>
+                </DropdownMenuContent>
+              </DropdownMenu>
+            ),
+          },
+        ]}
+        data={webhooksSetting.webhooks}
+        emptyMessage={t("setting.webhook.no-webhooks-found")}
+        getRowKey={(webhook) => webhook.id}
+      />
+
+      <InstanceWebhookDialog
+        webhook={editingWebhook}
+        onOpenChange={(open) => !open && setEditingWebhook(undefined)}
+        onSave={handleSaveWebhook}
+      />
+
+      <ConfirmDialog
+        open={!!deleteTarget}
+        onOpenChange={(open) => !open && setDeleteTarget(undefined)}
+        title={t("setting.webhook.delete-dialog.delete-webhook-title", { name: deleteTarget?.title || deleteTarget?.url || "" })}
+        description={t("setting.webhook.delete-dialog.delete-webhook-description")}
+        confirmLabel={t("common.delete")}
+        cancelLabel={t("common.cancel")}
+        onConfirm={handleDeleteWebhook}
+        confirmVariant="destructive"
+      />
+
+      <ConfirmDialog
+        open={!!rotateTarget}
+        onOpenChange={(open) => !open && setRotateTarget(undefined)}
+        title={t("setting.webhook.rotate-secret")}
+        description={t("setting.webhook.rotate-secret-description")}
+        confirmLabel={t("common.confirm")}
+        cancelLabel={t("common.cancel")}
+        onConfirm={handleRotateSecret}
+      />
+    </SettingSection>
+  );
+};
+
+interface InstanceWebhookDialogProps {
+  webhook?: InstanceSetting_Webhook;
+  onOpenChange: (open: boolean) => void;
+  onSave: (webhook: InstanceSetting_Webhook) => void;
+}
+
+const InstanceWebhookDialog = ({ webhook, onOpenChange, onSave }: InstanceWebhookDialogProps) => {
+  const t = useTranslate();
+  const [draft, setDraft] = useState<InstanceSetting_Webhook>(() => webhook ?? newWebhook());
+
+  useEffect(() => {
+    setDraft(webhook ?? newWebhook());
+  }, [webhook]);
+
+  const updateDraft = (partial: Partial<InstanceSetting_Webhook>) => {
+    setDraft((prev) => create(InstanceSetting_WebhookSchema, { ...prev, ...partial }));
+  };
+
+  const handleEventTypeCheckedChange = (eventType: string, checked: boolean) => {
+    updateDraft({
+      eventTypes: checked ? [...draft.eventTypes, eventType] : draft.eventTypes.filter((type) => type !== eventType),
+    });
+  };
+
+  return (
+    <Dialog open={!!webhook} onOpenChange={onOpenChange}>
+      <DialogContent size="2xl">
+        <DialogHeader>
+          <DialogTitle>
+            {webhook?.id ? t("setting.webhook.create-dialog.edit-webhook") : t("setting.webhook.create-dialog.create-webhook")}
+          </DialogTitle>
+          <DialogDescription>{t("setting.instance-webhook.dialog-description")}</DialogDescription>
+        </DialogHeader>
+
+        <div className="grid gap-4">
+          <div className="grid gap-2">
+            <Label>{t("setting.webhook.create-dialog.title")}</Label>
+            <Input
+              value={draft.title}
+              onChange={(e) => updateDraft({ title: e.target.value })}
+              placeholder={t("setting.webhook.create-dialog.an-easy-to-remember-name")}
+            />
+          </div>
+          <div className="grid gap-2">
+            <Label>{t("setting.webhook.create-dialog.payload-url")}</Label>
+            <Input
+              value={draft.url}
+              onChange={(e) => updateDraft({ url: e.target.value })}
+              placeholder={t("setting.webhook.create-dialog.url-example-post-receive")}
+            />
+          </div>
+          <div className="grid gap-2">
+            <Label>{t("setting.webhook.create-dialog.format")}</Label>
+            <Select value={String(draft.format)} onValueChange={(value) => updateDraft({ format: Number(value) as UserWebhook_Format })}>
+              <SelectTrigger className="w-full">
+                <SelectValue />
+              </SelectTrigger>
+              <SelectContent>
+                {FORMATS.map((format) => (
+                  <SelectItem key={format.value} value={String(format.value)}>
+                    {format.label}
+                  </SelectItem>
+                ))}
+              </SelectContent>
+            </Select>
+            {draft.format === UserWebhook_Format.TELEGRAM && (
+              <p className="text-xs text-muted-foreground">{t("setting.webhook.create-dialog.telegram-url-description")}</p>
+            )}
+          </div>
+          <div className="grid gap-2">
+            <Label>{t("setting.webhook.create-dialog.event-types")}</Label>
+            <div className="grid gap-1.5">
+              {EVENT_TYPES.map((eventType) => (
+                <label key={eventType} className="flex items-center gap-2 text-sm">
+                  <Checkbox
+                    checked={draft.eventTypes.includes(eventType)}
+                    onCheckedChange={(checked) => handleEventTypeCheckedChange(eventType, checked === true)}
+                  />
+                  <span className="font-mono">{eventType}</span>
+                </label>
+              ))}
+            </div>
+            <p className="text-xs text-muted-foreground">{t("setting.webhook.create-dialog.event-types-description")}</p>
+          </div>
+          <div className="grid gap-2">
+            <Label>{t("setting.webhook.create-dialog.filter")}</Label>
+            <Input value={draft.filter} onChange={(e) => updateDraft({ filter: e.target.value })} placeholder='tag in ["deploy"]' />
+            <p className="text-xs text-muted-foreground">{t("setting.instance-webhook.filter-description")}</p>
+          </div>
+        </div>
+
+        <DialogFooter>
+          <Button variant="ghost" onClick={() => onOpenChange(false)}>
+            {t("common.cancel")}
+          </Button>
+          <Button onClick={() => onSave(draft)}>{t("common.save")}</Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  );
+};
+
+export default InstanceWebhookSection;
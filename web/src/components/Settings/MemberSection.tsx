@@ -34,7 +34,12 @@ const MemberSection = () => {
   const [archiveTarget, setArchiveTarget] = useState<User | undefined>(undefined);
   const [deleteTarget, setDeleteTarget] = useState<User | undefined>(undefined);
 
-  const stringifyUserRole = (role: User_Role) => (role === User_Role.ADMIN ? t("setting.member.admin") : t("setting.member.user"));
+  const stringifyUserRole = (user: User) => {
+    if (user.customRole) {
+      return user.customRole.replace(/^roles\//, "");
+    }
+    return user.role === User_Role.ADMIN ? t("setting.member.admin") : t("setting.member.user");
+  };
 
   const handleCreateUser = () => {
     setEditingUser(undefined);
@@ -147,7 +152,7 @@ const MemberSection = () => {
               <div className="flex min-w-[18rem] flex-col gap-2">
                 <div className="flex flex-wrap items-center gap-2">
                   <Badge variant="secondary" className="rounded-full px-2.5 py-0.5">
-                    {stringifyUserRole(user.role)}
+                    {stringifyUserRole(user)}
                   </Badge>
                   <Badge variant={user.state === State.ARCHIVED ? "outline" : "default"} className="rounded-full px-2.5 py-0.5">
                     {user.state === State.ARCHIVED ? t("setting.member.archived") : t("setting.member.active")}
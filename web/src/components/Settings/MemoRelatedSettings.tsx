@@ -82,6 +82,13 @@ const MemoRelatedSettings = () => {
             />
           </SettingListItem>
 
+          <SettingListItem label={t("setting.memo.bump-time-on-publish")} description={t("setting.memo.bump-time-on-publish-description")}>
+            <Switch
+              checked={memoRelatedSetting.bumpTimeOnPublish}
+              onCheckedChange={(checked) => updatePartialSetting({ bumpTimeOnPublish: checked })}
+            />
+          </SettingListItem>
+
           <SettingListItem label={t("setting.memo.content-length-limit")} description={t("setting.memo.content-length-limit-description")}>
             <div className="flex items-center gap-2">
               <Input
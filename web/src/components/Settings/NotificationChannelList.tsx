@@ -0,0 +1,141 @@
+import { create } from "@bufbuild/protobuf";
+import { useState } from "react";
+import toast from "react-hot-toast";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
+import { userServiceClient } from "@/connect";
+import { useAuth } from "@/contexts/AuthContext";
+import { useUpdateUserNotificationSetting } from "@/hooks/useUserQueries";
+import { handleError } from "@/lib/error";
+import {
+  SendTestNotificationRequestSchema,
+  UserSetting_NotificationSetting_Channel,
+  UserSetting_NotificationSetting_Channel_Type,
+  UserSetting_NotificationSetting_ChannelSchema,
+} from "@/types/proto/api/v1/user_service_pb";
+import { useTranslate } from "@/utils/i18n";
+import { SettingList, SettingListItem } from "./SettingList";
+
+// Push services a notification channel can deliver to. Their names are not translated.
+const CHANNEL_TYPES = [
+  { type: UserSetting_NotificationSetting_Channel_Type.NTFY, label: "ntfy", urlPlaceholder: "https://ntfy.sh/my-topic" },
+  { type: UserSetting_NotificationSetting_Channel_Type.GOTIFY, label: "Gotify", urlPlaceholder: "https://gotify.example.com" },
+  {
+    type: UserSetting_NotificationSetting_Channel_Type.APPRISE,
+    label: "Apprise",
+    urlPlaceholder: "https://apprise.example.com/notify/memos",
+  },
+] as const;
+
+const getChannelTypeLabel = (type: UserSetting_NotificationSetting_Channel_Type) =>
+  CHANNEL_TYPES.find((item) => item.type === type)?.label ?? String(type);
+
+const NotificationChannelList = () => {
+  const t = useTranslate();
+  const { currentUser, userNotificationSetting, refetchSettings } = useAuth();
+  const { mutate: updateUserNotificationSetting, isPending } = useUpdateUserNotificationSetting(currentUser?.name);
+  const [type, setType] = useState<UserSetting_NotificationSetting_Channel_Type>(UserSetting_NotificationSetting_Channel_Type.NTFY);
+  const [url, setUrl] = useState("");
+  const [token, setToken] = useState("");
+  const [testingIndex, setTestingIndex] = useState<number | null>(null);
+  const channels = userNotificationSetting?.channels ?? [];
+
+  // Saved channels are sent back without their tokens, which the server keeps.
+  const updateChannels = (updatedChannels: UserSetting_NotificationSetting_Channel[], onSuccess?: () => void) => {
+    updateUserNotificationSetting(
+      { notificationSetting: { channels: updatedChannels }, updateMask: ["channels"] },
+      {
+        onSuccess: () => {
+          refetchSettings();
+          onSuccess?.();
+        },
+        onError: (error) => handleError(error, toast.error, { context: t("setting.preference.channels-title") }),
+      },
+    );
+  };
+
+  const handleAddChannel = () => {
+    const channel = create(UserSetting_NotificationSetting_ChannelSchema, { type, url: url.trim(), token: token.trim() });
+    updateChannels([...channels, channel], () => {
+      setUrl("");
+      setToken("");
+    });
+  };
+
+  const handleRemoveChannel = (index: number) => {
+    updateChannels(channels.filter((_, i) => i !== index));
+  };
+
+  const handleTestChannel = async (channel: UserSetting_NotificationSetting_Channel, index: number) => {
+    if (!currentUser) return;
+    setTestingIndex(index);
+    try {
+      await userServiceClient.sendTestNotification(create(SendTestNotificationRequestSchema, { name: currentUser.name, channel }));
+      toast.success(t("setting.preference.channel-test-success"));
+    } catch (error) {
+      handleError(error, toast.error, { context: t("setting.preference.channel-test") });
+    } finally {
+      setTestingIndex(null);
+    }
+  };
+
+  const urlPlaceholder = CHANNEL_TYPES.find((item) => item.type === type)?.urlPlaceholder;
+
+  return (
+    <SettingList>
+      {channels.map((channel, index) => (
+        <SettingListItem
+          key={`${channel.type}-${channel.url}`}
+          label={getChannelTypeLabel(channel.type)}
+          description={<span className="break-all">{channel.url}</span>}
+        >
+          <div className="flex items-center gap-2">
+            <Button variant="outline" size="sm" disabled={testingIndex !== null} onClick={() => handleTestChannel(channel, index)}>
+              {t("setting.preference.channel-test")}
+            </Button>
+            <Button variant="outline" size="sm" disabled={isPending} onClick={() => handleRemoveChannel(index)}>
+              {t("common.delete")}
+            </Button>
+          </div>
+        </SettingListItem>
+      ))}
+      <SettingListItem label={t("common.add")} vertical>
+        <div className="flex w-full flex-col gap-2 sm:flex-row sm:items-center">
+          <Select value={String(type)} onValueChange={(value) => setType(Number(value))}>
+            <SelectTrigger className="min-w-fit">
+              <SelectValue />
+            </SelectTrigger>
+            <SelectContent>
+              {CHANNEL_TYPES.map((item) => (
+                <SelectItem key={item.type} value={String(item.type)}>
+                  {item.label}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+          <Input
+            className="sm:flex-1"
+            value={url}
+            placeholder={urlPlaceholder}
+            aria-label={t("setting.preference.channel-url")}
+            onChange={(event) => setUrl(event.target.value)}
+          />
+          <Input
+            className="sm:w-48"
+            type="password"
+            value={token}
+            placeholder={t("setting.preference.channel-token-placeholder")}
+            aria-label={t("setting.preference.channel-token")}
+            onChange={(event) => setToken(event.target.value)}
+          />
+          <Button disabled={isPending || !url.trim()} onClick={handleAddChannel}>
+            {t("common.add")}
+          </Button>
+        </div>
+      </SettingListItem>
+    </SettingList>
+  );
+};
+
+export default NotificationChannelList;
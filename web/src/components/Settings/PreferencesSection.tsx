@@ -1,23 +1,62 @@
 import { create } from "@bufbuild/protobuf";
+import { useEffect, useState } from "react";
+import toast from "react-hot-toast";
 import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
+import { Switch } from "@/components/ui/switch";
 import { useAuth } from "@/contexts/AuthContext";
-import { useUpdateUserGeneralSetting } from "@/hooks/useUserQueries";
+import { useUpdateUserGeneralSetting, useUpdateUserNotificationSetting } from "@/hooks/useUserQueries";
+import { handleError } from "@/lib/error";
 import { Visibility } from "@/types/proto/api/v1/memo_service_pb";
-import { UserSetting_GeneralSetting, UserSetting_GeneralSettingSchema } from "@/types/proto/api/v1/user_service_pb";
+import {
+  UserNotification_Type,
+  UserSetting_GeneralSetting,
+  UserSetting_GeneralSettingSchema,
+  UserSetting_NotificationSetting_EmailDelivery,
+  UserSetting_NotificationSetting_PreferenceSchema,
+} from "@/types/proto/api/v1/user_service_pb";
 import { loadLocale, useTranslate } from "@/utils/i18n";
 import { convertVisibilityFromString, convertVisibilityToString } from "@/utils/memo";
+import { disableDevicePush, enableDevicePush, getDevicePushSubscription, isPushSupported } from "@/utils/push";
 import { loadTheme } from "@/utils/theme";
 import LocaleSelect from "../LocaleSelect";
 import ThemeSelect from "../ThemeSelect";
 import VisibilityIcon from "../VisibilityIcon";
+import NotificationChannelList from "./NotificationChannelList";
 import SettingGroup from "./SettingGroup";
 import { SettingList, SettingListItem } from "./SettingList";
 import SettingSection from "./SettingSection";
 
+// Notification types users can opt out of, with their locale keys.
+const NOTIFICATION_TYPES = [
+  { type: UserNotification_Type.MEMO_COMMENT, labelKey: "setting.preference.notification-memo-comment" },
+  { type: UserNotification_Type.MEMO_MENTION, labelKey: "setting.preference.notification-memo-mention" },
+  { type: UserNotification_Type.MEMO_THREAD_REPLY, labelKey: "setting.preference.notification-memo-thread-reply" },
+  { type: UserNotification_Type.MEMO_REACTION, labelKey: "setting.preference.notification-memo-reaction" },
+  { type: UserNotification_Type.MEMO_SHARE_OPENED, labelKey: "setting.preference.notification-memo-share-opened" },
+  { type: UserNotification_Type.MEMO_COLLABORATOR, labelKey: "setting.preference.notification-memo-collaborator" },
+  { type: UserNotification_Type.MEMO_REMINDER, labelKey: "setting.preference.notification-memo-reminder" },
+] as const;
+
+const EMAIL_DELIVERY_OPTIONS = [
+  { delivery: UserSetting_NotificationSetting_EmailDelivery.IMMEDIATE, labelKey: "setting.preference.email-delivery-immediate" },
+  { delivery: UserSetting_NotificationSetting_EmailDelivery.HOURLY_DIGEST, labelKey: "setting.preference.email-delivery-hourly-digest" },
+  { delivery: UserSetting_NotificationSetting_EmailDelivery.DAILY_DIGEST, labelKey: "setting.preference.email-delivery-daily-digest" },
+  { delivery: UserSetting_NotificationSetting_EmailDelivery.OFF, labelKey: "setting.preference.email-delivery-off" },
+] as const;
+
 const PreferencesSection = () => {
   const t = useTranslate();
-  const { currentUser, userGeneralSetting: generalSetting, refetchSettings } = useAuth();
+  const { currentUser, userGeneralSetting: generalSetting, userNotificationSetting, refetchSettings } = useAuth();
   const { mutate: updateUserGeneralSetting } = useUpdateUserGeneralSetting(currentUser?.name);
+  const { mutate: updateUserNotificationSetting } = useUpdateUserNotificationSetting(currentUser?.name);
+  const [devicePushEnabled, setDevicePushEnabled] = useState(false);
+  const [devicePushUpdating, setDevicePushUpdating] = useState(false);
+
+  useEffect(() => {
+    getDevicePushSubscription()
+      .then((subscription) => setDevicePushEnabled(subscription !== null))
+      .catch(() => setDevicePushEnabled(false));
+  }, []);
 
   const handleLocaleSelectChange = (locale: Locale) => {
     // Apply locale immediately for instant UI feedback and persist to localStorage
@@ -58,6 +97,71 @@ const PreferencesSection = () => {
     );
   };
 
+  const handleNotificationTypeToggle = (type: UserNotification_Type, enabled: boolean) => {
+    const mutedTypes = setting.mutedNotificationTypes.filter((item) => item !== type);
+    if (!enabled) {
+      mutedTypes.push(type);
+    }
+    updateUserGeneralSetting(
+      { generalSetting: { mutedNotificationTypes: mutedTypes }, updateMask: ["muted_notification_types"] },
+      {
+        onSuccess: () => {
+          refetchSettings();
+        },
+      },
+    );
+  };
+
+  // Notification types without a preference are emailed immediately.
+  const getEmailDelivery = (type: UserNotification_Type) => {
+    const preference = userNotificationSetting?.preferences.find((item) => item.type === type);
+    if (!preference || preference.emailDelivery === UserSetting_NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED) {
+      return UserSetting_NotificationSetting_EmailDelivery.IMMEDIATE;
+    }
+    return preference.emailDelivery;
+  };
+
+  const handleEmailDeliveryChange = (type: UserNotification_Type, emailDelivery: UserSetting_NotificationSetting_EmailDelivery) => {
+    const preferences = (userNotificationSetting?.preferences ?? []).filter((item) => item.type !== type);
+    preferences.push(create(UserSetting_NotificationSetting_PreferenceSchema, { type, emailDelivery }));
+    updateUserNotificationSetting(
+      { notificationSetting: { preferences }, updateMask: ["preferences"] },
+      {
+        onSuccess: () => {
+          refetchSettings();
+        },
+      },
+    );
+  };
+
+  const handleWeeklyRecapToggle = (weeklyRecap: boolean) => {
+    updateUserNotificationSetting(
+      { notificationSetting: { weeklyRecap }, updateMask: ["weekly_recap"] },
+      {
+        onSuccess: () => {
+          refetchSettings();
+        },
+      },
+    );
+  };
+
+  const handleDevicePushToggle = async (enabled: boolean) => {
+    if (!currentUser) return;
+    setDevicePushUpdating(true);
+    try {
+      if (enabled) {
+        await enableDevicePush(currentUser.name);
+      } else {
+        await disableDevicePush(currentUser.name);
+      }
+      setDevicePushEnabled(enabled);
+    } catch (error) {
+      handleError(error, toast.error, { context: t("setting.preference.push-notifications") });
+    } finally {
+      setDevicePushUpdating(false);
+    }
+  };
+
   // Provide default values if setting is not loaded yet
   const setting: UserSetting_GeneralSetting =
     generalSetting ||
@@ -111,6 +215,60 @@ const PreferencesSection = () => {
           </SettingListItem>
         </SettingList>
       </SettingGroup>
+
+      <SettingGroup
+        title={t("setting.preference.notifications-title")}
+        description={t("setting.preference.notifications-description")}
+        showSeparator
+      >
+        <SettingList>
+          {NOTIFICATION_TYPES.map(({ type, labelKey }) => {
+            const muted = setting.mutedNotificationTypes.includes(type);
+            return (
+              <SettingListItem key={type} label={t(labelKey)}>
+                <div className="flex items-center gap-3">
+                  <Select
+                    value={String(getEmailDelivery(type))}
+                    onValueChange={(value) => handleEmailDeliveryChange(type, Number(value))}
+                    disabled={muted}
+                  >
+                    <SelectTrigger className="min-w-fit">
+                      <SelectValue />
+                    </SelectTrigger>
+                    <SelectContent>
+                      {EMAIL_DELIVERY_OPTIONS.map(({ delivery, labelKey: deliveryLabelKey }) => (
+                        <SelectItem key={delivery} value={String(delivery)} className="whitespace-nowrap">
+                          {t(deliveryLabelKey)}
+                        </SelectItem>
+                      ))}
+                    </SelectContent>
+                  </Select>
+                  <Switch checked={!muted} onCheckedChange={(checked) => handleNotificationTypeToggle(type, checked)} />
+                </div>
+              </SettingListItem>
+            );
+          })}
+          <SettingListItem
+            label={t("setting.preference.push-notifications")}
+            description={t(
+              isPushSupported() ? "setting.preference.push-notifications-description" : "setting.preference.push-notifications-unsupported",
+            )}
+          >
+            <Switch
+              checked={devicePushEnabled}
+              disabled={!isPushSupported() || devicePushUpdating}
+              onCheckedChange={handleDevicePushToggle}
+            />
+          </SettingListItem>
+          <SettingListItem label={t("setting.preference.weekly-recap")} description={t("setting.preference.weekly-recap-description")}>
+            <Switch checked={userNotificationSetting?.weeklyRecap ?? false} onCheckedChange={handleWeeklyRecapToggle} />
+          </SettingListItem>
+        </SettingList>
+      </SettingGroup>
+
+      <SettingGroup title={t("setting.preference.channels-title")} description={t("setting.preference.channels-description")} showSeparator>
+        <NotificationChannelList />
+      </SettingGroup>
     </SettingSection>
   );
 };
@@ -1,14 +1,20 @@
-import { PlusIcon, TrashIcon } from "lucide-react";
+import { create } from "@bufbuild/protobuf";
+import { FieldMaskSchema } from "@bufbuild/protobuf/wkt";
+import copy from "copy-to-clipboard";
+import { HistoryIcon, KeyRoundIcon, PlusIcon, RefreshCwIcon, TrashIcon } from "lucide-react";
 import { useEffect, useState } from "react";
 import toast from "react-hot-toast";
 import ConfirmDialog from "@/components/ConfirmDialog";
+import { Badge } from "@/components/ui/badge";
 import { Button } from "@/components/ui/button";
 import { userServiceClient } from "@/connect";
 import useCurrentUser from "@/hooks/useCurrentUser";
+import { handleError } from "@/lib/error";
 import { UserWebhook } from "@/types/proto/api/v1/user_service_pb";
 import { useTranslate } from "@/utils/i18n";
 import CreateWebhookDialog from "../CreateWebhookDialog";
 import LearnMore from "../LearnMore";
+import WebhookDeliveriesDialog from "../WebhookDeliveriesDialog";
 import SettingSection from "./SettingSection";
 import SettingTable from "./SettingTable";
 
@@ -18,6 +24,8 @@ const WebhookSection = () => {
   const [webhooks, setWebhooks] = useState<UserWebhook[]>([]);
   const [isCreateWebhookDialogOpen, setIsCreateWebhookDialogOpen] = useState(false);
   const [deleteTarget, setDeleteTarget] = useState<UserWebhook | undefined>(undefined);
+  const [rotateTarget, setRotateTarget] = useState<UserWebhook | undefined>(undefined);
+  const [deliveriesTarget, setDeliveriesTarget] = useState<UserWebhook | undefined>(undefined);
 
   const fetchWebhooks = async () => {
     if (!currentUser) return [];
@@ -52,6 +60,45 @@ const WebhookSection = () => {
     toast.success(t("setting.webhook.delete-dialog.delete-webhook-success", { name }));
   };
 
+  const handleCopySecret = (webhook: UserWebhook) => {
+    copy(webhook.secret);
+    toast.success(t("setting.webhook.secret-copied"));
+  };
+
+  const confirmRotateSecret = async () => {
+    if (!rotateTarget) return;
+    try {
+      const updated = await userServiceClient.updateUserWebhook({
+        webhook: { name: rotateTarget.name },
+        updateMask: create(FieldMaskSchema, { paths: ["secret"] }),
+      });
+      setWebhooks((prev) => prev.map((item) => (item.name === updated.name ? updated : item)));
+      copy(updated.secret);
+      toast.success(t("setting.webhook.rotate-secret-success"));
+    } catch (error: unknown) {
+      await handleError(error, toast.error, {
+        context: "Rotate webhook secret",
+      });
+    } finally {
+      setRotateTarget(undefined);
+    }
+  };
+
+  const handleEnableWebhook = async (webhook: UserWebhook) => {
+    try {
+      const updated = await userServiceClient.updateUserWebhook({
+        webhook: { name: webhook.name, disabled: false },
+        updateMask: create(FieldMaskSchema, { paths: ["disabled"] }),
+      });
+      setWebhooks((prev) => prev.map((item) => (item.name === updated.name ? updated : item)));
+      toast.success(t("setting.webhook.enable-success", { name: updated.displayName }));
+    } catch (error: unknown) {
+      await handleError(error, toast.error, {
+        context: "Enable webhook",
+      });
+    }
+  };
+
   return (
     <SettingSection
       title={
@@ -72,7 +119,21 @@ const WebhookSection = () => {
           {
             key: "displayName",
             header: t("common.name"),
-            render: (_, webhook: UserWebhook) => <span className="text-foreground">{webhook.displayName}</span>,
+            render: (_, webhook: UserWebhook) => (
+              <div className="flex items-center gap-2">
+                <span className="text-foreground">{webhook.displayName}</span>
+                {webhook.disabled && (
+                  <Badge
+                    variant="destructive"
+                    className="cursor-pointer"
+                    title={t("setting.webhook.enable")}
+                    onClick={() => handleEnableWebhook(webhook)}
+                  >
+                    {t("setting.webhook.disabled")}
+                  </Badge>
+                )}
+              </div>
+            ),
           },
           {
             key: "url",
@@ -88,9 +149,32 @@ const WebhookSection = () => {
             header: "",
             className: "text-right",
             render: (_, webhook: UserWebhook) => (
-              <Button variant="ghost" size="sm" onClick={() => handleDeleteWebhook(webhook)}>
-                <TrashIcon className="text-destructive w-4 h-auto" />
-              </Button>
+              <div className="flex justify-end">
+                <Button
+                  variant="ghost"
+                  size="sm"
+                  title={t("setting.webhook.deliveries.label")}
+                  onClick={() => setDeliveriesTarget(webhook)}
+                >
+                  <HistoryIcon className="w-4 h-auto" />
+                </Button>
+                {webhook.secret && (
+                  <Button
+                    variant="ghost"
+                    size="sm"
+                    title={t("setting.webhook.copy-secret")}
+                    onClick={() => handleCopySecret(webhook)}
+                  >
+                    <KeyRoundIcon className="w-4 h-auto" />
+                  </Button>
+                )}
+                <Button variant="ghost" size="sm" title={t("setting.webhook.rotate-secret")} onClick={() => setRotateTarget(webhook)}>
+                  <RefreshCwIcon className="w-4 h-auto" />
+                </Button>
+                <Button variant="ghost" size="sm" onClick={() => handleDeleteWebhook(webhook)}>
+                  <TrashIcon className="text-destructive w-4 h-auto" />
+                </Button>
+              </div>
             ),
           },
         ]}
@@ -114,6 +198,20 @@ const WebhookSection = () => {
         onConfirm={confirmDeleteWebhook}
         confirmVariant="destructive"
       />
+      <WebhookDeliveriesDialog
+        open={!!deliveriesTarget}
+        onOpenChange={(open) => !open && setDeliveriesTarget(undefined)}
+        webhook={deliveriesTarget}
+      />
+      <ConfirmDialog
+        open={!!rotateTarget}
+        onOpenChange={(open) => !open && setRotateTarget(undefined)}
+        title={t("setting.webhook.rotate-secret")}
+        description={t("setting.webhook.rotate-secret-description")}
+        confirmLabel={t("common.confirm")}
+        cancelLabel={t("common.cancel")}
+        onConfirm={confirmRotateSecret}
+      />
     </SettingSection>
   );
 };
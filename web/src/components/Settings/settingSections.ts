@@ -16,6 +16,7 @@ import {
 import { type ComponentType } from "react";
 import AISection from "@/components/Settings/AISection";
 import InstanceSection from "@/components/Settings/InstanceSection";
+import InstanceWebhookSection from "@/components/Settings/InstanceWebhookSection";
 import MemberSection from "@/components/Settings/MemberSection";
 import MemoRelatedSettings from "@/components/Settings/MemoRelatedSettings";
 import MyAccountSection from "@/components/Settings/MyAccountSection";
@@ -37,6 +38,7 @@ export type SettingSectionKey =
   | "memo"
   | "storage"
   | "notification"
+  | "instance-webhook"
   | "sso"
   | "tags"
   | "ai"
@@ -120,6 +122,14 @@ export const SETTINGS_SECTIONS: SettingSectionDefinition[] = [
     component: NotificationSection,
     preloadSettingKeys: [InstanceSetting_Key.NOTIFICATION],
   },
+  {
+    key: "instance-webhook",
+    scope: "admin",
+    labelKey: "setting.instance-webhook.label",
+    icon: WebhookIcon,
+    component: InstanceWebhookSection,
+    preloadSettingKeys: [InstanceSetting_Key.WEBHOOKS],
+  },
   {
     key: "sso",
     scope: "admin",
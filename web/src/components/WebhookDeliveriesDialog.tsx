@@ -0,0 +1,114 @@
+import { timestampDate } from "@bufbuild/protobuf/wkt";
+import { RotateCcwIcon } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
+import { Dialog, DialogContent, DialogHeader, DialogTitle } from "@/components/ui/dialog";
+import { userServiceClient } from "@/connect";
+import { handleError } from "@/lib/error";
+import { UserWebhook, WebhookDelivery, WebhookDelivery_State } from "@/types/proto/api/v1/user_service_pb";
+import { useTranslate } from "@/utils/i18n";
+
+interface Props {
+  open: boolean;
+  onOpenChange: (open: boolean) => void;
+  webhook?: UserWebhook;
+}
+
+const stateBadgeVariant = (state: WebhookDelivery_State) => {
+  switch (state) {
+    case WebhookDelivery_State.SUCCEEDED:
+      return "default";
+    case WebhookDelivery_State.FAILED:
+      return "destructive";
+    default:
+      return "outline";
+  }
+};
+
+function WebhookDeliveriesDialog({ open, onOpenChange, webhook }: Props) {
+  const t = useTranslate();
+  const [deliveries, setDeliveries] = useState<WebhookDelivery[]>([]);
+  const [nextPageToken, setNextPageToken] = useState("");
+
+  const fetchDeliveries = async (pageToken = "") => {
+    if (!webhook) return;
+    const response = await userServiceClient.listWebhookDeliveries({ parent: webhook.name, pageToken });
+    setDeliveries((prev) => (pageToken ? [...prev, ...response.deliveries] : response.deliveries));
+    setNextPageToken(response.nextPageToken);
+  };
+
+  useEffect(() => {
+    if (open) {
+      fetchDeliveries();
+    }
+  }, [open, webhook?.name]);
+
+  const handleRedeliver = async (delivery: WebhookDelivery) => {
+    try {
+      const redelivered = await userServiceClient.redeliverWebhook({ name: delivery.name });
+      setDeliveries((prev) => prev.map((item) => (item.name === redelivered.name ? redelivered : item)));
+      toast.success(t("setting.webhook.deliveries.redeliver-success"));
+    } catch (error: unknown) {
+      await handleError(error, toast.error, {
+        context: "Redeliver webhook",
+      });
+    }
+  };
+
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent className="max-w-2xl">
+        <DialogHeader>
+          <DialogTitle>{t("setting.webhook.deliveries.title", { name: webhook?.displayName || webhook?.url || "" })}</DialogTitle>
+        </DialogHeader>
+        <div className="flex flex-col gap-2 max-h-[60vh] overflow-y-auto">
+          {deliveries.length === 0 && <p className="text-sm text-muted-foreground">{t("setting.webhook.deliveries.no-deliveries")}</p>}
+          {deliveries.map((delivery) => (
+            <div key={delivery.name} className="flex flex-col gap-1 border-b border-border pb-2">
+              <div className="flex items-center justify-between gap-2">
+                <div className="flex items-center gap-2 min-w-0">
+                  <Badge variant={stateBadgeVariant(delivery.state)}>{WebhookDelivery_State[delivery.state]}</Badge>
+                  <span className="text-sm text-foreground truncate">{delivery.activityType}</span>
+                </div>
+                <div className="flex items-center gap-2 shrink-0">
+                  <span className="text-xs text-muted-foreground">
+                    {delivery.createTime ? timestampDate(delivery.createTime).toLocaleString() : ""}
+                  </span>
+                  <Button
+                    variant="ghost"
+                    size="sm"
+                    title={t("setting.webhook.deliveries.redeliver")}
+                    disabled={webhook?.disabled}
+                    onClick={() => handleRedeliver(delivery)}
+                  >
+                    <RotateCcwIcon className="w-4 h-auto" />
+                  </Button>
+                </div>
+              </div>
+              <div className="text-xs text-muted-foreground">
+                {t("setting.webhook.deliveries.attempts", { count: delivery.attemptCount })}
+                {delivery.statusCode > 0 && ` · HTTP ${delivery.statusCode}`}
+                {delivery.nextAttemptTime &&
+                  ` · ${t("setting.webhook.deliveries.next-attempt", { time: timestampDate(delivery.nextAttemptTime).toLocaleString() })}`}
+              </div>
+              {delivery.responseSnippet && (
+                <pre className="text-xs bg-muted rounded p-2 whitespace-pre-wrap break-all max-h-24 overflow-y-auto">
+                  {delivery.responseSnippet}
+                </pre>
+              )}
+            </div>
+          ))}
+          {nextPageToken && (
+            <Button variant="outline" size="sm" onClick={() => fetchDeliveries(nextPageToken)}>
+              {t("memo.load-more")}
+            </Button>
+          )}
+        </div>
+      </DialogContent>
+    </Dialog>
+  );
+}
+
+export default WebhookDeliveriesDialog;
@@ -4,12 +4,18 @@ import { clearAccessToken, getAccessToken } from "@/auth-state";
 import { authServiceClient, refreshAccessToken, shortcutServiceClient, userServiceClient } from "@/connect";
 import { userKeys } from "@/hooks/useUserQueries";
 import type { Shortcut } from "@/types/proto/api/v1/shortcut_service_pb";
-import type { User, UserSetting_GeneralSetting, UserSetting_WebhooksSetting } from "@/types/proto/api/v1/user_service_pb";
+import type {
+  User,
+  UserSetting_GeneralSetting,
+  UserSetting_NotificationSetting,
+  UserSetting_WebhooksSetting,
+} from "@/types/proto/api/v1/user_service_pb";
 
 interface AuthState {
   currentUser: User | undefined;
   userGeneralSetting: UserSetting_GeneralSetting | undefined;
   userWebhooksSetting: UserSetting_WebhooksSetting | undefined;
+  userNotificationSetting: UserSetting_NotificationSetting | undefined;
   shortcuts: Shortcut[];
   isInitialized: boolean;
   isLoading: boolean;
@@ -30,6 +36,7 @@ export function AuthProvider({ children }: { children: ReactNode }) {
     currentUser: undefined,
     userGeneralSetting: undefined,
     userWebhooksSetting: undefined,
+    userNotificationSetting: undefined,
     shortcuts: [],
     isInitialized: false,
     isLoading: true,
@@ -43,10 +50,12 @@ export function AuthProvider({ children }: { children: ReactNode }) {
 
     const generalSetting = settings.find((s) => s.value.case === "generalSetting");
     const webhooksSetting = settings.find((s) => s.value.case === "webhooksSetting");
+    const notificationSetting = settings.find((s) => s.value.case === "notificationSetting");
 
     return {
       userGeneralSetting: generalSetting?.value.case === "generalSetting" ? generalSetting.value.value : undefined,
       userWebhooksSetting: webhooksSetting?.value.case === "webhooksSetting" ? webhooksSetting.value.value : undefined,
+      userNotificationSetting: notificationSetting?.value.case === "notificationSetting" ? notificationSetting.value.value : undefined,
       shortcuts,
     };
   }, []);
@@ -73,6 +82,7 @@ export function AuthProvider({ children }: { children: ReactNode }) {
         currentUser: undefined,
         userGeneralSetting: undefined,
         userWebhooksSetting: undefined,
+        userNotificationSetting: undefined,
         shortcuts: [],
         isInitialized: true,
         isLoading: false,
@@ -89,6 +99,7 @@ export function AuthProvider({ children }: { children: ReactNode }) {
           currentUser: undefined,
           userGeneralSetting: undefined,
           userWebhooksSetting: undefined,
+          userNotificationSetting: undefined,
           shortcuts: [],
           isInitialized: true,
           isLoading: false,
@@ -115,6 +126,7 @@ export function AuthProvider({ children }: { children: ReactNode }) {
         currentUser: undefined,
         userGeneralSetting: undefined,
         userWebhooksSetting: undefined,
+        userNotificationSetting: undefined,
         shortcuts: [],
         isInitialized: true,
         isLoading: false,
@@ -133,6 +145,7 @@ export function AuthProvider({ children }: { children: ReactNode }) {
         currentUser: undefined,
         userGeneralSetting: undefined,
         userWebhooksSetting: undefined,
+        userNotificationSetting: undefined,
         shortcuts: [],
         isInitialized: true,
         isLoading: false,
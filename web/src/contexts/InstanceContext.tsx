@@ -18,6 +18,8 @@ import {
   InstanceSetting_StorageSettingSchema,
   InstanceSetting_TagsSetting,
   InstanceSetting_TagsSettingSchema,
+  InstanceSetting_WebhooksSetting,
+  InstanceSetting_WebhooksSettingSchema,
 } from "@/types/proto/api/v1/instance_service_pb";
 
 const instanceSettingNamePrefix = "instance/settings/";
@@ -45,6 +47,7 @@ interface InstanceContextValue extends InstanceState {
   tagsSetting: InstanceSetting_TagsSetting;
   notificationSetting: InstanceSetting_NotificationSetting;
   aiSetting: InstanceSetting_AISetting;
+  webhooksSetting: InstanceSetting_WebhooksSetting;
   initialize: () => Promise<void>;
   fetchSetting: (key: InstanceSetting_Key) => Promise<void>;
   fetchSettings: (keys: InstanceSetting_Key[]) => Promise<void>;
@@ -113,6 +116,14 @@ export function InstanceProvider({ children }: { children: ReactNode }) {
     return create(InstanceSetting_AISettingSchema, {});
   }, [state.settings]);
 
+  const webhooksSetting = useMemo((): InstanceSetting_WebhooksSetting => {
+    const setting = state.settings.find((s) => s.name === `${instanceSettingNamePrefix}WEBHOOKS`);
+    if (setting?.value.case === "webhooksSetting") {
+      return setting.value.value;
+    }
+    return create(InstanceSetting_WebhooksSettingSchema, {});
+  }, [state.settings]);
+
   const initialize = useCallback(async () => {
     setState((prev) => ({ ...prev, isLoading: true }));
     try {
@@ -207,6 +218,7 @@ export function InstanceProvider({ children }: { children: ReactNode }) {
       tagsSetting,
       notificationSetting,
       aiSetting,
+      webhooksSetting,
       initialize,
       fetchSetting,
       fetchSettings,
@@ -220,6 +232,7 @@ export function InstanceProvider({ children }: { children: ReactNode }) {
       tagsSetting,
       notificationSetting,
       aiSetting,
+      webhooksSetting,
       initialize,
       fetchSetting,
       fetchSettings,
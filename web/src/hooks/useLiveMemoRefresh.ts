@@ -16,6 +16,7 @@ const SSE_EVENT_TYPES = {
   memoCreated: "memo.created",
   memoUpdated: "memo.updated",
   memoDeleted: "memo.deleted",
+  memoConflict: "memo.conflict",
   memoCommentCreated: "memo.comment.created",
   reactionUpserted: "reaction.upserted",
   reactionDeleted: "reaction.deleted",
@@ -214,6 +215,7 @@ interface SSEChangeEvent {
   type: (typeof SSE_EVENT_TYPES)[keyof typeof SSE_EVENT_TYPES];
   name: string;
   parent?: string;
+  etag?: string;
 }
 
 function handleSSEEvent(event: SSEChangeEvent, queryClient: ReturnType<typeof useQueryClient>) {
@@ -224,6 +226,7 @@ function handleSSEEvent(event: SSEChangeEvent, queryClient: ReturnType<typeof us
       break;
 
     case SSE_EVENT_TYPES.memoUpdated:
+    case SSE_EVENT_TYPES.memoConflict:
       queryClient.invalidateQueries({ queryKey: memoKeys.detail(event.name) });
       queryClient.invalidateQueries({ queryKey: memoKeys.lists() });
       if (event.parent) {
@@ -16,7 +16,7 @@ import {
 export const memoShareKeys = {
   all: ["memo-shares"] as const,
   list: (memoName: string) => [...memoShareKeys.all, "list", memoName] as const,
-  byShare: (shareId: string) => [...memoShareKeys.all, "by-share", shareId] as const,
+  byShare: (shareId: string, password?: string) => [...memoShareKeys.all, "by-share", shareId, password ?? ""] as const,
 };
 
 /** Lists all active share links for a memo (creator-only). */
@@ -62,12 +62,16 @@ export function useDeleteMemoShare() {
   });
 }
 
-/** Resolves a share token to its memo. Used by the public SharedMemo page. */
-export function useSharedMemo(shareId: string, options?: { enabled?: boolean }) {
+/**
+ * Resolves a share token to its memo. Used by the public SharedMemo page.
+ * Password-protected shares fail with PERMISSION_DENIED until the password is given.
+ */
+export function useSharedMemo(shareId: string, options?: { enabled?: boolean; password?: string }) {
+  const password = options?.password ?? "";
   return useQuery({
-    queryKey: memoShareKeys.byShare(shareId),
+    queryKey: memoShareKeys.byShare(shareId, password),
     queryFn: async () => {
-      const memo = await memoServiceClient.getMemoByShare(create(GetMemoByShareRequestSchema, { shareId }));
+      const memo = await memoServiceClient.getMemoByShare(create(GetMemoByShareRequestSchema, { shareId, password }));
       return memo;
     },
     enabled: options?.enabled ?? !!shareId,
@@ -92,10 +96,14 @@ export function getShareToken(share: MemoShare): string {
   return share.name.split("/").pop() ?? "";
 }
 
-/** Rewrites attachment URLs to include a share token for unauthenticated access. */
+/**
+ * Rewrites attachment URLs to include a share token for unauthenticated access.
+ * Password-protected shares are authorized by the cookie set when the share was opened.
+ */
 export function withShareAttachmentLinks(attachments: Attachment[], token: string): Attachment[] {
+  const query = `share_token=${encodeURIComponent(token)}`;
   return attachments.map((a) => {
     if (a.externalLink) return a;
-    return { ...a, externalLink: `${window.location.origin}/file/${a.name}/${a.filename}?share_token=${encodeURIComponent(token)}` };
+    return { ...a, externalLink: `${window.location.origin}/file/${a.name}/${a.filename}?${query}` };
   });
 }
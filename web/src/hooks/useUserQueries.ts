@@ -11,6 +11,7 @@ import {
   UserSetting,
   UserSetting_GeneralSetting,
   UserSetting_Key,
+  UserSetting_NotificationSetting,
   UserSettingSchema,
   UserStats,
 } from "@/types/proto/api/v1/user_service_pb";
@@ -247,6 +248,43 @@ export function useUpdateUserGeneralSetting(currentUserName?: string) {
   });
 }
 
+// Hook to update user notification delivery preferences (convenience wrapper)
+export function useUpdateUserNotificationSetting(currentUserName?: string) {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: async ({
+      notificationSetting,
+      updateMask,
+    }: {
+      notificationSetting: Partial<UserSetting_NotificationSetting>;
+      updateMask: string[];
+    }) => {
+      if (!currentUserName) {
+        throw new Error("No current user");
+      }
+
+      const settingName = buildUserSettingName(currentUserName, UserSetting_Key.NOTIFICATION);
+      const userSetting = create(UserSettingSchema, {
+        name: settingName,
+        value: {
+          case: "notificationSetting",
+          value: notificationSetting as UserSetting_NotificationSetting,
+        },
+      });
+
+      const updatedSetting = await userServiceClient.updateUserSetting({
+        setting: userSetting,
+        updateMask: create(FieldMaskSchema, { paths: updateMask }),
+      });
+      return updatedSetting;
+    },
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: [...userKeys.all, "settings"] });
+    },
+  });
+}
+
 // Hook to fetch multiple users by names (returns Map<name, User>)
 export function useUsersByNames(names: string[]) {
   const enabled = names.length > 0;
@@ -2,6 +2,7 @@ import { timestampDate } from "@bufbuild/protobuf/wkt";
 import { sortBy } from "lodash-es";
 import { ArchiveIcon, BellIcon, InboxIcon } from "lucide-react";
 import { useState } from "react";
+import MemoActivityMessage from "@/components/Inbox/MemoActivityMessage";
 import MemoCommentMessage from "@/components/Inbox/MemoCommentMessage";
 import MemoMentionMessage from "@/components/Inbox/MemoMentionMessage";
 import MobileHeader from "@/components/MobileHeader";
@@ -110,6 +111,13 @@ const Inboxes = () => {
                   if (notification.type === UserNotification_Type.MEMO_MENTION) {
                     return <MemoMentionMessage key={notification.name} notification={notification} />;
                   }
+                  if (
+                    notification.type === UserNotification_Type.MEMO_REACTION ||
+                    notification.type === UserNotification_Type.MEMO_THREAD_REPLY ||
+                    notification.type === UserNotification_Type.MEMO_SHARE_OPENED
+                  ) {
+                    return <MemoActivityMessage key={notification.name} notification={notification} />;
+                  }
                   return null;
                 })}
               </div>
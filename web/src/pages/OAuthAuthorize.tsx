@@ -0,0 +1,109 @@
+import { LoaderIcon } from "lucide-react";
+import { useEffect, useMemo, useState } from "react";
+import { toast } from "react-hot-toast";
+import { useSearchParams } from "react-router-dom";
+import AuthFooter from "@/components/AuthFooter";
+import { Button } from "@/components/ui/button";
+import { oauthServiceClient } from "@/connect";
+import { useInstance } from "@/contexts/InstanceContext";
+import useCurrentUser from "@/hooks/useCurrentUser";
+import useLoading from "@/hooks/useLoading";
+import { handleError } from "@/lib/error";
+import type { OAuthConsent } from "@/types/proto/api/v1/oauth_service_pb";
+import { useTranslate } from "@/utils/i18n";
+
+type ConsentState = "loading" | "ready" | "invalid";
+
+// Consent screen of the OAuth2 authorization server. Third-party apps send the
+// user here; the decision is recorded by the API, which returns where to go next.
+const OAuthAuthorize = () => {
+  const t = useTranslate();
+  const currentUser = useCurrentUser();
+  const actionBtnLoadingState = useLoading(false);
+  const { generalSetting: instanceGeneralSetting } = useInstance();
+  const [searchParams] = useSearchParams();
+  const [state, setState] = useState<ConsentState>("loading");
+  const [consent, setConsent] = useState<OAuthConsent>();
+
+  const request = useMemo(
+    () => ({
+      responseType: searchParams.get("response_type") ?? "",
+      clientId: searchParams.get("client_id") ?? "",
+      redirectUri: searchParams.get("redirect_uri") ?? "",
+      scope: searchParams.get("scope") ?? "",
+      state: searchParams.get("state") ?? "",
+      codeChallenge: searchParams.get("code_challenge") ?? "",
+      codeChallengeMethod: searchParams.get("code_challenge_method") ?? "",
+    }),
+    [searchParams],
+  );
+
+  useEffect(() => {
+    oauthServiceClient
+      .getOAuthConsent({ request })
+      .then((response) => {
+        setConsent(response);
+        setState("ready");
+      })
+      .catch(() => setState("invalid"));
+  }, [request]);
+
+  const handleDecision = async (approve: boolean) => {
+    if (actionBtnLoadingState.isLoading) {
+      return;
+    }
+
+    try {
+      actionBtnLoadingState.setLoading();
+      const response = await oauthServiceClient.authorizeOAuthClient({ request, approve });
+      window.location.replace(response.redirectUri);
+    } catch (error: unknown) {
+      handleError(error, toast.error, {
+        fallbackMessage: "Authorization failed",
+      });
+      actionBtnLoadingState.setFinish();
+    }
+  };
+
+  const clientName = consent?.client?.displayName ?? "";
+
+  return (
+    <div className="py-4 sm:py-8 w-80 max-w-full min-h-svh mx-auto flex flex-col justify-start items-center">
+      <div className="w-full py-4 grow flex flex-col justify-center items-center">
+        <div className="w-full flex flex-row justify-center items-center mb-6">
+          <img className="h-14 w-auto rounded-full shadow" src={instanceGeneralSetting.customProfile?.logoUrl || "/logo.webp"} alt="" />
+          <p className="ml-2 text-5xl text-foreground opacity-80">{instanceGeneralSetting.customProfile?.title || "Memos"}</p>
+        </div>
+        {state === "loading" && <LoaderIcon className="w-6 h-auto animate-spin opacity-60" />}
+        {state === "invalid" && <p className="w-full text-2xl text-muted-foreground">{t("oauth.invalid-request")}</p>}
+        {state === "ready" && consent && (
+          <div className="w-full flex flex-col gap-4">
+            <p className="w-full text-2xl text-muted-foreground">{t("oauth.authorize-title", { name: clientName })}</p>
+            <p className="w-full text-sm">{t("oauth.authorize-description", { name: clientName })}</p>
+            <ul className="w-full list-disc pl-5 text-sm">
+              {consent.scopes.map((scope) => (
+                <li key={scope}>{t(`oauth.scopes.${scope.replace(":", "-")}` as Parameters<typeof t>[0])}</li>
+              ))}
+            </ul>
+            <p className="w-full text-xs text-muted-foreground break-all">
+              {t("oauth.redirect-notice", { uri: consent.redirectUri })}
+            </p>
+            {currentUser && <p className="w-full text-xs text-muted-foreground">{t("oauth.signed-in-as", { username: currentUser.username })}</p>}
+            <div className="w-full flex flex-row justify-end items-center gap-2">
+              <Button variant="outline" disabled={actionBtnLoadingState.isLoading} onClick={() => handleDecision(false)}>
+                {t("oauth.deny")}
+              </Button>
+              <Button disabled={actionBtnLoadingState.isLoading} onClick={() => handleDecision(true)}>
+                {actionBtnLoadingState.isLoading && <LoaderIcon className="w-5 h-auto mr-1 animate-spin opacity-60" />}
+                {t("oauth.allow")}
+              </Button>
+            </div>
+          </div>
+        )}
+      </div>
+      <AuthFooter />
+    </div>
+  );
+};
+
+export default OAuthAuthorize;
@@ -0,0 +1,131 @@
+import { LoaderIcon } from "lucide-react";
+import { useState } from "react";
+import { toast } from "react-hot-toast";
+import { Link, useSearchParams } from "react-router-dom";
+import AuthFooter from "@/components/AuthFooter";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { authServiceClient } from "@/connect";
+import { useInstance } from "@/contexts/InstanceContext";
+import useLoading from "@/hooks/useLoading";
+import { handleError } from "@/lib/error";
+import { ROUTES } from "@/router/routes";
+import { useTranslate } from "@/utils/i18n";
+
+const ResetPassword = () => {
+  const t = useTranslate();
+  const actionBtnLoadingState = useLoading(false);
+  const { generalSetting: instanceGeneralSetting } = useInstance();
+  const [searchParams] = useSearchParams();
+  const token = searchParams.get("token") ?? "";
+  const [email, setEmail] = useState("");
+  const [password, setPassword] = useState("");
+  const [passwordAgain, setPasswordAgain] = useState("");
+  const [done, setDone] = useState(false);
+
+  const handleFormSubmit = async (e: React.FormEvent<HTMLFormElement>) => {
+    e.preventDefault();
+    if (actionBtnLoadingState.isLoading) {
+      return;
+    }
+    if (token && password !== passwordAgain) {
+      toast.error(t("message.new-password-not-match"));
+      return;
+    }
+
+    try {
+      actionBtnLoadingState.setLoading();
+      if (token) {
+        await authServiceClient.resetPassword({ token, newPassword: password });
+      } else {
+        await authServiceClient.requestPasswordReset({ email });
+      }
+      setDone(true);
+    } catch (error: unknown) {
+      handleError(error, toast.error, {
+        fallbackMessage: "Password reset failed",
+      });
+    }
+    actionBtnLoadingState.setFinish();
+  };
+
+  return (
+    <div className="py-4 sm:py-8 w-80 max-w-full min-h-svh mx-auto flex flex-col justify-start items-center">
+      <div className="w-full py-4 grow flex flex-col justify-center items-center">
+        <div className="w-full flex flex-row justify-center items-center mb-6">
+          <img className="h-14 w-auto rounded-full shadow" src={instanceGeneralSetting.customProfile?.logoUrl || "/logo.webp"} alt="" />
+          <p className="ml-2 text-5xl text-foreground opacity-80">{instanceGeneralSetting.customProfile?.title || "Memos"}</p>
+        </div>
+        <p className="w-full text-2xl mt-2 text-muted-foreground">{t("auth.reset-password")}</p>
+        {done ? (
+          <p className="w-full mt-4 text-muted-foreground">{token ? t("auth.reset-password-done") : t("auth.reset-password-sent")}</p>
+        ) : (
+          <form className="w-full mt-2" onSubmit={handleFormSubmit}>
+            <div className="flex flex-col justify-start items-start w-full gap-4">
+              {token ? (
+                <>
+                  <div className="w-full flex flex-col justify-start items-start">
+                    <span className="leading-8 text-muted-foreground">{t("auth.new-password")}</span>
+                    <Input
+                      className="w-full bg-background h-10"
+                      type="password"
+                      readOnly={actionBtnLoadingState.isLoading}
+                      placeholder={t("auth.new-password")}
+                      value={password}
+                      autoComplete="new-password"
+                      onChange={(e) => setPassword(e.target.value)}
+                      required
+                    />
+                  </div>
+                  <div className="w-full flex flex-col justify-start items-start">
+                    <span className="leading-8 text-muted-foreground">{t("auth.repeat-new-password")}</span>
+                    <Input
+                      className="w-full bg-background h-10"
+                      type="password"
+                      readOnly={actionBtnLoadingState.isLoading}
+                      placeholder={t("auth.repeat-new-password")}
+                      value={passwordAgain}
+                      autoComplete="new-password"
+                      onChange={(e) => setPasswordAgain(e.target.value)}
+                      required
+                    />
+                  </div>
+                </>
+              ) : (
+                <div className="w-full flex flex-col justify-start items-start">
+                  <span className="leading-8 text-muted-foreground">{t("common.email")}</span>
+                  <Input
+                    className="w-full bg-background h-10"
+                    type="email"
+                    readOnly={actionBtnLoadingState.isLoading}
+                    placeholder={t("common.email")}
+                    value={email}
+                    autoComplete="email"
+                    autoCapitalize="off"
+                    spellCheck={false}
+                    onChange={(e) => setEmail(e.target.value)}
+                    required
+                  />
+                </div>
+              )}
+            </div>
+            <div className="flex flex-row justify-end items-center w-full mt-6">
+              <Button type="submit" className="w-full h-10" disabled={actionBtnLoadingState.isLoading}>
+                {token ? t("auth.reset-password") : t("auth.send-reset-link")}
+                {actionBtnLoadingState.isLoading && <LoaderIcon className="w-5 h-auto ml-2 animate-spin opacity-60" />}
+              </Button>
+            </div>
+          </form>
+        )}
+        <p className="w-full mt-4 text-sm">
+          <Link to={ROUTES.AUTH} className="cursor-pointer text-primary hover:underline" viewTransition>
+            {t("common.sign-in")}
+          </Link>
+        </p>
+      </div>
+      <AuthFooter />
+    </div>
+  );
+};
+
+export default ResetPassword;
@@ -78,7 +78,14 @@ const SignIn = () => {
           <p className="ml-2 text-5xl text-foreground opacity-80">{instanceGeneralSetting.customProfile?.title || "Memos"}</p>
         </div>
         {!instanceGeneralSetting.disallowPasswordAuth ? (
-          <PasswordSignInForm redirectPath={redirectTarget} />
+          <>
+            <PasswordSignInForm redirectPath={redirectTarget} />
+            <p className="w-full mt-2 text-sm text-right">
+              <Link to={`${ROUTES.AUTH}/reset-password`} className="cursor-pointer text-muted-foreground hover:underline" viewTransition>
+                {t("auth.forgot-password")}
+              </Link>
+            </p>
+          </>
         ) : (
           identityProviderList.length === 0 && <p className="w-full text-2xl mt-2 text-muted-foreground">Password auth is not allowed.</p>
         )}
@@ -25,12 +25,15 @@ const SignUp = () => {
   const actionBtnLoadingState = useLoading(false);
   const [username, setUsername] = useState("");
   const [password, setPassword] = useState("");
+  const [email, setEmail] = useState("");
   const { initialize: initAuth } = useAuth();
   const { generalSetting: instanceGeneralSetting, profile, initialize: initInstance } = useInstance();
   const [searchParams] = useSearchParams();
   const redirectTarget = getSafeRedirectPath(searchParams.get(AUTH_REDIRECT_PARAM));
   const signInPath = searchParams.toString() ? `${ROUTES.AUTH}?${searchParams.toString()}` : ROUTES.AUTH;
-  const canUsePasswordSignUp = !instanceGeneralSetting.disallowUserRegistration && !instanceGeneralSetting.disallowPasswordAuth;
+  const invitationCode = searchParams.get("invitation") ?? "";
+  const canUsePasswordSignUp =
+    (!instanceGeneralSetting.disallowUserRegistration || invitationCode !== "") && !instanceGeneralSetting.disallowPasswordAuth;
 
   const handleUsernameInputChanged = (e: React.ChangeEvent<HTMLInputElement>) => {
     const text = e.target.value as string;
@@ -42,6 +45,11 @@ const SignUp = () => {
     setPassword(text);
   };
 
+  const handleEmailInputChanged = (e: React.ChangeEvent<HTMLInputElement>) => {
+    const text = e.target.value as string;
+    setEmail(text);
+  };
+
   const handleFormSubmit = (e: React.FormEvent<HTMLFormElement>) => {
     e.preventDefault();
     handleSignUpButtonClick();
@@ -61,9 +69,10 @@ const SignUp = () => {
       const user = create(UserSchema, {
         username,
         password,
+        email,
         role: User_Role.USER,
       });
-      await userServiceClient.createUser({ user });
+      await userServiceClient.createUser({ user, invitationCode });
       const response = await authServiceClient.signIn({
         credentials: {
           case: "passwordCredentials",
@@ -114,6 +123,22 @@ const SignUp = () => {
                     required
                   />
                 </div>
+                {invitationCode && (
+                  <div className="w-full flex flex-col justify-start items-start">
+                    <span className="leading-8 text-muted-foreground">{t("common.email")}</span>
+                    <Input
+                      className="w-full bg-background h-10"
+                      type="email"
+                      readOnly={actionBtnLoadingState.isLoading}
+                      placeholder={t("common.email")}
+                      value={email}
+                      autoComplete="email"
+                      autoCapitalize="off"
+                      spellCheck={false}
+                      onChange={handleEmailInputChanged}
+                    />
+                  </div>
+                )}
                 <div className="w-full flex flex-col justify-start items-start">
                   <span className="leading-8 text-muted-foreground">{t("common.password")}</span>
                   <Input
@@ -0,0 +1,45 @@
+import { LoaderIcon } from "lucide-react";
+import { useEffect, useState } from "react";
+import { Link, useSearchParams } from "react-router-dom";
+import AuthFooter from "@/components/AuthFooter";
+import { authServiceClient } from "@/connect";
+import { ROUTES } from "@/router/routes";
+import { useTranslate } from "@/utils/i18n";
+
+type VerifyState = "verifying" | "verified" | "failed";
+
+const VerifyEmail = () => {
+  const t = useTranslate();
+  const [searchParams] = useSearchParams();
+  const token = searchParams.get("token") ?? "";
+  const [state, setState] = useState<VerifyState>("verifying");
+
+  useEffect(() => {
+    if (!token) {
+      setState("failed");
+      return;
+    }
+    authServiceClient
+      .verifyEmail({ token })
+      .then(() => setState("verified"))
+      .catch(() => setState("failed"));
+  }, [token]);
+
+  return (
+    <div className="py-4 sm:py-8 w-80 max-w-full min-h-svh mx-auto flex flex-col justify-start items-center">
+      <div className="w-full py-4 grow flex flex-col justify-center items-center">
+        {state === "verifying" && <LoaderIcon className="w-6 h-auto animate-spin opacity-60" />}
+        {state === "verified" && <p className="w-full text-2xl text-muted-foreground">{t("auth.email-verified")}</p>}
+        {state === "failed" && <p className="w-full text-2xl text-muted-foreground">{t("auth.email-verification-failed")}</p>}
+        <p className="w-full mt-4 text-sm">
+          <Link to={ROUTES.AUTH} className="cursor-pointer text-primary hover:underline" viewTransition>
+            {t("common.sign-in")}
+          </Link>
+        </p>
+      </div>
+      <AuthFooter />
+    </div>
+  );
+};
+
+export default VerifyEmail;
@@ -33,13 +33,16 @@ const Home = lazyWithReload(() => import("@/pages/Home"));
 const Inboxes = lazyWithReload(() => import("@/pages/Inboxes"));
 const MemoDetail = lazyWithReload(() => import("@/pages/MemoDetail"));
 const NotFound = lazyWithReload(() => import("@/pages/NotFound"));
+const OAuthAuthorize = lazyWithReload(() => import("@/pages/OAuthAuthorize"));
 const PermissionDenied = lazyWithReload(() => import("@/pages/PermissionDenied"));
+const ResetPassword = lazyWithReload(() => import("@/pages/ResetPassword"));
 const Attachments = lazyWithReload(() => import("@/pages/Attachments"));
 const Setting = lazyWithReload(() => import("@/pages/Setting"));
 const Shortcuts = lazyWithReload(() => import("@/pages/Shortcuts"));
 const SignIn = lazyWithReload(() => import("@/pages/SignIn"));
 const SignUp = lazyWithReload(() => import("@/pages/SignUp"));
 const UserProfile = lazyWithReload(() => import("@/pages/UserProfile"));
+const VerifyEmail = lazyWithReload(() => import("@/pages/VerifyEmail"));
 
 // Backward compatibility alias.
 export const Routes = ROUTES;
@@ -63,16 +66,25 @@ export const routeConfig: RouteObject[] = [
           // authenticated tab elsewhere must not block it from consuming its
           // one-time OAuth state. Keep it outside the guest-only subtree.
           { path: "callback", element: <AuthCallback /> },
+          // Verification links may be opened while signed in.
+          { path: "verify-email", element: <VerifyEmail /> },
           {
             element: <RequireGuestRoute />,
             children: [
               { path: "", element: <SignIn /> },
               { path: "admin", element: <AdminSignIn /> },
               { path: "signup", element: <SignUp /> },
+              { path: "reset-password", element: <ResetPassword /> },
             ],
           },
         ],
       },
+      // Consent screen of the OAuth2 authorization server for third-party apps.
+      {
+        path: "oauth",
+        element: <RequireAuthRoute />,
+        children: [{ path: "authorize", element: <OAuthAuthorize /> }],
+      },
       // Backward compatibility: the old `/home` URL now lives at `/`.
       { path: "home", element: <Navigate to={Routes.HOME} replace /> },
       {
@@ -0,0 +1,261 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file api/v1/audit_service.proto (package memos.api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
+import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
+import { file_google_api_resource } from "../../google/api/resource_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/audit_service.proto.
+ */
+export const file_api_v1_audit_service: GenFile = /*@__PURE__*/
+  fileDesc("ChphcGkvdjEvYXVkaXRfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIuMECghBdWRpdExvZxIRCgRuYW1lGAEgASgJQgPgQQgSEgoFYWN0b3IYAiABKAlCA+BBAxIyCgZhY3Rpb24YAyABKA4yHS5tZW1vcy5hcGkudjEuQXVkaXRMb2cuQWN0aW9uQgPgQQMSFQoIcmVzb3VyY2UYBCABKAlCA+BBAxITCgZkZXRhaWwYBSABKAlCA+BBAxIXCgppcF9hZGRyZXNzGAYgASgJQgPgQQMSFwoKdXNlcl9hZ2VudBgHIAEoCUID4EEDEjQKC2NyZWF0ZV90aW1lGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDIpkCCgZBY3Rpb24SFgoSQUNUSU9OX1VOU1BFQ0lGSUVEEAASCwoHU0lHTl9JThABEgwKCFNJR05fT1VUEAISGwoXVVBEQVRFX0lOU1RBTkNFX1NFVFRJTkcQAxIPCgtERUxFVEVfVVNFUhAEEiAKHENSRUFURV9QRVJTT05BTF9BQ0NFU1NfVE9LRU4QBRIcChhDUkVBVEVfSURFTlRJVFlfUFJPVklERVIQBhIcChhVUERBVEVfSURFTlRJVFlfUFJPVklERVIQBxIcChhERUxFVEVfSURFTlRJVFlfUFJPVklERVIQCBIVChFDUkVBVEVfTUVNT19TSEFSRRAJEhsKF0NSRUFURV9DT0xMRUNUSU9OX1NIQVJFEAo6TOpBSQoVbWVtb3MuYXBpLnYxL0F1ZGl0TG9nEhVhdWRpdExvZ3Mve2F1ZGl0X2xvZ30aBG5hbWUqCWF1ZGl0TG9nczIIYXVkaXRMb2ci9wEKFExpc3RBdWRpdExvZ3NSZXF1ZXN0EhYKCXBhZ2Vfc2l6ZRgBIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAiABKAlCA+BBARISCgVhY3RvchgDIAEoCUID4EEBEjIKBmFjdGlvbhgEIAEoDjIdLm1lbW9zLmFwaS52MS5BdWRpdExvZy5BY3Rpb25CA+BBARIzCgpzdGFydF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBEjEKCGVuZF90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBIlwKFUxpc3RBdWRpdExvZ3NSZXNwb25zZRIqCgphdWRpdF9sb2dzGAEgAygLMhYubWVtb3MuYXBpLnYxLkF1ZGl0TG9nEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCTKDAQoMQXVkaXRTZXJ2aWNlEnMKDUxpc3RBdWRpdExvZ3MSIi5tZW1vcy5hcGkudjEuTGlzdEF1ZGl0TG9nc1JlcXVlc3QaIy5tZW1vcy5hcGkudjEuTGlzdEF1ZGl0TG9nc1Jlc3BvbnNlIhmC0+STAhMSES9hcGkvdjEvYXVkaXRMb2dzQqkBChBjb20ubWVtb3MuYXBpLnYxQhFBdWRpdFNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message memos.api.v1.AuditLog
+ */
+export type AuditLog = Message<"memos.api.v1.AuditLog"> & {
+  /**
+   * The resource name of the audit log entry.
+   * Format: auditLogs/{audit_log}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The user who performed the action.
+   * Format: users/{user}. Empty when the actor is unknown, e.g. a failed sign-in.
+   *
+   * @generated from field: string actor = 2;
+   */
+  actor: string;
+
+  /**
+   * The action that was performed.
+   *
+   * @generated from field: memos.api.v1.AuditLog.Action action = 3;
+   */
+  action: AuditLog_Action;
+
+  /**
+   * The resource name the action applied to, e.g. users/1 or instance/settings/GENERAL.
+   *
+   * @generated from field: string resource = 4;
+   */
+  resource: string;
+
+  /**
+   * Additional human-readable context about the action.
+   *
+   * @generated from field: string detail = 5;
+   */
+  detail: string;
+
+  /**
+   * The client IP address the request came from.
+   *
+   * @generated from field: string ip_address = 6;
+   */
+  ipAddress: string;
+
+  /**
+   * The client user agent.
+   *
+   * @generated from field: string user_agent = 7;
+   */
+  userAgent: string;
+
+  /**
+   * The time the action was performed.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 8;
+   */
+  createTime?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.AuditLog.
+ * Use `create(AuditLogSchema)` to create a new message.
+ */
+export const AuditLogSchema: GenMessage<AuditLog> = /*@__PURE__*/
+  messageDesc(file_api_v1_audit_service, 0);
+
+/**
+ * @generated from enum memos.api.v1.AuditLog.Action
+ */
+export enum AuditLog_Action {
+  /**
+   * @generated from enum value: ACTION_UNSPECIFIED = 0;
+   */
+  ACTION_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SIGN_IN = 1;
+   */
+  SIGN_IN = 1,
+
+  /**
+   * @generated from enum value: SIGN_OUT = 2;
+   */
+  SIGN_OUT = 2,
+
+  /**
+   * @generated from enum value: UPDATE_INSTANCE_SETTING = 3;
+   */
+  UPDATE_INSTANCE_SETTING = 3,
+
+  /**
+   * @generated from enum value: DELETE_USER = 4;
+   */
+  DELETE_USER = 4,
+
+  /**
+   * @generated from enum value: CREATE_PERSONAL_ACCESS_TOKEN = 5;
+   */
+  CREATE_PERSONAL_ACCESS_TOKEN = 5,
+
+  /**
+   * @generated from enum value: CREATE_IDENTITY_PROVIDER = 6;
+   */
+  CREATE_IDENTITY_PROVIDER = 6,
+
+  /**
+   * @generated from enum value: UPDATE_IDENTITY_PROVIDER = 7;
+   */
+  UPDATE_IDENTITY_PROVIDER = 7,
+
+  /**
+   * @generated from enum value: DELETE_IDENTITY_PROVIDER = 8;
+   */
+  DELETE_IDENTITY_PROVIDER = 8,
+
+  /**
+   * @generated from enum value: CREATE_MEMO_SHARE = 9;
+   */
+  CREATE_MEMO_SHARE = 9,
+
+  /**
+   * @generated from enum value: CREATE_COLLECTION_SHARE = 10;
+   */
+  CREATE_COLLECTION_SHARE = 10,
+}
+
+/**
+ * Describes the enum memos.api.v1.AuditLog.Action.
+ */
+export const AuditLog_ActionSchema: GenEnum<AuditLog_Action> = /*@__PURE__*/
+  enumDesc(file_api_v1_audit_service, 0, 0);
+
+/**
+ * @generated from message memos.api.v1.ListAuditLogsRequest
+ */
+export type ListAuditLogsRequest = Message<"memos.api.v1.ListAuditLogsRequest"> & {
+  /**
+   * Optional. The maximum number of entries to return.
+   * The service may return fewer than this value.
+   * If unspecified, at most 10 entries will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token, received from a previous `ListAuditLogs` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * Optional. Only return entries performed by this user.
+   * Format: users/{user}
+   *
+   * @generated from field: string actor = 3;
+   */
+  actor: string;
+
+  /**
+   * Optional. Only return entries of this action.
+   *
+   * @generated from field: memos.api.v1.AuditLog.Action action = 4;
+   */
+  action: AuditLog_Action;
+
+  /**
+   * Optional. Only return entries created at or after this time.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 5;
+   */
+  startTime?: Timestamp | undefined;
+
+  /**
+   * Optional. Only return entries created before this time.
+   *
+   * @generated from field: google.protobuf.Timestamp end_time = 6;
+   */
+  endTime?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.ListAuditLogsRequest.
+ * Use `create(ListAuditLogsRequestSchema)` to create a new message.
+ */
+export const ListAuditLogsRequestSchema: GenMessage<ListAuditLogsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_audit_service, 1);
+
+/**
+ * @generated from message memos.api.v1.ListAuditLogsResponse
+ */
+export type ListAuditLogsResponse = Message<"memos.api.v1.ListAuditLogsResponse"> & {
+  /**
+   * The list of audit log entries.
+   *
+   * @generated from field: repeated memos.api.v1.AuditLog audit_logs = 1;
+   */
+  auditLogs: AuditLog[];
+
+  /**
+   * A token that can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListAuditLogsResponse.
+ * Use `create(ListAuditLogsResponseSchema)` to create a new message.
+ */
+export const ListAuditLogsResponseSchema: GenMessage<ListAuditLogsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_audit_service, 2);
+
+/**
+ * @generated from service memos.api.v1.AuditService
+ */
+export const AuditService: GenService<{
+  /**
+   * ListAuditLogs lists audit log entries, newest first.
+   * Matching entries can also be downloaded as JSON Lines from
+   * GET /api/v1/auditLogs/export, which accepts the same filters as query parameters.
+   * Only admins can list audit logs.
+   *
+   * @generated from rpc memos.api.v1.AuditService.ListAuditLogs
+   */
+  listAuditLogs: {
+    methodKind: "unary";
+    input: typeof ListAuditLogsRequestSchema;
+    output: typeof ListAuditLogsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_audit_service, 0);
+
@@ -0,0 +1,221 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file api/v1/invitation_service.proto (package memos.api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { User_Role } from "./user_service_pb";
+import { file_api_v1_user_service } from "./user_service_pb";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
+import { file_google_api_client } from "../../google/api/client_pb";
+import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
+import { file_google_api_resource } from "../../google/api/resource_pb";
+import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/invitation_service.proto.
+ */
+export const file_api_v1_invitation_service: GenFile = /*@__PURE__*/
+  fileDesc("Ch9hcGkvdjEvaW52aXRhdGlvbl9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEiiQMKCkludml0YXRpb24SEQoEbmFtZRgBIAEoCUID4EEIEhEKBGNvZGUYAiABKAlCA+BBAxIUCgdjcmVhdG9yGAMgASgJQgPgQQMSKgoEcm9sZRgEIAEoDjIXLm1lbW9zLmFwaS52MS5Vc2VyLlJvbGVCA+BBARISCgVlbWFpbBgFIAEoCUID4EEBEhUKCG1heF91c2VzGAYgASgFQgPgQQESFgoJdXNlX2NvdW50GAcgASgFQgPgQQMSNAoLY3JlYXRlX3RpbWUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSOQoLZXhwaXJlX3RpbWUYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQFIAIgBATpP6kFMChdtZW1vcy5hcGkudjEvSW52aXRhdGlvbhIYaW52aXRhdGlvbnMve2ludml0YXRpb259KgtpbnZpdGF0aW9uczIKaW52aXRhdGlvbkIOCgxfZXhwaXJlX3RpbWUiGAoWTGlzdEludml0YXRpb25zUmVxdWVzdCJIChdMaXN0SW52aXRhdGlvbnNSZXNwb25zZRItCgtpbnZpdGF0aW9ucxgBIAMoCzIYLm1lbW9zLmFwaS52MS5JbnZpdGF0aW9uImUKF0NyZWF0ZUludml0YXRpb25SZXF1ZXN0EjEKCmludml0YXRpb24YASABKAsyGC5tZW1vcy5hcGkudjEuSW52aXRhdGlvbkID4EECEhcKCnNlbmRfZW1haWwYAiABKAhCA+BBASJIChdSZXZva2VJbnZpdGF0aW9uUmVxdWVzdBItCgRuYW1lGAEgASgJQh/gQQL6QRkKF21lbW9zLmFwaS52MS9JbnZpdGF0aW9uMpwDChFJbnZpdGF0aW9uU2VydmljZRJ7Cg9MaXN0SW52aXRhdGlvbnMSJC5tZW1vcy5hcGkudjEuTGlzdEludml0YXRpb25zUmVxdWVzdBolLm1lbW9zLmFwaS52MS5MaXN0SW52aXRhdGlvbnNSZXNwb25zZSIbgtPkkwIVEhMvYXBpL3YxL2ludml0YXRpb25zEokBChBDcmVhdGVJbnZpdGF0aW9uEiUubWVtb3MuYXBpLnYxLkNyZWF0ZUludml0YXRpb25SZXF1ZXN0GhgubWVtb3MuYXBpLnYxLkludml0YXRpb24iNNpBCmludml0YXRpb26C0+STAiE6Cmludml0YXRpb24iEy9hcGkvdjEvaW52aXRhdGlvbnMSfgoQUmV2b2tlSW52aXRhdGlvbhIlLm1lbW9zLmFwaS52MS5SZXZva2VJbnZpdGF0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIr2kEEbmFtZYLT5JMCHiocL2FwaS92MS97bmFtZT1pbnZpdGF0aW9ucy8qfUKuAQoQY29tLm1lbW9zLmFwaS52MUIWSW52aXRhdGlvblNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_api_v1_user_service, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message memos.api.v1.Invitation
+ */
+export type Invitation = Message<"memos.api.v1.Invitation"> & {
+  /**
+   * The resource name of the invitation.
+   * Format: invitations/{invitation}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The code to pass as invitation_code when creating a user.
+   *
+   * @generated from field: string code = 2;
+   */
+  code: string;
+
+  /**
+   * The resource name of the user who created the invitation.
+   * Format: users/{user}
+   *
+   * @generated from field: string creator = 3;
+   */
+  creator: string;
+
+  /**
+   * The role assigned to users who register with the invitation.
+   * Defaults to USER.
+   *
+   * @generated from field: memos.api.v1.User.Role role = 4;
+   */
+  role: User_Role;
+
+  /**
+   * If set, only a user registering with this email can use the invitation.
+   *
+   * @generated from field: string email = 5;
+   */
+  email: string;
+
+  /**
+   * The number of accounts that can be registered with the invitation.
+   * Defaults to 1.
+   *
+   * @generated from field: int32 max_uses = 6;
+   */
+  maxUses: number;
+
+  /**
+   * The number of accounts registered with the invitation so far.
+   *
+   * @generated from field: int32 use_count = 7;
+   */
+  useCount: number;
+
+  /**
+   * The creation timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 8;
+   */
+  createTime?: Timestamp | undefined;
+
+  /**
+   * The expiration timestamp. The invitation never expires if not set.
+   *
+   * @generated from field: optional google.protobuf.Timestamp expire_time = 9;
+   */
+  expireTime?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.Invitation.
+ * Use `create(InvitationSchema)` to create a new message.
+ */
+export const InvitationSchema: GenMessage<Invitation> = /*@__PURE__*/
+  messageDesc(file_api_v1_invitation_service, 0);
+
+/**
+ * @generated from message memos.api.v1.ListInvitationsRequest
+ */
+export type ListInvitationsRequest = Message<"memos.api.v1.ListInvitationsRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.ListInvitationsRequest.
+ * Use `create(ListInvitationsRequestSchema)` to create a new message.
+ */
+export const ListInvitationsRequestSchema: GenMessage<ListInvitationsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_invitation_service, 1);
+
+/**
+ * @generated from message memos.api.v1.ListInvitationsResponse
+ */
+export type ListInvitationsResponse = Message<"memos.api.v1.ListInvitationsResponse"> & {
+  /**
+   * The list of invitations.
+   *
+   * @generated from field: repeated memos.api.v1.Invitation invitations = 1;
+   */
+  invitations: Invitation[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListInvitationsResponse.
+ * Use `create(ListInvitationsResponseSchema)` to create a new message.
+ */
+export const ListInvitationsResponseSchema: GenMessage<ListInvitationsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_invitation_service, 2);
+
+/**
+ * @generated from message memos.api.v1.CreateInvitationRequest
+ */
+export type CreateInvitationRequest = Message<"memos.api.v1.CreateInvitationRequest"> & {
+  /**
+   * Required. The invitation to create.
+   *
+   * @generated from field: memos.api.v1.Invitation invitation = 1;
+   */
+  invitation?: Invitation | undefined;
+
+  /**
+   * Optional. If set, the invitation link is sent to the invitation email.
+   * Requires email delivery to be configured and the invitation email to be set.
+   *
+   * @generated from field: bool send_email = 2;
+   */
+  sendEmail: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateInvitationRequest.
+ * Use `create(CreateInvitationRequestSchema)` to create a new message.
+ */
+export const CreateInvitationRequestSchema: GenMessage<CreateInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_invitation_service, 3);
+
+/**
+ * @generated from message memos.api.v1.RevokeInvitationRequest
+ */
+export type RevokeInvitationRequest = Message<"memos.api.v1.RevokeInvitationRequest"> & {
+  /**
+   * Required. The resource name of the invitation to revoke.
+   * Format: invitations/{invitation}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RevokeInvitationRequest.
+ * Use `create(RevokeInvitationRequestSchema)` to create a new message.
+ */
+export const RevokeInvitationRequestSchema: GenMessage<RevokeInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_invitation_service, 4);
+
+/**
+ * @generated from service memos.api.v1.InvitationService
+ */
+export const InvitationService: GenService<{
+  /**
+   * ListInvitations returns all invitations of the instance.
+   * Requires the MANAGE_USERS permission.
+   *
+   * @generated from rpc memos.api.v1.InvitationService.ListInvitations
+   */
+  listInvitations: {
+    methodKind: "unary";
+    input: typeof ListInvitationsRequestSchema;
+    output: typeof ListInvitationsResponseSchema;
+  },
+  /**
+   * CreateInvitation creates an invitation that allows registering an account
+   * while user registration is disabled.
+   * Requires the MANAGE_USERS permission; only admins can invite admins.
+   *
+   * @generated from rpc memos.api.v1.InvitationService.CreateInvitation
+   */
+  createInvitation: {
+    methodKind: "unary";
+    input: typeof CreateInvitationRequestSchema;
+    output: typeof InvitationSchema;
+  },
+  /**
+   * RevokeInvitation deletes an invitation so it can no longer be used.
+   * Requires the MANAGE_USERS permission.
+   *
+   * @generated from rpc memos.api.v1.InvitationService.RevokeInvitation
+   */
+  revokeInvitation: {
+    methodKind: "unary";
+    input: typeof RevokeInvitationRequestSchema;
+    output: typeof EmptySchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_invitation_service, 0);
+
@@ -20,7 +20,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/memo_service.proto.
  */
 export const file_api_v1_memo_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvbWVtb19zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEipwIKCFJlYWN0aW9uEhQKBG5hbWUYASABKAlCBuBBA+BBCBIqCgdjcmVhdG9yGAIgASgJQhngQQP6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEi0KCmNvbnRlbnRfaWQYAyABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SGgoNcmVhY3Rpb25fdHlwZRgEIAEoCUID4EECEjQKC2NyZWF0ZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOljqQVUKFW1lbW9zLmFwaS52MS9SZWFjdGlvbhIhbWVtb3Mve21lbW99L3JlYWN0aW9ucy97cmVhY3Rpb259GgRuYW1lKglyZWFjdGlvbnMyCHJlYWN0aW9uIuoGCgRNZW1vEhEKBG5hbWUYASABKAlCA+BBCBInCgVzdGF0ZRgCIAEoDjITLm1lbW9zLmFwaS52MS5TdGF0ZUID4EECEioKB2NyZWF0b3IYAyABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL1VzZXISNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESNAoLdXBkYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESFAoHY29udGVudBgHIAEoCUID4EECEjEKCnZpc2liaWxpdHkYCSABKA4yGC5tZW1vcy5hcGkudjEuVmlzaWJpbGl0eUID4EECEhEKBHRhZ3MYCiADKAlCA+BBAxITCgZwaW5uZWQYCyABKAhCA+BBARIyCgthdHRhY2htZW50cxgMIAMoCzIYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50QgPgQQESMgoJcmVsYXRpb25zGA0gAygLMhoubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbkID4EEBEi4KCXJlYWN0aW9ucxgOIAMoCzIWLm1lbW9zLmFwaS52MS5SZWFjdGlvbkID4EEDEjIKCHByb3BlcnR5GA8gASgLMhsubWVtb3MuYXBpLnYxLk1lbW8uUHJvcGVydHlCA+BBAxIuCgZwYXJlbnQYECABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL01lbW9IAIgBARIUCgdzbmlwcGV0GBEgASgJQgPgQQMSMgoIbG9jYXRpb24YEiABKAsyFi5tZW1vcy5hcGkudjEuTG9jYXRpb25CA+BBAUgBiAEBGnIKCFByb3BlcnR5EhAKCGhhc19saW5rGAEgASgIEhUKDWhhc190YXNrX2xpc3QYAiABKAgSEAoIaGFzX2NvZGUYAyABKAgSHAoUaGFzX2luY29tcGxldGVfdGFza3MYBCABKAgSDQoFdGl0bGUYBSABKAk6N+pBNAoRbWVtb3MuYXBpLnYxL01lbW8SDG1lbW9zL3ttZW1vfRoEbmFtZSoFbWVtb3MyBG1lbW9CCQoHX3BhcmVudEILCglfbG9jYXRpb25KBAgGEAdSDGRpc3BsYXlfdGltZSJTCghMb2NhdGlvbhIYCgtwbGFjZWhvbGRlchgBIAEoCUID4EEBEhUKCGxhdGl0dWRlGAIgASgBQgPgQQESFgoJbG9uZ2l0dWRlGAMgASgBQgPgQQEiUAoRQ3JlYXRlTWVtb1JlcXVlc3QSJQoEbWVtbxgBIAEoCzISLm1lbW9zLmFwaS52MS5NZW1vQgPgQQISFAoHbWVtb19pZBgCIAEoCUID4EEBIrMBChBMaXN0TWVtb3NSZXF1ZXN0EhYKCXBhZ2Vfc2l6ZRgBIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAiABKAlCA+BBARInCgVzdGF0ZRgDIAEoDjITLm1lbW9zLmFwaS52MS5TdGF0ZUID4EEBEhUKCG9yZGVyX2J5GAQgASgJQgPgQQESEwoGZmlsdGVyGAUgASgJQgPgQQESGQoMc2hvd19kZWxldGVkGAYgASgIQgPgQQEiTwoRTGlzdE1lbW9zUmVzcG9uc2USIQoFbWVtb3MYASADKAsyEi5tZW1vcy5hcGkudjEuTWVtbxIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiOQoOR2V0TWVtb1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbyJwChFVcGRhdGVNZW1vUmVxdWVzdBIlCgRtZW1vGAEgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJQChFEZWxldGVNZW1vUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhIKBWZvcmNlGAIgASgIQgPgQQEieAoZU2V0TWVtb0F0dGFjaG1lbnRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEjIKC2F0dGFjaG1lbnRzGAIgAygLMhgubWVtb3MuYXBpLnYxLkF0dGFjaG1lbnRCA+BBAiJ2ChpMaXN0TWVtb0F0dGFjaG1lbnRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJlChtMaXN0TWVtb0F0dGFjaG1lbnRzUmVzcG9uc2USLQoLYXR0YWNobWVudHMYASADKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiswIKDE1lbW9SZWxhdGlvbhIyCgRtZW1vGAEgASgLMh8ubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbi5NZW1vQgPgQQISOgoMcmVsYXRlZF9tZW1vGAIgASgLMh8ubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbi5NZW1vQgPgQQISMgoEdHlwZRgDIAEoDjIfLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24uVHlwZUID4EECGkUKBE1lbW8SJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIUCgdzbmlwcGV0GAIgASgJQgPgQQMiOAoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASDQoJUkVGRVJFTkNFEAESCwoHQ09NTUVOVBACInYKF1NldE1lbW9SZWxhdGlvbnNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SMgoJcmVsYXRpb25zGAIgAygLMhoubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbkID4EECInQKGExpc3RNZW1vUmVsYXRpb25zUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJjChlMaXN0TWVtb1JlbGF0aW9uc1Jlc3BvbnNlEi0KCXJlbGF0aW9ucxgBIAMoCzIaLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIoYBChhDcmVhdGVNZW1vQ29tbWVudFJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIoCgdjb21tZW50GAIgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAhIXCgpjb21tZW50X2lkGAMgASgJQgPgQQEiigEKF0xpc3RNZW1vQ29tbWVudHNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBEhUKCG9yZGVyX2J5GAQgASgJQgPgQQEiagoYTGlzdE1lbW9Db21tZW50c1Jlc3BvbnNlEiEKBW1lbW9zGAEgAygLMhIubWVtb3MuYXBpLnYxLk1lbW8SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUidAoYTGlzdE1lbW9SZWFjdGlvbnNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBInMKGUxpc3RNZW1vUmVhY3Rpb25zUmVzcG9uc2USKQoJcmVhY3Rpb25zGAEgAygLMhYubWVtb3MuYXBpLnYxLlJlYWN0aW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFInMKGVVwc2VydE1lbW9SZWFjdGlvblJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxItCghyZWFjdGlvbhgCIAEoCzIWLm1lbW9zLmFwaS52MS5SZWFjdGlvbkID4EECIkgKGURlbGV0ZU1lbW9SZWFjdGlvblJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVtZW1vcy5hcGkudjEvUmVhY3Rpb24i6AEKCU1lbW9TaGFyZRIRCgRuYW1lGAEgASgJQgPgQQgSNAoLY3JlYXRlX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSOQoLZXhwaXJlX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQFIAIgBATpH6kFEChZtZW1vcy5hcGkudjEvTWVtb1NoYXJlEhttZW1vcy97bWVtb30vc2hhcmVzL3tzaGFyZX0qBnNoYXJlczIFc2hhcmVCDgoMX2V4cGlyZV90aW1lInUKFkNyZWF0ZU1lbW9TaGFyZVJlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEjAKCm1lbW9fc2hhcmUYAiABKAsyFy5tZW1vcy5hcGkudjEuTWVtb1NoYXJlQgPgQQIiQgoVTGlzdE1lbW9TaGFyZXNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbyJGChZMaXN0TWVtb1NoYXJlc1Jlc3BvbnNlEiwKC21lbW9fc2hhcmVzGAEgAygLMhcubWVtb3MuYXBpLnYxLk1lbW9TaGFyZSJGChZEZWxldGVNZW1vU2hhcmVSZXF1ZXN0EiwKBG5hbWUYASABKAlCHuBBAvpBGAoWbWVtb3MuYXBpLnYxL01lbW9TaGFyZSIuChVHZXRNZW1vQnlTaGFyZVJlcXVlc3QSFQoIc2hhcmVfaWQYASABKAlCA+BBAiIqChZHZXRMaW5rTWV0YWRhdGFSZXF1ZXN0EhAKA3VybBgBIAEoCUID4EECIjAKG0JhdGNoR2V0TGlua01ldGFkYXRhUmVxdWVzdBIRCgR1cmxzGAEgAygJQgPgQQIiUQocQmF0Y2hHZXRMaW5rTWV0YWRhdGFSZXNwb25zZRIxCg1saW5rX21ldGFkYXRhGAEgAygLMhoubWVtb3MuYXBpLnYxLkxpbmtNZXRhZGF0YSJOCgxMaW5rTWV0YWRhdGESCwoDdXJsGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg0KBWltYWdlGAQgASgJKlAKClZpc2liaWxpdHkSGgoWVklTSUJJTElUWV9VTlNQRUNJRklFRBAAEgsKB1BSSVZBVEUQARINCglQUk9URUNURUQQAhIKCgZQVUJMSUMQAzKLFQoLTWVtb1NlcnZpY2USZQoKQ3JlYXRlTWVtbxIfLm1lbW9zLmFwaS52MS5DcmVhdGVNZW1vUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIiLaQQRtZW1vgtPkkwIVOgRtZW1vIg0vYXBpL3YxL21lbW9zEmYKCUxpc3RNZW1vcxIeLm1lbW9zLmFwaS52MS5MaXN0TWVtb3NSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLkxpc3RNZW1vc1Jlc3BvbnNlIhjaQQCC0+STAg8SDS9hcGkvdjEvbWVtb3MSYgoHR2V0TWVtbxIcLm1lbW9zLmFwaS52MS5HZXRNZW1vUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIiXaQQRuYW1lgtPkkwIYEhYvYXBpL3YxL3tuYW1lPW1lbW9zLyp9En8KClVwZGF0ZU1lbW8SHy5tZW1vcy5hcGkudjEuVXBkYXRlTWVtb1JlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyI82kEQbWVtbyx1cGRhdGVfbWFza4LT5JMCIzoEbWVtbzIbL2FwaS92MS97bWVtby5uYW1lPW1lbW9zLyp9EmwKCkRlbGV0ZU1lbW8SHy5tZW1vcy5hcGkudjEuRGVsZXRlTWVtb1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiJdpBBG5hbWWC0+STAhgqFi9hcGkvdjEve25hbWU9bWVtb3MvKn0SiwEKElNldE1lbW9BdHRhY2htZW50cxInLm1lbW9zLmFwaS52MS5TZXRNZW1vQXR0YWNobWVudHNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjTaQQRuYW1lgtPkkwInOgEqMiIvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2F0dGFjaG1lbnRzEp0BChNMaXN0TWVtb0F0dGFjaG1lbnRzEigubWVtb3MuYXBpLnYxLkxpc3RNZW1vQXR0YWNobWVudHNSZXF1ZXN0GikubWVtb3MuYXBpLnYxLkxpc3RNZW1vQXR0YWNobWVudHNSZXNwb25zZSIx2kEEbmFtZYLT5JMCJBIiL2FwaS92MS97bmFtZT1tZW1vcy8qfS9hdHRhY2htZW50cxKFAQoQU2V0TWVtb1JlbGF0aW9ucxIlLm1lbW9zLmFwaS52MS5TZXRNZW1vUmVsYXRpb25zUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIy2kEEbmFtZYLT5JMCJToBKjIgL2FwaS92MS97bmFtZT1tZW1vcy8qfS9yZWxhdGlvbnMSlQEKEUxpc3RNZW1vUmVsYXRpb25zEiYubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVsYXRpb25zUmVxdWVzdBonLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JlbGF0aW9uc1Jlc3BvbnNlIi/aQQRuYW1lgtPkkwIiEiAvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L3JlbGF0aW9ucxKQAQoRQ3JlYXRlTWVtb0NvbW1lbnQSJi5tZW1vcy5hcGkudjEuQ3JlYXRlTWVtb0NvbW1lbnRSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iP9pBDG5hbWUsY29tbWVudILT5JMCKjoHY29tbWVudCIfL2FwaS92MS97bmFtZT1tZW1vcy8qfS9jb21tZW50cxKRAQoQTGlzdE1lbW9Db21tZW50cxIlLm1lbW9zLmFwaS52MS5MaXN0TWVtb0NvbW1lbnRzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0TWVtb0NvbW1lbnRzUmVzcG9uc2UiLtpBBG5hbWWC0+STAiESHy9hcGkvdjEve25hbWU9bWVtb3MvKn0vY29tbWVudHMSlQEKEUxpc3RNZW1vUmVhY3Rpb25zEiYubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVhY3Rpb25zUmVxdWVzdBonLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JlYWN0aW9uc1Jlc3BvbnNlIi/aQQRuYW1lgtPkkwIiEiAvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L3JlYWN0aW9ucxKJAQoSVXBzZXJ0TWVtb1JlYWN0aW9uEicubWVtb3MuYXBpLnYxLlVwc2VydE1lbW9SZWFjdGlvblJlcXVlc3QaFi5tZW1vcy5hcGkudjEuUmVhY3Rpb24iMtpBBG5hbWWC0+STAiU6ASoiIC9hcGkvdjEve25hbWU9bWVtb3MvKn0vcmVhY3Rpb25zEogBChJEZWxldGVNZW1vUmVhY3Rpb24SJy5tZW1vcy5hcGkudjEuRGVsZXRlTWVtb1JlYWN0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIx2kEEbmFtZYLT5JMCJCoiL2FwaS92MS97bmFtZT1tZW1vcy8qL3JlYWN0aW9ucy8qfRKZAQoPQ3JlYXRlTWVtb1NoYXJlEiQubWVtb3MuYXBpLnYxLkNyZWF0ZU1lbW9TaGFyZVJlcXVlc3QaFy5tZW1vcy5hcGkudjEuTWVtb1NoYXJlIkfaQRFwYXJlbnQsbWVtb19zaGFyZYLT5JMCLToKbWVtb19zaGFyZSIfL2FwaS92MS97cGFyZW50PW1lbW9zLyp9L3NoYXJlcxKNAQoOTGlzdE1lbW9TaGFyZXMSIy5tZW1vcy5hcGkudjEuTGlzdE1lbW9TaGFyZXNSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkxpc3RNZW1vU2hhcmVzUmVzcG9uc2UiMNpBBnBhcmVudILT5JMCIRIfL2FwaS92MS97cGFyZW50PW1lbW9zLyp9L3NoYXJlcxJ/Cg9EZWxldGVNZW1vU2hhcmUSJC5tZW1vcy5hcGkudjEuRGVsZXRlTWVtb1NoYXJlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIu2kEEbmFtZYLT5JMCISofL2FwaS92MS97bmFtZT1tZW1vcy8qL3NoYXJlcy8qfRJsCg5HZXRNZW1vQnlTaGFyZRIjLm1lbW9zLmFwaS52MS5HZXRNZW1vQnlTaGFyZVJlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyIhgtPkkwIbEhkvYXBpL3YxL3NoYXJlcy97c2hhcmVfaWR9EnkKD0dldExpbmtNZXRhZGF0YRIkLm1lbW9zLmFwaS52MS5HZXRMaW5rTWV0YWRhdGFSZXF1ZXN0GhoubWVtb3MuYXBpLnYxLkxpbmtNZXRhZGF0YSIkgtPkkwIeEhwvYXBpL3YxL21lbW9zLy0vbGlua01ldGFkYXRhEp8BChRCYXRjaEdldExpbmtNZXRhZGF0YRIpLm1lbW9zLmFwaS52MS5CYXRjaEdldExpbmtNZXRhZGF0YVJlcXVlc3QaKi5tZW1vcy5hcGkudjEuQmF0Y2hHZXRMaW5rTWV0YWRhdGFSZXNwb25zZSIwgtPkkwIqOgEqIiUvYXBpL3YxL21lbW9zLy0vbGlua01ldGFkYXRhOmJhdGNoR2V0QqgBChBjb20ubWVtb3MuYXBpLnYxQhBNZW1vU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_api_v1_attachment_service, file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+  fileDesc("ChlhcGkvdjEvbWVtb19zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEipwIKCFJlYWN0aW9uEhQKBG5hbWUYASABKAlCBuBBA+BBCBIqCgdjcmVhdG9yGAIgASgJQhngQQP6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEi0KCmNvbnRlbnRfaWQYAyABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SGgoNcmVhY3Rpb25fdHlwZRgEIAEoCUID4EECEjQKC2NyZWF0ZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOljqQVUKFW1lbW9zLmFwaS52MS9SZWFjdGlvbhIhbWVtb3Mve21lbW99L3JlYWN0aW9ucy97cmVhY3Rpb259GgRuYW1lKglyZWFjdGlvbnMyCHJlYWN0aW9uIowJCgRNZW1vEhEKBG5hbWUYASABKAlCA+BBCBInCgVzdGF0ZRgCIAEoDjITLm1lbW9zLmFwaS52MS5TdGF0ZUID4EECEioKB2NyZWF0b3IYAyABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL1VzZXISNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESNAoLdXBkYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESFAoHY29udGVudBgHIAEoCUID4EECEjEKCnZpc2liaWxpdHkYCSABKA4yGC5tZW1vcy5hcGkudjEuVmlzaWJpbGl0eUID4EECEhEKBHRhZ3MYCiADKAlCA+BBAxITCgZwaW5uZWQYCyABKAhCA+BBARIyCgthdHRhY2htZW50cxgMIAMoCzIYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50QgPgQQESMgoJcmVsYXRpb25zGA0gAygLMhoubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbkID4EEBEi4KCXJlYWN0aW9ucxgOIAMoCzIWLm1lbW9zLmFwaS52MS5SZWFjdGlvbkID4EEDEjIKCHByb3BlcnR5GA8gASgLMhsubWVtb3MuYXBpLnYxLk1lbW8uUHJvcGVydHlCA+BBAxIuCgZwYXJlbnQYECABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL01lbW9IAIgBARIUCgdzbmlwcGV0GBEgASgJQgPgQQMSMgoIbG9jYXRpb24YEiABKAsyFi5tZW1vcy5hcGkudjEuTG9jYXRpb25CA+BBAUgBiAEBEjAKCGF1ZGllbmNlGBMgAygJQh7gQQH6QRgKFm1lbW9zLmFwaS52MS9Vc2VyR3JvdXASEQoEZXRhZxgUIAEoCUID4EEBEjYKCGR1ZV90aW1lGBUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBSAKIAQESOQoLcmVtaW5kX3RpbWUYFiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQFIA4gBARI6CgxwdWJsaXNoX3RpbWUYFyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQFIBIgBARpyCghQcm9wZXJ0eRIQCghoYXNfbGluaxgBIAEoCBIVCg1oYXNfdGFza19saXN0GAIgASgIEhAKCGhhc19jb2RlGAMgASgIEhwKFGhhc19pbmNvbXBsZXRlX3Rhc2tzGAQgASgIEg0KBXRpdGxlGAUgASgJOjfqQTQKEW1lbW9zLmFwaS52MS9NZW1vEgxtZW1vcy97bWVtb30aBG5hbWUqBW1lbW9zMgRtZW1vQgkKB19wYXJlbnRCCwoJX2xvY2F0aW9uQgsKCV9kdWVfdGltZUIOCgxfcmVtaW5kX3RpbWVCDwoNX3B1Ymxpc2hfdGltZUoECAYQB1IMZGlzcGxheV90aW1lIlMKCExvY2F0aW9uEhgKC3BsYWNlaG9sZGVyGAEgASgJQgPgQQESFQoIbGF0aXR1ZGUYAiABKAFCA+BBARIWCglsb25naXR1ZGUYAyABKAFCA+BBASJQChFDcmVhdGVNZW1vUmVxdWVzdBIlCgRtZW1vGAEgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAhIUCgdtZW1vX2lkGAIgASgJQgPgQQEiswEKEExpc3RNZW1vc1JlcXVlc3QSFgoJcGFnZV9zaXplGAEgASgFQgPgQQESFwoKcGFnZV90b2tlbhgCIAEoCUID4EEBEicKBXN0YXRlGAMgASgOMhMubWVtb3MuYXBpLnYxLlN0YXRlQgPgQQESFQoIb3JkZXJfYnkYBCABKAlCA+BBARITCgZmaWx0ZXIYBSABKAlCA+BBARIZCgxzaG93X2RlbGV0ZWQYBiABKAhCA+BBASJPChFMaXN0TWVtb3NSZXNwb25zZRIhCgVtZW1vcxgBIAMoCzISLm1lbW9zLmFwaS52MS5NZW1vEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSI5Cg5HZXRNZW1vUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vInAKEVVwZGF0ZU1lbW9SZXF1ZXN0EiUKBG1lbW8YASABKAsyEi5tZW1vcy5hcGkudjEuTWVtb0ID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECImMKEURlbGV0ZU1lbW9SZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SEgoFZm9yY2UYAiABKAhCA+BBARIRCgRldGFnGAMgASgJQgPgQQEieAoZU2V0TWVtb0F0dGFjaG1lbnRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEjIKC2F0dGFjaG1lbnRzGAIgAygLMhgubWVtb3MuYXBpLnYxLkF0dGFjaG1lbnRCA+BBAiJ2ChpMaXN0TWVtb0F0dGFjaG1lbnRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJlChtMaXN0TWVtb0F0dGFjaG1lbnRzUmVzcG9uc2USLQoLYXR0YWNobWVudHMYASADKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiswIKDE1lbW9SZWxhdGlvbhIyCgRtZW1vGAEgASgLMh8ubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbi5NZW1vQgPgQQISOgoMcmVsYXRlZF9tZW1vGAIgASgLMh8ubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbi5NZW1vQgPgQQISMgoEdHlwZRgDIAEoDjIfLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24uVHlwZUID4EECGkUKBE1lbW8SJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIUCgdzbmlwcGV0GAIgASgJQgPgQQMiOAoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASDQoJUkVGRVJFTkNFEAESCwoHQ09NTUVOVBACInYKF1NldE1lbW9SZWxhdGlvbnNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SMgoJcmVsYXRpb25zGAIgAygLMhoubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbkID4EECInQKGExpc3RNZW1vUmVsYXRpb25zUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJjChlMaXN0TWVtb1JlbGF0aW9uc1Jlc3BvbnNlEi0KCXJlbGF0aW9ucxgBIAMoCzIaLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIoYBChhDcmVhdGVNZW1vQ29tbWVudFJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIoCgdjb21tZW50GAIgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAhIXCgpjb21tZW50X2lkGAMgASgJQgPgQQEiigEKF0xpc3RNZW1vQ29tbWVudHNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBEhUKCG9yZGVyX2J5GAQgASgJQgPgQQEiagoYTGlzdE1lbW9Db21tZW50c1Jlc3BvbnNlEiEKBW1lbW9zGAEgAygLMhIubWVtb3MuYXBpLnYxLk1lbW8SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUidAoYTGlzdE1lbW9SZWFjdGlvbnNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBInMKGUxpc3RNZW1vUmVhY3Rpb25zUmVzcG9uc2USKQoJcmVhY3Rpb25zGAEgAygLMhYubWVtb3MuYXBpLnYxLlJlYWN0aW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFInMKGVVwc2VydE1lbW9SZWFjdGlvblJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxItCghyZWFjdGlvbhgCIAEoCzIWLm1lbW9zLmFwaS52MS5SZWFjdGlvbkID4EECIkgKGURlbGV0ZU1lbW9SZWFjdGlvblJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVtZW1vcy5hcGkudjEvUmVhY3Rpb24iuQMKCU1lbW9TaGFyZRIRCgRuYW1lGAEgASgJQgPgQQgSNAoLY3JlYXRlX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSOQoLZXhwaXJlX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQFIAIgBARIVCghwYXNzd29yZBgEIAEoCUID4EEEEh8KEnBhc3N3b3JkX3Byb3RlY3RlZBgFIAEoCEID4EEDEhsKCW1heF92aWV3cxgGIAEoBUID4EEBSAGIAQESFwoKdmlld19jb3VudBgHIAEoBUID4EEDEj4KEGxhc3RfYWNjZXNzX3RpbWUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQNIAogBATpH6kFEChZtZW1vcy5hcGkudjEvTWVtb1NoYXJlEhttZW1vcy97bWVtb30vc2hhcmVzL3tzaGFyZX0qBnNoYXJlczIFc2hhcmVCDgoMX2V4cGlyZV90aW1lQgwKCl9tYXhfdmlld3NCEwoRX2xhc3RfYWNjZXNzX3RpbWUi4AIKD0NvbGxlY3Rpb25TaGFyZRIRCgRuYW1lGAEgASgJQgPgQQgSLwoIc2hvcnRjdXQYAiABKAlCHeBBAfpBFwoVbWVtb3MuYXBpLnYxL1Nob3J0Y3V0EhAKA3RhZxgDIAEoCUID4EEBEjQKC2NyZWF0ZV90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjkKC2V4cGlyZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBSACIAQE6dupBcwocbWVtb3MuYXBpLnYxL0NvbGxlY3Rpb25TaGFyZRIwdXNlcnMve3VzZXJ9L2NvbGxlY3Rpb25TaGFyZXMve2NvbGxlY3Rpb25fc2hhcmV9KhBjb2xsZWN0aW9uU2hhcmVzMg9jb2xsZWN0aW9uU2hhcmVCDgoMX2V4cGlyZV90aW1lIsMCChBNZW1vQ29sbGFib3JhdG9yEhEKBG5hbWUYASABKAlCA+BBCBIRCgR1c2VyGAIgASgJQgPgQQMSMQoEcm9sZRgDIAEoDjIjLm1lbW9zLmFwaS52MS5NZW1vQ29sbGFib3JhdG9yLlJvbGUSNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMiNAoEUm9sZRIUChBST0xFX1VOU1BFQ0lGSUVEEAASCgoGVklFV0VSEAESCgoGRURJVE9SEAI6aupBZwodbWVtb3MuYXBpLnYxL01lbW9Db2xsYWJvcmF0b3ISKW1lbW9zL3ttZW1vfS9jb2xsYWJvcmF0b3JzL3tjb2xsYWJvcmF0b3J9Kg1jb2xsYWJvcmF0b3JzMgxjb2xsYWJvcmF0b3IiSQocTGlzdE1lbW9Db2xsYWJvcmF0b3JzUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8iVgodTGlzdE1lbW9Db2xsYWJvcmF0b3JzUmVzcG9uc2USNQoNY29sbGFib3JhdG9ycxgBIAMoCzIeLm1lbW9zLmFwaS52MS5NZW1vQ29sbGFib3JhdG9yIqgBChpBZGRNZW1vQ29sbGFib3JhdG9yUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SJwoEdXNlchgCIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchI2CgRyb2xlGAMgASgOMiMubWVtb3MuYXBpLnYxLk1lbW9Db2xsYWJvcmF0b3IuUm9sZUID4EECIlQKHVJlbW92ZU1lbW9Db2xsYWJvcmF0b3JSZXF1ZXN0EjMKBG5hbWUYASABKAlCJeBBAvpBHwodbWVtb3MuYXBpLnYxL01lbW9Db2xsYWJvcmF0b3IidQoWQ3JlYXRlTWVtb1NoYXJlUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SMAoKbWVtb19zaGFyZRgCIAEoCzIXLm1lbW9zLmFwaS52MS5NZW1vU2hhcmVCA+BBAiJCChVMaXN0TWVtb1NoYXJlc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vIkYKFkxpc3RNZW1vU2hhcmVzUmVzcG9uc2USLAoLbWVtb19zaGFyZXMYASADKAsyFy5tZW1vcy5hcGkudjEuTWVtb1NoYXJlIkYKFkRlbGV0ZU1lbW9TaGFyZVJlcXVlc3QSLAoEbmFtZRgBIAEoCUIe4EEC+kEYChZtZW1vcy5hcGkudjEvTWVtb1NoYXJlIkUKFUdldE1lbW9CeVNoYXJlUmVxdWVzdBIVCghzaGFyZV9pZBgBIAEoCUID4EECEhUKCHBhc3N3b3JkGAIgASgJQgPgQQEihwEKHENyZWF0ZUNvbGxlY3Rpb25TaGFyZVJlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEjwKEGNvbGxlY3Rpb25fc2hhcmUYAiABKAsyHS5tZW1vcy5hcGkudjEuQ29sbGVjdGlvblNoYXJlQgPgQQIiSAobTGlzdENvbGxlY3Rpb25TaGFyZXNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlciJYChxMaXN0Q29sbGVjdGlvblNoYXJlc1Jlc3BvbnNlEjgKEWNvbGxlY3Rpb25fc2hhcmVzGAEgAygLMh0ubWVtb3MuYXBpLnYxLkNvbGxlY3Rpb25TaGFyZSJSChxEZWxldGVDb2xsZWN0aW9uU2hhcmVSZXF1ZXN0EjIKBG5hbWUYASABKAlCJOBBAvpBHgocbWVtb3MuYXBpLnYxL0NvbGxlY3Rpb25TaGFyZSJhChdMaXN0TWVtb3NCeVNoYXJlUmVxdWVzdBIVCghzaGFyZV9pZBgBIAEoCUID4EECEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJWChhMaXN0TWVtb3NCeVNoYXJlUmVzcG9uc2USIQoFbWVtb3MYASADKAsyEi5tZW1vcy5hcGkudjEuTWVtbxIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiKgoWR2V0TGlua01ldGFkYXRhUmVxdWVzdBIQCgN1cmwYASABKAlCA+BBAiIwChtCYXRjaEdldExpbmtNZXRhZGF0YVJlcXVlc3QSEQoEdXJscxgBIAMoCUID4EECIlEKHEJhdGNoR2V0TGlua01ldGFkYXRhUmVzcG9uc2USMQoNbGlua19tZXRhZGF0YRgBIAMoCzIaLm1lbW9zLmFwaS52MS5MaW5rTWV0YWRhdGEiTgoMTGlua01ldGFkYXRhEgsKA3VybBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRINCgVpbWFnZRgEIAEoCSpbCgpWaXNpYmlsaXR5EhoKFlZJU0lCSUxJVFlfVU5TUEVDSUZJRUQQABILCgdQUklWQVRFEAESDQoJUFJPVEVDVEVEEAISCgoGUFVCTElDEAMSCQoFR1JPVVAQBDKLHgoLTWVtb1NlcnZpY2USZQoKQ3JlYXRlTWVtbxIfLm1lbW9zLmFwaS52MS5DcmVhdGVNZW1vUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIiLaQQRtZW1vgtPkkwIVOgRtZW1vIg0vYXBpL3YxL21lbW9zEmYKCUxpc3RNZW1vcxIeLm1lbW9zLmFwaS52MS5MaXN0TWVtb3NSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLkxpc3RNZW1vc1Jlc3BvbnNlIhjaQQCC0+STAg8SDS9hcGkvdjEvbWVtb3MSYgoHR2V0TWVtbxIcLm1lbW9zLmFwaS52MS5HZXRNZW1vUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIiXaQQRuYW1lgtPkkwIYEhYvYXBpL3YxL3tuYW1lPW1lbW9zLyp9En8KClVwZGF0ZU1lbW8SHy5tZW1vcy5hcGkudjEuVXBkYXRlTWVtb1JlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyI82kEQbWVtbyx1cGRhdGVfbWFza4LT5JMCIzoEbWVtbzIbL2FwaS92MS97bWVtby5uYW1lPW1lbW9zLyp9EmwKCkRlbGV0ZU1lbW8SHy5tZW1vcy5hcGkudjEuRGVsZXRlTWVtb1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiJdpBBG5hbWWC0+STAhgqFi9hcGkvdjEve25hbWU9bWVtb3MvKn0SiwEKElNldE1lbW9BdHRhY2htZW50cxInLm1lbW9zLmFwaS52MS5TZXRNZW1vQXR0YWNobWVudHNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjTaQQRuYW1lgtPkkwInOgEqMiIvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2F0dGFjaG1lbnRzEp0BChNMaXN0TWVtb0F0dGFjaG1lbnRzEigubWVtb3MuYXBpLnYxLkxpc3RNZW1vQXR0YWNobWVudHNSZXF1ZXN0GikubWVtb3MuYXBpLnYxLkxpc3RNZW1vQXR0YWNobWVudHNSZXNwb25zZSIx2kEEbmFtZYLT5JMCJBIiL2FwaS92MS97bmFtZT1tZW1vcy8qfS9hdHRhY2htZW50cxKFAQoQU2V0TWVtb1JlbGF0aW9ucxIlLm1lbW9zLmFwaS52MS5TZXRNZW1vUmVsYXRpb25zUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIy2kEEbmFtZYLT5JMCJToBKjIgL2FwaS92MS97bmFtZT1tZW1vcy8qfS9yZWxhdGlvbnMSlQEKEUxpc3RNZW1vUmVsYXRpb25zEiYubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVsYXRpb25zUmVxdWVzdBonLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JlbGF0aW9uc1Jlc3BvbnNlIi/aQQRuYW1lgtPkkwIiEiAvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L3JlbGF0aW9ucxKQAQoRQ3JlYXRlTWVtb0NvbW1lbnQSJi5tZW1vcy5hcGkudjEuQ3JlYXRlTWVtb0NvbW1lbnRSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iP9pBDG5hbWUsY29tbWVudILT5JMCKjoHY29tbWVudCIfL2FwaS92MS97bmFtZT1tZW1vcy8qfS9jb21tZW50cxKRAQoQTGlzdE1lbW9Db21tZW50cxIlLm1lbW9zLmFwaS52MS5MaXN0TWVtb0NvbW1lbnRzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0TWVtb0NvbW1lbnRzUmVzcG9uc2UiLtpBBG5hbWWC0+STAiESHy9hcGkvdjEve25hbWU9bWVtb3MvKn0vY29tbWVudHMSlQEKEUxpc3RNZW1vUmVhY3Rpb25zEiYubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVhY3Rpb25zUmVxdWVzdBonLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JlYWN0aW9uc1Jlc3BvbnNlIi/aQQRuYW1lgtPkkwIiEiAvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L3JlYWN0aW9ucxKJAQoSVXBzZXJ0TWVtb1JlYWN0aW9uEicubWVtb3MuYXBpLnYxLlVwc2VydE1lbW9SZWFjdGlvblJlcXVlc3QaFi5tZW1vcy5hcGkudjEuUmVhY3Rpb24iMtpBBG5hbWWC0+STAiU6ASoiIC9hcGkvdjEve25hbWU9bWVtb3MvKn0vcmVhY3Rpb25zEogBChJEZWxldGVNZW1vUmVhY3Rpb24SJy5tZW1vcy5hcGkudjEuRGVsZXRlTWVtb1JlYWN0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIx2kEEbmFtZYLT5JMCJCoiL2FwaS92MS97bmFtZT1tZW1vcy8qL3JlYWN0aW9ucy8qfRKpAQoVTGlzdE1lbW9Db2xsYWJvcmF0b3JzEioubWVtb3MuYXBpLnYxLkxpc3RNZW1vQ29sbGFib3JhdG9yc1JlcXVlc3QaKy5tZW1vcy5hcGkudjEuTGlzdE1lbW9Db2xsYWJvcmF0b3JzUmVzcG9uc2UiN9pBBnBhcmVudILT5JMCKBImL2FwaS92MS97cGFyZW50PW1lbW9zLyp9L2NvbGxhYm9yYXRvcnMSpQEKE0FkZE1lbW9Db2xsYWJvcmF0b3ISKC5tZW1vcy5hcGkudjEuQWRkTWVtb0NvbGxhYm9yYXRvclJlcXVlc3QaHi5tZW1vcy5hcGkudjEuTWVtb0NvbGxhYm9yYXRvciJE2kEQcGFyZW50LHVzZXIscm9sZYLT5JMCKzoBKiImL2FwaS92MS97cGFyZW50PW1lbW9zLyp9L2NvbGxhYm9yYXRvcnMSlAEKFlJlbW92ZU1lbW9Db2xsYWJvcmF0b3ISKy5tZW1vcy5hcGkudjEuUmVtb3ZlTWVtb0NvbGxhYm9yYXRvclJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiNdpBBG5hbWWC0+STAigqJi9hcGkvdjEve25hbWU9bWVtb3MvKi9jb2xsYWJvcmF0b3JzLyp9EpkBCg9DcmVhdGVNZW1vU2hhcmUSJC5tZW1vcy5hcGkudjEuQ3JlYXRlTWVtb1NoYXJlUmVxdWVzdBoXLm1lbW9zLmFwaS52MS5NZW1vU2hhcmUiR9pBEXBhcmVudCxtZW1vX3NoYXJlgtPkkwItOgptZW1vX3NoYXJlIh8vYXBpL3YxL3twYXJlbnQ9bWVtb3MvKn0vc2hhcmVzEo0BCg5MaXN0TWVtb1NoYXJlcxIjLm1lbW9zLmFwaS52MS5MaXN0TWVtb1NoYXJlc1JlcXVlc3QaJC5tZW1vcy5hcGkudjEuTGlzdE1lbW9TaGFyZXNSZXNwb25zZSIw2kEGcGFyZW50gtPkkwIhEh8vYXBpL3YxL3twYXJlbnQ9bWVtb3MvKn0vc2hhcmVzEn8KD0RlbGV0ZU1lbW9TaGFyZRIkLm1lbW9zLmFwaS52MS5EZWxldGVNZW1vU2hhcmVSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Ii7aQQRuYW1lgtPkkwIhKh8vYXBpL3YxL3tuYW1lPW1lbW9zLyovc2hhcmVzLyp9EmwKDkdldE1lbW9CeVNoYXJlEiMubWVtb3MuYXBpLnYxLkdldE1lbW9CeVNoYXJlUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIiGC0+STAhsSGS9hcGkvdjEvc2hhcmVzL3tzaGFyZV9pZH0SwQEKFUNyZWF0ZUNvbGxlY3Rpb25TaGFyZRIqLm1lbW9zLmFwaS52MS5DcmVhdGVDb2xsZWN0aW9uU2hhcmVSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkNvbGxlY3Rpb25TaGFyZSJd2kEXcGFyZW50LGNvbGxlY3Rpb25fc2hhcmWC0+STAj06EGNvbGxlY3Rpb25fc2hhcmUiKS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9jb2xsZWN0aW9uU2hhcmVzEqkBChRMaXN0Q29sbGVjdGlvblNoYXJlcxIpLm1lbW9zLmFwaS52MS5MaXN0Q29sbGVjdGlvblNoYXJlc1JlcXVlc3QaKi5tZW1vcy5hcGkudjEuTGlzdENvbGxlY3Rpb25TaGFyZXNSZXNwb25zZSI62kEGcGFyZW50gtPkkwIrEikvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vY29sbGVjdGlvblNoYXJlcxKVAQoVRGVsZXRlQ29sbGVjdGlvblNoYXJlEioubWVtb3MuYXBpLnYxLkRlbGV0ZUNvbGxlY3Rpb25TaGFyZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiONpBBG5hbWWC0+STAisqKS9hcGkvdjEve25hbWU9dXNlcnMvKi9jb2xsZWN0aW9uU2hhcmVzLyp9EooBChBMaXN0TWVtb3NCeVNoYXJlEiUubWVtb3MuYXBpLnYxLkxpc3RNZW1vc0J5U2hhcmVSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RNZW1vc0J5U2hhcmVSZXNwb25zZSIngtPkkwIhEh8vYXBpL3YxL3NoYXJlcy97c2hhcmVfaWR9L21lbW9zEnkKD0dldExpbmtNZXRhZGF0YRIkLm1lbW9zLmFwaS52MS5HZXRMaW5rTWV0YWRhdGFSZXF1ZXN0GhoubWVtb3MuYXBpLnYxLkxpbmtNZXRhZGF0YSIkgtPkkwIeEhwvYXBpL3YxL21lbW9zLy0vbGlua01ldGFkYXRhEp8BChRCYXRjaEdldExpbmtNZXRhZGF0YRIpLm1lbW9zLmFwaS52MS5CYXRjaEdldExpbmtNZXRhZGF0YVJlcXVlc3QaKi5tZW1vcy5hcGkudjEuQmF0Y2hHZXRMaW5rTWV0YWRhdGFSZXNwb25zZSIwgtPkkwIqOgEqIiUvYXBpL3YxL21lbW9zLy0vbGlua01ldGFkYXRhOmJhdGNoR2V0QqgBChBjb20ubWVtb3MuYXBpLnYxQhBNZW1vU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_api_v1_attachment_service, file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.Reaction
@@ -193,6 +193,49 @@ export type Memo = Message<"memos.api.v1.Memo"> & {
    * @generated from field: optional memos.api.v1.Location location = 18;
    */
   location?: Location | undefined;
+
+  /**
+   * The user groups that can read a GROUP memo. Required for GROUP visibility
+   * and ignored otherwise.
+   * Format: groups/{group}
+   *
+   * @generated from field: repeated string audience = 19;
+   */
+  audience: string[];
+
+  /**
+   * Optional. The entity tag of the memo, which changes on every update.
+   * Send it back on update or delete to fail with FAILED_PRECONDITION if the
+   * memo was changed in the meantime.
+   *
+   * @generated from field: string etag = 20;
+   */
+  etag: string;
+
+  /**
+   * Optional. The time the memo is due. When not set explicitly, it is taken
+   * from the first @due(...) marker in the content, e.g. @due(2026-11-01 09:00).
+   *
+   * @generated from field: optional google.protobuf.Timestamp due_time = 21;
+   */
+  dueTime?: Timestamp | undefined;
+
+  /**
+   * Optional. The time to remind the creator about the memo. Defaults to the
+   * due time when not set.
+   *
+   * @generated from field: optional google.protobuf.Timestamp remind_time = 22;
+   */
+  remindTime?: Timestamp | undefined;
+
+  /**
+   * Optional. The time to publish the memo at. Until then the memo is only
+   * visible to its creator, and `visibility` is the visibility it gets once
+   * published. Clearing it cancels the schedule and keeps the memo private.
+   *
+   * @generated from field: optional google.protobuf.Timestamp publish_time = 23;
+   */
+  publishTime?: Timestamp | undefined;
 };
 
 /**
@@ -429,6 +472,8 @@ export type UpdateMemoRequest = Message<"memos.api.v1.UpdateMemoRequest"> & {
 
   /**
    * Required. The list of fields to update.
+   * If `memo.etag` is set, the update only applies when the memo has not been
+   * changed since; otherwise FAILED_PRECONDITION is returned.
    *
    * @generated from field: google.protobuf.FieldMask update_mask = 2;
    */
@@ -460,6 +505,14 @@ export type DeleteMemoRequest = Message<"memos.api.v1.DeleteMemoRequest"> & {
    * @generated from field: bool force = 2;
    */
   force: boolean;
+
+  /**
+   * Optional. The etag of the memo. If set, the memo is only deleted when it
+   * has not been changed since; otherwise FAILED_PRECONDITION is returned.
+   *
+   * @generated from field: string etag = 3;
+   */
+  etag: string;
 };
 
 /**
@@ -981,6 +1034,42 @@ export type MemoShare = Message<"memos.api.v1.MemoShare"> & {
    * @generated from field: optional google.protobuf.Timestamp expire_time = 3;
    */
   expireTime?: Timestamp | undefined;
+
+  /**
+   * Input only. When set, viewers must enter this password to open the share link.
+   *
+   * @generated from field: string password = 4;
+   */
+  password: string;
+
+  /**
+   * Output only. Whether the share link is protected by a password.
+   *
+   * @generated from field: bool password_protected = 5;
+   */
+  passwordProtected: boolean;
+
+  /**
+   * Optional. When set, the share link stops working after the memo has been
+   * viewed this many times through it.
+   *
+   * @generated from field: optional int32 max_views = 6;
+   */
+  maxViews?: number | undefined;
+
+  /**
+   * Output only. How many times the memo has been viewed through the share link.
+   *
+   * @generated from field: int32 view_count = 7;
+   */
+  viewCount: number;
+
+  /**
+   * Output only. When the memo was last viewed through the share link.
+   *
+   * @generated from field: optional google.protobuf.Timestamp last_access_time = 8;
+   */
+  lastAccessTime?: Timestamp | undefined;
 };
 
 /**
@@ -990,6 +1079,228 @@ export type MemoShare = Message<"memos.api.v1.MemoShare"> & {
 export const MemoShareSchema: GenMessage<MemoShare> = /*@__PURE__*/
   messageDesc(file_api_v1_memo_service, 23);
 
+/**
+ * CollectionShare is an access grant that permits read-only access to the memos
+ * matching a shortcut or a tag via an opaque bearer token.
+ *
+ * @generated from message memos.api.v1.CollectionShare
+ */
+export type CollectionShare = Message<"memos.api.v1.CollectionShare"> & {
+  /**
+   * The resource name of the collection share.
+   * Format: users/{user}/collectionShares/{collection_share}
+   * The {collection_share} segment is the opaque token used in the share URL.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The resource name of the shared shortcut. Exactly one of shortcut and tag must be set.
+   * Format: users/{user}/shortcuts/{shortcut}
+   *
+   * @generated from field: string shortcut = 2;
+   */
+  shortcut: string;
+
+  /**
+   * The shared tag. Exactly one of shortcut and tag must be set.
+   *
+   * @generated from field: string tag = 3;
+   */
+  tag: string;
+
+  /**
+   * Output only. When this share link was created.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 4;
+   */
+  createTime?: Timestamp | undefined;
+
+  /**
+   * Optional. When set, the share link stops working after this time.
+   * If unset, the link never expires.
+   *
+   * @generated from field: optional google.protobuf.Timestamp expire_time = 5;
+   */
+  expireTime?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.CollectionShare.
+ * Use `create(CollectionShareSchema)` to create a new message.
+ */
+export const CollectionShareSchema: GenMessage<CollectionShare> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 24);
+
+/**
+ * @generated from message memos.api.v1.MemoCollaborator
+ */
+export type MemoCollaborator = Message<"memos.api.v1.MemoCollaborator"> & {
+  /**
+   * The resource name of the collaborator.
+   * Format: memos/{memo}/collaborators/{collaborator}, where {collaborator} is the username.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The user granted access.
+   * Format: users/{user}
+   *
+   * @generated from field: string user = 2;
+   */
+  user: string;
+
+  /**
+   * The access level granted to the user.
+   *
+   * @generated from field: memos.api.v1.MemoCollaborator.Role role = 3;
+   */
+  role: MemoCollaborator_Role;
+
+  /**
+   * Output only. When the user became a collaborator.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 4;
+   */
+  createTime?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoCollaborator.
+ * Use `create(MemoCollaboratorSchema)` to create a new message.
+ */
+export const MemoCollaboratorSchema: GenMessage<MemoCollaborator> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 25);
+
+/**
+ * Role is the access level granted to a collaborator.
+ *
+ * @generated from enum memos.api.v1.MemoCollaborator.Role
+ */
+export enum MemoCollaborator_Role {
+  /**
+   * @generated from enum value: ROLE_UNSPECIFIED = 0;
+   */
+  ROLE_UNSPECIFIED = 0,
+
+  /**
+   * The collaborator can read the memo regardless of its visibility.
+   *
+   * @generated from enum value: VIEWER = 1;
+   */
+  VIEWER = 1,
+
+  /**
+   * The collaborator can also edit the memo's content and attachments.
+   *
+   * @generated from enum value: EDITOR = 2;
+   */
+  EDITOR = 2,
+}
+
+/**
+ * Describes the enum memos.api.v1.MemoCollaborator.Role.
+ */
+export const MemoCollaborator_RoleSchema: GenEnum<MemoCollaborator_Role> = /*@__PURE__*/
+  enumDesc(file_api_v1_memo_service, 25, 0);
+
+/**
+ * @generated from message memos.api.v1.ListMemoCollaboratorsRequest
+ */
+export type ListMemoCollaboratorsRequest = Message<"memos.api.v1.ListMemoCollaboratorsRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoCollaboratorsRequest.
+ * Use `create(ListMemoCollaboratorsRequestSchema)` to create a new message.
+ */
+export const ListMemoCollaboratorsRequestSchema: GenMessage<ListMemoCollaboratorsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 26);
+
+/**
+ * @generated from message memos.api.v1.ListMemoCollaboratorsResponse
+ */
+export type ListMemoCollaboratorsResponse = Message<"memos.api.v1.ListMemoCollaboratorsResponse"> & {
+  /**
+   * The list of collaborators.
+   *
+   * @generated from field: repeated memos.api.v1.MemoCollaborator collaborators = 1;
+   */
+  collaborators: MemoCollaborator[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoCollaboratorsResponse.
+ * Use `create(ListMemoCollaboratorsResponseSchema)` to create a new message.
+ */
+export const ListMemoCollaboratorsResponseSchema: GenMessage<ListMemoCollaboratorsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 27);
+
+/**
+ * @generated from message memos.api.v1.AddMemoCollaboratorRequest
+ */
+export type AddMemoCollaboratorRequest = Message<"memos.api.v1.AddMemoCollaboratorRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The user to grant access to.
+   * Format: users/{user}
+   *
+   * @generated from field: string user = 2;
+   */
+  user: string;
+
+  /**
+   * Required. The access level to grant.
+   *
+   * @generated from field: memos.api.v1.MemoCollaborator.Role role = 3;
+   */
+  role: MemoCollaborator_Role;
+};
+
+/**
+ * Describes the message memos.api.v1.AddMemoCollaboratorRequest.
+ * Use `create(AddMemoCollaboratorRequestSchema)` to create a new message.
+ */
+export const AddMemoCollaboratorRequestSchema: GenMessage<AddMemoCollaboratorRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 28);
+
+/**
+ * @generated from message memos.api.v1.RemoveMemoCollaboratorRequest
+ */
+export type RemoveMemoCollaboratorRequest = Message<"memos.api.v1.RemoveMemoCollaboratorRequest"> & {
+  /**
+   * Required. The resource name of the collaborator to remove.
+   * Format: memos/{memo}/collaborators/{collaborator}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RemoveMemoCollaboratorRequest.
+ * Use `create(RemoveMemoCollaboratorRequestSchema)` to create a new message.
+ */
+export const RemoveMemoCollaboratorRequestSchema: GenMessage<RemoveMemoCollaboratorRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 29);
+
 /**
  * @generated from message memos.api.v1.CreateMemoShareRequest
  */
@@ -1015,7 +1326,7 @@ export type CreateMemoShareRequest = Message<"memos.api.v1.CreateMemoShareReques
  * Use `create(CreateMemoShareRequestSchema)` to create a new message.
  */
 export const CreateMemoShareRequestSchema: GenMessage<CreateMemoShareRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 24);
+  messageDesc(file_api_v1_memo_service, 30);
 
 /**
  * @generated from message memos.api.v1.ListMemoSharesRequest
@@ -1035,7 +1346,7 @@ export type ListMemoSharesRequest = Message<"memos.api.v1.ListMemoSharesRequest"
  * Use `create(ListMemoSharesRequestSchema)` to create a new message.
  */
 export const ListMemoSharesRequestSchema: GenMessage<ListMemoSharesRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 25);
+  messageDesc(file_api_v1_memo_service, 31);
 
 /**
  * @generated from message memos.api.v1.ListMemoSharesResponse
@@ -1054,7 +1365,7 @@ export type ListMemoSharesResponse = Message<"memos.api.v1.ListMemoSharesRespons
  * Use `create(ListMemoSharesResponseSchema)` to create a new message.
  */
 export const ListMemoSharesResponseSchema: GenMessage<ListMemoSharesResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 26);
+  messageDesc(file_api_v1_memo_service, 32);
 
 /**
  * @generated from message memos.api.v1.DeleteMemoShareRequest
@@ -1074,7 +1385,7 @@ export type DeleteMemoShareRequest = Message<"memos.api.v1.DeleteMemoShareReques
  * Use `create(DeleteMemoShareRequestSchema)` to create a new message.
  */
 export const DeleteMemoShareRequestSchema: GenMessage<DeleteMemoShareRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 27);
+  messageDesc(file_api_v1_memo_service, 33);
 
 /**
  * @generated from message memos.api.v1.GetMemoByShareRequest
@@ -1086,6 +1397,13 @@ export type GetMemoByShareRequest = Message<"memos.api.v1.GetMemoByShareRequest"
    * @generated from field: string share_id = 1;
    */
   shareId: string;
+
+  /**
+   * Optional. The share password, required when the share link is password protected.
+   *
+   * @generated from field: string password = 2;
+   */
+  password: string;
 };
 
 /**
@@ -1093,7 +1411,153 @@ export type GetMemoByShareRequest = Message<"memos.api.v1.GetMemoByShareRequest"
  * Use `create(GetMemoByShareRequestSchema)` to create a new message.
  */
 export const GetMemoByShareRequestSchema: GenMessage<GetMemoByShareRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 28);
+  messageDesc(file_api_v1_memo_service, 34);
+
+/**
+ * @generated from message memos.api.v1.CreateCollectionShareRequest
+ */
+export type CreateCollectionShareRequest = Message<"memos.api.v1.CreateCollectionShareRequest"> & {
+  /**
+   * Required. The resource name of the user creating the share.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The collection share to create.
+   *
+   * @generated from field: memos.api.v1.CollectionShare collection_share = 2;
+   */
+  collectionShare?: CollectionShare | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateCollectionShareRequest.
+ * Use `create(CreateCollectionShareRequestSchema)` to create a new message.
+ */
+export const CreateCollectionShareRequestSchema: GenMessage<CreateCollectionShareRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 35);
+
+/**
+ * @generated from message memos.api.v1.ListCollectionSharesRequest
+ */
+export type ListCollectionSharesRequest = Message<"memos.api.v1.ListCollectionSharesRequest"> & {
+  /**
+   * Required. The resource name of the user.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListCollectionSharesRequest.
+ * Use `create(ListCollectionSharesRequestSchema)` to create a new message.
+ */
+export const ListCollectionSharesRequestSchema: GenMessage<ListCollectionSharesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 36);
+
+/**
+ * @generated from message memos.api.v1.ListCollectionSharesResponse
+ */
+export type ListCollectionSharesResponse = Message<"memos.api.v1.ListCollectionSharesResponse"> & {
+  /**
+   * The list of collection share links.
+   *
+   * @generated from field: repeated memos.api.v1.CollectionShare collection_shares = 1;
+   */
+  collectionShares: CollectionShare[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListCollectionSharesResponse.
+ * Use `create(ListCollectionSharesResponseSchema)` to create a new message.
+ */
+export const ListCollectionSharesResponseSchema: GenMessage<ListCollectionSharesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 37);
+
+/**
+ * @generated from message memos.api.v1.DeleteCollectionShareRequest
+ */
+export type DeleteCollectionShareRequest = Message<"memos.api.v1.DeleteCollectionShareRequest"> & {
+  /**
+   * Required. The resource name of the collection share to delete.
+   * Format: users/{user}/collectionShares/{collection_share}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteCollectionShareRequest.
+ * Use `create(DeleteCollectionShareRequestSchema)` to create a new message.
+ */
+export const DeleteCollectionShareRequestSchema: GenMessage<DeleteCollectionShareRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 38);
+
+/**
+ * @generated from message memos.api.v1.ListMemosByShareRequest
+ */
+export type ListMemosByShareRequest = Message<"memos.api.v1.ListMemosByShareRequest"> & {
+  /**
+   * Required. The collection share token extracted from the share URL.
+   *
+   * @generated from field: string share_id = 1;
+   */
+  shareId: string;
+
+  /**
+   * Optional. The maximum number of memos to return.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token, received from a previous `ListMemosByShare` call.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemosByShareRequest.
+ * Use `create(ListMemosByShareRequestSchema)` to create a new message.
+ */
+export const ListMemosByShareRequestSchema: GenMessage<ListMemosByShareRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 39);
+
+/**
+ * @generated from message memos.api.v1.ListMemosByShareResponse
+ */
+export type ListMemosByShareResponse = Message<"memos.api.v1.ListMemosByShareResponse"> & {
+  /**
+   * The memos of the collection.
+   *
+   * @generated from field: repeated memos.api.v1.Memo memos = 1;
+   */
+  memos: Memo[];
+
+  /**
+   * A token that can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemosByShareResponse.
+ * Use `create(ListMemosByShareResponseSchema)` to create a new message.
+ */
+export const ListMemosByShareResponseSchema: GenMessage<ListMemosByShareResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 40);
 
 /**
  * @generated from message memos.api.v1.GetLinkMetadataRequest
@@ -1112,7 +1576,7 @@ export type GetLinkMetadataRequest = Message<"memos.api.v1.GetLinkMetadataReques
  * Use `create(GetLinkMetadataRequestSchema)` to create a new message.
  */
 export const GetLinkMetadataRequestSchema: GenMessage<GetLinkMetadataRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 29);
+  messageDesc(file_api_v1_memo_service, 41);
 
 /**
  * @generated from message memos.api.v1.BatchGetLinkMetadataRequest
@@ -1131,7 +1595,7 @@ export type BatchGetLinkMetadataRequest = Message<"memos.api.v1.BatchGetLinkMeta
  * Use `create(BatchGetLinkMetadataRequestSchema)` to create a new message.
  */
 export const BatchGetLinkMetadataRequestSchema: GenMessage<BatchGetLinkMetadataRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 30);
+  messageDesc(file_api_v1_memo_service, 42);
 
 /**
  * @generated from message memos.api.v1.BatchGetLinkMetadataResponse
@@ -1150,7 +1614,7 @@ export type BatchGetLinkMetadataResponse = Message<"memos.api.v1.BatchGetLinkMet
  * Use `create(BatchGetLinkMetadataResponseSchema)` to create a new message.
  */
 export const BatchGetLinkMetadataResponseSchema: GenMessage<BatchGetLinkMetadataResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 31);
+  messageDesc(file_api_v1_memo_service, 43);
 
 /**
  * @generated from message memos.api.v1.LinkMetadata
@@ -1190,7 +1654,7 @@ export type LinkMetadata = Message<"memos.api.v1.LinkMetadata"> & {
  * Use `create(LinkMetadataSchema)` to create a new message.
  */
 export const LinkMetadataSchema: GenMessage<LinkMetadata> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 32);
+  messageDesc(file_api_v1_memo_service, 44);
 
 /**
  * @generated from enum memos.api.v1.Visibility
@@ -1215,6 +1679,13 @@ export enum Visibility {
    * @generated from enum value: PUBLIC = 3;
    */
   PUBLIC = 3,
+
+  /**
+   * Visible to the creator and the members of the memo's audience groups.
+   *
+   * @generated from enum value: GROUP = 4;
+   */
+  GROUP = 4,
 }
 
 /**
@@ -1367,6 +1838,38 @@ export const MemoService: GenService<{
     input: typeof DeleteMemoReactionRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * ListMemoCollaborators lists the collaborators of a memo.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListMemoCollaborators
+   */
+  listMemoCollaborators: {
+    methodKind: "unary";
+    input: typeof ListMemoCollaboratorsRequestSchema;
+    output: typeof ListMemoCollaboratorsResponseSchema;
+  },
+  /**
+   * AddMemoCollaborator grants a user viewer or editor rights on a memo.
+   * Adding an existing collaborator changes their role. Requires authentication as the memo creator.
+   *
+   * @generated from rpc memos.api.v1.MemoService.AddMemoCollaborator
+   */
+  addMemoCollaborator: {
+    methodKind: "unary";
+    input: typeof AddMemoCollaboratorRequestSchema;
+    output: typeof MemoCollaboratorSchema;
+  },
+  /**
+   * RemoveMemoCollaborator revokes a collaborator's access to a memo.
+   * Requires authentication as the memo creator, or as the collaborator being removed.
+   *
+   * @generated from rpc memos.api.v1.MemoService.RemoveMemoCollaborator
+   */
+  removeMemoCollaborator: {
+    methodKind: "unary";
+    input: typeof RemoveMemoCollaboratorRequestSchema;
+    output: typeof EmptySchema;
+  },
   /**
    * CreateMemoShare creates a share link for a memo. Requires authentication as the memo creator.
    *
@@ -1399,7 +1902,8 @@ export const MemoService: GenService<{
   },
   /**
    * GetMemoByShare resolves a share token to its memo. No authentication required.
-   * Returns NOT_FOUND if the token is invalid or expired.
+   * Returns NOT_FOUND if the token is invalid, expired or has used up its views,
+   * and PERMISSION_DENIED if the share password is missing or wrong.
    *
    * @generated from rpc memos.api.v1.MemoService.GetMemoByShare
    */
@@ -1408,6 +1912,49 @@ export const MemoService: GenService<{
     input: typeof GetMemoByShareRequestSchema;
     output: typeof MemoSchema;
   },
+  /**
+   * CreateCollectionShare creates a share link for the memos matching one of the
+   * user's shortcuts or carrying a tag. Requires authentication as the user.
+   *
+   * @generated from rpc memos.api.v1.MemoService.CreateCollectionShare
+   */
+  createCollectionShare: {
+    methodKind: "unary";
+    input: typeof CreateCollectionShareRequestSchema;
+    output: typeof CollectionShareSchema;
+  },
+  /**
+   * ListCollectionShares lists a user's collection share links. Requires authentication as the user.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListCollectionShares
+   */
+  listCollectionShares: {
+    methodKind: "unary";
+    input: typeof ListCollectionSharesRequestSchema;
+    output: typeof ListCollectionSharesResponseSchema;
+  },
+  /**
+   * DeleteCollectionShare revokes a collection share link. Requires authentication as the user.
+   *
+   * @generated from rpc memos.api.v1.MemoService.DeleteCollectionShare
+   */
+  deleteCollectionShare: {
+    methodKind: "unary";
+    input: typeof DeleteCollectionShareRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ListMemosByShare resolves a collection share token to the memos of the collection.
+   * No authentication required. Returns NOT_FOUND if the token is invalid or expired,
+   * or if the shared shortcut has been deleted.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListMemosByShare
+   */
+  listMemosByShare: {
+    methodKind: "unary";
+    input: typeof ListMemosByShareRequestSchema;
+    output: typeof ListMemosByShareResponseSchema;
+  },
   /**
    * GetLinkMetadata gets metadata for a link.
    *
@@ -0,0 +1,414 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file api/v1/oauth_service.proto (package memos.api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
+import { file_google_api_client } from "../../google/api/client_pb";
+import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
+import { file_google_api_resource } from "../../google/api/resource_pb";
+import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/oauth_service.proto.
+ */
+export const file_api_v1_oauth_service: GenFile = /*@__PURE__*/
+  fileDesc("ChphcGkvdjEvb2F1dGhfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIsMCCgtPQXV0aENsaWVudBIRCgRuYW1lGAEgASgJQgPgQQgSFgoJY2xpZW50X2lkGAIgASgJQgPgQQMSGQoMZGlzcGxheV9uYW1lGAMgASgJQgPgQQISGgoNcmVkaXJlY3RfdXJpcxgEIAMoCUID4EECEhMKBnNjb3BlcxgFIAMoCUID4EEBEhkKDGNvbmZpZGVudGlhbBgGIAEoCEID4EEBEhQKB2NyZWF0b3IYByABKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAzpW6kFTChhtZW1vcy5hcGkudjEvT0F1dGhDbGllbnQSHG9hdXRoLWNsaWVudHMve29hdXRoX2NsaWVudH0qDG9hdXRoQ2xpZW50czILb2F1dGhDbGllbnQiGQoXTGlzdE9BdXRoQ2xpZW50c1JlcXVlc3QiTAoYTGlzdE9BdXRoQ2xpZW50c1Jlc3BvbnNlEjAKDW9hdXRoX2NsaWVudHMYASADKAsyGS5tZW1vcy5hcGkudjEuT0F1dGhDbGllbnQiUAoYQ3JlYXRlT0F1dGhDbGllbnRSZXF1ZXN0EjQKDG9hdXRoX2NsaWVudBgBIAEoCzIZLm1lbW9zLmFwaS52MS5PQXV0aENsaWVudEID4EECImMKGUNyZWF0ZU9BdXRoQ2xpZW50UmVzcG9uc2USLwoMb2F1dGhfY2xpZW50GAEgASgLMhkubWVtb3MuYXBpLnYxLk9BdXRoQ2xpZW50EhUKDWNsaWVudF9zZWNyZXQYAiABKAkiSgoYRGVsZXRlT0F1dGhDbGllbnRSZXF1ZXN0Ei4KBG5hbWUYASABKAlCIOBBAvpBGgoYbWVtb3MuYXBpLnYxL09BdXRoQ2xpZW50ItMBChlPQXV0aEF1dGhvcml6YXRpb25SZXF1ZXN0EhoKDXJlc3BvbnNlX3R5cGUYASABKAlCA+BBAhIWCgljbGllbnRfaWQYAiABKAlCA+BBAhIZCgxyZWRpcmVjdF91cmkYAyABKAlCA+BBAhISCgVzY29wZRgEIAEoCUID4EEBEhIKBXN0YXRlGAUgASgJQgPgQQESGwoOY29kZV9jaGFsbGVuZ2UYBiABKAlCA+BBAhIiChVjb2RlX2NoYWxsZW5nZV9tZXRob2QYByABKAlCA+BBAiJXChZHZXRPQXV0aENvbnNlbnRSZXF1ZXN0Ej0KB3JlcXVlc3QYASABKAsyJy5tZW1vcy5hcGkudjEuT0F1dGhBdXRob3JpemF0aW9uUmVxdWVzdEID4EECIl8KDE9BdXRoQ29uc2VudBIpCgZjbGllbnQYASABKAsyGS5tZW1vcy5hcGkudjEuT0F1dGhDbGllbnQSDgoGc2NvcGVzGAIgAygJEhQKDHJlZGlyZWN0X3VyaRgDIAEoCSJtChtBdXRob3JpemVPQXV0aENsaWVudFJlcXVlc3QSPQoHcmVxdWVzdBgBIAEoCzInLm1lbW9zLmFwaS52MS5PQXV0aEF1dGhvcml6YXRpb25SZXF1ZXN0QgPgQQISDwoHYXBwcm92ZRgCIAEoCCI0ChxBdXRob3JpemVPQXV0aENsaWVudFJlc3BvbnNlEhQKDHJlZGlyZWN0X3VyaRgBIAEoCTLBBQoMT0F1dGhTZXJ2aWNlEoABChBMaXN0T0F1dGhDbGllbnRzEiUubWVtb3MuYXBpLnYxLkxpc3RPQXV0aENsaWVudHNSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RPQXV0aENsaWVudHNSZXNwb25zZSIdgtPkkwIXEhUvYXBpL3YxL29hdXRoLWNsaWVudHMSoAEKEUNyZWF0ZU9BdXRoQ2xpZW50EiYubWVtb3MuYXBpLnYxLkNyZWF0ZU9BdXRoQ2xpZW50UmVxdWVzdBonLm1lbW9zLmFwaS52MS5DcmVhdGVPQXV0aENsaWVudFJlc3BvbnNlIjraQQxvYXV0aF9jbGllbnSC0+STAiU6DG9hdXRoX2NsaWVudCIVL2FwaS92MS9vYXV0aC1jbGllbnRzEoIBChFEZWxldGVPQXV0aENsaWVudBImLm1lbW9zLmFwaS52MS5EZWxldGVPQXV0aENsaWVudFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiLdpBBG5hbWWC0+STAiAqHi9hcGkvdjEve25hbWU9b2F1dGgtY2xpZW50cy8qfRJyCg9HZXRPQXV0aENvbnNlbnQSJC5tZW1vcy5hcGkudjEuR2V0T0F1dGhDb25zZW50UmVxdWVzdBoaLm1lbW9zLmFwaS52MS5PQXV0aENvbnNlbnQiHYLT5JMCFxIVL2FwaS92MS9vYXV0aC9jb25zZW50EpEBChRBdXRob3JpemVPQXV0aENsaWVudBIpLm1lbW9zLmFwaS52MS5BdXRob3JpemVPQXV0aENsaWVudFJlcXVlc3QaKi5tZW1vcy5hcGkudjEuQXV0aG9yaXplT0F1dGhDbGllbnRSZXNwb25zZSIigtPkkwIcOgEqIhcvYXBpL3YxL29hdXRoL2F1dGhvcml6ZUKpAQoQY29tLm1lbW9zLmFwaS52MUIRT2F1dGhTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message memos.api.v1.OAuthClient
+ */
+export type OAuthClient = Message<"memos.api.v1.OAuthClient"> & {
+  /**
+   * The resource name of the OAuth client.
+   * Format: oauth-clients/{client_id}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The client identifier used in OAuth requests.
+   *
+   * @generated from field: string client_id = 2;
+   */
+  clientId: string;
+
+  /**
+   * The display name shown on the consent screen.
+   *
+   * @generated from field: string display_name = 3;
+   */
+  displayName: string;
+
+  /**
+   * The allowed redirect URIs. Authorization requests must use one of them exactly.
+   *
+   * @generated from field: repeated string redirect_uris = 4;
+   */
+  redirectUris: string[];
+
+  /**
+   * The scopes granted when an authorization request does not specify any.
+   *
+   * @generated from field: repeated string scopes = 5;
+   */
+  scopes: string[];
+
+  /**
+   * Whether the client authenticates with a client secret.
+   * Public clients, such as native and browser apps, rely on PKCE only.
+   *
+   * @generated from field: bool confidential = 6;
+   */
+  confidential: boolean;
+
+  /**
+   * The resource name of the user who created the client.
+   * Empty for dynamically registered clients.
+   * Format: users/{user}
+   *
+   * @generated from field: string creator = 7;
+   */
+  creator: string;
+
+  /**
+   * The creation timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 8;
+   */
+  createTime?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.OAuthClient.
+ * Use `create(OAuthClientSchema)` to create a new message.
+ */
+export const OAuthClientSchema: GenMessage<OAuthClient> = /*@__PURE__*/
+  messageDesc(file_api_v1_oauth_service, 0);
+
+/**
+ * @generated from message memos.api.v1.ListOAuthClientsRequest
+ */
+export type ListOAuthClientsRequest = Message<"memos.api.v1.ListOAuthClientsRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.ListOAuthClientsRequest.
+ * Use `create(ListOAuthClientsRequestSchema)` to create a new message.
+ */
+export const ListOAuthClientsRequestSchema: GenMessage<ListOAuthClientsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_oauth_service, 1);
+
+/**
+ * @generated from message memos.api.v1.ListOAuthClientsResponse
+ */
+export type ListOAuthClientsResponse = Message<"memos.api.v1.ListOAuthClientsResponse"> & {
+  /**
+   * The list of OAuth clients.
+   *
+   * @generated from field: repeated memos.api.v1.OAuthClient oauth_clients = 1;
+   */
+  oauthClients: OAuthClient[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListOAuthClientsResponse.
+ * Use `create(ListOAuthClientsResponseSchema)` to create a new message.
+ */
+export const ListOAuthClientsResponseSchema: GenMessage<ListOAuthClientsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_oauth_service, 2);
+
+/**
+ * @generated from message memos.api.v1.CreateOAuthClientRequest
+ */
+export type CreateOAuthClientRequest = Message<"memos.api.v1.CreateOAuthClientRequest"> & {
+  /**
+   * Required. The OAuth client to create.
+   *
+   * @generated from field: memos.api.v1.OAuthClient oauth_client = 1;
+   */
+  oauthClient?: OAuthClient | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateOAuthClientRequest.
+ * Use `create(CreateOAuthClientRequestSchema)` to create a new message.
+ */
+export const CreateOAuthClientRequestSchema: GenMessage<CreateOAuthClientRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_oauth_service, 3);
+
+/**
+ * @generated from message memos.api.v1.CreateOAuthClientResponse
+ */
+export type CreateOAuthClientResponse = Message<"memos.api.v1.CreateOAuthClientResponse"> & {
+  /**
+   * The created OAuth client.
+   *
+   * @generated from field: memos.api.v1.OAuthClient oauth_client = 1;
+   */
+  oauthClient?: OAuthClient | undefined;
+
+  /**
+   * The client secret - only returned on creation of a confidential client.
+   * This is the only time the secret will be visible.
+   *
+   * @generated from field: string client_secret = 2;
+   */
+  clientSecret: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateOAuthClientResponse.
+ * Use `create(CreateOAuthClientResponseSchema)` to create a new message.
+ */
+export const CreateOAuthClientResponseSchema: GenMessage<CreateOAuthClientResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_oauth_service, 4);
+
+/**
+ * @generated from message memos.api.v1.DeleteOAuthClientRequest
+ */
+export type DeleteOAuthClientRequest = Message<"memos.api.v1.DeleteOAuthClientRequest"> & {
+  /**
+   * Required. The resource name of the OAuth client to delete.
+   * Format: oauth-clients/{client_id}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteOAuthClientRequest.
+ * Use `create(DeleteOAuthClientRequestSchema)` to create a new message.
+ */
+export const DeleteOAuthClientRequestSchema: GenMessage<DeleteOAuthClientRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_oauth_service, 5);
+
+/**
+ * OAuthAuthorizationRequest holds the query parameters of an authorization request.
+ *
+ * @generated from message memos.api.v1.OAuthAuthorizationRequest
+ */
+export type OAuthAuthorizationRequest = Message<"memos.api.v1.OAuthAuthorizationRequest"> & {
+  /**
+   * Must be "code".
+   *
+   * @generated from field: string response_type = 1;
+   */
+  responseType: string;
+
+  /**
+   * @generated from field: string client_id = 2;
+   */
+  clientId: string;
+
+  /**
+   * Must exactly match one of the registered redirect URIs.
+   *
+   * @generated from field: string redirect_uri = 3;
+   */
+  redirectUri: string;
+
+  /**
+   * Space-separated scopes. Defaults to the scopes registered for the client.
+   *
+   * @generated from field: string scope = 4;
+   */
+  scope: string;
+
+  /**
+   * Opaque value returned to the client unchanged.
+   *
+   * @generated from field: string state = 5;
+   */
+  state: string;
+
+  /**
+   * The PKCE code challenge.
+   *
+   * @generated from field: string code_challenge = 6;
+   */
+  codeChallenge: string;
+
+  /**
+   * Must be "S256".
+   *
+   * @generated from field: string code_challenge_method = 7;
+   */
+  codeChallengeMethod: string;
+};
+
+/**
+ * Describes the message memos.api.v1.OAuthAuthorizationRequest.
+ * Use `create(OAuthAuthorizationRequestSchema)` to create a new message.
+ */
+export const OAuthAuthorizationRequestSchema: GenMessage<OAuthAuthorizationRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_oauth_service, 6);
+
+/**
+ * @generated from message memos.api.v1.GetOAuthConsentRequest
+ */
+export type GetOAuthConsentRequest = Message<"memos.api.v1.GetOAuthConsentRequest"> & {
+  /**
+   * Required. The authorization request to validate.
+   *
+   * @generated from field: memos.api.v1.OAuthAuthorizationRequest request = 1;
+   */
+  request?: OAuthAuthorizationRequest | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.GetOAuthConsentRequest.
+ * Use `create(GetOAuthConsentRequestSchema)` to create a new message.
+ */
+export const GetOAuthConsentRequestSchema: GenMessage<GetOAuthConsentRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_oauth_service, 7);
+
+/**
+ * @generated from message memos.api.v1.OAuthConsent
+ */
+export type OAuthConsent = Message<"memos.api.v1.OAuthConsent"> & {
+  /**
+   * The client requesting access.
+   *
+   * @generated from field: memos.api.v1.OAuthClient client = 1;
+   */
+  client?: OAuthClient | undefined;
+
+  /**
+   * The scopes that will be granted if the user approves.
+   *
+   * @generated from field: repeated string scopes = 2;
+   */
+  scopes: string[];
+
+  /**
+   * The redirect URI the browser returns to.
+   *
+   * @generated from field: string redirect_uri = 3;
+   */
+  redirectUri: string;
+};
+
+/**
+ * Describes the message memos.api.v1.OAuthConsent.
+ * Use `create(OAuthConsentSchema)` to create a new message.
+ */
+export const OAuthConsentSchema: GenMessage<OAuthConsent> = /*@__PURE__*/
+  messageDesc(file_api_v1_oauth_service, 8);
+
+/**
+ * @generated from message memos.api.v1.AuthorizeOAuthClientRequest
+ */
+export type AuthorizeOAuthClientRequest = Message<"memos.api.v1.AuthorizeOAuthClientRequest"> & {
+  /**
+   * Required. The authorization request being decided.
+   *
+   * @generated from field: memos.api.v1.OAuthAuthorizationRequest request = 1;
+   */
+  request?: OAuthAuthorizationRequest | undefined;
+
+  /**
+   * Whether the user approved the request.
+   *
+   * @generated from field: bool approve = 2;
+   */
+  approve: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.AuthorizeOAuthClientRequest.
+ * Use `create(AuthorizeOAuthClientRequestSchema)` to create a new message.
+ */
+export const AuthorizeOAuthClientRequestSchema: GenMessage<AuthorizeOAuthClientRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_oauth_service, 9);
+
+/**
+ * @generated from message memos.api.v1.AuthorizeOAuthClientResponse
+ */
+export type AuthorizeOAuthClientResponse = Message<"memos.api.v1.AuthorizeOAuthClientResponse"> & {
+  /**
+   * The URL to redirect the browser to. It carries either an authorization code
+   * or an access_denied error, together with the original state.
+   *
+   * @generated from field: string redirect_uri = 1;
+   */
+  redirectUri: string;
+};
+
+/**
+ * Describes the message memos.api.v1.AuthorizeOAuthClientResponse.
+ * Use `create(AuthorizeOAuthClientResponseSchema)` to create a new message.
+ */
+export const AuthorizeOAuthClientResponseSchema: GenMessage<AuthorizeOAuthClientResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_oauth_service, 10);
+
+/**
+ * OAuthService manages third-party applications that access Memos through the
+ * OAuth2 authorization server, and backs the consent screen of the authorization flow.
+ * The protocol endpoints themselves (token, userinfo, registration) are served under /oauth.
+ *
+ * @generated from service memos.api.v1.OAuthService
+ */
+export const OAuthService: GenService<{
+  /**
+   * ListOAuthClients returns the OAuth clients created by the current user.
+   * Admins see every client, including dynamically registered ones.
+   *
+   * @generated from rpc memos.api.v1.OAuthService.ListOAuthClients
+   */
+  listOAuthClients: {
+    methodKind: "unary";
+    input: typeof ListOAuthClientsRequestSchema;
+    output: typeof ListOAuthClientsResponseSchema;
+  },
+  /**
+   * CreateOAuthClient registers a new OAuth client owned by the current user.
+   *
+   * @generated from rpc memos.api.v1.OAuthService.CreateOAuthClient
+   */
+  createOAuthClient: {
+    methodKind: "unary";
+    input: typeof CreateOAuthClientRequestSchema;
+    output: typeof CreateOAuthClientResponseSchema;
+  },
+  /**
+   * DeleteOAuthClient deletes an OAuth client and revokes every token issued to it.
+   *
+   * @generated from rpc memos.api.v1.OAuthService.DeleteOAuthClient
+   */
+  deleteOAuthClient: {
+    methodKind: "unary";
+    input: typeof DeleteOAuthClientRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GetOAuthConsent validates an authorization request and returns the data
+   * shown on the consent screen.
+   *
+   * @generated from rpc memos.api.v1.OAuthService.GetOAuthConsent
+   */
+  getOAuthConsent: {
+    methodKind: "unary";
+    input: typeof GetOAuthConsentRequestSchema;
+    output: typeof OAuthConsentSchema;
+  },
+  /**
+   * AuthorizeOAuthClient records the decision of the current user on an
+   * authorization request and returns the URL to redirect the browser to.
+   * Only browser sessions can authorize clients.
+   *
+   * @generated from rpc memos.api.v1.OAuthService.AuthorizeOAuthClient
+   */
+  authorizeOAuthClient: {
+    methodKind: "unary";
+    input: typeof AuthorizeOAuthClientRequestSchema;
+    output: typeof AuthorizeOAuthClientResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_oauth_service, 0);
+
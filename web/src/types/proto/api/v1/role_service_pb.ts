@@ -0,0 +1,309 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file api/v1/role_service.proto (package memos.api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
+import { file_google_api_client } from "../../google/api/client_pb";
+import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
+import { file_google_api_resource } from "../../google/api/resource_pb";
+import type { EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/role_service.proto.
+ */
+export const file_api_v1_role_service: GenFile = /*@__PURE__*/
+  fileDesc("ChlhcGkvdjEvcm9sZV9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEijAIKBFJvbGUSEQoEbmFtZRgBIAEoCUID4EEIEhgKC2Rlc2NyaXB0aW9uGAIgASgJQgPgQQESMgoLcGVybWlzc2lvbnMYAyADKA4yGC5tZW1vcy5hcGkudjEuUGVybWlzc2lvbkID4EEBEjQKC2NyZWF0ZV90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC3VwZGF0ZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOjfqQTQKEW1lbW9zLmFwaS52MS9Sb2xlEgxyb2xlcy97cm9sZX0aBG5hbWUqBXJvbGVzMgRyb2xlIhIKEExpc3RSb2xlc1JlcXVlc3QiNgoRTGlzdFJvbGVzUmVzcG9uc2USIQoFcm9sZXMYASADKAsyEi5tZW1vcy5hcGkudjEuUm9sZSI5Cg5HZXRSb2xlUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Sb2xlIlAKEUNyZWF0ZVJvbGVSZXF1ZXN0EiUKBHJvbGUYASABKAsyEi5tZW1vcy5hcGkudjEuUm9sZUID4EECEhQKB3JvbGVfaWQYAiABKAlCA+BBAiJwChFVcGRhdGVSb2xlUmVxdWVzdBIlCgRyb2xlGAEgASgLMhIubWVtb3MuYXBpLnYxLlJvbGVCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiI8ChFEZWxldGVSb2xlUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Sb2xlKpIBCgpQZXJtaXNzaW9uEhoKFlBFUk1JU1NJT05fVU5TUEVDSUZJRUQQABIQCgxNQU5BR0VfVVNFUlMQARITCg9NQU5BR0VfU0VUVElOR1MQAhISCg5NT0RFUkFURV9NRU1PUxADEg4KClZJRVdfU1RBVFMQBBIdChlNQU5BR0VfSURFTlRJVFlfUFJPVklERVJTEAUytAQKC1JvbGVTZXJ2aWNlEmMKCUxpc3RSb2xlcxIeLm1lbW9zLmFwaS52MS5MaXN0Um9sZXNSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLkxpc3RSb2xlc1Jlc3BvbnNlIhWC0+STAg8SDS9hcGkvdjEvcm9sZXMSYgoHR2V0Um9sZRIcLm1lbW9zLmFwaS52MS5HZXRSb2xlUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5Sb2xlIiXaQQRuYW1lgtPkkwIYEhYvYXBpL3YxL3tuYW1lPXJvbGVzLyp9Em0KCkNyZWF0ZVJvbGUSHy5tZW1vcy5hcGkudjEuQ3JlYXRlUm9sZVJlcXVlc3QaEi5tZW1vcy5hcGkudjEuUm9sZSIq2kEMcm9sZSxyb2xlX2lkgtPkkwIVOgRyb2xlIg0vYXBpL3YxL3JvbGVzEn8KClVwZGF0ZVJvbGUSHy5tZW1vcy5hcGkudjEuVXBkYXRlUm9sZVJlcXVlc3QaEi5tZW1vcy5hcGkudjEuUm9sZSI82kEQcm9sZSx1cGRhdGVfbWFza4LT5JMCIzoEcm9sZTIbL2FwaS92MS97cm9sZS5uYW1lPXJvbGVzLyp9EmwKCkRlbGV0ZVJvbGUSHy5tZW1vcy5hcGkudjEuRGVsZXRlUm9sZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiJdpBBG5hbWWC0+STAhgqFi9hcGkvdjEve25hbWU9cm9sZXMvKn1CqAEKEGNvbS5tZW1vcy5hcGkudjFCEFJvbGVTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message memos.api.v1.Role
+ */
+export type Role = Message<"memos.api.v1.Role"> & {
+  /**
+   * The resource name of the role.
+   * Format: roles/{role}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. A description of what the role is for.
+   *
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * The permissions granted by the role.
+   *
+   * @generated from field: repeated memos.api.v1.Permission permissions = 3;
+   */
+  permissions: Permission[];
+
+  /**
+   * Output only. The creation timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 4;
+   */
+  createTime?: Timestamp | undefined;
+
+  /**
+   * Output only. The last update timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp update_time = 5;
+   */
+  updateTime?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.Role.
+ * Use `create(RoleSchema)` to create a new message.
+ */
+export const RoleSchema: GenMessage<Role> = /*@__PURE__*/
+  messageDesc(file_api_v1_role_service, 0);
+
+/**
+ * @generated from message memos.api.v1.ListRolesRequest
+ */
+export type ListRolesRequest = Message<"memos.api.v1.ListRolesRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.ListRolesRequest.
+ * Use `create(ListRolesRequestSchema)` to create a new message.
+ */
+export const ListRolesRequestSchema: GenMessage<ListRolesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_role_service, 1);
+
+/**
+ * @generated from message memos.api.v1.ListRolesResponse
+ */
+export type ListRolesResponse = Message<"memos.api.v1.ListRolesResponse"> & {
+  /**
+   * The list of custom roles.
+   *
+   * @generated from field: repeated memos.api.v1.Role roles = 1;
+   */
+  roles: Role[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListRolesResponse.
+ * Use `create(ListRolesResponseSchema)` to create a new message.
+ */
+export const ListRolesResponseSchema: GenMessage<ListRolesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_role_service, 2);
+
+/**
+ * @generated from message memos.api.v1.GetRoleRequest
+ */
+export type GetRoleRequest = Message<"memos.api.v1.GetRoleRequest"> & {
+  /**
+   * Required. The resource name of the role.
+   * Format: roles/{role}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetRoleRequest.
+ * Use `create(GetRoleRequestSchema)` to create a new message.
+ */
+export const GetRoleRequestSchema: GenMessage<GetRoleRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_role_service, 3);
+
+/**
+ * @generated from message memos.api.v1.CreateRoleRequest
+ */
+export type CreateRoleRequest = Message<"memos.api.v1.CreateRoleRequest"> & {
+  /**
+   * Required. The role to create.
+   *
+   * @generated from field: memos.api.v1.Role role = 1;
+   */
+  role?: Role | undefined;
+
+  /**
+   * Required. The ID to use for the role, which will become the final component of the resource name.
+   * Must start with a lowercase letter and contain only lowercase letters, digits and hyphens.
+   *
+   * @generated from field: string role_id = 2;
+   */
+  roleId: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateRoleRequest.
+ * Use `create(CreateRoleRequestSchema)` to create a new message.
+ */
+export const CreateRoleRequestSchema: GenMessage<CreateRoleRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_role_service, 4);
+
+/**
+ * @generated from message memos.api.v1.UpdateRoleRequest
+ */
+export type UpdateRoleRequest = Message<"memos.api.v1.UpdateRoleRequest"> & {
+  /**
+   * Required. The role to update.
+   *
+   * @generated from field: memos.api.v1.Role role = 1;
+   */
+  role?: Role | undefined;
+
+  /**
+   * Required. The update mask. Supported fields: description, permissions.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateRoleRequest.
+ * Use `create(UpdateRoleRequestSchema)` to create a new message.
+ */
+export const UpdateRoleRequestSchema: GenMessage<UpdateRoleRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_role_service, 5);
+
+/**
+ * @generated from message memos.api.v1.DeleteRoleRequest
+ */
+export type DeleteRoleRequest = Message<"memos.api.v1.DeleteRoleRequest"> & {
+  /**
+   * Required. The resource name of the role to delete.
+   * Format: roles/{role}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteRoleRequest.
+ * Use `create(DeleteRoleRequestSchema)` to create a new message.
+ */
+export const DeleteRoleRequestSchema: GenMessage<DeleteRoleRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_role_service, 6);
+
+/**
+ * Permission is a named capability that a custom role grants on top of the USER role.
+ * Admins implicitly hold every permission.
+ *
+ * @generated from enum memos.api.v1.Permission
+ */
+export enum Permission {
+  /**
+   * @generated from enum value: PERMISSION_UNSPECIFIED = 0;
+   */
+  PERMISSION_UNSPECIFIED = 0,
+
+  /**
+   * Create, update, archive and unlock non-admin users, and manage invitations.
+   *
+   * @generated from enum value: MANAGE_USERS = 1;
+   */
+  MANAGE_USERS = 1,
+
+  /**
+   * Read and update instance settings.
+   *
+   * @generated from enum value: MANAGE_SETTINGS = 2;
+   */
+  MANAGE_SETTINGS = 2,
+
+  /**
+   * Archive and restore other users' public and protected memos, and manage tag metadata.
+   *
+   * @generated from enum value: MODERATE_MEMOS = 3;
+   */
+  MODERATE_MEMOS = 3,
+
+  /**
+   * Read instance statistics.
+   *
+   * @generated from enum value: VIEW_STATS = 4;
+   */
+  VIEW_STATS = 4,
+
+  /**
+   * Create, update and delete identity providers.
+   *
+   * @generated from enum value: MANAGE_IDENTITY_PROVIDERS = 5;
+   */
+  MANAGE_IDENTITY_PROVIDERS = 5,
+}
+
+/**
+ * Describes the enum memos.api.v1.Permission.
+ */
+export const PermissionSchema: GenEnum<Permission> = /*@__PURE__*/
+  enumDesc(file_api_v1_role_service, 0);
+
+/**
+ * @generated from service memos.api.v1.RoleService
+ */
+export const RoleService: GenService<{
+  /**
+   * ListRoles lists the custom roles of the instance.
+   * Only admins can list roles.
+   *
+   * @generated from rpc memos.api.v1.RoleService.ListRoles
+   */
+  listRoles: {
+    methodKind: "unary";
+    input: typeof ListRolesRequestSchema;
+    output: typeof ListRolesResponseSchema;
+  },
+  /**
+   * GetRole gets a custom role.
+   * Only admins can get roles.
+   *
+   * @generated from rpc memos.api.v1.RoleService.GetRole
+   */
+  getRole: {
+    methodKind: "unary";
+    input: typeof GetRoleRequestSchema;
+    output: typeof RoleSchema;
+  },
+  /**
+   * CreateRole creates a custom role.
+   * Only admins can create roles.
+   *
+   * @generated from rpc memos.api.v1.RoleService.CreateRole
+   */
+  createRole: {
+    methodKind: "unary";
+    input: typeof CreateRoleRequestSchema;
+    output: typeof RoleSchema;
+  },
+  /**
+   * UpdateRole updates the description or permissions of a custom role.
+   * Only admins can update roles.
+   *
+   * @generated from rpc memos.api.v1.RoleService.UpdateRole
+   */
+  updateRole: {
+    methodKind: "unary";
+    input: typeof UpdateRoleRequestSchema;
+    output: typeof RoleSchema;
+  },
+  /**
+   * DeleteRole deletes a custom role. Roles still assigned to users cannot be deleted.
+   * Only admins can delete roles.
+   *
+   * @generated from rpc memos.api.v1.RoleService.DeleteRole
+   */
+  deleteRole: {
+    methodKind: "unary";
+    input: typeof DeleteRoleRequestSchema;
+    output: typeof EmptySchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_role_service, 0);
+
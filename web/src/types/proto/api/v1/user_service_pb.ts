@@ -18,7 +18,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/user_service.proto.
  */
 export const file_api_v1_user_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvdXNlcl9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEi1gMKBFVzZXISEQoEbmFtZRgBIAEoCUID4EEIEioKBHJvbGUYAiABKA4yFy5tZW1vcy5hcGkudjEuVXNlci5Sb2xlQgPgQQISFQoIdXNlcm5hbWUYAyABKAlCA+BBAhISCgVlbWFpbBgEIAEoCUID4EEBEhkKDGRpc3BsYXlfbmFtZRgFIAEoCUID4EEBEhcKCmF2YXRhcl91cmwYBiABKAlCA+BBARIYCgtkZXNjcmlwdGlvbhgHIAEoCUID4EEBEhUKCHBhc3N3b3JkGAggASgJQgPgQQQSJwoFc3RhdGUYCSABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGVCA+BBAhI0CgtjcmVhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAyIxCgRSb2xlEhQKEFJPTEVfVU5TUEVDSUZJRUQQABIJCgVBRE1JThACEggKBFVTRVIQAzo36kE0ChFtZW1vcy5hcGkudjEvVXNlchIMdXNlcnMve3VzZXJ9GgRuYW1lKgV1c2VyczIEdXNlciJzChBMaXN0VXNlcnNSZXF1ZXN0EhYKCXBhZ2Vfc2l6ZRgBIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAiABKAlCA+BBARITCgZmaWx0ZXIYAyABKAlCA+BBARIZCgxzaG93X2RlbGV0ZWQYBCABKAhCA+BBASJjChFMaXN0VXNlcnNSZXNwb25zZRIhCgV1c2VycxgBIAMoCzISLm1lbW9zLmFwaS52MS5Vc2VyEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIikKFEJhdGNoR2V0VXNlcnNSZXF1ZXN0EhEKCXVzZXJuYW1lcxgBIAMoCSI6ChVCYXRjaEdldFVzZXJzUmVzcG9uc2USIQoFdXNlcnMYASADKAsyEi5tZW1vcy5hcGkudjEuVXNlciJtCg5HZXRVc2VyUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEjIKCXJlYWRfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBASKIAQoRQ3JlYXRlVXNlclJlcXVlc3QSKAoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyQgbgQQLgQQQSFAoHdXNlcl9pZBgCIAEoCUID4EEBEhoKDXZhbGlkYXRlX29ubHkYAyABKAhCA+BBARIXCgpyZXF1ZXN0X2lkGAQgASgJQgPgQQEijAEKEVVwZGF0ZVVzZXJSZXF1ZXN0EiUKBHVzZXIYASABKAsyEi5tZW1vcy5hcGkudjEuVXNlckID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECEhoKDWFsbG93X21pc3NpbmcYAyABKAhCA+BBASJQChFEZWxldGVVc2VyUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhIKBWZvcmNlGAIgASgIQgPgQQEitAQKCVVzZXJTdGF0cxIRCgRuYW1lGAEgASgJQgPgQQgSPgoPbWVtb190eXBlX3N0YXRzGAMgASgLMiUubWVtb3MuYXBpLnYxLlVzZXJTdGF0cy5NZW1vVHlwZVN0YXRzEjgKCXRhZ19jb3VudBgEIAMoCzIlLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMuVGFnQ291bnRFbnRyeRI7ChdtZW1vX2NyZWF0ZWRfdGltZXN0YW1wcxgHIAMoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOwoXbWVtb191cGRhdGVkX3RpbWVzdGFtcHMYCCADKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDHBpbm5lZF9tZW1vcxgFIAMoCRIYChB0b3RhbF9tZW1vX2NvdW50GAYgASgFGl8KDU1lbW9UeXBlU3RhdHMSEgoKbGlua19jb3VudBgBIAEoBRISCgpjb2RlX2NvdW50GAIgASgFEhIKCnRvZG9fY291bnQYAyABKAUSEgoKdW5kb19jb3VudBgEIAEoBRovCg1UYWdDb3VudEVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAE6P+pBPAoWbWVtb3MuYXBpLnYxL1VzZXJTdGF0cxIMdXNlcnMve3VzZXJ9Kgl1c2VyU3RhdHMyCXVzZXJTdGF0c0oECAIQA1IXbWVtb19kaXNwbGF5X3RpbWVzdGFtcHMiPgoTR2V0VXNlclN0YXRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyIlcKF0xpc3RBbGxVc2VyU3RhdHNSZXF1ZXN0EicKBXN0YXRlGAEgASgOMhMubWVtb3MuYXBpLnYxLlN0YXRlQgPgQQESEwoGZmlsdGVyGAIgASgJQgPgQQEiQgoYTGlzdEFsbFVzZXJTdGF0c1Jlc3BvbnNlEiYKBXN0YXRzGAEgAygLMhcubWVtb3MuYXBpLnYxLlVzZXJTdGF0cyLkAwoLVXNlclNldHRpbmcSEQoEbmFtZRgBIAEoCUID4EEIEkMKD2dlbmVyYWxfc2V0dGluZxgCIAEoCzIoLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZy5HZW5lcmFsU2V0dGluZ0gAEkUKEHdlYmhvb2tzX3NldHRpbmcYBSABKAsyKS5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuV2ViaG9va3NTZXR0aW5nSAAaVwoOR2VuZXJhbFNldHRpbmcSEwoGbG9jYWxlGAEgASgJQgPgQQESHAoPbWVtb192aXNpYmlsaXR5GAMgASgJQgPgQQESEgoFdGhlbWUYBCABKAlCA+BBARo+Cg9XZWJob29rc1NldHRpbmcSKwoId2ViaG9va3MYASADKAsyGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2siNQoDS2V5EhMKD0tFWV9VTlNQRUNJRklFRBAAEgsKB0dFTkVSQUwQARIMCghXRUJIT09LUxAEOl3qQVoKGG1lbW9zLmFwaS52MS9Vc2VyU2V0dGluZxIjdXNlcnMve3VzZXJuYW1lfS9zZXR0aW5ncy97c2V0dGluZ30qDHVzZXJTZXR0aW5nczILdXNlclNldHRpbmdCBwoFdmFsdWUiRwoVR2V0VXNlclNldHRpbmdSZXF1ZXN0Ei4KBG5hbWUYASABKAlCIOBBAvpBGgoYbWVtb3MuYXBpLnYxL1VzZXJTZXR0aW5nIoEBChhVcGRhdGVVc2VyU2V0dGluZ1JlcXVlc3QSLwoHc2V0dGluZxgBIAEoCzIZLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZ0ID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECInUKF0xpc3RVc2VyU2V0dGluZ3NSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEidAoYTGlzdFVzZXJTZXR0aW5nc1Jlc3BvbnNlEisKCHNldHRpbmdzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIuoBCg5MaW5rZWRJZGVudGl0eRIRCgRuYW1lGAEgASgJQgPgQQgSNwoIaWRwX25hbWUYAiABKAlCJeBBA/pBHwodbWVtb3MuYXBpLnYxL0lkZW50aXR5UHJvdmlkZXISFwoKZXh0ZXJuX3VpZBgDIAEoCUID4EEDOnPqQXAKG21lbW9zLmFwaS52MS9MaW5rZWRJZGVudGl0eRIvdXNlcnMve3VzZXJ9L2xpbmtlZElkZW50aXRpZXMve2xpbmtlZF9pZGVudGl0eX0qEGxpbmtlZElkZW50aXRpZXMyDmxpbmtlZElkZW50aXR5IkgKG0xpc3RMaW5rZWRJZGVudGl0aWVzUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXIiVwocTGlzdExpbmtlZElkZW50aXRpZXNSZXNwb25zZRI3ChFsaW5rZWRfaWRlbnRpdGllcxgBIAMoCzIcLm1lbW9zLmFwaS52MS5MaW5rZWRJZGVudGl0eSLLAQobQ3JlYXRlTGlua2VkSWRlbnRpdHlSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchI3CghpZHBfbmFtZRgCIAEoCUIl4EEC+kEfCh1tZW1vcy5hcGkudjEvSWRlbnRpdHlQcm92aWRlchIRCgRjb2RlGAMgASgJQgPgQQISGQoMcmVkaXJlY3RfdXJpGAQgASgJQgPgQQISGgoNY29kZV92ZXJpZmllchgFIAEoCUID4EEBIk0KGEdldExpbmtlZElkZW50aXR5UmVxdWVzdBIxCgRuYW1lGAEgASgJQiPgQQL6QR0KG21lbW9zLmFwaS52MS9MaW5rZWRJZGVudGl0eSJQChtEZWxldGVMaW5rZWRJZGVudGl0eVJlcXVlc3QSMQoEbmFtZRgBIAEoCUIj4EEC+kEdChttZW1vcy5hcGkudjEvTGlua2VkSWRlbnRpdHki8gIKE1BlcnNvbmFsQWNjZXNzVG9rZW4SEQoEbmFtZRgBIAEoCUID4EEIEhgKC2Rlc2NyaXB0aW9uGAIgASgJQgPgQQESMwoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIzCgpleHBpcmVzX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBEjUKDGxhc3RfdXNlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAzqMAepBiAEKIG1lbW9zLmFwaS52MS9QZXJzb25hbEFjY2Vzc1Rva2VuEjl1c2Vycy97dXNlcn0vcGVyc29uYWxBY2Nlc3NUb2tlbnMve3BlcnNvbmFsX2FjY2Vzc190b2tlbn0qFHBlcnNvbmFsQWNjZXNzVG9rZW5zMhNwZXJzb25hbEFjY2Vzc1Rva2VuIn0KH0xpc3RQZXJzb25hbEFjY2Vzc1Rva2Vuc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASKSAQogTGlzdFBlcnNvbmFsQWNjZXNzVG9rZW5zUmVzcG9uc2USQQoWcGVyc29uYWxfYWNjZXNzX3Rva2VucxgBIAMoCzIhLm1lbW9zLmFwaS52MS5QZXJzb25hbEFjY2Vzc1Rva2VuEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIoUBCiBDcmVhdGVQZXJzb25hbEFjY2Vzc1Rva2VuUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISGAoLZGVzY3JpcHRpb24YAiABKAlCA+BBARIcCg9leHBpcmVzX2luX2RheXMYAyABKAVCA+BBASJ0CiFDcmVhdGVQZXJzb25hbEFjY2Vzc1Rva2VuUmVzcG9uc2USQAoVcGVyc29uYWxfYWNjZXNzX3Rva2VuGAEgASgLMiEubWVtb3MuYXBpLnYxLlBlcnNvbmFsQWNjZXNzVG9rZW4SDQoFdG9rZW4YAiABKAkiWgogRGVsZXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlcXVlc3QSNgoEbmFtZRgBIAEoCUIo4EEC+kEiCiBtZW1vcy5hcGkudjEvUGVyc29uYWxBY2Nlc3NUb2tlbiKqAQoLVXNlcldlYmhvb2sSDAoEbmFtZRgBIAEoCRILCgN1cmwYAiABKAkSFAoMZGlzcGxheV9uYW1lGAMgASgJEjQKC2NyZWF0ZV90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC3VwZGF0ZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDIi4KF0xpc3RVc2VyV2ViaG9va3NSZXF1ZXN0EhMKBnBhcmVudBgBIAEoCUID4EECIkcKGExpc3RVc2VyV2ViaG9va3NSZXNwb25zZRIrCgh3ZWJob29rcxgBIAMoCzIZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9vayJgChhDcmVhdGVVc2VyV2ViaG9va1JlcXVlc3QSEwoGcGFyZW50GAEgASgJQgPgQQISLwoHd2ViaG9vaxgCIAEoCzIZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9va0ID4EECInwKGFVwZGF0ZVVzZXJXZWJob29rUmVxdWVzdBIvCgd3ZWJob29rGAEgASgLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rQgPgQQISLwoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrIi0KGERlbGV0ZVVzZXJXZWJob29rUmVxdWVzdBIRCgRuYW1lGAEgASgJQgPgQQIiogcKEFVzZXJOb3RpZmljYXRpb24SFAoEbmFtZRgBIAEoCUIG4EED4EEIEikKBnNlbmRlchgCIAEoCUIZ4EED+kETChFtZW1vcy5hcGkudjEvVXNlchIsCgtzZW5kZXJfdXNlchgIIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyQgPgQQMSOgoGc3RhdHVzGAMgASgOMiUubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24uU3RhdHVzQgPgQQESNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNgoEdHlwZRgFIAEoDjIjLm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uLlR5cGVCA+BBAxJOCgxtZW1vX2NvbW1lbnQYBiABKAsyMS5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbi5NZW1vQ29tbWVudFBheWxvYWRCA+BBA0gAEk4KDG1lbW9fbWVudGlvbhgHIAEoCzIxLm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uLk1lbW9NZW50aW9uUGF5bG9hZEID4EEDSAAabAoSTWVtb0NvbW1lbnRQYXlsb2FkEgwKBG1lbW8YASABKAkSFAoMcmVsYXRlZF9tZW1vGAIgASgJEhQKDG1lbW9fc25pcHBldBgDIAEoCRIcChRyZWxhdGVkX21lbW9fc25pcHBldBgEIAEoCRpsChJNZW1vTWVudGlvblBheWxvYWQSDAoEbWVtbxgBIAEoCRIUCgxyZWxhdGVkX21lbW8YAiABKAkSFAoMbWVtb19zbmlwcGV0GAMgASgJEhwKFHJlbGF0ZWRfbWVtb19zbmlwcGV0GAQgASgJIjoKBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABIKCgZVTlJFQUQQARIMCghBUkNISVZFRBACIkAKBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEhAKDE1FTU9fQ09NTUVOVBABEhAKDE1FTU9fTUVOVElPThACOnDqQW0KHW1lbW9zLmFwaS52MS9Vc2VyTm90aWZpY2F0aW9uEil1c2Vycy97dXNlcn0vbm90aWZpY2F0aW9ucy97bm90aWZpY2F0aW9ufRoEbmFtZSoNbm90aWZpY2F0aW9uczIMbm90aWZpY2F0aW9uQgkKB3BheWxvYWQijwEKHExpc3RVc2VyTm90aWZpY2F0aW9uc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBARITCgZmaWx0ZXIYBCABKAlCA+BBASJvCh1MaXN0VXNlck5vdGlmaWNhdGlvbnNSZXNwb25zZRI1Cg1ub3RpZmljYXRpb25zGAEgAygLMh4ubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIpABCh1VcGRhdGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBI5Cgxub3RpZmljYXRpb24YASABKAsyHi5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbkID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECIlQKHURlbGV0ZVVzZXJOb3RpZmljYXRpb25SZXF1ZXN0EjMKBG5hbWUYASABKAlCJeBBAvpBHwodbWVtb3MuYXBpLnYxL1VzZXJOb3RpZmljYXRpb24ygh0KC1VzZXJTZXJ2aWNlEmMKCUxpc3RVc2VycxIeLm1lbW9zLmFwaS52MS5MaXN0VXNlcnNSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLkxpc3RVc2Vyc1Jlc3BvbnNlIhWC0+STAg8SDS9hcGkvdjEvdXNlcnMSewoNQmF0Y2hHZXRVc2VycxIiLm1lbW9zLmFwaS52MS5CYXRjaEdldFVzZXJzUmVxdWVzdBojLm1lbW9zLmFwaS52MS5CYXRjaEdldFVzZXJzUmVzcG9uc2UiIYLT5JMCGzoBKiIWL2FwaS92MS91c2VyczpiYXRjaEdldBJiCgdHZXRVc2VyEhwubWVtb3MuYXBpLnYxLkdldFVzZXJSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLlVzZXIiJdpBBG5hbWWC0+STAhgSFi9hcGkvdjEve25hbWU9dXNlcnMvKn0SZQoKQ3JlYXRlVXNlchIfLm1lbW9zLmFwaS52MS5DcmVhdGVVc2VyUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5Vc2VyIiLaQQR1c2VygtPkkwIVOgR1c2VyIg0vYXBpL3YxL3VzZXJzEn8KClVwZGF0ZVVzZXISHy5tZW1vcy5hcGkudjEuVXBkYXRlVXNlclJlcXVlc3QaEi5tZW1vcy5hcGkudjEuVXNlciI82kEQdXNlcix1cGRhdGVfbWFza4LT5JMCIzoEdXNlcjIbL2FwaS92MS97dXNlci5uYW1lPXVzZXJzLyp9EmwKCkRlbGV0ZVVzZXISHy5tZW1vcy5hcGkudjEuRGVsZXRlVXNlclJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiJdpBBG5hbWWC0+STAhgqFi9hcGkvdjEve25hbWU9dXNlcnMvKn0SfgoQTGlzdEFsbFVzZXJTdGF0cxIlLm1lbW9zLmFwaS52MS5MaXN0QWxsVXNlclN0YXRzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0QWxsVXNlclN0YXRzUmVzcG9uc2UiG4LT5JMCFRITL2FwaS92MS91c2VyczpzdGF0cxJ6CgxHZXRVc2VyU3RhdHMSIS5tZW1vcy5hcGkudjEuR2V0VXNlclN0YXRzUmVxdWVzdBoXLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMiLtpBBG5hbWWC0+STAiESHy9hcGkvdjEve25hbWU9dXNlcnMvKn06Z2V0U3RhdHMSggEKDkdldFVzZXJTZXR0aW5nEiMubWVtb3MuYXBpLnYxLkdldFVzZXJTZXR0aW5nUmVxdWVzdBoZLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZyIw2kEEbmFtZYLT5JMCIxIhL2FwaS92MS97bmFtZT11c2Vycy8qL3NldHRpbmdzLyp9EqgBChFVcGRhdGVVc2VyU2V0dGluZxImLm1lbW9zLmFwaS52MS5VcGRhdGVVc2VyU2V0dGluZ1JlcXVlc3QaGS5tZW1vcy5hcGkudjEuVXNlclNldHRpbmciUNpBE3NldHRpbmcsdXBkYXRlX21hc2uC0+STAjQ6B3NldHRpbmcyKS9hcGkvdjEve3NldHRpbmcubmFtZT11c2Vycy8qL3NldHRpbmdzLyp9EpUBChBMaXN0VXNlclNldHRpbmdzEiUubWVtb3MuYXBpLnYxLkxpc3RVc2VyU2V0dGluZ3NSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RVc2VyU2V0dGluZ3NSZXNwb25zZSIy2kEGcGFyZW50gtPkkwIjEiEvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vc2V0dGluZ3MSqQEKFExpc3RMaW5rZWRJZGVudGl0aWVzEikubWVtb3MuYXBpLnYxLkxpc3RMaW5rZWRJZGVudGl0aWVzUmVxdWVzdBoqLm1lbW9zLmFwaS52MS5MaXN0TGlua2VkSWRlbnRpdGllc1Jlc3BvbnNlIjraQQZwYXJlbnSC0+STAisSKS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9saW5rZWRJZGVudGl0aWVzEqcBChRDcmVhdGVMaW5rZWRJZGVudGl0eRIpLm1lbW9zLmFwaS52MS5DcmVhdGVMaW5rZWRJZGVudGl0eVJlcXVlc3QaHC5tZW1vcy5hcGkudjEuTGlua2VkSWRlbnRpdHkiRtpBD3BhcmVudCxpZHBfbmFtZYLT5JMCLjoBKiIpL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L2xpbmtlZElkZW50aXRpZXMSkwEKEUdldExpbmtlZElkZW50aXR5EiYubWVtb3MuYXBpLnYxLkdldExpbmtlZElkZW50aXR5UmVxdWVzdBocLm1lbW9zLmFwaS52MS5MaW5rZWRJZGVudGl0eSI42kEEbmFtZYLT5JMCKxIpL2FwaS92MS97bmFtZT11c2Vycy8qL2xpbmtlZElkZW50aXRpZXMvKn0SkwEKFERlbGV0ZUxpbmtlZElkZW50aXR5EikubWVtb3MuYXBpLnYxLkRlbGV0ZUxpbmtlZElkZW50aXR5UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSI42kEEbmFtZYLT5JMCKyopL2FwaS92MS97bmFtZT11c2Vycy8qL2xpbmtlZElkZW50aXRpZXMvKn0SuQEKGExpc3RQZXJzb25hbEFjY2Vzc1Rva2VucxItLm1lbW9zLmFwaS52MS5MaXN0UGVyc29uYWxBY2Nlc3NUb2tlbnNSZXF1ZXN0Gi4ubWVtb3MuYXBpLnYxLkxpc3RQZXJzb25hbEFjY2Vzc1Rva2Vuc1Jlc3BvbnNlIj7aQQZwYXJlbnSC0+STAi8SLS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9wZXJzb25hbEFjY2Vzc1Rva2VucxK2AQoZQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlbhIuLm1lbW9zLmFwaS52MS5DcmVhdGVQZXJzb25hbEFjY2Vzc1Rva2VuUmVxdWVzdBovLm1lbW9zLmFwaS52MS5DcmVhdGVQZXJzb25hbEFjY2Vzc1Rva2VuUmVzcG9uc2UiOILT5JMCMjoBKiItL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3BlcnNvbmFsQWNjZXNzVG9rZW5zEqEBChlEZWxldGVQZXJzb25hbEFjY2Vzc1Rva2VuEi4ubWVtb3MuYXBpLnYxLkRlbGV0ZVBlcnNvbmFsQWNjZXNzVG9rZW5SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjzaQQRuYW1lgtPkkwIvKi0vYXBpL3YxL3tuYW1lPXVzZXJzLyovcGVyc29uYWxBY2Nlc3NUb2tlbnMvKn0SlQEKEExpc3RVc2VyV2ViaG9va3MSJS5tZW1vcy5hcGkudjEuTGlzdFVzZXJXZWJob29rc1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdFVzZXJXZWJob29rc1Jlc3BvbnNlIjLaQQZwYXJlbnSC0+STAiMSIS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS93ZWJob29rcxKbAQoRQ3JlYXRlVXNlcldlYmhvb2sSJi5tZW1vcy5hcGkudjEuQ3JlYXRlVXNlcldlYmhvb2tSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rIkPaQQ5wYXJlbnQsd2ViaG9va4LT5JMCLDoHd2ViaG9vayIhL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3dlYmhvb2tzEqgBChFVcGRhdGVVc2VyV2ViaG9vaxImLm1lbW9zLmFwaS52MS5VcGRhdGVVc2VyV2ViaG9va1JlcXVlc3QaGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2siUNpBE3dlYmhvb2ssdXBkYXRlX21hc2uC0+STAjQ6B3dlYmhvb2syKS9hcGkvdjEve3dlYmhvb2submFtZT11c2Vycy8qL3dlYmhvb2tzLyp9EoUBChFEZWxldGVVc2VyV2ViaG9vaxImLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyV2ViaG9va1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiMNpBBG5hbWWC0+STAiMqIS9hcGkvdjEve25hbWU9dXNlcnMvKi93ZWJob29rcy8qfRKpAQoVTGlzdFVzZXJOb3RpZmljYXRpb25zEioubWVtb3MuYXBpLnYxLkxpc3RVc2VyTm90aWZpY2F0aW9uc1JlcXVlc3QaKy5tZW1vcy5hcGkudjEuTGlzdFVzZXJOb3RpZmljYXRpb25zUmVzcG9uc2UiN9pBBnBhcmVudILT5JMCKBImL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L25vdGlmaWNhdGlvbnMSywEKFlVwZGF0ZVVzZXJOb3RpZmljYXRpb24SKy5tZW1vcy5hcGkudjEuVXBkYXRlVXNlck5vdGlmaWNhdGlvblJlcXVlc3QaHi5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbiJk2kEYbm90aWZpY2F0aW9uLHVwZGF0ZV9tYXNrgtPkkwJDOgxub3RpZmljYXRpb24yMy9hcGkvdjEve25vdGlmaWNhdGlvbi5uYW1lPXVzZXJzLyovbm90aWZpY2F0aW9ucy8qfRKUAQoWRGVsZXRlVXNlck5vdGlmaWNhdGlvbhIrLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSI12kEEbmFtZYLT5JMCKComL2FwaS92MS97bmFtZT11c2Vycy8qL25vdGlmaWNhdGlvbnMvKn1CqAEKEGNvbS5tZW1vcy5hcGkudjFCEFVzZXJTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+  fileDesc("ChlhcGkvdjEvdXNlcl9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEiowQKBFVzZXISEQoEbmFtZRgBIAEoCUID4EEIEioKBHJvbGUYAiABKA4yFy5tZW1vcy5hcGkudjEuVXNlci5Sb2xlQgPgQQISFQoIdXNlcm5hbWUYAyABKAlCA+BBAhISCgVlbWFpbBgEIAEoCUID4EEBEhkKDGRpc3BsYXlfbmFtZRgFIAEoCUID4EEBEhcKCmF2YXRhcl91cmwYBiABKAlCA+BBARIYCgtkZXNjcmlwdGlvbhgHIAEoCUID4EEBEhUKCHBhc3N3b3JkGAggASgJQgPgQQQSJwoFc3RhdGUYCSABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGVCA+BBAhI0CgtjcmVhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIbCg5lbWFpbF92ZXJpZmllZBgMIAEoCEID4EEDEi4KC2N1c3RvbV9yb2xlGA0gASgJQhngQQH6QRMKEW1lbW9zLmFwaS52MS9Sb2xlIjEKBFJvbGUSFAoQUk9MRV9VTlNQRUNJRklFRBAAEgkKBUFETUlOEAISCAoEVVNFUhADOjfqQTQKEW1lbW9zLmFwaS52MS9Vc2VyEgx1c2Vycy97dXNlcn0aBG5hbWUqBXVzZXJzMgR1c2VyInMKEExpc3RVc2Vyc1JlcXVlc3QSFgoJcGFnZV9zaXplGAEgASgFQgPgQQESFwoKcGFnZV90b2tlbhgCIAEoCUID4EEBEhMKBmZpbHRlchgDIAEoCUID4EEBEhkKDHNob3dfZGVsZXRlZBgEIAEoCEID4EEBImMKEUxpc3RVc2Vyc1Jlc3BvbnNlEiEKBXVzZXJzGAEgAygLMhIubWVtb3MuYXBpLnYxLlVzZXISFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUiKQoUQmF0Y2hHZXRVc2Vyc1JlcXVlc3QSEQoJdXNlcm5hbWVzGAEgAygJIjoKFUJhdGNoR2V0VXNlcnNSZXNwb25zZRIhCgV1c2VycxgBIAMoCzISLm1lbW9zLmFwaS52MS5Vc2VyIm0KDkdldFVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISMgoJcmVhZF9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EEBIqYBChFDcmVhdGVVc2VyUmVxdWVzdBIoCgR1c2VyGAEgASgLMhIubWVtb3MuYXBpLnYxLlVzZXJCBuBBAuBBBBIUCgd1c2VyX2lkGAIgASgJQgPgQQESGgoNdmFsaWRhdGVfb25seRgDIAEoCEID4EEBEhcKCnJlcXVlc3RfaWQYBCABKAlCA+BBARIcCg9pbnZpdGF0aW9uX2NvZGUYBSABKAlCA+BBASKMAQoRVXBkYXRlVXNlclJlcXVlc3QSJQoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQISGgoNYWxsb3dfbWlzc2luZxgDIAEoCEID4EEBIlAKEURlbGV0ZVVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISEgoFZm9yY2UYAiABKAhCA+BBASI8ChFVbmxvY2tVc2VyUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyIrQECglVc2VyU3RhdHMSEQoEbmFtZRgBIAEoCUID4EEIEj4KD21lbW9fdHlwZV9zdGF0cxgDIAEoCzIlLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMuTWVtb1R5cGVTdGF0cxI4Cgl0YWdfY291bnQYBCADKAsyJS5tZW1vcy5hcGkudjEuVXNlclN0YXRzLlRhZ0NvdW50RW50cnkSOwoXbWVtb19jcmVhdGVkX3RpbWVzdGFtcHMYByADKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjsKF21lbW9fdXBkYXRlZF90aW1lc3RhbXBzGAggAygLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxwaW5uZWRfbWVtb3MYBSADKAkSGAoQdG90YWxfbWVtb19jb3VudBgGIAEoBRpfCg1NZW1vVHlwZVN0YXRzEhIKCmxpbmtfY291bnQYASABKAUSEgoKY29kZV9jb3VudBgCIAEoBRISCgp0b2RvX2NvdW50GAMgASgFEhIKCnVuZG9fY291bnQYBCABKAUaLwoNVGFnQ291bnRFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAU6AjgBOj/qQTwKFm1lbW9zLmFwaS52MS9Vc2VyU3RhdHMSDHVzZXJzL3t1c2VyfSoJdXNlclN0YXRzMgl1c2VyU3RhdHNKBAgCEANSF21lbW9fZGlzcGxheV90aW1lc3RhbXBzIj4KE0dldFVzZXJTdGF0c1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlciJXChdMaXN0QWxsVXNlclN0YXRzUmVxdWVzdBInCgVzdGF0ZRgBIAEoDjITLm1lbW9zLmFwaS52MS5TdGF0ZUID4EEBEhMKBmZpbHRlchgCIAEoCUID4EEBIkIKGExpc3RBbGxVc2VyU3RhdHNSZXNwb25zZRImCgVzdGF0cxgBIAMoCzIXLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMiuwoKC1VzZXJTZXR0aW5nEhEKBG5hbWUYASABKAlCA+BBCBJDCg9nZW5lcmFsX3NldHRpbmcYAiABKAsyKC5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuR2VuZXJhbFNldHRpbmdIABJFChB3ZWJob29rc19zZXR0aW5nGAUgASgLMikubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLldlYmhvb2tzU2V0dGluZ0gAEk0KFG5vdGlmaWNhdGlvbl9zZXR0aW5nGAYgASgLMi0ubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLk5vdGlmaWNhdGlvblNldHRpbmdIABqjAQoOR2VuZXJhbFNldHRpbmcSEwoGbG9jYWxlGAEgASgJQgPgQQESHAoPbWVtb192aXNpYmlsaXR5GAMgASgJQgPgQQESEgoFdGhlbWUYBCABKAlCA+BBARJKChhtdXRlZF9ub3RpZmljYXRpb25fdHlwZXMYBSADKA4yIy5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbi5UeXBlQgPgQQEaPgoPV2ViaG9va3NTZXR0aW5nEisKCHdlYmhvb2tzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rGqYFChNOb3RpZmljYXRpb25TZXR0aW5nElIKC3ByZWZlcmVuY2VzGAEgAygLMjgubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLk5vdGlmaWNhdGlvblNldHRpbmcuUHJlZmVyZW5jZUID4EEBEkwKCGNoYW5uZWxzGAIgAygLMjUubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLk5vdGlmaWNhdGlvblNldHRpbmcuQ2hhbm5lbEID4EEBEhkKDHdlZWtseV9yZWNhcBgDIAEoCEID4EEBGp4BCgpQcmVmZXJlbmNlEjYKBHR5cGUYASABKA4yIy5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbi5UeXBlQgPgQQISWAoOZW1haWxfZGVsaXZlcnkYAiABKA4yOy5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuTm90aWZpY2F0aW9uU2V0dGluZy5FbWFpbERlbGl2ZXJ5QgPgQQIawgEKB0NoYW5uZWwSTQoEdHlwZRgBIAEoDjI6Lm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZy5Ob3RpZmljYXRpb25TZXR0aW5nLkNoYW5uZWwuVHlwZUID4EECEhAKA3VybBgCIAEoCUID4EECEhUKBXRva2VuGAMgASgJQgbgQQHgQQQiPwoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASCAoETlRGWRABEgoKBkdPVElGWRACEgsKB0FQUFJJU0UQAyJsCg1FbWFpbERlbGl2ZXJ5Eh4KGkVNQUlMX0RFTElWRVJZX1VOU1BFQ0lGSUVEEAASDQoJSU1NRURJQVRFEAESEQoNSE9VUkxZX0RJR0VTVBACEhAKDERBSUxZX0RJR0VTVBADEgcKA09GRhAEIkcKA0tleRITCg9LRVlfVU5TUEVDSUZJRUQQABILCgdHRU5FUkFMEAESDAoIV0VCSE9PS1MQBBIQCgxOT1RJRklDQVRJT04QBTpd6kFaChhtZW1vcy5hcGkudjEvVXNlclNldHRpbmcSI3VzZXJzL3t1c2VybmFtZX0vc2V0dGluZ3Mve3NldHRpbmd9Kgx1c2VyU2V0dGluZ3MyC3VzZXJTZXR0aW5nQgcKBXZhbHVlIkcKFUdldFVzZXJTZXR0aW5nUmVxdWVzdBIuCgRuYW1lGAEgASgJQiDgQQL6QRoKGG1lbW9zLmFwaS52MS9Vc2VyU2V0dGluZyKBAQoYVXBkYXRlVXNlclNldHRpbmdSZXF1ZXN0Ei8KB3NldHRpbmcYASABKAsyGS5tZW1vcy5hcGkudjEuVXNlclNldHRpbmdCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJ1ChdMaXN0VXNlclNldHRpbmdzUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBInQKGExpc3RVc2VyU2V0dGluZ3NSZXNwb25zZRIrCghzZXR0aW5ncxgBIAMoCzIZLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZxIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSLqAQoOTGlua2VkSWRlbnRpdHkSEQoEbmFtZRgBIAEoCUID4EEIEjcKCGlkcF9uYW1lGAIgASgJQiXgQQP6QR8KHW1lbW9zLmFwaS52MS9JZGVudGl0eVByb3ZpZGVyEhcKCmV4dGVybl91aWQYAyABKAlCA+BBAzpz6kFwChttZW1vcy5hcGkudjEvTGlua2VkSWRlbnRpdHkSL3VzZXJzL3t1c2VyfS9saW5rZWRJZGVudGl0aWVzL3tsaW5rZWRfaWRlbnRpdHl9KhBsaW5rZWRJZGVudGl0aWVzMg5saW5rZWRJZGVudGl0eSJIChtMaXN0TGlua2VkSWRlbnRpdGllc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyIlcKHExpc3RMaW5rZWRJZGVudGl0aWVzUmVzcG9uc2USNwoRbGlua2VkX2lkZW50aXRpZXMYASADKAsyHC5tZW1vcy5hcGkudjEuTGlua2VkSWRlbnRpdHkiywEKG0NyZWF0ZUxpbmtlZElkZW50aXR5UmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISNwoIaWRwX25hbWUYAiABKAlCJeBBAvpBHwodbWVtb3MuYXBpLnYxL0lkZW50aXR5UHJvdmlkZXISEQoEY29kZRgDIAEoCUID4EECEhkKDHJlZGlyZWN0X3VyaRgEIAEoCUID4EECEhoKDWNvZGVfdmVyaWZpZXIYBSABKAlCA+BBASJNChhHZXRMaW5rZWRJZGVudGl0eVJlcXVlc3QSMQoEbmFtZRgBIAEoCUIj4EEC+kEdChttZW1vcy5hcGkudjEvTGlua2VkSWRlbnRpdHkiUAobRGVsZXRlTGlua2VkSWRlbnRpdHlSZXF1ZXN0EjEKBG5hbWUYASABKAlCI+BBAvpBHQobbWVtb3MuYXBpLnYxL0xpbmtlZElkZW50aXR5IocDChNQZXJzb25hbEFjY2Vzc1Rva2VuEhEKBG5hbWUYASABKAlCA+BBCBIYCgtkZXNjcmlwdGlvbhgCIAEoCUID4EEBEjMKCmNyZWF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoKZXhwaXJlc19hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBARI1CgxsYXN0X3VzZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSEwoGc2NvcGVzGAYgAygJQgPgQQM6jAHqQYgBCiBtZW1vcy5hcGkudjEvUGVyc29uYWxBY2Nlc3NUb2tlbhI5dXNlcnMve3VzZXJ9L3BlcnNvbmFsQWNjZXNzVG9rZW5zL3twZXJzb25hbF9hY2Nlc3NfdG9rZW59KhRwZXJzb25hbEFjY2Vzc1Rva2VuczITcGVyc29uYWxBY2Nlc3NUb2tlbiJ9Ch9MaXN0UGVyc29uYWxBY2Nlc3NUb2tlbnNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEikgEKIExpc3RQZXJzb25hbEFjY2Vzc1Rva2Vuc1Jlc3BvbnNlEkEKFnBlcnNvbmFsX2FjY2Vzc190b2tlbnMYASADKAsyIS5tZW1vcy5hcGkudjEuUGVyc29uYWxBY2Nlc3NUb2tlbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSKaAQogQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhgKC2Rlc2NyaXB0aW9uGAIgASgJQgPgQQESHAoPZXhwaXJlc19pbl9kYXlzGAMgASgFQgPgQQESEwoGc2NvcGVzGAQgAygJQgPgQQEidAohQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlc3BvbnNlEkAKFXBlcnNvbmFsX2FjY2Vzc190b2tlbhgBIAEoCzIhLm1lbW9zLmFwaS52MS5QZXJzb25hbEFjY2Vzc1Rva2VuEg0KBXRva2VuGAIgASgJIloKIERlbGV0ZVBlcnNvbmFsQWNjZXNzVG9rZW5SZXF1ZXN0EjYKBG5hbWUYASABKAlCKOBBAvpBIgogbWVtb3MuYXBpLnYxL1BlcnNvbmFsQWNjZXNzVG9rZW4i4QMKB1Nlc3Npb24SEQoEbmFtZRgBIAEoCUID4EEIEjMKCmNyZWF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoKZXhwaXJlc19hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI1CgxsYXN0X3NlZW5fYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSGQoMbGFzdF9zZWVuX2lwGAUgASgJQgPgQQMSOgoLY2xpZW50X2luZm8YBiABKAsyIC5tZW1vcy5hcGkudjEuU2Vzc2lvbi5DbGllbnRJbmZvQgPgQQMSFAoHY3VycmVudBgHIAEoCEID4EEDGmYKCkNsaWVudEluZm8SEgoKdXNlcl9hZ2VudBgBIAEoCRISCgppcF9hZGRyZXNzGAIgASgJEhMKC2RldmljZV90eXBlGAMgASgJEgoKAm9zGAQgASgJEg8KB2Jyb3dzZXIYBSABKAk6TepBSgoUbWVtb3MuYXBpLnYxL1Nlc3Npb24SH3VzZXJzL3t1c2VyfS9zZXNzaW9ucy97c2Vzc2lvbn0qCHNlc3Npb25zMgdzZXNzaW9uIkAKE0xpc3RTZXNzaW9uc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyIj8KFExpc3RTZXNzaW9uc1Jlc3BvbnNlEicKCHNlc3Npb25zGAEgAygLMhUubWVtb3MuYXBpLnYxLlNlc3Npb24iQgoUUmV2b2tlU2Vzc2lvblJlcXVlc3QSKgoEbmFtZRgBIAEoCUIc4EEC+kEWChRtZW1vcy5hcGkudjEvU2Vzc2lvbiJyChhSZXZva2VBbGxTZXNzaW9uc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEisKHmluY2x1ZGVfcGVyc29uYWxfYWNjZXNzX3Rva2VucxgCIAEoCEID4EEBIpUDCgtVc2VyV2ViaG9vaxIMCgRuYW1lGAEgASgJEgsKA3VybBgCIAEoCRIUCgxkaXNwbGF5X25hbWUYAyABKAkSNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNAoLdXBkYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSEwoGc2VjcmV0GAYgASgJQgPgQQMSEAoIZGlzYWJsZWQYByABKAgSGAoLZXZlbnRfdHlwZXMYCCADKAlCA+BBARITCgZmaWx0ZXIYCSABKAlCA+BBARI1CgZmb3JtYXQYCiABKA4yIC5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2suRm9ybWF0QgPgQQEiXAoGRm9ybWF0EhYKEkZPUk1BVF9VTlNQRUNJRklFRBAAEgkKBU1FTU9TEAESCQoFU0xBQ0sQAhILCgdESVNDT1JEEAMSCQoFVEVBTVMQBBIMCghURUxFR1JBTRAFIi4KF0xpc3RVc2VyV2ViaG9va3NSZXF1ZXN0EhMKBnBhcmVudBgBIAEoCUID4EECIkcKGExpc3RVc2VyV2ViaG9va3NSZXNwb25zZRIrCgh3ZWJob29rcxgBIAMoCzIZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9vayJgChhDcmVhdGVVc2VyV2ViaG9va1JlcXVlc3QSEwoGcGFyZW50GAEgASgJQgPgQQISLwoHd2ViaG9vaxgCIAEoCzIZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9va0ID4EECInwKGFVwZGF0ZVVzZXJXZWJob29rUmVxdWVzdBIvCgd3ZWJob29rGAEgASgLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rQgPgQQISLwoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrIi0KGERlbGV0ZVVzZXJXZWJob29rUmVxdWVzdBIRCgRuYW1lGAEgASgJQgPgQQIijAQKD1dlYmhvb2tEZWxpdmVyeRIRCgRuYW1lGAEgASgJQgPgQQgSGgoNYWN0aXZpdHlfdHlwZRgCIAEoCUID4EEDEhAKA3VybBgDIAEoCUID4EEDEjcKBXN0YXRlGAQgASgOMiMubWVtb3MuYXBpLnYxLldlYmhvb2tEZWxpdmVyeS5TdGF0ZUID4EEDEhoKDWF0dGVtcHRfY291bnQYBSABKAVCA+BBAxIYCgtzdGF0dXNfY29kZRgGIAEoBUID4EEDEh0KEHJlc3BvbnNlX3NuaXBwZXQYByABKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI/ChFsYXN0X2F0dGVtcHRfdGltZRgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBA0gAiAEBEj8KEW5leHRfYXR0ZW1wdF90aW1lGAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDSAGIAQEiRgoFU3RhdGUSFQoRU1RBVEVfVU5TUEVDSUZJRUQQABILCgdQRU5ESU5HEAESDQoJU1VDQ0VFREVEEAISCgoGRkFJTEVEEANCFAoSX2xhc3RfYXR0ZW1wdF90aW1lQhQKEl9uZXh0X2F0dGVtcHRfdGltZSJkChxMaXN0V2ViaG9va0RlbGl2ZXJpZXNSZXF1ZXN0EhMKBnBhcmVudBgBIAEoCUID4EECEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJrCh1MaXN0V2ViaG9va0RlbGl2ZXJpZXNSZXNwb25zZRIxCgpkZWxpdmVyaWVzGAEgAygLMh0ubWVtb3MuYXBpLnYxLldlYmhvb2tEZWxpdmVyeRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiLAoXUmVkZWxpdmVyV2ViaG9va1JlcXVlc3QSEQoEbmFtZRgBIAEoCUID4EECIoUPChBVc2VyTm90aWZpY2F0aW9uEhQKBG5hbWUYASABKAlCBuBBA+BBCBIpCgZzZW5kZXIYAiABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL1VzZXISLAoLc2VuZGVyX3VzZXIYCCABKAsyEi5tZW1vcy5hcGkudjEuVXNlckID4EEDEjoKBnN0YXR1cxgDIAEoDjIlLm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uLlN0YXR1c0ID4EEBEjQKC2NyZWF0ZV90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjYKBHR5cGUYBSABKA4yIy5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbi5UeXBlQgPgQQMSTgoMbWVtb19jb21tZW50GAYgASgLMjEubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24uTWVtb0NvbW1lbnRQYXlsb2FkQgPgQQNIABJOCgxtZW1vX21lbnRpb24YByABKAsyMS5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbi5NZW1vTWVudGlvblBheWxvYWRCA+BBA0gAElgKEW1lbW9fY29sbGFib3JhdG9yGAkgASgLMjYubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24uTWVtb0NvbGxhYm9yYXRvclBheWxvYWRCA+BBA0gAElAKDW1lbW9fcmVtaW5kZXIYCiABKAsyMi5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbi5NZW1vUmVtaW5kZXJQYXlsb2FkQgPgQQNIABJQCg1tZW1vX3JlYWN0aW9uGAsgASgLMjIubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24uTWVtb1JlYWN0aW9uUGF5bG9hZEID4EEDSAASVwoRbWVtb190aHJlYWRfcmVwbHkYDCABKAsyNS5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbi5NZW1vVGhyZWFkUmVwbHlQYXlsb2FkQgPgQQNIABJXChFtZW1vX3NoYXJlX29wZW5lZBgNIAEoCzI1Lm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uLk1lbW9TaGFyZU9wZW5lZFBheWxvYWRCA+BBA0gAGmwKEk1lbW9Db21tZW50UGF5bG9hZBIMCgRtZW1vGAEgASgJEhQKDHJlbGF0ZWRfbWVtbxgCIAEoCRIUCgxtZW1vX3NuaXBwZXQYAyABKAkSHAoUcmVsYXRlZF9tZW1vX3NuaXBwZXQYBCABKAkabAoSTWVtb01lbnRpb25QYXlsb2FkEgwKBG1lbW8YASABKAkSFAoMcmVsYXRlZF9tZW1vGAIgASgJEhQKDG1lbW9fc25pcHBldBgDIAEoCRIcChRyZWxhdGVkX21lbW9fc25pcHBldBgEIAEoCRpLChdNZW1vQ29sbGFib3JhdG9yUGF5bG9hZBIMCgRtZW1vGAEgASgJEhQKDG1lbW9fc25pcHBldBgCIAEoCRIMCgRyb2xlGAMgASgJGmcKE01lbW9SZW1pbmRlclBheWxvYWQSDAoEbWVtbxgBIAEoCRIUCgxtZW1vX3NuaXBwZXQYAiABKAkSLAoIZHVlX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wGlAKE01lbW9SZWFjdGlvblBheWxvYWQSDAoEbWVtbxgBIAEoCRIUCgxtZW1vX3NuaXBwZXQYAiABKAkSFQoNcmVhY3Rpb25fdHlwZRgDIAEoCRpwChZNZW1vVGhyZWFkUmVwbHlQYXlsb2FkEgwKBG1lbW8YASABKAkSFAoMcmVsYXRlZF9tZW1vGAIgASgJEhQKDG1lbW9fc25pcHBldBgDIAEoCRIcChRyZWxhdGVkX21lbW9fc25pcHBldBgEIAEoCRpLChZNZW1vU2hhcmVPcGVuZWRQYXlsb2FkEgwKBG1lbW8YASABKAkSFAoMbWVtb19zbmlwcGV0GAIgASgJEg0KBXNoYXJlGAMgASgJIjoKBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABIKCgZVTlJFQUQQARIMCghBUkNISVZFRBACIqsBCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIQCgxNRU1PX0NPTU1FTlQQARIQCgxNRU1PX01FTlRJT04QAhIVChFNRU1PX0NPTExBQk9SQVRPUhADEhEKDU1FTU9fUkVNSU5ERVIQBBIRCg1NRU1PX1JFQUNUSU9OEAUSFQoRTUVNT19USFJFQURfUkVQTFkQBhIVChFNRU1PX1NIQVJFX09QRU5FRBAHOnDqQW0KHW1lbW9zLmFwaS52MS9Vc2VyTm90aWZpY2F0aW9uEil1c2Vycy97dXNlcn0vbm90aWZpY2F0aW9ucy97bm90aWZpY2F0aW9ufRoEbmFtZSoNbm90aWZpY2F0aW9uczIMbm90aWZpY2F0aW9uQgkKB3BheWxvYWQijwEKHExpc3RVc2VyTm90aWZpY2F0aW9uc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBARITCgZmaWx0ZXIYBCABKAlCA+BBASJvCh1MaXN0VXNlck5vdGlmaWNhdGlvbnNSZXNwb25zZRI1Cg1ub3RpZmljYXRpb25zGAEgAygLMh4ubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIpABCh1VcGRhdGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBI5Cgxub3RpZmljYXRpb24YASABKAsyHi5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbkID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECIlQKHURlbGV0ZVVzZXJOb3RpZmljYXRpb25SZXF1ZXN0EjMKBG5hbWUYASABKAlCJeBBAvpBHwodbWVtb3MuYXBpLnYxL1VzZXJOb3RpZmljYXRpb24ikwEKG1NlbmRUZXN0Tm90aWZpY2F0aW9uUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEksKB2NoYW5uZWwYAiABKAsyNS5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuTm90aWZpY2F0aW9uU2V0dGluZy5DaGFubmVsQgPgQQEixwIKFFVzZXJQdXNoU3Vic2NyaXB0aW9uEhEKBG5hbWUYASABKAlCA+BBCBIVCghlbmRwb2ludBgCIAEoCUID4EECEhoKCnAyNTZkaF9rZXkYAyABKAlCBuBBAuBBBBIYCghhdXRoX2tleRgEIAEoCUIG4EEC4EEEEhgKC2Rlc2NyaXB0aW9uGAUgASgJQgPgQQESNAoLY3JlYXRlX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6f+pBfAohbWVtb3MuYXBpLnYxL1VzZXJQdXNoU3Vic2NyaXB0aW9uEjJ1c2Vycy97dXNlcn0vcHVzaFN1YnNjcmlwdGlvbnMve3B1c2hfc3Vic2NyaXB0aW9ufSoRcHVzaFN1YnNjcmlwdGlvbnMyEHB1c2hTdWJzY3JpcHRpb24iTQogTGlzdFVzZXJQdXNoU3Vic2NyaXB0aW9uc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyIn0KIUxpc3RVc2VyUHVzaFN1YnNjcmlwdGlvbnNSZXNwb25zZRI+ChJwdXNoX3N1YnNjcmlwdGlvbnMYASADKAsyIi5tZW1vcy5hcGkudjEuVXNlclB1c2hTdWJzY3JpcHRpb24SGAoQdmFwaWRfcHVibGljX2tleRgCIAEoCSKSAQohQ3JlYXRlVXNlclB1c2hTdWJzY3JpcHRpb25SZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchJCChFwdXNoX3N1YnNjcmlwdGlvbhgCIAEoCzIiLm1lbW9zLmFwaS52MS5Vc2VyUHVzaFN1YnNjcmlwdGlvbkID4EECIlwKIURlbGV0ZVVzZXJQdXNoU3Vic2NyaXB0aW9uUmVxdWVzdBI3CgRuYW1lGAEgASgJQingQQL6QSMKIW1lbW9zLmFwaS52MS9Vc2VyUHVzaFN1YnNjcmlwdGlvbiKBAgoJVXNlckdyb3VwEhEKBG5hbWUYASABKAlCA+BBCBIYCgtkZXNjcmlwdGlvbhgCIAEoCUID4EEBEhkKDG1lbWJlcl9jb3VudBgDIAEoBUID4EEDEjQKC2NyZWF0ZV90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC3VwZGF0ZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOkDqQT0KFm1lbW9zLmFwaS52MS9Vc2VyR3JvdXASDmdyb3Vwcy97Z3JvdXB9GgRuYW1lKgZncm91cHMyBWdyb3VwIuEBCg9Vc2VyR3JvdXBNZW1iZXISFAoEbmFtZRgBIAEoCUIG4EED4EEIEicKBHVzZXIYAiABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL1VzZXISNAoLY3JlYXRlX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6WepBVgocbWVtb3MuYXBpLnYxL1VzZXJHcm91cE1lbWJlchIfZ3JvdXBzL3tncm91cH0vbWVtYmVycy97bWVtYmVyfRoEbmFtZSoHbWVtYmVyczIGbWVtYmVyIhcKFUxpc3RVc2VyR3JvdXBzUmVxdWVzdCJBChZMaXN0VXNlckdyb3Vwc1Jlc3BvbnNlEicKBmdyb3VwcxgBIAMoCzIXLm1lbW9zLmFwaS52MS5Vc2VyR3JvdXAiQwoTR2V0VXNlckdyb3VwUmVxdWVzdBIsCgRuYW1lGAEgASgJQh7gQQL6QRgKFm1lbW9zLmFwaS52MS9Vc2VyR3JvdXAiXAoWQ3JlYXRlVXNlckdyb3VwUmVxdWVzdBIrCgVncm91cBgBIAEoCzIXLm1lbW9zLmFwaS52MS5Vc2VyR3JvdXBCA+BBAhIVCghncm91cF9pZBgCIAEoCUID4EECInsKFlVwZGF0ZVVzZXJHcm91cFJlcXVlc3QSKwoFZ3JvdXAYASABKAsyFy5tZW1vcy5hcGkudjEuVXNlckdyb3VwQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQIiRgoWRGVsZXRlVXNlckdyb3VwUmVxdWVzdBIsCgRuYW1lGAEgASgJQh7gQQL6QRgKFm1lbW9zLmFwaS52MS9Vc2VyR3JvdXAiTQobTGlzdFVzZXJHcm91cE1lbWJlcnNSZXF1ZXN0Ei4KBnBhcmVudBgBIAEoCUIe4EEC+kEYChZtZW1vcy5hcGkudjEvVXNlckdyb3VwIk4KHExpc3RVc2VyR3JvdXBNZW1iZXJzUmVzcG9uc2USLgoHbWVtYmVycxgBIAMoCzIdLm1lbW9zLmFwaS52MS5Vc2VyR3JvdXBNZW1iZXIidAoZQWRkVXNlckdyb3VwTWVtYmVyUmVxdWVzdBIuCgZwYXJlbnQYASABKAlCHuBBAvpBGAoWbWVtb3MuYXBpLnYxL1VzZXJHcm91cBInCgR1c2VyGAIgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyIlIKHFJlbW92ZVVzZXJHcm91cE1lbWJlclJlcXVlc3QSMgoEbmFtZRgBIAEoCUIk4EEC+kEeChxtZW1vcy5hcGkudjEvVXNlckdyb3VwTWVtYmVyMpAyCgtVc2VyU2VydmljZRJjCglMaXN0VXNlcnMSHi5tZW1vcy5hcGkudjEuTGlzdFVzZXJzUmVxdWVzdBofLm1lbW9zLmFwaS52MS5MaXN0VXNlcnNSZXNwb25zZSIVgtPkkwIPEg0vYXBpL3YxL3VzZXJzEnsKDUJhdGNoR2V0VXNlcnMSIi5tZW1vcy5hcGkudjEuQmF0Y2hHZXRVc2Vyc1JlcXVlc3QaIy5tZW1vcy5hcGkudjEuQmF0Y2hHZXRVc2Vyc1Jlc3BvbnNlIiGC0+STAhs6ASoiFi9hcGkvdjEvdXNlcnM6YmF0Y2hHZXQSYgoHR2V0VXNlchIcLm1lbW9zLmFwaS52MS5HZXRVc2VyUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5Vc2VyIiXaQQRuYW1lgtPkkwIYEhYvYXBpL3YxL3tuYW1lPXVzZXJzLyp9EmUKCkNyZWF0ZVVzZXISHy5tZW1vcy5hcGkudjEuQ3JlYXRlVXNlclJlcXVlc3QaEi5tZW1vcy5hcGkudjEuVXNlciIi2kEEdXNlcoLT5JMCFToEdXNlciINL2FwaS92MS91c2VycxJ/CgpVcGRhdGVVc2VyEh8ubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLlVzZXIiPNpBEHVzZXIsdXBkYXRlX21hc2uC0+STAiM6BHVzZXIyGy9hcGkvdjEve3VzZXIubmFtZT11c2Vycy8qfRJsCgpEZWxldGVVc2VyEh8ubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IiXaQQRuYW1lgtPkkwIYKhYvYXBpL3YxL3tuYW1lPXVzZXJzLyp9EnYKClVubG9ja1VzZXISHy5tZW1vcy5hcGkudjEuVW5sb2NrVXNlclJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiL9pBBG5hbWWC0+STAiI6ASoiHS9hcGkvdjEve25hbWU9dXNlcnMvKn06dW5sb2NrEn4KEExpc3RBbGxVc2VyU3RhdHMSJS5tZW1vcy5hcGkudjEuTGlzdEFsbFVzZXJTdGF0c1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdEFsbFVzZXJTdGF0c1Jlc3BvbnNlIhuC0+STAhUSEy9hcGkvdjEvdXNlcnM6c3RhdHMSegoMR2V0VXNlclN0YXRzEiEubWVtb3MuYXBpLnYxLkdldFVzZXJTdGF0c1JlcXVlc3QaFy5tZW1vcy5hcGkudjEuVXNlclN0YXRzIi7aQQRuYW1lgtPkkwIhEh8vYXBpL3YxL3tuYW1lPXVzZXJzLyp9OmdldFN0YXRzEoIBCg5HZXRVc2VyU2V0dGluZxIjLm1lbW9zLmFwaS52MS5HZXRVc2VyU2V0dGluZ1JlcXVlc3QaGS5tZW1vcy5hcGkudjEuVXNlclNldHRpbmciMNpBBG5hbWWC0+STAiMSIS9hcGkvdjEve25hbWU9dXNlcnMvKi9zZXR0aW5ncy8qfRKoAQoRVXBkYXRlVXNlclNldHRpbmcSJi5tZW1vcy5hcGkudjEuVXBkYXRlVXNlclNldHRpbmdSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nIlDaQRNzZXR0aW5nLHVwZGF0ZV9tYXNrgtPkkwI0OgdzZXR0aW5nMikvYXBpL3YxL3tzZXR0aW5nLm5hbWU9dXNlcnMvKi9zZXR0aW5ncy8qfRKVAQoQTGlzdFVzZXJTZXR0aW5ncxIlLm1lbW9zLmFwaS52MS5MaXN0VXNlclNldHRpbmdzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0VXNlclNldHRpbmdzUmVzcG9uc2UiMtpBBnBhcmVudILT5JMCIxIhL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3NldHRpbmdzEqkBChRMaXN0TGlua2VkSWRlbnRpdGllcxIpLm1lbW9zLmFwaS52MS5MaXN0TGlua2VkSWRlbnRpdGllc1JlcXVlc3QaKi5tZW1vcy5hcGkudjEuTGlzdExpbmtlZElkZW50aXRpZXNSZXNwb25zZSI62kEGcGFyZW50gtPkkwIrEikvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vbGlua2VkSWRlbnRpdGllcxKnAQoUQ3JlYXRlTGlua2VkSWRlbnRpdHkSKS5tZW1vcy5hcGkudjEuQ3JlYXRlTGlua2VkSWRlbnRpdHlSZXF1ZXN0GhwubWVtb3MuYXBpLnYxLkxpbmtlZElkZW50aXR5IkbaQQ9wYXJlbnQsaWRwX25hbWWC0+STAi46ASoiKS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9saW5rZWRJZGVudGl0aWVzEpMBChFHZXRMaW5rZWRJZGVudGl0eRImLm1lbW9zLmFwaS52MS5HZXRMaW5rZWRJZGVudGl0eVJlcXVlc3QaHC5tZW1vcy5hcGkudjEuTGlua2VkSWRlbnRpdHkiONpBBG5hbWWC0+STAisSKS9hcGkvdjEve25hbWU9dXNlcnMvKi9saW5rZWRJZGVudGl0aWVzLyp9EpMBChREZWxldGVMaW5rZWRJZGVudGl0eRIpLm1lbW9zLmFwaS52MS5EZWxldGVMaW5rZWRJZGVudGl0eVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiONpBBG5hbWWC0+STAisqKS9hcGkvdjEve25hbWU9dXNlcnMvKi9saW5rZWRJZGVudGl0aWVzLyp9ErkBChhMaXN0UGVyc29uYWxBY2Nlc3NUb2tlbnMSLS5tZW1vcy5hcGkudjEuTGlzdFBlcnNvbmFsQWNjZXNzVG9rZW5zUmVxdWVzdBouLm1lbW9zLmFwaS52MS5MaXN0UGVyc29uYWxBY2Nlc3NUb2tlbnNSZXNwb25zZSI+2kEGcGFyZW50gtPkkwIvEi0vYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vcGVyc29uYWxBY2Nlc3NUb2tlbnMStgEKGUNyZWF0ZVBlcnNvbmFsQWNjZXNzVG9rZW4SLi5tZW1vcy5hcGkudjEuQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlcXVlc3QaLy5tZW1vcy5hcGkudjEuQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlc3BvbnNlIjiC0+STAjI6ASoiLS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9wZXJzb25hbEFjY2Vzc1Rva2VucxKhAQoZRGVsZXRlUGVyc29uYWxBY2Nlc3NUb2tlbhIuLm1lbW9zLmFwaS52MS5EZWxldGVQZXJzb25hbEFjY2Vzc1Rva2VuUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSI82kEEbmFtZYLT5JMCLyotL2FwaS92MS97bmFtZT11c2Vycy8qL3BlcnNvbmFsQWNjZXNzVG9rZW5zLyp9EokBCgxMaXN0U2Vzc2lvbnMSIS5tZW1vcy5hcGkudjEuTGlzdFNlc3Npb25zUmVxdWVzdBoiLm1lbW9zLmFwaS52MS5MaXN0U2Vzc2lvbnNSZXNwb25zZSIy2kEGcGFyZW50gtPkkwIjEiEvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vc2Vzc2lvbnMSfQoNUmV2b2tlU2Vzc2lvbhIiLm1lbW9zLmFwaS52MS5SZXZva2VTZXNzaW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIw2kEEbmFtZYLT5JMCIyohL2FwaS92MS97bmFtZT11c2Vycy8qL3Nlc3Npb25zLyp9EpQBChFSZXZva2VBbGxTZXNzaW9ucxImLm1lbW9zLmFwaS52MS5SZXZva2VBbGxTZXNzaW9uc1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiP9pBBnBhcmVudILT5JMCMDoBKiIrL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3Nlc3Npb25zOnJldm9rZUFsbBKVAQoQTGlzdFVzZXJXZWJob29rcxIlLm1lbW9zLmFwaS52MS5MaXN0VXNlcldlYmhvb2tzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0VXNlcldlYmhvb2tzUmVzcG9uc2UiMtpBBnBhcmVudILT5JMCIxIhL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3dlYmhvb2tzEpsBChFDcmVhdGVVc2VyV2ViaG9vaxImLm1lbW9zLmFwaS52MS5DcmVhdGVVc2VyV2ViaG9va1JlcXVlc3QaGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2siQ9pBDnBhcmVudCx3ZWJob29rgtPkkwIsOgd3ZWJob29rIiEvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vd2ViaG9va3MSqAEKEVVwZGF0ZVVzZXJXZWJob29rEiYubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJXZWJob29rUmVxdWVzdBoZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9vayJQ2kETd2ViaG9vayx1cGRhdGVfbWFza4LT5JMCNDoHd2ViaG9vazIpL2FwaS92MS97d2ViaG9vay5uYW1lPXVzZXJzLyovd2ViaG9va3MvKn0ShQEKEURlbGV0ZVVzZXJXZWJob29rEiYubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJXZWJob29rUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIw2kEEbmFtZYLT5JMCIyohL2FwaS92MS97bmFtZT11c2Vycy8qL3dlYmhvb2tzLyp9ErEBChVMaXN0V2ViaG9va0RlbGl2ZXJpZXMSKi5tZW1vcy5hcGkudjEuTGlzdFdlYmhvb2tEZWxpdmVyaWVzUmVxdWVzdBorLm1lbW9zLmFwaS52MS5MaXN0V2ViaG9va0RlbGl2ZXJpZXNSZXNwb25zZSI/2kEGcGFyZW50gtPkkwIwEi4vYXBpL3YxL3twYXJlbnQ9dXNlcnMvKi93ZWJob29rcy8qfS9kZWxpdmVyaWVzEqQBChBSZWRlbGl2ZXJXZWJob29rEiUubWVtb3MuYXBpLnYxLlJlZGVsaXZlcldlYmhvb2tSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLldlYmhvb2tEZWxpdmVyeSJK2kEEbmFtZYLT5JMCPToBKiI4L2FwaS92MS97bmFtZT11c2Vycy8qL3dlYmhvb2tzLyovZGVsaXZlcmllcy8qfTpyZWRlbGl2ZXISqQEKFUxpc3RVc2VyTm90aWZpY2F0aW9ucxIqLm1lbW9zLmFwaS52MS5MaXN0VXNlck5vdGlmaWNhdGlvbnNSZXF1ZXN0GisubWVtb3MuYXBpLnYxLkxpc3RVc2VyTm90aWZpY2F0aW9uc1Jlc3BvbnNlIjfaQQZwYXJlbnSC0+STAigSJi9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9ub3RpZmljYXRpb25zEssBChZVcGRhdGVVc2VyTm90aWZpY2F0aW9uEisubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJOb3RpZmljYXRpb25SZXF1ZXN0Gh4ubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24iZNpBGG5vdGlmaWNhdGlvbix1cGRhdGVfbWFza4LT5JMCQzoMbm90aWZpY2F0aW9uMjMvYXBpL3YxL3tub3RpZmljYXRpb24ubmFtZT11c2Vycy8qL25vdGlmaWNhdGlvbnMvKn0SlAEKFkRlbGV0ZVVzZXJOb3RpZmljYXRpb24SKy5tZW1vcy5hcGkudjEuRGVsZXRlVXNlck5vdGlmaWNhdGlvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiNdpBBG5hbWWC0+STAigqJi9hcGkvdjEve25hbWU9dXNlcnMvKi9ub3RpZmljYXRpb25zLyp9EpgBChRTZW5kVGVzdE5vdGlmaWNhdGlvbhIpLm1lbW9zLmFwaS52MS5TZW5kVGVzdE5vdGlmaWNhdGlvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiPdpBBG5hbWWC0+STAjA6ASoiKy9hcGkvdjEve25hbWU9dXNlcnMvKn06c2VuZFRlc3ROb3RpZmljYXRpb24SuQEKGUxpc3RVc2VyUHVzaFN1YnNjcmlwdGlvbnMSLi5tZW1vcy5hcGkudjEuTGlzdFVzZXJQdXNoU3Vic2NyaXB0aW9uc1JlcXVlc3QaLy5tZW1vcy5hcGkudjEuTGlzdFVzZXJQdXNoU3Vic2NyaXB0aW9uc1Jlc3BvbnNlIjvaQQZwYXJlbnSC0+STAiwSKi9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9wdXNoU3Vic2NyaXB0aW9ucxLTAQoaQ3JlYXRlVXNlclB1c2hTdWJzY3JpcHRpb24SLy5tZW1vcy5hcGkudjEuQ3JlYXRlVXNlclB1c2hTdWJzY3JpcHRpb25SZXF1ZXN0GiIubWVtb3MuYXBpLnYxLlVzZXJQdXNoU3Vic2NyaXB0aW9uImDaQRhwYXJlbnQscHVzaF9zdWJzY3JpcHRpb26C0+STAj86EXB1c2hfc3Vic2NyaXB0aW9uIiovYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vcHVzaFN1YnNjcmlwdGlvbnMSoAEKGkRlbGV0ZVVzZXJQdXNoU3Vic2NyaXB0aW9uEi8ubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJQdXNoU3Vic2NyaXB0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSI52kEEbmFtZYLT5JMCLCoqL2FwaS92MS97bmFtZT11c2Vycy8qL3B1c2hTdWJzY3JpcHRpb25zLyp9EnMKDkxpc3RVc2VyR3JvdXBzEiMubWVtb3MuYXBpLnYxLkxpc3RVc2VyR3JvdXBzUmVxdWVzdBokLm1lbW9zLmFwaS52MS5MaXN0VXNlckdyb3Vwc1Jlc3BvbnNlIhaC0+STAhASDi9hcGkvdjEvZ3JvdXBzEnIKDEdldFVzZXJHcm91cBIhLm1lbW9zLmFwaS52MS5HZXRVc2VyR3JvdXBSZXF1ZXN0GhcubWVtb3MuYXBpLnYxLlVzZXJHcm91cCIm2kEEbmFtZYLT5JMCGRIXL2FwaS92MS97bmFtZT1ncm91cHMvKn0SgAEKD0NyZWF0ZVVzZXJHcm91cBIkLm1lbW9zLmFwaS52MS5DcmVhdGVVc2VyR3JvdXBSZXF1ZXN0GhcubWVtb3MuYXBpLnYxLlVzZXJHcm91cCIu2kEOZ3JvdXAsZ3JvdXBfaWSC0+STAhc6BWdyb3VwIg4vYXBpL3YxL2dyb3VwcxKSAQoPVXBkYXRlVXNlckdyb3VwEiQubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJHcm91cFJlcXVlc3QaFy5tZW1vcy5hcGkudjEuVXNlckdyb3VwIkDaQRFncm91cCx1cGRhdGVfbWFza4LT5JMCJjoFZ3JvdXAyHS9hcGkvdjEve2dyb3VwLm5hbWU9Z3JvdXBzLyp9EncKD0RlbGV0ZVVzZXJHcm91cBIkLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyR3JvdXBSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IibaQQRuYW1lgtPkkwIZKhcvYXBpL3YxL3tuYW1lPWdyb3Vwcy8qfRKhAQoUTGlzdFVzZXJHcm91cE1lbWJlcnMSKS5tZW1vcy5hcGkudjEuTGlzdFVzZXJHcm91cE1lbWJlcnNSZXF1ZXN0GioubWVtb3MuYXBpLnYxLkxpc3RVc2VyR3JvdXBNZW1iZXJzUmVzcG9uc2UiMtpBBnBhcmVudILT5JMCIxIhL2FwaS92MS97cGFyZW50PWdyb3Vwcy8qfS9tZW1iZXJzEpgBChJBZGRVc2VyR3JvdXBNZW1iZXISJy5tZW1vcy5hcGkudjEuQWRkVXNlckdyb3VwTWVtYmVyUmVxdWVzdBodLm1lbW9zLmFwaS52MS5Vc2VyR3JvdXBNZW1iZXIiOtpBC3BhcmVudCx1c2VygtPkkwImOgEqIiEvYXBpL3YxL3twYXJlbnQ9Z3JvdXBzLyp9L21lbWJlcnMSjQEKFVJlbW92ZVVzZXJHcm91cE1lbWJlchIqLm1lbW9zLmFwaS52MS5SZW1vdmVVc2VyR3JvdXBNZW1iZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjDaQQRuYW1lgtPkkwIjKiEvYXBpL3YxL3tuYW1lPWdyb3Vwcy8qL21lbWJlcnMvKn1CqAEKEGNvbS5tZW1vcy5hcGkudjFCEFVzZXJTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.User
@@ -101,6 +101,22 @@ export type User = Message<"memos.api.v1.User"> & {
    * @generated from field: google.protobuf.Timestamp update_time = 11;
    */
   updateTime?: Timestamp | undefined;
+
+  /**
+   * Output only. Whether the user confirmed ownership of the email.
+   *
+   * @generated from field: bool email_verified = 12;
+   */
+  emailVerified: boolean;
+
+  /**
+   * Optional. The custom role of the user, which grants its permissions on top of
+   * the USER role. Only admins can change it; setting role clears it.
+   * Format: roles/{role}
+   *
+   * @generated from field: string custom_role = 13;
+   */
+  customRole: string;
 };
 
 /**
@@ -319,6 +335,14 @@ export type CreateUserRequest = Message<"memos.api.v1.CreateUserRequest"> & {
    * @generated from field: string request_id = 4;
    */
   requestId: string;
+
+  /**
+   * Optional. An invitation code that allows registering while user
+   * registration is disabled. The invitation determines the role of the user.
+   *
+   * @generated from field: string invitation_code = 5;
+   */
+  invitationCode: string;
 };
 
 /**
@@ -388,6 +412,26 @@ export type DeleteUserRequest = Message<"memos.api.v1.DeleteUserRequest"> & {
 export const DeleteUserRequestSchema: GenMessage<DeleteUserRequest> = /*@__PURE__*/
   messageDesc(file_api_v1_user_service, 8);
 
+/**
+ * @generated from message memos.api.v1.UnlockUserRequest
+ */
+export type UnlockUserRequest = Message<"memos.api.v1.UnlockUserRequest"> & {
+  /**
+   * Required. The resource name of the user to unlock.
+   * Format: users/{user}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UnlockUserRequest.
+ * Use `create(UnlockUserRequestSchema)` to create a new message.
+ */
+export const UnlockUserRequestSchema: GenMessage<UnlockUserRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 9);
+
 /**
  * User statistics messages
  *
@@ -452,7 +496,7 @@ export type UserStats = Message<"memos.api.v1.UserStats"> & {
  * Use `create(UserStatsSchema)` to create a new message.
  */
 export const UserStatsSchema: GenMessage<UserStats> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 9);
+  messageDesc(file_api_v1_user_service, 10);
 
 /**
  * Memo type statistics.
@@ -486,7 +530,7 @@ export type UserStats_MemoTypeStats = Message<"memos.api.v1.UserStats.MemoTypeSt
  * Use `create(UserStats_MemoTypeStatsSchema)` to create a new message.
  */
 export const UserStats_MemoTypeStatsSchema: GenMessage<UserStats_MemoTypeStats> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 9, 0);
+  messageDesc(file_api_v1_user_service, 10, 0);
 
 /**
  * @generated from message memos.api.v1.GetUserStatsRequest
@@ -506,7 +550,7 @@ export type GetUserStatsRequest = Message<"memos.api.v1.GetUserStatsRequest"> &
  * Use `create(GetUserStatsRequestSchema)` to create a new message.
  */
 export const GetUserStatsRequestSchema: GenMessage<GetUserStatsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 10);
+  messageDesc(file_api_v1_user_service, 11);
 
 /**
  * @generated from message memos.api.v1.ListAllUserStatsRequest
@@ -533,7 +577,7 @@ export type ListAllUserStatsRequest = Message<"memos.api.v1.ListAllUserStatsRequ
  * Use `create(ListAllUserStatsRequestSchema)` to create a new message.
  */
 export const ListAllUserStatsRequestSchema: GenMessage<ListAllUserStatsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 11);
+  messageDesc(file_api_v1_user_service, 12);
 
 /**
  * @generated from message memos.api.v1.ListAllUserStatsResponse
@@ -552,7 +596,7 @@ export type ListAllUserStatsResponse = Message<"memos.api.v1.ListAllUserStatsRes
  * Use `create(ListAllUserStatsResponseSchema)` to create a new message.
  */
 export const ListAllUserStatsResponseSchema: GenMessage<ListAllUserStatsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 12);
+  messageDesc(file_api_v1_user_service, 13);
 
 /**
  * User settings message
@@ -584,6 +628,12 @@ export type UserSetting = Message<"memos.api.v1.UserSetting"> & {
      */
     value: UserSetting_WebhooksSetting;
     case: "webhooksSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserSetting.NotificationSetting notification_setting = 6;
+     */
+    value: UserSetting_NotificationSetting;
+    case: "notificationSetting";
   } | { case: undefined; value?: undefined };
 };
 
@@ -592,7 +642,7 @@ export type UserSetting = Message<"memos.api.v1.UserSetting"> & {
  * Use `create(UserSettingSchema)` to create a new message.
  */
 export const UserSettingSchema: GenMessage<UserSetting> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 13);
+  messageDesc(file_api_v1_user_service, 14);
 
 /**
  * General user settings configuration.
@@ -622,6 +672,14 @@ export type UserSetting_GeneralSetting = Message<"memos.api.v1.UserSetting.Gener
    * @generated from field: string theme = 4;
    */
   theme: string;
+
+  /**
+   * The notification types the user opted out of. Muted notifications are
+   * neither added to the inbox nor emailed.
+   *
+   * @generated from field: repeated memos.api.v1.UserNotification.Type muted_notification_types = 5;
+   */
+  mutedNotificationTypes: UserNotification_Type[];
 };
 
 /**
@@ -629,7 +687,7 @@ export type UserSetting_GeneralSetting = Message<"memos.api.v1.UserSetting.Gener
  * Use `create(UserSetting_GeneralSettingSchema)` to create a new message.
  */
 export const UserSetting_GeneralSettingSchema: GenMessage<UserSetting_GeneralSetting> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 13, 0);
+  messageDesc(file_api_v1_user_service, 14, 0);
 
 /**
  * User webhooks configuration.
@@ -650,7 +708,188 @@ export type UserSetting_WebhooksSetting = Message<"memos.api.v1.UserSetting.Webh
  * Use `create(UserSetting_WebhooksSettingSchema)` to create a new message.
  */
 export const UserSetting_WebhooksSettingSchema: GenMessage<UserSetting_WebhooksSetting> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 13, 1);
+  messageDesc(file_api_v1_user_service, 14, 1);
+
+/**
+ * Notification delivery preferences.
+ *
+ * @generated from message memos.api.v1.UserSetting.NotificationSetting
+ */
+export type UserSetting_NotificationSetting = Message<"memos.api.v1.UserSetting.NotificationSetting"> & {
+  /**
+   * The email delivery preferences. Types without a preference are emailed immediately.
+   *
+   * @generated from field: repeated memos.api.v1.UserSetting.NotificationSetting.Preference preferences = 1;
+   */
+  preferences: UserSetting_NotificationSetting_Preference[];
+
+  /**
+   * The push services notifications are delivered to, in addition to email.
+   *
+   * @generated from field: repeated memos.api.v1.UserSetting.NotificationSetting.Channel channels = 2;
+   */
+  channels: UserSetting_NotificationSetting_Channel[];
+
+  /**
+   * Whether to receive a weekly recap email with memos from the same date in past years,
+   * this week's activity and open tasks.
+   *
+   * @generated from field: bool weekly_recap = 3;
+   */
+  weeklyRecap: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.UserSetting.NotificationSetting.
+ * Use `create(UserSetting_NotificationSettingSchema)` to create a new message.
+ */
+export const UserSetting_NotificationSettingSchema: GenMessage<UserSetting_NotificationSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 14, 2);
+
+/**
+ * The email delivery preference for a notification type.
+ *
+ * @generated from message memos.api.v1.UserSetting.NotificationSetting.Preference
+ */
+export type UserSetting_NotificationSetting_Preference = Message<"memos.api.v1.UserSetting.NotificationSetting.Preference"> & {
+  /**
+   * @generated from field: memos.api.v1.UserNotification.Type type = 1;
+   */
+  type: UserNotification_Type;
+
+  /**
+   * @generated from field: memos.api.v1.UserSetting.NotificationSetting.EmailDelivery email_delivery = 2;
+   */
+  emailDelivery: UserSetting_NotificationSetting_EmailDelivery;
+};
+
+/**
+ * Describes the message memos.api.v1.UserSetting.NotificationSetting.Preference.
+ * Use `create(UserSetting_NotificationSetting_PreferenceSchema)` to create a new message.
+ */
+export const UserSetting_NotificationSetting_PreferenceSchema: GenMessage<UserSetting_NotificationSetting_Preference> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 14, 2, 0);
+
+/**
+ * A self-hosted push service that inbox notifications and reminders are also delivered to.
+ *
+ * @generated from message memos.api.v1.UserSetting.NotificationSetting.Channel
+ */
+export type UserSetting_NotificationSetting_Channel = Message<"memos.api.v1.UserSetting.NotificationSetting.Channel"> & {
+  /**
+   * @generated from field: memos.api.v1.UserSetting.NotificationSetting.Channel.Type type = 1;
+   */
+  type: UserSetting_NotificationSetting_Channel_Type;
+
+  /**
+   * For ntfy, the topic URL, e.g. https://ntfy.sh/my-topic.
+   * For Gotify, the server URL.
+   * For Apprise, the notify URL of the API, e.g. https://apprise.example.com/notify/my-key.
+   *
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * The ntfy access token (optional) or the Gotify application token (required).
+   * It is never returned; leave it empty to keep the token of the channel with the same type and URL.
+   *
+   * @generated from field: string token = 3;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UserSetting.NotificationSetting.Channel.
+ * Use `create(UserSetting_NotificationSetting_ChannelSchema)` to create a new message.
+ */
+export const UserSetting_NotificationSetting_ChannelSchema: GenMessage<UserSetting_NotificationSetting_Channel> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 14, 2, 1);
+
+/**
+ * @generated from enum memos.api.v1.UserSetting.NotificationSetting.Channel.Type
+ */
+export enum UserSetting_NotificationSetting_Channel_Type {
+  /**
+   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   */
+  TYPE_UNSPECIFIED = 0,
+
+  /**
+   * ntfy (https://ntfy.sh).
+   *
+   * @generated from enum value: NTFY = 1;
+   */
+  NTFY = 1,
+
+  /**
+   * Gotify (https://gotify.net).
+   *
+   * @generated from enum value: GOTIFY = 2;
+   */
+  GOTIFY = 2,
+
+  /**
+   * Apprise API (https://github.com/caronc/apprise-api).
+   *
+   * @generated from enum value: APPRISE = 3;
+   */
+  APPRISE = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserSetting.NotificationSetting.Channel.Type.
+ */
+export const UserSetting_NotificationSetting_Channel_TypeSchema: GenEnum<UserSetting_NotificationSetting_Channel_Type> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 14, 2, 1, 0);
+
+/**
+ * How notification emails are delivered.
+ *
+ * @generated from enum memos.api.v1.UserSetting.NotificationSetting.EmailDelivery
+ */
+export enum UserSetting_NotificationSetting_EmailDelivery {
+  /**
+   * Unspecified is treated as IMMEDIATE.
+   *
+   * @generated from enum value: EMAIL_DELIVERY_UNSPECIFIED = 0;
+   */
+  EMAIL_DELIVERY_UNSPECIFIED = 0,
+
+  /**
+   * Email each notification as soon as it is created.
+   *
+   * @generated from enum value: IMMEDIATE = 1;
+   */
+  IMMEDIATE = 1,
+
+  /**
+   * Batch unread notifications into an hourly digest email.
+   *
+   * @generated from enum value: HOURLY_DIGEST = 2;
+   */
+  HOURLY_DIGEST = 2,
+
+  /**
+   * Batch unread notifications into a daily digest email.
+   *
+   * @generated from enum value: DAILY_DIGEST = 3;
+   */
+  DAILY_DIGEST = 3,
+
+  /**
+   * Never email the notification.
+   *
+   * @generated from enum value: OFF = 4;
+   */
+  OFF = 4,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserSetting.NotificationSetting.EmailDelivery.
+ */
+export const UserSetting_NotificationSetting_EmailDeliverySchema: GenEnum<UserSetting_NotificationSetting_EmailDelivery> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 14, 2, 0);
 
 /**
  * Enumeration of user setting keys.
@@ -676,13 +915,20 @@ export enum UserSetting_Key {
    * @generated from enum value: WEBHOOKS = 4;
    */
   WEBHOOKS = 4,
+
+  /**
+   * NOTIFICATION is the key for notification delivery preferences.
+   *
+   * @generated from enum value: NOTIFICATION = 5;
+   */
+  NOTIFICATION = 5,
 }
 
 /**
  * Describes the enum memos.api.v1.UserSetting.Key.
  */
 export const UserSetting_KeySchema: GenEnum<UserSetting_Key> = /*@__PURE__*/
-  enumDesc(file_api_v1_user_service, 13, 0);
+  enumDesc(file_api_v1_user_service, 14, 0);
 
 /**
  * @generated from message memos.api.v1.GetUserSettingRequest
@@ -702,7 +948,7 @@ export type GetUserSettingRequest = Message<"memos.api.v1.GetUserSettingRequest"
  * Use `create(GetUserSettingRequestSchema)` to create a new message.
  */
 export const GetUserSettingRequestSchema: GenMessage<GetUserSettingRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 14);
+  messageDesc(file_api_v1_user_service, 15);
 
 /**
  * @generated from message memos.api.v1.UpdateUserSettingRequest
@@ -728,7 +974,7 @@ export type UpdateUserSettingRequest = Message<"memos.api.v1.UpdateUserSettingRe
  * Use `create(UpdateUserSettingRequestSchema)` to create a new message.
  */
 export const UpdateUserSettingRequestSchema: GenMessage<UpdateUserSettingRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 15);
+  messageDesc(file_api_v1_user_service, 16);
 
 /**
  * Request message for ListUserSettings method.
@@ -768,7 +1014,7 @@ export type ListUserSettingsRequest = Message<"memos.api.v1.ListUserSettingsRequ
  * Use `create(ListUserSettingsRequestSchema)` to create a new message.
  */
 export const ListUserSettingsRequestSchema: GenMessage<ListUserSettingsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 16);
+  messageDesc(file_api_v1_user_service, 17);
 
 /**
  * Response message for ListUserSettings method.
@@ -804,7 +1050,7 @@ export type ListUserSettingsResponse = Message<"memos.api.v1.ListUserSettingsRes
  * Use `create(ListUserSettingsResponseSchema)` to create a new message.
  */
 export const ListUserSettingsResponseSchema: GenMessage<ListUserSettingsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 17);
+  messageDesc(file_api_v1_user_service, 18);
 
 /**
  * LinkedIdentity represents an SSO identity linked to a user account.
@@ -841,7 +1087,7 @@ export type LinkedIdentity = Message<"memos.api.v1.LinkedIdentity"> & {
  * Use `create(LinkedIdentitySchema)` to create a new message.
  */
 export const LinkedIdentitySchema: GenMessage<LinkedIdentity> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 18);
+  messageDesc(file_api_v1_user_service, 19);
 
 /**
  * @generated from message memos.api.v1.ListLinkedIdentitiesRequest
@@ -861,7 +1107,7 @@ export type ListLinkedIdentitiesRequest = Message<"memos.api.v1.ListLinkedIdenti
  * Use `create(ListLinkedIdentitiesRequestSchema)` to create a new message.
  */
 export const ListLinkedIdentitiesRequestSchema: GenMessage<ListLinkedIdentitiesRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 19);
+  messageDesc(file_api_v1_user_service, 20);
 
 /**
  * @generated from message memos.api.v1.ListLinkedIdentitiesResponse
@@ -880,7 +1126,7 @@ export type ListLinkedIdentitiesResponse = Message<"memos.api.v1.ListLinkedIdent
  * Use `create(ListLinkedIdentitiesResponseSchema)` to create a new message.
  */
 export const ListLinkedIdentitiesResponseSchema: GenMessage<ListLinkedIdentitiesResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 20);
+  messageDesc(file_api_v1_user_service, 21);
 
 /**
  * @generated from message memos.api.v1.CreateLinkedIdentityRequest
@@ -929,7 +1175,7 @@ export type CreateLinkedIdentityRequest = Message<"memos.api.v1.CreateLinkedIden
  * Use `create(CreateLinkedIdentityRequestSchema)` to create a new message.
  */
 export const CreateLinkedIdentityRequestSchema: GenMessage<CreateLinkedIdentityRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 21);
+  messageDesc(file_api_v1_user_service, 22);
 
 /**
  * @generated from message memos.api.v1.GetLinkedIdentityRequest
@@ -949,7 +1195,7 @@ export type GetLinkedIdentityRequest = Message<"memos.api.v1.GetLinkedIdentityRe
  * Use `create(GetLinkedIdentityRequestSchema)` to create a new message.
  */
 export const GetLinkedIdentityRequestSchema: GenMessage<GetLinkedIdentityRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 22);
+  messageDesc(file_api_v1_user_service, 23);
 
 /**
  * @generated from message memos.api.v1.DeleteLinkedIdentityRequest
@@ -969,7 +1215,7 @@ export type DeleteLinkedIdentityRequest = Message<"memos.api.v1.DeleteLinkedIden
  * Use `create(DeleteLinkedIdentityRequestSchema)` to create a new message.
  */
 export const DeleteLinkedIdentityRequestSchema: GenMessage<DeleteLinkedIdentityRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 23);
+  messageDesc(file_api_v1_user_service, 24);
 
 /**
  * PersonalAccessToken represents a long-lived token for API/script access.
@@ -1013,6 +1259,14 @@ export type PersonalAccessToken = Message<"memos.api.v1.PersonalAccessToken"> &
    * @generated from field: google.protobuf.Timestamp last_used_at = 5;
    */
   lastUsedAt?: Timestamp | undefined;
+
+  /**
+   * Output only. The scopes granted to the token.
+   * An empty list means the token has full access to the account.
+   *
+   * @generated from field: repeated string scopes = 6;
+   */
+  scopes: string[];
 };
 
 /**
@@ -1020,7 +1274,7 @@ export type PersonalAccessToken = Message<"memos.api.v1.PersonalAccessToken"> &
  * Use `create(PersonalAccessTokenSchema)` to create a new message.
  */
 export const PersonalAccessTokenSchema: GenMessage<PersonalAccessToken> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 24);
+  messageDesc(file_api_v1_user_service, 25);
 
 /**
  * @generated from message memos.api.v1.ListPersonalAccessTokensRequest
@@ -1054,7 +1308,7 @@ export type ListPersonalAccessTokensRequest = Message<"memos.api.v1.ListPersonal
  * Use `create(ListPersonalAccessTokensRequestSchema)` to create a new message.
  */
 export const ListPersonalAccessTokensRequestSchema: GenMessage<ListPersonalAccessTokensRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 25);
+  messageDesc(file_api_v1_user_service, 26);
 
 /**
  * @generated from message memos.api.v1.ListPersonalAccessTokensResponse
@@ -1087,7 +1341,7 @@ export type ListPersonalAccessTokensResponse = Message<"memos.api.v1.ListPersona
  * Use `create(ListPersonalAccessTokensResponseSchema)` to create a new message.
  */
 export const ListPersonalAccessTokensResponseSchema: GenMessage<ListPersonalAccessTokensResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 26);
+  messageDesc(file_api_v1_user_service, 27);
 
 /**
  * @generated from message memos.api.v1.CreatePersonalAccessTokenRequest
@@ -1114,6 +1368,16 @@ export type CreatePersonalAccessTokenRequest = Message<"memos.api.v1.CreatePerso
    * @generated from field: int32 expires_in_days = 3;
    */
   expiresInDays: number;
+
+  /**
+   * Optional. The scopes to grant to the token.
+   * Supported values: memos:read, memos:write, attachments:read,
+   * attachments:write, settings:read, settings:write, admin.
+   * An empty list grants full access to the account.
+   *
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[];
 };
 
 /**
@@ -1121,7 +1385,7 @@ export type CreatePersonalAccessTokenRequest = Message<"memos.api.v1.CreatePerso
  * Use `create(CreatePersonalAccessTokenRequestSchema)` to create a new message.
  */
 export const CreatePersonalAccessTokenRequestSchema: GenMessage<CreatePersonalAccessTokenRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 27);
+  messageDesc(file_api_v1_user_service, 28);
 
 /**
  * @generated from message memos.api.v1.CreatePersonalAccessTokenResponse
@@ -1148,7 +1412,7 @@ export type CreatePersonalAccessTokenResponse = Message<"memos.api.v1.CreatePers
  * Use `create(CreatePersonalAccessTokenResponseSchema)` to create a new message.
  */
 export const CreatePersonalAccessTokenResponseSchema: GenMessage<CreatePersonalAccessTokenResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 28);
+  messageDesc(file_api_v1_user_service, 29);
 
 /**
  * @generated from message memos.api.v1.DeletePersonalAccessTokenRequest
@@ -1168,157 +1432,1197 @@ export type DeletePersonalAccessTokenRequest = Message<"memos.api.v1.DeletePerso
  * Use `create(DeletePersonalAccessTokenRequestSchema)` to create a new message.
  */
 export const DeletePersonalAccessTokenRequestSchema: GenMessage<DeletePersonalAccessTokenRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 29);
+  messageDesc(file_api_v1_user_service, 30);
 
 /**
- * UserWebhook represents a webhook owned by a user.
+ * Session represents an active sign-in session of a user.
+ * A session is backed by a refresh token, so its identifier changes each time
+ * the token is rotated while its creation time and client info are preserved.
  *
- * @generated from message memos.api.v1.UserWebhook
+ * @generated from message memos.api.v1.Session
  */
-export type UserWebhook = Message<"memos.api.v1.UserWebhook"> & {
+export type Session = Message<"memos.api.v1.Session"> & {
   /**
-   * The name of the webhook.
-   * Format: users/{user}/webhooks/{webhook}
+   * The resource name of the session.
+   * Format: users/{user}/sessions/{session}
    *
    * @generated from field: string name = 1;
    */
   name: string;
 
   /**
-   * The URL to send the webhook to.
+   * Output only. When the session was created.
    *
-   * @generated from field: string url = 2;
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
    */
-  url: string;
+  createdAt?: Timestamp | undefined;
 
   /**
-   * Optional. Human-readable name for the webhook.
+   * Output only. When the session expires unless it is refreshed.
    *
-   * @generated from field: string display_name = 3;
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
    */
-  displayName: string;
+  expiresAt?: Timestamp | undefined;
 
   /**
-   * The creation time of the webhook.
+   * Output only. When the session was last refreshed.
    *
-   * @generated from field: google.protobuf.Timestamp create_time = 4;
+   * @generated from field: google.protobuf.Timestamp last_seen_at = 4;
    */
-  createTime?: Timestamp | undefined;
+  lastSeenAt?: Timestamp | undefined;
 
   /**
-   * The last update time of the webhook.
+   * Output only. The IP address of the client at the last refresh.
    *
-   * @generated from field: google.protobuf.Timestamp update_time = 5;
+   * @generated from field: string last_seen_ip = 5;
    */
-  updateTime?: Timestamp | undefined;
-};
+  lastSeenIp: string;
 
-/**
- * Describes the message memos.api.v1.UserWebhook.
- * Use `create(UserWebhookSchema)` to create a new message.
- */
-export const UserWebhookSchema: GenMessage<UserWebhook> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 30);
+  /**
+   * Output only. Information about the client that signed in.
+   *
+   * @generated from field: memos.api.v1.Session.ClientInfo client_info = 6;
+   */
+  clientInfo?: Session_ClientInfo | undefined;
 
-/**
- * @generated from message memos.api.v1.ListUserWebhooksRequest
- */
-export type ListUserWebhooksRequest = Message<"memos.api.v1.ListUserWebhooksRequest"> & {
   /**
-   * The parent user resource.
-   * Format: users/{user}
+   * Output only. Whether this is the session making the request.
    *
-   * @generated from field: string parent = 1;
+   * @generated from field: bool current = 7;
    */
-  parent: string;
+  current: boolean;
 };
 
 /**
- * Describes the message memos.api.v1.ListUserWebhooksRequest.
- * Use `create(ListUserWebhooksRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
  */
-export const ListUserWebhooksRequestSchema: GenMessage<ListUserWebhooksRequest> = /*@__PURE__*/
+export const SessionSchema: GenMessage<Session> = /*@__PURE__*/
   messageDesc(file_api_v1_user_service, 31);
 
 /**
- * @generated from message memos.api.v1.ListUserWebhooksResponse
+ * @generated from message memos.api.v1.Session.ClientInfo
  */
-export type ListUserWebhooksResponse = Message<"memos.api.v1.ListUserWebhooksResponse"> & {
+export type Session_ClientInfo = Message<"memos.api.v1.Session.ClientInfo"> & {
   /**
-   * The list of webhooks.
+   * The user agent string of the client.
    *
-   * @generated from field: repeated memos.api.v1.UserWebhook webhooks = 1;
+   * @generated from field: string user_agent = 1;
    */
-  webhooks: UserWebhook[];
-};
-
-/**
- * Describes the message memos.api.v1.ListUserWebhooksResponse.
- * Use `create(ListUserWebhooksResponseSchema)` to create a new message.
- */
-export const ListUserWebhooksResponseSchema: GenMessage<ListUserWebhooksResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 32);
+  userAgent: string;
 
-/**
- * @generated from message memos.api.v1.CreateUserWebhookRequest
- */
-export type CreateUserWebhookRequest = Message<"memos.api.v1.CreateUserWebhookRequest"> & {
   /**
-   * The parent user resource.
-   * Format: users/{user}
+   * The IP address of the client at sign-in.
    *
-   * @generated from field: string parent = 1;
+   * @generated from field: string ip_address = 2;
    */
-  parent: string;
+  ipAddress: string;
 
   /**
-   * The webhook to create.
+   * The device type (e.g., "mobile", "desktop", "tablet").
    *
-   * @generated from field: memos.api.v1.UserWebhook webhook = 2;
+   * @generated from field: string device_type = 3;
    */
-  webhook?: UserWebhook | undefined;
-};
+  deviceType: string;
+
+  /**
+   * The operating system (e.g., "iOS 17.0", "Windows 11").
+   *
+   * @generated from field: string os = 4;
+   */
+  os: string;
+
+  /**
+   * The browser name and version (e.g., "Chrome 119.0").
+   *
+   * @generated from field: string browser = 5;
+   */
+  browser: string;
+};
+
+/**
+ * Describes the message memos.api.v1.Session.ClientInfo.
+ * Use `create(Session_ClientInfoSchema)` to create a new message.
+ */
+export const Session_ClientInfoSchema: GenMessage<Session_ClientInfo> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 31, 0);
+
+/**
+ * @generated from message memos.api.v1.ListSessionsRequest
+ */
+export type ListSessionsRequest = Message<"memos.api.v1.ListSessionsRequest"> & {
+  /**
+   * Required. The parent resource whose sessions will be listed.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListSessionsRequest.
+ * Use `create(ListSessionsRequestSchema)` to create a new message.
+ */
+export const ListSessionsRequestSchema: GenMessage<ListSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 32);
+
+/**
+ * @generated from message memos.api.v1.ListSessionsResponse
+ */
+export type ListSessionsResponse = Message<"memos.api.v1.ListSessionsResponse"> & {
+  /**
+   * The list of active sessions, most recently seen first.
+   *
+   * @generated from field: repeated memos.api.v1.Session sessions = 1;
+   */
+  sessions: Session[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListSessionsResponse.
+ * Use `create(ListSessionsResponseSchema)` to create a new message.
+ */
+export const ListSessionsResponseSchema: GenMessage<ListSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 33);
+
+/**
+ * @generated from message memos.api.v1.RevokeSessionRequest
+ */
+export type RevokeSessionRequest = Message<"memos.api.v1.RevokeSessionRequest"> & {
+  /**
+   * Required. The resource name of the session to revoke.
+   * Format: users/{user}/sessions/{session}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export const RevokeSessionRequestSchema: GenMessage<RevokeSessionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 34);
+
+/**
+ * @generated from message memos.api.v1.RevokeAllSessionsRequest
+ */
+export type RevokeAllSessionsRequest = Message<"memos.api.v1.RevokeAllSessionsRequest"> & {
+  /**
+   * Required. The user whose sessions will be revoked.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Optional. Whether to also delete all personal access tokens of the user.
+   *
+   * @generated from field: bool include_personal_access_tokens = 2;
+   */
+  includePersonalAccessTokens: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.RevokeAllSessionsRequest.
+ * Use `create(RevokeAllSessionsRequestSchema)` to create a new message.
+ */
+export const RevokeAllSessionsRequestSchema: GenMessage<RevokeAllSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 35);
+
+/**
+ * UserWebhook represents a webhook owned by a user.
+ *
+ * @generated from message memos.api.v1.UserWebhook
+ */
+export type UserWebhook = Message<"memos.api.v1.UserWebhook"> & {
+  /**
+   * The name of the webhook.
+   * Format: users/{user}/webhooks/{webhook}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The URL to send the webhook to.
+   *
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * Optional. Human-readable name for the webhook.
+   *
+   * @generated from field: string display_name = 3;
+   */
+  displayName: string;
+
+  /**
+   * The creation time of the webhook.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 4;
+   */
+  createTime?: Timestamp | undefined;
+
+  /**
+   * The last update time of the webhook.
+   *
+   * @generated from field: google.protobuf.Timestamp update_time = 5;
+   */
+  updateTime?: Timestamp | undefined;
+
+  /**
+   * The secret used to sign deliveries.
+   * Each delivery carries the headers X-Memos-Webhook-Id, X-Memos-Webhook-Timestamp and
+   * X-Memos-Webhook-Signature, where the signature is "sha256=" followed by the hex-encoded
+   * HMAC-SHA256 of "{timestamp}.{body}" keyed with this secret.
+   * The secret is generated on creation and regenerated by updating the "secret" path.
+   *
+   * @generated from field: string secret = 6;
+   */
+  secret: string;
+
+  /**
+   * Whether deliveries are paused.
+   * Webhooks are disabled automatically after repeated failed deliveries,
+   * and re-enabled by updating the "disabled" path.
+   *
+   * @generated from field: bool disabled = 7;
+   */
+  disabled: boolean;
+
+  /**
+   * Optional. The event types delivered to the webhook. Empty means all event types.
+   * Supported: memos.memo.created, memos.memo.updated, memos.memo.deleted,
+   * memos.memo.comment.created, memos.memo.reaction.upserted and memos.memo.reminder.
+   *
+   * @generated from field: repeated string event_types = 8;
+   */
+  eventTypes: string[];
+
+  /**
+   * Optional. A CEL filter the memo of an event must match to be delivered,
+   * using the same syntax as ListMemos filters, e.g. `tag in ["deploy"]`.
+   *
+   * @generated from field: string filter = 9;
+   */
+  filter: string;
+
+  /**
+   * Optional. The payload format of deliveries. Chat formats render the memo snippet,
+   * tags, author and a link to the memo built from the instance URL.
+   *
+   * @generated from field: memos.api.v1.UserWebhook.Format format = 10;
+   */
+  format: UserWebhook_Format;
+};
+
+/**
+ * Describes the message memos.api.v1.UserWebhook.
+ * Use `create(UserWebhookSchema)` to create a new message.
+ */
+export const UserWebhookSchema: GenMessage<UserWebhook> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 36);
+
+/**
+ * The payload format of a webhook delivery.
+ *
+ * @generated from enum memos.api.v1.UserWebhook.Format
+ */
+export enum UserWebhook_Format {
+  /**
+   * @generated from enum value: FORMAT_UNSPECIFIED = 0;
+   */
+  FORMAT_UNSPECIFIED = 0,
+
+  /**
+   * The memos JSON payload, the default.
+   *
+   * @generated from enum value: MEMOS = 1;
+   */
+  MEMOS = 1,
+
+  /**
+   * A Slack incoming webhook message.
+   *
+   * @generated from enum value: SLACK = 2;
+   */
+  SLACK = 2,
+
+  /**
+   * A Discord webhook message with an embed.
+   *
+   * @generated from enum value: DISCORD = 3;
+   */
+  DISCORD = 3,
+
+  /**
+   * A Microsoft Teams message with an adaptive card.
+   *
+   * @generated from enum value: TEAMS = 4;
+   */
+  TEAMS = 4,
+
+  /**
+   * A Telegram Bot API sendMessage request.
+   * The URL is https://api.telegram.org/bot{token}/sendMessage?chat_id={chat}.
+   *
+   * @generated from enum value: TELEGRAM = 5;
+   */
+  TELEGRAM = 5,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserWebhook.Format.
+ */
+export const UserWebhook_FormatSchema: GenEnum<UserWebhook_Format> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 36, 0);
+
+/**
+ * @generated from message memos.api.v1.ListUserWebhooksRequest
+ */
+export type ListUserWebhooksRequest = Message<"memos.api.v1.ListUserWebhooksRequest"> & {
+  /**
+   * The parent user resource.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserWebhooksRequest.
+ * Use `create(ListUserWebhooksRequestSchema)` to create a new message.
+ */
+export const ListUserWebhooksRequestSchema: GenMessage<ListUserWebhooksRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 37);
+
+/**
+ * @generated from message memos.api.v1.ListUserWebhooksResponse
+ */
+export type ListUserWebhooksResponse = Message<"memos.api.v1.ListUserWebhooksResponse"> & {
+  /**
+   * The list of webhooks.
+   *
+   * @generated from field: repeated memos.api.v1.UserWebhook webhooks = 1;
+   */
+  webhooks: UserWebhook[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserWebhooksResponse.
+ * Use `create(ListUserWebhooksResponseSchema)` to create a new message.
+ */
+export const ListUserWebhooksResponseSchema: GenMessage<ListUserWebhooksResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 38);
+
+/**
+ * @generated from message memos.api.v1.CreateUserWebhookRequest
+ */
+export type CreateUserWebhookRequest = Message<"memos.api.v1.CreateUserWebhookRequest"> & {
+  /**
+   * The parent user resource.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * The webhook to create.
+   *
+   * @generated from field: memos.api.v1.UserWebhook webhook = 2;
+   */
+  webhook?: UserWebhook | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateUserWebhookRequest.
+ * Use `create(CreateUserWebhookRequestSchema)` to create a new message.
+ */
+export const CreateUserWebhookRequestSchema: GenMessage<CreateUserWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 39);
+
+/**
+ * @generated from message memos.api.v1.UpdateUserWebhookRequest
+ */
+export type UpdateUserWebhookRequest = Message<"memos.api.v1.UpdateUserWebhookRequest"> & {
+  /**
+   * The webhook to update.
+   *
+   * @generated from field: memos.api.v1.UserWebhook webhook = 1;
+   */
+  webhook?: UserWebhook | undefined;
+
+  /**
+   * The list of fields to update.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateUserWebhookRequest.
+ * Use `create(UpdateUserWebhookRequestSchema)` to create a new message.
+ */
+export const UpdateUserWebhookRequestSchema: GenMessage<UpdateUserWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 40);
+
+/**
+ * @generated from message memos.api.v1.DeleteUserWebhookRequest
+ */
+export type DeleteUserWebhookRequest = Message<"memos.api.v1.DeleteUserWebhookRequest"> & {
+  /**
+   * The name of the webhook to delete.
+   * Format: users/{user}/webhooks/{webhook}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteUserWebhookRequest.
+ * Use `create(DeleteUserWebhookRequestSchema)` to create a new message.
+ */
+export const DeleteUserWebhookRequestSchema: GenMessage<DeleteUserWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 41);
+
+/**
+ * WebhookDelivery is a webhook event sent, or to be sent, to a webhook.
+ *
+ * @generated from message memos.api.v1.WebhookDelivery
+ */
+export type WebhookDelivery = Message<"memos.api.v1.WebhookDelivery"> & {
+  /**
+   * The name of the delivery.
+   * Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+   * The delivery ID is sent in the X-Memos-Webhook-Id header.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The type of activity delivered, e.g. memos.memo.created.
+   *
+   * @generated from field: string activity_type = 2;
+   */
+  activityType: string;
+
+  /**
+   * The URL the delivery is sent to.
+   *
+   * @generated from field: string url = 3;
+   */
+  url: string;
+
+  /**
+   * @generated from field: memos.api.v1.WebhookDelivery.State state = 4;
+   */
+  state: WebhookDelivery_State;
+
+  /**
+   * The number of attempts made so far.
+   *
+   * @generated from field: int32 attempt_count = 5;
+   */
+  attemptCount: number;
+
+  /**
+   * The HTTP status code of the last attempt, or 0 if there was no response.
+   *
+   * @generated from field: int32 status_code = 6;
+   */
+  statusCode: number;
+
+  /**
+   * The beginning of the response body of the last attempt, or the error if there was no response.
+   *
+   * @generated from field: string response_snippet = 7;
+   */
+  responseSnippet: string;
+
+  /**
+   * The time the delivery was created.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 8;
+   */
+  createTime?: Timestamp | undefined;
+
+  /**
+   * The time of the last attempt.
+   *
+   * @generated from field: optional google.protobuf.Timestamp last_attempt_time = 9;
+   */
+  lastAttemptTime?: Timestamp | undefined;
+
+  /**
+   * The time of the next attempt of a pending delivery.
+   *
+   * @generated from field: optional google.protobuf.Timestamp next_attempt_time = 10;
+   */
+  nextAttemptTime?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.WebhookDelivery.
+ * Use `create(WebhookDeliverySchema)` to create a new message.
+ */
+export const WebhookDeliverySchema: GenMessage<WebhookDelivery> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 42);
+
+/**
+ * @generated from enum memos.api.v1.WebhookDelivery.State
+ */
+export enum WebhookDelivery_State {
+  /**
+   * @generated from enum value: STATE_UNSPECIFIED = 0;
+   */
+  STATE_UNSPECIFIED = 0,
+
+  /**
+   * The delivery is waiting for its next attempt.
+   *
+   * @generated from enum value: PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * The delivery was accepted by the endpoint.
+   *
+   * @generated from enum value: SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * The delivery ran out of attempts, or its webhook was disabled.
+   *
+   * @generated from enum value: FAILED = 3;
+   */
+  FAILED = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.WebhookDelivery.State.
+ */
+export const WebhookDelivery_StateSchema: GenEnum<WebhookDelivery_State> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 42, 0);
+
+/**
+ * @generated from message memos.api.v1.ListWebhookDeliveriesRequest
+ */
+export type ListWebhookDeliveriesRequest = Message<"memos.api.v1.ListWebhookDeliveriesRequest"> & {
+  /**
+   * The parent webhook.
+   * Format: users/{user}/webhooks/{webhook}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Optional. The maximum number of deliveries to return.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token from a previous call.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListWebhookDeliveriesRequest.
+ * Use `create(ListWebhookDeliveriesRequestSchema)` to create a new message.
+ */
+export const ListWebhookDeliveriesRequestSchema: GenMessage<ListWebhookDeliveriesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 43);
+
+/**
+ * @generated from message memos.api.v1.ListWebhookDeliveriesResponse
+ */
+export type ListWebhookDeliveriesResponse = Message<"memos.api.v1.ListWebhookDeliveriesResponse"> & {
+  /**
+   * The deliveries, newest first.
+   *
+   * @generated from field: repeated memos.api.v1.WebhookDelivery deliveries = 1;
+   */
+  deliveries: WebhookDelivery[];
+
+  /**
+   * A token for the next page, empty if there are no more deliveries.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListWebhookDeliveriesResponse.
+ * Use `create(ListWebhookDeliveriesResponseSchema)` to create a new message.
+ */
+export const ListWebhookDeliveriesResponseSchema: GenMessage<ListWebhookDeliveriesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 44);
+
+/**
+ * @generated from message memos.api.v1.RedeliverWebhookRequest
+ */
+export type RedeliverWebhookRequest = Message<"memos.api.v1.RedeliverWebhookRequest"> & {
+  /**
+   * The name of the delivery to send again.
+   * Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RedeliverWebhookRequest.
+ * Use `create(RedeliverWebhookRequestSchema)` to create a new message.
+ */
+export const RedeliverWebhookRequestSchema: GenMessage<RedeliverWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 45);
+
+/**
+ * @generated from message memos.api.v1.UserNotification
+ */
+export type UserNotification = Message<"memos.api.v1.UserNotification"> & {
+  /**
+   * The resource name of the notification.
+   * Format: users/{user}/notifications/{notification}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The sender of the notification.
+   * Format: users/{user}
+   *
+   * @generated from field: string sender = 2;
+   */
+  sender: string;
+
+  /**
+   * The sender user details.
+   *
+   * @generated from field: memos.api.v1.User sender_user = 8;
+   */
+  senderUser?: User | undefined;
+
+  /**
+   * The status of the notification.
+   *
+   * @generated from field: memos.api.v1.UserNotification.Status status = 3;
+   */
+  status: UserNotification_Status;
+
+  /**
+   * The creation timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 4;
+   */
+  createTime?: Timestamp | undefined;
+
+  /**
+   * The type of the notification.
+   *
+   * @generated from field: memos.api.v1.UserNotification.Type type = 5;
+   */
+  type: UserNotification_Type;
+
+  /**
+   * @generated from oneof memos.api.v1.UserNotification.payload
+   */
+  payload: {
+    /**
+     * @generated from field: memos.api.v1.UserNotification.MemoCommentPayload memo_comment = 6;
+     */
+    value: UserNotification_MemoCommentPayload;
+    case: "memoComment";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserNotification.MemoMentionPayload memo_mention = 7;
+     */
+    value: UserNotification_MemoMentionPayload;
+    case: "memoMention";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserNotification.MemoCollaboratorPayload memo_collaborator = 9;
+     */
+    value: UserNotification_MemoCollaboratorPayload;
+    case: "memoCollaborator";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserNotification.MemoReminderPayload memo_reminder = 10;
+     */
+    value: UserNotification_MemoReminderPayload;
+    case: "memoReminder";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserNotification.MemoReactionPayload memo_reaction = 11;
+     */
+    value: UserNotification_MemoReactionPayload;
+    case: "memoReaction";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserNotification.MemoThreadReplyPayload memo_thread_reply = 12;
+     */
+    value: UserNotification_MemoThreadReplyPayload;
+    case: "memoThreadReply";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserNotification.MemoShareOpenedPayload memo_share_opened = 13;
+     */
+    value: UserNotification_MemoShareOpenedPayload;
+    case: "memoShareOpened";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message memos.api.v1.UserNotification.
+ * Use `create(UserNotificationSchema)` to create a new message.
+ */
+export const UserNotificationSchema: GenMessage<UserNotification> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 46);
+
+/**
+ * @generated from message memos.api.v1.UserNotification.MemoCommentPayload
+ */
+export type UserNotification_MemoCommentPayload = Message<"memos.api.v1.UserNotification.MemoCommentPayload"> & {
+  /**
+   * The memo name of comment.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+
+  /**
+   * The name of related memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string related_memo = 2;
+   */
+  relatedMemo: string;
+
+  /**
+   * Preview text of the comment memo.
+   *
+   * @generated from field: string memo_snippet = 3;
+   */
+  memoSnippet: string;
+
+  /**
+   * Preview text of the related memo.
+   *
+   * @generated from field: string related_memo_snippet = 4;
+   */
+  relatedMemoSnippet: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UserNotification.MemoCommentPayload.
+ * Use `create(UserNotification_MemoCommentPayloadSchema)` to create a new message.
+ */
+export const UserNotification_MemoCommentPayloadSchema: GenMessage<UserNotification_MemoCommentPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 46, 0);
+
+/**
+ * @generated from message memos.api.v1.UserNotification.MemoMentionPayload
+ */
+export type UserNotification_MemoMentionPayload = Message<"memos.api.v1.UserNotification.MemoMentionPayload"> & {
+  /**
+   * The memo that contains the mention.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+
+  /**
+   * The related parent memo when the mention was created in a comment.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string related_memo = 2;
+   */
+  relatedMemo: string;
+
+  /**
+   * Preview text of the memo that contains the mention.
+   *
+   * @generated from field: string memo_snippet = 3;
+   */
+  memoSnippet: string;
+
+  /**
+   * Preview text of the related parent memo.
+   *
+   * @generated from field: string related_memo_snippet = 4;
+   */
+  relatedMemoSnippet: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UserNotification.MemoMentionPayload.
+ * Use `create(UserNotification_MemoMentionPayloadSchema)` to create a new message.
+ */
+export const UserNotification_MemoMentionPayloadSchema: GenMessage<UserNotification_MemoMentionPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 46, 1);
+
+/**
+ * @generated from message memos.api.v1.UserNotification.MemoCollaboratorPayload
+ */
+export type UserNotification_MemoCollaboratorPayload = Message<"memos.api.v1.UserNotification.MemoCollaboratorPayload"> & {
+  /**
+   * The memo the receiver can now collaborate on.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+
+  /**
+   * Preview text of the memo.
+   *
+   * @generated from field: string memo_snippet = 2;
+   */
+  memoSnippet: string;
+
+  /**
+   * The granted collaborator role, VIEWER or EDITOR.
+   *
+   * @generated from field: string role = 3;
+   */
+  role: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UserNotification.MemoCollaboratorPayload.
+ * Use `create(UserNotification_MemoCollaboratorPayloadSchema)` to create a new message.
+ */
+export const UserNotification_MemoCollaboratorPayloadSchema: GenMessage<UserNotification_MemoCollaboratorPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 46, 2);
+
+/**
+ * @generated from message memos.api.v1.UserNotification.MemoReminderPayload
+ */
+export type UserNotification_MemoReminderPayload = Message<"memos.api.v1.UserNotification.MemoReminderPayload"> & {
+  /**
+   * The memo the reminder is for.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+
+  /**
+   * Preview text of the memo.
+   *
+   * @generated from field: string memo_snippet = 2;
+   */
+  memoSnippet: string;
+
+  /**
+   * The time the memo is due, if set.
+   *
+   * @generated from field: google.protobuf.Timestamp due_time = 3;
+   */
+  dueTime?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.UserNotification.MemoReminderPayload.
+ * Use `create(UserNotification_MemoReminderPayloadSchema)` to create a new message.
+ */
+export const UserNotification_MemoReminderPayloadSchema: GenMessage<UserNotification_MemoReminderPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 46, 3);
+
+/**
+ * @generated from message memos.api.v1.UserNotification.MemoReactionPayload
+ */
+export type UserNotification_MemoReactionPayload = Message<"memos.api.v1.UserNotification.MemoReactionPayload"> & {
+  /**
+   * The memo that was reacted to.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+
+  /**
+   * Preview text of the memo.
+   *
+   * @generated from field: string memo_snippet = 2;
+   */
+  memoSnippet: string;
+
+  /**
+   * The reaction, e.g. an emoji.
+   *
+   * @generated from field: string reaction_type = 3;
+   */
+  reactionType: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UserNotification.MemoReactionPayload.
+ * Use `create(UserNotification_MemoReactionPayloadSchema)` to create a new message.
+ */
+export const UserNotification_MemoReactionPayloadSchema: GenMessage<UserNotification_MemoReactionPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 46, 4);
+
+/**
+ * @generated from message memos.api.v1.UserNotification.MemoThreadReplyPayload
+ */
+export type UserNotification_MemoThreadReplyPayload = Message<"memos.api.v1.UserNotification.MemoThreadReplyPayload"> & {
+  /**
+   * The new comment.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+
+  /**
+   * The memo whose comment thread the receiver took part in.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string related_memo = 2;
+   */
+  relatedMemo: string;
+
+  /**
+   * Preview text of the comment.
+   *
+   * @generated from field: string memo_snippet = 3;
+   */
+  memoSnippet: string;
+
+  /**
+   * Preview text of the related memo.
+   *
+   * @generated from field: string related_memo_snippet = 4;
+   */
+  relatedMemoSnippet: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UserNotification.MemoThreadReplyPayload.
+ * Use `create(UserNotification_MemoThreadReplyPayloadSchema)` to create a new message.
+ */
+export const UserNotification_MemoThreadReplyPayloadSchema: GenMessage<UserNotification_MemoThreadReplyPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 46, 5);
+
+/**
+ * @generated from message memos.api.v1.UserNotification.MemoShareOpenedPayload
+ */
+export type UserNotification_MemoShareOpenedPayload = Message<"memos.api.v1.UserNotification.MemoShareOpenedPayload"> & {
+  /**
+   * The shared memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+
+  /**
+   * Preview text of the memo.
+   *
+   * @generated from field: string memo_snippet = 2;
+   */
+  memoSnippet: string;
+
+  /**
+   * The share link that was opened.
+   * Format: memos/{memo}/shares/{share}
+   *
+   * @generated from field: string share = 3;
+   */
+  share: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UserNotification.MemoShareOpenedPayload.
+ * Use `create(UserNotification_MemoShareOpenedPayloadSchema)` to create a new message.
+ */
+export const UserNotification_MemoShareOpenedPayloadSchema: GenMessage<UserNotification_MemoShareOpenedPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 46, 6);
+
+/**
+ * @generated from enum memos.api.v1.UserNotification.Status
+ */
+export enum UserNotification_Status {
+  /**
+   * @generated from enum value: STATUS_UNSPECIFIED = 0;
+   */
+  STATUS_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: UNREAD = 1;
+   */
+  UNREAD = 1,
+
+  /**
+   * @generated from enum value: ARCHIVED = 2;
+   */
+  ARCHIVED = 2,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserNotification.Status.
+ */
+export const UserNotification_StatusSchema: GenEnum<UserNotification_Status> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 46, 0);
+
+/**
+ * @generated from enum memos.api.v1.UserNotification.Type
+ */
+export enum UserNotification_Type {
+  /**
+   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   */
+  TYPE_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MEMO_COMMENT = 1;
+   */
+  MEMO_COMMENT = 1,
+
+  /**
+   * @generated from enum value: MEMO_MENTION = 2;
+   */
+  MEMO_MENTION = 2,
+
+  /**
+   * @generated from enum value: MEMO_COLLABORATOR = 3;
+   */
+  MEMO_COLLABORATOR = 3,
+
+  /**
+   * @generated from enum value: MEMO_REMINDER = 4;
+   */
+  MEMO_REMINDER = 4,
+
+  /**
+   * @generated from enum value: MEMO_REACTION = 5;
+   */
+  MEMO_REACTION = 5,
+
+  /**
+   * @generated from enum value: MEMO_THREAD_REPLY = 6;
+   */
+  MEMO_THREAD_REPLY = 6,
+
+  /**
+   * @generated from enum value: MEMO_SHARE_OPENED = 7;
+   */
+  MEMO_SHARE_OPENED = 7,
+}
 
 /**
- * Describes the message memos.api.v1.CreateUserWebhookRequest.
- * Use `create(CreateUserWebhookRequestSchema)` to create a new message.
+ * Describes the enum memos.api.v1.UserNotification.Type.
  */
-export const CreateUserWebhookRequestSchema: GenMessage<CreateUserWebhookRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 33);
+export const UserNotification_TypeSchema: GenEnum<UserNotification_Type> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 46, 1);
 
 /**
- * @generated from message memos.api.v1.UpdateUserWebhookRequest
+ * @generated from message memos.api.v1.ListUserNotificationsRequest
  */
-export type UpdateUserWebhookRequest = Message<"memos.api.v1.UpdateUserWebhookRequest"> & {
+export type ListUserNotificationsRequest = Message<"memos.api.v1.ListUserNotificationsRequest"> & {
   /**
-   * The webhook to update.
+   * The parent user resource.
+   * Format: users/{user}
    *
-   * @generated from field: memos.api.v1.UserWebhook webhook = 1;
+   * @generated from field: string parent = 1;
    */
-  webhook?: UserWebhook | undefined;
+  parent: string;
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: string filter = 4;
+   */
+  filter: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserNotificationsRequest.
+ * Use `create(ListUserNotificationsRequestSchema)` to create a new message.
+ */
+export const ListUserNotificationsRequestSchema: GenMessage<ListUserNotificationsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 47);
+
+/**
+ * @generated from message memos.api.v1.ListUserNotificationsResponse
+ */
+export type ListUserNotificationsResponse = Message<"memos.api.v1.ListUserNotificationsResponse"> & {
+  /**
+   * @generated from field: repeated memos.api.v1.UserNotification notifications = 1;
+   */
+  notifications: UserNotification[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserNotificationsResponse.
+ * Use `create(ListUserNotificationsResponseSchema)` to create a new message.
+ */
+export const ListUserNotificationsResponseSchema: GenMessage<ListUserNotificationsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 48);
+
+/**
+ * @generated from message memos.api.v1.UpdateUserNotificationRequest
+ */
+export type UpdateUserNotificationRequest = Message<"memos.api.v1.UpdateUserNotificationRequest"> & {
+  /**
+   * @generated from field: memos.api.v1.UserNotification notification = 1;
+   */
+  notification?: UserNotification | undefined;
 
   /**
-   * The list of fields to update.
-   *
    * @generated from field: google.protobuf.FieldMask update_mask = 2;
    */
   updateMask?: FieldMask | undefined;
 };
 
 /**
- * Describes the message memos.api.v1.UpdateUserWebhookRequest.
- * Use `create(UpdateUserWebhookRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.UpdateUserNotificationRequest.
+ * Use `create(UpdateUserNotificationRequestSchema)` to create a new message.
  */
-export const UpdateUserWebhookRequestSchema: GenMessage<UpdateUserWebhookRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 34);
+export const UpdateUserNotificationRequestSchema: GenMessage<UpdateUserNotificationRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 49);
 
 /**
- * @generated from message memos.api.v1.DeleteUserWebhookRequest
+ * @generated from message memos.api.v1.DeleteUserNotificationRequest
  */
-export type DeleteUserWebhookRequest = Message<"memos.api.v1.DeleteUserWebhookRequest"> & {
+export type DeleteUserNotificationRequest = Message<"memos.api.v1.DeleteUserNotificationRequest"> & {
   /**
-   * The name of the webhook to delete.
-   * Format: users/{user}/webhooks/{webhook}
+   * Format: users/{user}/notifications/{notification}
    *
    * @generated from field: string name = 1;
    */
@@ -1326,306 +2630,477 @@ export type DeleteUserWebhookRequest = Message<"memos.api.v1.DeleteUserWebhookRe
 };
 
 /**
- * Describes the message memos.api.v1.DeleteUserWebhookRequest.
- * Use `create(DeleteUserWebhookRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.DeleteUserNotificationRequest.
+ * Use `create(DeleteUserNotificationRequestSchema)` to create a new message.
  */
-export const DeleteUserWebhookRequestSchema: GenMessage<DeleteUserWebhookRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 35);
+export const DeleteUserNotificationRequestSchema: GenMessage<DeleteUserNotificationRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 50);
 
 /**
- * @generated from message memos.api.v1.UserNotification
+ * @generated from message memos.api.v1.SendTestNotificationRequest
  */
-export type UserNotification = Message<"memos.api.v1.UserNotification"> & {
+export type SendTestNotificationRequest = Message<"memos.api.v1.SendTestNotificationRequest"> & {
   /**
-   * The resource name of the notification.
-   * Format: users/{user}/notifications/{notification}
+   * The user to send the test notification to.
+   * Format: users/{user}
    *
    * @generated from field: string name = 1;
    */
   name: string;
 
   /**
-   * The sender of the notification.
-   * Format: users/{user}
+   * Optional. The channel to test. If omitted, every saved channel is tested.
    *
-   * @generated from field: string sender = 2;
+   * @generated from field: memos.api.v1.UserSetting.NotificationSetting.Channel channel = 2;
    */
-  sender: string;
+  channel?: UserSetting_NotificationSetting_Channel | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.SendTestNotificationRequest.
+ * Use `create(SendTestNotificationRequestSchema)` to create a new message.
+ */
+export const SendTestNotificationRequestSchema: GenMessage<SendTestNotificationRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 51);
 
+/**
+ * UserPushSubscription is a device registered for Web Push notifications.
+ * The fields mirror the browser's PushSubscription.
+ *
+ * @generated from message memos.api.v1.UserPushSubscription
+ */
+export type UserPushSubscription = Message<"memos.api.v1.UserPushSubscription"> & {
   /**
-   * The sender user details.
+   * The name of the push subscription.
+   * Format: users/{user}/pushSubscriptions/{push_subscription}
    *
-   * @generated from field: memos.api.v1.User sender_user = 8;
+   * @generated from field: string name = 1;
    */
-  senderUser?: User | undefined;
+  name: string;
 
   /**
-   * The status of the notification.
+   * The push service URL of the device. It must use https.
    *
-   * @generated from field: memos.api.v1.UserNotification.Status status = 3;
+   * @generated from field: string endpoint = 2;
    */
-  status: UserNotification_Status;
+  endpoint: string;
 
   /**
-   * The creation timestamp.
+   * The "p256dh" key of the subscription, base64url-encoded.
    *
-   * @generated from field: google.protobuf.Timestamp create_time = 4;
+   * @generated from field: string p256dh_key = 3;
+   */
+  p256dhKey: string;
+
+  /**
+   * The "auth" secret of the subscription, base64url-encoded.
+   *
+   * @generated from field: string auth_key = 4;
+   */
+  authKey: string;
+
+  /**
+   * Optional. Human-readable description of the device.
+   *
+   * @generated from field: string description = 5;
+   */
+  description: string;
+
+  /**
+   * The creation time of the push subscription.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 6;
    */
   createTime?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.UserPushSubscription.
+ * Use `create(UserPushSubscriptionSchema)` to create a new message.
+ */
+export const UserPushSubscriptionSchema: GenMessage<UserPushSubscription> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 52);
 
+/**
+ * @generated from message memos.api.v1.ListUserPushSubscriptionsRequest
+ */
+export type ListUserPushSubscriptionsRequest = Message<"memos.api.v1.ListUserPushSubscriptionsRequest"> & {
   /**
-   * The type of the notification.
+   * The parent user resource.
+   * Format: users/{user}
    *
-   * @generated from field: memos.api.v1.UserNotification.Type type = 5;
+   * @generated from field: string parent = 1;
    */
-  type: UserNotification_Type;
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserPushSubscriptionsRequest.
+ * Use `create(ListUserPushSubscriptionsRequestSchema)` to create a new message.
+ */
+export const ListUserPushSubscriptionsRequestSchema: GenMessage<ListUserPushSubscriptionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 53);
 
+/**
+ * @generated from message memos.api.v1.ListUserPushSubscriptionsResponse
+ */
+export type ListUserPushSubscriptionsResponse = Message<"memos.api.v1.ListUserPushSubscriptionsResponse"> & {
   /**
-   * @generated from oneof memos.api.v1.UserNotification.payload
+   * The list of push subscriptions.
+   *
+   * @generated from field: repeated memos.api.v1.UserPushSubscription push_subscriptions = 1;
    */
-  payload: {
-    /**
-     * @generated from field: memos.api.v1.UserNotification.MemoCommentPayload memo_comment = 6;
-     */
-    value: UserNotification_MemoCommentPayload;
-    case: "memoComment";
-  } | {
-    /**
-     * @generated from field: memos.api.v1.UserNotification.MemoMentionPayload memo_mention = 7;
-     */
-    value: UserNotification_MemoMentionPayload;
-    case: "memoMention";
-  } | { case: undefined; value?: undefined };
+  pushSubscriptions: UserPushSubscription[];
+
+  /**
+   * The VAPID public key of the instance, base64url-encoded.
+   * Browsers subscribe with it as the applicationServerKey.
+   *
+   * @generated from field: string vapid_public_key = 2;
+   */
+  vapidPublicKey: string;
 };
 
 /**
- * Describes the message memos.api.v1.UserNotification.
- * Use `create(UserNotificationSchema)` to create a new message.
+ * Describes the message memos.api.v1.ListUserPushSubscriptionsResponse.
+ * Use `create(ListUserPushSubscriptionsResponseSchema)` to create a new message.
  */
-export const UserNotificationSchema: GenMessage<UserNotification> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 36);
+export const ListUserPushSubscriptionsResponseSchema: GenMessage<ListUserPushSubscriptionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 54);
 
 /**
- * @generated from message memos.api.v1.UserNotification.MemoCommentPayload
+ * @generated from message memos.api.v1.CreateUserPushSubscriptionRequest
  */
-export type UserNotification_MemoCommentPayload = Message<"memos.api.v1.UserNotification.MemoCommentPayload"> & {
+export type CreateUserPushSubscriptionRequest = Message<"memos.api.v1.CreateUserPushSubscriptionRequest"> & {
   /**
-   * The memo name of comment.
-   * Format: memos/{memo}
+   * The parent user resource.
+   * Format: users/{user}
    *
-   * @generated from field: string memo = 1;
+   * @generated from field: string parent = 1;
    */
-  memo: string;
+  parent: string;
 
   /**
-   * The name of related memo.
-   * Format: memos/{memo}
+   * The push subscription to register.
    *
-   * @generated from field: string related_memo = 2;
+   * @generated from field: memos.api.v1.UserPushSubscription push_subscription = 2;
    */
-  relatedMemo: string;
+  pushSubscription?: UserPushSubscription | undefined;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateUserPushSubscriptionRequest.
+ * Use `create(CreateUserPushSubscriptionRequestSchema)` to create a new message.
+ */
+export const CreateUserPushSubscriptionRequestSchema: GenMessage<CreateUserPushSubscriptionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 55);
 
+/**
+ * @generated from message memos.api.v1.DeleteUserPushSubscriptionRequest
+ */
+export type DeleteUserPushSubscriptionRequest = Message<"memos.api.v1.DeleteUserPushSubscriptionRequest"> & {
   /**
-   * Preview text of the comment memo.
+   * The name of the push subscription to delete.
+   * Format: users/{user}/pushSubscriptions/{push_subscription}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteUserPushSubscriptionRequest.
+ * Use `create(DeleteUserPushSubscriptionRequestSchema)` to create a new message.
+ */
+export const DeleteUserPushSubscriptionRequestSchema: GenMessage<DeleteUserPushSubscriptionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 56);
+
+/**
+ * UserGroup is a named set of users that GROUP memos can be shared with.
+ *
+ * @generated from message memos.api.v1.UserGroup
+ */
+export type UserGroup = Message<"memos.api.v1.UserGroup"> & {
+  /**
+   * The resource name of the group.
+   * Format: groups/{group}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. A description of the group.
+   *
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * Output only. The number of members in the group.
+   *
+   * @generated from field: int32 member_count = 3;
+   */
+  memberCount: number;
+
+  /**
+   * Output only. The creation timestamp.
    *
-   * @generated from field: string memo_snippet = 3;
+   * @generated from field: google.protobuf.Timestamp create_time = 4;
    */
-  memoSnippet: string;
+  createTime?: Timestamp | undefined;
 
   /**
-   * Preview text of the related memo.
+   * Output only. The last update timestamp.
    *
-   * @generated from field: string related_memo_snippet = 4;
+   * @generated from field: google.protobuf.Timestamp update_time = 5;
    */
-  relatedMemoSnippet: string;
+  updateTime?: Timestamp | undefined;
 };
 
 /**
- * Describes the message memos.api.v1.UserNotification.MemoCommentPayload.
- * Use `create(UserNotification_MemoCommentPayloadSchema)` to create a new message.
+ * Describes the message memos.api.v1.UserGroup.
+ * Use `create(UserGroupSchema)` to create a new message.
  */
-export const UserNotification_MemoCommentPayloadSchema: GenMessage<UserNotification_MemoCommentPayload> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 36, 0);
+export const UserGroupSchema: GenMessage<UserGroup> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 57);
 
 /**
- * @generated from message memos.api.v1.UserNotification.MemoMentionPayload
+ * UserGroupMember is a user's membership in a group.
+ *
+ * @generated from message memos.api.v1.UserGroupMember
  */
-export type UserNotification_MemoMentionPayload = Message<"memos.api.v1.UserNotification.MemoMentionPayload"> & {
-  /**
-   * The memo that contains the mention.
-   * Format: memos/{memo}
-   *
-   * @generated from field: string memo = 1;
-   */
-  memo: string;
-
+export type UserGroupMember = Message<"memos.api.v1.UserGroupMember"> & {
   /**
-   * The related parent memo when the mention was created in a comment.
-   * Format: memos/{memo}
+   * The resource name of the membership.
+   * Format: groups/{group}/members/{member}, member is the username.
    *
-   * @generated from field: string related_memo = 2;
+   * @generated from field: string name = 1;
    */
-  relatedMemo: string;
+  name: string;
 
   /**
-   * Preview text of the memo that contains the mention.
+   * The member user.
+   * Format: users/{user}
    *
-   * @generated from field: string memo_snippet = 3;
+   * @generated from field: string user = 2;
    */
-  memoSnippet: string;
+  user: string;
 
   /**
-   * Preview text of the related parent memo.
+   * Output only. When the user joined the group.
    *
-   * @generated from field: string related_memo_snippet = 4;
+   * @generated from field: google.protobuf.Timestamp create_time = 3;
    */
-  relatedMemoSnippet: string;
+  createTime?: Timestamp | undefined;
 };
 
 /**
- * Describes the message memos.api.v1.UserNotification.MemoMentionPayload.
- * Use `create(UserNotification_MemoMentionPayloadSchema)` to create a new message.
+ * Describes the message memos.api.v1.UserGroupMember.
+ * Use `create(UserGroupMemberSchema)` to create a new message.
  */
-export const UserNotification_MemoMentionPayloadSchema: GenMessage<UserNotification_MemoMentionPayload> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 36, 1);
+export const UserGroupMemberSchema: GenMessage<UserGroupMember> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 58);
 
 /**
- * @generated from enum memos.api.v1.UserNotification.Status
+ * @generated from message memos.api.v1.ListUserGroupsRequest
  */
-export enum UserNotification_Status {
-  /**
-   * @generated from enum value: STATUS_UNSPECIFIED = 0;
-   */
-  STATUS_UNSPECIFIED = 0,
+export type ListUserGroupsRequest = Message<"memos.api.v1.ListUserGroupsRequest"> & {
+};
 
-  /**
-   * @generated from enum value: UNREAD = 1;
-   */
-  UNREAD = 1,
+/**
+ * Describes the message memos.api.v1.ListUserGroupsRequest.
+ * Use `create(ListUserGroupsRequestSchema)` to create a new message.
+ */
+export const ListUserGroupsRequestSchema: GenMessage<ListUserGroupsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 59);
 
+/**
+ * @generated from message memos.api.v1.ListUserGroupsResponse
+ */
+export type ListUserGroupsResponse = Message<"memos.api.v1.ListUserGroupsResponse"> & {
   /**
-   * @generated from enum value: ARCHIVED = 2;
+   * The list of groups.
+   *
+   * @generated from field: repeated memos.api.v1.UserGroup groups = 1;
    */
-  ARCHIVED = 2,
-}
+  groups: UserGroup[];
+};
 
 /**
- * Describes the enum memos.api.v1.UserNotification.Status.
+ * Describes the message memos.api.v1.ListUserGroupsResponse.
+ * Use `create(ListUserGroupsResponseSchema)` to create a new message.
  */
-export const UserNotification_StatusSchema: GenEnum<UserNotification_Status> = /*@__PURE__*/
-  enumDesc(file_api_v1_user_service, 36, 0);
+export const ListUserGroupsResponseSchema: GenMessage<ListUserGroupsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 60);
 
 /**
- * @generated from enum memos.api.v1.UserNotification.Type
+ * @generated from message memos.api.v1.GetUserGroupRequest
  */
-export enum UserNotification_Type {
+export type GetUserGroupRequest = Message<"memos.api.v1.GetUserGroupRequest"> & {
   /**
-   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   * Required. The resource name of the group.
+   * Format: groups/{group}
+   *
+   * @generated from field: string name = 1;
    */
-  TYPE_UNSPECIFIED = 0,
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetUserGroupRequest.
+ * Use `create(GetUserGroupRequestSchema)` to create a new message.
+ */
+export const GetUserGroupRequestSchema: GenMessage<GetUserGroupRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 61);
 
+/**
+ * @generated from message memos.api.v1.CreateUserGroupRequest
+ */
+export type CreateUserGroupRequest = Message<"memos.api.v1.CreateUserGroupRequest"> & {
   /**
-   * @generated from enum value: MEMO_COMMENT = 1;
+   * Required. The group to create.
+   *
+   * @generated from field: memos.api.v1.UserGroup group = 1;
    */
-  MEMO_COMMENT = 1,
+  group?: UserGroup | undefined;
 
   /**
-   * @generated from enum value: MEMO_MENTION = 2;
+   * Required. The ID to use for the group, which will become the final component of the resource name.
+   * Must start with a lowercase letter and contain only lowercase letters, digits and hyphens.
+   *
+   * @generated from field: string group_id = 2;
    */
-  MEMO_MENTION = 2,
-}
+  groupId: string;
+};
 
 /**
- * Describes the enum memos.api.v1.UserNotification.Type.
+ * Describes the message memos.api.v1.CreateUserGroupRequest.
+ * Use `create(CreateUserGroupRequestSchema)` to create a new message.
  */
-export const UserNotification_TypeSchema: GenEnum<UserNotification_Type> = /*@__PURE__*/
-  enumDesc(file_api_v1_user_service, 36, 1);
+export const CreateUserGroupRequestSchema: GenMessage<CreateUserGroupRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 62);
 
 /**
- * @generated from message memos.api.v1.ListUserNotificationsRequest
+ * @generated from message memos.api.v1.UpdateUserGroupRequest
  */
-export type ListUserNotificationsRequest = Message<"memos.api.v1.ListUserNotificationsRequest"> & {
+export type UpdateUserGroupRequest = Message<"memos.api.v1.UpdateUserGroupRequest"> & {
   /**
-   * The parent user resource.
-   * Format: users/{user}
+   * Required. The group to update.
    *
-   * @generated from field: string parent = 1;
+   * @generated from field: memos.api.v1.UserGroup group = 1;
    */
-  parent: string;
+  group?: UserGroup | undefined;
 
   /**
-   * @generated from field: int32 page_size = 2;
+   * Required. The update mask. Supported fields: description.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
    */
-  pageSize: number;
+  updateMask?: FieldMask | undefined;
+};
 
-  /**
-   * @generated from field: string page_token = 3;
-   */
-  pageToken: string;
+/**
+ * Describes the message memos.api.v1.UpdateUserGroupRequest.
+ * Use `create(UpdateUserGroupRequestSchema)` to create a new message.
+ */
+export const UpdateUserGroupRequestSchema: GenMessage<UpdateUserGroupRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 63);
 
+/**
+ * @generated from message memos.api.v1.DeleteUserGroupRequest
+ */
+export type DeleteUserGroupRequest = Message<"memos.api.v1.DeleteUserGroupRequest"> & {
   /**
-   * @generated from field: string filter = 4;
+   * Required. The resource name of the group to delete.
+   * Format: groups/{group}
+   *
+   * @generated from field: string name = 1;
    */
-  filter: string;
+  name: string;
 };
 
 /**
- * Describes the message memos.api.v1.ListUserNotificationsRequest.
- * Use `create(ListUserNotificationsRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.DeleteUserGroupRequest.
+ * Use `create(DeleteUserGroupRequestSchema)` to create a new message.
  */
-export const ListUserNotificationsRequestSchema: GenMessage<ListUserNotificationsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 37);
+export const DeleteUserGroupRequestSchema: GenMessage<DeleteUserGroupRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 64);
 
 /**
- * @generated from message memos.api.v1.ListUserNotificationsResponse
+ * @generated from message memos.api.v1.ListUserGroupMembersRequest
  */
-export type ListUserNotificationsResponse = Message<"memos.api.v1.ListUserNotificationsResponse"> & {
+export type ListUserGroupMembersRequest = Message<"memos.api.v1.ListUserGroupMembersRequest"> & {
   /**
-   * @generated from field: repeated memos.api.v1.UserNotification notifications = 1;
+   * Required. The parent group.
+   * Format: groups/{group}
+   *
+   * @generated from field: string parent = 1;
    */
-  notifications: UserNotification[];
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserGroupMembersRequest.
+ * Use `create(ListUserGroupMembersRequestSchema)` to create a new message.
+ */
+export const ListUserGroupMembersRequestSchema: GenMessage<ListUserGroupMembersRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 65);
 
+/**
+ * @generated from message memos.api.v1.ListUserGroupMembersResponse
+ */
+export type ListUserGroupMembersResponse = Message<"memos.api.v1.ListUserGroupMembersResponse"> & {
   /**
-   * @generated from field: string next_page_token = 2;
+   * The list of members.
+   *
+   * @generated from field: repeated memos.api.v1.UserGroupMember members = 1;
    */
-  nextPageToken: string;
+  members: UserGroupMember[];
 };
 
 /**
- * Describes the message memos.api.v1.ListUserNotificationsResponse.
- * Use `create(ListUserNotificationsResponseSchema)` to create a new message.
+ * Describes the message memos.api.v1.ListUserGroupMembersResponse.
+ * Use `create(ListUserGroupMembersResponseSchema)` to create a new message.
  */
-export const ListUserNotificationsResponseSchema: GenMessage<ListUserNotificationsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 38);
+export const ListUserGroupMembersResponseSchema: GenMessage<ListUserGroupMembersResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 66);
 
 /**
- * @generated from message memos.api.v1.UpdateUserNotificationRequest
+ * @generated from message memos.api.v1.AddUserGroupMemberRequest
  */
-export type UpdateUserNotificationRequest = Message<"memos.api.v1.UpdateUserNotificationRequest"> & {
+export type AddUserGroupMemberRequest = Message<"memos.api.v1.AddUserGroupMemberRequest"> & {
   /**
-   * @generated from field: memos.api.v1.UserNotification notification = 1;
+   * Required. The parent group.
+   * Format: groups/{group}
+   *
+   * @generated from field: string parent = 1;
    */
-  notification?: UserNotification | undefined;
+  parent: string;
 
   /**
-   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   * Required. The user to add.
+   * Format: users/{user}
+   *
+   * @generated from field: string user = 2;
    */
-  updateMask?: FieldMask | undefined;
+  user: string;
 };
 
 /**
- * Describes the message memos.api.v1.UpdateUserNotificationRequest.
- * Use `create(UpdateUserNotificationRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.AddUserGroupMemberRequest.
+ * Use `create(AddUserGroupMemberRequestSchema)` to create a new message.
  */
-export const UpdateUserNotificationRequestSchema: GenMessage<UpdateUserNotificationRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 39);
+export const AddUserGroupMemberRequestSchema: GenMessage<AddUserGroupMemberRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 67);
 
 /**
- * @generated from message memos.api.v1.DeleteUserNotificationRequest
+ * @generated from message memos.api.v1.RemoveUserGroupMemberRequest
  */
-export type DeleteUserNotificationRequest = Message<"memos.api.v1.DeleteUserNotificationRequest"> & {
+export type RemoveUserGroupMemberRequest = Message<"memos.api.v1.RemoveUserGroupMemberRequest"> & {
   /**
-   * Format: users/{user}/notifications/{notification}
+   * Required. The resource name of the membership to remove.
+   * Format: groups/{group}/members/{member}
    *
    * @generated from field: string name = 1;
    */
@@ -1633,11 +3108,11 @@ export type DeleteUserNotificationRequest = Message<"memos.api.v1.DeleteUserNoti
 };
 
 /**
- * Describes the message memos.api.v1.DeleteUserNotificationRequest.
- * Use `create(DeleteUserNotificationRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.RemoveUserGroupMemberRequest.
+ * Use `create(RemoveUserGroupMemberRequestSchema)` to create a new message.
  */
-export const DeleteUserNotificationRequestSchema: GenMessage<DeleteUserNotificationRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 40);
+export const RemoveUserGroupMemberRequestSchema: GenMessage<RemoveUserGroupMemberRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 68);
 
 /**
  * @generated from service memos.api.v1.UserService
@@ -1704,6 +3179,17 @@ export const UserService: GenService<{
     input: typeof DeleteUserRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * UnlockUser clears the failed sign-in attempts of a user, lifting any lockout.
+   * Only admins can unlock users.
+   *
+   * @generated from rpc memos.api.v1.UserService.UnlockUser
+   */
+  unlockUser: {
+    methodKind: "unary";
+    input: typeof UnlockUserRequestSchema;
+    output: typeof EmptySchema;
+  },
   /**
    * ListAllUserStats returns statistics for all users.
    *
@@ -1826,6 +3312,37 @@ export const UserService: GenService<{
     input: typeof DeletePersonalAccessTokenRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * ListSessions returns the active sign-in sessions of a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.ListSessions
+   */
+  listSessions: {
+    methodKind: "unary";
+    input: typeof ListSessionsRequestSchema;
+    output: typeof ListSessionsResponseSchema;
+  },
+  /**
+   * RevokeSession signs out a single session.
+   *
+   * @generated from rpc memos.api.v1.UserService.RevokeSession
+   */
+  revokeSession: {
+    methodKind: "unary";
+    input: typeof RevokeSessionRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * RevokeAllSessions signs a user out everywhere.
+   * Admins may also use it to revoke the sessions and personal access tokens of other users.
+   *
+   * @generated from rpc memos.api.v1.UserService.RevokeAllSessions
+   */
+  revokeAllSessions: {
+    methodKind: "unary";
+    input: typeof RevokeAllSessionsRequestSchema;
+    output: typeof EmptySchema;
+  },
   /**
    * ListUserWebhooks returns a list of webhooks for a user.
    *
@@ -1866,6 +3383,26 @@ export const UserService: GenService<{
     input: typeof DeleteUserWebhookRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * ListWebhookDeliveries lists the deliveries of a webhook, newest first.
+   *
+   * @generated from rpc memos.api.v1.UserService.ListWebhookDeliveries
+   */
+  listWebhookDeliveries: {
+    methodKind: "unary";
+    input: typeof ListWebhookDeliveriesRequestSchema;
+    output: typeof ListWebhookDeliveriesResponseSchema;
+  },
+  /**
+   * RedeliverWebhook queues a delivery to be sent again with the same delivery ID.
+   *
+   * @generated from rpc memos.api.v1.UserService.RedeliverWebhook
+   */
+  redeliverWebhook: {
+    methodKind: "unary";
+    input: typeof RedeliverWebhookRequestSchema;
+    output: typeof WebhookDeliverySchema;
+  },
   /**
    * ListUserNotifications lists notifications for a user.
    *
@@ -1896,6 +3433,136 @@ export const UserService: GenService<{
     input: typeof DeleteUserNotificationRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * SendTestNotification sends a test notification to the user's notification channels,
+   * or to the given channel before it is saved.
+   *
+   * @generated from rpc memos.api.v1.UserService.SendTestNotification
+   */
+  sendTestNotification: {
+    methodKind: "unary";
+    input: typeof SendTestNotificationRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ListUserPushSubscriptions lists the devices a user registered for Web Push notifications.
+   *
+   * @generated from rpc memos.api.v1.UserService.ListUserPushSubscriptions
+   */
+  listUserPushSubscriptions: {
+    methodKind: "unary";
+    input: typeof ListUserPushSubscriptionsRequestSchema;
+    output: typeof ListUserPushSubscriptionsResponseSchema;
+  },
+  /**
+   * CreateUserPushSubscription registers a device for Web Push notifications.
+   * Registering an endpoint again replaces its keys.
+   *
+   * @generated from rpc memos.api.v1.UserService.CreateUserPushSubscription
+   */
+  createUserPushSubscription: {
+    methodKind: "unary";
+    input: typeof CreateUserPushSubscriptionRequestSchema;
+    output: typeof UserPushSubscriptionSchema;
+  },
+  /**
+   * DeleteUserPushSubscription unregisters a device from Web Push notifications.
+   *
+   * @generated from rpc memos.api.v1.UserService.DeleteUserPushSubscription
+   */
+  deleteUserPushSubscription: {
+    methodKind: "unary";
+    input: typeof DeleteUserPushSubscriptionRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ListUserGroups lists user groups.
+   * User managers see every group; other users see the groups they belong to.
+   *
+   * @generated from rpc memos.api.v1.UserService.ListUserGroups
+   */
+  listUserGroups: {
+    methodKind: "unary";
+    input: typeof ListUserGroupsRequestSchema;
+    output: typeof ListUserGroupsResponseSchema;
+  },
+  /**
+   * GetUserGroup gets a user group.
+   * User managers can get any group; other users can get the groups they belong to.
+   *
+   * @generated from rpc memos.api.v1.UserService.GetUserGroup
+   */
+  getUserGroup: {
+    methodKind: "unary";
+    input: typeof GetUserGroupRequestSchema;
+    output: typeof UserGroupSchema;
+  },
+  /**
+   * CreateUserGroup creates a user group.
+   * Requires the MANAGE_USERS permission.
+   *
+   * @generated from rpc memos.api.v1.UserService.CreateUserGroup
+   */
+  createUserGroup: {
+    methodKind: "unary";
+    input: typeof CreateUserGroupRequestSchema;
+    output: typeof UserGroupSchema;
+  },
+  /**
+   * UpdateUserGroup updates the description of a user group.
+   * Requires the MANAGE_USERS permission.
+   *
+   * @generated from rpc memos.api.v1.UserService.UpdateUserGroup
+   */
+  updateUserGroup: {
+    methodKind: "unary";
+    input: typeof UpdateUserGroupRequestSchema;
+    output: typeof UserGroupSchema;
+  },
+  /**
+   * DeleteUserGroup deletes a user group and its memberships.
+   * Requires the MANAGE_USERS permission.
+   *
+   * @generated from rpc memos.api.v1.UserService.DeleteUserGroup
+   */
+  deleteUserGroup: {
+    methodKind: "unary";
+    input: typeof DeleteUserGroupRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ListUserGroupMembers lists the members of a user group.
+   * User managers can list any group; other users can list the groups they belong to.
+   *
+   * @generated from rpc memos.api.v1.UserService.ListUserGroupMembers
+   */
+  listUserGroupMembers: {
+    methodKind: "unary";
+    input: typeof ListUserGroupMembersRequestSchema;
+    output: typeof ListUserGroupMembersResponseSchema;
+  },
+  /**
+   * AddUserGroupMember adds a user to a group.
+   * Requires the MANAGE_USERS permission.
+   *
+   * @generated from rpc memos.api.v1.UserService.AddUserGroupMember
+   */
+  addUserGroupMember: {
+    methodKind: "unary";
+    input: typeof AddUserGroupMemberRequestSchema;
+    output: typeof UserGroupMemberSchema;
+  },
+  /**
+   * RemoveUserGroupMember removes a user from a group.
+   * Requires the MANAGE_USERS permission.
+   *
+   * @generated from rpc memos.api.v1.UserService.RemoveUserGroupMember
+   */
+  removeUserGroupMember: {
+    methodKind: "unary";
+    input: typeof RemoveUserGroupMemberRequestSchema;
+    output: typeof EmptySchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_user_service, 0);
 
@@ -0,0 +1,60 @@
+import { userServiceClient } from "@/connect";
+
+const SERVICE_WORKER_URL = "/sw.js";
+
+export const isPushSupported = () => "serviceWorker" in navigator && "PushManager" in window && "Notification" in window;
+
+const decodeApplicationServerKey = (key: string) => {
+  const base64 = key.replace(/-/g, "+").replace(/_/g, "/").padEnd(Math.ceil(key.length / 4) * 4, "=");
+  return Uint8Array.from(atob(base64), (char) => char.charCodeAt(0));
+};
+
+// getDevicePushSubscription returns the push subscription of this browser, if any.
+export const getDevicePushSubscription = async () => {
+  if (!isPushSupported()) return null;
+  const registration = await navigator.serviceWorker.getRegistration(SERVICE_WORKER_URL);
+  return (await registration?.pushManager.getSubscription()) ?? null;
+};
+
+// enableDevicePush subscribes this browser to push notifications and registers it for the user.
+export const enableDevicePush = async (userName: string) => {
+  const permission = await Notification.requestPermission();
+  if (permission !== "granted") {
+    throw new Error("Notification permission was not granted");
+  }
+  const registration = await navigator.serviceWorker.register(SERVICE_WORKER_URL);
+  await navigator.serviceWorker.ready;
+  const { vapidPublicKey } = await userServiceClient.listUserPushSubscriptions({ parent: userName });
+
+  let subscription = await registration.pushManager.getSubscription();
+  if (subscription) {
+    // A subscription made for other VAPID keys cannot receive messages from this instance.
+    await subscription.unsubscribe();
+  }
+  subscription = await registration.pushManager.subscribe({
+    userVisibleOnly: true,
+    applicationServerKey: decodeApplicationServerKey(vapidPublicKey),
+  });
+  const { endpoint, keys } = subscription.toJSON();
+  await userServiceClient.createUserPushSubscription({
+    parent: userName,
+    pushSubscription: {
+      endpoint,
+      p256dhKey: keys?.p256dh ?? "",
+      authKey: keys?.auth ?? "",
+      description: navigator.userAgent,
+    },
+  });
+};
+
+// disableDevicePush removes the push subscription of this browser.
+export const disableDevicePush = async (userName: string) => {
+  const subscription = await getDevicePushSubscription();
+  if (!subscription) return;
+  const { pushSubscriptions } = await userServiceClient.listUserPushSubscriptions({ parent: userName });
+  const registered = pushSubscriptions.find((item) => item.endpoint === subscription.endpoint);
+  if (registered) {
+    await userServiceClient.deleteUserPushSubscription({ name: registered.name });
+  }
+  await subscription.unsubscribe();
+};